@@ -0,0 +1,94 @@
+//Package aggregator provides the interface of the strategies which aggregate the values of the jobs of a collection
+//Custom strategies are compiled in by registering them with Register in an init function, they are selected per collection with the aggregator key of the collection in assets.json
+package aggregator
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+//Metadata is the data of the collection whose values are aggregated
+type Metadata struct {
+	CollectionId      uint16
+	CollectionName    string
+	Power             int8
+	Epoch             uint32
+	AggregationMethod uint32
+}
+
+//Input is the value and the weight of every job of the collection which returned data in the epoch, Values[i] has the weight Weights[i]
+//The values are shared with the caller and must not be modified
+type Input struct {
+	Values   []*big.Int
+	Weights  []uint8
+	Metadata Metadata
+}
+
+//Output is the aggregated value of the collection and the confidence of the strategy in it, from 0 to 1
+type Output struct {
+	Value      *big.Int
+	Confidence float64
+}
+
+//Aggregator is a strategy which aggregates the values of the jobs of a collection
+type Aggregator interface {
+	Aggregate(input Input) (Output, error)
+}
+
+//AggregatorFunc adapts a function to the Aggregator interface
+type AggregatorFunc func(input Input) (Output, error)
+
+//This function calls the adapted function
+func (f AggregatorFunc) Aggregate(input Input) (Output, error) {
+	return f(input)
+}
+
+var (
+	aggregators      = make(map[string]Aggregator)
+	aggregatorsMutex sync.RWMutex
+)
+
+//This function registers the aggregator with the name, it returns an error if the name is empty or already registered
+func Register(name string, aggregator Aggregator) error {
+	if name == "" {
+		return errors.New("name of the aggregator is empty")
+	}
+	if aggregator == nil {
+		return errors.New("aggregator " + name + " is nil")
+	}
+	aggregatorsMutex.Lock()
+	defer aggregatorsMutex.Unlock()
+	if _, ok := aggregators[name]; ok {
+		return errors.New("aggregator " + name + " is already registered")
+	}
+	aggregators[name] = aggregator
+	return nil
+}
+
+//This function returns the aggregator registered with the name
+func Get(name string) (Aggregator, bool) {
+	aggregatorsMutex.RLock()
+	defer aggregatorsMutex.RUnlock()
+	aggregator, ok := aggregators[name]
+	return aggregator, ok
+}
+
+//This function returns the sorted names of the registered aggregators
+func Names() []string {
+	aggregatorsMutex.RLock()
+	defer aggregatorsMutex.RUnlock()
+	var names []string
+	for name := range aggregators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func unregister(name string) {
+	aggregatorsMutex.Lock()
+	defer aggregatorsMutex.Unlock()
+	delete(aggregators, name)
+}
@@ -0,0 +1,99 @@
+package aggregator
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestRegister(t *testing.T) {
+	maxAggregator := AggregatorFunc(func(input Input) (Output, error) {
+		max := input.Values[0]
+		for _, value := range input.Values {
+			if value.Cmp(max) > 0 {
+				max = value
+			}
+		}
+		return Output{Value: max, Confidence: 1}, nil
+	})
+
+	tests := []struct {
+		name       string
+		register   []string
+		aggregator Aggregator
+		wantErr    []bool
+		wantNames  []string
+	}{
+		{
+			name:       "Test 1: When aggregators are registered",
+			register:   []string{"max", "highest"},
+			aggregator: maxAggregator,
+			wantErr:    []bool{false, false},
+			wantNames:  []string{"highest", "max"},
+		},
+		{
+			name:       "Test 2: When the name is already registered",
+			register:   []string{"max", "max"},
+			aggregator: maxAggregator,
+			wantErr:    []bool{false, true},
+			wantNames:  []string{"max"},
+		},
+		{
+			name:       "Test 3: When the name is empty",
+			register:   []string{""},
+			aggregator: maxAggregator,
+			wantErr:    []bool{true},
+			wantNames:  nil,
+		},
+		{
+			name:       "Test 4: When the aggregator is nil",
+			register:   []string{"max"},
+			aggregator: nil,
+			wantErr:    []bool{true},
+			wantNames:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, name := range tt.register {
+				err := Register(name, tt.aggregator)
+				if (err != nil) != tt.wantErr[i] {
+					t.Errorf("Register(%q) error = %v, wantErr %v", name, err, tt.wantErr[i])
+				}
+				defer unregister(name)
+			}
+			if got := Names(); !reflect.DeepEqual(got, tt.wantNames) {
+				t.Errorf("Names() = %v, want %v", got, tt.wantNames)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	err := Register("max", AggregatorFunc(func(input Input) (Output, error) {
+		max := input.Values[0]
+		for _, value := range input.Values {
+			if value.Cmp(max) > 0 {
+				max = value
+			}
+		}
+		return Output{Value: max, Confidence: 0.5}, nil
+	}))
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	defer unregister("max")
+
+	registeredAggregator, ok := Get("max")
+	if !ok {
+		t.Fatalf("Get() didn't return the registered aggregator")
+	}
+	got, err := registeredAggregator.Aggregate(Input{Values: []*big.Int{big.NewInt(3), big.NewInt(7), big.NewInt(5)}, Weights: []uint8{1, 1, 1}})
+	if err != nil || got.Value.Cmp(big.NewInt(7)) != 0 || got.Confidence != 0.5 {
+		t.Errorf("Aggregate() = %v, %v, want 7 with confidence 0.5", got, err)
+	}
+
+	if _, ok := Get("missing"); ok {
+		t.Errorf("Get() returned an aggregator which isn't registered")
+	}
+}
@@ -0,0 +1,189 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"razor/path"
+	"razor/utils"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var setAccountAliasCmd = &cobra.Command{
+	Use:   "setAccountAlias",
+	Short: "set an alias for an account of the keystore",
+	Long: `Sets an alias for an account of the keystore, so that the account can be selected in any command with --account <alias> instead of its address.
+Several accounts can be selected in the vote command to vote for all of them in one process.
+
+Example:
+  ./razor setAccountAlias --alias staker1 --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c
+  ./razor setAccountAlias --alias staker1 --remove
+  ./razor stakerInfo --account staker1`,
+	Run: initialiseSetAccountAlias,
+}
+
+//This function initialises the ExecuteSetAccountAlias function
+func initialiseSetAccountAlias(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteSetAccountAlias(cmd.Flags())
+}
+
+//This function sets the flags appropriately and sets or removes the alias of the account
+func (*UtilsStruct) ExecuteSetAccountAlias(flagSet *pflag.FlagSet) {
+	razorUtils.AssignLogFile(flagSet)
+
+	alias, err := flagSetUtils.GetStringAlias(flagSet)
+	utils.CheckError("Error in getting alias: ", err)
+
+	remove, err := flagSetUtils.GetBoolRemove(flagSet)
+	utils.CheckError("Error in getting remove: ", err)
+	if remove {
+		err = cmdUtils.RemoveAccountAlias(alias)
+		utils.CheckError("Error in removing account alias: ", err)
+		log.Infof("Alias %s is removed", alias)
+		return
+	}
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	err = cmdUtils.SetAccountAlias(alias, address)
+	utils.CheckError("Error in setting account alias: ", err)
+	log.Infof("Alias %s is set for %s", alias, address)
+}
+
+//This function sets the alias of the account, an alias which is already set is moved to the account
+func (*UtilsStruct) SetAccountAlias(alias string, address string) error {
+	if alias == "" || common.IsHexAddress(alias) || strings.Contains(alias, ",") {
+		return errors.New("alias should be a name which isn't an address and doesn't contain a comma")
+	}
+	if !common.IsHexAddress(address) {
+		return errors.New("invalid address")
+	}
+	accounts, err := cmdUtils.ListAccounts()
+	if err != nil {
+		return err
+	}
+	var inKeystore bool
+	for _, account := range accounts {
+		if strings.EqualFold(account.Address.Hex(), address) {
+			inKeystore = true
+		}
+	}
+	if !inKeystore {
+		log.Warnf("%s isn't in the keystore, it can only be used with an external signer", address)
+	}
+	filePath, err := path.PathUtilsInterface.GetAccountAliasesFilePath()
+	if err != nil {
+		return err
+	}
+	aliases, err := utils.UtilsInterface.ReadAccountAliases(filePath)
+	if err != nil {
+		return err
+	}
+	aliases[alias] = address
+	return utils.UtilsInterface.SaveAccountAliases(filePath, aliases)
+}
+
+//This function removes the alias of the account
+func (*UtilsStruct) RemoveAccountAlias(alias string) error {
+	aliases, err := cmdUtils.GetAccountAliases()
+	if err != nil {
+		return err
+	}
+	if _, ok := aliases[alias]; !ok {
+		return fmt.Errorf("alias %s isn't set", alias)
+	}
+	delete(aliases, alias)
+	filePath, err := path.PathUtilsInterface.GetAccountAliasesFilePath()
+	if err != nil {
+		return err
+	}
+	return utils.UtilsInterface.SaveAccountAliases(filePath, aliases)
+}
+
+//This function returns the aliases of the accounts mapped to their addresses
+func (*UtilsStruct) GetAccountAliases() (map[string]string, error) {
+	filePath, err := path.PathUtilsInterface.GetAccountAliasesFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return utils.UtilsInterface.ReadAccountAliases(filePath)
+}
+
+//This function returns the addresses of the accounts which are selected by their address or alias
+//An account can't be selected more than once
+func (*UtilsStruct) ResolveAccounts(accounts []string) ([]string, error) {
+	var (
+		addresses []string
+		aliases   map[string]string
+	)
+	for _, account := range accounts {
+		address := account
+		if !common.IsHexAddress(account) {
+			if aliases == nil {
+				var err error
+				aliases, err = cmdUtils.GetAccountAliases()
+				if err != nil {
+					return nil, err
+				}
+			}
+			var ok bool
+			address, ok = aliases[account]
+			if !ok {
+				return nil, fmt.Errorf("%s is neither an address nor an alias set with setAccountAlias", account)
+			}
+		}
+		for _, selectedAddress := range addresses {
+			if strings.EqualFold(selectedAddress, address) {
+				return nil, fmt.Errorf("account %s is selected more than once", address)
+			}
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+//This function sets the address flag of the command to the account selected with --account
+//Only the vote command can take several accounts, the address flag is set to the first one
+func selectAccount(cmd *cobra.Command, args []string) {
+	accounts, err := cmd.Flags().GetStringSlice("account")
+	utils.CheckError("Error in getting account: ", err)
+	if len(accounts) == 0 {
+		return
+	}
+	addressFlag := cmd.Flags().Lookup("address")
+	if addressFlag == nil {
+		log.Fatalf("%s command doesn't take an account", cmd.Name())
+	}
+	if len(accounts) > 1 && cmd != voteCmd {
+		log.Fatal("Only the vote command can take several accounts")
+	}
+	addresses, err := cmdUtils.ResolveAccounts(accounts)
+	utils.CheckError("Error in selecting account: ", err)
+	if addressFlag.Changed && !strings.EqualFold(addressFlag.Value.String(), addresses[0]) {
+		log.Fatal("--address and --account select different accounts")
+	}
+	err = cmd.Flags().Set("address", addresses[0])
+	utils.CheckError("Error in setting address: ", err)
+}
+
+func init() {
+	rootCmd.AddCommand(setAccountAliasCmd)
+
+	var (
+		Alias   string
+		Address string
+		Remove  bool
+	)
+
+	setAccountAliasCmd.Flags().StringVarP(&Alias, "alias", "", "", "alias of the account")
+	setAccountAliasCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the account")
+	setAccountAliasCmd.Flags().BoolVarP(&Remove, "remove", "", false, "remove the alias")
+
+	aliasErr := setAccountAliasCmd.MarkFlagRequired("alias")
+	utils.CheckError("Alias error: ", aliasErr)
+}
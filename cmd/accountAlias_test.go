@@ -0,0 +1,294 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetAccountAlias(t *testing.T) {
+	address := "0x000000000000000000000000000000000000dea1"
+
+	type args struct {
+		alias          string
+		address        string
+		accounts       []accounts.Account
+		accountsErr    error
+		aliases        map[string]string
+		aliasesErr     error
+		saveAliasesErr error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantAliases map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "Test 1: When the alias is set",
+			args: args{
+				alias:    "staker1",
+				address:  address,
+				accounts: []accounts.Account{{Address: common.HexToAddress(address)}},
+				aliases:  map[string]string{"staker2": "0x000000000000000000000000000000000000dea2"},
+			},
+			wantAliases: map[string]string{"staker1": address, "staker2": "0x000000000000000000000000000000000000dea2"},
+			wantErr:     false,
+		},
+		{
+			name: "Test 2: When the alias is moved to another account",
+			args: args{
+				alias:   "staker1",
+				address: address,
+				aliases: map[string]string{"staker1": "0x000000000000000000000000000000000000dea2"},
+			},
+			wantAliases: map[string]string{"staker1": address},
+			wantErr:     false,
+		},
+		{
+			name: "Test 3: When the alias is an address",
+			args: args{
+				alias:   "0x000000000000000000000000000000000000dea2",
+				address: address,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the alias contains a comma",
+			args: args{
+				alias:   "staker1,staker2",
+				address: address,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the address is invalid",
+			args: args{
+				alias:   "staker1",
+				address: "0xdea1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in listing the accounts",
+			args: args{
+				alias:       "staker1",
+				address:     address,
+				accountsErr: errors.New("accounts error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in reading the aliases",
+			args: args{
+				alias:      "staker1",
+				address:    address,
+				aliasesErr: errors.New("read error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in saving the aliases",
+			args: args{
+				alias:          "staker1",
+				address:        address,
+				aliases:        map[string]string{},
+				saveAliasesErr: errors.New("save error"),
+			},
+			wantAliases: map[string]string{"staker1": address},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var savedAliases map[string]string
+			cmdUtilsMock.On("ListAccounts").Return(tt.args.accounts, tt.args.accountsErr)
+			pathUtilsMock.On("GetAccountAliasesFilePath").Return("accountAliases.json", nil)
+			utilsPkgMock.On("ReadAccountAliases", "accountAliases.json").Return(tt.args.aliases, tt.args.aliasesErr)
+			utilsPkgMock.On("SaveAccountAliases", "accountAliases.json", mock.Anything).Run(func(args mock.Arguments) {
+				savedAliases = args.Get(1).(map[string]string)
+			}).Return(tt.args.saveAliasesErr)
+
+			ut := &UtilsStruct{}
+			err := ut.SetAccountAlias(tt.args.alias, tt.args.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetAccountAlias() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(savedAliases, tt.wantAliases) {
+				t.Errorf("SetAccountAlias() saved aliases = %v, want %v", savedAliases, tt.wantAliases)
+			}
+		})
+	}
+}
+
+func TestRemoveAccountAlias(t *testing.T) {
+	type args struct {
+		alias      string
+		aliases    map[string]string
+		aliasesErr error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantAliases map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "Test 1: When the alias is removed",
+			args: args{
+				alias:   "staker1",
+				aliases: map[string]string{"staker1": "0x000000000000000000000000000000000000dea1", "staker2": "0x000000000000000000000000000000000000dea2"},
+			},
+			wantAliases: map[string]string{"staker2": "0x000000000000000000000000000000000000dea2"},
+			wantErr:     false,
+		},
+		{
+			name: "Test 2: When the alias isn't set",
+			args: args{
+				alias:   "staker1",
+				aliases: map[string]string{"staker2": "0x000000000000000000000000000000000000dea2"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting the aliases",
+			args: args{
+				alias:      "staker1",
+				aliasesErr: errors.New("aliases error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var savedAliases map[string]string
+			cmdUtilsMock.On("GetAccountAliases").Return(tt.args.aliases, tt.args.aliasesErr)
+			pathUtilsMock.On("GetAccountAliasesFilePath").Return("accountAliases.json", nil)
+			utilsPkgMock.On("SaveAccountAliases", "accountAliases.json", mock.Anything).Run(func(args mock.Arguments) {
+				savedAliases = args.Get(1).(map[string]string)
+			}).Return(nil)
+
+			ut := &UtilsStruct{}
+			err := ut.RemoveAccountAlias(tt.args.alias)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RemoveAccountAlias() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(savedAliases, tt.wantAliases) {
+				t.Errorf("RemoveAccountAlias() saved aliases = %v, want %v", savedAliases, tt.wantAliases)
+			}
+		})
+	}
+}
+
+func TestResolveAccounts(t *testing.T) {
+	aliases := map[string]string{
+		"staker1": "0x000000000000000000000000000000000000dea1",
+		"staker2": "0x000000000000000000000000000000000000dea2",
+	}
+
+	type args struct {
+		accounts   []string
+		aliasesErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the accounts are selected by their aliases",
+			args: args{
+				accounts: []string{"staker2", "staker1"},
+			},
+			want:    []string{"0x000000000000000000000000000000000000dea2", "0x000000000000000000000000000000000000dea1"},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the accounts are selected by their address and alias",
+			args: args{
+				accounts: []string{"0x000000000000000000000000000000000000dea3", "staker1"},
+			},
+			want:    []string{"0x000000000000000000000000000000000000dea3", "0x000000000000000000000000000000000000dea1"},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the addresses don't need the aliases",
+			args: args{
+				accounts:   []string{"0x000000000000000000000000000000000000dea1"},
+				aliasesErr: errors.New("aliases error"),
+			},
+			want:    []string{"0x000000000000000000000000000000000000dea1"},
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When the alias isn't set",
+			args: args{
+				accounts: []string{"staker3"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When an account is selected by its address and alias",
+			args: args{
+				accounts: []string{"staker1", "0x000000000000000000000000000000000000DEA1"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting the aliases",
+			args: args{
+				accounts:   []string{"staker1"},
+				aliasesErr: errors.New("aliases error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			cmdUtils = cmdUtilsMock
+
+			cmdUtilsMock.On("GetAccountAliases").Return(aliases, tt.args.aliasesErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.ResolveAccounts(tt.args.accounts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveAccounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveAccounts() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/pkg/bindings"
+	"razor/utils"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	localActivitySource   = "local"
+	onChainActivitySource = "on-chain"
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "activity feed of an account",
+	Long: `Provides a chronological feed of the activity of an account, merging the transactions recorded locally in the journal while voting with the events emitted on-chain for the account or its staker.
+A transaction which is recorded locally and has emitted events on-chain is shown once with both the local status and the decoded events.
+
+Example:
+  ./razor activity --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --last 100
+  ./razor activity --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --last 20 --days 1`,
+	Run: initialiseActivity,
+}
+
+//This function initialises the ExecuteActivity function
+func initialiseActivity(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteActivity(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetActivityFeed function
+func (*UtilsStruct) ExecuteActivity(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	last, err := flagSetUtils.GetUint32Last(flagSet)
+	utils.CheckError("Error in getting last: ", err)
+
+	days, err := flagSetUtils.GetUint32Days(flagSet)
+	utils.CheckError("Error in getting days: ", err)
+
+	feed, err := cmdUtils.GetActivityFeed(client, address, days)
+	utils.CheckError("Error in getting activity feed: ", err)
+
+	printActivityFeed(address, getLastActivities(feed, last))
+}
+
+//This function returns the activity of the address over the last days in chronological order
+//The transactions recorded locally are merged with the on-chain events emitted by the same transactions
+func (*UtilsStruct) GetActivityFeed(client *ethclient.Client, address string, days uint32) ([]types.ActivityEntry, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New("invalid address")
+	}
+	if days == 0 {
+		return nil, errors.New("days should be greater than 0")
+	}
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return nil, err
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return nil, err
+	}
+	var fromEpoch uint32
+	epochsInRange := uint32(int64(days) * 24 * 60 * 60 / core.EpochLength)
+	if epoch > epochsInRange {
+		fromEpoch = epoch - epochsInRange
+	}
+	blocksInRange := int64(time.Duration(days) * 24 * time.Hour / utils.UtilsInterface.GetAverageBlockTime(client))
+	fromBlock := big.NewInt(0)
+	if latestHeader.Number.Int64() > blocksInRange {
+		fromBlock = big.NewInt(latestHeader.Number.Int64() - blocksInRange)
+	}
+
+	localActivity, err := getLocalActivity(address, fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		return nil, err
+	}
+	onChainActivity, err := getOnChainActivity(client, common.HexToAddress(address), stakerId, fromBlock, latestHeader.Number)
+	if err != nil {
+		return nil, err
+	}
+	return mergeActivity(localActivity, onChainActivity), nil
+}
+
+//This function returns the transactions and checks recorded in the journal of the address from the given epoch
+func getLocalActivity(address string, fromEpoch uint32) ([]types.ActivityEntry, error) {
+	fileName, err := path.PathUtilsInterface.GetJournalFileName(address)
+	if err != nil {
+		return nil, err
+	}
+	journal, err := utils.UtilsInterface.ReadJournal(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var activity []types.ActivityEntry
+	for _, entry := range journal {
+		if entry.Epoch < fromEpoch {
+			continue
+		}
+		for _, action := range entry.Actions {
+			description := fmt.Sprintf("%s %s", action.Action, action.Status)
+			if action.TxnHash != "" {
+				description = fmt.Sprintf("%s transaction %s", action.Action, action.Status)
+			}
+			activity = append(activity, types.ActivityEntry{
+				Epoch:       entry.Epoch,
+				TxnHash:     action.TxnHash,
+				Source:      localActivitySource,
+				Description: description,
+			})
+		}
+	}
+	return activity, nil
+}
+
+//This function returns the decoded events of the stake, vote and block manager contracts which concern the address or its staker
+func getOnChainActivity(client *ethclient.Client, address common.Address, stakerId uint32, fromBlock *big.Int, toBlock *big.Int) ([]types.ActivityEntry, error) {
+	contractAbis := make(map[common.Address]abi.ABI)
+	for contractAddress, contractAbiJson := range map[string]string{
+		core.StakeManagerAddress: bindings.StakeManagerABI,
+		core.VoteManagerAddress:  bindings.VoteManagerABI,
+		core.BlockManagerAddress: bindings.BlockManagerABI,
+	} {
+		contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(contractAbiJson))
+		if err != nil {
+			return nil, err
+		}
+		contractAbis[common.HexToAddress(contractAddress)] = contractAbi
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: []common.Address{
+			common.HexToAddress(core.StakeManagerAddress),
+			common.HexToAddress(core.VoteManagerAddress),
+			common.HexToAddress(core.BlockManagerAddress),
+		},
+	}
+	// The logs are streamed and decoded one by one, so that a long history isn't held in memory
+	logIterator := utils.NewLogIterator(client, query)
+	epochOfBlock := make(map[uint64]uint32)
+	var activity []types.ActivityEntry
+	for logIterator.Next() {
+		vLog := logIterator.Log()
+		contractAbi, ok := contractAbis[vLog.Address]
+		if !ok {
+			continue
+		}
+		event, args, err := decodeActivityEvent(contractAbi, vLog)
+		if err != nil {
+			log.Debugf("Error in decoding event of transaction %s: %s", vLog.TxHash.Hex(), err)
+			continue
+		}
+		if !isActivityOfAccount(event, args, address, stakerId) {
+			continue
+		}
+		epoch, ok := epochOfBlock[vLog.BlockNumber]
+		if !ok {
+			header, err := utils.ClientInterface.HeaderByNumber(client, context.Background(), new(big.Int).SetUint64(vLog.BlockNumber))
+			if err != nil {
+				return nil, err
+			}
+			epoch = uint32(header.Time / uint64(core.EpochLength))
+			epochOfBlock[vLog.BlockNumber] = epoch
+		}
+		activity = append(activity, types.ActivityEntry{
+			Epoch:       epoch,
+			BlockNumber: vLog.BlockNumber,
+			TxnHash:     vLog.TxHash.Hex(),
+			Source:      onChainActivitySource,
+			Description: describeActivityEvent(event, args),
+		})
+	}
+	if err := logIterator.Error(); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+//This function decodes the indexed and non indexed arguments of an event log by their names
+func decodeActivityEvent(contractAbi abi.ABI, vLog Types.Log) (*abi.Event, map[string]interface{}, error) {
+	if len(vLog.Topics) == 0 {
+		return nil, nil, errors.New("anonymous event")
+	}
+	event, err := contractAbi.EventByID(vLog.Topics[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	args := make(map[string]interface{})
+	if len(vLog.Data) > 0 {
+		err = event.Inputs.UnpackIntoMap(args, vLog.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	err = abi.ParseTopicsIntoMap(args, indexed, vLog.Topics[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return event, args, nil
+}
+
+//This function checks if any address argument of the event is the address or any staker id argument is the staker id of the address
+func isActivityOfAccount(event *abi.Event, args map[string]interface{}, address common.Address, stakerId uint32) bool {
+	for _, input := range event.Inputs {
+		switch value := args[input.Name].(type) {
+		case common.Address:
+			if value == address {
+				return true
+			}
+		case uint32:
+			if stakerId != 0 && value == stakerId && strings.Contains(strings.ToLower(input.Name), "staker") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+//This function returns a human readable description of the event with its arguments in the order of declaration
+func describeActivityEvent(event *abi.Event, args map[string]interface{}) string {
+	var fields []string
+	for _, input := range event.Inputs {
+		var value string
+		switch arg := args[input.Name].(type) {
+		case common.Address:
+			value = arg.Hex()
+		case common.Hash:
+			value = arg.Hex()
+		case [32]byte:
+			value = common.Hash(arg).Hex()
+		case []byte:
+			value = common.Bytes2Hex(arg)
+		default:
+			value = fmt.Sprintf("%v", arg)
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s", input.Name, value))
+	}
+	return fmt.Sprintf("%s(%s)", event.Name, strings.Join(fields, ", "))
+}
+
+//This function merges the local activity with the on-chain activity in chronological order
+//The on-chain events of a transaction recorded locally carry the local status and the recorded entry isn't repeated
+func mergeActivity(localActivity []types.ActivityEntry, onChainActivity []types.ActivityEntry) []types.ActivityEntry {
+	recordedTxns := make(map[string]types.ActivityEntry)
+	for _, entry := range localActivity {
+		if entry.TxnHash != "" {
+			recordedTxns[strings.ToLower(entry.TxnHash)] = entry
+		}
+	}
+
+	emittedTxns := make(map[string]bool)
+	feed := make([]types.ActivityEntry, 0, len(localActivity)+len(onChainActivity))
+	for _, entry := range onChainActivity {
+		txnHash := strings.ToLower(entry.TxnHash)
+		if recorded, ok := recordedTxns[txnHash]; ok {
+			entry.Source = localActivitySource + ", " + onChainActivitySource
+			entry.Description = recorded.Description + ": " + entry.Description
+		}
+		emittedTxns[txnHash] = true
+		feed = append(feed, entry)
+	}
+	for _, entry := range localActivity {
+		if entry.TxnHash != "" && emittedTxns[strings.ToLower(entry.TxnHash)] {
+			continue
+		}
+		feed = append(feed, entry)
+	}
+	// The on-chain events are in block order, the local entries of an epoch without a block follow them
+	sort.SliceStable(feed, func(i, j int) bool { return feed[i].Epoch < feed[j].Epoch })
+	return feed
+}
+
+//This function returns the last activities of the feed, the whole feed is returned if last is 0
+func getLastActivities(feed []types.ActivityEntry, last uint32) []types.ActivityEntry {
+	if last == 0 || int(last) >= len(feed) {
+		return feed
+	}
+	return feed[len(feed)-int(last):]
+}
+
+//This function prints the activity feed in a table
+func printActivityFeed(address string, feed []types.ActivityEntry) {
+	if len(feed) == 0 {
+		log.Infof("No activity found for %s", address)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Epoch", "Block", "Source", "Activity", "Txn Hash"})
+	for _, entry := range feed {
+		block := "-"
+		if entry.BlockNumber != 0 {
+			block = strconv.FormatUint(entry.BlockNumber, 10)
+		}
+		table.Append([]string{strconv.Itoa(int(entry.Epoch)), block, entry.Source, entry.Description, entry.TxnHash})
+	}
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(activityCmd)
+
+	var (
+		Address string
+		Last    uint32
+		Days    uint32
+	)
+
+	activityCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the account")
+	activityCmd.Flags().Uint32VarP(&Last, "last", "", 100, "number of latest activities to show, all the activities are shown if 0")
+	activityCmd.Flags().Uint32VarP(&Days, "days", "", 7, "number of days of activity to look back")
+
+	addrErr := activityCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
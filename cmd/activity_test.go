@@ -0,0 +1,461 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+const activityTestAbi = `[
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"epoch","type":"uint32"},{"indexed":true,"name":"stakerId","type":"uint32"},{"indexed":false,"name":"commitment","type":"bytes32"}],"name":"Committed","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"delegator","type":"address"},{"indexed":true,"name":"stakerId","type":"uint32"},{"indexed":false,"name":"amount","type":"uint256"}],"name":"Delegated","type":"event"}
+]`
+
+func TestGetActivityFeed(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	contractAbi, err := abi.JSON(strings.NewReader(activityTestAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed := contractAbi.Events["Committed"]
+	delegated := contractAbi.Events["Delegated"]
+	commitment := [32]byte{1}
+	committedData, err := committed.Inputs.NonIndexed().Pack(uint32(100), commitment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delegatedData, err := delegated.Inputs.NonIndexed().Pack(big.NewInt(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commitTxn := common.HexToHash("0xa1")
+	delegateTxn := common.HexToHash("0xc1")
+	logs := []Types.Log{
+		{
+			Address:     common.HexToAddress(core.VoteManagerAddress),
+			Topics:      []common.Hash{committed.ID, common.BigToHash(big.NewInt(3))},
+			Data:        committedData,
+			BlockNumber: 50,
+			TxHash:      commitTxn,
+		},
+		{
+			Address:     common.HexToAddress(core.VoteManagerAddress),
+			Topics:      []common.Hash{committed.ID, common.BigToHash(big.NewInt(4))},
+			Data:        committedData,
+			BlockNumber: 55,
+			TxHash:      common.HexToHash("0xb1"),
+		},
+		{
+			Address:     common.HexToAddress(core.StakeManagerAddress),
+			Topics:      []common.Hash{delegated.ID, common.HexToAddress(address).Hash(), common.BigToHash(big.NewInt(9))},
+			Data:        delegatedData,
+			BlockNumber: 60,
+			TxHash:      delegateTxn,
+		},
+		{
+			Address:     common.HexToAddress("0x01"),
+			Topics:      []common.Hash{committed.ID, common.BigToHash(big.NewInt(3))},
+			Data:        committedData,
+			BlockNumber: 61,
+		},
+	}
+	journal := []types.JournalEntry{
+		{
+			Epoch:   10,
+			Actions: []types.JournalAction{{Action: "commit", TxnHash: "0x01", Status: "mined"}},
+		},
+		{
+			Epoch: 100,
+			Actions: []types.JournalAction{
+				{Action: "commit", TxnHash: "0x" + strings.ToUpper(commitTxn.Hex()[2:]), Status: "mined"},
+				{Action: "reveal", TxnHash: "0xd1", Status: "failed"},
+				{Action: "disputeCheck", Status: "noDispute"},
+			},
+		},
+	}
+
+	type args struct {
+		address        string
+		days           uint32
+		latestBlockErr error
+		epochErr       error
+		journal        []types.JournalEntry
+		journalErr     error
+		stakerIdErr    error
+		contractAbiErr error
+		logs           []Types.Log
+		logsErr        error
+		headerErr      error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []types.ActivityEntry
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetActivityFeed executes successfully",
+			args: args{
+				address: address,
+				days:    1,
+				journal: journal,
+				logs:    logs,
+			},
+			want: []types.ActivityEntry{
+				{
+					Epoch:       100,
+					BlockNumber: 50,
+					TxnHash:     commitTxn.Hex(),
+					Source:      "local, on-chain",
+					Description: "commit transaction mined: Committed(epoch: 100, stakerId: 3, commitment: " + common.Hash(commitment).Hex() + ")",
+				},
+				{
+					Epoch:       100,
+					BlockNumber: 60,
+					TxnHash:     delegateTxn.Hex(),
+					Source:      "on-chain",
+					Description: "Delegated(delegator: " + address + ", stakerId: 9, amount: 10)",
+				},
+				{
+					Epoch:       100,
+					TxnHash:     "0xd1",
+					Source:      "local",
+					Description: "reveal transaction failed",
+				},
+				{
+					Epoch:       100,
+					Source:      "local",
+					Description: "disputeCheck noDispute",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is no activity",
+			args: args{
+				address: address,
+				days:    1,
+			},
+			want:    []types.ActivityEntry{},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the address is invalid",
+			args: args{
+				address: "0x123",
+				days:    1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When days is 0",
+			args: args{
+				address: address,
+				days:    0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting latest block",
+			args: args{
+				address:        address,
+				days:           1,
+				latestBlockErr: errors.New("block error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting epoch",
+			args: args{
+				address:  address,
+				days:     1,
+				epochErr: errors.New("epoch error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in reading journal",
+			args: args{
+				address:    address,
+				days:       1,
+				journalErr: errors.New("journal error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting staker id",
+			args: args{
+				address:     address,
+				days:        1,
+				stakerIdErr: errors.New("stakerId error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When there is an error in parsing contract abi",
+			args: args{
+				address:        address,
+				days:           1,
+				contractAbiErr: errors.New("abi error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When there is an error in fetching logs",
+			args: args{
+				address: address,
+				days:    1,
+				logsErr: errors.New("logs error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 11: When there is an error in fetching block header of an event",
+			args: args{
+				address:   address,
+				days:      1,
+				logs:      logs,
+				headerErr: errors.New("header error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			abiUtilsMock := new(mocks2.ABIUtils)
+			clientUtilsMock := new(mocks2.ClientUtils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utils.ABIInterface = abiUtilsMock
+			utils.ClientInterface = clientUtilsMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(100)}, tt.args.latestBlockErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(uint32(100), tt.args.epochErr)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
+			pathUtilsMock.On("GetJournalFileName", mock.AnythingOfType("string")).Return("journal.jsonl", nil)
+			utilsPkgMock.On("ReadJournal", mock.AnythingOfType("string")).Return(tt.args.journal, tt.args.journalErr)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(uint32(3), tt.args.stakerIdErr)
+			abiUtilsMock.On("Parse", mock.Anything).Return(contractAbi, tt.args.contractAbiErr)
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr).Once()
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return([]Types.Log{}, nil)
+			clientUtilsMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(&Types.Header{Time: uint64(100 * core.EpochLength)}, tt.args.headerErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetActivityFeed(client, tt.args.address, tt.args.days)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetActivityFeed() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetActivityFeed() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeActivity(t *testing.T) {
+	tests := []struct {
+		name            string
+		localActivity   []types.ActivityEntry
+		onChainActivity []types.ActivityEntry
+		want            []types.ActivityEntry
+	}{
+		{
+			name: "Test 1: When activities of different epochs are merged in chronological order",
+			localActivity: []types.ActivityEntry{
+				{Epoch: 1, Source: "local", Description: "commit transaction mined", TxnHash: "0x01"},
+				{Epoch: 3, Source: "local", Description: "reveal transaction failed", TxnHash: "0x03"},
+			},
+			onChainActivity: []types.ActivityEntry{
+				{Epoch: 2, BlockNumber: 20, Source: "on-chain", Description: "Staked()", TxnHash: "0x02"},
+				{Epoch: 3, BlockNumber: 30, Source: "on-chain", Description: "Claimed()", TxnHash: "0x04"},
+			},
+			want: []types.ActivityEntry{
+				{Epoch: 1, Source: "local", Description: "commit transaction mined", TxnHash: "0x01"},
+				{Epoch: 2, BlockNumber: 20, Source: "on-chain", Description: "Staked()", TxnHash: "0x02"},
+				{Epoch: 3, BlockNumber: 30, Source: "on-chain", Description: "Claimed()", TxnHash: "0x04"},
+				{Epoch: 3, Source: "local", Description: "reveal transaction failed", TxnHash: "0x03"},
+			},
+		},
+		{
+			name: "Test 2: When every event of a recorded transaction carries the local status",
+			localActivity: []types.ActivityEntry{
+				{Epoch: 1, Source: "local", Description: "propose transaction mined", TxnHash: "0xAB"},
+			},
+			onChainActivity: []types.ActivityEntry{
+				{Epoch: 1, BlockNumber: 10, Source: "on-chain", Description: "Proposed()", TxnHash: "0xab"},
+				{Epoch: 1, BlockNumber: 10, Source: "on-chain", Description: "Transfer()", TxnHash: "0xab"},
+			},
+			want: []types.ActivityEntry{
+				{Epoch: 1, BlockNumber: 10, Source: "local, on-chain", Description: "propose transaction mined: Proposed()", TxnHash: "0xab"},
+				{Epoch: 1, BlockNumber: 10, Source: "local, on-chain", Description: "propose transaction mined: Transfer()", TxnHash: "0xab"},
+			},
+		},
+		{
+			name:            "Test 3: When there is no activity",
+			localActivity:   nil,
+			onChainActivity: nil,
+			want:            []types.ActivityEntry{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeActivity(tt.localActivity, tt.onChainActivity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeActivity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLastActivities(t *testing.T) {
+	feed := []types.ActivityEntry{{Epoch: 1}, {Epoch: 2}, {Epoch: 3}}
+	tests := []struct {
+		name string
+		last uint32
+		want []types.ActivityEntry
+	}{
+		{
+			name: "Test 1: When last is less than the length of the feed",
+			last: 2,
+			want: []types.ActivityEntry{{Epoch: 2}, {Epoch: 3}},
+		},
+		{
+			name: "Test 2: When last is more than the length of the feed",
+			last: 5,
+			want: feed,
+		},
+		{
+			name: "Test 3: When last is 0",
+			last: 0,
+			want: feed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getLastActivities(feed, tt.last); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getLastActivities() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteActivity(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	var client *ethclient.Client
+	var config types.Configurations
+
+	type args struct {
+		configErr  error
+		addressErr error
+		lastErr    error
+		daysErr    error
+		feed       []types.ActivityEntry
+		feedErr    error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteActivity executes successfully",
+			args: args{
+				feed: []types.ActivityEntry{
+					{Epoch: 1, BlockNumber: 10, Source: "on-chain", Description: "Staked()", TxnHash: "0x01"},
+				},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting config",
+			args: args{
+				configErr: errors.New("config error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting address",
+			args: args{
+				addressErr: errors.New("address error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting last",
+			args: args{
+				lastErr: errors.New("last error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting days",
+			args: args{
+				daysErr: errors.New("days error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting activity feed",
+			args: args{
+				feedErr: errors.New("feed error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			flagSetUtils = flagSetUtilsMock
+
+			cmdUtilsMock.On("GetConfigData").Return(config, tt.args.configErr)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetStringAddress", flagSet).Return("0x000000000000000000000000000000000000dEaD", tt.args.addressErr)
+			flagSetUtilsMock.On("GetUint32Last", flagSet).Return(uint32(100), tt.args.lastErr)
+			flagSetUtilsMock.On("GetUint32Days", flagSet).Return(uint32(7), tt.args.daysErr)
+			cmdUtilsMock.On("GetActivityFeed", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(tt.args.feed, tt.args.feedErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteActivity(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteActivity function didn't execute as expected")
+			}
+		})
+	}
+}
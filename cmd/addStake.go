@@ -61,6 +61,15 @@ func (*UtilsStruct) ExecuteStake(flagSet *pflag.FlagSet) {
 		log.Fatal("The amount of razors entered is below min safe value.")
 	}
 
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	utils.CheckError("Error in getting stakerId: ", err)
+	if stakerId != 0 {
+		force, err := flagSetUtils.GetBoolForce(flagSet)
+		utils.CheckError("Error in getting force: ", err)
+		err = cmdUtils.CheckStakeSafety(client, stakerId, valueInWei, force)
+		utils.CheckError("Stake is unsafe: ", err)
+	}
+
 	txnArgs := types.TransactionOptions{
 		Client:         client,
 		AccountAddress: address,
@@ -112,11 +121,13 @@ func init() {
 		Amount   string
 		Address  string
 		WeiRazor bool
+		Force    bool
 	)
 
 	stakeCmd.Flags().StringVarP(&Amount, "value", "v", "0", "amount of Razors to stake")
 	stakeCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
 	stakeCmd.Flags().BoolVarP(&WeiRazor, "weiRazor", "", false, "value can be passed in wei")
+	stakeCmd.Flags().BoolVarP(&Force, "force", "", false, "stake even if the staker is slashed")
 
 	amountErr := stakeCmd.MarkFlagRequired("value")
 	utils.CheckError("Value error: ", amountErr)
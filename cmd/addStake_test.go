@@ -149,6 +149,9 @@ func TestExecuteStake(t *testing.T) {
 		minSafeRazorErr error
 		stakeTxn        common.Hash
 		stakeErr        error
+		stakerId        uint32
+		stakerIdErr     error
+		stakeSafetyErr  error
 	}
 	tests := []struct {
 		name          string
@@ -270,6 +273,52 @@ func TestExecuteStake(t *testing.T) {
 			},
 			expectedFatal: true,
 		},
+		{
+			name: "Test 9: When the staker exists and the stake is safe",
+			args: args{
+				config:       config,
+				password:     "test",
+				address:      "0x000000000000000000000000000000000000dead",
+				amount:       big.NewInt(2000),
+				balance:      big.NewInt(10000),
+				minSafeRazor: big.NewInt(0),
+				stakerId:     1,
+				approveTxn:   common.BigToHash(big.NewInt(1)),
+				stakeTxn:     common.BigToHash(big.NewInt(2)),
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 10: When the staker exists and the stake is unsafe",
+			args: args{
+				config:         config,
+				password:       "test",
+				address:        "0x000000000000000000000000000000000000dead",
+				amount:         big.NewInt(2000),
+				balance:        big.NewInt(10000),
+				minSafeRazor:   big.NewInt(0),
+				stakerId:       1,
+				stakeSafetyErr: errors.New("staker is slashed"),
+				approveTxn:     common.BigToHash(big.NewInt(1)),
+				stakeTxn:       common.BigToHash(big.NewInt(2)),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 11: When there is an error in getting stakerId",
+			args: args{
+				config:       config,
+				password:     "test",
+				address:      "0x000000000000000000000000000000000000dead",
+				amount:       big.NewInt(2000),
+				balance:      big.NewInt(10000),
+				minSafeRazor: big.NewInt(0),
+				stakerIdErr:  errors.New("stakerId error"),
+				approveTxn:   common.BigToHash(big.NewInt(1)),
+				stakeTxn:     common.BigToHash(big.NewInt(2)),
+			},
+			expectedFatal: true,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -299,6 +348,9 @@ func TestExecuteStake(t *testing.T) {
 			utilsMock.On("CheckAmountAndBalance", mock.AnythingOfType("*big.Int"), mock.AnythingOfType("*big.Int")).Return(tt.args.amount)
 			utilsMock.On("CheckEthBalanceIsZero", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return()
 			utilsPkgMock.On("GetMinSafeRazor", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.minSafeRazor, tt.args.minSafeRazorErr)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.stakerId, tt.args.stakerIdErr)
+			flagSetUtilsMock.On("GetBoolForce", mock.AnythingOfType("*pflag.FlagSet")).Return(false, nil)
+			cmdUtilsMock.On("CheckStakeSafety", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("*big.Int"), false).Return(tt.args.stakeSafetyErr)
 			cmdUtilsMock.On("Approve", mock.Anything).Return(tt.args.approveTxn, tt.args.approveErr)
 			cmdUtilsMock.On("StakeCoins", mock.Anything).Return(tt.args.stakeTxn, tt.args.stakeErr)
 
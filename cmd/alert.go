@@ -0,0 +1,146 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"razor/core/types"
+	"razor/utils"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	rpcDownSince              time.Time
+	rpcDownAlerted            bool
+	lowBalanceAlerted         = make(map[string]bool)
+	missedRevealCheckedEpoch  uint32
+	disputedBlockCheckedEpoch uint32
+)
+
+//This function alerts once the provider is down for the alert minutes and once it recovers, the provider is down while the latest block can't be fetched
+func checkRpcDown(fetchErr error, alertMinutes uint32, now time.Time) {
+	if fetchErr == nil {
+		if rpcDownAlerted {
+			utils.Notify(types.Notification{
+				Event:  "rpcDown",
+				Status: fmt.Sprintf("recovered after %s", now.Sub(rpcDownSince).Round(time.Second)),
+			})
+		}
+		rpcDownSince = time.Time{}
+		rpcDownAlerted = false
+		return
+	}
+	if rpcDownSince.IsZero() {
+		rpcDownSince = now
+	}
+	if rpcDownAlerted || alertMinutes == 0 || now.Sub(rpcDownSince) < time.Duration(alertMinutes)*time.Minute {
+		return
+	}
+	rpcDownAlerted = true
+	utils.Notify(types.Notification{
+		Event:  "rpcDown",
+		Status: fmt.Sprintf("is down for %s: %s", now.Sub(rpcDownSince).Round(time.Second), fetchErr),
+	})
+}
+
+//This function alerts once the balance of the token of the account falls below the threshold, it alerts again only after the balance is topped up above it
+//The alert has the amount which the balance should be topped up by to reach the threshold
+func checkLowBalance(address string, epoch uint32, token string, balance *big.Float, threshold float32) {
+	if threshold <= 0 {
+		return
+	}
+	thresholdBalance := big.NewFloat(float64(threshold))
+	if balance.Cmp(thresholdBalance) >= 0 {
+		lowBalanceAlerted[token] = false
+		return
+	}
+	if lowBalanceAlerted[token] {
+		return
+	}
+	lowBalanceAlerted[token] = true
+	topUp := new(big.Float).Sub(thresholdBalance, balance)
+	utils.Notify(types.Notification{
+		Event:   "lowBalance",
+		Address: address,
+		Epoch:   epoch,
+		Status:  fmt.Sprintf("%s %s is below the threshold of %g %s, top up at least %s %s", balance.Text('f', 6), token, threshold, token, topUp.Text('f', 6), token),
+	})
+}
+
+//This function checks that the ETH balance of the account can pay for the commit and the reveal of the epoch at the current gas price
+//The node doesn't commit if it can't reveal, as a commit which isn't revealed is penalised, and the commit isn't blocked if the cost can't be estimated
+func checkRevealAffordable(client *ethclient.Client, config types.Configurations, address string, epoch uint32) error {
+	estimate, err := cmdUtils.EstimateEpochGas(client, config, address)
+	if err != nil {
+		log.Error("Error in estimating the cost of the commit and the reveal: ", err)
+		return nil
+	}
+	if estimate.Balance.Cmp(estimate.ExpectedCost) >= 0 {
+		return nil
+	}
+	topUp := new(big.Int).Sub(estimate.ExpectedCost, estimate.Balance)
+	status := fmt.Sprintf("%s ETH can't pay for the commit and the reveal of %s ETH, top up at least %s ETH", utils.GetAmountInDecimal(estimate.Balance).Text('f', 6), utils.GetAmountInDecimal(estimate.ExpectedCost).Text('f', 6), utils.GetAmountInDecimal(topUp).Text('f', 6))
+	utils.Notify(types.Notification{
+		Event:   "insufficientBalance",
+		Address: address,
+		Epoch:   epoch,
+		Status:  status,
+	})
+	return fmt.Errorf("commit of epoch %d is skipped, %s", epoch, status)
+}
+
+//This function alerts if the staker committed in the epoch but didn't reveal, it is checked once the reveal state is over
+func checkMissedReveal(client *ethclient.Client, address string, epoch uint32, stakerId uint32) {
+	if missedRevealCheckedEpoch >= epoch {
+		return
+	}
+	missedRevealCheckedEpoch = epoch
+	lastCommit, err := razorUtils.GetEpochLastCommitted(client, stakerId)
+	if err != nil {
+		log.Error("Error in getting last committed epoch: ", err)
+		return
+	}
+	lastReveal, err := razorUtils.GetEpochLastRevealed(client, stakerId)
+	if err != nil {
+		log.Error("Error in getting last revealed epoch: ", err)
+		return
+	}
+	if getLastActionEpoch("commit", lastCommit) == epoch && getLastActionEpoch("reveal", lastReveal) < epoch {
+		utils.Notify(types.Notification{
+			Event:   "missedReveal",
+			Address: address,
+			Epoch:   epoch,
+			Status:  "committed but not revealed, the stake is penalised for the missed reveal",
+		})
+	}
+}
+
+//This function alerts for every block proposed by the staker in the epoch which is invalidated by a dispute, it is checked once the dispute state is over
+func checkDisputedBlocks(client *ethclient.Client, address string, epoch uint32, stakerId uint32) {
+	if disputedBlockCheckedEpoch >= epoch {
+		return
+	}
+	disputedBlockCheckedEpoch = epoch
+	numberOfProposedBlocks, err := razorUtils.GetNumberOfProposedBlocks(client, epoch)
+	if err != nil {
+		log.Error("Error in getting number of proposed blocks: ", err)
+		return
+	}
+	for blockId := uint32(0); blockId < uint32(numberOfProposedBlocks); blockId++ {
+		proposedBlock, err := razorUtils.GetProposedBlock(client, epoch, blockId)
+		if err != nil {
+			log.Error("Error in getting proposed block: ", err)
+			return
+		}
+		if proposedBlock.ProposerId == stakerId && !proposedBlock.Valid {
+			utils.Notify(types.Notification{
+				Event:   "disputedBlock",
+				Address: address,
+				Epoch:   epoch,
+				Status:  fmt.Sprintf("block %d is invalidated by a dispute", blockId),
+			})
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCheckRpcDown(t *testing.T) {
+	defer func() {
+		rpcDownSince = time.Time{}
+		rpcDownAlerted = false
+	}()
+	start := time.Now()
+	fetchErr := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		fetchErr    error
+		after       time.Duration
+		wantAlerted bool
+	}{
+		{
+			name:        "Test 1: When the provider goes down",
+			fetchErr:    fetchErr,
+			after:       0,
+			wantAlerted: false,
+		},
+		{
+			name:        "Test 2: When the provider is down for less than the alert minutes",
+			fetchErr:    fetchErr,
+			after:       4 * time.Minute,
+			wantAlerted: false,
+		},
+		{
+			name:        "Test 3: When the provider is down for the alert minutes",
+			fetchErr:    fetchErr,
+			after:       5 * time.Minute,
+			wantAlerted: true,
+		},
+		{
+			name:        "Test 4: When the provider stays down after the alert",
+			fetchErr:    fetchErr,
+			after:       10 * time.Minute,
+			wantAlerted: true,
+		},
+		{
+			name:        "Test 5: When the provider recovers",
+			fetchErr:    nil,
+			after:       11 * time.Minute,
+			wantAlerted: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkRpcDown(tt.fetchErr, 5, start.Add(tt.after))
+			if rpcDownAlerted != tt.wantAlerted {
+				t.Errorf("rpcDownAlerted = %v, want %v", rpcDownAlerted, tt.wantAlerted)
+			}
+		})
+	}
+}
+
+func TestCheckLowBalance(t *testing.T) {
+	defer func() { lowBalanceAlerted = make(map[string]bool) }()
+
+	tests := []struct {
+		name        string
+		token       string
+		balance     *big.Float
+		threshold   float32
+		wantAlerted bool
+	}{
+		{
+			name:        "Test 1: When the alert is disabled",
+			token:       "ETH",
+			balance:     big.NewFloat(0.01),
+			threshold:   0,
+			wantAlerted: false,
+		},
+		{
+			name:        "Test 2: When the balance is above the threshold",
+			token:       "ETH",
+			balance:     big.NewFloat(1),
+			threshold:   0.5,
+			wantAlerted: false,
+		},
+		{
+			name:        "Test 3: When the balance falls below the threshold",
+			token:       "ETH",
+			balance:     big.NewFloat(0.4),
+			threshold:   0.5,
+			wantAlerted: true,
+		},
+		{
+			name:        "Test 4: When the balance is topped up",
+			token:       "ETH",
+			balance:     big.NewFloat(2),
+			threshold:   0.5,
+			wantAlerted: false,
+		},
+		{
+			name:        "Test 5: When the RZR balance falls below its threshold",
+			token:       "RZR",
+			balance:     big.NewFloat(50),
+			threshold:   100,
+			wantAlerted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkLowBalance("0x000000000000000000000000000000000000dea1", 10, tt.token, tt.balance, tt.threshold)
+			if lowBalanceAlerted[tt.token] != tt.wantAlerted {
+				t.Errorf("lowBalanceAlerted[%s] = %v, want %v", tt.token, lowBalanceAlerted[tt.token], tt.wantAlerted)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//transactionMutex serialises the handling of the blocks by the vote loop and the bounty claims so that their transactions don't use the same nonce
+var transactionMutex sync.Mutex
+
+//This function claims the eligible bounties stored in the dispute data file once every epoch until the context is done
+func (*UtilsStruct) AutoClaimBounties(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	var lastClaimEpoch uint32
+	for {
+		epoch, err := razorUtils.GetEpoch(client)
+		if err != nil {
+			log.Error("Error in getting epoch: ", err)
+		} else if epoch > lastClaimEpoch {
+			transactionMutex.Lock()
+			err = cmdUtils.ClaimEligibleBounties(client, config, account, epoch)
+			transactionMutex.Unlock()
+			if err != nil {
+				log.Error("Error in claiming bounties: ", err)
+			} else {
+				lastClaimEpoch = epoch
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(core.StateLength) * time.Second):
+		}
+	}
+}
+
+//This function claims the bounties in the dispute data file whose lock period is over in the epoch
+//The claimed bounties and the bounties which have no amount left are removed from the file, the others are kept to be claimed in a later epoch
+func (*UtilsStruct) ClaimEligibleBounties(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32) error {
+	disputeFilePath, err := razorUtils.GetDisputeDataFileName(account.Address)
+	if err != nil {
+		return err
+	}
+	disputeFileData, err := razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(disputeFileData.BountyIdQueue) == 0 {
+		return nil
+	}
+
+	_, bountyIdQueue := claimBounties(client, config, account, epoch, disputeFileData.BountyIdQueue)
+	return razorUtils.SaveDataToDisputeJsonFile(disputeFilePath, bountyIdQueue)
+}
+
+//This function claims the bounties whose lock period is over in the epoch and returns the result of every bounty
+//The bounty ids which are left to be claimed in a later epoch are also returned, which are the locked bounties and the bounties whose claim failed
+func claimBounties(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, bountyIds []uint32) ([]types.BountyClaim, []uint32) {
+	var (
+		bountyClaims  []types.BountyClaim
+		bountyIdQueue []uint32
+	)
+	callOpts := razorUtils.GetOptions()
+	for _, bountyId := range bountyIds {
+		bountyLock, err := stakeManagerUtils.GetBountyLock(client, &callOpts, bountyId)
+		if err != nil {
+			log.Errorf("Error in getting bounty lock of bountyId %d: %s", bountyId, err)
+			bountyClaims = append(bountyClaims, types.BountyClaim{BountyId: bountyId, Status: core.BountyClaimFailed})
+			bountyIdQueue = append(bountyIdQueue, bountyId)
+			continue
+		}
+		bountyClaim := types.BountyClaim{
+			BountyId:    bountyId,
+			Amount:      bountyLock.Amount,
+			RedeemAfter: bountyLock.RedeemAfter,
+		}
+		if bountyLock.Amount == nil || bountyLock.Amount.Cmp(big.NewInt(0)) == 0 {
+			log.Infof("BountyId %d has no amount left to be claimed, removing it from the queue", bountyId)
+			bountyClaim.Status = core.BountyClaimRedeemed
+			bountyClaims = append(bountyClaims, bountyClaim)
+			continue
+		}
+		if bountyLock.RedeemAfter > epoch {
+			log.Debugf("BountyId %d can be claimed after epoch %d", bountyId, bountyLock.RedeemAfter)
+			bountyClaim.Status = core.BountyClaimLocked
+			bountyClaims = append(bountyClaims, bountyClaim)
+			bountyIdQueue = append(bountyIdQueue, bountyId)
+			continue
+		}
+
+		log.Info("Claiming bounty for bountyId ", bountyId)
+		claimBountyTxn, err := cmdUtils.ClaimBounty(config, client, types.RedeemBountyInput{
+			BountyId: bountyId,
+			Address:  account.Address,
+			Password: account.Password,
+		})
+		if err != nil || claimBountyTxn == core.NilHash {
+			log.Errorf("Error in claiming bounty for bountyId %d: %v", bountyId, err)
+			bountyClaim.Status = core.BountyClaimFailed
+			bountyClaims = append(bountyClaims, bountyClaim)
+			bountyIdQueue = append(bountyIdQueue, bountyId)
+			continue
+		}
+		bountyClaim.TxnHash = claimBountyTxn.Hex()
+		claimBountyErr := utilsInterface.WaitForBlockCompletion(client, claimBountyTxn.Hex())
+		cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+			Action:  "claimBounty",
+			TxnHash: claimBountyTxn.Hex(),
+			Amount:  utils.GetAmountInDecimal(bountyLock.Amount).String(),
+			Status:  GetJournalTxnStatus(claimBountyErr),
+		})
+		if claimBountyErr != nil {
+			log.Errorf("Error in WaitForBlockCompletion for claimBounty of bountyId %d: %s", bountyId, claimBountyErr)
+			bountyClaim.Status = core.BountyClaimFailed
+			bountyIdQueue = append(bountyIdQueue, bountyId)
+		} else {
+			bountyClaim.Status = core.BountyClaimClaimed
+		}
+		bountyClaims = append(bountyClaims, bountyClaim)
+	}
+	return bountyClaims, bountyIdQueue
+}
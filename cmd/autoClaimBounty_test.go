@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"errors"
+	"io/fs"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClaimEligibleBounties(t *testing.T) {
+	var (
+		client   *ethclient.Client
+		config   types.Configurations
+		account  types.Account
+		callOpts bind.CallOpts
+	)
+	epoch := uint32(10)
+	bountyLocks := map[uint32]types.BountyLock{
+		1: {RedeemAfter: 8, Amount: big.NewInt(100)},
+		2: {RedeemAfter: 12, Amount: big.NewInt(100)},
+		3: {RedeemAfter: 10, Amount: big.NewInt(0)},
+		4: {RedeemAfter: 9, Amount: big.NewInt(100)},
+	}
+
+	type args struct {
+		disputeFilePathErr error
+		disputeData        types.DisputeFileData
+		disputeDataErr     error
+		bountyLockErr      error
+		claimBountyTxn     common.Hash
+		claimBountyErr     error
+		waitForBlockErr    error
+		saveDataErr        error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantSaved     bool
+		wantQueue     []uint32
+		wantClaimings int
+		wantErr       bool
+	}{
+		{
+			name: "Test 1: When the bounties whose lock period is over are claimed",
+			args: args{
+				disputeData:    types.DisputeFileData{BountyIdQueue: []uint32{4, 3, 2, 1}},
+				claimBountyTxn: common.BigToHash(big.NewInt(1)),
+			},
+			wantSaved:     true,
+			wantQueue:     []uint32{2},
+			wantClaimings: 2,
+			wantErr:       false,
+		},
+		{
+			name: "Test 2: When there is no dispute data file",
+			args: args{
+				disputeDataErr: fs.ErrNotExist,
+			},
+			wantSaved: false,
+			wantErr:   false,
+		},
+		{
+			name: "Test 3: When there are no bounty ids in the queue",
+			args: args{
+				disputeData: types.DisputeFileData{},
+			},
+			wantSaved: false,
+			wantErr:   false,
+		},
+		{
+			name: "Test 4: When there is an error in getting bounty lock",
+			args: args{
+				disputeData:   types.DisputeFileData{BountyIdQueue: []uint32{2, 1}},
+				bountyLockErr: errors.New("bounty lock error"),
+			},
+			wantSaved: true,
+			wantQueue: []uint32{2, 1},
+			wantErr:   false,
+		},
+		{
+			name: "Test 5: When there is an error in claiming bounty",
+			args: args{
+				disputeData:    types.DisputeFileData{BountyIdQueue: []uint32{2, 1}},
+				claimBountyTxn: core.NilHash,
+				claimBountyErr: errors.New("claim bounty error"),
+			},
+			wantSaved:     true,
+			wantQueue:     []uint32{2, 1},
+			wantClaimings: 1,
+			wantErr:       false,
+		},
+		{
+			name: "Test 6: When the claim bounty transaction fails",
+			args: args{
+				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1}},
+				claimBountyTxn:  common.BigToHash(big.NewInt(1)),
+				waitForBlockErr: errors.New("transaction mining unsuccessful"),
+			},
+			wantSaved:     true,
+			wantQueue:     []uint32{1},
+			wantClaimings: 1,
+			wantErr:       false,
+		},
+		{
+			name: "Test 7: When there is an error in getting dispute file path",
+			args: args{
+				disputeFilePathErr: errors.New("path error"),
+			},
+			wantSaved: false,
+			wantErr:   true,
+		},
+		{
+			name: "Test 8: When there is an error in reading dispute data",
+			args: args{
+				disputeDataErr: errors.New("read error"),
+			},
+			wantSaved: false,
+			wantErr:   true,
+		},
+		{
+			name: "Test 9: When there is an error in saving dispute data",
+			args: args{
+				disputeData:    types.DisputeFileData{BountyIdQueue: []uint32{1}},
+				claimBountyTxn: common.BigToHash(big.NewInt(1)),
+				saveDataErr:    errors.New("save error"),
+			},
+			wantSaved:     true,
+			wantQueue:     nil,
+			wantClaimings: 1,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			stakeManagerMock := new(mocks.StakeManagerInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utilsInterface = utilsPkgMock
+			stakeManagerUtils = stakeManagerMock
+
+			var (
+				saved      bool
+				savedQueue []uint32
+			)
+			utilsMock.On("GetDisputeDataFileName", mock.AnythingOfType("string")).Return("", tt.args.disputeFilePathErr)
+			utilsMock.On("ReadFromDisputeJsonFile", mock.Anything).Return(tt.args.disputeData, tt.args.disputeDataErr)
+			utilsMock.On("GetOptions").Return(callOpts)
+			for bountyId, bountyLock := range bountyLocks {
+				stakeManagerMock.On("GetBountyLock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*bind.CallOpts"), bountyId).Return(bountyLock, tt.args.bountyLockErr)
+			}
+			cmdUtilsMock.On("ClaimBounty", mock.Anything, mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.claimBountyTxn, tt.args.claimBountyErr)
+			utilsPkgMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.waitForBlockErr)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
+			utilsMock.On("SaveDataToDisputeJsonFile", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = true
+				savedQueue = args.Get(1).([]uint32)
+			}).Return(tt.args.saveDataErr)
+
+			ut := &UtilsStruct{}
+			err := ut.ClaimEligibleBounties(client, config, account, epoch)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ClaimEligibleBounties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if saved != tt.wantSaved {
+				t.Fatalf("Dispute data saved = %v, want %v", saved, tt.wantSaved)
+			}
+			if saved && !reflect.DeepEqual(savedQueue, tt.wantQueue) {
+				t.Errorf("Saved bounty id queue = %v, want %v", savedQueue, tt.wantQueue)
+			}
+			cmdUtilsMock.AssertNumberOfCalls(t, "ClaimBounty", tt.wantClaimings)
+		})
+	}
+}
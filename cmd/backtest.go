@@ -0,0 +1,195 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var backtestCmd = &cobra.Command{
+	Use:   "backtest",
+	Short: "backtest the aggregation settings of a collection against the values reported by the network",
+	Long: `backtest replays the job data stored locally while voting through the configured aggregation method and compares the results with the values reported by the network.
+It helps to tune the weights and the aggregation method of the jobs of a collection.
+
+Example:
+  ./razor backtest --collection 1 --days 7
+  ./razor backtest --collection 1 --days 7 --aggregation 2`,
+	Run: initialiseBacktest,
+}
+
+//This function initialises the ExecuteBacktest function
+func initialiseBacktest(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteBacktest(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the Backtest function
+func (*UtilsStruct) ExecuteBacktest(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+	razorUtils.AssignLogFile(flagSet)
+
+	encryptState, err := flagSetUtils.GetBoolEncryptState(flagSet)
+	utils.CheckError("Error in getting encryptState: ", err)
+	if encryptState {
+		password := razorUtils.AssignPassword()
+		err = utils.InitStateEncryption(password)
+		utils.CheckError("Error in initialising state encryption: ", err)
+	}
+
+	collectionId, err := flagSetUtils.GetUint16Collection(flagSet)
+	utils.CheckError("Error in getting collection id: ", err)
+
+	days, err := flagSetUtils.GetUint32Days(flagSet)
+	utils.CheckError("Error in getting days: ", err)
+
+	var aggregationMethod uint32
+	if razorUtils.IsFlagPassed("aggregation") {
+		aggregationMethod, err = flagSetUtils.GetUint32Aggregation(flagSet)
+		utils.CheckError("Error in getting aggregation method: ", err)
+	}
+
+	err = cmdUtils.Backtest(client, collectionId, days, aggregationMethod)
+	utils.CheckError("Backtest error: ", err)
+}
+
+//This function replays the stored job data of a collection and compares the aggregated values with the values reported by the network
+func (*UtilsStruct) Backtest(client *ethclient.Client, collectionId uint16, days uint32, aggregationMethod uint32) error {
+	if days == 0 {
+		return errors.New("days should be greater than 0")
+	}
+	collection, err := utils.UtilsInterface.GetCollection(client, collectionId)
+	if err != nil {
+		return err
+	}
+	if aggregationMethod == 0 {
+		aggregationMethod = collection.AggregationMethod
+	}
+
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return err
+	}
+	var fromEpoch uint32
+	epochsInRange := uint32(int64(days) * 24 * 60 * 60 / core.EpochLength)
+	if epoch > epochsInRange {
+		fromEpoch = epoch - epochsInRange
+	}
+
+	fileName, err := path.PathUtilsInterface.GetCollectionHistoryFileName(collectionId)
+	if err != nil {
+		return err
+	}
+	historyData, err := utils.UtilsInterface.ReadFromCollectionHistoryFile(fileName)
+	if err != nil {
+		return errors.New("no history data found for collection, history is stored while voting: " + err.Error())
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Epoch", "Local Value", "Network Value", "Deviation (%)"})
+
+	var (
+		comparedEpochs int
+		totalDeviation float64
+		maxDeviation   float64
+	)
+	for _, entry := range historyData.History {
+		// Values of the current epoch are not confirmed yet
+		if entry.Epoch < fromEpoch || entry.Epoch >= epoch {
+			continue
+		}
+		localValue, err := utils.PerformAggregation(entry.Values, entry.Weights, aggregationMethod)
+		if err != nil {
+			log.Errorf("Error in aggregating values of epoch %d: %s", entry.Epoch, err)
+			continue
+		}
+		block, err := utils.UtilsInterface.GetBlock(client, entry.Epoch)
+		if err != nil {
+			log.Errorf("Error in fetching block of epoch %d: %s", entry.Epoch, err)
+			continue
+		}
+		networkValue := getCollectionValueFromBlock(block.Ids, block.Medians, collectionId)
+		if networkValue == nil {
+			log.Debugf("Collection %d was not reported in epoch %d", collectionId, entry.Epoch)
+			continue
+		}
+		deviation := calculateDeviation(localValue, networkValue)
+		comparedEpochs++
+		totalDeviation += deviation
+		if deviation > maxDeviation {
+			maxDeviation = deviation
+		}
+		table.Append([]string{
+			strconv.Itoa(int(entry.Epoch)),
+			localValue.String(),
+			networkValue.String(),
+			fmt.Sprintf("%.4f", deviation),
+		})
+	}
+
+	if comparedEpochs == 0 {
+		return errors.New("no confirmed epochs found in the stored history of the collection")
+	}
+	table.Render()
+	fmt.Printf("Collection: %s, Aggregation method: %d, Epochs compared: %d\n", collection.Name, aggregationMethod, comparedEpochs)
+	fmt.Printf("Mean deviation: %.4f%%, Max deviation: %.4f%%\n", totalDeviation/float64(comparedEpochs), maxDeviation)
+	return nil
+}
+
+//This function returns the value of a collection from the ids and medians of a block
+func getCollectionValueFromBlock(ids []uint16, medians []*big.Int, collectionId uint16) *big.Int {
+	for i, id := range ids {
+		if id == collectionId && i < len(medians) {
+			return medians[i]
+		}
+	}
+	return nil
+}
+
+//This function returns the absolute deviation of the local value from the network value in percentage
+func calculateDeviation(localValue *big.Int, networkValue *big.Int) float64 {
+	if networkValue.Cmp(big.NewInt(0)) == 0 {
+		if localValue.Cmp(big.NewInt(0)) == 0 {
+			return 0
+		}
+		return 100
+	}
+	difference := big.NewInt(0).Sub(localValue, networkValue)
+	deviation := new(big.Float).Quo(new(big.Float).SetInt(difference.Abs(difference)), new(big.Float).SetInt(networkValue))
+	deviationPercent, _ := deviation.Mul(deviation, big.NewFloat(100)).Float64()
+	return deviationPercent
+}
+
+func init() {
+	rootCmd.AddCommand(backtestCmd)
+
+	var (
+		Collection  uint16
+		Days        uint32
+		Aggregation uint32
+		LogFile     string
+	)
+
+	backtestCmd.Flags().Uint16VarP(&Collection, "collection", "", 0, "collection id")
+	backtestCmd.Flags().Uint32VarP(&Days, "days", "", 7, "number of days to backtest")
+	backtestCmd.Flags().Uint32VarP(&Aggregation, "aggregation", "", 0, "aggregation method to backtest with (1 for median, 2 for mean)")
+	backtestCmd.Flags().StringVarP(&LogFile, "logFile", "", "", "name of log file")
+
+	collectionErr := backtestCmd.MarkFlagRequired("collection")
+	utils.CheckError("Collection error: ", collectionErr)
+}
@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+)
+
+func TestBacktest(t *testing.T) {
+	var client *ethclient.Client
+
+	collection := bindings.StructsCollection{
+		Id:                1,
+		Name:              "ethCollectionMean",
+		AggregationMethod: 2,
+	}
+	historyData := types.CollectionHistoryFileData{
+		CollectionId: 1,
+		History: []types.CollectionHistoryData{
+			{
+				Epoch:   98,
+				Values:  []*big.Int{big.NewInt(100), big.NewInt(200)},
+				Weights: []uint8{100, 100},
+			},
+			{
+				Epoch:   99,
+				Values:  []*big.Int{big.NewInt(100), big.NewInt(300)},
+				Weights: []uint8{100, 100},
+			},
+			{
+				Epoch:   100,
+				Values:  []*big.Int{big.NewInt(100), big.NewInt(300)},
+				Weights: []uint8{100, 100},
+			},
+		},
+	}
+	block := bindings.StructsBlock{
+		Ids:     []uint16{1, 2},
+		Medians: []*big.Int{big.NewInt(150), big.NewInt(5000)},
+	}
+
+	type args struct {
+		days              uint32
+		aggregationMethod uint32
+		collection        bindings.StructsCollection
+		collectionErr     error
+		epoch             uint32
+		epochErr          error
+		fileName          string
+		fileNameErr       error
+		historyData       types.CollectionHistoryFileData
+		historyDataErr    error
+		block             bindings.StructsBlock
+		blockErr          error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When Backtest executes successfully",
+			args: args{
+				days:        1,
+				collection:  collection,
+				epoch:       100,
+				fileName:    "1_collectionHistory.json",
+				historyData: historyData,
+				block:       block,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When Backtest executes successfully with aggregation method override",
+			args: args{
+				days:              1,
+				aggregationMethod: 1,
+				collection:        collection,
+				epoch:             100,
+				fileName:          "1_collectionHistory.json",
+				historyData:       historyData,
+				block:             block,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When days is 0",
+			args: args{
+				days: 0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting collection",
+			args: args{
+				days:          1,
+				collectionErr: errors.New("collection error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting epoch",
+			args: args{
+				days:       1,
+				collection: collection,
+				epochErr:   errors.New("epoch error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting history file name",
+			args: args{
+				days:        1,
+				collection:  collection,
+				epoch:       100,
+				fileNameErr: errors.New("fileName error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in reading history file",
+			args: args{
+				days:           1,
+				collection:     collection,
+				epoch:          100,
+				fileName:       "1_collectionHistory.json",
+				historyDataErr: errors.New("read error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting block",
+			args: args{
+				days:        1,
+				collection:  collection,
+				epoch:       100,
+				fileName:    "1_collectionHistory.json",
+				historyData: historyData,
+				blockErr:    errors.New("block error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When collection is not present in the blocks",
+			args: args{
+				days:        1,
+				collection:  collection,
+				epoch:       100,
+				fileName:    "1_collectionHistory.json",
+				historyData: historyData,
+				block: bindings.StructsBlock{
+					Ids:     []uint16{2},
+					Medians: []*big.Int{big.NewInt(5000)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When history entries are older than the given days",
+			args: args{
+				days:        1,
+				collection:  collection,
+				epoch:       1000,
+				fileName:    "1_collectionHistory.json",
+				historyData: historyData,
+				block:       block,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			utilsPkgMock.On("GetCollection", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint16")).Return(tt.args.collection, tt.args.collectionErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			pathUtilsMock.On("GetCollectionHistoryFileName", mock.AnythingOfType("uint16")).Return(tt.args.fileName, tt.args.fileNameErr)
+			utilsPkgMock.On("ReadFromCollectionHistoryFile", mock.AnythingOfType("string")).Return(tt.args.historyData, tt.args.historyDataErr)
+			utilsPkgMock.On("GetBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.block, tt.args.blockErr)
+
+			ut := &UtilsStruct{}
+			if err := ut.Backtest(client, 1, tt.args.days, tt.args.aggregationMethod); (err != nil) != tt.wantErr {
+				t.Errorf("Backtest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalculateDeviation(t *testing.T) {
+	type args struct {
+		localValue   *big.Int
+		networkValue *big.Int
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "Test 1: When local value is greater than network value",
+			args: args{
+				localValue:   big.NewInt(110),
+				networkValue: big.NewInt(100),
+			},
+			want: 10,
+		},
+		{
+			name: "Test 2: When local value is less than network value",
+			args: args{
+				localValue:   big.NewInt(75),
+				networkValue: big.NewInt(100),
+			},
+			want: 25,
+		},
+		{
+			name: "Test 3: When both values are equal",
+			args: args{
+				localValue:   big.NewInt(100),
+				networkValue: big.NewInt(100),
+			},
+			want: 0,
+		},
+		{
+			name: "Test 4: When network value is 0",
+			args: args{
+				localValue:   big.NewInt(100),
+				networkValue: big.NewInt(0),
+			},
+			want: 100,
+		},
+		{
+			name: "Test 5: When both values are 0",
+			args: args{
+				localValue:   big.NewInt(0),
+				networkValue: big.NewInt(0),
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateDeviation(tt.args.localValue, tt.args.networkValue); got != tt.want {
+				t.Errorf("calculateDeviation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/utils"
+	"runtime"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "bench runs the benchmarks of the voting hot paths against synthetic data",
+	Long: `bench runs the benchmarks of sorting the revealed values, calculating the medians and checking the proposed blocks for disputes against synthetic data of the passed sizes and prints a JSON report.
+It helps to size the hardware of a node for large networks. The benchmarks are run in parallel on all the CPUs and parallelism sets the number of goroutines per CPU.
+
+Example:
+  ./razor bench --collections 500 --stakers 1000 --blocks 5
+  ./razor bench --benchmarks medians,handleDispute --benchtime 5s --output bench.json`,
+	Run: initialiseBench,
+}
+
+//Benchmarks which are run by the bench command
+var benchmarkNames = []string{"sortRevealedValues", "medians", "influencedMedian", "handleDispute", "collectionIdPositionInBlock"}
+
+//Number of distinct values revealed by the stakers for a collection in the synthetic data
+const benchDistinctValues = 100
+
+//This function initialises the ExecuteBench function
+func initialiseBench(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteBench(cmd.Flags())
+}
+
+//This function sets the flags appropriately, executes the RunBenchmarks function and prints or writes the report
+func (*UtilsStruct) ExecuteBench(flagSet *pflag.FlagSet) {
+	collections, err := flagSetUtils.GetUint16Collections(flagSet)
+	utils.CheckError("Error in getting collections: ", err)
+
+	stakers, err := flagSetUtils.GetUint32Stakers(flagSet)
+	utils.CheckError("Error in getting stakers: ", err)
+
+	blocks, err := flagSetUtils.GetUint32Blocks(flagSet)
+	utils.CheckError("Error in getting blocks: ", err)
+
+	parallelism, err := flagSetUtils.GetInt32Parallelism(flagSet)
+	utils.CheckError("Error in getting parallelism: ", err)
+
+	benchTime, err := flagSetUtils.GetStringBenchTime(flagSet)
+	utils.CheckError("Error in getting benchtime: ", err)
+
+	benchmarks, err := flagSetUtils.GetStringSliceBenchmarks(flagSet)
+	utils.CheckError("Error in getting benchmarks: ", err)
+
+	output, err := flagSetUtils.GetStringOutput(flagSet)
+	utils.CheckError("Error in getting output: ", err)
+
+	err = setBenchTime(benchTime)
+	utils.CheckError("Benchtime error: ", err)
+
+	sizes := types.BenchSizes{
+		Collections: collections,
+		Stakers:     stakers,
+		Blocks:      blocks,
+	}
+	report, err := cmdUtils.RunBenchmarks(sizes, benchmarks, parallelism)
+	utils.CheckError("RunBenchmarks error: ", err)
+
+	reportData, err := json.MarshalIndent(report, "", "  ")
+	utils.CheckError("Error in marshalling report: ", err)
+
+	if output == "" {
+		fmt.Println(string(reportData))
+		return
+	}
+	err = os.WriteFile(output, reportData, 0600)
+	utils.CheckError("Error in writing report: ", err)
+	log.Info("Benchmark report written to ", output)
+}
+
+//This function sets the duration of every benchmark or the number of iterations if it is of the form Nx
+func setBenchTime(benchTime string) error {
+	testing.Init()
+	return flag.Set("test.benchtime", benchTime)
+}
+
+//This function runs the benchmarks against synthetic data of the sizes and returns the report
+//All the benchmarks are run if no names are passed
+func (*UtilsStruct) RunBenchmarks(sizes types.BenchSizes, names []string, parallelism int32) (types.BenchReport, error) {
+	if sizes.Collections == 0 || sizes.Stakers == 0 || sizes.Blocks == 0 {
+		return types.BenchReport{}, errors.New("collections, stakers and blocks should be greater than 0")
+	}
+	if parallelism <= 0 {
+		return types.BenchReport{}, errors.New("parallelism should be greater than 0")
+	}
+	if len(names) == 0 {
+		names = benchmarkNames
+	}
+	for _, name := range names {
+		if !utils.Contains(benchmarkNames, name) {
+			return types.BenchReport{}, fmt.Errorf("invalid benchmark %s, benchmarks available are %v", name, benchmarkNames)
+		}
+	}
+
+	benchmarks := getBenchmarks(sizes)
+	report := types.BenchReport{
+		GoVersion:   runtime.Version(),
+		OS:          runtime.GOOS,
+		Arch:        runtime.GOARCH,
+		NumCPU:      runtime.NumCPU(),
+		GoMaxProcs:  runtime.GOMAXPROCS(0),
+		Parallelism: parallelism,
+		Sizes:       sizes,
+	}
+	for _, name := range names {
+		log.Infof("Running %s benchmark...", name)
+		newOperation := benchmarks[name]
+		result := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetParallelism(int(parallelism))
+			b.RunParallel(func(pb *testing.PB) {
+				operation := newOperation()
+				for pb.Next() {
+					operation()
+				}
+			})
+		})
+		report.Results = append(report.Results, types.BenchResult{
+			Name:        name,
+			Iterations:  result.N,
+			NsPerOp:     result.NsPerOp(),
+			AllocsPerOp: result.AllocsPerOp(),
+			BytesPerOp:  result.AllocedBytesPerOp(),
+		})
+	}
+	return report, nil
+}
+
+//This function creates the synthetic data of the sizes and returns the benchmarks by their names
+//Every benchmark returns a new operation for every goroutine, so that the buffers which are changed aren't shared
+func getBenchmarks(sizes types.BenchSizes) map[string]func() func() {
+	revealedValues := getBenchRevealedValues(sizes.Collections, sizes.Stakers)
+	revealedDataMaps := sortRevealedValues(revealedValues)
+
+	var (
+		ids       []uint16
+		medians   []*big.Int
+		accWeight = new(big.Int)
+	)
+	for leafId := uint16(0); leafId < sizes.Collections; leafId++ {
+		influenceSum := new(big.Int).Set(revealedDataMaps.InfluenceSum[leafId])
+		ids = append(ids, leafId+1)
+		medians = append(medians, calculateMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.VoteWeights, influenceSum, accWeight))
+	}
+
+	//The median of the last collection in the last proposed block is wrong, so that the block is disputed
+	blocksMedians := make([][]*big.Int, sizes.Blocks)
+	for i := range blocksMedians {
+		blocksMedians[i] = append([]*big.Int{}, medians...)
+	}
+	lastBlockMedians := blocksMedians[len(blocksMedians)-1]
+	lastBlockMedians[len(lastBlockMedians)-1] = new(big.Int).Add(medians[len(medians)-1], big.NewInt(1))
+
+	return map[string]func() func(){
+		"sortRevealedValues": func() func() {
+			return func() {
+				sortRevealedValues(revealedValues)
+			}
+		},
+		"medians": func() func() {
+			accWeight := new(big.Int)
+			influenceSum := new(big.Int)
+			return func() {
+				for leafId := uint16(0); leafId < sizes.Collections; leafId++ {
+					influenceSum.Set(revealedDataMaps.InfluenceSum[leafId])
+					calculateMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.VoteWeights, influenceSum, accWeight)
+				}
+			}
+		},
+		"influencedMedian": func() func() {
+			ut := &UtilsStruct{}
+			return func() {
+				for leafId := uint16(0); leafId < sizes.Collections; leafId++ {
+					ut.InfluencedMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.InfluenceSum[leafId])
+				}
+			}
+		},
+		"handleDispute": func() func() {
+			return func() {
+				disputeComparisons = newDisputeComparisonCache()
+				defer func() { disputeComparisons = nil }()
+				for _, blockMedians := range blocksMedians {
+					if !collectionIdsMatch(ids, ids) {
+						continue
+					}
+					isEqual, mismatchIndex := utils.IsEqual(blockMedians, medians)
+					if !isEqual {
+						getCollectionIdPosition(ids, ids[mismatchIndex])
+					}
+				}
+			}
+		},
+		"collectionIdPositionInBlock": func() func() {
+			return func() {
+				getCollectionIdPosition(ids, ids[len(ids)-1])
+			}
+		},
+	}
+}
+
+//This function returns the synthetic revealed values, every staker reveals a value for every collection
+func getBenchRevealedValues(collections uint16, stakers uint32) []types.RevealedStruct {
+	revealedValues := make([]types.RevealedStruct, 0, stakers)
+	for stakerIndex := uint32(0); stakerIndex < stakers; stakerIndex++ {
+		values := make([]types.AssignedAsset, 0, collections)
+		for leafId := uint16(0); leafId < collections; leafId++ {
+			values = append(values, types.AssignedAsset{
+				LeafId: leafId,
+				Value:  big.NewInt(int64(leafId)*1e6 + int64(stakerIndex%benchDistinctValues)),
+			})
+		}
+		revealedValues = append(revealedValues, types.RevealedStruct{
+			RevealedValues: values,
+			Influence:      new(big.Int).Mul(big.NewInt(int64(stakerIndex)+1), big.NewInt(1e18)),
+		})
+	}
+	return revealedValues
+}
+
+func init() {
+	rootCmd.AddCommand(benchCmd)
+
+	var (
+		Collections uint16
+		Stakers     uint32
+		Blocks      uint32
+		Parallelism int32
+		BenchTime   string
+		Benchmarks  []string
+		Output      string
+	)
+
+	benchCmd.Flags().Uint16VarP(&Collections, "collections", "", 100, "number of active collections in the synthetic data")
+	benchCmd.Flags().Uint32VarP(&Stakers, "stakers", "", 100, "number of stakers revealing in the synthetic data")
+	benchCmd.Flags().Uint32VarP(&Blocks, "blocks", "", 5, "number of proposed blocks in the synthetic data")
+	benchCmd.Flags().Int32VarP(&Parallelism, "parallelism", "", 1, "number of goroutines per CPU running every benchmark")
+	benchCmd.Flags().StringVarP(&BenchTime, "benchtime", "", "1s", "duration of every benchmark or number of iterations if it is of the form Nx")
+	benchCmd.Flags().StringSliceVarP(&Benchmarks, "benchmarks", "", []string{}, "benchmarks to run (sortRevealedValues, medians, influencedMedian, handleDispute, collectionIdPositionInBlock), all are run by default")
+	benchCmd.Flags().StringVarP(&Output, "output", "", "", "file to write the JSON report to, it is printed if not passed")
+}
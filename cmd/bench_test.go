@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteBench(t *testing.T) {
+	var flagSet *pflag.FlagSet
+
+	type args struct {
+		collections      uint16
+		collectionsErr   error
+		stakers          uint32
+		stakersErr       error
+		blocks           uint32
+		blocksErr        error
+		parallelism      int32
+		parallelismErr   error
+		benchTime        string
+		benchTimeErr     error
+		benchmarks       []string
+		benchmarksErr    error
+		output           string
+		outputErr        error
+		report           types.BenchReport
+		runBenchmarksErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteBench executes successfully and the report is printed",
+			args: args{
+				collections: 10,
+				stakers:     10,
+				blocks:      2,
+				parallelism: 1,
+				benchTime:   "1x",
+				report:      types.BenchReport{Results: []types.BenchResult{{Name: "medians", Iterations: 1}}},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When ExecuteBench executes successfully and the report is written to the output file",
+			args: args{
+				collections: 10,
+				stakers:     10,
+				blocks:      2,
+				parallelism: 1,
+				benchTime:   "1x",
+				output:      filepath.Join(t.TempDir(), "bench.json"),
+				report:      types.BenchReport{Results: []types.BenchResult{{Name: "medians", Iterations: 1}}},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting collections",
+			args: args{
+				collectionsErr: errors.New("collections error"),
+				benchTime:      "1x",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When the benchtime is invalid",
+			args: args{
+				collections: 10,
+				stakers:     10,
+				blocks:      2,
+				parallelism: 1,
+				benchTime:   "invalid",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When there is an error in running benchmarks",
+			args: args{
+				collections:      10,
+				stakers:          10,
+				blocks:           2,
+				parallelism:      1,
+				benchTime:        "1x",
+				runBenchmarksErr: errors.New("runBenchmarks error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When the output file can't be written",
+			args: args{
+				collections: 10,
+				stakers:     10,
+				blocks:      2,
+				parallelism: 1,
+				benchTime:   "1x",
+				output:      filepath.Join(t.TempDir(), "missing", "bench.json"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	testing.Init()
+	benchTime := flag.Lookup("test.benchtime").Value.String()
+	defer flag.Set("test.benchtime", benchTime)
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			flagSetUtils = flagSetUtilsMock
+			cmdUtils = cmdUtilsMock
+
+			flagSetUtilsMock.On("GetUint16Collections", flagSet).Return(tt.args.collections, tt.args.collectionsErr)
+			flagSetUtilsMock.On("GetUint32Stakers", flagSet).Return(tt.args.stakers, tt.args.stakersErr)
+			flagSetUtilsMock.On("GetUint32Blocks", flagSet).Return(tt.args.blocks, tt.args.blocksErr)
+			flagSetUtilsMock.On("GetInt32Parallelism", flagSet).Return(tt.args.parallelism, tt.args.parallelismErr)
+			flagSetUtilsMock.On("GetStringBenchTime", flagSet).Return(tt.args.benchTime, tt.args.benchTimeErr)
+			flagSetUtilsMock.On("GetStringSliceBenchmarks", flagSet).Return(tt.args.benchmarks, tt.args.benchmarksErr)
+			flagSetUtilsMock.On("GetStringOutput", flagSet).Return(tt.args.output, tt.args.outputErr)
+			cmdUtilsMock.On("RunBenchmarks", mock.AnythingOfType("types.BenchSizes"), mock.Anything, mock.AnythingOfType("int32")).Return(tt.args.report, tt.args.runBenchmarksErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteBench(flagSet)
+
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteBench function didn't execute as expected")
+			}
+		})
+	}
+}
+
+func TestRunBenchmarks(t *testing.T) {
+	testing.Init()
+	benchTime := flag.Lookup("test.benchtime").Value.String()
+	defer flag.Set("test.benchtime", benchTime)
+	if err := setBenchTime("1x"); err != nil {
+		t.Fatalf("Error in setting benchtime: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		sizes       types.BenchSizes
+		names       []string
+		parallelism int32
+		wantNames   []string
+		wantErr     bool
+	}{
+		{
+			name:        "Test 1: When all the benchmarks are run",
+			sizes:       types.BenchSizes{Collections: 5, Stakers: 10, Blocks: 2},
+			names:       nil,
+			parallelism: 1,
+			wantNames:   benchmarkNames,
+			wantErr:     false,
+		},
+		{
+			name:        "Test 2: When the benchmarks to run are passed",
+			sizes:       types.BenchSizes{Collections: 5, Stakers: 10, Blocks: 2},
+			names:       []string{"medians", "handleDispute"},
+			parallelism: 2,
+			wantNames:   []string{"medians", "handleDispute"},
+			wantErr:     false,
+		},
+		{
+			name:        "Test 3: When a benchmark is invalid",
+			sizes:       types.BenchSizes{Collections: 5, Stakers: 10, Blocks: 2},
+			names:       []string{"invalid"},
+			parallelism: 1,
+			wantErr:     true,
+		},
+		{
+			name:        "Test 4: When a size is 0",
+			sizes:       types.BenchSizes{Collections: 0, Stakers: 10, Blocks: 2},
+			parallelism: 1,
+			wantErr:     true,
+		},
+		{
+			name:        "Test 5: When parallelism is 0",
+			sizes:       types.BenchSizes{Collections: 5, Stakers: 10, Blocks: 2},
+			parallelism: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := &UtilsStruct{}
+			report, err := ut.RunBenchmarks(tt.sizes, tt.names, tt.parallelism)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunBenchmarks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if report.Sizes != tt.sizes || report.Parallelism != tt.parallelism {
+				t.Errorf("RunBenchmarks() report sizes = %+v, parallelism = %d, want %+v, %d", report.Sizes, report.Parallelism, tt.sizes, tt.parallelism)
+			}
+			if len(report.Results) != len(tt.wantNames) {
+				t.Fatalf("RunBenchmarks() got %d results, want %d", len(report.Results), len(tt.wantNames))
+			}
+			for i, result := range report.Results {
+				if result.Name != tt.wantNames[i] || result.Iterations == 0 {
+					t.Errorf("RunBenchmarks() result = %+v, want benchmark %s to be run", result, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
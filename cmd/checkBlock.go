@@ -0,0 +1,244 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"strconv"
+
+	types2 "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var checkBlockCmd = &cobra.Command{
+	Use:   "checkBlock",
+	Short: "check the proposed blocks of an epoch for the disputes the node would raise",
+	Long: `Recomputes the medians of an epoch from the reveals on chain, fetches the blocks proposed in the epoch and prints the dispute the node would raise on every block along with the transactions it would send for it.
+Nothing is sent to the chain and no account is needed, so it can be used to find out why the node did or didn't dispute a block and to watch the network read-only.
+The blocks of the current epoch are checked by default. The active collections are read at the latest block, so the checks of an old epoch can differ if the collections changed since.
+
+The dispute is one of none, biggestStakeProposed, orderOfIds, collectionIdShouldBePresent, collectionIdShouldBeAbsent, median or alreadyDisputed.
+
+Example:
+  ./razor checkBlock --epoch 1200`,
+	Run: initialiseCheckBlock,
+}
+
+//This function initialises the ExecuteCheckBlock function
+func initialiseCheckBlock(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteCheckBlock(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the CheckProposedBlocks function
+func (*UtilsStruct) ExecuteCheckBlock(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	epoch, err := flagSetUtils.GetUint32Epoch(flagSet)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	utils.CheckError("Error in getting block: ", err)
+
+	if epoch == 0 {
+		epoch, err = razorUtils.GetEpoch(client)
+		utils.CheckError("Error in getting epoch: ", err)
+	}
+
+	blockNumber, err := getLastBlockOfEpoch(client, epoch, latestHeader)
+	utils.CheckError("Error in getting block of epoch: ", err)
+
+	blockCheck, err := cmdUtils.CheckProposedBlocks(client, blockNumber, epoch)
+	utils.CheckError("Error in checking proposed blocks: ", err)
+
+	if utils.IsJsonOutput() {
+		utils.CheckError("Error in printing proposed blocks: ", utils.PrintJson(blockCheck))
+		return
+	}
+	printEpochBlockCheck(blockCheck)
+}
+
+//This function recomputes the medians of the epoch from the reveals up to the block and returns the dispute the node would raise on every block proposed in the epoch
+//The blocks are checked as HandleDispute does, a block which can't be fetched is returned with its error
+func (*UtilsStruct) CheckProposedBlocks(client *ethclient.Client, blockNumber *big.Int, epoch uint32) (types.EpochBlockCheck, error) {
+	revealedData, err := cmdUtils.IndexRevealEventsOfCurrentEpoch(client, blockNumber, epoch)
+	if err != nil {
+		return types.EpochBlockCheck{}, err
+	}
+	reveals := make([]types.SimulatedReveal, len(revealedData))
+	for i, reveal := range revealedData {
+		reveals[i] = types.SimulatedReveal{
+			StakerId:  reveal.StakerId,
+			Influence: reveal.Influence,
+			Values:    reveal.RevealedValues,
+		}
+	}
+
+	activeCollections, err := razorUtils.GetActiveCollections(client)
+	if err != nil {
+		return types.EpochBlockCheck{}, err
+	}
+
+	biggestStake, biggestStakerId, err := getBiggestStakeOfEpoch(client, epoch)
+	if err != nil {
+		return types.EpochBlockCheck{}, err
+	}
+
+	sortedProposedBlockIds, err := razorUtils.GetSortedProposedBlockIds(client, epoch)
+	if err != nil {
+		return types.EpochBlockCheck{}, err
+	}
+
+	medians, revealedCollectionIds, _ := simulateLocalMedians(activeCollections, reveals)
+	epochBlockCheck := types.EpochBlockCheck{
+		Epoch:                 epoch,
+		BiggestStake:          biggestStake,
+		BiggestStakerId:       biggestStakerId,
+		RevealedCollectionIds: revealedCollectionIds,
+		Medians:               medians,
+		Blocks:                make([]types.BlockCheck, 0, len(sortedProposedBlockIds)),
+	}
+	for blockIndex, blockId := range sortedProposedBlockIds {
+		blockCheck := types.BlockCheck{
+			BlockId:    blockId,
+			BlockIndex: uint8(blockIndex),
+		}
+		proposedBlock, err := razorUtils.GetProposedBlock(client, epoch, blockId)
+		if err != nil {
+			blockCheck.Error = err.Error()
+			epochBlockCheck.Blocks = append(epochBlockCheck.Blocks, blockCheck)
+			continue
+		}
+		blockCheck.ProposerId = proposedBlock.ProposerId
+		blockCheck.Valid = proposedBlock.Valid
+		blockCheck.BiggestStake = proposedBlock.BiggestStake
+		blockCheck.Ids = proposedBlock.Ids
+		blockCheck.Medians = proposedBlock.Medians
+
+		simulation, err := SimulateDispute(types.DisputeSimulationInput{
+			Epoch:      epoch,
+			BlockIndex: uint8(blockIndex),
+			Block: types.SimulatedBlock{
+				Ids:          proposedBlock.Ids,
+				Medians:      proposedBlock.Medians,
+				BiggestStake: proposedBlock.BiggestStake,
+				Valid:        proposedBlock.Valid,
+			},
+			BiggestStake:      biggestStake,
+			BiggestStakerId:   biggestStakerId,
+			ActiveCollections: activeCollections,
+			Reveals:           reveals,
+		})
+		if err != nil {
+			blockCheck.Error = err.Error()
+		} else {
+			blockCheck.Dispute = simulation.Dispute
+			blockCheck.Transactions = simulation.Transactions
+		}
+		epochBlockCheck.Blocks = append(epochBlockCheck.Blocks, blockCheck)
+	}
+	return epochBlockCheck, nil
+}
+
+//This function returns the biggest stake of the epoch and the id of its staker from the stake snapshots of the epoch
+//Unlike GetBiggestStakeAndId it isn't bound to the remaining time of the state, as nothing is sent with it
+func getBiggestStakeOfEpoch(client *ethclient.Client, epoch uint32) (*big.Int, uint32, error) {
+	numberOfStakers, err := razorUtils.GetNumberOfStakers(client)
+	if err != nil {
+		return nil, 0, err
+	}
+	if numberOfStakers == 0 {
+		return nil, 0, errors.New("numberOfStakers is 0")
+	}
+	var biggestStakerId uint32
+	biggestStake := big.NewInt(0)
+	for stakerId := uint32(1); stakerId <= numberOfStakers; stakerId++ {
+		stake, err := razorUtils.GetStakeSnapshot(client, stakerId, epoch)
+		if err != nil {
+			return nil, 0, err
+		}
+		if stake.Cmp(biggestStake) > 0 {
+			biggestStake = stake
+			biggestStakerId = stakerId
+		}
+	}
+	return biggestStake, biggestStakerId, nil
+}
+
+//This function returns the last block of the epoch, which is the latest block for the current epoch
+//The last block of a past epoch is searched by the timestamps of the blocks
+func getLastBlockOfEpoch(client *ethclient.Client, epoch uint32, latestHeader *types2.Header) (*big.Int, error) {
+	latestEpoch := uint32(latestHeader.Time / uint64(core.EpochLength))
+	if epoch > latestEpoch {
+		return nil, fmt.Errorf("epoch %d hasn't started, the current epoch is %d", epoch, latestEpoch)
+	}
+	if epoch == latestEpoch {
+		return latestHeader.Number, nil
+	}
+	endTime := uint64(epoch+1) * uint64(core.EpochLength)
+	low, high := uint64(0), latestHeader.Number.Uint64()
+	for low < high {
+		middle := low + (high-low+1)/2
+		header, err := utils.ClientInterface.HeaderByNumber(client, context.Background(), new(big.Int).SetUint64(middle))
+		if err != nil {
+			return nil, err
+		}
+		if header.Time < endTime {
+			low = middle
+		} else {
+			high = middle - 1
+		}
+	}
+	return new(big.Int).SetUint64(low), nil
+}
+
+//This function prints the local data of the epoch and the dispute of every proposed block in a table
+func printEpochBlockCheck(blockCheck types.EpochBlockCheck) {
+	log.Infof("Epoch: %d Biggest Stake: %s Biggest Staker Id: %d", blockCheck.Epoch, blockCheck.BiggestStake, blockCheck.BiggestStakerId)
+	log.Infof("Locally revealed collection ids: %v", blockCheck.RevealedCollectionIds)
+	log.Infof("Local medians: %v", blockCheck.Medians)
+	if len(blockCheck.Blocks) == 0 {
+		log.Infof("No blocks are proposed in epoch %d", blockCheck.Epoch)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Block Id", "Index", "Proposer Id", "Valid", "Biggest Stake", "Ids", "Medians", "Dispute"})
+	for _, block := range blockCheck.Blocks {
+		dispute := block.Dispute
+		if block.Error != "" {
+			dispute = "error: " + block.Error
+		}
+		table.Append([]string{
+			strconv.Itoa(int(block.BlockId)),
+			strconv.Itoa(int(block.BlockIndex)),
+			strconv.Itoa(int(block.ProposerId)),
+			strconv.FormatBool(block.Valid),
+			fmt.Sprint(block.BiggestStake),
+			fmt.Sprint(block.Ids),
+			fmt.Sprint(block.Medians),
+			dispute,
+		})
+	}
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(checkBlockCmd)
+
+	var Epoch uint32
+
+	checkBlockCmd.Flags().Uint32VarP(&Epoch, "epoch", "", 0, "epoch whose proposed blocks are checked, the current epoch is checked by default")
+}
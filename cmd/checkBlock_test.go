@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckProposedBlocks(t *testing.T) {
+	var client *ethclient.Client
+	blockNumber := big.NewInt(100)
+
+	revealedData := []types.RevealedStruct{
+		{
+			StakerId:  1,
+			Influence: big.NewInt(100),
+			RevealedValues: []types.AssignedAsset{
+				{LeafId: 0, Value: big.NewInt(100)},
+				{LeafId: 1, Value: big.NewInt(200)},
+			},
+		},
+		{
+			StakerId:  2,
+			Influence: big.NewInt(50),
+			RevealedValues: []types.AssignedAsset{
+				{LeafId: 0, Value: big.NewInt(100)},
+				{LeafId: 1, Value: big.NewInt(200)},
+			},
+		},
+	}
+	proposedBlocks := map[uint32]bindings.StructsBlock{
+		4: {ProposerId: 1, Valid: true, Ids: []uint16{1, 2}, Medians: []*big.Int{big.NewInt(100), big.NewInt(200)}, BiggestStake: big.NewInt(100)},
+		6: {ProposerId: 2, Valid: true, Ids: []uint16{1, 2}, Medians: []*big.Int{big.NewInt(100), big.NewInt(250)}, BiggestStake: big.NewInt(100)},
+		9: {ProposerId: 2, Valid: true, Ids: []uint16{1, 2}, Medians: []*big.Int{big.NewInt(100), big.NewInt(200)}, BiggestStake: big.NewInt(50)},
+	}
+
+	type args struct {
+		revealedData           []types.RevealedStruct
+		revealedDataErr        error
+		numberOfStakers        uint32
+		numberOfStakersErr     error
+		sortedProposedBlockIds []uint32
+		sortedProposedBlockErr error
+		proposedBlockErr       error
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantDisputes []string
+		wantErrors   []string
+		wantErr      bool
+	}{
+		{
+			name: "Test 1: When the proposed blocks are checked",
+			args: args{
+				revealedData:           revealedData,
+				numberOfStakers:        2,
+				sortedProposedBlockIds: []uint32{4, 6, 9},
+			},
+			wantDisputes: []string{"none", "median", "biggestStakeProposed"},
+			wantErrors:   []string{"", "", ""},
+			wantErr:      false,
+		},
+		{
+			name: "Test 2: When no block is proposed",
+			args: args{
+				revealedData:    revealedData,
+				numberOfStakers: 2,
+			},
+			wantDisputes: []string{},
+			wantErrors:   []string{},
+			wantErr:      false,
+		},
+		{
+			name: "Test 3: When there is an error in getting the proposed blocks",
+			args: args{
+				revealedData:           revealedData,
+				numberOfStakers:        2,
+				sortedProposedBlockIds: []uint32{4},
+				proposedBlockErr:       errors.New("proposedBlock error"),
+			},
+			wantDisputes: []string{""},
+			wantErrors:   []string{"proposedBlock error"},
+			wantErr:      false,
+		},
+		{
+			name: "Test 4: When there is an error in indexing the reveals",
+			args: args{
+				revealedDataErr: errors.New("reveal events error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the number of stakers",
+			args: args{
+				revealedData:       revealedData,
+				numberOfStakersErr: errors.New("numberOfStakers error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting the sorted proposed block ids",
+			args: args{
+				revealedData:           revealedData,
+				numberOfStakers:        2,
+				sortedProposedBlockErr: errors.New("sortedProposedBlockIds error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsMock := new(mocks.UtilsInterface)
+
+			cmdUtils = cmdUtilsMock
+			razorUtils = utilsMock
+
+			cmdUtilsMock.On("IndexRevealEventsOfCurrentEpoch", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(tt.args.revealedData, tt.args.revealedDataErr)
+			utilsMock.On("GetActiveCollections", mock.AnythingOfType("*ethclient.Client")).Return([]uint16{1, 2}, nil)
+			utilsMock.On("GetNumberOfStakers", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.numberOfStakers, tt.args.numberOfStakersErr)
+			utilsMock.On("GetStakeSnapshot", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(func(client *ethclient.Client, stakerId uint32, epoch uint32) *big.Int {
+				return big.NewInt(150 - 50*int64(stakerId))
+			}, nil)
+			utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.sortedProposedBlockIds, tt.args.sortedProposedBlockErr)
+			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(func(client *ethclient.Client, epoch uint32, blockId uint32) bindings.StructsBlock {
+				return proposedBlocks[blockId]
+			}, tt.args.proposedBlockErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.CheckProposedBlocks(client, blockNumber, 5)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckProposedBlocks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.BiggestStake.Cmp(big.NewInt(100)) != 0 || got.BiggestStakerId != 1 {
+				t.Errorf("CheckProposedBlocks() biggest stake = %v of %d, want 100 of 1", got.BiggestStake, got.BiggestStakerId)
+			}
+			if !reflect.DeepEqual(got.RevealedCollectionIds, []uint16{1, 2}) {
+				t.Errorf("CheckProposedBlocks() revealed collection ids = %v, want [1 2]", got.RevealedCollectionIds)
+			}
+			gotDisputes := []string{}
+			gotErrors := []string{}
+			for _, block := range got.Blocks {
+				gotDisputes = append(gotDisputes, block.Dispute)
+				gotErrors = append(gotErrors, block.Error)
+			}
+			if !reflect.DeepEqual(gotDisputes, tt.wantDisputes) {
+				t.Errorf("CheckProposedBlocks() disputes = %v, want %v", gotDisputes, tt.wantDisputes)
+			}
+			if !reflect.DeepEqual(gotErrors, tt.wantErrors) {
+				t.Errorf("CheckProposedBlocks() errors = %v, want %v", gotErrors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestGetLastBlockOfEpoch(t *testing.T) {
+	var client *ethclient.Client
+	epochLength := uint64(core.EpochLength)
+	//A block is mined every 100 seconds from the start of epoch 10
+	blockTime := func(number uint64) uint64 {
+		return 10*epochLength + number*100
+	}
+	latestHeader := &Types.Header{Number: big.NewInt(50), Time: blockTime(50)}
+
+	tests := []struct {
+		name    string
+		epoch   uint32
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When the epoch is the current epoch",
+			epoch:   uint32(blockTime(50) / epochLength),
+			want:    big.NewInt(50),
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When the epoch is a past epoch",
+			epoch:   10,
+			want:    new(big.Int).SetUint64((epochLength - 1) / 100),
+			wantErr: false,
+		},
+		{
+			name:    "Test 3: When the epoch hasn't started",
+			epoch:   uint32(blockTime(50)/epochLength) + 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientUtilsMock := new(mocks2.ClientUtils)
+			utils.ClientInterface = clientUtilsMock
+
+			clientUtilsMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("*big.Int")).Return(func(client *ethclient.Client, ctx context.Context, number *big.Int) *Types.Header {
+				return &Types.Header{Number: number, Time: blockTime(number.Uint64())}
+			}, nil)
+
+			got, err := getLastBlockOfEpoch(client, tt.epoch, latestHeader)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getLastBlockOfEpoch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.Cmp(tt.want) != 0 {
+				t.Errorf("getLastBlockOfEpoch() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
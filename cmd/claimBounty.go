@@ -3,8 +3,10 @@ package cmd
 
 import (
 	"errors"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"math/big"
@@ -12,9 +14,11 @@ import (
 	"razor/core"
 	"razor/core/types"
 	"razor/logger"
-	"razor/path"
 	"razor/pkg/bindings"
 	"razor/utils"
+	"strconv"
+	"strings"
+	"time"
 )
 
 var claimBountyCmd = &cobra.Command{
@@ -23,7 +27,8 @@ var claimBountyCmd = &cobra.Command{
 	Long: `ClaimBounty allows the users who are bountyHunter to redeem their bounty in razor network
 
 Example:
-  ./razor claimBounty --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --bountyId 2 --logFile claimBounty`,
+  ./razor claimBounty --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --bountyId 2 --logFile claimBounty
+  ./razor claimBounty --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --all --eventsDays 7`,
 	Run: initialiseClaimBounty,
 }
 
@@ -47,7 +52,26 @@ func (*UtilsStruct) ExecuteClaimBounty(flagSet *pflag.FlagSet) {
 
 	password := razorUtils.AssignPassword()
 
-	if utilsInterface.IsFlagPassed("bountyId") {
+	encryptState, err := flagSetUtils.GetBoolEncryptState(flagSet)
+	utils.CheckError("Error in getting encryptState: ", err)
+	if encryptState {
+		err = utils.InitStateEncryption(password)
+		utils.CheckError("Error in initialising state encryption: ", err)
+	}
+
+	all, err := flagSetUtils.GetBoolAll(flagSet)
+	utils.CheckError("Error in getting all: ", err)
+	if all {
+		eventsDays, err := flagSetUtils.GetUint32EventsDays(flagSet)
+		utils.CheckError("Error in getting eventsDays: ", err)
+
+		bountyClaims, err := cmdUtils.ClaimAllBounties(client, config, types.Account{
+			Address:  address,
+			Password: password,
+		}, eventsDays)
+		utils.CheckError("ClaimAllBounties error: ", err)
+		printBountyClaims(address, bountyClaims)
+	} else if utilsInterface.IsFlagPassed("bountyId") {
 		bountyId, err := flagSetUtils.GetUint32BountyId(flagSet)
 		utils.CheckError("Error in getting bountyId: ", err)
 
@@ -74,17 +98,115 @@ func (*UtilsStruct) ExecuteClaimBounty(flagSet *pflag.FlagSet) {
 
 }
 
+//This function claims every bounty of the dispute data file whose lock period is over and returns the result of every bounty
+//If eventsDays is not 0, the bounties earned by the address in the Slashed events of the last eventsDays days are claimed too
+//The bounties which are left to be claimed in a later epoch are stored in the dispute data file
+func (*UtilsStruct) ClaimAllBounties(client *ethclient.Client, config types.Configurations, account types.Account, eventsDays uint32) ([]types.BountyClaim, error) {
+	disputeFilePath, err := razorUtils.GetDisputeDataFileName(account.Address)
+	if err != nil {
+		return nil, err
+	}
+	var bountyIds []uint32
+	disputeFileData, err := razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	bountyIds = append(bountyIds, disputeFileData.BountyIdQueue...)
+	if eventsDays > 0 {
+		bountyIdsFromEvents, err := getBountyIdsFromEvents(client, account.Address, eventsDays)
+		if err != nil {
+			return nil, err
+		}
+		for _, bountyId := range bountyIdsFromEvents {
+			if !utils.Contains(bountyIds, bountyId) {
+				bountyIds = append(bountyIds, bountyId)
+			}
+		}
+	}
+	if len(bountyIds) == 0 {
+		return nil, nil
+	}
+
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return nil, err
+	}
+	bountyClaims, bountyIdQueue := claimBounties(client, config, account, epoch, bountyIds)
+	return bountyClaims, razorUtils.SaveDataToDisputeJsonFile(disputeFilePath, bountyIdQueue)
+}
+
+//This function returns the ids of the bounties earned by the bounty hunter in the Slashed events of the last days
+func getBountyIdsFromEvents(client *ethclient.Client, bountyHunter string, days uint32) ([]uint32, error) {
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return nil, err
+	}
+	blocksInRange := int64(time.Duration(days) * 24 * time.Hour / utils.UtilsInterface.GetAverageBlockTime(client))
+	fromBlock := big.NewInt(0)
+	if latestHeader.Number.Int64() > blocksInRange {
+		fromBlock = big.NewInt(latestHeader.Number.Int64() - blocksInRange)
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   latestHeader.Number,
+		Addresses: []common.Address{
+			common.HexToAddress(core.StakeManagerAddress),
+		},
+	}
+	contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(bindings.StakeManagerABI))
+	if err != nil {
+		return nil, err
+	}
+	var bountyIds []uint32
+	bountyHunterInHash := common.HexToHash(bountyHunter)
+	logIterator := utils.NewLogIterator(client, query)
+	for logIterator.Next() {
+		vLog := logIterator.Log()
+		// topics[1] gives bounty hunter address in data type common.Hash
+		if len(vLog.Topics) < 2 || vLog.Topics[1] != bountyHunterInHash {
+			continue
+		}
+		data, unpackErr := abiUtils.Unpack(contractAbi, "Slashed", vLog.Data)
+		if unpackErr != nil || len(data) == 0 {
+			continue
+		}
+		if bountyId, ok := data[0].(uint32); ok {
+			bountyIds = append(bountyIds, bountyId)
+		}
+	}
+	if err := logIterator.Error(); err != nil {
+		return nil, err
+	}
+	return bountyIds, nil
+}
+
+//This function prints the result of every bounty in a table
+func printBountyClaims(address string, bountyClaims []types.BountyClaim) {
+	if len(bountyClaims) == 0 {
+		log.Infof("No bounties found for %s", address)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Bounty Id", "Amount (RZR)", "Redeem After", "Status", "Txn Hash"})
+	for _, bountyClaim := range bountyClaims {
+		amount := "-"
+		if bountyClaim.Amount != nil {
+			amount = utils.GetAmountInDecimal(bountyClaim.Amount).String()
+		}
+		table.Append([]string{strconv.Itoa(int(bountyClaim.BountyId)), amount, strconv.Itoa(int(bountyClaim.RedeemAfter)), bountyClaim.Status, bountyClaim.TxnHash})
+	}
+	table.Render()
+}
+
 //This function handles claimBounty by picking bountyid's from disputeData file and if there is any error it returns the error
 func (*UtilsStruct) HandleClaimBounty(client *ethclient.Client, config types.Configurations, account types.Account) error {
 	disputeFilePath, err := razorUtils.GetDisputeDataFileName(account.Address)
 	if err != nil {
 		return err
 	}
-	if _, err := path.OSUtilsInterface.Stat(disputeFilePath); !errors.Is(err, os.ErrNotExist) {
-		disputeData, err = razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
-		if err != nil {
-			return err
-		}
+	disputeData, err = razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
 	}
 
 	if disputeData.BountyIdQueue == nil {
@@ -182,12 +304,16 @@ func (*UtilsStruct) ClaimBounty(config types.Configurations, client *ethclient.C
 func init() {
 	rootCmd.AddCommand(claimBountyCmd)
 	var (
-		Address  string
-		BountyId uint32
+		Address    string
+		BountyId   uint32
+		All        bool
+		EventsDays uint32
 	)
 
 	claimBountyCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
 	claimBountyCmd.Flags().Uint32VarP(&BountyId, "bountyId", "", 0, "bountyId of the bounty hunter")
+	claimBountyCmd.Flags().BoolVarP(&All, "all", "", false, "claim all the bounties of the dispute data file whose lock period is over")
+	claimBountyCmd.Flags().Uint32VarP(&EventsDays, "eventsDays", "", 0, "number of days of Slashed events to look back for bounties with --all, the events aren't queried if 0")
 
 	addrErr := claimBountyCmd.MarkFlagRequired("address")
 	utils.CheckError("Address error: ", addrErr)
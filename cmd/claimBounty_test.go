@@ -5,6 +5,7 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	Types "github.com/ethereum/go-ethereum/core/types"
@@ -16,11 +17,11 @@ import (
 	"razor/cmd/mocks"
 	"razor/core"
 	"razor/core/types"
-	"razor/path"
-	pathMocks "razor/path/mocks"
 	"razor/utils"
 	mocks2 "razor/utils/mocks"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestExecuteClaimBounty(t *testing.T) {
@@ -39,6 +40,11 @@ func TestExecuteClaimBounty(t *testing.T) {
 		claimBountyTxn       common.Hash
 		claimBountyErr       error
 		handleClaimBountyErr error
+		all                  bool
+		allErr               error
+		eventsDaysErr        error
+		bountyClaims         []types.BountyClaim
+		claimAllBountiesErr  error
 	}
 	tests := []struct {
 		name          string
@@ -115,6 +121,52 @@ func TestExecuteClaimBounty(t *testing.T) {
 			},
 			expectedFatal: true,
 		},
+		{
+			name: "Test 7: When all the claimable bounties are claimed",
+			args: args{
+				config:   types.Configurations{},
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dead",
+				all:      true,
+				bountyClaims: []types.BountyClaim{
+					{BountyId: 1, Amount: big.NewInt(1e18), RedeemAfter: 5, Status: core.BountyClaimClaimed, TxnHash: common.BigToHash(big.NewInt(1)).Hex()},
+					{BountyId: 2, Amount: big.NewInt(1e18), RedeemAfter: 50, Status: core.BountyClaimLocked},
+				},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 8: When there is an error in getting all",
+			args: args{
+				config:   types.Configurations{},
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dead",
+				allErr:   errors.New("all error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 9: When there is an error in getting eventsDays",
+			args: args{
+				config:        types.Configurations{},
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dead",
+				all:           true,
+				eventsDaysErr: errors.New("eventsDays error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 10: When there is an error from ClaimAllBounties function",
+			args: args{
+				config:              types.Configurations{},
+				password:            "test",
+				address:             "0x000000000000000000000000000000000000dead",
+				all:                 true,
+				claimAllBountiesErr: errors.New("ClaimAllBounties error"),
+			},
+			expectedFatal: true,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -138,8 +190,12 @@ func TestExecuteClaimBounty(t *testing.T) {
 			cmdUtilsMock.On("GetConfigData").Return(tt.args.config, tt.args.configErr)
 			utilsMock.On("AssignPassword").Return(tt.args.password)
 			flagSetUtilsMock.On("GetStringAddress", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.address, tt.args.addressErr)
+			flagSetUtilsMock.On("GetBoolEncryptState", mock.AnythingOfType("*pflag.FlagSet")).Return(false, nil)
 			flagSetUtilsMock.On("GetUint32BountyId", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.bountyId, tt.args.bountyIdErr)
 			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetBoolAll", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.all, tt.args.allErr)
+			flagSetUtilsMock.On("GetUint32EventsDays", mock.AnythingOfType("*pflag.FlagSet")).Return(uint32(7), tt.args.eventsDaysErr)
+			cmdUtilsMock.On("ClaimAllBounties", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.AnythingOfType("uint32")).Return(tt.args.bountyClaims, tt.args.claimAllBountiesErr)
 			utilsPkgMock.On("IsFlagPassed", mock.Anything).Return(tt.args.isFlagPassed)
 			cmdUtilsMock.On("HandleClaimBounty", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.handleClaimBountyErr)
 			cmdUtilsMock.On("ClaimBounty", mock.Anything, mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.claimBountyTxn, tt.args.claimBountyErr)
@@ -308,15 +364,13 @@ func TestClaimBounty(t *testing.T) {
 
 func TestHandleClaimBounty(t *testing.T) {
 	var (
-		client   *ethclient.Client
-		config   types.Configurations
-		account  types.Account
-		fileInfo fs.FileInfo
+		client  *ethclient.Client
+		config  types.Configurations
+		account types.Account
 	)
 	type args struct {
 		disputeFilePath    string
 		disputeFilePathErr error
-		statErr            error
 		disputeData        types.DisputeFileData
 		disputeDataErr     error
 		claimBountyTxn     common.Hash
@@ -332,7 +386,6 @@ func TestHandleClaimBounty(t *testing.T) {
 			name: "Test 1: When HandleClaimBounty() executes successfully",
 			args: args{
 				disputeFilePath: "",
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1}},
 				claimBountyTxn:  common.BigToHash(big.NewInt(1)),
 				saveDataErr:     nil,
@@ -343,7 +396,6 @@ func TestHandleClaimBounty(t *testing.T) {
 			name: "Test 2: When HandleClaimBounty() executes successfully and there are more than one bountyId in queue",
 			args: args{
 				disputeFilePath: "",
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1, 2}},
 				claimBountyTxn:  common.BigToHash(big.NewInt(1)),
 				saveDataErr:     nil,
@@ -361,7 +413,6 @@ func TestHandleClaimBounty(t *testing.T) {
 			name: "Test 6: When there is an error in getting disputeData",
 			args: args{
 				disputeFilePath: "",
-				statErr:         nil,
 				disputeDataErr:  errors.New("error in getting diapute data"),
 			},
 			wantErr: true,
@@ -370,7 +421,6 @@ func TestHandleClaimBounty(t *testing.T) {
 			name: "When there is an error in claimBounty",
 			args: args{
 				disputeFilePath:   "",
-				statErr:           nil,
 				disputeData:       types.DisputeFileData{BountyIdQueue: []uint32{1}},
 				claimBountyTxnErr: errors.New("error in claimBounty"),
 			},
@@ -380,7 +430,6 @@ func TestHandleClaimBounty(t *testing.T) {
 			name: "When there is an error in saving data to file",
 			args: args{
 				disputeFilePath: "",
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1}},
 				claimBountyTxn:  common.BigToHash(big.NewInt(1)),
 				saveDataErr:     errors.New("error in saving data to file"),
@@ -393,16 +442,13 @@ func TestHandleClaimBounty(t *testing.T) {
 			utilsMock := new(mocks.UtilsInterface)
 			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 			utilsPkgMock := new(mocks2.Utils)
-			osUtilsMock := new(pathMocks.OSInterface)
 
 			razorUtils = utilsMock
 			cmdUtils = cmdUtilsMock
 			utils.UtilsInterface = utilsPkgMock
 			utilsInterface = utilsPkgMock
-			path.OSUtilsInterface = osUtilsMock
 
 			utilsMock.On("GetDisputeDataFileName", mock.AnythingOfType("string")).Return(tt.args.disputeFilePath, tt.args.disputeFilePathErr)
-			osUtilsMock.On("Stat", mock.Anything).Return(fileInfo, tt.args.statErr)
 			utilsMock.On("ReadFromDisputeJsonFile", mock.Anything).Return(tt.args.disputeData, tt.args.disputeDataErr)
 			cmdUtilsMock.On("ClaimBounty", mock.Anything, mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.claimBountyTxn, tt.args.claimBountyTxnErr)
 			utilsPkgMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
@@ -415,3 +461,172 @@ func TestHandleClaimBounty(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimAllBounties(t *testing.T) {
+	var (
+		client   *ethclient.Client
+		config   types.Configurations
+		callOpts bind.CallOpts
+	)
+	account := types.Account{Address: "0x000000000000000000000000000000000000dead", Password: "test"}
+	bountyLocks := map[uint32]types.BountyLock{
+		1: {RedeemAfter: 8, Amount: big.NewInt(100)},
+		2: {RedeemAfter: 12, Amount: big.NewInt(100)},
+		3: {RedeemAfter: 9, Amount: big.NewInt(100)},
+	}
+	bountyHunterLog := Types.Log{
+		Topics: []common.Hash{{}, common.HexToHash(account.Address)},
+	}
+	otherHunterLog := Types.Log{
+		Topics: []common.Hash{{}, common.HexToHash("0x000000000000000000000000000000000000dea1")},
+	}
+
+	type args struct {
+		disputeFilePathErr error
+		disputeData        types.DisputeFileData
+		disputeDataErr     error
+		eventsDays         uint32
+		logs               []Types.Log
+		logsErr            error
+		epochErr           error
+		saveDataErr        error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantStatuses  map[uint32]string
+		wantQueue     []uint32
+		wantClaimings int
+		wantErr       bool
+	}{
+		{
+			name: "Test 1: When the bounties of the dispute data file are claimed",
+			args: args{
+				disputeData: types.DisputeFileData{BountyIdQueue: []uint32{2, 1}},
+			},
+			wantStatuses:  map[uint32]string{1: core.BountyClaimClaimed, 2: core.BountyClaimLocked},
+			wantQueue:     []uint32{2},
+			wantClaimings: 1,
+			wantErr:       false,
+		},
+		{
+			name: "Test 2: When the bounties of the events of the bounty hunter are claimed too",
+			args: args{
+				disputeData: types.DisputeFileData{BountyIdQueue: []uint32{2, 1}},
+				eventsDays:  7,
+				logs:        []Types.Log{bountyHunterLog, otherHunterLog},
+			},
+			wantStatuses:  map[uint32]string{1: core.BountyClaimClaimed, 2: core.BountyClaimLocked, 3: core.BountyClaimClaimed},
+			wantQueue:     []uint32{2},
+			wantClaimings: 2,
+			wantErr:       false,
+		},
+		{
+			name: "Test 3: When there is no dispute data file and no events are queried",
+			args: args{
+				disputeDataErr: fs.ErrNotExist,
+			},
+			wantStatuses: map[uint32]string{},
+			wantErr:      false,
+		},
+		{
+			name: "Test 4: When there is an error in getting dispute file path",
+			args: args{
+				disputeFilePathErr: errors.New("path error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in reading dispute data",
+			args: args{
+				disputeDataErr: errors.New("read error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting logs",
+			args: args{
+				disputeData: types.DisputeFileData{BountyIdQueue: []uint32{1}},
+				eventsDays:  7,
+				logsErr:     errors.New("logs error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting epoch",
+			args: args{
+				disputeData: types.DisputeFileData{BountyIdQueue: []uint32{1}},
+				epochErr:    errors.New("epoch error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in saving dispute data",
+			args: args{
+				disputeData: types.DisputeFileData{BountyIdQueue: []uint32{1}},
+				saveDataErr: errors.New("save error"),
+			},
+			wantStatuses:  map[uint32]string{1: core.BountyClaimClaimed},
+			wantClaimings: 1,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			stakeManagerMock := new(mocks.StakeManagerInterface)
+			abiMock := new(mocks.AbiInterface)
+			abiUtilsMock := new(mocks2.ABIUtils)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utilsInterface = utilsPkgMock
+			stakeManagerUtils = stakeManagerMock
+			abiUtils = abiMock
+			utils.ABIInterface = abiUtilsMock
+
+			var savedQueue []uint32
+			utilsMock.On("GetDisputeDataFileName", mock.AnythingOfType("string")).Return("", tt.args.disputeFilePathErr)
+			utilsMock.On("ReadFromDisputeJsonFile", mock.Anything).Return(tt.args.disputeData, tt.args.disputeDataErr)
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(100000)}, nil)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(2 * time.Second)
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr).Once()
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return([]Types.Log{}, nil)
+			abiUtilsMock.On("Parse", mock.Anything).Return(abi.ABI{}, nil)
+			abiMock.On("Unpack", mock.Anything, mock.Anything, mock.Anything).Return([]interface{}{uint32(3)}, nil)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(uint32(10), tt.args.epochErr)
+			utilsMock.On("GetOptions").Return(callOpts)
+			for bountyId, bountyLock := range bountyLocks {
+				stakeManagerMock.On("GetBountyLock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*bind.CallOpts"), bountyId).Return(bountyLock, nil)
+			}
+			cmdUtilsMock.On("ClaimBounty", mock.Anything, mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(common.BigToHash(big.NewInt(1)), nil)
+			utilsPkgMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
+			utilsMock.On("SaveDataToDisputeJsonFile", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				savedQueue = args.Get(1).([]uint32)
+			}).Return(tt.args.saveDataErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.ClaimAllBounties(client, config, account, tt.args.eventsDays)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ClaimAllBounties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantStatuses != nil {
+				gotStatuses := make(map[uint32]string)
+				for _, bountyClaim := range got {
+					gotStatuses[bountyClaim.BountyId] = bountyClaim.Status
+				}
+				if !reflect.DeepEqual(gotStatuses, tt.wantStatuses) {
+					t.Errorf("ClaimAllBounties() statuses = %v, want %v", gotStatuses, tt.wantStatuses)
+				}
+			}
+			if !tt.wantErr && !reflect.DeepEqual(savedQueue, tt.wantQueue) {
+				t.Errorf("Saved bounty id queue = %v, want %v", savedQueue, tt.wantQueue)
+			}
+			cmdUtilsMock.AssertNumberOfCalls(t, "ClaimBounty", tt.wantClaimings)
+		})
+	}
+}
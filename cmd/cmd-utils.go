@@ -2,14 +2,22 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/path"
 	"razor/utils"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
@@ -67,6 +75,174 @@ func (*UtilsStruct) WaitIfCommitState(client *ethclient.Client, action string) (
 	}
 }
 
+//This function waits for the transaction sent in a state for at most the txnTimeout of that state and the remaining time of the state
+//If speedUpBlocks is set, a transaction which isn't mined within that many blocks is replaced by one with the same nonce and a higher gas price, at most core.MaxSpeedUps times
+//The hash of the transaction which is mined is returned, as it can be a replacement of the transaction sent
+//If the transaction is still pending after that, it is recorded as unresolved and utils.ErrTransactionMiningTimeout is returned so that the caller can proceed
+func (*UtilsStruct) WaitForTransactionOfState(client *ethclient.Client, config types.Configurations, state string, hashToRead string) (string, error) {
+	timeout := int64(core.BlockCompletionTimeout)
+	if txnTimeout, ok := config.TxnTimeouts[state]; ok && txnTimeout > 0 {
+		timeout = int64(txnTimeout)
+	}
+	stateRemainingTime, err := utilsInterface.GetRemainingTimeOfCurrentState(client, config.BufferPercent)
+	if err != nil {
+		log.Error("Error in getting remaining time of the state: ", err)
+	} else if stateRemainingTime > 0 && stateRemainingTime < timeout {
+		timeout = stateRemainingTime
+	}
+
+	maxWaitTime := time.Duration(timeout) * time.Second
+	speedUp := config.SpeedUpBlocks > 0 && !utils.IsNoSendMode()
+	var speedUpInterval time.Duration
+	if speedUp {
+		speedUpInterval = time.Duration(config.SpeedUpBlocks) * utilsInterface.GetAverageBlockTime(client)
+	}
+	hashes := []string{hashToRead}
+	for waitedTime := time.Duration(0); ; {
+		latestHash := hashes[len(hashes)-1]
+		waitTime := maxWaitTime - waitedTime
+		if speedUp && len(hashes) <= core.MaxSpeedUps && speedUpInterval < waitTime {
+			waitTime = speedUpInterval
+		}
+		err = razorUtils.WaitForBlockCompletionWithTimeout(client, latestHash, waitTime)
+		waitedTime += waitTime
+		if !errors.Is(err, utils.ErrTransactionMiningTimeout) {
+			if err == nil {
+				recordInclusionLatency(client, state, latestHash)
+			}
+			return latestHash, err
+		}
+		// A replaced transaction can still be mined before its replacement
+		for _, hash := range hashes[:len(hashes)-1] {
+			status := utilsInterface.CheckTransactionReceipt(client, hash)
+			if status == 0 || status == 1 {
+				if err := utilsInterface.SaveTransactionReceipt(client, hash); err != nil {
+					log.Error("Error in saving transaction receipt: ", err)
+				}
+			}
+			switch status {
+			case 1:
+				recordInclusionLatency(client, state, hash)
+				return hash, nil
+			case 0:
+				if reason := utilsInterface.GetTransactionRevertReason(client, hash); reason != "" {
+					return hash, fmt.Errorf("transaction mining unsuccessful, execution reverted: %s", reason)
+				}
+				return hash, errors.New("transaction mining unsuccessful")
+			}
+		}
+		if waitedTime >= maxWaitTime {
+			break
+		}
+		replacementHash, speedUpErr := utilsInterface.SpeedUpTransaction(client, latestHash)
+		if speedUpErr != nil {
+			log.Errorf("Error in speeding up transaction %s of %s state, waiting for it without speeding it up: %s", latestHash, state, speedUpErr)
+			speedUp = false
+			continue
+		}
+		log.Warnf("Transaction %s of %s state is not mined within %d blocks, replaced it with %s", latestHash, state, config.SpeedUpBlocks, replacementHash)
+		metrics.SpedUpTransactionsMetric.WithLabelValues(state).Inc()
+		hashes = append(hashes, replacementHash)
+	}
+	log.Warnf("Transaction %s of %s state is still pending after %d seconds, recording it as unresolved and proceeding", hashes[len(hashes)-1], state, timeout)
+	metrics.UnresolvedTransactionsMetric.WithLabelValues(state).Inc()
+	return hashes[len(hashes)-1], err
+}
+
+//The states whose transactions have their inclusion latency recorded
+var inclusionLatencyStates = []string{"commit", "reveal", "propose"}
+
+//This function records the seconds from the opening of the state to the inclusion of the commit, reveal and propose transactions and alerts when they are included close to the end of the state
+//The errors are only logged as the latency shouldn't stop the voting
+func recordInclusionLatency(client *ethclient.Client, state string, hash string) {
+	if !utils.Contains(inclusionLatencyStates, state) {
+		return
+	}
+	receipt, err := utils.ClientInterface.TransactionReceipt(client, context.Background(), common.HexToHash(hash))
+	if err != nil {
+		log.Debugf("Error in getting receipt of %s transaction %s: %s", state, hash, err)
+		return
+	}
+	header, err := utils.ClientInterface.HeaderByNumber(client, context.Background(), receipt.BlockNumber)
+	if err != nil {
+		log.Debugf("Error in getting block %s of %s transaction %s: %s", receipt.BlockNumber, state, hash, err)
+		return
+	}
+	epoch := uint32(header.Time / uint64(core.EpochLength))
+	latency := header.Time % core.StateLength
+	metrics.InclusionLatencyMetric.WithLabelValues(state).Observe(float64(latency))
+	log.Debugf("Transaction %s of %s state of epoch %d is included %d seconds after the state opened", hash, state, epoch, latency)
+	if latency*100 < core.StateLength*core.InclusionLatencyAlertPercent {
+		return
+	}
+	log.Warnf("Transaction %s of %s state of epoch %d is included %d seconds after the state opened, close to the state length of %d seconds", hash, state, epoch, latency, core.StateLength)
+	metrics.InclusionLatencyAlertsMetric.WithLabelValues(state).Inc()
+	utils.Notify(types.Notification{
+		Event:   "inclusionLatency",
+		Epoch:   epoch,
+		Status:  fmt.Sprintf("%s included %d of %d seconds after the state opened", state, latency, core.StateLength),
+		TxnHash: hash,
+	})
+}
+
+//This function records the action taken in the epoch in the journal and notifies it, the errors are only logged as the journal shouldn't stop the voting
+func (*UtilsStruct) RecordJournalAction(address string, epoch uint32, action types.JournalAction) {
+	recordVoteTransactionMetrics(epoch, action)
+	utils.Notify(types.Notification{
+		Event:   strings.Split(action.Action, ":")[0],
+		Address: address,
+		Epoch:   epoch,
+		Status:  action.Status,
+		TxnHash: action.TxnHash,
+		Hashes:  action.Hashes,
+		Amount:  action.Amount,
+	})
+	err := utils.UtilsInterface.SaveLedgerRecord(address, getLedgerRecord(epoch, action))
+	if err != nil {
+		log.Error("Error in saving action to ledger: ", err)
+	}
+	fileName, err := path.PathUtilsInterface.GetJournalFileName(address)
+	if err != nil {
+		log.Error("Error in getting journal file name: ", err)
+		return
+	}
+	err = utils.UtilsInterface.SaveJournalAction(fileName, epoch, action)
+	if err != nil {
+		log.Error("Error in saving action to journal: ", err)
+	}
+}
+
+//This function returns the ledger record of the journal action, the gas of its transaction is filled in when the ledger is read by the history command
+func getLedgerRecord(epoch uint32, action types.JournalAction) types.LedgerRecord {
+	var values []string
+	for _, value := range action.Values {
+		values = append(values, value.String())
+	}
+	return types.LedgerRecord{
+		Epoch:     epoch,
+		Action:    action.Action,
+		Values:    values,
+		TxnHash:   action.TxnHash,
+		Status:    action.Status,
+		Amount:    action.Amount,
+		Timestamp: time.Now().Unix(),
+	}
+}
+
+//This function returns the status of a transaction which is recorded in the journal, the transactions of canary and dry run mode are recorded as not sent
+func GetJournalTxnStatus(waitForBlockCompletionErr error) string {
+	if utils.IsNoSendMode() {
+		return "notSent"
+	}
+	if waitForBlockCompletionErr == nil {
+		return "mined"
+	}
+	if errors.Is(waitForBlockCompletionErr, utils.ErrTransactionMiningTimeout) {
+		return "unresolved"
+	}
+	return "failed"
+}
+
 //This function assignes amount in wei
 func (*UtilsStruct) AssignAmountInWei(flagSet *pflag.FlagSet) (*big.Int, error) {
 	amount, err := flagSetUtils.GetStringValue(flagSet)
@@ -107,3 +283,13 @@ func GetStatesAllowed(states []int) string {
 	}
 	return statesAllowed
 }
+
+//This function records the transaction of the action in the vote metrics, the index of the block is removed from the dispute actions to keep the labels bounded
+func recordVoteTransactionMetrics(epoch uint32, action types.JournalAction) {
+	if action.TxnHash == "" {
+		return
+	}
+	actionName := strings.SplitN(action.Action, ":", 2)[0]
+	metrics.VoteTransactionsMetric.WithLabelValues(actionName, action.Status).Inc()
+	metrics.VoteTransactionEpochMetric.WithLabelValues(actionName).Set(float64(epoch))
+}
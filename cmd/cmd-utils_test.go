@@ -2,14 +2,20 @@ package cmd
 
 import (
 	"errors"
+	Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/mock"
 	"math/big"
 	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
 	"razor/utils"
 	mocks2 "razor/utils/mocks"
 	"testing"
+	"time"
 )
 
 func TestGetEpochAndState(t *testing.T) {
@@ -270,6 +276,454 @@ func TestWaitIfCommitState(t *testing.T) {
 	}
 }
 
+func TestWaitForTransactionOfState(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		config           types.Configurations
+		state            string
+		remainingTime    int64
+		remainingTimeErr error
+		waitErr          error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantTimeout time.Duration
+		wantErr     error
+	}{
+		{
+			name: "Test 1: When the txnTimeout of the state is not set",
+			args: args{
+				state:         "commit",
+				remainingTime: 100,
+			},
+			wantTimeout: 30 * time.Second,
+			wantErr:     nil,
+		},
+		{
+			name: "Test 2: When the txnTimeout of the state is set",
+			args: args{
+				config:        types.Configurations{TxnTimeouts: map[string]int{"commit": 60}},
+				state:         "commit",
+				remainingTime: 100,
+			},
+			wantTimeout: 60 * time.Second,
+			wantErr:     nil,
+		},
+		{
+			name: "Test 3: When the remaining time of the state is less than the txnTimeout",
+			args: args{
+				config:        types.Configurations{TxnTimeouts: map[string]int{"reveal": 60}},
+				state:         "reveal",
+				remainingTime: 20,
+			},
+			wantTimeout: 20 * time.Second,
+			wantErr:     nil,
+		},
+		{
+			name: "Test 4: When there is an error in getting remaining time of the state",
+			args: args{
+				config:           types.Configurations{TxnTimeouts: map[string]int{"propose": 60}},
+				state:            "propose",
+				remainingTimeErr: errors.New("remaining time error"),
+			},
+			wantTimeout: 60 * time.Second,
+			wantErr:     nil,
+		},
+		{
+			name: "Test 5: When the transaction is unresolved after the timeout",
+			args: args{
+				state:         "dispute",
+				remainingTime: 100,
+				waitErr:       utils.ErrTransactionMiningTimeout,
+			},
+			wantTimeout: 30 * time.Second,
+			wantErr:     utils.ErrTransactionMiningTimeout,
+		},
+		{
+			name: "Test 6: When the transaction fails",
+			args: args{
+				state:         "confirm",
+				remainingTime: 100,
+				waitErr:       errors.New("transaction mining unsuccessful"),
+			},
+			wantTimeout: 30 * time.Second,
+			wantErr:     errors.New("transaction mining unsuccessful"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			clientUtilsMock := new(mocks2.ClientUtils)
+
+			razorUtils = utilsMock
+			utilsInterface = utilsPkgMock
+			utils.ClientInterface = clientUtilsMock
+
+			clientUtilsMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(nil, errors.New("receipt error"))
+			utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(tt.args.remainingTime, tt.args.remainingTimeErr)
+			utilsMock.On("WaitForBlockCompletionWithTimeout", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), tt.wantTimeout).Return(tt.args.waitErr)
+
+			ut := &UtilsStruct{}
+			_, err := ut.WaitForTransactionOfState(client, tt.args.config, tt.args.state, "0x01")
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for WaitForTransactionOfState function, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for WaitForTransactionOfState function, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForTransactionOfStateSpeedUp(t *testing.T) {
+	var client *ethclient.Client
+
+	type wait struct {
+		hash    string
+		timeout time.Duration
+		err     error
+	}
+	type args struct {
+		speedUpBlocks uint32
+		waits         []wait
+		receipts      map[string]int
+		replacements  map[string]string
+		speedUpErr    error
+		revertReason  string
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantHash string
+		wantErr  error
+	}{
+		{
+			name: "Test 1: When the transaction is mined before it is sped up",
+			args: args{
+				speedUpBlocks: 2,
+				waits: []wait{
+					{hash: "0x01", timeout: 10 * time.Second},
+				},
+			},
+			wantHash: "0x01",
+			wantErr:  nil,
+		},
+		{
+			name: "Test 2: When the replacement of the stuck transaction is mined",
+			args: args{
+				speedUpBlocks: 2,
+				waits: []wait{
+					{hash: "0x01", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+					{hash: "0x02", timeout: 10 * time.Second},
+				},
+				replacements: map[string]string{"0x01": "0x02"},
+			},
+			wantHash: "0x02",
+			wantErr:  nil,
+		},
+		{
+			name: "Test 3: When the stuck transaction is mined before its replacement",
+			args: args{
+				speedUpBlocks: 2,
+				waits: []wait{
+					{hash: "0x01", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+					{hash: "0x02", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+				},
+				receipts:     map[string]int{"0x01": 1},
+				replacements: map[string]string{"0x01": "0x02"},
+			},
+			wantHash: "0x01",
+			wantErr:  nil,
+		},
+		{
+			name: "Test 4: When there is an error in speeding up the transaction",
+			args: args{
+				speedUpBlocks: 2,
+				waits: []wait{
+					{hash: "0x01", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+					{hash: "0x01", timeout: 20 * time.Second},
+				},
+				speedUpErr: errors.New("replacement transaction underpriced"),
+			},
+			wantHash: "0x01",
+			wantErr:  nil,
+		},
+		{
+			name: "Test 5: When all the replacements are still pending after the timeout",
+			args: args{
+				speedUpBlocks: 4,
+				waits: []wait{
+					{hash: "0x01", timeout: 20 * time.Second, err: utils.ErrTransactionMiningTimeout},
+					{hash: "0x02", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+				},
+				receipts:     map[string]int{"0x01": -1},
+				replacements: map[string]string{"0x01": "0x02"},
+			},
+			wantHash: "0x02",
+			wantErr:  utils.ErrTransactionMiningTimeout,
+		},
+		{
+			name: "Test 6: When the stuck transaction is mined before its replacement and reverts",
+			args: args{
+				speedUpBlocks: 2,
+				waits: []wait{
+					{hash: "0x01", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+					{hash: "0x02", timeout: 10 * time.Second, err: utils.ErrTransactionMiningTimeout},
+				},
+				receipts:     map[string]int{"0x01": 0},
+				replacements: map[string]string{"0x01": "0x02"},
+				revertReason: "Block already confirmed",
+			},
+			wantHash: "0x01",
+			wantErr:  errors.New("transaction mining unsuccessful, execution reverted: Block already confirmed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			clientUtilsMock := new(mocks2.ClientUtils)
+
+			razorUtils = utilsMock
+			utilsInterface = utilsPkgMock
+			utils.ClientInterface = clientUtilsMock
+
+			clientUtilsMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(nil, errors.New("receipt error"))
+			utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(int64(100), nil)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(5 * time.Second)
+			for _, wait := range tt.args.waits {
+				utilsMock.On("WaitForBlockCompletionWithTimeout", mock.AnythingOfType("*ethclient.Client"), wait.hash, wait.timeout).Return(wait.err).Once()
+			}
+			for hash, status := range tt.args.receipts {
+				utilsPkgMock.On("CheckTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), hash).Return(status)
+			}
+			utilsPkgMock.On("GetTransactionRevertReason", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.revertReason)
+			utilsPkgMock.On("SaveTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			for hash, replacementHash := range tt.args.replacements {
+				utilsPkgMock.On("SpeedUpTransaction", mock.AnythingOfType("*ethclient.Client"), hash).Return(replacementHash, nil)
+			}
+			if tt.args.speedUpErr != nil {
+				utilsPkgMock.On("SpeedUpTransaction", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return("", tt.args.speedUpErr)
+			}
+
+			ut := &UtilsStruct{}
+			gotHash, err := ut.WaitForTransactionOfState(client, types.Configurations{SpeedUpBlocks: tt.args.speedUpBlocks}, "commit", "0x01")
+			if gotHash != tt.wantHash {
+				t.Errorf("Hash of WaitForTransactionOfState function, got = %v, want = %v", gotHash, tt.wantHash)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for WaitForTransactionOfState function, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for WaitForTransactionOfState function, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordInclusionLatency(t *testing.T) {
+	var client *ethclient.Client
+	epochStart := uint64(100 * core.EpochLength)
+
+	type args struct {
+		state      string
+		receiptErr error
+		blockTime  uint64
+		headerErr  error
+	}
+	tests := []struct {
+		name              string
+		args              args
+		wantReceiptCalled bool
+		wantHeaderCalled  bool
+	}{
+		{
+			name: "Test 1: When the transaction is included early in the state",
+			args: args{
+				state:     "commit",
+				blockTime: epochStart + 10,
+			},
+			wantReceiptCalled: true,
+			wantHeaderCalled:  true,
+		},
+		{
+			name: "Test 2: When the transaction is included close to the end of the state",
+			args: args{
+				state:     "reveal",
+				blockTime: epochStart + core.StateLength + core.StateLength*9/10,
+			},
+			wantReceiptCalled: true,
+			wantHeaderCalled:  true,
+		},
+		{
+			name: "Test 3: When the latency of the state is not recorded",
+			args: args{
+				state: "dispute",
+			},
+			wantReceiptCalled: false,
+			wantHeaderCalled:  false,
+		},
+		{
+			name: "Test 4: When there is an error in getting the receipt",
+			args: args{
+				state:      "propose",
+				receiptErr: errors.New("receipt error"),
+			},
+			wantReceiptCalled: true,
+			wantHeaderCalled:  false,
+		},
+		{
+			name: "Test 5: When there is an error in getting the block",
+			args: args{
+				state:     "propose",
+				headerErr: errors.New("header error"),
+			},
+			wantReceiptCalled: true,
+			wantHeaderCalled:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientUtilsMock := new(mocks2.ClientUtils)
+
+			utils.ClientInterface = clientUtilsMock
+
+			clientUtilsMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(&Types.Receipt{BlockNumber: big.NewInt(20)}, tt.args.receiptErr)
+			clientUtilsMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, big.NewInt(20)).Return(&Types.Header{Time: tt.args.blockTime}, tt.args.headerErr)
+
+			recordInclusionLatency(client, tt.args.state, "0x01")
+			if tt.wantReceiptCalled {
+				clientUtilsMock.AssertCalled(t, "TransactionReceipt", client, mock.Anything, mock.Anything)
+			} else {
+				clientUtilsMock.AssertNotCalled(t, "TransactionReceipt", client, mock.Anything, mock.Anything)
+			}
+			if tt.wantHeaderCalled {
+				clientUtilsMock.AssertCalled(t, "HeaderByNumber", client, mock.Anything, big.NewInt(20))
+			} else {
+				clientUtilsMock.AssertNotCalled(t, "HeaderByNumber", client, mock.Anything, big.NewInt(20))
+			}
+		})
+	}
+}
+func TestRecordJournalAction(t *testing.T) {
+	action := types.JournalAction{
+		Action:  "commit",
+		Hashes:  map[string]string{"values": "0x01"},
+		TxnHash: "0x02",
+		Status:  "mined",
+	}
+
+	type args struct {
+		fileName    string
+		fileNameErr error
+		saveErr     error
+		ledgerErr   error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantSave bool
+	}{
+		{
+			name: "Test 1: When the action is recorded in the journal",
+			args: args{
+				fileName: "/home/.razor/data_files/0x000000000000000000000000000000000000dead_journal.jsonl",
+			},
+			wantSave: true,
+		},
+		{
+			name: "Test 2: When there is an error in getting journal file name",
+			args: args{
+				fileNameErr: errors.New("fileName error"),
+			},
+			wantSave: false,
+		},
+		{
+			name: "Test 3: When there is an error in saving the action",
+			args: args{
+				fileName: "/home/.razor/data_files/0x000000000000000000000000000000000000dead_journal.jsonl",
+				saveErr:  errors.New("save error"),
+			},
+			wantSave: true,
+		},
+		{
+			name: "Test 4: When there is an error in saving the action to the ledger",
+			args: args{
+				fileName:  "/home/.razor/data_files/0x000000000000000000000000000000000000dead_journal.jsonl",
+				ledgerErr: errors.New("ledger error"),
+			},
+			wantSave: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathUtilsMock := new(pathMocks.PathInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			path.PathUtilsInterface = pathUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			pathUtilsMock.On("GetJournalFileName", mock.AnythingOfType("string")).Return(tt.args.fileName, tt.args.fileNameErr)
+			utilsPkgMock.On("SaveJournalAction", mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), mock.AnythingOfType("types.JournalAction")).Return(tt.args.saveErr)
+			utilsPkgMock.On("SaveLedgerRecord", mock.AnythingOfType("string"), mock.AnythingOfType("types.LedgerRecord")).Return(tt.args.ledgerErr)
+
+			ut := &UtilsStruct{}
+			ut.RecordJournalAction("0x000000000000000000000000000000000000dead", 10, action)
+
+			utilsPkgMock.AssertCalled(t, "SaveLedgerRecord", "0x000000000000000000000000000000000000dead", mock.MatchedBy(func(record types.LedgerRecord) bool {
+				return record.Epoch == 10 && record.Action == action.Action && record.TxnHash == action.TxnHash && record.Status == action.Status
+			}))
+			if tt.wantSave {
+				utilsPkgMock.AssertCalled(t, "SaveJournalAction", tt.args.fileName, uint32(10), action)
+			} else {
+				utilsPkgMock.AssertNotCalled(t, "SaveJournalAction", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestGetJournalTxnStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Test 1: When the transaction is mined",
+			err:  nil,
+			want: "mined",
+		},
+		{
+			name: "Test 2: When the transaction is unresolved",
+			err:  utils.ErrTransactionMiningTimeout,
+			want: "unresolved",
+		},
+		{
+			name: "Test 3: When the transaction failed",
+			err:  errors.New("transaction failed"),
+			want: "failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetJournalTxnStatus(tt.err); got != tt.want {
+				t.Errorf("GetJournalTxnStatus() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAssignAmountInWei1(t *testing.T) {
 	var flagSet *pflag.FlagSet
 
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"os"
+	"razor/core/types"
 	"razor/logger"
 	"razor/utils"
 	"strconv"
@@ -49,6 +50,22 @@ func (*UtilsStruct) GetCollectionList(client *ethclient.Client) error {
 		return err
 	}
 
+	if utils.IsJsonOutput() {
+		collectionsOutput := make([]types.CollectionOutput, 0, len(collections))
+		for _, collection := range collections {
+			collectionsOutput = append(collectionsOutput, types.CollectionOutput{
+				Active:            collection.Active,
+				CollectionId:      collection.Id,
+				Power:             collection.Power,
+				AggregationMethod: collection.AggregationMethod,
+				JobIds:            collection.JobIDs,
+				Name:              collection.Name,
+				Tolerance:         collection.Tolerance,
+			})
+		}
+		return utils.PrintJson(collectionsOutput)
+	}
+
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"Active", "Collection Id", "Power", "Aggregation Method", "Job IDs", "Name", "Tolerance"})
 	for i := 0; i < len(collections); i++ {
@@ -0,0 +1,148 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var commissionInfoCmd = &cobra.Command{
+	Use:   "commissionInfo",
+	Short: "preview the commission update allowed for a staker",
+	Long: `Shows the current commission of the staker, the range it can be updated to and the epoch from which it can be updated, along with the update scheduled with updateCommission --schedule.
+
+Example:
+  ./razor commissionInfo --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+	Run: initialiseCommissionInfo,
+}
+
+//This function initialises the ExecuteCommissionInfo function
+func initialiseCommissionInfo(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteCommissionInfo(cmd.Flags())
+}
+
+//This function sets the flags appropriately and prints the commission window and the scheduled update of the staker
+func (*UtilsStruct) ExecuteCommissionInfo(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	utils.CheckError("Error in getting stakerId: ", err)
+	if stakerId == 0 {
+		log.Fatal("Staker doesn't exist")
+	}
+
+	window, err := cmdUtils.GetCommissionWindow(client, stakerId)
+	utils.CheckError("Error in getting commission window: ", err)
+
+	fileName, err := path.PathUtilsInterface.GetCommissionScheduleFileName(address)
+	utils.CheckError("Error in getting commission schedule file name: ", err)
+	schedule, err := utils.UtilsInterface.ReadFromCommissionScheduleFile(fileName)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Error("Error in reading scheduled commission update: ", err)
+	}
+
+	if utils.IsJsonOutput() {
+		output := struct {
+			types.CommissionWindow
+			Schedule *types.CommissionSchedule `json:"schedule,omitempty"`
+		}{CommissionWindow: window}
+		if schedule.Status != "" {
+			output.Schedule = &schedule
+		}
+		utils.CheckError("Error in printing commission info: ", utils.PrintJson(output))
+		return
+	}
+	printCommissionInfo(window, schedule)
+}
+
+//This function returns the current commission of the staker, the range it can be updated to and the epoch from which it can be updated
+//The commission can be updated once the epoch limit for updating the commission has passed since its last update
+func (*UtilsStruct) GetCommissionWindow(client *ethclient.Client, stakerId uint32) (types.CommissionWindow, error) {
+	staker, err := razorUtils.GetStaker(client, stakerId)
+	if err != nil {
+		return types.CommissionWindow{}, err
+	}
+	maxCommission, err := razorUtils.GetMaxCommission(client)
+	if err != nil {
+		return types.CommissionWindow{}, err
+	}
+	epochLimitForUpdateCommission, err := razorUtils.GetEpochLimitForUpdateCommission(client)
+	if err != nil {
+		return types.CommissionWindow{}, err
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return types.CommissionWindow{}, err
+	}
+	nextUpdateEpoch := epoch
+	if staker.EpochCommissionLastUpdated != 0 && staker.EpochCommissionLastUpdated+uint32(epochLimitForUpdateCommission) >= epoch {
+		nextUpdateEpoch = staker.EpochCommissionLastUpdated + uint32(epochLimitForUpdateCommission) + 1
+	}
+	return types.CommissionWindow{
+		StakerId:                      stakerId,
+		Commission:                    staker.Commission,
+		MinCommission:                 1,
+		MaxCommission:                 maxCommission,
+		EpochCommissionLastUpdated:    staker.EpochCommissionLastUpdated,
+		EpochLimitForUpdateCommission: epochLimitForUpdateCommission,
+		Epoch:                         epoch,
+		NextUpdateEpoch:               nextUpdateEpoch,
+		CanUpdate:                     nextUpdateEpoch == epoch,
+	}, nil
+}
+
+//This function prints the commission window and the scheduled update of the staker in a table
+func printCommissionInfo(window types.CommissionWindow, schedule types.CommissionSchedule) {
+	nextUpdate := "now"
+	if !window.CanUpdate {
+		timeRemaining := int64(window.NextUpdateEpoch-window.Epoch) * core.EpochLength
+		nextUpdate = "epoch " + strconv.Itoa(int(window.NextUpdateEpoch)) + " (approximately " + razorUtils.SecondsToReadableTime(int(timeRemaining)) + ")"
+	}
+	scheduled := "-"
+	if schedule.Status != "" {
+		scheduled = strconv.Itoa(int(schedule.Commission)) + "% (" + schedule.Status + ")"
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Staker Id", "Commission (%)", "Allowed Range (%)", "Last Updated Epoch", "Epoch Limit", "Next Update", "Scheduled Update"})
+	table.Append([]string{
+		strconv.Itoa(int(window.StakerId)),
+		strconv.Itoa(int(window.Commission)),
+		strconv.Itoa(int(window.MinCommission)) + " - " + strconv.Itoa(int(window.MaxCommission)),
+		strconv.Itoa(int(window.EpochCommissionLastUpdated)),
+		strconv.Itoa(int(window.EpochLimitForUpdateCommission)),
+		nextUpdate,
+		scheduled,
+	})
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(commissionInfoCmd)
+
+	var (
+		Address string
+	)
+
+	commissionInfoCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+
+	addrErr := commissionInfoCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
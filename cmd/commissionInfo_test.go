@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCommissionWindow(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		staker                           bindings.StructsStaker
+		stakerErr                        error
+		maxCommission                    uint8
+		maxCommissionErr                 error
+		epochLimitForUpdateCommission    uint16
+		epochLimitForUpdateCommissionErr error
+		epoch                            uint32
+		epochErr                         error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.CommissionWindow
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the commission was never updated",
+			args: args{
+				staker:                        bindings.StructsStaker{Commission: 0},
+				maxCommission:                 20,
+				epochLimitForUpdateCommission: 100,
+				epoch:                         50,
+			},
+			want:    types.CommissionWindow{StakerId: 1, Commission: 0, MinCommission: 1, MaxCommission: 20, EpochLimitForUpdateCommission: 100, Epoch: 50, NextUpdateEpoch: 50, CanUpdate: true},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the epoch limit for updating the commission isn't over",
+			args: args{
+				staker:                        bindings.StructsStaker{Commission: 5, EpochCommissionLastUpdated: 40},
+				maxCommission:                 20,
+				epochLimitForUpdateCommission: 100,
+				epoch:                         140,
+			},
+			want:    types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20, EpochCommissionLastUpdated: 40, EpochLimitForUpdateCommission: 100, Epoch: 140, NextUpdateEpoch: 141, CanUpdate: false},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the epoch limit for updating the commission is over",
+			args: args{
+				staker:                        bindings.StructsStaker{Commission: 5, EpochCommissionLastUpdated: 40},
+				maxCommission:                 20,
+				epochLimitForUpdateCommission: 100,
+				epoch:                         141,
+			},
+			want:    types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20, EpochCommissionLastUpdated: 40, EpochLimitForUpdateCommission: 100, Epoch: 141, NextUpdateEpoch: 141, CanUpdate: true},
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting staker",
+			args: args{
+				stakerErr: errors.New("staker error"),
+			},
+			want:    types.CommissionWindow{},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting max commission",
+			args: args{
+				maxCommissionErr: errors.New("max commission error"),
+			},
+			want:    types.CommissionWindow{},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting epoch limit for updating the commission",
+			args: args{
+				maxCommission:                    20,
+				epochLimitForUpdateCommissionErr: errors.New("epoch limit error"),
+			},
+			want:    types.CommissionWindow{},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting epoch",
+			args: args{
+				maxCommission:                 20,
+				epochLimitForUpdateCommission: 100,
+				epochErr:                      errors.New("epoch error"),
+			},
+			want:    types.CommissionWindow{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+
+			razorUtils = utilsMock
+
+			utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), uint32(1)).Return(tt.args.staker, tt.args.stakerErr)
+			utilsMock.On("GetMaxCommission", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.maxCommission, tt.args.maxCommissionErr)
+			utilsMock.On("GetEpochLimitForUpdateCommission", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epochLimitForUpdateCommission, tt.args.epochLimitForUpdateCommissionErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetCommissionWindow(client, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCommissionWindow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCommissionWindow() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ func (*UtilsStruct) GetSalt(client *ethclient.Client, epoch uint32) ([32]byte, e
 /*
 HandleCommitState fetches the collections assigned to the staker and creates the leaves required for the merkle tree generation.
 Values for only the collections assigned to the staker is fetched for others, 0 is added to the leaves of tree.
+If the node is subscribed to a subset of collections, the value reported by the network in the previous epoch is committed for the assigned collections it isn't subscribed to.
 */
 func (*UtilsStruct) HandleCommitState(client *ethclient.Client, epoch uint32, seed []byte, rogueData types.Rogue) (types.CommitData, error) {
 	numActiveCollections, err := utils.UtilsInterface.GetNumActiveCollections(client)
@@ -57,22 +58,54 @@ func (*UtilsStruct) HandleCommitState(client *ethclient.Client, epoch uint32, se
 		return types.CommitData{}, err
 	}
 
-	var leavesOfTree []*big.Int
+	collectionIdOfIndex := make(map[int]uint16)
+	var collectionIds []uint16
 	for i := 0; i < int(numActiveCollections); i++ {
 		if assignedCollections[i] {
 			collectionId, err := utils.UtilsInterface.GetCollectionIdFromIndex(client, uint16(i))
 			if err != nil {
 				return types.CommitData{}, err
 			}
-			collectionData, err := utils.UtilsInterface.GetAggregatedDataOfCollection(client, collectionId, epoch)
+			collectionIdOfIndex[i] = collectionId
+			collectionIds = append(collectionIds, collectionId)
+		}
+	}
+
+	// The values of the collections with the most reliable sources are fetched first
+	dataOfCollection := make(map[uint16]*big.Int)
+	for _, collectionId := range utils.SortCollectionsByReliability(collectionIds) {
+		if _, ok := dataOfCollection[collectionId]; ok {
+			continue
+		}
+		if !utils.IsSubscribedToCollection(collectionId) {
+			previousValue, err := utils.UtilsInterface.FetchPreviousValue(client, epoch-1, collectionId)
 			if err != nil {
 				return types.CommitData{}, err
 			}
-			if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "commit") {
-				collectionData = razorUtils.GetRogueRandomValue(100000)
-			}
-			log.Debugf("Data of collection %d:%s", collectionId, collectionData)
-			leavesOfTree = append(leavesOfTree, collectionData)
+			log.Debugf("Not subscribed to collection %d, committing previous value %s", collectionId, previousValue)
+			dataOfCollection[collectionId] = previousValue
+			utils.SetAttestedCollectionValue(collectionId, previousValue)
+			continue
+		}
+		// The responses of the sources fetched for the collection are attested with its value
+		utils.StartAttestedCollection(collectionId)
+		collectionData, err := utils.UtilsInterface.GetAggregatedDataOfCollection(client, collectionId, epoch)
+		utils.RecordCollectionFetch(collectionId, err)
+		if err != nil {
+			return types.CommitData{}, err
+		}
+		if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "commit") {
+			collectionData = razorUtils.GetRogueRandomValue(100000)
+		}
+		log.Debugf("Data of collection %d:%s", collectionId, collectionData)
+		dataOfCollection[collectionId] = collectionData
+		utils.SetAttestedCollectionValue(collectionId, collectionData)
+	}
+
+	var leavesOfTree []*big.Int
+	for i := 0; i < int(numActiveCollections); i++ {
+		if assignedCollections[i] {
+			leavesOfTree = append(leavesOfTree, dataOfCollection[collectionIdOfIndex[i]])
 		} else {
 			leavesOfTree = append(leavesOfTree, big.NewInt(0))
 		}
@@ -141,6 +141,9 @@ func TestHandleCommitState(t *testing.T) {
 		collectionData          *big.Int
 		collectionDataErr       error
 		rogueData               types.Rogue
+		subscribedCollections   []uint16
+		previousValue           *big.Int
+		previousValueErr        error
 	}
 	tests := []struct {
 		name    string
@@ -224,6 +227,37 @@ func TestHandleCommitState(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "Test 7: When the node is not subscribed to the assigned collections",
+			args: args{
+				numActiveCollections:   3,
+				assignedCollections:    map[int]bool{1: true, 2: true},
+				seqAllottedCollections: []*big.Int{big.NewInt(1), big.NewInt(2)},
+				collectionId:           1,
+				collectionDataErr:      errors.New("error in getting collectionData"),
+				subscribedCollections:  []uint16{2},
+				previousValue:          big.NewInt(5),
+			},
+			want: types.CommitData{
+				AssignedCollections:    map[int]bool{1: true, 2: true},
+				SeqAllottedCollections: []*big.Int{big.NewInt(1), big.NewInt(2)},
+				Leaves:                 []*big.Int{big.NewInt(0), big.NewInt(5), big.NewInt(5)},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 8: When there is an error in fetching the previous value of a collection which is not subscribed",
+			args: args{
+				numActiveCollections:   3,
+				assignedCollections:    map[int]bool{1: true, 2: true},
+				seqAllottedCollections: []*big.Int{big.NewInt(1), big.NewInt(2)},
+				collectionId:           1,
+				subscribedCollections:  []uint16{2},
+				previousValueErr:       errors.New("error in fetching previous value"),
+			},
+			want:    types.CommitData{},
+			wantErr: errors.New("error in fetching previous value"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -237,10 +271,13 @@ func TestHandleCommitState(t *testing.T) {
 			utilsPkgMock.On("GetAssignedCollections", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.assignedCollections, tt.args.seqAllottedCollections, tt.args.assignedCollectionsErr)
 			utilsPkgMock.On("GetCollectionIdFromIndex", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.collectionId, tt.args.collectionIdErr)
 			utilsPkgMock.On("GetAggregatedDataOfCollection", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.collectionData, tt.args.collectionDataErr)
+			utilsPkgMock.On("FetchPreviousValue", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint16")).Return(tt.args.previousValue, tt.args.previousValueErr)
 			utilsMock.On("GetRogueRandomValue", mock.Anything).Return(rogueValue)
+			utils.SetSubscribedCollections(tt.args.subscribedCollections)
+			defer utils.SetSubscribedCollections(nil)
 
-			utils := &UtilsStruct{}
-			got, err := utils.HandleCommitState(client, epoch, seed, tt.args.rogueData)
+			ut := &UtilsStruct{}
+			got, err := ut.HandleCommitState(client, epoch, seed, tt.args.rogueData)
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Data from HandleCommitState function, got = %v, want = %v", got, tt.want)
 			}
@@ -2,8 +2,14 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
 	"github.com/spf13/viper"
+	"net/url"
+	"razor/core"
 	"razor/core/types"
+	"razor/utils"
+	"strconv"
 	"strings"
 )
 
@@ -46,6 +52,76 @@ func (*UtilsStruct) GetConfigData() (types.Configurations, error) {
 	if err != nil {
 		return config, err
 	}
+	txnTimeouts, err := cmdUtils.GetTxnTimeouts()
+	if err != nil {
+		return config, err
+	}
+	gasStrategies, err := cmdUtils.GetGasStrategies()
+	if err != nil {
+		return config, err
+	}
+	maxGasPrice, err := cmdUtils.GetMaxGasPrice()
+	if err != nil {
+		return config, err
+	}
+	gasBudget, err := cmdUtils.GetGasBudget()
+	if err != nil {
+		return config, err
+	}
+	gasBudgetPeriod, err := cmdUtils.GetGasBudgetPeriod()
+	if err != nil {
+		return config, err
+	}
+	requestHeaders, err := cmdUtils.GetRequestHeaders()
+	if err != nil {
+		return config, err
+	}
+	allowedHosts, err := cmdUtils.GetAllowedHosts()
+	if err != nil {
+		return config, err
+	}
+	signerUrl, err := cmdUtils.GetSignerUrl()
+	if err != nil {
+		return config, err
+	}
+	kmsKey, err := cmdUtils.GetKMSKey()
+	if err != nil {
+		return config, err
+	}
+	if signerUrl != "" && kmsKey != "" {
+		return config, errors.New("the transactions are signed either by the external signer or by the KMS, signerUrl and kmsKey can't both be set")
+	}
+	readProvider, err := cmdUtils.GetReadProvider()
+	if err != nil {
+		return config, err
+	}
+	logsChunkSize, err := cmdUtils.GetLogsChunkSize()
+	if err != nil {
+		return config, err
+	}
+	apiCacheTTL, err := cmdUtils.GetAPICacheTTL()
+	if err != nil {
+		return config, err
+	}
+	httpTimeout, err := cmdUtils.GetHTTPTimeout()
+	if err != nil {
+		return config, err
+	}
+	httpRetryAttempts, err := cmdUtils.GetHTTPRetryAttempts()
+	if err != nil {
+		return config, err
+	}
+	httpRetryDelay, err := cmdUtils.GetHTTPRetryDelay()
+	if err != nil {
+		return config, err
+	}
+	httpProxy, err := cmdUtils.GetHTTPProxy()
+	if err != nil {
+		return config, err
+	}
+	if chainId := viper.GetInt64("chainId"); chainId != 0 && chainId != core.ChainId.Int64() {
+		return config, fmt.Errorf("the config is for the chain %d but the client is built for the chain %d", chainId, core.ChainId.Int64())
+	}
 	config.Provider = provider
 	config.GasMultiplier = gasMultiplier
 	config.BufferPercent = bufferPercent
@@ -53,6 +129,33 @@ func (*UtilsStruct) GetConfigData() (types.Configurations, error) {
 	config.GasPrice = gasPrice
 	config.LogLevel = logLevel
 	config.GasLimitMultiplier = gasLimit
+	config.TxnTimeouts = txnTimeouts
+	config.GasStrategies = gasStrategies
+	config.MaxGasPrice = maxGasPrice
+	config.GasBudget = gasBudget
+	config.GasBudgetPeriod = gasBudgetPeriod
+	config.RequestHeaders = requestHeaders
+	config.AllowedHosts = allowedHosts
+	config.SignerUrl = signerUrl
+	config.KMSKey = kmsKey
+	config.ReadProvider = readProvider
+	config.LogsChunkSize = logsChunkSize
+	config.APICacheTTL = apiCacheTTL
+	config.HTTPTimeout = httpTimeout
+	config.HTTPRetryAttempts = httpRetryAttempts
+	config.HTTPRetryDelay = httpRetryDelay
+	config.HTTPProxy = httpProxy
+
+	utils.SetRequestPrivacy(requestHeaders, allowedHosts)
+	utils.SetRemoteSigner(signerUrl)
+	err = utils.SetKMSSigner(kmsKey)
+	if err != nil {
+		return config, err
+	}
+	utils.SetReadProvider(readProvider)
+	utils.SetLogsChunkSize(logsChunkSize)
+	utils.SetAPICacheTTL(apiCacheTTL)
+	utils.SetHTTPOptions(httpTimeout, httpRetryAttempts, httpRetryDelay, httpProxy)
 
 	return config, nil
 }
@@ -143,3 +246,437 @@ func (*UtilsStruct) GetGasLimit() (float32, error) {
 	}
 	return gasLimit, nil
 }
+
+//This function returns the maximum time in seconds to wait for the transactions of each state
+func (*UtilsStruct) GetTxnTimeouts() (map[string]int, error) {
+	txnTimeouts, err := flagSetUtils.GetRootStringToIntTxnTimeouts()
+	if err != nil {
+		return nil, err
+	}
+	if len(txnTimeouts) == 0 {
+		txnTimeouts, err = getConfigTxnTimeouts()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = validateTxnTimeouts(txnTimeouts)
+	if err != nil {
+		return nil, err
+	}
+	return txnTimeouts, nil
+}
+
+//This function returns the transaction timeouts of the config file, or of the environment variable in which they are given as commit=60,reveal=60
+func getConfigTxnTimeouts() (map[string]int, error) {
+	txnTimeouts := make(map[string]int)
+	if value, ok := viper.Get("txnTimeouts").(string); ok {
+		for _, pair := range strings.Split(value, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			stateTimeout := strings.SplitN(pair, "=", 2)
+			if len(stateTimeout) != 2 {
+				return nil, fmt.Errorf("invalid txnTimeouts %s, it should be of the form commit=60,reveal=60", value)
+			}
+			timeout, err := strconv.Atoi(strings.TrimSpace(stateTimeout[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid txnTimeout of %s state: %s", strings.TrimSpace(stateTimeout[0]), err)
+			}
+			txnTimeouts[strings.TrimSpace(stateTimeout[0])] = timeout
+		}
+		return txnTimeouts, nil
+	}
+	for state, value := range viper.GetStringMapString("txnTimeouts") {
+		timeout, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid txnTimeout of %s state: %s", state, err)
+		}
+		txnTimeouts[state] = timeout
+	}
+	return txnTimeouts, nil
+}
+
+//This function checks that the transaction timeouts are given for valid states and are not negative
+func validateTxnTimeouts(txnTimeouts map[string]int) error {
+	for state, timeout := range txnTimeouts {
+		if !utils.Contains(core.TxnTimeoutStates, state) {
+			return fmt.Errorf("invalid state %s in txnTimeouts, valid states are %s", state, strings.Join(core.TxnTimeoutStates, ", "))
+		}
+		if timeout < 0 {
+			return fmt.Errorf("txnTimeout of %s state cannot be negative", state)
+		}
+	}
+	return nil
+}
+
+//This function returns the gas strategy of the transactions of each class
+func (*UtilsStruct) GetGasStrategies() (map[string]string, error) {
+	gasStrategies, err := flagSetUtils.GetRootStringToStringGasStrategies()
+	if err != nil {
+		return nil, err
+	}
+	if len(gasStrategies) == 0 {
+		gasStrategies, err = getConfigGasStrategies()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = utils.ValidateGasStrategies(gasStrategies)
+	if err != nil {
+		return nil, err
+	}
+	return gasStrategies, nil
+}
+
+//This function returns the gas strategies of the config file, or of the environment variable in which they are given as reveal=fast,claim=slow
+func getConfigGasStrategies() (map[string]string, error) {
+	if value, ok := viper.Get("gasStrategies").(string); ok {
+		gasStrategies := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			if strings.TrimSpace(pair) == "" {
+				continue
+			}
+			classStrategy := strings.SplitN(pair, "=", 2)
+			if len(classStrategy) != 2 {
+				return nil, fmt.Errorf("invalid gasStrategies %s, it should be of the form reveal=fast,claim=slow", value)
+			}
+			gasStrategies[strings.TrimSpace(classStrategy[0])] = strings.TrimSpace(classStrategy[1])
+		}
+		return gasStrategies, nil
+	}
+	return viper.GetStringMapString("gasStrategies"), nil
+}
+
+//This function returns the maximum gas price in gwei of the transactions, 0 if there is none
+func (*UtilsStruct) GetMaxGasPrice() (int32, error) {
+	maxGasPrice, err := flagSetUtils.GetRootInt32MaxGasPrice()
+	if err != nil {
+		return 0, err
+	}
+	if maxGasPrice == -1 {
+		maxGasPrice = viper.GetInt32("maxGasPrice")
+	}
+	err = validateMaxGasPrice(maxGasPrice)
+	if err != nil {
+		return 0, err
+	}
+	return maxGasPrice, nil
+}
+
+//This function checks that the maximum gas price is not negative
+func validateMaxGasPrice(maxGasPrice int32) error {
+	if maxGasPrice < 0 {
+		return fmt.Errorf("maxGasPrice %d cannot be negative", maxGasPrice)
+	}
+	return nil
+}
+
+//This function returns the gas budget in the native token of the transactions of each period, 0 if there is none
+func (*UtilsStruct) GetGasBudget() (float32, error) {
+	gasBudget, err := flagSetUtils.GetRootFloat32GasBudget()
+	if err != nil {
+		return 0, err
+	}
+	if gasBudget == -1 {
+		gasBudget = float32(viper.GetFloat64("gasBudget"))
+	}
+	err = validateGasBudget(gasBudget)
+	if err != nil {
+		return 0, err
+	}
+	return gasBudget, nil
+}
+
+//This function checks that the gas budget is not negative
+func validateGasBudget(gasBudget float32) error {
+	if gasBudget < 0 {
+		return fmt.Errorf("gasBudget %v cannot be negative", gasBudget)
+	}
+	return nil
+}
+
+//This function returns the period of the gas budget, which is an epoch by default
+func (*UtilsStruct) GetGasBudgetPeriod() (string, error) {
+	gasBudgetPeriod, err := flagSetUtils.GetRootStringGasBudgetPeriod()
+	if err != nil {
+		return "", err
+	}
+	if gasBudgetPeriod == "" {
+		gasBudgetPeriod = viper.GetString("gasBudgetPeriod")
+	}
+	if gasBudgetPeriod == "" {
+		gasBudgetPeriod = core.EpochGasBudgetPeriod
+	}
+	err = utils.ValidateGasBudgetPeriod(gasBudgetPeriod)
+	if err != nil {
+		return "", err
+	}
+	return gasBudgetPeriod, nil
+}
+
+//This function returns how the identifying headers of the requests to the provider and the APIs are sent
+func (*UtilsStruct) GetRequestHeaders() (string, error) {
+	requestHeaders, err := flagSetUtils.GetRootStringRequestHeaders()
+	if err != nil {
+		return "", err
+	}
+	if requestHeaders == "" {
+		requestHeaders = viper.GetString("requestHeaders")
+	}
+	err = validateRequestHeaders(requestHeaders)
+	if err != nil {
+		return "", err
+	}
+	return requestHeaders, nil
+}
+
+//This function checks that the request headers mode is one of the valid modes
+func validateRequestHeaders(requestHeaders string) error {
+	if requestHeaders != "" && !utils.Contains(core.RequestHeadersModes, requestHeaders) {
+		return fmt.Errorf("invalid requestHeaders %s, valid values are %s", requestHeaders, strings.Join(core.RequestHeadersModes, ", "))
+	}
+	return nil
+}
+
+//This function returns the hosts which the APIs of the jobs are allowed to be fetched from
+func (*UtilsStruct) GetAllowedHosts() ([]string, error) {
+	allowedHosts, err := flagSetUtils.GetRootStringSliceAllowedHosts()
+	if err != nil {
+		return nil, err
+	}
+	if len(allowedHosts) == 0 {
+		allowedHosts = splitConfigList(viper.GetStringSlice("allowedHosts"))
+	}
+	return allowedHosts, nil
+}
+
+//This function splits the values of a list which are separated by commas, as in the environment variables
+func splitConfigList(values []string) []string {
+	list := []string{}
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
+
+//This function returns the url of the external signer which signs the transactions, the local keystore is used if it is empty
+func (*UtilsStruct) GetSignerUrl() (string, error) {
+	signerUrl, err := flagSetUtils.GetRootStringSignerUrl()
+	if err != nil {
+		return "", err
+	}
+	if signerUrl == "" {
+		signerUrl = viper.GetString("signerUrl")
+	}
+	err = validateSignerUrl(signerUrl)
+	if err != nil {
+		return "", err
+	}
+	return signerUrl, nil
+}
+
+//This function returns the key of the KMS which signs the transactions, the local keystore is used if it is empty
+func (*UtilsStruct) GetKMSKey() (string, error) {
+	kmsKey, err := flagSetUtils.GetRootStringKMSKey()
+	if err != nil {
+		return "", err
+	}
+	if kmsKey == "" {
+		kmsKey = viper.GetString("kmsKey")
+	}
+	err = utils.ValidateKMSKey(kmsKey)
+	if err != nil {
+		return "", err
+	}
+	return kmsKey, nil
+}
+
+//This function checks that the signer url is an http(s) url
+func validateSignerUrl(signerUrl string) error {
+	if signerUrl != "" && !strings.HasPrefix(signerUrl, "http://") && !strings.HasPrefix(signerUrl, "https://") {
+		return fmt.Errorf("invalid signerUrl %s, the external signer must be reached over http or https", signerUrl)
+	}
+	return nil
+}
+
+//This function returns the provider which serves the heavy reads such as the log scans, the provider serves them if it is empty
+func (*UtilsStruct) GetReadProvider() (string, error) {
+	readProvider, err := flagSetUtils.GetRootStringReadProvider()
+	if err != nil {
+		return "", err
+	}
+	if readProvider == "" {
+		readProvider = viper.GetString("readProvider")
+	}
+	if readProvider != "" && !strings.HasPrefix(readProvider, "https") {
+		log.Warn("You are not using a secure read RPC URL. Switch to an https URL instead to be safe.")
+	}
+	return readProvider, nil
+}
+
+//This function returns the maximum number of blocks whose logs are fetched in a single query
+func (*UtilsStruct) GetLogsChunkSize() (int32, error) {
+	logsChunkSize, err := flagSetUtils.GetRootInt32LogsChunkSize()
+	if err != nil {
+		return core.DefaultLogsChunkSize, err
+	}
+	if logsChunkSize == -1 {
+		logsChunkSize = core.DefaultLogsChunkSize
+		if viper.IsSet("logsChunkSize") {
+			logsChunkSize = viper.GetInt32("logsChunkSize")
+		}
+	}
+	err = validateLogsChunkSize(logsChunkSize)
+	if err != nil {
+		return core.DefaultLogsChunkSize, err
+	}
+	return logsChunkSize, nil
+}
+
+//This function checks that the logs of at least one block are fetched in a query
+func validateLogsChunkSize(logsChunkSize int32) error {
+	if logsChunkSize < 1 {
+		return fmt.Errorf("logsChunkSize %d should be at least 1", logsChunkSize)
+	}
+	return nil
+}
+
+//This function returns the seconds for which the responses of the APIs are reused without being fetched again
+func (*UtilsStruct) GetAPICacheTTL() (int32, error) {
+	apiCacheTTL, err := flagSetUtils.GetRootInt32APICacheTTL()
+	if err != nil {
+		return 0, err
+	}
+	if apiCacheTTL == -1 {
+		apiCacheTTL = viper.GetInt32("apiCacheTTL")
+	}
+	err = validateAPICacheTTL(apiCacheTTL)
+	if err != nil {
+		return 0, err
+	}
+	return apiCacheTTL, nil
+}
+
+//This function checks that the cache TTL of the API responses is not negative
+func validateAPICacheTTL(apiCacheTTL int32) error {
+	if apiCacheTTL < 0 {
+		return fmt.Errorf("apiCacheTTL %d cannot be negative", apiCacheTTL)
+	}
+	return nil
+}
+
+//This function returns the time in seconds after which the requests to the APIs time out
+func (*UtilsStruct) GetHTTPTimeout() (int32, error) {
+	httpTimeout, err := flagSetUtils.GetRootInt32HTTPTimeout()
+	if err != nil {
+		return core.DefaultHTTPTimeout, err
+	}
+	if httpTimeout == -1 {
+		httpTimeout = core.DefaultHTTPTimeout
+		if viper.IsSet("httpTimeout") {
+			httpTimeout = viper.GetInt32("httpTimeout")
+		}
+	}
+	err = validateHTTPTimeout(httpTimeout)
+	if err != nil {
+		return core.DefaultHTTPTimeout, err
+	}
+	return httpTimeout, nil
+}
+
+//This function checks that the timeout of the requests to the APIs is positive
+func validateHTTPTimeout(httpTimeout int32) error {
+	if httpTimeout <= 0 {
+		return fmt.Errorf("httpTimeout %d should be greater than 0", httpTimeout)
+	}
+	return nil
+}
+
+//This function returns the number of attempts at a request to the APIs
+func (*UtilsStruct) GetHTTPRetryAttempts() (int32, error) {
+	httpRetryAttempts, err := flagSetUtils.GetRootInt32HTTPRetryAttempts()
+	if err != nil {
+		return core.DefaultHTTPRetryAttempts, err
+	}
+	if httpRetryAttempts == -1 {
+		httpRetryAttempts = core.DefaultHTTPRetryAttempts
+		if viper.IsSet("httpRetryAttempts") {
+			httpRetryAttempts = viper.GetInt32("httpRetryAttempts")
+		}
+	}
+	err = validateHTTPRetryAttempts(httpRetryAttempts)
+	if err != nil {
+		return core.DefaultHTTPRetryAttempts, err
+	}
+	return httpRetryAttempts, nil
+}
+
+//This function checks that a request to the APIs is attempted at least once
+func validateHTTPRetryAttempts(httpRetryAttempts int32) error {
+	if httpRetryAttempts < 1 {
+		return fmt.Errorf("httpRetryAttempts %d should be at least 1", httpRetryAttempts)
+	}
+	return nil
+}
+
+//This function returns the delay in seconds before a failed request to the APIs is retried
+func (*UtilsStruct) GetHTTPRetryDelay() (int32, error) {
+	httpRetryDelay, err := flagSetUtils.GetRootInt32HTTPRetryDelay()
+	if err != nil {
+		return core.DefaultHTTPRetryDelay, err
+	}
+	if httpRetryDelay == -1 {
+		httpRetryDelay = core.DefaultHTTPRetryDelay
+		if viper.IsSet("httpRetryDelay") {
+			httpRetryDelay = viper.GetInt32("httpRetryDelay")
+		}
+	}
+	err = validateHTTPRetryDelay(httpRetryDelay)
+	if err != nil {
+		return core.DefaultHTTPRetryDelay, err
+	}
+	return httpRetryDelay, nil
+}
+
+//This function checks that the delay before a request to the APIs is retried is not negative
+func validateHTTPRetryDelay(httpRetryDelay int32) error {
+	if httpRetryDelay < 0 {
+		return fmt.Errorf("httpRetryDelay %d cannot be negative", httpRetryDelay)
+	}
+	return nil
+}
+
+//This function returns the proxy which the requests to the APIs are sent through, the proxy of the HTTPS_PROXY and HTTP_PROXY environment variables is used if it is empty
+func (*UtilsStruct) GetHTTPProxy() (string, error) {
+	httpProxy, err := flagSetUtils.GetRootStringHTTPProxy()
+	if err != nil {
+		return "", err
+	}
+	if httpProxy == "" {
+		httpProxy = viper.GetString("httpProxy")
+	}
+	err = validateHTTPProxy(httpProxy)
+	if err != nil {
+		return "", err
+	}
+	return httpProxy, nil
+}
+
+//This function checks that the proxy is a url with one of the supported schemes
+func validateHTTPProxy(httpProxy string) error {
+	if httpProxy == "" {
+		return nil
+	}
+	proxyUrl, err := url.Parse(httpProxy)
+	if err != nil {
+		return fmt.Errorf("invalid httpProxy %s: %s", httpProxy, err)
+	}
+	if !utils.Contains(core.HTTPProxySchemes, proxyUrl.Scheme) || proxyUrl.Host == "" {
+		return fmt.Errorf("invalid httpProxy %s, the proxy must be a url with one of the schemes %s", httpProxy, strings.Join(core.HTTPProxySchemes, ", "))
+	}
+	return nil
+}
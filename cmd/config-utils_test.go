@@ -3,9 +3,13 @@ package cmd
 import (
 	"errors"
 	"razor/cmd/mocks"
+	"razor/core"
 	"razor/core/types"
+	"razor/utils"
 	"reflect"
 	"testing"
+
+	"github.com/spf13/viper"
 )
 
 func TestGetConfigData(t *testing.T) {
@@ -25,23 +29,70 @@ func TestGetConfigData(t *testing.T) {
 		WaitTime:           1,
 		LogLevel:           "debug",
 		GasLimitMultiplier: 3,
+		TxnTimeouts:        map[string]int{"commit": 60},
+		GasStrategies:      map[string]string{"dispute": "fast"},
+		MaxGasPrice:        100,
+		GasBudget:          0.5,
+		GasBudgetPeriod:    "day",
+		RequestHeaders:     "omit",
+		AllowedHosts:       []string{"api.gemini.com"},
+		SignerUrl:          "http://localhost:9000",
+		ReadProvider:       "https://read.example.com",
+		LogsChunkSize:      2000,
+		APICacheTTL:        30,
+		HTTPTimeout:        20,
+		HTTPRetryAttempts:  3,
+		HTTPRetryDelay:     1,
+		HTTPProxy:          "socks5://127.0.0.1:1080",
 	}
 
 	type args struct {
-		provider         string
-		providerErr      error
-		gasMultiplier    float32
-		gasMultiplierErr error
-		bufferPercent    int32
-		bufferPercentErr error
-		waitTime         int32
-		waitTimeErr      error
-		gasPrice         int32
-		gasPriceErr      error
-		logLevel         string
-		logLevelErr      error
-		gasLimit         float32
-		gasLimitErr      error
+		provider             string
+		providerErr          error
+		gasMultiplier        float32
+		gasMultiplierErr     error
+		bufferPercent        int32
+		bufferPercentErr     error
+		waitTime             int32
+		waitTimeErr          error
+		gasPrice             int32
+		gasPriceErr          error
+		logLevel             string
+		logLevelErr          error
+		gasLimit             float32
+		gasLimitErr          error
+		txnTimeouts          map[string]int
+		txnTimeoutsErr       error
+		gasStrategies        map[string]string
+		gasStrategiesErr     error
+		maxGasPrice          int32
+		maxGasPriceErr       error
+		gasBudget            float32
+		gasBudgetErr         error
+		gasBudgetPeriod      string
+		gasBudgetPeriodErr   error
+		requestHeaders       string
+		requestHeadersErr    error
+		allowedHosts         []string
+		allowedHostsErr      error
+		signerUrl            string
+		signerUrlErr         error
+		readProvider         string
+		readProviderErr      error
+		logsChunkSize        int32
+		logsChunkSizeErr     error
+		apiCacheTTL          int32
+		apiCacheTTLErr       error
+		httpTimeout          int32
+		httpTimeoutErr       error
+		httpRetryAttempts    int32
+		httpRetryAttemptsErr error
+		httpRetryDelay       int32
+		httpRetryDelayErr    error
+		httpProxy            string
+		httpProxyErr         error
+		kmsKey               string
+		kmsKeyErr            error
 	}
 	tests := []struct {
 		name    string
@@ -52,12 +103,27 @@ func TestGetConfigData(t *testing.T) {
 		{
 			name: "Test 1: When GetConfigData function executes successfully",
 			args: args{
-				provider:      "",
-				gasMultiplier: 1,
-				bufferPercent: 20,
-				waitTime:      1,
-				logLevel:      "debug",
-				gasLimit:      3,
+				provider:          "",
+				gasMultiplier:     1,
+				bufferPercent:     20,
+				waitTime:          1,
+				logLevel:          "debug",
+				gasLimit:          3,
+				txnTimeouts:       map[string]int{"commit": 60},
+				gasStrategies:     map[string]string{"dispute": "fast"},
+				maxGasPrice:       100,
+				gasBudget:         0.5,
+				gasBudgetPeriod:   "day",
+				requestHeaders:    "omit",
+				allowedHosts:      []string{"api.gemini.com"},
+				signerUrl:         "http://localhost:9000",
+				readProvider:      "https://read.example.com",
+				logsChunkSize:     2000,
+				apiCacheTTL:       30,
+				httpTimeout:       20,
+				httpRetryAttempts: 3,
+				httpRetryDelay:    1,
+				httpProxy:         "socks5://127.0.0.1:1080",
 			},
 			want:    configData,
 			wantErr: nil,
@@ -118,6 +184,143 @@ func TestGetConfigData(t *testing.T) {
 			want:    config,
 			wantErr: errors.New("gasLimit error"),
 		},
+		{
+			name: "Test 9: When there is an error in getting txnTimeouts",
+			args: args{
+				txnTimeoutsErr: errors.New("txnTimeouts error"),
+			},
+			want:    config,
+			wantErr: errors.New("txnTimeouts error"),
+		},
+		{
+			name: "Test 10: When there is an error in getting requestHeaders",
+			args: args{
+				requestHeadersErr: errors.New("requestHeaders error"),
+			},
+			want:    config,
+			wantErr: errors.New("requestHeaders error"),
+		},
+		{
+			name: "Test 11: When there is an error in getting allowedHosts",
+			args: args{
+				allowedHostsErr: errors.New("allowedHosts error"),
+			},
+			want:    config,
+			wantErr: errors.New("allowedHosts error"),
+		},
+		{
+			name: "Test 12: When there is an error in getting signerUrl",
+			args: args{
+				signerUrlErr: errors.New("signerUrl error"),
+			},
+			want:    config,
+			wantErr: errors.New("signerUrl error"),
+		},
+		{
+			name: "Test 13: When there is an error in getting readProvider",
+			args: args{
+				readProviderErr: errors.New("readProvider error"),
+			},
+			want:    config,
+			wantErr: errors.New("readProvider error"),
+		},
+		{
+			name: "Test 14: When there is an error in getting apiCacheTTL",
+			args: args{
+				apiCacheTTLErr: errors.New("apiCacheTTL error"),
+			},
+			want:    config,
+			wantErr: errors.New("apiCacheTTL error"),
+		},
+		{
+			name: "Test 15: When there is an error in getting httpTimeout",
+			args: args{
+				httpTimeoutErr: errors.New("httpTimeout error"),
+			},
+			want:    config,
+			wantErr: errors.New("httpTimeout error"),
+		},
+		{
+			name: "Test 16: When there is an error in getting httpRetryAttempts",
+			args: args{
+				httpRetryAttemptsErr: errors.New("httpRetryAttempts error"),
+			},
+			want:    config,
+			wantErr: errors.New("httpRetryAttempts error"),
+		},
+		{
+			name: "Test 17: When there is an error in getting httpRetryDelay",
+			args: args{
+				httpRetryDelayErr: errors.New("httpRetryDelay error"),
+			},
+			want:    config,
+			wantErr: errors.New("httpRetryDelay error"),
+		},
+		{
+			name: "Test 18: When there is an error in getting httpProxy",
+			args: args{
+				httpProxyErr: errors.New("httpProxy error"),
+			},
+			want:    config,
+			wantErr: errors.New("httpProxy error"),
+		},
+		{
+			name: "Test 19: When there is an error in getting kmsKey",
+			args: args{
+				kmsKeyErr: errors.New("kmsKey error"),
+			},
+			want:    config,
+			wantErr: errors.New("kmsKey error"),
+		},
+		{
+			name: "Test 20: When both signerUrl and kmsKey are set",
+			args: args{
+				signerUrl: "http://localhost:9000",
+				kmsKey:    "awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+			want:    config,
+			wantErr: errors.New("the transactions are signed either by the external signer or by the KMS, signerUrl and kmsKey can't both be set"),
+		},
+		{
+			name: "Test 21: When there is an error in getting gasStrategies",
+			args: args{
+				gasStrategiesErr: errors.New("gasStrategies error"),
+			},
+			want:    config,
+			wantErr: errors.New("gasStrategies error"),
+		},
+		{
+			name: "Test 22: When there is an error in getting maxGasPrice",
+			args: args{
+				maxGasPriceErr: errors.New("maxGasPrice error"),
+			},
+			want:    config,
+			wantErr: errors.New("maxGasPrice error"),
+		},
+		{
+			name: "Test 23: When there is an error in getting gasBudget",
+			args: args{
+				gasBudgetErr: errors.New("gasBudget error"),
+			},
+			want:    config,
+			wantErr: errors.New("gasBudget error"),
+		},
+		{
+			name: "Test 24: When there is an error in getting gasBudgetPeriod",
+			args: args{
+				gasBudgetPeriodErr: errors.New("gasBudgetPeriod error"),
+			},
+			want:    config,
+			wantErr: errors.New("gasBudgetPeriod error"),
+		},
+		{
+			name: "Test 25: When there is an error in getting logsChunkSize",
+			args: args{
+				logsChunkSizeErr: errors.New("logsChunkSize error"),
+			},
+			want:    config,
+			wantErr: errors.New("logsChunkSize error"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,6 +334,28 @@ func TestGetConfigData(t *testing.T) {
 			cmdUtilsMock.On("GetLogLevel").Return(tt.args.logLevel, tt.args.logLevelErr)
 			cmdUtilsMock.On("GetGasLimit").Return(tt.args.gasLimit, tt.args.gasLimitErr)
 			cmdUtilsMock.On("GetBufferPercent").Return(tt.args.bufferPercent, tt.args.bufferPercentErr)
+			cmdUtilsMock.On("GetTxnTimeouts").Return(tt.args.txnTimeouts, tt.args.txnTimeoutsErr)
+			cmdUtilsMock.On("GetGasStrategies").Return(tt.args.gasStrategies, tt.args.gasStrategiesErr)
+			cmdUtilsMock.On("GetMaxGasPrice").Return(tt.args.maxGasPrice, tt.args.maxGasPriceErr)
+			cmdUtilsMock.On("GetGasBudget").Return(tt.args.gasBudget, tt.args.gasBudgetErr)
+			cmdUtilsMock.On("GetGasBudgetPeriod").Return(tt.args.gasBudgetPeriod, tt.args.gasBudgetPeriodErr)
+			cmdUtilsMock.On("GetRequestHeaders").Return(tt.args.requestHeaders, tt.args.requestHeadersErr)
+			cmdUtilsMock.On("GetAllowedHosts").Return(tt.args.allowedHosts, tt.args.allowedHostsErr)
+			cmdUtilsMock.On("GetSignerUrl").Return(tt.args.signerUrl, tt.args.signerUrlErr)
+			cmdUtilsMock.On("GetKMSKey").Return(tt.args.kmsKey, tt.args.kmsKeyErr)
+			cmdUtilsMock.On("GetReadProvider").Return(tt.args.readProvider, tt.args.readProviderErr)
+			cmdUtilsMock.On("GetLogsChunkSize").Return(tt.args.logsChunkSize, tt.args.logsChunkSizeErr)
+			cmdUtilsMock.On("GetAPICacheTTL").Return(tt.args.apiCacheTTL, tt.args.apiCacheTTLErr)
+			cmdUtilsMock.On("GetHTTPTimeout").Return(tt.args.httpTimeout, tt.args.httpTimeoutErr)
+			cmdUtilsMock.On("GetHTTPRetryAttempts").Return(tt.args.httpRetryAttempts, tt.args.httpRetryAttemptsErr)
+			cmdUtilsMock.On("GetHTTPRetryDelay").Return(tt.args.httpRetryDelay, tt.args.httpRetryDelayErr)
+			cmdUtilsMock.On("GetHTTPProxy").Return(tt.args.httpProxy, tt.args.httpProxyErr)
+			defer utils.SetRemoteSigner("")
+			defer utils.SetKMSSigner("")
+			defer utils.SetReadProvider("")
+			defer utils.SetLogsChunkSize(core.DefaultLogsChunkSize)
+			defer utils.SetAPICacheTTL(0)
+			defer utils.SetHTTPOptions(core.DefaultHTTPTimeout, core.DefaultHTTPRetryAttempts, core.DefaultHTTPRetryDelay, "")
 
 			utils := &UtilsStruct{}
 
@@ -584,3 +809,1129 @@ func TestGetWaitTime(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTxnTimeouts(t *testing.T) {
+	type args struct {
+		txnTimeouts       map[string]int
+		txnTimeoutsErr    error
+		configTxnTimeouts interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetTxnTimeouts function executes successfully",
+			args: args{
+				txnTimeouts: map[string]int{"commit": 60, "propose": 120},
+			},
+			want:    map[string]int{"commit": 60, "propose": 120},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When txnTimeouts are not passed",
+			args: args{
+				txnTimeouts: map[string]int{},
+			},
+			want:    map[string]int{},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting txnTimeouts",
+			args: args{
+				txnTimeoutsErr: errors.New("txnTimeouts error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When txnTimeouts are passed for an invalid state",
+			args: args{
+				txnTimeouts: map[string]int{"vote": 60},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When a txnTimeout is negative",
+			args: args{
+				txnTimeouts: map[string]int{"reveal": -1},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When txnTimeouts are set in the config file",
+			args: args{
+				txnTimeouts:       map[string]int{},
+				configTxnTimeouts: map[string]interface{}{"commit": 60, "reveal": 90},
+			},
+			want:    map[string]int{"commit": 60, "reveal": 90},
+			wantErr: false,
+		},
+		{
+			name: "Test 7: When txnTimeouts are set in the environment variable",
+			args: args{
+				txnTimeouts:       map[string]int{},
+				configTxnTimeouts: "commit=60, reveal=90",
+			},
+			want:    map[string]int{"commit": 60, "reveal": 90},
+			wantErr: false,
+		},
+		{
+			name: "Test 8: When txnTimeouts of the environment variable are not of the form commit=60",
+			args: args{
+				txnTimeouts:       map[string]int{},
+				configTxnTimeouts: "commit:60",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	defer viper.Reset()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			viper.Reset()
+			if tt.args.configTxnTimeouts != nil {
+				viper.Set("txnTimeouts", tt.args.configTxnTimeouts)
+			}
+			flagSetUtilsMock.On("GetRootStringToIntTxnTimeouts").Return(tt.args.txnTimeouts, tt.args.txnTimeoutsErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetTxnTimeouts()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTxnTimeouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTxnTimeouts() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGasStrategies(t *testing.T) {
+	type args struct {
+		gasStrategies       map[string]string
+		gasStrategiesErr    error
+		configGasStrategies interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetGasStrategies function executes successfully",
+			args: args{
+				gasStrategies: map[string]string{"reveal": "fast", "claim": "slow"},
+			},
+			want:    map[string]string{"reveal": "fast", "claim": "slow"},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When gasStrategies are not passed",
+			args: args{
+				gasStrategies: map[string]string{},
+			},
+			want:    map[string]string{},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting gasStrategies",
+			args: args{
+				gasStrategiesErr: errors.New("gasStrategies error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When a gas strategy is passed for an invalid class",
+			args: args{
+				gasStrategies: map[string]string{"vote": "fast"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When a gas strategy is invalid",
+			args: args{
+				gasStrategies: map[string]string{"dispute": "fastest"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When gasStrategies are set in the config file",
+			args: args{
+				gasStrategies:       map[string]string{},
+				configGasStrategies: map[string]interface{}{"dispute": "oracle:https://gas.example.com/api#fast", "default": "standard"},
+			},
+			want:    map[string]string{"dispute": "oracle:https://gas.example.com/api#fast", "default": "standard"},
+			wantErr: false,
+		},
+		{
+			name: "Test 7: When gasStrategies are set in the environment variable",
+			args: args{
+				gasStrategies:       map[string]string{},
+				configGasStrategies: "reveal=fast, claim=slow",
+			},
+			want:    map[string]string{"reveal": "fast", "claim": "slow"},
+			wantErr: false,
+		},
+		{
+			name: "Test 8: When gasStrategies of the environment variable are not of the form reveal=fast",
+			args: args{
+				gasStrategies:       map[string]string{},
+				configGasStrategies: "reveal:fast",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	defer viper.Reset()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			viper.Reset()
+			if tt.args.configGasStrategies != nil {
+				viper.Set("gasStrategies", tt.args.configGasStrategies)
+			}
+			flagSetUtilsMock.On("GetRootStringToStringGasStrategies").Return(tt.args.gasStrategies, tt.args.gasStrategiesErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetGasStrategies()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetGasStrategies() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetGasStrategies() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetMaxGasPrice(t *testing.T) {
+	type args struct {
+		maxGasPrice       int32
+		maxGasPriceErr    error
+		configMaxGasPrice interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetMaxGasPrice function executes successfully",
+			args: args{
+				maxGasPrice: 100,
+			},
+			want:    100,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When maxGasPrice is not passed and is set in the config file",
+			args: args{
+				maxGasPrice:       -1,
+				configMaxGasPrice: 50,
+			},
+			want:    50,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When maxGasPrice is neither passed nor set in the config file",
+			args: args{
+				maxGasPrice: -1,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting maxGasPrice",
+			args: args{
+				maxGasPriceErr: errors.New("maxGasPrice error"),
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When maxGasPrice of the config file is negative",
+			args: args{
+				maxGasPrice:       -1,
+				configMaxGasPrice: -5,
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	defer viper.Reset()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			viper.Reset()
+			if tt.args.configMaxGasPrice != nil {
+				viper.Set("maxGasPrice", tt.args.configMaxGasPrice)
+			}
+			flagSetUtilsMock.On("GetRootInt32MaxGasPrice").Return(tt.args.maxGasPrice, tt.args.maxGasPriceErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetMaxGasPrice()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMaxGasPrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetMaxGasPrice() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGasBudget(t *testing.T) {
+	type args struct {
+		gasBudget       float32
+		gasBudgetErr    error
+		configGasBudget interface{}
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    float32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetGasBudget function executes successfully",
+			args: args{
+				gasBudget: 0.5,
+			},
+			want:    0.5,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When gasBudget is not passed and is set in the config file",
+			args: args{
+				gasBudget:       -1,
+				configGasBudget: 0.25,
+			},
+			want:    0.25,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When gasBudget is neither passed nor set in the config file",
+			args: args{
+				gasBudget: -1,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting gasBudget",
+			args: args{
+				gasBudgetErr: errors.New("gasBudget error"),
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When gasBudget of the config file is negative",
+			args: args{
+				gasBudget:       -1,
+				configGasBudget: -2,
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	defer viper.Reset()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			viper.Reset()
+			if tt.args.configGasBudget != nil {
+				viper.Set("gasBudget", tt.args.configGasBudget)
+			}
+			flagSetUtilsMock.On("GetRootFloat32GasBudget").Return(tt.args.gasBudget, tt.args.gasBudgetErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetGasBudget()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetGasBudget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetGasBudget() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGasBudgetPeriod(t *testing.T) {
+	type args struct {
+		gasBudgetPeriod       string
+		gasBudgetPeriodErr    error
+		configGasBudgetPeriod string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetGasBudgetPeriod function executes successfully",
+			args: args{
+				gasBudgetPeriod: "day",
+			},
+			want:    "day",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When gasBudgetPeriod is not passed and is set in the config file",
+			args: args{
+				configGasBudgetPeriod: "day",
+			},
+			want:    "day",
+			wantErr: false,
+		},
+		{
+			name:    "Test 3: When gasBudgetPeriod is neither passed nor set in the config file",
+			args:    args{},
+			want:    "epoch",
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting gasBudgetPeriod",
+			args: args{
+				gasBudgetPeriodErr: errors.New("gasBudgetPeriod error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When gasBudgetPeriod is invalid",
+			args: args{
+				gasBudgetPeriod: "week",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	defer viper.Reset()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			viper.Reset()
+			if tt.args.configGasBudgetPeriod != "" {
+				viper.Set("gasBudgetPeriod", tt.args.configGasBudgetPeriod)
+			}
+			flagSetUtilsMock.On("GetRootStringGasBudgetPeriod").Return(tt.args.gasBudgetPeriod, tt.args.gasBudgetPeriodErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetGasBudgetPeriod()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetGasBudgetPeriod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetGasBudgetPeriod() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRequestHeaders(t *testing.T) {
+	type args struct {
+		requestHeaders    string
+		requestHeadersErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetRequestHeaders function executes successfully",
+			args: args{
+				requestHeaders: "randomize",
+			},
+			want:    "randomize",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When requestHeaders is not passed",
+			args: args{
+				requestHeaders: "",
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting requestHeaders",
+			args: args{
+				requestHeadersErr: errors.New("requestHeaders error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When requestHeaders is invalid",
+			args: args{
+				requestHeaders: "hide",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringRequestHeaders").Return(tt.args.requestHeaders, tt.args.requestHeadersErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetRequestHeaders()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRequestHeaders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetRequestHeaders() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAllowedHosts(t *testing.T) {
+	type args struct {
+		allowedHosts    []string
+		allowedHostsErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetAllowedHosts function executes successfully",
+			args: args{
+				allowedHosts: []string{"api.gemini.com", "api.kraken.com"},
+			},
+			want:    []string{"api.gemini.com", "api.kraken.com"},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting allowedHosts",
+			args: args{
+				allowedHostsErr: errors.New("allowedHosts error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringSliceAllowedHosts").Return(tt.args.allowedHosts, tt.args.allowedHostsErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetAllowedHosts()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAllowedHosts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAllowedHosts() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSignerUrl(t *testing.T) {
+	type args struct {
+		signerUrl    string
+		signerUrlErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetSignerUrl function executes successfully",
+			args: args{
+				signerUrl: "http://localhost:9000",
+			},
+			want:    "http://localhost:9000",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When signerUrl is not passed",
+			args: args{
+				signerUrl: "",
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting signerUrl",
+			args: args{
+				signerUrlErr: errors.New("signerUrl error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When signerUrl is not an http url",
+			args: args{
+				signerUrl: "/home/user/.clef/clef.ipc",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringSignerUrl").Return(tt.args.signerUrl, tt.args.signerUrlErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetSignerUrl()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSignerUrl() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetSignerUrl() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetKMSKey(t *testing.T) {
+	type args struct {
+		kmsKey    string
+		kmsKeyErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the key of AWS KMS is passed",
+			args: args{
+				kmsKey: "awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+			want:    "awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the key of GCP Cloud KMS is passed",
+			args: args{
+				kmsKey: "gcpkms://projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1/cryptoKeyVersions/1",
+			},
+			want:    "gcpkms://projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1/cryptoKeyVersions/1",
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When kmsKey is not passed",
+			args: args{
+				kmsKey: "",
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting kmsKey",
+			args: args{
+				kmsKeyErr: errors.New("kmsKey error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the KMS of kmsKey is not supported",
+			args: args{
+				kmsKey: "vault://127.0.0.1:8200/transit/keys/staker1",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringKMSKey").Return(tt.args.kmsKey, tt.args.kmsKeyErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetKMSKey()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetKMSKey() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetKMSKey() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetReadProvider(t *testing.T) {
+	type args struct {
+		readProvider    string
+		readProviderErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetReadProvider function executes successfully",
+			args: args{
+				readProvider: "https://read.example.com",
+			},
+			want:    "https://read.example.com",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When readProvider is not passed",
+			args: args{
+				readProvider: "",
+			},
+			want:    "",
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting readProvider",
+			args: args{
+				readProviderErr: errors.New("readProvider error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringReadProvider").Return(tt.args.readProvider, tt.args.readProviderErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetReadProvider()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReadProvider() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetReadProvider() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAPICacheTTL(t *testing.T) {
+	type args struct {
+		apiCacheTTL    int32
+		apiCacheTTLErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetAPICacheTTL function executes successfully",
+			args: args{
+				apiCacheTTL: 30,
+			},
+			want:    30,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When apiCacheTTL is not passed",
+			args: args{
+				apiCacheTTL: -1,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting apiCacheTTL",
+			args: args{
+				apiCacheTTLErr: errors.New("apiCacheTTL error"),
+			},
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When apiCacheTTL is negative",
+			args: args{
+				apiCacheTTL: -5,
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootInt32APICacheTTL").Return(tt.args.apiCacheTTL, tt.args.apiCacheTTLErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetAPICacheTTL()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetAPICacheTTL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetAPICacheTTL() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHTTPTimeout(t *testing.T) {
+	type args struct {
+		httpTimeout    int32
+		httpTimeoutErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetHTTPTimeout function executes successfully",
+			args: args{
+				httpTimeout: 20,
+			},
+			want:    20,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When httpTimeout is not passed",
+			args: args{
+				httpTimeout: -1,
+			},
+			want:    core.DefaultHTTPTimeout,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting httpTimeout",
+			args: args{
+				httpTimeoutErr: errors.New("httpTimeout error"),
+			},
+			want:    core.DefaultHTTPTimeout,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When httpTimeout is 0",
+			args: args{
+				httpTimeout: 0,
+			},
+			want:    core.DefaultHTTPTimeout,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootInt32HTTPTimeout").Return(tt.args.httpTimeout, tt.args.httpTimeoutErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetHTTPTimeout()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHTTPTimeout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetHTTPTimeout() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHTTPRetryAttempts(t *testing.T) {
+	type args struct {
+		httpRetryAttempts    int32
+		httpRetryAttemptsErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetHTTPRetryAttempts function executes successfully",
+			args: args{
+				httpRetryAttempts: 5,
+			},
+			want:    5,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When httpRetryAttempts is not passed",
+			args: args{
+				httpRetryAttempts: -1,
+			},
+			want:    core.DefaultHTTPRetryAttempts,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting httpRetryAttempts",
+			args: args{
+				httpRetryAttemptsErr: errors.New("httpRetryAttempts error"),
+			},
+			want:    core.DefaultHTTPRetryAttempts,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When httpRetryAttempts is 0",
+			args: args{
+				httpRetryAttempts: 0,
+			},
+			want:    core.DefaultHTTPRetryAttempts,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootInt32HTTPRetryAttempts").Return(tt.args.httpRetryAttempts, tt.args.httpRetryAttemptsErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetHTTPRetryAttempts()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHTTPRetryAttempts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetHTTPRetryAttempts() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHTTPRetryDelay(t *testing.T) {
+	type args struct {
+		httpRetryDelay    int32
+		httpRetryDelayErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetHTTPRetryDelay function executes successfully",
+			args: args{
+				httpRetryDelay: 0,
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When httpRetryDelay is not passed",
+			args: args{
+				httpRetryDelay: -1,
+			},
+			want:    core.DefaultHTTPRetryDelay,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting httpRetryDelay",
+			args: args{
+				httpRetryDelayErr: errors.New("httpRetryDelay error"),
+			},
+			want:    core.DefaultHTTPRetryDelay,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When httpRetryDelay is negative",
+			args: args{
+				httpRetryDelay: -5,
+			},
+			want:    core.DefaultHTTPRetryDelay,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootInt32HTTPRetryDelay").Return(tt.args.httpRetryDelay, tt.args.httpRetryDelayErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetHTTPRetryDelay()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHTTPRetryDelay() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetHTTPRetryDelay() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHTTPProxy(t *testing.T) {
+	type args struct {
+		httpProxy    string
+		httpProxyErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetHTTPProxy function executes successfully",
+			args: args{
+				httpProxy: "http://proxy.example.com:3128",
+			},
+			want:    "http://proxy.example.com:3128",
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the proxy is a socks5 proxy",
+			args: args{
+				httpProxy: "socks5://127.0.0.1:1080",
+			},
+			want:    "socks5://127.0.0.1:1080",
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting httpProxy",
+			args: args{
+				httpProxyErr: errors.New("httpProxy error"),
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the scheme of the proxy is not supported",
+			args: args{
+				httpProxy: "ftp://proxy.example.com",
+			},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the proxy has no host",
+			args: args{
+				httpProxy: "proxy.example.com:3128",
+			},
+			want:    "",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootStringHTTPProxy").Return(tt.args.httpProxy, tt.args.httpProxyErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetHTTPProxy()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHTTPProxy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetHTTPProxy() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLogsChunkSize(t *testing.T) {
+	type args struct {
+		logsChunkSize    int32
+		logsChunkSizeErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetLogsChunkSize function executes successfully",
+			args: args{
+				logsChunkSize: 2000,
+			},
+			want:    2000,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When logsChunkSize is not passed",
+			args: args{
+				logsChunkSize: -1,
+			},
+			want:    core.DefaultLogsChunkSize,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting logsChunkSize",
+			args: args{
+				logsChunkSizeErr: errors.New("logsChunkSize error"),
+			},
+			want:    core.DefaultLogsChunkSize,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When logsChunkSize is 0",
+			args: args{
+				logsChunkSize: 0,
+			},
+			want:    core.DefaultLogsChunkSize,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetUtilsMock
+
+			flagSetUtilsMock.On("GetRootInt32LogsChunkSize").Return(tt.args.logsChunkSize, tt.args.logsChunkSizeErr)
+			utils := &UtilsStruct{}
+			got, err := utils.GetLogsChunkSize()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetLogsChunkSize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetLogsChunkSize() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+//Kinds of the values of the config keys
+const (
+	configKindString = "string"
+	configKindNumber = "number"
+	configKindList   = "list"
+	configKindMap    = "map"
+)
+
+//A key of the config file with its section and the environment variable which overrides it
+//The key is the top level name which setConfig wrote before the config file had sections, it is still read and is used by the code
+type configKey struct {
+	key     string
+	section string
+	env     string
+	kind    string
+}
+
+//The keys of the config file, which can be YAML or TOML
+var configKeys = []configKey{
+	{key: "provider", section: "rpc.provider", env: "RAZOR_PROVIDER", kind: configKindString},
+	{key: "readProvider", section: "rpc.readProvider", env: "RAZOR_READ_PROVIDER", kind: configKindString},
+	{key: "logsChunkSize", section: "rpc.logsChunkSize", env: "RAZOR_LOGS_CHUNK_SIZE", kind: configKindNumber},
+	{key: "chainId", section: "rpc.chainId", env: "RAZOR_CHAIN_ID", kind: configKindNumber},
+	{key: "network", section: "rpc.network", env: "RAZOR_NETWORK", kind: configKindString},
+	{key: "addressesFile", section: "rpc.addressesFile", env: "RAZOR_ADDRESSES_FILE", kind: configKindString},
+	{key: "gasmultiplier", section: "gas.multiplier", env: "RAZOR_GAS_MULTIPLIER", kind: configKindNumber},
+	{key: "gasprice", section: "gas.price", env: "RAZOR_GAS_PRICE", kind: configKindNumber},
+	{key: "gasLimit", section: "gas.limit", env: "RAZOR_GAS_LIMIT", kind: configKindNumber},
+	{key: "gasStrategies", section: "gas.strategies", env: "RAZOR_GAS_STRATEGIES", kind: configKindMap},
+	{key: "maxGasPrice", section: "gas.maxPrice", env: "RAZOR_MAX_GAS_PRICE", kind: configKindNumber},
+	{key: "gasBudget", section: "gas.budget", env: "RAZOR_GAS_BUDGET", kind: configKindNumber},
+	{key: "gasBudgetPeriod", section: "gas.budgetPeriod", env: "RAZOR_GAS_BUDGET_PERIOD", kind: configKindString},
+	{key: "buffer", section: "vote.buffer", env: "RAZOR_BUFFER", kind: configKindNumber},
+	{key: "wait", section: "vote.wait", env: "RAZOR_WAIT", kind: configKindNumber},
+	{key: "txnTimeouts", section: "vote.txnTimeouts", env: "RAZOR_TXN_TIMEOUTS", kind: configKindMap},
+	{key: "logLevel", section: "log.level", env: "RAZOR_LOG_LEVEL", kind: configKindString},
+	{key: "signerUrl", section: "signer.url", env: "RAZOR_SIGNER_URL", kind: configKindString},
+	{key: "kmsKey", section: "signer.kmsKey", env: "RAZOR_KMS_KEY", kind: configKindString},
+	{key: "requestHeaders", section: "http.requestHeaders", env: "RAZOR_REQUEST_HEADERS", kind: configKindString},
+	{key: "allowedHosts", section: "http.allowedHosts", env: "RAZOR_ALLOWED_HOSTS", kind: configKindList},
+	{key: "apiCacheTTL", section: "http.cacheTTL", env: "RAZOR_API_CACHE_TTL", kind: configKindNumber},
+	{key: "httpTimeout", section: "http.timeout", env: "RAZOR_HTTP_TIMEOUT", kind: configKindNumber},
+	{key: "httpRetryAttempts", section: "http.retryAttempts", env: "RAZOR_HTTP_RETRY_ATTEMPTS", kind: configKindNumber},
+	{key: "httpRetryDelay", section: "http.retryDelay", env: "RAZOR_HTTP_RETRY_DELAY", kind: configKindNumber},
+	{key: "httpProxy", section: "http.proxy", env: "RAZOR_HTTP_PROXY", kind: configKindString},
+	{key: "exposeMetricsPort", section: "metrics.port", env: "RAZOR_METRICS_PORT", kind: configKindString},
+	{key: "alertWebhooks", section: "alert.webhooks", env: "RAZOR_ALERT_WEBHOOKS", kind: configKindList},
+}
+
+//This function makes the keys readable by their top level names from their sections
+//The values of the top level names in the config file are moved into their sections, so it should be called after the config file is read
+func registerConfigAliases(v *viper.Viper) {
+	for _, configKey := range configKeys {
+		v.RegisterAlias(configKey.key, configKey.section)
+	}
+}
+
+//This function binds the keys to their RAZOR_* environment variables, which override the config file
+func bindConfigEnv(v *viper.Viper) {
+	for _, configKey := range configKeys {
+		_ = v.BindEnv(configKey.section, configKey.env)
+	}
+}
+
+//This function reads the config file again, the values of the top level keys are moved into their sections as when the file is read at startup
+//The config is not changed if the file can't be read
+func rereadConfigFile(v *viper.Viper) error {
+	file := viper.New()
+	file.SetConfigFile(v.ConfigFileUsed())
+	if err := file.ReadInConfig(); err != nil {
+		return err
+	}
+	if err := v.ReadInConfig(); err != nil {
+		return err
+	}
+	sections := viper.New()
+	for _, configKey := range configKeys {
+		if file.IsSet(configKey.key) && !file.IsSet(configKey.section) {
+			sections.Set(configKey.section, file.Get(configKey.key))
+		}
+	}
+	return v.MergeConfigMap(sections.AllSettings())
+}
+
+//This function writes the config to the file with every key in its section
+//The keys of the file which are not changed are kept and the values of the environment variables are not written to the file
+func writeConfigFile(v *viper.Viper, path string) error {
+	file := viper.New()
+	file.SetConfigFile(path)
+	if err := file.ReadInConfig(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	registerConfigAliases(file)
+
+	topLevelKeys := make(map[string]bool)
+	for _, configKey := range configKeys {
+		topLevelKeys[strings.ToLower(configKey.key)] = true
+	}
+	config := viper.New()
+	for _, key := range file.AllKeys() {
+		if !topLevelKeys[key] {
+			config.Set(key, file.Get(key))
+		}
+	}
+	for _, configKey := range configKeys {
+		if os.Getenv(configKey.env) != "" || !v.IsSet(configKey.section) {
+			continue
+		}
+		config.Set(configKey.section, v.Get(configKey.section))
+	}
+	return config.WriteConfigAs(path)
+}
+
+//This function returns the key of the config file which the name is of, the states of the map keys are part of the name
+func findConfigKey(name string) (configKey, bool) {
+	for _, configKey := range configKeys {
+		for _, key := range []string{strings.ToLower(configKey.key), strings.ToLower(configKey.section)} {
+			if name == key || (configKey.kind == configKindMap && strings.HasPrefix(name, key+".")) {
+				return configKey, true
+			}
+		}
+	}
+	return configKey{}, false
+}
+
+//This function checks that the value of the key is of the kind of the key
+func validateConfigValue(configKey configKey, value interface{}) error {
+	kind := reflect.ValueOf(value).Kind()
+	isMap := kind == reflect.Map
+	isList := kind == reflect.Slice || kind == reflect.Array
+	switch configKey.kind {
+	case configKindNumber:
+		if _, err := strconv.ParseFloat(fmt.Sprint(value), 64); isMap || isList || err != nil {
+			return fmt.Errorf("%s should be a number", configKey.section)
+		}
+	case configKindString:
+		if isMap || isList {
+			return fmt.Errorf("%s should be a string", configKey.section)
+		}
+	case configKindList:
+		if isMap {
+			return fmt.Errorf("%s should be a list", configKey.section)
+		}
+	case configKindMap:
+		if !isMap && kind != reflect.String {
+			return fmt.Errorf("%s should be a map", configKey.section)
+		}
+	}
+	return nil
+}
+
+//This function checks that the config file has only known keys, each set once and with a value of its kind
+func validateConfigFile(file *viper.Viper) error {
+	var errs []string
+	keys := file.AllKeys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := findConfigKey(key); !ok {
+			errs = append(errs, "unknown key "+key)
+		}
+	}
+	for _, configKey := range configKeys {
+		name := configKey.section
+		if file.IsSet(configKey.key) {
+			if file.IsSet(configKey.section) {
+				errs = append(errs, fmt.Sprintf("%s is set both as %s and in its section as %s", configKey.key, configKey.key, configKey.section))
+				continue
+			}
+			name = configKey.key
+		}
+		if !file.IsSet(name) {
+			continue
+		}
+		if err := validateConfigValue(configKey, file.Get(name)); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
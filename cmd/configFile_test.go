@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+//This function reads the config file of the content with the aliases and environment variables of the keys
+func readTestConfigFile(t *testing.T, name string, content string) (*viper.Viper, string) {
+	configFilePath := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(configFilePath, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	v := viper.New()
+	v.SetConfigFile(configFilePath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	registerConfigAliases(v)
+	bindConfigEnv(v)
+	return v, configFilePath
+}
+
+func TestRegisterConfigAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "Test 1: When the YAML config file has the top level keys set by setConfig",
+			file: "razor.yaml",
+			content: `provider: https://rpc.razor.network
+gasmultiplier: 1.5
+buffer: 20
+httpTimeout: 15
+txnTimeouts:
+  commit: 60
+`,
+		},
+		{
+			name: "Test 2: When the YAML config file has sections",
+			file: "razor.yaml",
+			content: `rpc:
+  provider: https://rpc.razor.network
+gas:
+  multiplier: 1.5
+vote:
+  buffer: 20
+  txnTimeouts:
+    commit: 60
+http:
+  timeout: 15
+`,
+		},
+		{
+			name: "Test 3: When the TOML config file has sections",
+			file: "razor.toml",
+			content: `[rpc]
+provider = "https://rpc.razor.network"
+
+[gas]
+multiplier = 1.5
+
+[vote]
+buffer = 20
+
+[vote.txnTimeouts]
+commit = 60
+
+[http]
+timeout = 15
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := readTestConfigFile(t, tt.file, tt.content)
+			if got := v.GetString("provider"); got != "https://rpc.razor.network" {
+				t.Errorf("provider = %s, want https://rpc.razor.network", got)
+			}
+			if got := v.GetFloat64("gasmultiplier"); got != 1.5 {
+				t.Errorf("gasmultiplier = %v, want 1.5", got)
+			}
+			if got := v.GetInt32("buffer"); got != 20 {
+				t.Errorf("buffer = %d, want 20", got)
+			}
+			if got := v.GetInt32("httpTimeout"); got != 15 {
+				t.Errorf("httpTimeout = %d, want 15", got)
+			}
+			if got := v.GetStringMapString("txnTimeouts"); !reflect.DeepEqual(got, map[string]string{"commit": "60"}) {
+				t.Errorf("txnTimeouts = %v, want map[commit:60]", got)
+			}
+		})
+	}
+}
+
+func TestBindConfigEnv(t *testing.T) {
+	t.Setenv("RAZOR_GAS_MULTIPLIER", "2")
+	t.Setenv("RAZOR_HTTP_TIMEOUT", "30")
+
+	v, _ := readTestConfigFile(t, "razor.yaml", `gasmultiplier: 1.5
+http:
+  timeout: 15
+wait: 5
+`)
+	if got := v.GetFloat64("gasmultiplier"); got != 2 {
+		t.Errorf("gasmultiplier = %v, want 2 from RAZOR_GAS_MULTIPLIER", got)
+	}
+	if got := v.GetInt32("httpTimeout"); got != 30 {
+		t.Errorf("httpTimeout = %d, want 30 from RAZOR_HTTP_TIMEOUT", got)
+	}
+	if got := v.GetInt32("wait"); got != 5 {
+		t.Errorf("wait = %d, want 5 from the config file", got)
+	}
+}
+
+func TestWriteConfigFile(t *testing.T) {
+	t.Setenv("RAZOR_PROVIDER", "https://env.razor.network")
+
+	v, configFilePath := readTestConfigFile(t, "razor.yaml", `provider: https://rpc.razor.network
+gasmultiplier: 1.5
+txnTimeouts:
+  commit: 60
+  reveal: 60
+`)
+	v.Set("wait", 30)
+	v.Set("txnTimeouts", map[string]int{"commit": 90})
+
+	if err := writeConfigFile(v, configFilePath); err != nil {
+		t.Fatalf("writeConfigFile() error = %v", err)
+	}
+
+	content, err := os.ReadFile(configFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(content), "env.razor.network") {
+		t.Error("writeConfigFile() wrote the value of the environment variable")
+	}
+	written := viper.New()
+	written.SetConfigFile(configFilePath)
+	if err := written.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"rpc.provider":            "https://rpc.razor.network",
+		"gas.multiplier":          1.5,
+		"vote.wait":               30,
+		"vote.txntimeouts.commit": 90,
+	}
+	if !reflect.DeepEqual(written.AllSettings(), toNestedSettings(want)) {
+		t.Errorf("writeConfigFile() wrote %v, want %v", written.AllSettings(), toNestedSettings(want))
+	}
+}
+
+//This function returns the settings of the keys in nested maps as returned by viper
+func toNestedSettings(settings map[string]interface{}) map[string]interface{} {
+	v := viper.New()
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	return v.AllSettings()
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{
+			name: "Test 1: When the config file is valid",
+			file: "razor.yaml",
+			content: `provider: https://rpc.razor.network
+gas:
+  multiplier: 1.5
+vote:
+  txnTimeouts:
+    commit: 60
+http:
+  allowedHosts:
+    - api.gemini.com
+`,
+		},
+		{
+			name: "Test 2: When the TOML config file is valid",
+			file: "razor.toml",
+			content: `chainId = 2138
+[signer]
+kmsKey = "awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab"
+`,
+		},
+		{
+			name: "Test 3: When the config file has an unknown key",
+			file: "razor.yaml",
+			content: `gas:
+  multiplyer: 1.5
+`,
+			wantErr: "unknown key gas.multiplyer",
+		},
+		{
+			name: "Test 4: When a key is set both at the top level and in its section",
+			file: "razor.yaml",
+			content: `wait: 5
+vote:
+  wait: 10
+`,
+			wantErr: "wait is set both as wait and in its section as vote.wait",
+		},
+		{
+			name: "Test 5: When a number is not a number",
+			file: "razor.yaml",
+			content: `http:
+  timeout: ten
+`,
+			wantErr: "http.timeout should be a number",
+		},
+		{
+			name: "Test 6: When a string is a section",
+			file: "razor.yaml",
+			content: `rpc:
+  provider:
+    url: https://rpc.razor.network
+`,
+			wantErr: "unknown key rpc.provider.url, rpc.provider should be a string",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configFilePath := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(configFilePath, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			file := viper.New()
+			file.SetConfigFile(configFilePath)
+			if err := file.ReadInConfig(); err != nil {
+				t.Fatal(err)
+			}
+			err := validateConfigFile(file)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateConfigFile() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("validateConfigFile() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
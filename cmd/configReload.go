@@ -0,0 +1,216 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"path/filepath"
+	"razor/core/types"
+	"razor/utils"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var (
+	configFileChanged       bool
+	configFileChangedMutex  sync.Mutex
+	configFileChangeEpoch   uint32
+	alertWebhooksFromConfig bool
+)
+
+//This function watches the config file and marks it as changed when it is written, replaced or created
+//The directory of the file is watched as editors and mounted config maps replace the file instead of writing it
+func watchConfigFile(ctx context.Context, configFilePath string) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	configFilePath = filepath.Clean(configFilePath)
+	err = watcher.Add(filepath.Dir(configFilePath))
+	if err != nil {
+		watcher.Close()
+		return err
+	}
+	realConfigFilePath, _ := filepath.EvalSymlinks(configFilePath)
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				currentConfigFilePath, _ := filepath.EvalSymlinks(configFilePath)
+				isConfigFileEvent := filepath.Clean(event.Name) == configFilePath && event.Op&(fsnotify.Write|fsnotify.Create) != 0
+				if isConfigFileEvent || (currentConfigFilePath != "" && currentConfigFilePath != realConfigFilePath) {
+					realConfigFilePath = currentConfigFilePath
+					markConfigFileChanged()
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Error("Error in watching config file: ", err)
+			}
+		}
+	}()
+	return nil
+}
+
+//This function marks the config file as changed, the changes are applied by the vote loop
+func markConfigFileChanged() {
+	configFileChangedMutex.Lock()
+	defer configFileChangedMutex.Unlock()
+	configFileChanged = true
+}
+
+//This function returns if the config file is changed and marks it as applied if the changes are taken
+func takeConfigFileChange(take bool) bool {
+	configFileChangedMutex.Lock()
+	defer configFileChangedMutex.Unlock()
+	changed := configFileChanged
+	if take {
+		configFileChanged = false
+	}
+	return changed
+}
+
+//This function applies the changes of the config file at the start of the epoch after the one in which the file is changed
+//The epoch in which the file is changed is completed with the config it started with, so that the commit and the reveal of an epoch use the same config
+func applyConfigFileChanges(client *ethclient.Client, config types.Configurations) types.Configurations {
+	if !takeConfigFileChange(false) {
+		return config
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		log.Error("Error in getting epoch to apply the config file changes: ", err)
+		return config
+	}
+	if configFileChangeEpoch == 0 {
+		configFileChangeEpoch = epoch
+		log.Infof("Config file is changed, the changes are applied at the start of epoch %d", epoch+1)
+		return config
+	}
+	if epoch == configFileChangeEpoch {
+		return config
+	}
+	takeConfigFileChange(true)
+	configFileChangeEpoch = 0
+
+	newConfig, err := cmdUtils.ReloadConfig(config)
+	if err != nil {
+		log.Error("Error in reloading config file, the config is not changed: ", err)
+		return config
+	}
+	// The remote config is applied again over the values of the config file
+	appliedRemoteConfigVersion = 0
+	log.Infof("Reloaded config file, Gas Multiplier: %.2f, Buffer Percent: %d, Wait Time: %d, Gas Price: %d, Gas Limit: %.2f, Log Level: %s, Txn Timeouts: %v, Gas Strategies: %v, Max Gas Price: %d, Gas Budget: %v, Gas Budget Period: %s", newConfig.GasMultiplier, newConfig.BufferPercent, newConfig.WaitTime, newConfig.GasPrice, newConfig.GasLimitMultiplier, newConfig.LogLevel, newConfig.TxnTimeouts, newConfig.GasStrategies, newConfig.MaxGasPrice, newConfig.GasBudget, newConfig.GasBudgetPeriod)
+	return newConfig
+}
+
+//This function reads the config file again and returns the config with its hot reloadable values, the flags are still used over the config file
+//The other keys are not changed while voting, a warning is logged if they are changed in the config file
+func (*UtilsStruct) ReloadConfig(config types.Configurations) (types.Configurations, error) {
+	previousValues := getRestartConfigValues()
+	err := rereadConfigFile(viper.GetViper())
+	if err != nil {
+		return config, err
+	}
+	for key, value := range getRestartConfigValues() {
+		if !reflect.DeepEqual(value, previousValues[key]) {
+			log.Warnf("%s is changed in the config file, the change is applied at the next restart", key)
+		}
+	}
+
+	gasMultiplier, err := cmdUtils.GetMultiplier()
+	if err != nil {
+		return config, err
+	}
+	bufferPercent, err := cmdUtils.GetBufferPercent()
+	if err != nil {
+		return config, err
+	}
+	waitTime, err := cmdUtils.GetWaitTime()
+	if err != nil {
+		return config, err
+	}
+	gasPrice, err := cmdUtils.GetGasPrice()
+	if err != nil {
+		return config, err
+	}
+	gasLimit, err := cmdUtils.GetGasLimit()
+	if err != nil {
+		return config, err
+	}
+	logLevel, err := cmdUtils.GetLogLevel()
+	if err != nil {
+		return config, err
+	}
+	txnTimeouts, err := cmdUtils.GetTxnTimeouts()
+	if err != nil {
+		return config, err
+	}
+	gasStrategies, err := cmdUtils.GetGasStrategies()
+	if err != nil {
+		return config, err
+	}
+	maxGasPrice, err := cmdUtils.GetMaxGasPrice()
+	if err != nil {
+		return config, err
+	}
+	gasBudget, err := cmdUtils.GetGasBudget()
+	if err != nil {
+		return config, err
+	}
+	gasBudgetPeriod, err := cmdUtils.GetGasBudgetPeriod()
+	if err != nil {
+		return config, err
+	}
+	if alertWebhooksFromConfig {
+		err = utils.SetAlertWebhooks(getConfigAlertWebhooks())
+		if err != nil {
+			return config, err
+		}
+	}
+
+	config.GasMultiplier = gasMultiplier
+	config.BufferPercent = bufferPercent
+	config.WaitTime = waitTime
+	config.GasPrice = gasPrice
+	config.GasLimitMultiplier = gasLimit
+	config.LogLevel = logLevel
+	config.TxnTimeouts = txnTimeouts
+	config.GasStrategies = gasStrategies
+	config.MaxGasPrice = maxGasPrice
+	config.GasBudget = gasBudget
+	config.GasBudgetPeriod = gasBudgetPeriod
+	if config.LogLevel == "debug" {
+		log.SetLevel(logrus.DebugLevel)
+	} else {
+		log.SetLevel(logrus.InfoLevel)
+	}
+	return config, nil
+}
+
+//This function returns the values of the keys which are only applied when the client is restarted
+func getRestartConfigValues() map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, configKey := range configKeys {
+		if !utils.Contains(hotReloadableConfigKeys, configKey.key) && configKey.key != "alertWebhooks" {
+			values[configKey.key] = viper.Get(configKey.section)
+		}
+	}
+	return values
+}
+
+//This function returns the webhooks of the config file which the alerts are sent to
+func getConfigAlertWebhooks() []string {
+	return splitConfigList(viper.GetStringSlice("alertWebhooks"))
+}
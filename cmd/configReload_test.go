@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyConfigFileChanges(t *testing.T) {
+	var client *ethclient.Client
+	config := types.Configurations{GasMultiplier: 1}
+	newConfig := types.Configurations{GasMultiplier: 2}
+
+	defer func() {
+		configFileChanged, configFileChangeEpoch = false, 0
+	}()
+
+	tests := []struct {
+		name            string
+		changed         bool
+		epoch           uint32
+		reloadConfigErr error
+		want            types.Configurations
+		wantChanged     bool
+	}{
+		{
+			name:        "Test 1: When the config file is not changed",
+			epoch:       10,
+			want:        config,
+			wantChanged: false,
+		},
+		{
+			name:        "Test 2: When the config file is changed in the epoch",
+			changed:     true,
+			epoch:       10,
+			want:        config,
+			wantChanged: true,
+		},
+		{
+			name:        "Test 3: When the epoch in which the config file is changed is not over",
+			epoch:       10,
+			want:        config,
+			wantChanged: true,
+		},
+		{
+			name:        "Test 4: When the next epoch starts",
+			epoch:       11,
+			want:        newConfig,
+			wantChanged: false,
+		},
+		{
+			name:            "Test 5: When there is an error in reloading the config file",
+			changed:         true,
+			epoch:           12,
+			reloadConfigErr: errors.New("reload error"),
+			want:            config,
+			wantChanged:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+
+			if tt.changed {
+				markConfigFileChanged()
+				if tt.reloadConfigErr != nil {
+					// The change is seen in the previous epoch
+					configFileChangeEpoch = tt.epoch - 1
+				}
+			}
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.epoch, nil)
+			cmdUtilsMock.On("ReloadConfig", config).Return(newConfig, tt.reloadConfigErr)
+
+			got := applyConfigFileChanges(client, config)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyConfigFileChanges() got = %v, want %v", got, tt.want)
+			}
+			if changed := takeConfigFileChange(false); changed != tt.wantChanged {
+				t.Errorf("applyConfigFileChanges() config file changed = %v, want %v", changed, tt.wantChanged)
+			}
+		})
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	configFilePath := filepath.Join(t.TempDir(), "razor.yaml")
+	if err := os.WriteFile(configFilePath, []byte("provider: https://rpc.razor.network\ngasmultiplier: 1\nwait: 5\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer viper.Reset()
+	viper.Reset()
+	viper.SetConfigFile(configFilePath)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	registerConfigAliases(viper.GetViper())
+
+	flagSetUtilsMock := new(mocks.FlagSetInterface)
+	flagSetUtils = flagSetUtilsMock
+	cmdUtils = &UtilsStruct{}
+
+	flagSetUtilsMock.On("GetRootFloat32GasMultiplier").Return(float32(-1), nil)
+	flagSetUtilsMock.On("GetRootInt32Buffer").Return(int32(0), nil)
+	flagSetUtilsMock.On("GetRootInt32Wait").Return(int32(-1), nil)
+	flagSetUtilsMock.On("GetRootInt32GasPrice").Return(int32(-1), nil)
+	flagSetUtilsMock.On("GetRootFloat32GasLimit").Return(float32(-1), nil)
+	flagSetUtilsMock.On("GetRootStringLogLevel").Return("", nil)
+	flagSetUtilsMock.On("GetRootStringToIntTxnTimeouts").Return(map[string]int{}, nil)
+	flagSetUtilsMock.On("GetRootStringToStringGasStrategies").Return(map[string]string{}, nil)
+	flagSetUtilsMock.On("GetRootInt32MaxGasPrice").Return(int32(-1), nil)
+	flagSetUtilsMock.On("GetRootFloat32GasBudget").Return(float32(-1), nil)
+	flagSetUtilsMock.On("GetRootStringGasBudgetPeriod").Return("", nil)
+
+	config := types.Configurations{Provider: "https://rpc.razor.network", GasMultiplier: 1, WaitTime: 5}
+
+	// The top level keys of the config file are replaced by their sections, the provider is only applied at the next restart
+	if err := os.WriteFile(configFilePath, []byte("rpc:\n  provider: https://rpc2.razor.network\ngas:\n  multiplier: 1.5\n  strategies:\n    dispute: fast\nvote:\n  wait: 5\n  txnTimeouts:\n    commit: 60\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ut := &UtilsStruct{}
+	got, err := ut.ReloadConfig(config)
+	if err != nil {
+		t.Fatalf("ReloadConfig() error = %v", err)
+	}
+	want := types.Configurations{Provider: "https://rpc.razor.network", GasMultiplier: 1.5, WaitTime: 5, TxnTimeouts: map[string]int{"commit": 60}, GasStrategies: map[string]string{"dispute": "fast"}, GasBudgetPeriod: "epoch"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReloadConfig() got = %v, want %v", got, want)
+	}
+
+	// The config is not changed if the config file can't be parsed
+	if err := os.WriteFile(configFilePath, []byte("gas: [multiplier"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ut.ReloadConfig(want)
+	if err == nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ReloadConfig() got = %v, %v, want %v and an error", got, err, want)
+	}
+	if multiplier := viper.GetFloat64("gasmultiplier"); multiplier != 1.5 {
+		t.Errorf("gasmultiplier = %v after the config file can't be parsed, want 1.5", multiplier)
+	}
+}
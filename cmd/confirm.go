@@ -2,10 +2,15 @@
 package cmd
 
 import (
+	"fmt"
 	"razor/core"
 	"razor/core/types"
+	"razor/metrics"
+	"razor/utils"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 //This function allows the user to claim the block reward and returns the hash
@@ -33,7 +38,18 @@ func (*UtilsStruct) ClaimBlockReward(options types.TransactionOptions) (common.H
 		return core.NilHash, err
 	}
 
-	selectedProposedBlock, err := razorUtils.GetProposedBlock(options.Client, epoch, sortedProposedBlockIds[0])
+	// The block to be confirmed is the first of the sorted blocks which isn't invalidated by a dispute
+	blockIndexToBeConfirmed, err := utils.UtilsInterface.GetBlockIndexToBeConfirmed(options.Client)
+	if err != nil {
+		log.Error("Error in getting blockIndexToBeConfirmed: ", err)
+		return core.NilHash, err
+	}
+	if blockIndexToBeConfirmed < 0 || int(blockIndexToBeConfirmed) >= len(sortedProposedBlockIds) {
+		log.Debug("No valid block proposed in this epoch")
+		return core.NilHash, nil
+	}
+
+	selectedProposedBlock, err := razorUtils.GetProposedBlock(options.Client, epoch, sortedProposedBlockIds[blockIndexToBeConfirmed])
 	if err != nil {
 		log.Error("Error in getting selectedProposedBlock: ", err)
 		return core.NilHash, err
@@ -51,6 +67,123 @@ func (*UtilsStruct) ClaimBlockReward(options types.TransactionOptions) (common.H
 		return transactionUtils.Hash(txn), nil
 	}
 
-	log.Debug("Only selected block proposer can claim block reward")
+	log.Debug("Only selected block proposer can claim block reward, the block is confirmed by the first commit of the next epoch if its proposer doesn't claim it")
 	return core.NilHash, nil
 }
+
+//This function returns the config of the commit of the epoch, the gas multiplier is escalated if the commit confirms the block of the previous epoch as a backup of its proposer
+//If the proposer of the selected block didn't claim the block reward in the confirm state, the block is confirmed by the first commit of the next epoch and its staker gets the block reward
+//The proposers of the blocks of the previous epoch race for it by their rank, the proposer of the selected block and of the next block escalate the most steps and the ones further behind fewer, so that they don't all outbid each other
+func getBackupConfirmConfig(client *ethclient.Client, config types.Configurations, epoch uint32, stakerId uint32) types.Configurations {
+	if epoch <= 1 {
+		return config
+	}
+	previousEpoch := epoch - 1
+	confirmedBlock, err := utils.UtilsInterface.GetBlock(client, previousEpoch)
+	if err != nil {
+		log.Error("Error in getting confirmed block of previous epoch: ", err)
+		return config
+	}
+	if confirmedBlock.ProposerId != 0 {
+		return config
+	}
+	blockIndexToBeConfirmed, err := utils.UtilsInterface.GetBlockIndexToBeConfirmed(client)
+	if err != nil {
+		log.Error("Error in getting blockIndexToBeConfirmed: ", err)
+		return config
+	}
+	if blockIndexToBeConfirmed < 0 {
+		return config
+	}
+	sortedProposedBlockIds, err := razorUtils.GetSortedProposedBlockIds(client, previousEpoch)
+	if err != nil {
+		log.Error("Error in getting sortedProposedBlockIds: ", err)
+		return config
+	}
+	for blockIndex := int(blockIndexToBeConfirmed); blockIndex < len(sortedProposedBlockIds); blockIndex++ {
+		proposedBlock, err := razorUtils.GetProposedBlock(client, previousEpoch, sortedProposedBlockIds[blockIndex])
+		if err != nil {
+			log.Error("Error in getting proposed block: ", err)
+			return config
+		}
+		if proposedBlock.ProposerId != stakerId {
+			continue
+		}
+		rank := blockIndex - int(blockIndexToBeConfirmed)
+		steps := core.MaxBackupConfirmEscalations - rank + 1
+		if steps > core.MaxBackupConfirmEscalations {
+			steps = core.MaxBackupConfirmEscalations
+		}
+		if steps < 1 {
+			steps = 1
+		}
+		config.GasMultiplier = config.GasMultiplier * (1 + float32(core.BackupConfirmGasEscalationPercent*int64(steps))/100)
+		log.Infof("Block of epoch %d isn't confirmed by its proposer, the commit confirms it as a backup at rank %d with the gas multiplier escalated to %.2f", previousEpoch, rank, config.GasMultiplier)
+		return config
+	}
+	return config
+}
+
+//The attempts to claim the block reward of an epoch, a failed claim is retried in the confirm state after a delay which is doubled after every failure
+//The claim isn't retried once it reverts with a reason which doesn't change in the epoch or once the attempts of the epoch are used up
+type claimBlockRewardAttempts struct {
+	epoch     uint32
+	failures  int
+	nextRetry time.Time
+	stopped   bool
+}
+
+var claimAttempts claimBlockRewardAttempts
+
+//This function returns if the block reward of the epoch can be claimed at the time, the attempts of the previous epoch are dropped when the epoch changes
+func (attempts *claimBlockRewardAttempts) canClaim(epoch uint32, now time.Time) bool {
+	if attempts.epoch != epoch {
+		*attempts = claimBlockRewardAttempts{epoch: epoch}
+	}
+	return !attempts.stopped && !now.Before(attempts.nextRetry)
+}
+
+//This function records a failed claim and returns the delay after which it is retried, the claim isn't retried if the attempts of the epoch are used up
+func (attempts *claimBlockRewardAttempts) fail(now time.Time) (time.Duration, bool) {
+	attempts.failures++
+	if attempts.failures >= core.MaxClaimBlockRewardAttempts {
+		attempts.stopped = true
+		return 0, false
+	}
+	delay := core.ClaimBlockRewardRetryDelay << (attempts.failures - 1)
+	attempts.nextRetry = now.Add(delay)
+	return delay, true
+}
+
+//This function handles a failed claim of the block reward of the epoch and returns the error which is logged for it
+//The claim is done if the block is confirmed already, e.g. by an earlier claim which was mined late, it isn't retried if it reverted with a reason which doesn't change in the epoch and is retried with backoff otherwise
+func handleClaimBlockRewardError(client *ethclient.Client, epoch uint32, claimErr error) error {
+	reason := utils.GetRevertReason(claimErr)
+	if reason == core.BlockAlreadyConfirmedReason || isBlockConfirmed(client, epoch) {
+		log.Infof("Block of epoch %d is already confirmed", epoch)
+		metrics.ClaimBlockRewardAttemptsMetric.WithLabelValues("alreadyConfirmed").Inc()
+		blockConfirmed = epoch
+		return nil
+	}
+	if utils.Contains(core.NonRetriableClaimRevertReasons, reason) {
+		metrics.ClaimBlockRewardAttemptsMetric.WithLabelValues("rejected").Inc()
+		claimAttempts.stopped = true
+		return fmt.Errorf("%w, claimBlockReward reverted with %q and isn't retried in epoch %d", claimErr, reason, epoch)
+	}
+	metrics.ClaimBlockRewardAttemptsMetric.WithLabelValues("failed").Inc()
+	delay, retry := claimAttempts.fail(time.Now())
+	if !retry {
+		return fmt.Errorf("%w, claimBlockReward isn't retried in epoch %d after %d attempts", claimErr, epoch, core.MaxClaimBlockRewardAttempts)
+	}
+	return fmt.Errorf("%w, claimBlockReward is retried in %s", claimErr, delay)
+}
+
+//This function returns if the block of the epoch is confirmed, it is false if the confirmed block can't be fetched
+func isBlockConfirmed(client *ethclient.Client, epoch uint32) bool {
+	block, err := utils.UtilsInterface.GetBlock(client, epoch)
+	if err != nil {
+		log.Error("Error in getting confirmed block: ", err)
+		return false
+	}
+	return block.ProposerId != 0
+}
@@ -6,16 +6,21 @@ import (
 	"crypto/rand"
 	"errors"
 	"github.com/stretchr/testify/mock"
+	"math"
 	"math/big"
 	"razor/cmd/mocks"
 	"razor/core"
 	"razor/core/types"
 	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 func TestClaimBlockReward(t *testing.T) {
@@ -31,6 +36,8 @@ func TestClaimBlockReward(t *testing.T) {
 		stakerIdErr               error
 		sortedProposedBlockIds    []uint32
 		sortedProposedBlockIdsErr error
+		blockIndexToBeConfirmed   int8
+		blockIndexErr             error
 		selectedBlock             bindings.StructsBlock
 		selectedBlockErr          error
 		txnOpts                   *bind.TransactOpts
@@ -136,18 +143,60 @@ func TestClaimBlockReward(t *testing.T) {
 			want:    core.NilHash,
 			wantErr: nil,
 		},
+		{
+			name: "Test 9: When the blocks ahead of the block of the staker are invalidated by disputes",
+			args: args{
+				epoch:                   5,
+				stakerId:                1,
+				sortedProposedBlockIds:  []uint32{2, 1, 3},
+				blockIndexToBeConfirmed: 1,
+				selectedBlock:           bindings.StructsBlock{ProposerId: 1},
+				txnOpts:                 txnOpts,
+				ClaimBlockRewardTxn:     &Types.Transaction{},
+				hash:                    common.BigToHash(big.NewInt(1)),
+			},
+			want:    common.BigToHash(big.NewInt(1)),
+			wantErr: nil,
+		},
+		{
+			name: "Test 10: When all the proposed blocks are invalidated by disputes",
+			args: args{
+				epoch:                   5,
+				stakerId:                2,
+				sortedProposedBlockIds:  []uint32{2, 1, 3},
+				blockIndexToBeConfirmed: -1,
+				selectedBlock:           bindings.StructsBlock{ProposerId: 2},
+			},
+			want:    core.NilHash,
+			wantErr: nil,
+		},
+		{
+			name: "Test 11: When there is an error in getting blockIndexToBeConfirmed",
+			args: args{
+				epoch:                  5,
+				stakerId:               2,
+				sortedProposedBlockIds: []uint32{2, 1, 3},
+				blockIndexErr:          errors.New("blockIndex error"),
+			},
+			want:    core.NilHash,
+			wantErr: errors.New("blockIndex error"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
 			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
 			blockManagerMock := new(mocks.BlockManagerInterface)
 			transactionUtilsMock := new(mocks.TransactionInterface)
 
 			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
 			blockManagerUtils = blockManagerMock
 			transactionUtils = transactionUtilsMock
 
+			utilsPkgMock.On("GetBlockIndexToBeConfirmed", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.blockIndexToBeConfirmed, tt.args.blockIndexErr)
+
 			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
 			utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.sortedProposedBlockIds, tt.args.sortedProposedBlockIdsErr)
 			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.stakerId, tt.args.stakerIdErr)
@@ -156,8 +205,8 @@ func TestClaimBlockReward(t *testing.T) {
 			blockManagerMock.On("ClaimBlockReward", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*bind.TransactOpts")).Return(tt.args.ClaimBlockRewardTxn, tt.args.ClaimBlockRewardErr)
 			transactionUtilsMock.On("Hash", mock.AnythingOfType("*types.Transaction")).Return(tt.args.hash)
 
-			utils := &UtilsStruct{}
-			got, err := utils.ClaimBlockReward(options)
+			ut := &UtilsStruct{}
+			got, err := ut.ClaimBlockReward(options)
 			if got != tt.want {
 				t.Errorf("Txn hash for ClaimBlockReward function, got = %v, want = %v", got, tt.want)
 			}
@@ -174,3 +223,225 @@ func TestClaimBlockReward(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBackupConfirmConfig(t *testing.T) {
+	var client *ethclient.Client
+	config := types.Configurations{GasMultiplier: 1}
+
+	type args struct {
+		epoch                   uint32
+		confirmedBlock          bindings.StructsBlock
+		confirmedBlockErr       error
+		blockIndexToBeConfirmed int8
+		sortedProposedBlockIds  []uint32
+		proposerIds             map[uint32]uint32
+	}
+	tests := []struct {
+		name              string
+		args              args
+		wantGasMultiplier float32
+	}{
+		{
+			name: "Test 1: When the block of the previous epoch is confirmed",
+			args: args{
+				epoch:                  5,
+				confirmedBlock:         bindings.StructsBlock{ProposerId: 2},
+				sortedProposedBlockIds: []uint32{1, 2, 3},
+				proposerIds:            map[uint32]uint32{1: 2, 2: 1, 3: 3},
+			},
+			wantGasMultiplier: 1,
+		},
+		{
+			name: "Test 2: When the staker proposed the block next to the selected one",
+			args: args{
+				epoch:                  5,
+				sortedProposedBlockIds: []uint32{1, 2, 3},
+				proposerIds:            map[uint32]uint32{1: 2, 2: 1, 3: 3},
+			},
+			wantGasMultiplier: 1.6,
+		},
+		{
+			name: "Test 3: When the staker proposed a block further behind the selected one",
+			args: args{
+				epoch:                  5,
+				sortedProposedBlockIds: []uint32{1, 2, 3},
+				proposerIds:            map[uint32]uint32{1: 2, 2: 3, 3: 1},
+			},
+			wantGasMultiplier: 1.4,
+		},
+		{
+			name: "Test 4: When the staker proposed the selected block",
+			args: args{
+				epoch:                   5,
+				blockIndexToBeConfirmed: 1,
+				sortedProposedBlockIds:  []uint32{1, 2, 3},
+				proposerIds:             map[uint32]uint32{1: 2, 2: 1, 3: 3},
+			},
+			wantGasMultiplier: 1.6,
+		},
+		{
+			name: "Test 5: When the staker didn't propose a block",
+			args: args{
+				epoch:                  5,
+				sortedProposedBlockIds: []uint32{1, 2},
+				proposerIds:            map[uint32]uint32{1: 2, 2: 3},
+			},
+			wantGasMultiplier: 1,
+		},
+		{
+			name: "Test 6: When all the proposed blocks are invalidated by disputes",
+			args: args{
+				epoch:                   5,
+				blockIndexToBeConfirmed: -1,
+				sortedProposedBlockIds:  []uint32{1, 2},
+				proposerIds:             map[uint32]uint32{1: 1, 2: 3},
+			},
+			wantGasMultiplier: 1,
+		},
+		{
+			name: "Test 7: When there is an error in getting the confirmed block",
+			args: args{
+				epoch:                  5,
+				confirmedBlockErr:      errors.New("block error"),
+				sortedProposedBlockIds: []uint32{1, 2},
+				proposerIds:            map[uint32]uint32{1: 2, 2: 1},
+			},
+			wantGasMultiplier: 1,
+		},
+		{
+			name: "Test 8: When it is the first epoch",
+			args: args{
+				epoch: 1,
+			},
+			wantGasMultiplier: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("GetBlock", mock.AnythingOfType("*ethclient.Client"), tt.args.epoch-1).Return(tt.args.confirmedBlock, tt.args.confirmedBlockErr)
+			utilsPkgMock.On("GetBlockIndexToBeConfirmed", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.blockIndexToBeConfirmed, nil)
+			utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), tt.args.epoch-1).Return(tt.args.sortedProposedBlockIds, nil)
+			for blockId, proposerId := range tt.args.proposerIds {
+				utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), tt.args.epoch-1, blockId).Return(bindings.StructsBlock{ProposerId: proposerId, Valid: true}, nil)
+			}
+
+			got := getBackupConfirmConfig(client, config, tt.args.epoch, 1)
+			if math.Abs(float64(got.GasMultiplier-tt.wantGasMultiplier)) > 1e-6 {
+				t.Errorf("getBackupConfirmConfig() gas multiplier = %v, want %v", got.GasMultiplier, tt.wantGasMultiplier)
+			}
+		})
+	}
+}
+
+func TestHandleClaimBlockRewardError(t *testing.T) {
+	var client *ethclient.Client
+	defer func() {
+		blockConfirmed = 0
+		claimAttempts = claimBlockRewardAttempts{}
+	}()
+
+	tests := []struct {
+		name               string
+		claimErr           error
+		confirmedBlock     bindings.StructsBlock
+		failures           int
+		wantErr            bool
+		wantBlockConfirmed uint32
+		wantStopped        bool
+		wantFailures       int
+	}{
+		{
+			name:               "Test 1: When the claim reverts as the block is already confirmed",
+			claimErr:           errors.New("execution reverted: Block already confirmed"),
+			wantErr:            false,
+			wantBlockConfirmed: 5,
+		},
+		{
+			name:               "Test 2: When the claim fails but the block is confirmed by an earlier claim",
+			claimErr:           errors.New("transaction mining unsuccessful"),
+			confirmedBlock:     bindings.StructsBlock{ProposerId: 2},
+			wantErr:            false,
+			wantBlockConfirmed: 5,
+		},
+		{
+			name:        "Test 3: When the claim reverts with a reason which doesn't change in the epoch",
+			claimErr:    errors.New("execution reverted: Block Proposer mismatches"),
+			wantErr:     true,
+			wantStopped: true,
+		},
+		{
+			name:         "Test 4: When the claim fails and is retried",
+			claimErr:     errors.New("nonce too low"),
+			wantErr:      true,
+			wantFailures: 1,
+		},
+		{
+			name:         "Test 5: When the attempts of the epoch are used up",
+			claimErr:     errors.New("nonce too low"),
+			failures:     core.MaxClaimBlockRewardAttempts - 1,
+			wantErr:      true,
+			wantStopped:  true,
+			wantFailures: core.MaxClaimBlockRewardAttempts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+			utilsPkgMock.On("GetBlock", mock.AnythingOfType("*ethclient.Client"), uint32(5)).Return(tt.confirmedBlock, nil)
+
+			blockConfirmed = 0
+			claimAttempts = claimBlockRewardAttempts{epoch: 5, failures: tt.failures}
+
+			err := handleClaimBlockRewardError(client, 5, tt.claimErr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("handleClaimBlockRewardError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if blockConfirmed != tt.wantBlockConfirmed {
+				t.Errorf("blockConfirmed = %d, want %d", blockConfirmed, tt.wantBlockConfirmed)
+			}
+			if claimAttempts.stopped != tt.wantStopped {
+				t.Errorf("claimAttempts.stopped = %v, want %v", claimAttempts.stopped, tt.wantStopped)
+			}
+			if claimAttempts.failures != tt.wantFailures {
+				t.Errorf("claimAttempts.failures = %d, want %d", claimAttempts.failures, tt.wantFailures)
+			}
+		})
+	}
+}
+
+func TestClaimBlockRewardAttempts(t *testing.T) {
+	now := time.Now()
+	attempts := claimBlockRewardAttempts{}
+
+	if !attempts.canClaim(5, now) {
+		t.Fatal("canClaim() = false before any attempt, want true")
+	}
+	delay, retry := attempts.fail(now)
+	if !retry || delay != core.ClaimBlockRewardRetryDelay {
+		t.Errorf("fail() = %s, %v, want %s, true", delay, retry, core.ClaimBlockRewardRetryDelay)
+	}
+	if attempts.canClaim(5, now.Add(delay/2)) {
+		t.Error("canClaim() = true before the retry delay passed, want false")
+	}
+	if !attempts.canClaim(5, now.Add(delay)) {
+		t.Error("canClaim() = false after the retry delay passed, want true")
+	}
+	delay, _ = attempts.fail(now)
+	if delay != 2*core.ClaimBlockRewardRetryDelay {
+		t.Errorf("fail() delay = %s after the second failure, want %s", delay, 2*core.ClaimBlockRewardRetryDelay)
+	}
+	attempts.stopped = true
+	if attempts.canClaim(5, now.Add(time.Hour)) {
+		t.Error("canClaim() = true after the claim is stopped, want false")
+	}
+	if !attempts.canClaim(6, now) || attempts.failures != 0 {
+		t.Errorf("canClaim() in the next epoch = false or failures = %d, want true and 0", attempts.failures)
+	}
+}
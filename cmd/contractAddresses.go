@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"razor/core"
+	"razor/utils"
 )
 
 // contractAddressesCmd represents the contractAddresses command
@@ -31,6 +32,8 @@ func (*UtilsStruct) ExecuteContractAddresses(flagSet *pflag.FlagSet) {
 
 //This function provides the list of all contract addresses
 func (*UtilsStruct) ContractAddresses() {
+	fmt.Println("Network :", utils.GetNetwork())
+	fmt.Println("ChainId :", core.ChainId)
 	fmt.Println("StakeManagerAddress :", core.StakeManagerAddress)
 	fmt.Println("RAZORAddress :", core.RAZORAddress)
 	fmt.Println("CollectionManagerAddress :", core.CollectionManagerAddress)
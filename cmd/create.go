@@ -30,7 +30,7 @@ func initialiseCreate(cmd *cobra.Command, args []string) {
 func (*UtilsStruct) ExecuteCreate(flagSet *pflag.FlagSet) {
 	razorUtils.AssignLogFile(flagSet)
 	log.Info("The password should be of minimum 8 characters containing least 1 uppercase, lowercase, digit and special character.")
-	password := razorUtils.AssignPassword()
+	password := razorUtils.AssignNewPassword()
 	account, err := cmdUtils.Create(password)
 	utils.CheckError("Create error: ", err)
 	log.Info("Account address: ", account.Address)
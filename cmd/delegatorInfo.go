@@ -0,0 +1,269 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/pkg/bindings"
+	"razor/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+//Reason of the StakeChange event when the block reward is given to the staker
+const blockRewardStakeChangeReason uint8 = 0
+
+var delegatorInfoCmd = &cobra.Command{
+	Use:   "delegatorInfo",
+	Short: "delegation details of an address",
+	Long: `Provides the delegations of an address to all the stakers, with the sRZR balances, their current value in RZR, the commission of the stakers, the pending unstake and withdraw locks and an APR estimated from the block rewards of the stakers in the latest days.
+
+Example:
+  ./razor delegatorInfo --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c
+  ./razor delegatorInfo --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --days 30`,
+	Run: initialiseDelegatorInfo,
+}
+
+//This function initialises the ExecuteDelegatorInfo function
+func initialiseDelegatorInfo(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteDelegatorInfo(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetDelegatorInfo function
+func (*UtilsStruct) ExecuteDelegatorInfo(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	days, err := flagSetUtils.GetUint32Days(flagSet)
+	utils.CheckError("Error in getting days: ", err)
+
+	positions, err := cmdUtils.GetDelegatorInfo(client, address, days)
+	utils.CheckError("Error in getting delegator info: ", err)
+
+	if utils.IsJsonOutput() {
+		if positions == nil {
+			positions = []types.DelegatorPosition{}
+		}
+		utils.CheckError("Error in printing delegator info: ", utils.PrintJson(positions))
+		return
+	}
+	printDelegatorInfo(address, positions)
+}
+
+//This function returns the delegations of the address with an APR estimated from the block rewards of the stakers over the last days
+func (*UtilsStruct) GetDelegatorInfo(client *ethclient.Client, address string, days uint32) ([]types.DelegatorPosition, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New("invalid address")
+	}
+	if days == 0 {
+		return nil, errors.New("days should be greater than 0")
+	}
+	positions, err := cmdUtils.GetDelegatorPositions(client, address)
+	if err != nil || len(positions) == 0 {
+		return positions, err
+	}
+
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return nil, err
+	}
+	blocksInRange := uint64(time.Duration(days) * 24 * time.Hour / utils.UtilsInterface.GetAverageBlockTime(client))
+	var fromBlock uint64
+	if latestHeader.Number.Uint64() > blocksInRange {
+		fromBlock = latestHeader.Number.Uint64() - blocksInRange
+	}
+	var stakerIds []uint32
+	for _, position := range positions {
+		stakerIds = append(stakerIds, position.StakerId)
+	}
+	blockRewards, err := getStakerBlockRewards(client, stakerIds, fromBlock, latestHeader.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	for i := range positions {
+		positions[i].BlockRewards = big.NewInt(0)
+		if rewards, ok := blockRewards[positions[i].StakerId]; ok {
+			positions[i].BlockRewards = rewards
+		}
+		positions[i].EstimatedAPR = estimateDelegatorAPR(positions[i].BlockRewards, positions[i].StakerStake, positions[i].Commission, days)
+	}
+	return positions, nil
+}
+
+//This function returns the stakers to which the address delegates, i.e. whose sRZRs it holds or from which it has a pending unstake or withdraw lock
+func (*UtilsStruct) GetDelegatorPositions(client *ethclient.Client, address string) ([]types.DelegatorPosition, error) {
+	numberOfStakers, err := razorUtils.GetNumberOfStakers(client)
+	if err != nil {
+		return nil, err
+	}
+	var positions []types.DelegatorPosition
+	for stakerId := uint32(1); stakerId <= numberOfStakers; stakerId++ {
+		staker, err := razorUtils.GetStaker(client, stakerId)
+		if err != nil {
+			return nil, err
+		}
+		sRZRBalance, err := utils.UtilsInterface.GetDelegatorSRZRBalance(client, address, staker)
+		if err != nil {
+			return nil, err
+		}
+		unstakeLock, err := razorUtils.GetLock(client, address, stakerId, 0)
+		if err != nil {
+			return nil, err
+		}
+		withdrawLock, err := razorUtils.GetLock(client, address, stakerId, 1)
+		if err != nil {
+			return nil, err
+		}
+		if sRZRBalance.Sign() == 0 && unstakeLock.Amount.Sign() == 0 && withdrawLock.Amount.Sign() == 0 {
+			continue
+		}
+		totalSupply, err := utils.UtilsInterface.GetSRZRTotalSupply(client, staker)
+		if err != nil {
+			return nil, err
+		}
+		shareValue := new(big.Float)
+		rzrValue := big.NewInt(0)
+		if totalSupply.Sign() != 0 {
+			shareValue.Quo(new(big.Float).SetInt(staker.Stake), new(big.Float).SetInt(totalSupply))
+			rzrValue = razorUtils.ConvertSRZRToRZR(sRZRBalance, staker.Stake, totalSupply)
+		}
+		positions = append(positions, types.DelegatorPosition{
+			StakerId:         stakerId,
+			StakerAddress:    staker.Address.Hex(),
+			AcceptDelegation: staker.AcceptDelegation,
+			IsSlashed:        staker.IsSlashed,
+			Commission:       staker.Commission,
+			StakerStake:      staker.Stake,
+			SRZRBalance:      sRZRBalance,
+			ShareValue:       shareValue,
+			RZRValue:         rzrValue,
+			UnstakeLock:      unstakeLock,
+			WithdrawLock:     withdrawLock,
+		})
+	}
+	return positions, nil
+}
+
+//This function returns the block rewards given to the stakers between the blocks from their StakeChange events
+func getStakerBlockRewards(client *ethclient.Client, stakerIds []uint32, fromBlock uint64, toBlock uint64) (map[uint32]*big.Int, error) {
+	contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(bindings.StakeManagerABI))
+	if err != nil {
+		return nil, err
+	}
+	stakeChangeEvent, ok := contractAbi.Events["StakeChange"]
+	if !ok {
+		return nil, errors.New("StakeChange event not found in stake manager ABI")
+	}
+	var stakerIdTopics []common.Hash
+	for _, stakerId := range stakerIds {
+		stakerIdTopics = append(stakerIdTopics, common.BigToHash(big.NewInt(int64(stakerId))))
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{common.HexToAddress(core.StakeManagerAddress)},
+		Topics:    [][]common.Hash{{stakeChangeEvent.ID}, stakerIdTopics},
+	}
+	logIterator := utils.NewLogIterator(client, query)
+	blockRewards := make(map[uint32]*big.Int)
+	for logIterator.Next() {
+		vLog := logIterator.Log()
+		_, args, err := decodeActivityEvent(contractAbi, vLog)
+		if err != nil {
+			log.Debugf("Error in decoding StakeChange event of transaction %s: %s", vLog.TxHash.Hex(), err)
+			continue
+		}
+		stakerId, stakerIdOk := args["stakerId"].(uint32)
+		reason, reasonOk := args["reason"].(uint8)
+		prevStake, prevStakeOk := args["prevStake"].(*big.Int)
+		newStake, newStakeOk := args["newStake"].(*big.Int)
+		if !stakerIdOk || !reasonOk || !prevStakeOk || !newStakeOk || reason != blockRewardStakeChangeReason {
+			continue
+		}
+		if _, ok := blockRewards[stakerId]; !ok {
+			blockRewards[stakerId] = big.NewInt(0)
+		}
+		blockRewards[stakerId].Add(blockRewards[stakerId], new(big.Int).Sub(newStake, prevStake))
+	}
+	if err := logIterator.Error(); err != nil {
+		return nil, err
+	}
+	return blockRewards, nil
+}
+
+//This function returns the APR in percent which a delegator would earn if the staker kept getting the block rewards of the days
+//The block rewards are added to the stake of the staker, so they are shared by the sRZR holders after the commission of the staker
+func estimateDelegatorAPR(blockRewards *big.Int, stake *big.Int, commission uint8, days uint32) float64 {
+	if stake == nil || stake.Sign() == 0 || days == 0 {
+		return 0
+	}
+	rate := new(big.Float).Quo(new(big.Float).SetInt(blockRewards), new(big.Float).SetInt(stake))
+	yearlyRate, _ := rate.Mul(rate, big.NewFloat(365/float64(days))).Float64()
+	return yearlyRate * float64(100-int(commission))
+}
+
+//This function prints the delegations of the address in a table
+func printDelegatorInfo(address string, positions []types.DelegatorPosition) {
+	if len(positions) == 0 {
+		log.Infof("No delegations found for %s", address)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Staker Id", "Staker Address", "sRZR Balance", "Share Value (RZR)", "Value (RZR)", "Commission (%)", "Unstake Lock (sRZR)", "Withdraw Lock (RZR)", "Estimated APR (%)"})
+	for _, position := range positions {
+		table.Append([]string{
+			strconv.Itoa(int(position.StakerId)),
+			position.StakerAddress,
+			utils.GetAmountInDecimal(position.SRZRBalance).String(),
+			position.ShareValue.Text('f', 6),
+			utils.GetAmountInDecimal(position.RZRValue).String(),
+			strconv.Itoa(int(position.Commission)),
+			formatDelegatorLock(position.UnstakeLock),
+			formatDelegatorLock(position.WithdrawLock),
+			strconv.FormatFloat(position.EstimatedAPR, 'f', 2, 64),
+		})
+	}
+	table.Render()
+}
+
+//This function returns the amount of the lock and the epoch after which it is unlocked as text, it is "-" if there is no lock
+func formatDelegatorLock(lock types.Locks) string {
+	if lock.Amount == nil || lock.Amount.Sign() == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s (after epoch %s)", utils.GetAmountInDecimal(lock.Amount), lock.UnlockAfter)
+}
+
+func init() {
+	rootCmd.AddCommand(delegatorInfoCmd)
+
+	var (
+		Address string
+		Days    uint32
+	)
+
+	delegatorInfoCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the delegator")
+	delegatorInfoCmd.Flags().Uint32VarP(&Days, "days", "", 7, "number of latest days whose block rewards are used to estimate the APR")
+
+	addrErr := delegatorInfoCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetDelegatorPositions(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+	noLock := types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)}
+	unstakeLock := types.Locks{Amount: big.NewInt(50), UnlockAfter: big.NewInt(120)}
+	stakers := map[uint32]bindings.StructsStaker{
+		1: {Id: 1, Commission: 10, AcceptDelegation: true, Stake: big.NewInt(2000)},
+		2: {Id: 2, Stake: big.NewInt(3000)},
+		3: {Id: 3, Commission: 5, Stake: big.NewInt(1000)},
+	}
+
+	type args struct {
+		numberOfStakersErr error
+		stakerErr          error
+		sRZRBalanceErr     error
+		lockErr            error
+		totalSupplyErr     error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []types.DelegatorPosition
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the stakers with a balance or a lock of the address are returned",
+			args: args{},
+			want: []types.DelegatorPosition{
+				{StakerId: 1, StakerAddress: common.Address{}.Hex(), AcceptDelegation: true, Commission: 10, StakerStake: big.NewInt(2000), SRZRBalance: big.NewInt(100), ShareValue: big.NewFloat(2), RZRValue: big.NewInt(200), UnstakeLock: noLock, WithdrawLock: noLock},
+				{StakerId: 3, StakerAddress: common.Address{}.Hex(), Commission: 5, StakerStake: big.NewInt(1000), SRZRBalance: big.NewInt(0), ShareValue: big.NewFloat(1), RZRValue: big.NewInt(0), UnstakeLock: unstakeLock, WithdrawLock: noLock},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When there is an error in getting number of stakers",
+			args:    args{numberOfStakersErr: errors.New("numberOfStakers error")},
+			wantErr: true,
+		},
+		{
+			name:    "Test 3: When there is an error in getting staker",
+			args:    args{stakerErr: errors.New("staker error")},
+			wantErr: true,
+		},
+		{
+			name:    "Test 4: When there is an error in getting sRZR balance",
+			args:    args{sRZRBalanceErr: errors.New("sRZR error")},
+			wantErr: true,
+		},
+		{
+			name:    "Test 5: When there is an error in getting lock",
+			args:    args{lockErr: errors.New("lock error")},
+			wantErr: true,
+		},
+		{
+			name:    "Test 6: When there is an error in getting sRZR total supply",
+			args:    args{totalSupplyErr: errors.New("totalSupply error")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsMock.On("GetNumberOfStakers", mock.AnythingOfType("*ethclient.Client")).Return(uint32(3), tt.args.numberOfStakersErr)
+			for stakerId, staker := range stakers {
+				utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), stakerId).Return(staker, tt.args.stakerErr)
+				utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, stakerId, uint8(1)).Return(noLock, tt.args.lockErr)
+			}
+			utilsPkgMock.On("GetDelegatorSRZRBalance", mock.AnythingOfType("*ethclient.Client"), address, stakers[1]).Return(big.NewInt(100), tt.args.sRZRBalanceErr)
+			utilsPkgMock.On("GetDelegatorSRZRBalance", mock.AnythingOfType("*ethclient.Client"), address, mock.AnythingOfType("bindings.StructsStaker")).Return(big.NewInt(0), tt.args.sRZRBalanceErr)
+			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, uint32(3), uint8(0)).Return(unstakeLock, tt.args.lockErr)
+			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, mock.AnythingOfType("uint32"), uint8(0)).Return(noLock, tt.args.lockErr)
+			utilsPkgMock.On("GetSRZRTotalSupply", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("bindings.StructsStaker")).Return(big.NewInt(1000), tt.args.totalSupplyErr)
+			utilsMock.On("ConvertSRZRToRZR", mock.Anything, mock.Anything, mock.Anything).Return(func(sAmount *big.Int, currentStake *big.Int, totalSupply *big.Int) *big.Int {
+				return utils.ConvertSRZRToRZR(sAmount, currentStake, totalSupply)
+			})
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetDelegatorPositions(client, address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDelegatorPositions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// The share values are compared by their value, as the big floats of the same value can differ in their precision
+			if !tt.wantErr && fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("GetDelegatorPositions() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDelegatorInfo(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	contractAbi, err := abi.JSON(strings.NewReader(rewardsTestAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakeChange := contractAbi.Events["StakeChange"]
+	var logs []Types.Log
+	for _, change := range []struct {
+		stakerId  int64
+		reason    uint8
+		prevStake int64
+		newStake  int64
+	}{
+		{stakerId: 1, reason: 0, prevStake: 1000, newStake: 1010},
+		{stakerId: 1, reason: 0, prevStake: 1010, newStake: 1030},
+		{stakerId: 1, reason: 1, prevStake: 1030, newStake: 1000},
+	} {
+		data, err := stakeChange.Inputs.NonIndexed().Pack(uint32(100), change.reason, big.NewInt(change.prevStake), big.NewInt(change.newStake), big.NewInt(1700000000))
+		if err != nil {
+			t.Fatal(err)
+		}
+		logs = append(logs, Types.Log{Topics: []common.Hash{stakeChange.ID, common.BigToHash(big.NewInt(change.stakerId))}, Data: data})
+	}
+	positions := []types.DelegatorPosition{
+		{StakerId: 1, Commission: 10, StakerStake: big.NewInt(1000)},
+		{StakerId: 2, StakerStake: big.NewInt(1000)},
+	}
+
+	type args struct {
+		address        string
+		days           uint32
+		positions      []types.DelegatorPosition
+		positionsErr   error
+		latestBlockErr error
+		contractAbiErr error
+		logsErr        error
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantBlockRewards []int64
+		wantAPR          []float64
+		wantErr          bool
+	}{
+		{
+			name: "Test 1: When the APR is estimated from the block rewards",
+			args: args{
+				address:   address,
+				days:      365,
+				positions: positions,
+			},
+			wantBlockRewards: []int64{30, 0},
+			wantAPR:          []float64{2.7, 0},
+			wantErr:          false,
+		},
+		{
+			name: "Test 2: When the address has no delegations",
+			args: args{
+				address: address,
+				days:    7,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the address is invalid",
+			args: args{
+				address: "0x123",
+				days:    7,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When days is 0",
+			args: args{
+				address: address,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the delegations",
+			args: args{
+				address:      address,
+				days:         7,
+				positionsErr: errors.New("positions error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting latest block",
+			args: args{
+				address:        address,
+				days:           7,
+				positions:      positions,
+				latestBlockErr: errors.New("block error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in parsing contract abi",
+			args: args{
+				address:        address,
+				days:           7,
+				positions:      positions,
+				contractAbiErr: errors.New("abi error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in fetching logs",
+			args: args{
+				address:   address,
+				days:      7,
+				positions: positions,
+				logsErr:   errors.New("logs error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			abiUtilsMock := new(mocks2.ABIUtils)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utils.ABIInterface = abiUtilsMock
+
+			var delegations []types.DelegatorPosition
+			for _, position := range tt.args.positions {
+				delegations = append(delegations, position)
+			}
+			cmdUtilsMock.On("GetDelegatorPositions", mock.AnythingOfType("*ethclient.Client"), tt.args.address).Return(delegations, tt.args.positionsErr)
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(1000000)}, tt.args.latestBlockErr)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
+			abiUtilsMock.On("Parse", mock.Anything).Return(contractAbi, tt.args.contractAbiErr)
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(logs, tt.args.logsErr).Once()
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return([]Types.Log{}, nil)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetDelegatorInfo(client, tt.args.address, tt.args.days)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDelegatorInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != len(tt.wantBlockRewards) {
+				t.Fatalf("GetDelegatorInfo() returned %d delegations, want %d", len(got), len(tt.wantBlockRewards))
+			}
+			for i, position := range got {
+				if position.BlockRewards.Cmp(big.NewInt(tt.wantBlockRewards[i])) != 0 {
+					t.Errorf("GetDelegatorInfo() block rewards of staker %d = %s, want %d", position.StakerId, position.BlockRewards, tt.wantBlockRewards[i])
+				}
+				if fmt.Sprintf("%.4f", position.EstimatedAPR) != fmt.Sprintf("%.4f", tt.wantAPR[i]) {
+					t.Errorf("GetDelegatorInfo() estimated APR of staker %d = %f, want %f", position.StakerId, position.EstimatedAPR, tt.wantAPR[i])
+				}
+			}
+		})
+	}
+}
@@ -3,20 +3,24 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	types2 "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
-	solsha3 "github.com/miguelmota/go-solidity-sha3"
+	"math"
 	"math/big"
 	"os"
 	"razor/core"
 	"razor/core/types"
+	"razor/metrics"
 	"razor/path"
 	"razor/pkg/bindings"
 	"razor/utils"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -24,11 +28,31 @@ var (
 	disputedFlag      bool
 )
 
+var errDisputeWindowTooShort = errors.New("dispute can't be finalized in the rest of the dispute state")
+
+//medianDisputePlan is the number of giveSorted transactions which submit the remaining sorted values of a median dispute and the time which they and the finalizeDispute transaction need to be mined
+type medianDisputePlan struct {
+	remainingValues        int
+	chunkSize              int
+	giveSortedTransactions int
+	requiredTime           int64
+	remainingTime          int64
+}
+
 //blockId is id of the block
 
 //This function handles the dispute and if there is any error it returns the error
 func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) error {
 	disputedFlag = false
+	disputeComparisons = newDisputeComparisonCache()
+	defer func() { disputeComparisons = nil }()
+
+	if err := utils.InjectFault(core.DisputeFaultPoint, core.RPCTimeoutFault); err != nil {
+		return err
+	}
+
+	// The reveals are audited once the disputes are sent, so that the audit never delays them
+	defer auditReveals(client, account.Address, epoch, blockNumber)
 
 	sortedProposedBlockIds, err := razorUtils.GetSortedProposedBlockIds(client, epoch)
 	if err != nil {
@@ -47,6 +71,16 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 	if err != nil {
 		return err
 	}
+	cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+		Action: "localMedians",
+		Hashes: map[string]string{
+			"medians":               utils.HashJournalData(medians),
+			"revealedCollectionIds": utils.HashJournalData(revealedCollectionIds),
+			"revealedDataMaps":      utils.HashJournalData(revealedDataMaps),
+		},
+		Values: medians,
+		Status: "calculated",
+	})
 
 	randomSortedProposedBlockIds := utils.UtilsInterface.Shuffle(sortedProposedBlockIds) //shuffles the sortedProposedBlockIds array
 	transactionOptions := types.TransactionOptions{
@@ -57,23 +91,26 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 		Config:         config,
 	}
 
-	for _, blockId := range randomSortedProposedBlockIds {
-		proposedBlock, err := razorUtils.GetProposedBlock(client, epoch, uint32(blockId))
-		if err != nil {
-			log.Error(err)
+	//The proposed blocks are verified concurrently and only the dispute transactions are sent one after another, in the shuffled order of the blocks
+	blockVerifications := verifyProposedBlocks(client, epoch, randomSortedProposedBlockIds, sortedProposedBlockIds, biggestStake, medians)
+	for _, blockVerification := range blockVerifications {
+		if blockVerification.err != nil {
+			log.Error(blockVerification.err)
 			continue
 		}
+		blockId := blockVerification.blockId
+		proposedBlock := blockVerification.proposedBlock
 		log.Debug("Proposed block ", blockId, proposedBlock)
 
 		//blockIndex is index of blockId in sortedProposedBlock
-		blockIndex := utils.IndexOf(sortedProposedBlockIds, uint32(blockId))
+		blockIndex := blockVerification.blockIndex
 		if blockIndex == -1 {
 			log.Error("Block is not present in SortedProposedBlockIds array")
 			continue
 		}
 
 		// Biggest staker dispute
-		if proposedBlock.BiggestStake.Cmp(biggestStake) != 0 && proposedBlock.Valid {
+		if blockVerification.biggestStakeMismatch {
 			log.Debug("Biggest Stake in proposed block: ", proposedBlock.BiggestStake)
 			log.Warn("PROPOSED BIGGEST STAKE DOES NOT MATCH WITH ACTUAL BIGGEST STAKE")
 			log.Info("Disputing BiggestStakeProposed...")
@@ -90,7 +127,13 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 				continue
 			}
 			log.Info("Txn Hash: ", transactionUtils.Hash(disputeBiggestStakeProposedTxn))
-			WaitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(disputeBiggestStakeProposedTxn).String())
+			disputeTxnHash, WaitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(client, config, "dispute", transactionUtils.Hash(disputeBiggestStakeProposedTxn).String())
+			cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+				Action:  fmt.Sprintf("disputeBiggestStakeProposed:%d", blockIndex),
+				Hashes:  map[string]string{"biggestStake": utils.HashJournalData(biggestStake)},
+				TxnHash: disputeTxnHash,
+				Status:  GetJournalTxnStatus(WaitForBlockCompletionErr),
+			})
 
 			//If dispute happens, then storing the bountyId into disputeData file
 			if WaitForBlockCompletionErr == nil {
@@ -114,7 +157,13 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 		}
 		if idDisputeTxn != nil {
 			log.Debugf("Txn Hash: %s", transactionUtils.Hash(idDisputeTxn).String())
-			WaitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(idDisputeTxn).String())
+			idDisputeTxnHash, WaitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(client, config, "dispute", transactionUtils.Hash(idDisputeTxn).String())
+			cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+				Action:  fmt.Sprintf("disputeCollectionIds:%d", blockIndex),
+				Hashes:  map[string]string{"revealedCollectionIds": utils.HashJournalData(revealedCollectionIds)},
+				TxnHash: idDisputeTxnHash,
+				Status:  GetJournalTxnStatus(WaitForBlockCompletionErr),
+			})
 
 			//If dispute happens, then storing the bountyId into disputeData file
 			if WaitForBlockCompletionErr == nil {
@@ -129,8 +178,7 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 		}
 
 		// Median Value dispute
-		isEqual, mismatchIndex := utils.IsEqual(proposedBlock.Medians, medians)
-		if !isEqual {
+		if !blockVerification.mediansMatch {
 			log.Warn("BLOCK NOT MATCHING WITH LOCAL CALCULATIONS.")
 			log.Debug("Block Values: ", proposedBlock.Medians)
 			log.Debug("Local Calculations: ", medians)
@@ -138,19 +186,18 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 				// median locally calculated: [100, 200, 300, 500]   median proposed: [100, 230, 300, 500]
 				// ids [1, 2, 3, 4]
 				// Sorted revealed values would be the vote values for the wrong median, here 230
-				collectionIdOfWrongMedian := proposedBlock.Ids[mismatchIndex]
+				collectionIdOfWrongMedian := blockVerification.collectionIdOfWrongMedian
 
 				//collectionId starts from 1 and in SortedRevealedValues, the keys start from 0 which are collectionId-1 mapping to respective revealed data for that collectionId.
 				//e.g. collectionId = [1,2,3,4] & Sorted Reveal Votes: map[0:[100] 1:[200 202] 2:[300]]
 				//Here 0th key in map represents collectionId 1.
 
 				sortedValues := revealedDataMaps.SortedRevealedValues[collectionIdOfWrongMedian-1]
-				leafId, err := utils.UtilsInterface.GetLeafIdOfACollection(client, collectionIdOfWrongMedian)
-				if err != nil {
-					log.Error("Error in leaf id: ", err)
+				if blockVerification.leafIdErr != nil {
+					log.Error("Error in leaf id: ", blockVerification.leafIdErr)
 					continue
 				}
-				disputeErr := cmdUtils.Dispute(client, config, account, epoch, uint8(blockIndex), proposedBlock, leafId, sortedValues)
+				disputeErr := cmdUtils.Dispute(client, config, account, epoch, uint8(blockIndex), proposedBlock, blockVerification.leafId, sortedValues)
 				if disputeErr != nil {
 					log.Error("Error in disputing...", disputeErr)
 					continue
@@ -174,36 +221,109 @@ func (*UtilsStruct) HandleDispute(client *ethclient.Client, config types.Configu
 		Config:         config,
 	})
 	if disputedFlag {
-		cmdUtils.ResetDispute(client, blockManager, txnOpts, epoch)
+		remainingTime, err := utils.UtilsInterface.GetRemainingTimeOfCurrentState(client, config.BufferPercent)
+		if err != nil {
+			log.Error("Error in getting remaining time of dispute state: ", err)
+		}
+		if requiredTime := getDisputeTransactionsTime(client, 1); err == nil && requiredTime > remainingTime {
+			log.Warnf("Not resetting the dispute as resetDispute needs about %d seconds to be mined and %d seconds are left in the dispute state", requiredTime, remainingTime)
+		} else {
+			cmdUtils.ResetDispute(client, blockManager, txnOpts, epoch)
+		}
 	}
 
 	giveSortedLeafIds = []int{}
 	return nil
 }
 
-//This function returns the local median data
-func (*UtilsStruct) GetLocalMediansData(client *ethclient.Client, account types.Account, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) ([]*big.Int, []uint16, *types.RevealedDataMaps, error) {
+//This function verifies the reveals of the epoch against their commitments and logs the inconsistencies found
+func auditReveals(client *ethclient.Client, address string, epoch uint32, blockNumber *big.Int) {
+	inconsistencies, err := cmdUtils.VerifyRevealedValues(client, blockNumber, epoch)
+	if err != nil {
+		log.Error("Error in verifying revealed values: ", err)
+	} else if len(inconsistencies) > 0 {
+		log.Warnf("Found %d inconsistencies in the reveals of epoch %d", len(inconsistencies), epoch)
+		clearAPICacheOnRevealMismatch(client, address, inconsistencies)
+	}
+}
 
-	if _mediansData == nil && !rogueData.IsRogue {
-		fileName, err := razorUtils.GetProposeDataFileName(account.Address)
-		if err != nil {
-			log.Error("Error in getting file name to read median data: ", err)
-			goto CalculateMedian
-		}
-		proposedata, err := razorUtils.ReadFromProposeJsonFile(fileName)
-		if err != nil {
-			log.Errorf("Error in getting propose data from file %s: %t", fileName, err)
-			goto CalculateMedian
-		}
-		if proposedata.Epoch != epoch {
-			log.Errorf("File %s doesn't contain latest median data: %t", fileName, err)
-			goto CalculateMedian
+//This function drops the cached responses of the APIs if the reveal of the staker itself doesn't match its commitment, so that its next commit fetches fresh data
+func clearAPICacheOnRevealMismatch(client *ethclient.Client, address string, inconsistencies []types.RevealInconsistency) {
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		log.Error("Error in getting staker id: ", err)
+		return
+	}
+	for _, inconsistency := range inconsistencies {
+		if inconsistency.StakerId == stakerId {
+			log.Warn("Reveal of the staker doesn't match its commitment, the cached responses of the APIs are fetched again")
+			utils.ClearAPICache()
+			return
 		}
-		_mediansData = proposedata.MediansData
-		_revealedDataMaps = proposedata.RevealedDataMaps
-		_revealedCollectionIds = proposedata.RevealedCollectionIds
 	}
-CalculateMedian:
+}
+
+//proposedBlockVerification is the result of comparing a proposed block with the local data, the disputes it calls for are raised afterwards
+type proposedBlockVerification struct {
+	blockId                   uint32
+	blockIndex                int
+	proposedBlock             bindings.StructsBlock
+	biggestStakeMismatch      bool
+	mediansMatch              bool
+	collectionIdOfWrongMedian uint16
+	leafId                    uint16
+	leafIdErr                 error
+	err                       error
+}
+
+//This function verifies the proposed blocks concurrently with core.MaxConcurrentBlockVerifications workers and returns the verifications in the order of blockIds
+func verifyProposedBlocks(client *ethclient.Client, epoch uint32, blockIds []uint32, sortedProposedBlockIds []uint32, biggestStake *big.Int, medians []*big.Int) []proposedBlockVerification {
+	blockVerifications := make([]proposedBlockVerification, len(blockIds))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < core.MaxConcurrentBlockVerifications && worker < len(blockIds); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				blockVerifications[index] = verifyProposedBlock(client, epoch, blockIds[index], sortedProposedBlockIds, biggestStake, medians)
+			}
+		}()
+	}
+	for index := range blockIds {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+	return blockVerifications
+}
+
+//This function compares the biggest stake and the medians of the proposed block with the local data and fetches the leaf id of the first wrong median
+func verifyProposedBlock(client *ethclient.Client, epoch uint32, blockId uint32, sortedProposedBlockIds []uint32, biggestStake *big.Int, medians []*big.Int) proposedBlockVerification {
+	blockVerification := proposedBlockVerification{blockId: blockId}
+	proposedBlock, err := razorUtils.GetProposedBlock(client, epoch, blockId)
+	if err != nil {
+		blockVerification.err = err
+		return blockVerification
+	}
+	blockVerification.proposedBlock = proposedBlock
+	blockVerification.blockIndex = utils.IndexOf(sortedProposedBlockIds, blockId)
+	if blockVerification.blockIndex == -1 {
+		return blockVerification
+	}
+	blockVerification.biggestStakeMismatch = proposedBlock.BiggestStake.Cmp(biggestStake) != 0 && proposedBlock.Valid
+
+	isEqual, mismatchIndex := utils.IsEqual(proposedBlock.Medians, medians)
+	blockVerification.mediansMatch = isEqual
+	if !isEqual && proposedBlock.Valid && len(proposedBlock.Ids) != 0 && len(proposedBlock.Medians) != 0 {
+		blockVerification.collectionIdOfWrongMedian = proposedBlock.Ids[mismatchIndex]
+		blockVerification.leafId, blockVerification.leafIdErr = disputeComparisons.getLeafIdOfACollection(client, blockVerification.collectionIdOfWrongMedian)
+	}
+	return blockVerification
+}
+
+//This function returns the local median data, which is the block proposed by the staker in the epoch or else the block recomputed from the reveals on chain
+func (*UtilsStruct) GetLocalMediansData(client *ethclient.Client, account types.Account, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) ([]*big.Int, []uint16, *types.RevealedDataMaps, error) {
 	stakerId, err := razorUtils.GetStakerId(client, account.Address)
 	if err != nil {
 		log.Error("Error in getting stakerId: ", err)
@@ -218,6 +338,16 @@ CalculateMedian:
 	nilProposedData := _mediansData == nil || _revealedCollectionIds == nil || _revealedDataMaps == nil
 	epochCheck := epoch != lastProposedEpoch
 
+	// After a restart the block proposed in the epoch is only available in the propose data file
+	if nilProposedData && !epochCheck && !rogueData.IsRogue {
+		reason, err := loadProposeData(account.Address, epoch)
+		if err != nil {
+			log.Warnf("Block proposed in epoch %d can't be loaded from the propose data file, recomputing it from the reveals on chain: %s", epoch, err)
+			metrics.ProposeDataFallbacksMetric.WithLabelValues(reason).Inc()
+		}
+		nilProposedData = _mediansData == nil || _revealedCollectionIds == nil || _revealedDataMaps == nil
+	}
+
 	if nilProposedData || rogueData.IsRogue || epochCheck {
 		medians, revealedCollectionIds, revealedDataMaps, err := cmdUtils.MakeBlock(client, blockNumber, epoch, types.Rogue{IsRogue: false})
 		if err != nil {
@@ -234,15 +364,32 @@ CalculateMedian:
 	return _mediansData, _revealedCollectionIds, _revealedDataMaps, nil
 }
 
+//This function loads the block proposed in the epoch from the propose data file
+//If the file can't be used, the reason is returned along with the error
+func loadProposeData(address string, epoch uint32) (string, error) {
+	fileName, err := razorUtils.GetProposeDataFileName(address)
+	if err != nil {
+		return "file_name", err
+	}
+	proposeData, err := razorUtils.ReadFromProposeJsonFile(fileName)
+	if err != nil {
+		return "unreadable", fmt.Errorf("error in reading %s: %w", fileName, err)
+	}
+	if proposeData.Epoch != epoch {
+		return "stale", fmt.Errorf("%s contains the block of epoch %d", fileName, proposeData.Epoch)
+	}
+	if proposeData.MediansData == nil || proposeData.RevealedCollectionIds == nil || proposeData.RevealedDataMaps == nil {
+		return "incomplete", fmt.Errorf("%s doesn't contain all the data of the block", fileName)
+	}
+	_mediansData = proposeData.MediansData
+	_revealedDataMaps = proposeData.RevealedDataMaps
+	_revealedCollectionIds = proposeData.RevealedCollectionIds
+	return "", nil
+}
+
 //This function check for the dispute in different type of Id's
 func (*UtilsStruct) CheckDisputeForIds(client *ethclient.Client, transactionOpts types.TransactionOptions, epoch uint32, blockIndex uint8, idsInProposedBlock []uint16, revealedCollectionIds []uint16) (*types2.Transaction, error) {
-	//checking for hashing whether there is any dispute or not
-	hashIdsInProposedBlock := solsha3.SoliditySHA3([]string{"uint16[]"}, []interface{}{idsInProposedBlock})
-	hashRevealedCollectionIds := solsha3.SoliditySHA3([]string{"uint16[]"}, []interface{}{revealedCollectionIds})
-
-	isEqual, _ := utils.IsEqualByte(hashIdsInProposedBlock, hashRevealedCollectionIds)
-
-	if isEqual {
+	if collectionIdsMatch(idsInProposedBlock, revealedCollectionIds) {
 		return nil, nil
 	}
 
@@ -309,8 +456,20 @@ func (*UtilsStruct) Dispute(client *ethclient.Client, config types.Configuration
 		Config:         config,
 	})
 
-	if !utils.Contains(giveSortedLeafIds, leafId) {
-		cmdUtils.GiveSorted(client, blockManager, txnOpts, epoch, leafId, sortedValues)
+	if !utils.Contains(giveSortedLeafIds, int(leafId)) {
+		plan, err := planMedianDispute(client, config, txnOpts.From.Hex(), epoch, leafId, len(sortedValues))
+		if err != nil {
+			return err
+		}
+		if plan.requiredTime > plan.remainingTime {
+			log.Warnf("Skipping median dispute of leaf id %d, its %d giveSorted transactions of %d sorted values and finalizeDispute need about %d seconds to be mined and %d seconds are left in the dispute state", leafId, plan.giveSortedTransactions, plan.remainingValues, plan.requiredTime, plan.remainingTime)
+			return errDisputeWindowTooShort
+		}
+		log.Infof("Disputing median of leaf id %d with %d giveSorted transactions of at most %d sorted values and finalizeDispute, which need about %d of the %d seconds left in the dispute state", leafId, plan.giveSortedTransactions, plan.chunkSize, plan.requiredTime, plan.remainingTime)
+		err = cmdUtils.GiveSorted(client, blockManager, txnOpts, epoch, leafId, sortedValues)
+		if err != nil {
+			return err
+		}
 	}
 
 	log.Info("Finalizing dispute...")
@@ -328,6 +487,12 @@ func (*UtilsStruct) Dispute(client *ethclient.Client, config types.Configuration
 	}
 	log.Info("Txn Hash: ", transactionUtils.Hash(finalizeTxn))
 	WaitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(finalizeTxn).String())
+	cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+		Action:  fmt.Sprintf("finalizeDispute:%d", blockIndex),
+		Hashes:  map[string]string{"sortedValues": utils.HashJournalData(sortedValues)},
+		TxnHash: transactionUtils.Hash(finalizeTxn).String(),
+		Status:  GetJournalTxnStatus(WaitForBlockCompletionErr),
+	})
 
 	//If dispute happens, then storing the bountyId into disputeData file
 	if WaitForBlockCompletionErr == nil {
@@ -340,47 +505,165 @@ func (*UtilsStruct) Dispute(client *ethclient.Client, config types.Configuration
 	return nil
 }
 
-//This function sorts the Id's recursively
-func GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, leafId uint16, sortedValues []*big.Int) {
+//This function submits the sorted values in chunks which fit in the block gas limit, the chunk is halved whenever the gas limit is reached
+//The progress is saved after every chunk, so that a restarted node resumes from the last submitted chunk instead of submitting all the values again
+func GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, leafId uint16, sortedValues []*big.Int) error {
 	if len(sortedValues) == 0 {
-		return
+		return nil
 	}
-	txn, err := blockManagerUtils.GiveSorted(blockManager, txnOpts, epoch, leafId, sortedValues)
+	progressFilePath, err := path.PathUtilsInterface.GetGiveSortedProgressFileName(txnOpts.From.Hex())
 	if err != nil {
-		if err.Error() == errors.New("gas limit reached").Error() {
+		log.Error("Error in getting giveSorted progress file name: ", err)
+	}
+	progress := loadGiveSortedProgress(progressFilePath, epoch, leafId, len(sortedValues), getGiveSortedChunkSize(client, len(sortedValues)))
+	for progress.SubmittedValues < len(sortedValues) {
+		chunkEnd := progress.SubmittedValues + progress.ChunkSize
+		if chunkEnd > len(sortedValues) {
+			chunkEnd = len(sortedValues)
+		}
+		txn, err := blockManagerUtils.GiveSorted(blockManager, txnOpts, epoch, leafId, sortedValues[progress.SubmittedValues:chunkEnd])
+		if err != nil {
 			log.Error("Error in calling GiveSorted: ", err)
-			mid := len(sortedValues) / 2
-			GiveSorted(client, blockManager, txnOpts, epoch, leafId, sortedValues[:mid])
-			GiveSorted(client, blockManager, txnOpts, epoch, leafId, sortedValues[mid:])
-		} else {
-			return
+			if err.Error() == errors.New("gas limit reached").Error() && chunkEnd-progress.SubmittedValues > 1 {
+				progress.ChunkSize = (chunkEnd - progress.SubmittedValues) / 2
+				continue
+			}
+			return err
 		}
+		log.Info("Calling GiveSorted...")
+		log.Info("Txn Hash: ", transactionUtils.Hash(txn))
+		giveSortedLeafIds = append(giveSortedLeafIds, int(leafId))
+		err = razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(txn).String())
+		if err != nil {
+			log.Error("Error in WaitForBlockCompletion for giveSorted: ", err)
+			return err
+		}
+		progress.SubmittedValues = chunkEnd
+		saveGiveSortedProgress(progressFilePath, progress)
 	}
-	log.Info("Calling GiveSorted...")
-	log.Info("Txn Hash: ", transactionUtils.Hash(txn))
-	giveSortedLeafIds = append(giveSortedLeafIds, int(leafId))
-	err = razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(txn).String())
+	return nil
+}
+
+//This function plans the giveSorted transactions of the sorted values of the leaf id which aren't submitted yet, in chunks which fit in the block gas limit
+//The time which they and the finalizeDispute transaction need is compared with the time left in the dispute state, so that a dispute which can't be finalized isn't started
+func planMedianDispute(client *ethclient.Client, config types.Configurations, address string, epoch uint32, leafId uint16, numberOfValues int) (medianDisputePlan, error) {
+	remainingTime, err := utils.UtilsInterface.GetRemainingTimeOfCurrentState(client, config.BufferPercent)
+	if err != nil {
+		return medianDisputePlan{}, err
+	}
+	plan := medianDisputePlan{
+		remainingValues: numberOfValues - getSubmittedSortedValues(address, epoch, leafId, numberOfValues),
+		remainingTime:   remainingTime,
+	}
+	if plan.remainingValues > 0 {
+		plan.chunkSize = getGiveSortedChunkSize(client, plan.remainingValues)
+		plan.giveSortedTransactions = (plan.remainingValues + plan.chunkSize - 1) / plan.chunkSize
+	}
+	plan.requiredTime = getDisputeTransactionsTime(client, plan.giveSortedTransactions+1)
+	return plan, nil
+}
+
+//This function returns the number of sorted values of the leaf id which were already submitted in the epoch before a restart
+func getSubmittedSortedValues(address string, epoch uint32, leafId uint16, numberOfValues int) int {
+	progressFilePath, err := path.PathUtilsInterface.GetGiveSortedProgressFileName(address)
+	if err != nil {
+		log.Error("Error in getting giveSorted progress file name: ", err)
+		return 0
+	}
+	return loadGiveSortedProgress(progressFilePath, epoch, leafId, numberOfValues, numberOfValues).SubmittedValues
+}
+
+//This function returns the number of sorted values which a giveSorted transaction can submit within the gas limit of the latest block
+//The values are submitted in a single chunk if the latest block can't be fetched, the chunk is then halved whenever the gas limit is reached
+func getGiveSortedChunkSize(client *ethclient.Client, numberOfValues int) int {
+	header, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		log.Error("Error in getting latest block for the giveSorted chunk size: ", err)
+		return numberOfValues
+	}
+	if header.GasLimit < core.GiveSortedBaseGasEstimate+core.GiveSortedValueGasEstimate {
+		return 1
+	}
+	chunkSize := (header.GasLimit - core.GiveSortedBaseGasEstimate) / core.GiveSortedValueGasEstimate
+	if chunkSize >= uint64(numberOfValues) {
+		return numberOfValues
+	}
+	return int(chunkSize)
+}
+
+//This function returns the seconds which the given number of dispute transactions need to be mined one after another
+func getDisputeTransactionsTime(client *ethclient.Client, transactions int) int64 {
+	blockTime := utils.UtilsInterface.GetAverageBlockTime(client)
+	return int64(math.Ceil((time.Duration(transactions*core.DisputeTransactionBlocks) * blockTime).Seconds()))
+}
+
+//This function returns the saved progress of giveSorted for the leaf id in the epoch, the progress starts again if the saved one is of another dispute
+func loadGiveSortedProgress(progressFilePath string, epoch uint32, leafId uint16, numberOfValues int, chunkSize int) types.GiveSortedProgress {
+	newProgress := types.GiveSortedProgress{Epoch: epoch, LeafId: leafId, ChunkSize: chunkSize}
+	if progressFilePath == "" {
+		return newProgress
+	}
+	progress, err := utils.UtilsInterface.ReadFromGiveSortedProgressFile(progressFilePath)
 	if err != nil {
-		log.Error("Error in WaitForBlockCompletion for giveSorted: ", err)
+		log.Error("Error in reading giveSorted progress: ", err)
+		return newProgress
+	}
+	if progress.Epoch != epoch || progress.LeafId != leafId || progress.ChunkSize <= 0 || progress.SubmittedValues > numberOfValues {
+		return newProgress
 	}
+	log.Infof("Resuming giveSorted of leaf id %d from %d of %d sorted values", leafId, progress.SubmittedValues, numberOfValues)
+	return progress
+}
+
+//This function saves the progress of giveSorted, the errors are only logged as the values can still be submitted
+func saveGiveSortedProgress(progressFilePath string, progress types.GiveSortedProgress) {
+	if progressFilePath == "" {
+		return
+	}
+	err := utils.UtilsInterface.SaveDataToGiveSortedProgressFile(progressFilePath, progress)
+	if err != nil {
+		log.Error("Error in saving giveSorted progress: ", err)
+	}
+}
+
+//This function clears the saved progress of giveSorted of the address
+func clearGiveSortedProgress(address string) error {
+	progressFilePath, err := path.PathUtilsInterface.GetGiveSortedProgressFileName(common.HexToAddress(address).Hex())
+	if err != nil {
+		return err
+	}
+	return utils.UtilsInterface.SaveDataToGiveSortedProgressFile(progressFilePath, types.GiveSortedProgress{})
 }
 
 //This function returns the collection Id position in block
 func (*UtilsStruct) GetCollectionIdPositionInBlock(client *ethclient.Client, leafId uint16, proposedBlock bindings.StructsBlock) *big.Int {
-	ids := proposedBlock.Ids
-	idToBeDisputed, err := utils.UtilsInterface.GetCollectionIdFromLeafId(client, leafId)
+	idToBeDisputed, err := disputeComparisons.getCollectionIdFromLeafId(client, leafId)
 	if err != nil {
 		log.Error("Error in fetching collection id from leaf id")
 		return nil
 	}
+	return getCollectionIdPosition(proposedBlock.Ids, idToBeDisputed)
+}
+
+//This function returns the position of the collection id in the ids of a block
+func getCollectionIdPosition(ids []uint16, collectionId uint16) *big.Int {
 	for i := 0; i < len(ids); i++ {
-		if ids[i] == idToBeDisputed {
+		if ids[i] == collectionId {
 			return big.NewInt(int64(i))
 		}
 	}
 	return nil
 }
 
+//This function checks for hashing whether the ids in the proposed block match the locally revealed collection ids
+func collectionIdsMatch(idsInProposedBlock []uint16, revealedCollectionIds []uint16) bool {
+	hashIdsInProposedBlock := hashCollectionIds(idsInProposedBlock)
+	hashRevealedCollectionIds := disputeComparisons.getRevealedIdsHash(revealedCollectionIds)
+
+	isEqual, _ := utils.IsEqualByte(hashIdsInProposedBlock, hashRevealedCollectionIds)
+	return isEqual
+}
+
 //This function saves the bountyId in disputeData file and return the error if there is any
 func (*UtilsStruct) StoreBountyId(client *ethclient.Client, account types.Account) error {
 	disputeFilePath, err := razorUtils.GetDisputeDataFileName(account.Address)
@@ -401,11 +684,9 @@ func (*UtilsStruct) StoreBountyId(client *ethclient.Client, account types.Accoun
 		return err
 	}
 
-	if _, err := path.OSUtilsInterface.Stat(disputeFilePath); !errors.Is(err, os.ErrNotExist) {
-		disputeData, err = razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
-		if err != nil {
-			return err
-		}
+	disputeData, err = razorUtils.ReadFromDisputeJsonFile(disputeFilePath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
 	}
 
 	if latestBountyId != 0 {
@@ -418,7 +699,7 @@ func (*UtilsStruct) StoreBountyId(client *ethclient.Client, account types.Accoun
 	if err != nil {
 		return err
 	}
-	return nil
+	return utils.InjectStateFileCorruption(core.DisputeFaultPoint, disputeFilePath)
 }
 
 //This function resets the dispute
@@ -427,12 +708,19 @@ func (*UtilsStruct) ResetDispute(client *ethclient.Client, blockManager *binding
 	txn, err := blockManagerUtils.ResetDispute(blockManager, txnOpts, epoch)
 	if err != nil {
 		log.Error("error in resetting dispute", err)
+		return
 	}
 	log.Info("Transaction hash: ", transactionUtils.Hash(txn))
 	log.Info("Dispute has been reset")
 	err = razorUtils.WaitForBlockCompletion(client, transactionUtils.Hash(txn).String())
 	if err != nil {
 		log.Error("Error in WaitForBlockCompletion for resetDispute: ", err)
+		return
+	}
+	//The sorted values submitted by giveSorted are cleared with the dispute, so the saved progress doesn't apply anymore
+	err = clearGiveSortedProgress(txnOpts.From.Hex())
+	if err != nil {
+		log.Error("Error in clearing giveSorted progress: ", err)
 	}
 }
 
@@ -450,7 +738,7 @@ func (*UtilsStruct) GetBountyIdFromEvents(client *ethclient.Client, blockNumber
 			common.HexToAddress(core.StakeManagerAddress),
 		},
 	}
-	logs, err := utils.UtilsInterface.FilterLogsWithRetry(client, query)
+	logs, err := utils.FilterIndexedLogs(client, query)
 	if err != nil {
 		return 0, err
 	}
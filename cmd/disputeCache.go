@@ -0,0 +1,106 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"razor/utils"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	solsha3 "github.com/miguelmota/go-solidity-sha3"
+)
+
+//disputeComparisonCache caches the data which is the same for every proposed block of an epoch, so that each additional block only costs the comparisons of its own data
+//It only lives while the proposed blocks of an epoch are verified, as the leaf ids of the collections can change between epochs
+//The proposed blocks are verified concurrently, so the cached data is guarded by a mutex which isn't held while fetching from the chain
+type disputeComparisonCache struct {
+	mutex                 sync.Mutex
+	revealedCollectionIds []uint16
+	revealedIdsHash       []byte
+	leafIds               map[uint16]uint16
+	collectionIds         map[uint16]uint16
+}
+
+//disputeComparisons is the cache of the epoch being verified, it is nil outside HandleDispute and then nothing is cached
+var disputeComparisons *disputeComparisonCache
+
+func newDisputeComparisonCache() *disputeComparisonCache {
+	return &disputeComparisonCache{
+		leafIds:       make(map[uint16]uint16),
+		collectionIds: make(map[uint16]uint16),
+	}
+}
+
+//This function returns the hash of the ids as computed by the contracts
+func hashCollectionIds(ids []uint16) []byte {
+	return solsha3.SoliditySHA3([]string{"uint16[]"}, []interface{}{ids})
+}
+
+//This function returns the hash of the locally revealed collection ids, which is only computed again if the ids change
+func (cache *disputeComparisonCache) getRevealedIdsHash(revealedCollectionIds []uint16) []byte {
+	if cache == nil {
+		return hashCollectionIds(revealedCollectionIds)
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.revealedIdsHash == nil || !equalCollectionIds(cache.revealedCollectionIds, revealedCollectionIds) {
+		cache.revealedCollectionIds = append([]uint16{}, revealedCollectionIds...)
+		cache.revealedIdsHash = hashCollectionIds(revealedCollectionIds)
+	}
+	return cache.revealedIdsHash
+}
+
+//This function returns the leaf id of the collection, which is only fetched once per epoch
+func (cache *disputeComparisonCache) getLeafIdOfACollection(client *ethclient.Client, collectionId uint16) (uint16, error) {
+	if cache == nil {
+		return utils.UtilsInterface.GetLeafIdOfACollection(client, collectionId)
+	}
+	cache.mutex.Lock()
+	leafId, ok := cache.leafIds[collectionId]
+	cache.mutex.Unlock()
+	if ok {
+		return leafId, nil
+	}
+	leafId, err := utils.UtilsInterface.GetLeafIdOfACollection(client, collectionId)
+	if err != nil {
+		return 0, err
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.leafIds[collectionId] = leafId
+	cache.collectionIds[leafId] = collectionId
+	return leafId, nil
+}
+
+//This function returns the collection id of the leaf id, which is only fetched once per epoch
+func (cache *disputeComparisonCache) getCollectionIdFromLeafId(client *ethclient.Client, leafId uint16) (uint16, error) {
+	if cache == nil {
+		return utils.UtilsInterface.GetCollectionIdFromLeafId(client, leafId)
+	}
+	cache.mutex.Lock()
+	collectionId, ok := cache.collectionIds[leafId]
+	cache.mutex.Unlock()
+	if ok {
+		return collectionId, nil
+	}
+	collectionId, err := utils.UtilsInterface.GetCollectionIdFromLeafId(client, leafId)
+	if err != nil {
+		return 0, err
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.collectionIds[leafId] = collectionId
+	cache.leafIds[collectionId] = leafId
+	return collectionId, nil
+}
+
+func equalCollectionIds(ids1 []uint16, ids2 []uint16) bool {
+	if len(ids1) != len(ids2) {
+		return false
+	}
+	for i := range ids1 {
+		if ids1[i] != ids2[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"errors"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetRevealedIdsHash(t *testing.T) {
+	tests := []struct {
+		name                  string
+		cache                 *disputeComparisonCache
+		revealedCollectionIds [][]uint16
+	}{
+		{
+			name:                  "Test 1: When the hash is computed without a cache",
+			cache:                 nil,
+			revealedCollectionIds: [][]uint16{{1, 2, 3}},
+		},
+		{
+			name:                  "Test 2: When the hash is cached for the same ids",
+			cache:                 newDisputeComparisonCache(),
+			revealedCollectionIds: [][]uint16{{1, 2, 3}, {1, 2, 3}},
+		},
+		{
+			name:                  "Test 3: When the hash is computed again as the ids changed",
+			cache:                 newDisputeComparisonCache(),
+			revealedCollectionIds: [][]uint16{{1, 2, 3}, {1, 2}, {2, 1, 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, ids := range tt.revealedCollectionIds {
+				got := tt.cache.getRevealedIdsHash(ids)
+				if want := hashCollectionIds(ids); !reflect.DeepEqual(got, want) {
+					t.Errorf("getRevealedIdsHash(%v) = %x, want %x", ids, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDisputeComparisonCacheLeafIds(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		leafId          uint16
+		leafIdErr       error
+		collectionId    uint16
+		collectionIdErr error
+	}
+	tests := []struct {
+		name                  string
+		cache                 *disputeComparisonCache
+		args                  args
+		wantLeafIdCalls       int
+		wantCollectionIdCalls int
+		wantErr               bool
+	}{
+		{
+			name:  "Test 1: When the leaf id is fetched once and the collection id is taken from the cache",
+			cache: newDisputeComparisonCache(),
+			args: args{
+				leafId:       4,
+				collectionId: 5,
+			},
+			wantLeafIdCalls:       1,
+			wantCollectionIdCalls: 0,
+			wantErr:               false,
+		},
+		{
+			name:  "Test 2: When nothing is cached without a cache",
+			cache: nil,
+			args: args{
+				leafId:       4,
+				collectionId: 5,
+			},
+			wantLeafIdCalls:       3,
+			wantCollectionIdCalls: 1,
+			wantErr:               false,
+		},
+		{
+			name:  "Test 3: When the errors in fetching the leaf id are not cached",
+			cache: newDisputeComparisonCache(),
+			args: args{
+				leafIdErr:       errors.New("leafId error"),
+				collectionIdErr: errors.New("collectionId error"),
+			},
+			wantLeafIdCalls:       3,
+			wantCollectionIdCalls: 1,
+			wantErr:               true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("GetLeafIdOfACollection", mock.AnythingOfType("*ethclient.Client"), uint16(5)).Return(tt.args.leafId, tt.args.leafIdErr)
+			utilsPkgMock.On("GetCollectionIdFromLeafId", mock.AnythingOfType("*ethclient.Client"), uint16(4)).Return(tt.args.collectionId, tt.args.collectionIdErr)
+
+			for i := 0; i < 3; i++ {
+				leafId, err := tt.cache.getLeafIdOfACollection(client, 5)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("getLeafIdOfACollection() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !tt.wantErr && leafId != 4 {
+					t.Errorf("getLeafIdOfACollection() = %d, want 4", leafId)
+				}
+			}
+			collectionId, err := tt.cache.getCollectionIdFromLeafId(client, 4)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getCollectionIdFromLeafId() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && collectionId != 5 {
+				t.Errorf("getCollectionIdFromLeafId() = %d, want 5", collectionId)
+			}
+			utilsPkgMock.AssertNumberOfCalls(t, "GetLeafIdOfACollection", tt.wantLeafIdCalls)
+			utilsPkgMock.AssertNumberOfCalls(t, "GetCollectionIdFromLeafId", tt.wantCollectionIdCalls)
+		})
+	}
+}
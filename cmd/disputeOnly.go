@@ -0,0 +1,32 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"math/big"
+	"razor/core/types"
+	"razor/utils"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//This function handles the block when the node is running in dispute only mode
+//In this mode no commits, reveals or proposals are sent, the node only verifies every proposed block in the dispute state
+func (*UtilsStruct) HandleDisputeOnlyBlock(client *ethclient.Client, account types.Account, state int64, epoch uint32, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue) {
+	log.Infof("State: %s Epoch: %d Running in dispute only mode", utils.UtilsInterface.GetStateName(state), epoch)
+
+	if state != 3 {
+		return
+	}
+	if lastVerification >= epoch {
+		log.Debugf("Proposed blocks of epoch %d are already verified", epoch)
+		return
+	}
+
+	err := cmdUtils.HandleDispute(client, config, account, epoch, blockNumber, rogueData)
+	if err != nil {
+		log.Error("Error in verifying proposed blocks: ", err)
+		return
+	}
+
+	lastVerification = epoch
+}
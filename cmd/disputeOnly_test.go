@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+)
+
+func TestHandleDisputeOnlyBlock(t *testing.T) {
+	var (
+		client      *ethclient.Client
+		account     types.Account
+		blockNumber *big.Int
+		config      types.Configurations
+		rogueData   types.Rogue
+	)
+
+	type args struct {
+		state            int64
+		epoch            uint32
+		lastVerification uint32
+		handleDisputeErr error
+	}
+	tests := []struct {
+		name                 string
+		args                 args
+		wantLastVerification uint32
+		wantDispute          bool
+	}{
+		{
+			name: "Test 1: When blocks are verified in dispute state",
+			args: args{
+				state: 3,
+				epoch: 5,
+			},
+			wantLastVerification: 5,
+			wantDispute:          true,
+		},
+		{
+			name: "Test 2: When state is not dispute state",
+			args: args{
+				state: 0,
+				epoch: 5,
+			},
+			wantLastVerification: 0,
+			wantDispute:          false,
+		},
+		{
+			name: "Test 3: When blocks of the epoch are already verified",
+			args: args{
+				state:            3,
+				epoch:            5,
+				lastVerification: 5,
+			},
+			wantLastVerification: 5,
+			wantDispute:          false,
+		},
+		{
+			name: "Test 4: When there is an error in HandleDispute",
+			args: args{
+				state:            3,
+				epoch:            5,
+				handleDisputeErr: errors.New("dispute error"),
+			},
+			wantLastVerification: 0,
+			wantDispute:          true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("GetStateName", mock.AnythingOfType("int64")).Return("")
+			cmdUtilsMock.On("HandleDispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.handleDisputeErr)
+
+			lastVerification = tt.args.lastVerification
+			ut := &UtilsStruct{}
+			ut.HandleDisputeOnlyBlock(client, account, tt.args.state, tt.args.epoch, blockNumber, config, rogueData)
+
+			if lastVerification != tt.wantLastVerification {
+				t.Errorf("lastVerification = %d, want %d", lastVerification, tt.wantLastVerification)
+			}
+			if tt.wantDispute {
+				cmdUtilsMock.AssertCalled(t, "HandleDispute", client, config, account, tt.args.epoch, blockNumber, rogueData)
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "HandleDispute", client, config, account, tt.args.epoch, blockNumber, rogueData)
+			}
+		})
+	}
+}
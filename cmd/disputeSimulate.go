@@ -0,0 +1,203 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var disputeCmd = &cobra.Command{
+	Use:   "dispute",
+	Short: "tools to check the disputes raised by the node",
+	Long: `Tools to check the disputes raised by the node without sending any transaction.
+
+Example:
+  ./razor dispute simulate --block-json block.json`,
+}
+
+var disputeSimulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "simulate the dispute the node would raise on a proposed block",
+	Long: `Reads a proposed block along with the biggest stake, the active collections and the reveals of the epoch from a JSON file and prints the dispute the node would raise on the block and the transactions it would send for it.
+Nothing is read from or sent to the chain. The disputes are checked in the same order as the node does and only the first one is returned, as the block is disputed by it.
+
+The dispute is one of none, biggestStakeProposed, orderOfIds, collectionIdShouldBePresent, collectionIdShouldBeAbsent, median or alreadyDisputed.
+
+Example of block.json:
+  {
+    "epoch": 1200,
+    "blockIndex": 0,
+    "block": {"ids": [1, 2], "medians": [100, 230], "biggestStake": 5000, "valid": true},
+    "biggestStake": 5000,
+    "biggestStakerId": 3,
+    "activeCollections": [1, 2],
+    "reveals": [
+      {"stakerId": 3, "influence": 5000, "values": [{"leafId": 0, "value": 100}, {"leafId": 1, "value": 200}]}
+    ]
+  }
+
+Example:
+  ./razor dispute simulate --block-json block.json`,
+	Run: initialiseDisputeSimulate,
+}
+
+//This function initialises the ExecuteDisputeSimulate function
+func initialiseDisputeSimulate(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteDisputeSimulate(cmd.Flags())
+}
+
+//This function reads the proposed block from the file and prints the dispute the node would raise on it
+func (*UtilsStruct) ExecuteDisputeSimulate(flagSet *pflag.FlagSet) {
+	blockJson, err := flagSetUtils.GetStringBlockJson(flagSet)
+	utils.CheckError("Error in getting block json: ", err)
+
+	data, err := os.ReadFile(blockJson)
+	utils.CheckError("Error in reading block json: ", err)
+
+	var input types.DisputeSimulationInput
+	err = json.Unmarshal(data, &input)
+	utils.CheckError("Error in parsing block json: ", err)
+
+	simulation, err := SimulateDispute(input)
+	utils.CheckError("Error in simulating dispute: ", err)
+
+	simulationData, err := json.MarshalIndent(simulation, "", "  ")
+	utils.CheckError("Error in marshalling dispute simulation: ", err)
+	fmt.Println(string(simulationData))
+}
+
+//This function returns the dispute the node would raise on the proposed block and the transactions it would send for it, the disputes are checked in the order of HandleDispute
+//It only depends on its input, so that the edge cases of the disputes can be checked without a chain
+func SimulateDispute(input types.DisputeSimulationInput) (types.DisputeSimulation, error) {
+	block := input.Block
+	if block.BiggestStake == nil || input.BiggestStake == nil {
+		return types.DisputeSimulation{}, errors.New("biggest stake of the block and of the epoch are required")
+	}
+
+	if block.BiggestStake.Cmp(input.BiggestStake) != 0 && block.Valid {
+		return types.DisputeSimulation{
+			Dispute: "biggestStakeProposed",
+			Transactions: []types.DisputeTransaction{{
+				MethodName: "disputeBiggestStakeProposed",
+				Parameters: []interface{}{input.Epoch, input.BlockIndex, input.BiggestStakerId},
+			}},
+		}, nil
+	}
+
+	medians, revealedCollectionIds, revealedDataMaps := simulateLocalMedians(input.ActiveCollections, input.Reveals)
+
+	if !collectionIdsMatch(block.Ids, revealedCollectionIds) {
+		if isSorted, index0, index1 := utils.IsSorted(block.Ids); !isSorted {
+			return types.DisputeSimulation{
+				Dispute: "orderOfIds",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeOnOrderOfIds",
+					Parameters: []interface{}{input.Epoch, input.BlockIndex, big.NewInt(int64(index0)), big.NewInt(int64(index1))},
+				}},
+			}, nil
+		}
+		if isMissing, _, missingCollectionId := utils.IsMissing(revealedCollectionIds, block.Ids); isMissing {
+			return types.DisputeSimulation{
+				Dispute: "collectionIdShouldBePresent",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeCollectionIdShouldBePresent",
+					Parameters: []interface{}{input.Epoch, input.BlockIndex, missingCollectionId},
+				}},
+			}, nil
+		}
+		if isPresent, positionOfPresentValue, presentCollectionId := utils.IsMissing(block.Ids, revealedCollectionIds); isPresent {
+			return types.DisputeSimulation{
+				Dispute: "collectionIdShouldBeAbsent",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeCollectionIdShouldBeAbsent",
+					Parameters: []interface{}{input.Epoch, input.BlockIndex, presentCollectionId, big.NewInt(int64(positionOfPresentValue))},
+				}},
+			}, nil
+		}
+	}
+
+	isEqual, mismatchIndex := utils.IsEqual(block.Medians, medians)
+	if isEqual {
+		return types.DisputeSimulation{Dispute: "none"}, nil
+	}
+	if !block.Valid || len(block.Ids) == 0 || len(block.Medians) == 0 {
+		return types.DisputeSimulation{Dispute: "alreadyDisputed"}, nil
+	}
+	if mismatchIndex >= len(block.Ids) {
+		return types.DisputeSimulation{}, fmt.Errorf("block has %d ids and %d medians", len(block.Ids), len(block.Medians))
+	}
+	collectionIdOfWrongMedian := block.Ids[mismatchIndex]
+	leafId := getCollectionIdPosition(input.ActiveCollections, collectionIdOfWrongMedian)
+	if leafId == nil {
+		return types.DisputeSimulation{}, fmt.Errorf("collection %d of the wrong median is not active", collectionIdOfWrongMedian)
+	}
+	//The sorted values are taken by collectionId-1 as HandleDispute does
+	sortedValues := revealedDataMaps.SortedRevealedValues[collectionIdOfWrongMedian-1]
+	var transactions []types.DisputeTransaction
+	if len(sortedValues) != 0 {
+		transactions = append(transactions, types.DisputeTransaction{
+			MethodName: "giveSorted",
+			Parameters: []interface{}{input.Epoch, uint16(leafId.Uint64()), sortedValues},
+		})
+	}
+	transactions = append(transactions, types.DisputeTransaction{
+		MethodName: "finalizeDispute",
+		Parameters: []interface{}{input.Epoch, input.BlockIndex, getCollectionIdPosition(block.Ids, collectionIdOfWrongMedian)},
+	})
+	return types.DisputeSimulation{
+		Dispute:      "median",
+		Transactions: transactions,
+	}, nil
+}
+
+//This function calculates the medians and the revealed collection ids from the reveals as MakeBlock does
+func simulateLocalMedians(activeCollections []uint16, reveals []types.SimulatedReveal) ([]*big.Int, []uint16, *types.RevealedDataMaps) {
+	revealedStructs := make([]types.RevealedStruct, len(reveals))
+	for i, reveal := range reveals {
+		revealedStructs[i] = types.RevealedStruct{
+			RevealedValues: reveal.Values,
+			Influence:      reveal.Influence,
+			StakerId:       reveal.StakerId,
+		}
+	}
+	revealedDataMaps := sortRevealedValues(revealedStructs)
+
+	var (
+		medians               []*big.Int
+		revealedCollectionIds []uint16
+		accWeight             = new(big.Int)
+	)
+	for leafId := uint16(0); leafId < uint16(len(activeCollections)); leafId++ {
+		influenceSum := revealedDataMaps.InfluenceSum[leafId]
+		if influenceSum == nil || influenceSum.Sign() == 0 {
+			continue
+		}
+		revealedCollectionIds = append(revealedCollectionIds, activeCollections[leafId])
+		//calculateMedian halves the influence sum in place, so it is given a copy to keep the sums of the maps intact
+		median := calculateMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.VoteWeights, new(big.Int).Set(influenceSum), accWeight)
+		if median != nil {
+			medians = append(medians, median)
+		}
+	}
+	return medians, revealedCollectionIds, revealedDataMaps
+}
+
+func init() {
+	rootCmd.AddCommand(disputeCmd)
+	disputeCmd.AddCommand(disputeSimulateCmd)
+
+	var BlockJson string
+
+	disputeSimulateCmd.Flags().StringVarP(&BlockJson, "block-json", "", "", "JSON file with the proposed block and the reveals of the epoch")
+
+	blockJsonErr := disputeSimulateCmd.MarkFlagRequired("block-json")
+	utils.CheckError("Block json error: ", blockJsonErr)
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSimulateDispute(t *testing.T) {
+	reveals := []types.SimulatedReveal{
+		{
+			StakerId:  1,
+			Influence: big.NewInt(100),
+			Values:    []types.AssignedAsset{{LeafId: 0, Value: big.NewInt(100)}, {LeafId: 1, Value: big.NewInt(200)}},
+		},
+		{
+			StakerId:  2,
+			Influence: big.NewInt(50),
+			Values:    []types.AssignedAsset{{LeafId: 0, Value: big.NewInt(110)}, {LeafId: 1, Value: big.NewInt(200)}},
+		},
+	}
+	input := func(block types.SimulatedBlock) types.DisputeSimulationInput {
+		return types.DisputeSimulationInput{
+			Epoch:             5,
+			BlockIndex:        1,
+			Block:             block,
+			BiggestStake:      big.NewInt(1000),
+			BiggestStakerId:   3,
+			ActiveCollections: []uint16{1, 2, 3},
+			Reveals:           reveals,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		input   types.DisputeSimulationInput
+		want    types.DisputeSimulation
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the block matches the local calculations",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(200)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{Dispute: "none"},
+		},
+		{
+			name: "Test 2: When the biggest stake of the block is wrong",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(200)},
+				BiggestStake: big.NewInt(999),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{
+				Dispute: "biggestStakeProposed",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeBiggestStakeProposed",
+					Parameters: []interface{}{uint32(5), uint8(1), uint32(3)},
+				}},
+			},
+		},
+		{
+			name: "Test 3: When the biggest stake of an invalid block is wrong",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(200)},
+				BiggestStake: big.NewInt(999),
+				Valid:        false,
+			}),
+			want: types.DisputeSimulation{Dispute: "none"},
+		},
+		{
+			name: "Test 4: When the ids of the block are not sorted",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{2, 1},
+				Medians:      []*big.Int{big.NewInt(200), big.NewInt(100)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{
+				Dispute: "orderOfIds",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeOnOrderOfIds",
+					Parameters: []interface{}{uint32(5), uint8(1), big.NewInt(0), big.NewInt(1)},
+				}},
+			},
+		},
+		{
+			name: "Test 5: When a revealed collection id is missing in the block",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1},
+				Medians:      []*big.Int{big.NewInt(100)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{
+				Dispute: "collectionIdShouldBePresent",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeCollectionIdShouldBePresent",
+					Parameters: []interface{}{uint32(5), uint8(1), uint16(2)},
+				}},
+			},
+		},
+		{
+			name: "Test 6: When the block has a collection id which is not revealed",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2, 3},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{
+				Dispute: "collectionIdShouldBeAbsent",
+				Transactions: []types.DisputeTransaction{{
+					MethodName: "disputeCollectionIdShouldBeAbsent",
+					Parameters: []interface{}{uint32(5), uint8(1), uint16(3), big.NewInt(2)},
+				}},
+			},
+		},
+		{
+			name: "Test 7: When a median of the block is wrong",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(230)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			want: types.DisputeSimulation{
+				Dispute: "median",
+				Transactions: []types.DisputeTransaction{
+					{
+						MethodName: "giveSorted",
+						Parameters: []interface{}{uint32(5), uint16(1), []*big.Int{big.NewInt(200)}},
+					},
+					{
+						MethodName: "finalizeDispute",
+						Parameters: []interface{}{uint32(5), uint8(1), big.NewInt(1)},
+					},
+				},
+			},
+		},
+		{
+			name: "Test 8: When a median of an invalid block is wrong",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(230)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        false,
+			}),
+			want: types.DisputeSimulation{Dispute: "alreadyDisputed"},
+		},
+		{
+			name: "Test 9: When the block has more medians than ids",
+			input: input(types.SimulatedBlock{
+				Ids:          []uint16{1, 2},
+				Medians:      []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+				BiggestStake: big.NewInt(1000),
+				Valid:        true,
+			}),
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When the biggest stake of the block is not given",
+			input: input(types.SimulatedBlock{
+				Ids:     []uint16{1, 2},
+				Medians: []*big.Int{big.NewInt(100), big.NewInt(200)},
+				Valid:   true,
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimulateDispute(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SimulateDispute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SimulateDispute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteDisputeSimulate(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	blockJson := filepath.Join(t.TempDir(), "block.json")
+	err := os.WriteFile(blockJson, []byte(`{"epoch": 5, "block": {"ids": [1], "medians": [100], "biggestStake": 1000, "valid": true}, "biggestStake": 1000, "activeCollections": [1], "reveals": [{"stakerId": 1, "influence": 100, "values": [{"leafId": 0, "value": 100}]}]}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		blockJson     string
+		blockJsonErr  error
+		expectedFatal bool
+	}{
+		{
+			name:          "Test 1: When the dispute of the block is simulated",
+			blockJson:     blockJson,
+			expectedFatal: false,
+		},
+		{
+			name:          "Test 2: When there is an error in getting the block json",
+			blockJsonErr:  errors.New("block json error"),
+			expectedFatal: true,
+		},
+		{
+			name:          "Test 3: When the block json doesn't exist",
+			blockJson:     filepath.Join(t.TempDir(), "missing.json"),
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetMock := new(mocks.FlagSetInterface)
+			flagSetUtils = flagSetMock
+
+			flagSetMock.On("GetStringBlockJson", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.blockJson, tt.blockJsonErr)
+
+			fatal = false
+			utils := &UtilsStruct{}
+			utils.ExecuteDisputeSimulate(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteDisputeSimulate function didn't execute as expected")
+			}
+		})
+	}
+}
@@ -12,9 +12,9 @@ import (
 	Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/stretchr/testify/mock"
-	"io/fs"
 	"math/big"
 	"razor/cmd/mocks"
+	"razor/core"
 	"razor/core/types"
 	"razor/path"
 	pathMocks "razor/path/mocks"
@@ -23,6 +23,7 @@ import (
 	mocks2 "razor/utils/mocks"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestDispute(t *testing.T) {
@@ -37,12 +38,15 @@ func TestDispute(t *testing.T) {
 		blockIndex    uint8
 		proposedBlock bindings.StructsBlock
 		leafId        uint16
-		sortedValues  []*big.Int
 		blockManager  *bindings.BlockManager
 	)
+	sortedValues := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4)}
 
 	type args struct {
 		containsStatus              bool
+		remainingTime               int64
+		remainingTimeErr            error
+		giveSortedErr               error
 		positionOfCollectionInBlock *big.Int
 		finalizeDisputeTxn          *Types.Transaction
 		finalizeDisputeErr          error
@@ -50,18 +54,23 @@ func TestDispute(t *testing.T) {
 		storeBountyIdErr            error
 	}
 	tests := []struct {
-		name string
-		args args
-		want error
+		name           string
+		args           args
+		wantGiveSorted bool
+		wantFinalize   bool
+		want           error
 	}{
 		{
 			name: "Test 1: When Dispute function executes successfully",
 			args: args{
 				containsStatus:     false,
+				remainingTime:      100,
 				finalizeDisputeTxn: &Types.Transaction{},
 				hash:               common.BigToHash(big.NewInt(1)),
 			},
-			want: nil,
+			wantGiveSorted: true,
+			wantFinalize:   true,
+			want:           nil,
 		},
 		{
 			name: "Test 2: When Dispute function executes successfully without executing giveSorted",
@@ -70,25 +79,64 @@ func TestDispute(t *testing.T) {
 				finalizeDisputeTxn: &Types.Transaction{},
 				hash:               common.BigToHash(big.NewInt(1)),
 			},
-			want: nil,
+			wantGiveSorted: false,
+			wantFinalize:   true,
+			want:           nil,
 		},
 		{
 			name: "Test 3: When FinalizeDispute transaction fails",
 			args: args{
 				containsStatus:     false,
+				remainingTime:      100,
 				finalizeDisputeErr: errors.New("finalizeDispute error"),
 			},
-			want: errors.New("finalizeDispute error"),
+			wantGiveSorted: true,
+			wantFinalize:   true,
+			want:           errors.New("finalizeDispute error"),
 		},
 		{
 			name: "Test 4: When Dispute function executes successfully but there is an error in storing bountyId",
 			args: args{
 				containsStatus:     false,
+				remainingTime:      100,
 				finalizeDisputeTxn: &Types.Transaction{},
 				hash:               common.BigToHash(big.NewInt(1)),
 				storeBountyIdErr:   errors.New("storeBountyId error"),
 			},
-			want: errors.New("storeBountyId error"),
+			wantGiveSorted: true,
+			wantFinalize:   true,
+			want:           errors.New("storeBountyId error"),
+		},
+		{
+			name: "Test 5: When the giveSorted and finalizeDispute transactions can't be mined in the rest of the dispute state",
+			args: args{
+				containsStatus: false,
+				remainingTime:  7,
+			},
+			wantGiveSorted: false,
+			wantFinalize:   false,
+			want:           errDisputeWindowTooShort,
+		},
+		{
+			name: "Test 6: When the giveSorted transactions fail",
+			args: args{
+				containsStatus: false,
+				remainingTime:  100,
+				giveSortedErr:  errors.New("giveSorted error"),
+			},
+			wantGiveSorted: true,
+			wantFinalize:   false,
+			want:           errors.New("giveSorted error"),
+		},
+		{
+			name: "Test 7: When there is an error in getting the remaining time of the dispute state",
+			args: args{
+				containsStatus:   false,
+				remainingTimeErr: errors.New("remaining time error"),
+			},
+			wantGiveSorted: false,
+			wantFinalize:   false,
+			want:           errors.New("remaining time error"),
 		},
 	}
 	for _, tt := range tests {
@@ -98,20 +146,35 @@ func TestDispute(t *testing.T) {
 			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 			blockManagerUtilsMock := new(mocks.BlockManagerInterface)
 			transactionUtilsMock := new(mocks.TransactionInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathMock := new(pathMocks.PathInterface)
 
 			razorUtils = utilsMock
 			cmdUtils = cmdUtilsMock
 			blockManagerUtils = blockManagerUtilsMock
 			transactionUtils = transactionUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathMock
+
+			giveSortedLeafIds = []int{}
+			if tt.args.containsStatus {
+				giveSortedLeafIds = []int{int(leafId)}
+			}
+			defer func() { giveSortedLeafIds = []int{} }()
 
 			utilsMock.On("GetBlockManager", mock.AnythingOfType("*ethclient.Client")).Return(blockManager)
 			utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(txnOpts)
-			cmdUtilsMock.On("GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(tt.args.remainingTime, tt.args.remainingTimeErr)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(2 * time.Second)
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{GasLimit: 30000000}, nil)
+			pathMock.On("GetGiveSortedProgressFileName", mock.AnythingOfType("string")).Return("", errors.New("path error"))
+			cmdUtilsMock.On("GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.giveSortedErr)
 			cmdUtilsMock.On("GetCollectionIdPositionInBlock", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.positionOfCollectionInBlock)
 			blockManagerUtilsMock.On("FinalizeDispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.finalizeDisputeTxn, tt.args.finalizeDisputeErr)
 			transactionUtilsMock.On("Hash", mock.Anything).Return(tt.args.hash)
 			cmdUtilsMock.On("StoreBountyId", mock.Anything, mock.Anything).Return(tt.args.storeBountyIdErr)
 			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 
 			utils := &UtilsStruct{}
 
@@ -125,6 +188,16 @@ func TestDispute(t *testing.T) {
 					t.Errorf("Error for Dispute function, got = %v, want = %v", err, tt.want)
 				}
 			}
+			if tt.wantGiveSorted {
+				cmdUtilsMock.AssertCalled(t, "GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+			if tt.wantFinalize {
+				blockManagerUtilsMock.AssertCalled(t, "FinalizeDispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				blockManagerUtilsMock.AssertNotCalled(t, "FinalizeDispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
 		})
 	}
 }
@@ -164,6 +237,10 @@ func TestHandleDispute(t *testing.T) {
 		leafIdErr                    error
 		disputeErr                   error
 		storeBountyIdErr             error
+		inconsistencies              []types.RevealInconsistency
+		verifyRevealedValuesErr      error
+		stakerId                     uint32
+		stakerIdErr                  error
 	}
 	tests := []struct {
 		name string
@@ -521,6 +598,78 @@ func TestHandleDispute(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "Test 19: When there are inconsistencies in the reveals and there is an error in verifying revealed values",
+			args: args{
+				sortedProposedBlockIds:       []uint32{45, 65, 23, 64, 12},
+				randomSortedProposedBlockIds: []uint32{23, 64, 12, 65, 23},
+				biggestStake:                 big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				biggestStakeId:               2,
+				medians:                      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+				revealedCollectionIds:        []uint16{1},
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: nil,
+					VoteWeights:          nil,
+					InfluenceSum:         nil,
+				},
+				proposedBlock: bindings.StructsBlock{
+					Medians:      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+					Valid:        true,
+					BiggestStake: big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				},
+				inconsistencies:         []types.RevealInconsistency{{StakerId: 3, Reason: "merkle proof of leaf 1 is invalid"}},
+				verifyRevealedValuesErr: errors.New("verify error"),
+			},
+			want: nil,
+		},
+		{
+			name: "Test 20: When the reveal of the staker itself doesn't match its commitment",
+			args: args{
+				sortedProposedBlockIds:       []uint32{45, 65, 23, 64, 12},
+				randomSortedProposedBlockIds: []uint32{23, 64, 12, 65, 23},
+				biggestStake:                 big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				biggestStakeId:               2,
+				medians:                      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+				revealedCollectionIds:        []uint16{1},
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: nil,
+					VoteWeights:          nil,
+					InfluenceSum:         nil,
+				},
+				proposedBlock: bindings.StructsBlock{
+					Medians:      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+					Valid:        true,
+					BiggestStake: big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				},
+				inconsistencies: []types.RevealInconsistency{{StakerId: 3, Reason: "revealed merkle root and signature don't match the commitment"}},
+				stakerId:        3,
+			},
+			want: nil,
+		},
+		{
+			name: "Test 21: When there are inconsistencies in the reveals and there is an error in getting staker id",
+			args: args{
+				sortedProposedBlockIds:       []uint32{45, 65, 23, 64, 12},
+				randomSortedProposedBlockIds: []uint32{23, 64, 12, 65, 23},
+				biggestStake:                 big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				biggestStakeId:               2,
+				medians:                      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+				revealedCollectionIds:        []uint16{1},
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: nil,
+					VoteWeights:          nil,
+					InfluenceSum:         nil,
+				},
+				proposedBlock: bindings.StructsBlock{
+					Medians:      []*big.Int{big.NewInt(6901548), big.NewInt(498307)},
+					Valid:        true,
+					BiggestStake: big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)),
+				},
+				inconsistencies: []types.RevealInconsistency{{StakerId: 3, Reason: "merkle proof of leaf 1 is invalid"}},
+				stakerIdErr:     errors.New("stakerId error"),
+			},
+			want: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -540,20 +689,25 @@ func TestHandleDispute(t *testing.T) {
 			utils.UtilsInterface = utilsPkgMock
 
 			utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.sortedProposedBlockIds, tt.args.sortedProposedBlockIdsErr)
+			cmdUtilsMock.On("VerifyRevealedValues", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(tt.args.inconsistencies, tt.args.verifyRevealedValuesErr)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.stakerId, tt.args.stakerIdErr)
 			cmdUtilsMock.On("GetBiggestStakeAndId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(tt.args.biggestStake, tt.args.biggestStakeId, tt.args.biggestStakeErr)
 			cmdUtilsMock.On("GetLocalMediansData", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.medians, tt.args.revealedCollectionIds, tt.args.revealedDataMaps, tt.args.mediansErr)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 			utilsPkgMock.On("Shuffle", mock.Anything).Return(tt.args.randomSortedProposedBlockIds)
 			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(tt.args.proposedBlock, tt.args.proposedBlockErr)
 			utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(txnOpts)
 			blockManagerUtilsMock.On("DisputeBiggestStakeProposed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.disputeBiggestStakeTxn, tt.args.disputeBiggestStakeErr)
 			transactionUtilsMock.On("Hash", mock.Anything).Return(tt.args.Hash)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil)
 			cmdUtilsMock.On("CheckDisputeForIds", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.idDisputeTxn, tt.args.idDisputeTxnErr)
 			utilsPkgMock.On("GetLeafIdOfACollection", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.leafId, tt.args.leafIdErr)
 			cmdUtilsMock.On("Dispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.disputeErr)
 			utilsMock.On("GetBlockManager", mock.AnythingOfType("*ethclient.Client")).Return(blockManager)
 			cmdUtilsMock.On("StoreBountyId", mock.Anything, mock.Anything).Return(tt.args.storeBountyIdErr)
 			cmdUtilsMock.On("ResetDispute", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything)
+			utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(int64(100), nil)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(2 * time.Second)
 
 			utils := &UtilsStruct{}
 			err := utils.HandleDispute(client, config, account, epoch, blockNumber, rogueData)
@@ -570,51 +724,247 @@ func TestHandleDispute(t *testing.T) {
 	}
 }
 
+func TestHandleDisputeAuditsRevealsAfterDisputes(t *testing.T) {
+	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(31337))
+
+	var client *ethclient.Client
+	var config types.Configurations
+	var account types.Account
+	var epoch uint32
+	var blockNumber *big.Int
+	var rogueData types.Rogue
+	var blockManager *bindings.BlockManager
+
+	utilsMock := new(mocks.UtilsInterface)
+	cmdUtilsMock := new(mocks.UtilsCmdInterface)
+	blockManagerUtilsMock := new(mocks.BlockManagerInterface)
+	transactionUtilsMock := new(mocks.TransactionInterface)
+	utilsPkgMock := new(mocks2.Utils)
+
+	razorUtils = utilsMock
+	cmdUtils = cmdUtilsMock
+	blockManagerUtils = blockManagerUtilsMock
+	transactionUtils = transactionUtilsMock
+	utils.UtilsInterface = utilsPkgMock
+
+	var calls []string
+	medians := []*big.Int{big.NewInt(6901548), big.NewInt(498307)}
+	utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return([]uint32{45}, nil)
+	cmdUtilsMock.On("VerifyRevealedValues", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(nil, nil).Run(func(args mock.Arguments) {
+		calls = append(calls, "VerifyRevealedValues")
+	})
+	cmdUtilsMock.On("GetBiggestStakeAndId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)), uint32(2), nil)
+	cmdUtilsMock.On("GetLocalMediansData", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(medians, []uint16{1}, &types.RevealedDataMaps{}, nil)
+	cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
+	utilsPkgMock.On("Shuffle", mock.Anything).Return([]uint32{45})
+	utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(bindings.StructsBlock{
+		Medians:      medians,
+		Valid:        true,
+		BiggestStake: big.NewInt(1).Mul(big.NewInt(2592), big.NewInt(1e18)),
+	}, nil)
+	utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(txnOpts)
+	blockManagerUtilsMock.On("DisputeBiggestStakeProposed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&Types.Transaction{}, nil).Run(func(args mock.Arguments) {
+		calls = append(calls, "DisputeBiggestStakeProposed")
+	})
+	transactionUtilsMock.On("Hash", mock.Anything).Return(common.BigToHash(big.NewInt(1)))
+	cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil)
+	cmdUtilsMock.On("CheckDisputeForIds", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	utilsMock.On("GetBlockManager", mock.AnythingOfType("*ethclient.Client")).Return(blockManager)
+	cmdUtilsMock.On("StoreBountyId", mock.Anything, mock.Anything).Return(nil)
+	cmdUtilsMock.On("ResetDispute", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything)
+	utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(int64(100), nil)
+	utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(2 * time.Second)
+
+	ut := &UtilsStruct{}
+	if err := ut.HandleDispute(client, config, account, epoch, blockNumber, rogueData); err != nil {
+		t.Fatalf("HandleDispute() error = %v", err)
+	}
+	if !reflect.DeepEqual(calls, []string{"DisputeBiggestStakeProposed", "VerifyRevealedValues"}) {
+		t.Errorf("HandleDispute() calls = %v, want the reveals audited after the disputes are sent", calls)
+	}
+}
+
+func TestVerifyProposedBlocks(t *testing.T) {
+	var (
+		client *ethclient.Client
+		epoch  uint32
+	)
+
+	sortedProposedBlockIds := []uint32{1, 2, 3, 4, 5, 6}
+	biggestStake := big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18))
+	medians := []*big.Int{big.NewInt(6901548), big.NewInt(498307)}
+	matchingBlock := bindings.StructsBlock{
+		Ids:          []uint16{1, 2},
+		Medians:      medians,
+		Valid:        true,
+		BiggestStake: biggestStake,
+	}
+	wrongBlock := bindings.StructsBlock{
+		Ids:          []uint16{1, 2},
+		Medians:      []*big.Int{big.NewInt(6901548), big.NewInt(478307)},
+		Valid:        true,
+		BiggestStake: big.NewInt(1).Mul(big.NewInt(4356), big.NewInt(1e18)),
+	}
+	invalidBlock := bindings.StructsBlock{
+		Ids:          []uint16{1, 2},
+		Medians:      []*big.Int{big.NewInt(6901548), big.NewInt(478307)},
+		Valid:        false,
+		BiggestStake: big.NewInt(1).Mul(big.NewInt(4356), big.NewInt(1e18)),
+	}
+	proposedBlockErr := errors.New("proposedBlock error")
+	leafIdErr := errors.New("leafId error")
+
+	type args struct {
+		blockIds  []uint32
+		leafId    uint16
+		leafIdErr error
+	}
+	tests := []struct {
+		name string
+		args args
+		want []proposedBlockVerification
+	}{
+		{
+			name: "Test 1: When more blocks than workers are verified in the shuffled order",
+			args: args{
+				blockIds: []uint32{3, 6, 1, 5, 2, 4},
+			},
+			want: []proposedBlockVerification{
+				{blockId: 3, blockIndex: 2, proposedBlock: matchingBlock, mediansMatch: true},
+				{blockId: 6, blockIndex: 5, proposedBlock: matchingBlock, mediansMatch: true},
+				{blockId: 1, blockIndex: 0, proposedBlock: matchingBlock, mediansMatch: true},
+				{blockId: 5, blockIndex: 4, proposedBlock: invalidBlock},
+				{blockId: 2, blockIndex: 1, proposedBlock: wrongBlock, biggestStakeMismatch: true, collectionIdOfWrongMedian: 2, leafId: 1},
+				{blockId: 4, blockIndex: 3, proposedBlock: matchingBlock, mediansMatch: true},
+			},
+		},
+		{
+			name: "Test 2: When there is an error in getting the leaf id of the wrong median",
+			args: args{
+				blockIds:  []uint32{2},
+				leafIdErr: leafIdErr,
+			},
+			want: []proposedBlockVerification{
+				{blockId: 2, blockIndex: 1, proposedBlock: wrongBlock, biggestStakeMismatch: true, collectionIdOfWrongMedian: 2, leafIdErr: leafIdErr},
+			},
+		},
+		{
+			name: "Test 3: When the block is not present in the sorted proposed block ids",
+			args: args{
+				blockIds: []uint32{7},
+			},
+			want: []proposedBlockVerification{
+				{blockId: 7, blockIndex: -1, proposedBlock: wrongBlock},
+			},
+		},
+		{
+			name: "Test 4: When there is an error in getting the proposed block",
+			args: args{
+				blockIds: []uint32{8, 1},
+			},
+			want: []proposedBlockVerification{
+				{blockId: 8, err: proposedBlockErr},
+				{blockId: 1, blockIndex: 0, proposedBlock: matchingBlock, mediansMatch: true},
+			},
+		},
+		{
+			name: "Test 5: When there are no proposed blocks",
+			args: args{
+				blockIds: []uint32{},
+			},
+			want: []proposedBlockVerification{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			disputeComparisons = newDisputeComparisonCache()
+			defer func() { disputeComparisons = nil }()
+
+			for _, blockId := range []uint32{1, 3, 4, 6} {
+				utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), blockId).Return(matchingBlock, nil)
+			}
+			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), uint32(2)).Return(wrongBlock, nil)
+			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), uint32(5)).Return(invalidBlock, nil)
+			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), uint32(7)).Return(wrongBlock, nil)
+			utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), uint32(8)).Return(bindings.StructsBlock{}, proposedBlockErr)
+			utilsPkgMock.On("GetLeafIdOfACollection", mock.AnythingOfType("*ethclient.Client"), uint16(2)).Return(uint16(1), tt.args.leafIdErr)
+
+			got := verifyProposedBlocks(client, epoch, tt.args.blockIds, sortedProposedBlockIds, biggestStake, medians)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("verifyProposedBlocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGiveSorted(t *testing.T) {
 	var client *ethclient.Client
 	var blockManager *bindings.BlockManager
-	var txnOpts *bind.TransactOpts
+	txnOpts := &bind.TransactOpts{}
 	var epoch uint32
 	var assetId uint16
+	sortedValues := []*big.Int{big.NewInt(2), big.NewInt(1), big.NewInt(3), big.NewInt(5)}
 	type args struct {
-		sortedValues  []*big.Int
-		giveSorted    *Types.Transaction
-		giveSortedErr error
-		hash          common.Hash
+		sortedValues        []*big.Int
+		giveSorted          *Types.Transaction
+		giveSortedErr       error
+		hash                common.Hash
+		waitErr             error
+		progress            types.GiveSortedProgress
+		progressErr         error
+		progressFileNameErr error
+		gasLimit            uint64
+		latestBlockErr      error
 	}
 	tests := []struct {
-		name string
-		args args
+		name                string
+		args                args
+		wantGiveSortedCalls int
+		wantSavedProgress   []types.GiveSortedProgress
+		wantErr             bool
 	}{
 		{
 			name: "Test 1: When Give Sorted executes successfully",
 			args: args{
-				sortedValues: []*big.Int{big.NewInt(2), big.NewInt(1), big.NewInt(3), big.NewInt(5)},
+				sortedValues: sortedValues,
 				giveSorted:   &Types.Transaction{},
 				hash:         common.BigToHash(big.NewInt(1)),
 			},
+			wantGiveSortedCalls: 1,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 4, ChunkSize: 4}},
 		},
 		{
 			name: "Test 2: When there is an error from GiveSorted",
 			args: args{
-				sortedValues:  []*big.Int{big.NewInt(2), big.NewInt(1), big.NewInt(3), big.NewInt(5)},
+				sortedValues:  sortedValues,
 				giveSortedErr: errors.New("giveSorted error"),
 			},
+			wantGiveSortedCalls: 1,
+			wantErr:             true,
 		},
 		{
 			name: "Test 3: When sortedStakers is nil",
 			args: args{
 				sortedValues: nil,
 			},
+			wantGiveSortedCalls: 0,
 		},
 		{
 			name: "Test 4: When error is gas limit reached",
 			args: args{
-				sortedValues:  []*big.Int{big.NewInt(2), big.NewInt(1), big.NewInt(3), big.NewInt(5)},
+				sortedValues:  sortedValues,
 				giveSortedErr: errors.New("gas limit reached"),
 				giveSorted:    &Types.Transaction{},
 				hash:          common.BigToHash(big.NewInt(1)),
 			},
+			wantGiveSortedCalls: 3,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 2, ChunkSize: 2}, {SubmittedValues: 4, ChunkSize: 2}},
 		},
 		{
 			name: "Test 5: When error is gas limit reached with higher number of stakers",
@@ -624,6 +974,92 @@ func TestGiveSorted(t *testing.T) {
 				giveSorted:    &Types.Transaction{},
 				hash:          common.BigToHash(big.NewInt(1)),
 			},
+			wantGiveSortedCalls: 3,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 10, ChunkSize: 10}, {SubmittedValues: 20, ChunkSize: 10}},
+		},
+		{
+			name: "Test 6: When giveSorted resumes from the last submitted chunk",
+			args: args{
+				sortedValues: sortedValues,
+				giveSorted:   &Types.Transaction{},
+				hash:         common.BigToHash(big.NewInt(1)),
+				progress:     types.GiveSortedProgress{SubmittedValues: 2, ChunkSize: 1},
+			},
+			wantGiveSortedCalls: 2,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 3, ChunkSize: 1}, {SubmittedValues: 4, ChunkSize: 1}},
+		},
+		{
+			name: "Test 7: When all the sorted values were already submitted before the restart",
+			args: args{
+				sortedValues: sortedValues,
+				progress:     types.GiveSortedProgress{SubmittedValues: 4, ChunkSize: 4},
+			},
+			wantGiveSortedCalls: 0,
+		},
+		{
+			name: "Test 8: When the saved progress is of another epoch",
+			args: args{
+				sortedValues: sortedValues,
+				giveSorted:   &Types.Transaction{},
+				hash:         common.BigToHash(big.NewInt(1)),
+				progress:     types.GiveSortedProgress{Epoch: 4, SubmittedValues: 2, ChunkSize: 2},
+			},
+			wantGiveSortedCalls: 1,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 4, ChunkSize: 4}},
+		},
+		{
+			name: "Test 9: When there is an error in reading the saved progress",
+			args: args{
+				sortedValues: sortedValues,
+				giveSorted:   &Types.Transaction{},
+				hash:         common.BigToHash(big.NewInt(1)),
+				progressErr:  errors.New("progress error"),
+			},
+			wantGiveSortedCalls: 1,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 4, ChunkSize: 4}},
+		},
+		{
+			name: "Test 10: When there is an error in getting the progress file name",
+			args: args{
+				sortedValues:        sortedValues,
+				giveSorted:          &Types.Transaction{},
+				hash:                common.BigToHash(big.NewInt(1)),
+				progressFileNameErr: errors.New("path error"),
+			},
+			wantGiveSortedCalls: 1,
+		},
+		{
+			name: "Test 11: When the giveSorted transaction fails",
+			args: args{
+				sortedValues: sortedValues,
+				giveSorted:   &Types.Transaction{},
+				hash:         common.BigToHash(big.NewInt(1)),
+				waitErr:      errors.New("transaction failed"),
+			},
+			wantGiveSortedCalls: 1,
+			wantErr:             true,
+		},
+		{
+			name: "Test 12: When the sorted values don't fit in the block gas limit",
+			args: args{
+				sortedValues: sortedValues,
+				giveSorted:   &Types.Transaction{},
+				hash:         common.BigToHash(big.NewInt(1)),
+				gasLimit:     core.GiveSortedBaseGasEstimate + 3*core.GiveSortedValueGasEstimate,
+			},
+			wantGiveSortedCalls: 2,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 3, ChunkSize: 3}, {SubmittedValues: 4, ChunkSize: 3}},
+		},
+		{
+			name: "Test 13: When there is an error in getting the latest block",
+			args: args{
+				sortedValues:   sortedValues,
+				giveSorted:     &Types.Transaction{},
+				hash:           common.BigToHash(big.NewInt(1)),
+				latestBlockErr: errors.New("block error"),
+			},
+			wantGiveSortedCalls: 1,
+			wantSavedProgress:   []types.GiveSortedProgress{{SubmittedValues: 4, ChunkSize: 4}},
 		},
 	}
 	for _, tt := range tests {
@@ -631,17 +1067,44 @@ func TestGiveSorted(t *testing.T) {
 			utilsMock := new(mocks.UtilsInterface)
 			blockManagerUtilsMock := new(mocks.BlockManagerInterface)
 			transactionUtilsMock := new(mocks.TransactionInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathMock := new(pathMocks.PathInterface)
 
 			razorUtils = utilsMock
 			blockManagerUtils = blockManagerUtilsMock
 			transactionUtils = transactionUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathMock
 
+			var progressFilePath string
+			if tt.args.progressFileNameErr == nil {
+				progressFilePath = "/home/data_files/0x0000000000000000000000000000000000000000_giveSortedProgress.json"
+			}
+			var savedProgress []types.GiveSortedProgress
+
+			pathMock.On("GetGiveSortedProgressFileName", mock.AnythingOfType("string")).Return(progressFilePath, tt.args.progressFileNameErr)
+			utilsPkgMock.On("ReadFromGiveSortedProgressFile", progressFilePath).Return(tt.args.progress, tt.args.progressErr)
+			utilsPkgMock.On("SaveDataToGiveSortedProgressFile", progressFilePath, mock.AnythingOfType("types.GiveSortedProgress")).Run(func(args mock.Arguments) {
+				savedProgress = append(savedProgress, args.Get(1).(types.GiveSortedProgress))
+			}).Return(nil)
 			blockManagerUtilsMock.On("GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.giveSorted, tt.args.giveSortedErr).Once()
 			transactionUtilsMock.On("Hash", mock.Anything).Return(tt.args.hash)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.waitErr)
 			blockManagerUtilsMock.On("GiveSorted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.giveSorted, nil)
+			gasLimit := uint64(30000000)
+			if tt.args.gasLimit != 0 {
+				gasLimit = tt.args.gasLimit
+			}
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{GasLimit: gasLimit}, tt.args.latestBlockErr)
 
-			GiveSorted(client, blockManager, txnOpts, epoch, assetId, tt.args.sortedValues)
+			err := GiveSorted(client, blockManager, txnOpts, epoch, assetId, tt.args.sortedValues)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GiveSorted() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			blockManagerUtilsMock.AssertNumberOfCalls(t, "GiveSorted", tt.wantGiveSortedCalls)
+			if !reflect.DeepEqual(savedProgress, tt.wantSavedProgress) {
+				t.Errorf("GiveSorted() saved progress = %v, want %v", savedProgress, tt.wantSavedProgress)
+			}
 		})
 	}
 }
@@ -653,6 +1116,15 @@ func TestGetLocalMediansData(t *testing.T) {
 		blockNumber *big.Int
 		rogueData   types.Rogue
 	)
+	medians := []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)}
+	proposedMedians := []*big.Int{big.NewInt(101), big.NewInt(201)}
+	proposedData := types.ProposeFileData{
+		Epoch:                 5,
+		MediansData:           proposedMedians,
+		RevealedCollectionIds: []uint16{1, 2},
+		RevealedDataMaps:      &types.RevealedDataMaps{},
+	}
+
 	type args struct {
 		epoch                 uint32
 		fileName              string
@@ -677,36 +1149,50 @@ func TestGetLocalMediansData(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Test 1: When there is an error in getting fileName",
+			name: "Test 1: When there is an error in getting fileName, the block is recomputed",
 			args: args{
-				fileNameErr: errors.New("error in getting fileName"),
+				epoch:                 5,
+				lastProposedEpoch:     5,
+				fileNameErr:           errors.New("error in getting fileName"),
+				medians:               medians,
+				revealedCollectionIds: []uint16{1, 2, 3},
+				revealedDataMaps:      &types.RevealedDataMaps{},
 			},
-			want:    nil,
-			want1:   nil,
-			want2:   nil,
+			want:    medians,
+			want1:   []uint16{1, 2, 3},
+			want2:   &types.RevealedDataMaps{},
 			wantErr: false,
 		},
 		{
-			name: "Test 2: When there is an error in getting proposedData",
+			name: "Test 2: When there is an error in getting proposedData, the block is recomputed",
 			args: args{
-				fileName:       "",
-				proposeDataErr: errors.New("error in getting proposedData"),
+				epoch:                 5,
+				lastProposedEpoch:     5,
+				fileName:              "",
+				proposeDataErr:        errors.New("error in getting proposedData"),
+				medians:               medians,
+				revealedCollectionIds: []uint16{1, 2, 3},
+				revealedDataMaps:      &types.RevealedDataMaps{},
 			},
-			want:    nil,
-			want1:   nil,
-			want2:   nil,
+			want:    medians,
+			want1:   []uint16{1, 2, 3},
+			want2:   &types.RevealedDataMaps{},
 			wantErr: false,
 		},
 		{
-			name: "Test 3: When file does not contain latest data",
+			name: "Test 3: When file does not contain latest data, the block is recomputed",
 			args: args{
-				fileName:     "",
-				proposedData: types.ProposeFileData{Epoch: 3},
-				epoch:        5,
+				epoch:                 5,
+				lastProposedEpoch:     5,
+				fileName:              "",
+				proposedData:          types.ProposeFileData{Epoch: 3, MediansData: proposedMedians},
+				medians:               medians,
+				revealedCollectionIds: []uint16{1, 2, 3},
+				revealedDataMaps:      &types.RevealedDataMaps{},
 			},
-			want:    nil,
-			want1:   nil,
-			want2:   nil,
+			want:    medians,
+			want1:   []uint16{1, 2, 3},
+			want2:   &types.RevealedDataMaps{},
 			wantErr: false,
 		},
 		{
@@ -722,11 +1208,11 @@ func TestGetLocalMediansData(t *testing.T) {
 		{
 			name: "Test 5: When GetLocalMediansData executes successfully",
 			args: args{
-				medians:               []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+				medians:               medians,
 				revealedCollectionIds: []uint16{1, 2, 3},
 				revealedDataMaps:      &types.RevealedDataMaps{},
 			},
-			want:    []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+			want:    medians,
 			want1:   []uint16{1, 2, 3},
 			want2:   &types.RevealedDataMaps{},
 			wantErr: false,
@@ -734,7 +1220,7 @@ func TestGetLocalMediansData(t *testing.T) {
 		{
 			name: "Test 6: When there is an error in getting stakerId",
 			args: args{
-				medians:               []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+				medians:               medians,
 				revealedCollectionIds: []uint16{1, 2, 3},
 				revealedDataMaps:      &types.RevealedDataMaps{},
 				stakerIdErr:           errors.New("stakerId error"),
@@ -747,7 +1233,7 @@ func TestGetLocalMediansData(t *testing.T) {
 		{
 			name: "Test 7: When there is an error in getting last proposed epoch",
 			args: args{
-				medians:               []*big.Int{big.NewInt(100), big.NewInt(200), big.NewInt(300)},
+				medians:               medians,
 				revealedCollectionIds: []uint16{1, 2, 3},
 				revealedDataMaps:      &types.RevealedDataMaps{},
 				stakerId:              2,
@@ -759,6 +1245,36 @@ func TestGetLocalMediansData(t *testing.T) {
 			want2:   nil,
 			wantErr: true,
 		},
+		{
+			name: "Test 8: When the block proposed in the epoch is loaded from the propose data file",
+			args: args{
+				epoch:             5,
+				lastProposedEpoch: 5,
+				fileName:          "",
+				proposedData:      proposedData,
+				mediansErr:        errors.New("block shouldn't be recomputed"),
+			},
+			want:    proposedMedians,
+			want1:   []uint16{1, 2},
+			want2:   &types.RevealedDataMaps{},
+			wantErr: false,
+		},
+		{
+			name: "Test 9: When the propose data file doesn't contain all the data of the block, the block is recomputed",
+			args: args{
+				epoch:                 5,
+				lastProposedEpoch:     5,
+				fileName:              "",
+				proposedData:          types.ProposeFileData{Epoch: 5, MediansData: proposedMedians},
+				medians:               medians,
+				revealedCollectionIds: []uint16{1, 2, 3},
+				revealedDataMaps:      &types.RevealedDataMaps{},
+			},
+			want:    medians,
+			want1:   []uint16{1, 2, 3},
+			want2:   &types.RevealedDataMaps{},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -768,6 +1284,8 @@ func TestGetLocalMediansData(t *testing.T) {
 			razorUtils = utilsMock
 			cmdUtils = cmdUtilsMock
 
+			_mediansData, _revealedCollectionIds, _revealedDataMaps = nil, nil, nil
+
 			utilsMock.On("GetProposeDataFileName", mock.AnythingOfType("string")).Return(tt.args.fileName, tt.args.fileNameErr)
 			utilsMock.On("ReadFromProposeJsonFile", mock.Anything).Return(tt.args.proposedData, tt.args.proposeDataErr)
 			cmdUtilsMock.On("MakeBlock", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything).Return(tt.args.medians, tt.args.revealedCollectionIds, tt.args.revealedDataMaps, tt.args.mediansErr)
@@ -1070,7 +1588,7 @@ func TestGetBountyIdFromEvents(t *testing.T) {
 
 			utilsPkgMock.On("CalculateBlockNumberAtEpochBeginning", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.fromBlock, tt.args.fromBlockErr)
 			abiUtilsMock.On("Parse", mock.Anything).Return(tt.args.contractABI, tt.args.contractABIErr)
-			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
+			utilsPkgMock.On("FilterLogsInChunks", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
 			abiMock.On("Unpack", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.data, tt.args.unpackErr)
 			ut := &UtilsStruct{}
 			got, err := ut.GetBountyIdFromEvents(client, blockNumber, bountyHunter)
@@ -1089,17 +1607,19 @@ func TestResetDispute(t *testing.T) {
 	var (
 		client       *ethclient.Client
 		blockManager *bindings.BlockManager
-		txnOpts      *bind.TransactOpts
 		epoch        uint32
 	)
+	txnOpts := &bind.TransactOpts{}
 	type args struct {
 		ResetDisputeTxn    *Types.Transaction
 		ResetDisputeTxnErr error
 		hash               common.Hash
+		waitErr            error
 	}
 	tests := []struct {
-		name string
-		args args
+		name              string
+		args              args
+		wantProgressClear bool
 	}{
 		{
 			name: "Test 1: When ResetDispute() executes successfully",
@@ -1107,12 +1627,23 @@ func TestResetDispute(t *testing.T) {
 				ResetDisputeTxn: &Types.Transaction{},
 				hash:            common.Hash{1},
 			},
+			wantProgressClear: true,
 		},
 		{
 			name: "Test 2: When there is an error in executing ResetDispute()",
 			args: args{
 				ResetDisputeTxnErr: errors.New("error in resetting dispute"),
 			},
+			wantProgressClear: false,
+		},
+		{
+			name: "Test 3: When the resetDispute transaction fails",
+			args: args{
+				ResetDisputeTxn: &Types.Transaction{},
+				hash:            common.Hash{1},
+				waitErr:         errors.New("transaction failed"),
+			},
+			wantProgressClear: false,
 		},
 	}
 	for _, tt := range tests {
@@ -1120,17 +1651,28 @@ func TestResetDispute(t *testing.T) {
 			utilsMock := new(mocks.UtilsInterface)
 			blockManagerMock := new(mocks.BlockManagerInterface)
 			transactionUtilsMock := new(mocks.TransactionInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathMock := new(pathMocks.PathInterface)
 
 			razorUtils = utilsMock
 			blockManagerUtils = blockManagerMock
 			transactionUtils = transactionUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathMock
 
 			blockManagerMock.On("ResetDispute", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.ResetDisputeTxn, tt.args.ResetDisputeTxnErr)
 			transactionUtilsMock.On("Hash", mock.AnythingOfType("*types.Transaction")).Return(tt.args.hash)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.waitErr)
+			pathMock.On("GetGiveSortedProgressFileName", mock.AnythingOfType("string")).Return("/home/data_files/giveSortedProgress.json", nil)
+			utilsPkgMock.On("SaveDataToGiveSortedProgressFile", mock.AnythingOfType("string"), types.GiveSortedProgress{}).Return(nil)
 
 			ut := &UtilsStruct{}
 			ut.ResetDispute(client, blockManager, txnOpts, epoch)
+			if tt.wantProgressClear {
+				utilsPkgMock.AssertCalled(t, "SaveDataToGiveSortedProgressFile", "/home/data_files/giveSortedProgress.json", types.GiveSortedProgress{})
+			} else {
+				utilsPkgMock.AssertNotCalled(t, "SaveDataToGiveSortedProgressFile", mock.Anything, mock.Anything)
+			}
 		})
 	}
 }
@@ -1211,8 +1753,10 @@ func BenchmarkHandleDispute(b *testing.B) {
 					BiggestStake: big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18))}
 
 				utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(getUint32DummyIds(v.numOfSortedBlocks), nil)
+				cmdUtilsMock.On("VerifyRevealedValues", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(nil, nil)
 				cmdUtilsMock.On("GetBiggestStakeAndId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(big.NewInt(1).Mul(big.NewInt(5356), big.NewInt(1e18)), uint32(2), nil)
 				cmdUtilsMock.On("GetLocalMediansData", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(medians, revealedCollectionIds, revealedDataMaps, nil)
+				cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 				utilsPkgMock.On("Shuffle", mock.Anything).Return(randomSortedPorposedBlockIds)
 				utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(proposedBlock, nil)
 				utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(txnOpts)
@@ -1226,6 +1770,8 @@ func BenchmarkHandleDispute(b *testing.B) {
 				utilsMock.On("GetBlockManager", mock.AnythingOfType("*ethclient.Client")).Return(blockManager)
 				cmdUtilsMock.On("StoreBountyId", mock.Anything, mock.Anything).Return(nil)
 				cmdUtilsMock.On("ResetDispute", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.Anything)
+				utilsPkgMock.On("GetRemainingTimeOfCurrentState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(int64(100), nil)
+				utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(2 * time.Second)
 
 				utils := &UtilsStruct{}
 				err := utils.HandleDispute(client, config, account, epoch, blockNumber, rogueData)
@@ -1240,9 +1786,8 @@ func BenchmarkHandleDispute(b *testing.B) {
 
 func TestStoreBountyId(t *testing.T) {
 	var (
-		client   *ethclient.Client
-		account  types.Account
-		fileInfo fs.FileInfo
+		client  *ethclient.Client
+		account types.Account
 	)
 	type args struct {
 		disputeFilePath    string
@@ -1252,7 +1797,6 @@ func TestStoreBountyId(t *testing.T) {
 		latestHeaderErr    error
 		latestBountyId     uint32
 		latestBountyIdErr  error
-		statErr            error
 		disputeData        types.DisputeFileData
 		disputeDataErr     error
 		saveDataErr        error
@@ -1269,7 +1813,6 @@ func TestStoreBountyId(t *testing.T) {
 				disputedFlag:    true,
 				latestHeader:    &Types.Header{Number: big.NewInt(1)},
 				latestBountyId:  1,
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1}},
 				saveDataErr:     nil,
 			},
@@ -1282,7 +1825,6 @@ func TestStoreBountyId(t *testing.T) {
 				disputedFlag:    true,
 				latestHeader:    &Types.Header{Number: big.NewInt(1)},
 				latestBountyId:  1,
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1, 2}},
 				saveDataErr:     nil,
 			},
@@ -1321,7 +1863,6 @@ func TestStoreBountyId(t *testing.T) {
 				disputedFlag:    true,
 				latestHeader:    &Types.Header{Number: big.NewInt(1)},
 				latestBountyId:  1,
-				statErr:         nil,
 				disputeDataErr:  errors.New("error in getting diapute data"),
 			},
 			wantErr: true,
@@ -1333,7 +1874,6 @@ func TestStoreBountyId(t *testing.T) {
 				disputedFlag:    true,
 				latestHeader:    &Types.Header{Number: big.NewInt(1)},
 				latestBountyId:  1,
-				statErr:         nil,
 				disputeData:     types.DisputeFileData{BountyIdQueue: []uint32{1}},
 				saveDataErr:     errors.New("error in saving data to file"),
 			},
@@ -1345,18 +1885,15 @@ func TestStoreBountyId(t *testing.T) {
 			utilsMock := new(mocks.UtilsInterface)
 			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 			utilsPkgMock := new(mocks2.Utils)
-			osUtilsMock := new(pathMocks.OSInterface)
 
 			razorUtils = utilsMock
 			cmdUtils = cmdUtilsMock
 			utils.UtilsInterface = utilsPkgMock
 			utilsInterface = utilsPkgMock
-			path.OSUtilsInterface = osUtilsMock
 
 			utilsMock.On("GetDisputeDataFileName", mock.AnythingOfType("string")).Return(tt.args.disputeFilePath, tt.args.disputeFilePathErr)
 			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.latestHeader, tt.args.latestHeaderErr)
 			cmdUtilsMock.On("GetBountyIdFromEvents", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.latestBountyId, tt.args.latestBountyIdErr)
-			osUtilsMock.On("Stat", mock.Anything).Return(fileInfo, tt.args.statErr)
 			utilsMock.On("ReadFromDisputeJsonFile", mock.Anything).Return(tt.args.disputeData, tt.args.disputeDataErr)
 			utilsMock.On("SaveDataToDisputeJsonFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.saveDataErr)
 
@@ -0,0 +1,167 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	journalGasSource = "journal"
+	defaultGasSource = "default"
+)
+
+//epochAction is an action of the node in an epoch along with the journal actions whose transactions are used to estimate its gas
+type epochAction struct {
+	name           string
+	journalActions []string
+	defaultGas     uint64
+	possible       bool
+}
+
+var epochActions = []epochAction{
+	{name: "commit", journalActions: []string{"commit"}, defaultGas: core.CommitGasEstimate},
+	{name: "reveal", journalActions: []string{"reveal"}, defaultGas: core.RevealGasEstimate},
+	{name: "propose", journalActions: []string{"propose"}, defaultGas: core.ProposeGasEstimate, possible: true},
+	{name: "claimBlockReward", journalActions: []string{"claimBlockReward"}, defaultGas: core.ClaimBlockRewardGasEstimate, possible: true},
+	{name: "dispute", journalActions: []string{"disputeBiggestStakeProposed", "disputeCollectionIds"}, defaultGas: core.DisputeGasEstimate, possible: true},
+	{name: "finalizeDispute", journalActions: []string{"finalizeDispute"}, defaultGas: core.FinalizeDisputeGasEstimate, possible: true},
+}
+
+var estimateEpochCmd = &cobra.Command{
+	Use:   "estimateEpoch",
+	Short: "estimate the gas cost of an epoch",
+	Long: `Estimates the total gas cost of the actions of the node in the coming epoch at the current gas price and warns if the ETH balance of the account can't cover it.
+The gas of an action is taken from its latest mined transaction in the journal, the default gas of the action is used if there is none.
+Propose, claimBlockReward and the dispute budget are only needed in some epochs and are counted in the maximum cost.
+
+Example:
+  ./razor estimateEpoch --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+	Run: initialiseEstimateEpoch,
+}
+
+//This function initialises the ExecuteEstimateEpoch function
+func initialiseEstimateEpoch(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteEstimateEpoch(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the EstimateEpochGas function
+func (*UtilsStruct) ExecuteEstimateEpoch(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	estimate, err := cmdUtils.EstimateEpochGas(client, config, address)
+	utils.CheckError("Error in estimating epoch gas: ", err)
+
+	printEpochGasEstimate(estimate)
+	if estimate.Balance.Cmp(estimate.ExpectedCost) < 0 {
+		log.Warnf("ETH balance of %s can't cover the expected cost of the epoch, the node won't be able to commit and reveal", address)
+	} else if estimate.Balance.Cmp(estimate.MaximumCost) < 0 {
+		log.Warnf("ETH balance of %s can't cover the maximum cost of the epoch, the node won't be able to propose or dispute", address)
+	}
+}
+
+//This function returns the estimated gas and cost of the actions of the node in an epoch at the current gas price along with the ETH balance of the address
+func (*UtilsStruct) EstimateEpochGas(client *ethclient.Client, config types.Configurations, address string) (types.EpochGasEstimate, error) {
+	fileName, err := path.PathUtilsInterface.GetJournalFileName(address)
+	if err != nil {
+		return types.EpochGasEstimate{}, err
+	}
+	journal, err := utils.UtilsInterface.ReadJournal(fileName)
+	if err != nil {
+		return types.EpochGasEstimate{}, err
+	}
+	balance, err := utils.UtilsInterface.BalanceAtWithRetry(client, common.HexToAddress(address))
+	if err != nil {
+		return types.EpochGasEstimate{}, err
+	}
+
+	estimate := types.EpochGasEstimate{
+		GasPrice:     utils.UtilsInterface.GetGasPrice(client, config),
+		ExpectedCost: big.NewInt(0),
+		MaximumCost:  big.NewInt(0),
+		Balance:      balance,
+	}
+	for _, action := range epochActions {
+		actionEstimate := types.ActionGasEstimate{
+			Action:   action.name,
+			Gas:      action.defaultGas,
+			Source:   defaultGasSource,
+			Possible: action.possible,
+		}
+		if gasUsed, ok := getJournalGasUsed(client, journal, action.journalActions); ok {
+			actionEstimate.Gas = gasUsed
+			actionEstimate.Source = journalGasSource
+		}
+		actionEstimate.Cost = new(big.Int).Mul(new(big.Int).SetUint64(actionEstimate.Gas), estimate.GasPrice)
+		if !action.possible {
+			estimate.ExpectedCost.Add(estimate.ExpectedCost, actionEstimate.Cost)
+		}
+		estimate.MaximumCost.Add(estimate.MaximumCost, actionEstimate.Cost)
+		estimate.Actions = append(estimate.Actions, actionEstimate)
+	}
+	return estimate, nil
+}
+
+//This function returns the gas used by the latest mined transaction of the journal actions
+func getJournalGasUsed(client *ethclient.Client, journal []types.JournalEntry, journalActions []string) (uint64, bool) {
+	for i := len(journal) - 1; i >= 0; i-- {
+		actions := journal[i].Actions
+		for j := len(actions) - 1; j >= 0; j-- {
+			action := actions[j]
+			if action.TxnHash == "" || action.Status != "mined" || !utils.Contains(journalActions, strings.Split(action.Action, ":")[0]) {
+				continue
+			}
+			receipt, err := utils.ClientInterface.TransactionReceipt(client, context.Background(), common.HexToHash(action.TxnHash))
+			if err != nil {
+				log.Debugf("Error in getting receipt of %s transaction %s: %s", action.Action, action.TxnHash, err)
+				continue
+			}
+			return receipt.GasUsed, true
+		}
+	}
+	return 0, false
+}
+
+func printEpochGasEstimate(estimate types.EpochGasEstimate) {
+	log.Infof("Gas price: %g Gwei", new(big.Float).Quo(new(big.Float).SetInt(estimate.GasPrice), big.NewFloat(1e9)))
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Action", "Gas", "Source", "Cost (ETH)", "Possible"})
+	for _, action := range estimate.Actions {
+		table.Append([]string{action.Action, strconv.FormatUint(action.Gas, 10), action.Source, utils.GetAmountInDecimal(action.Cost).String(), strconv.FormatBool(action.Possible)})
+	}
+	table.Render()
+	log.Infof("Expected cost: %s ETH, maximum cost: %s ETH", utils.GetAmountInDecimal(estimate.ExpectedCost).String(), utils.GetAmountInDecimal(estimate.MaximumCost).String())
+	log.Infof("ETH balance: %s ETH", utils.GetAmountInDecimal(estimate.Balance).String())
+}
+
+func init() {
+	rootCmd.AddCommand(estimateEpochCmd)
+
+	var Address string
+
+	estimateEpochCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the account")
+
+	addrErr := estimateEpochCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEstimateEpochGas(t *testing.T) {
+	var (
+		client *ethclient.Client
+		config types.Configurations
+	)
+	address := "0x000000000000000000000000000000000000dEaD"
+	gasPrice := big.NewInt(10)
+	receipts := map[common.Hash]uint64{
+		common.HexToHash("0x01"): 100000,
+		common.HexToHash("0x02"): 200000,
+		common.HexToHash("0x03"): 400000,
+		common.HexToHash("0x04"): 120000,
+	}
+	journal := []types.JournalEntry{
+		{Epoch: 9, Actions: []types.JournalAction{
+			{Action: "commit", TxnHash: "0x04", Status: "mined"},
+		}},
+		{Epoch: 10, Actions: []types.JournalAction{
+			{Action: "commit", TxnHash: "0x01", Status: "mined"},
+			{Action: "reveal", TxnHash: "0x02", Status: "failed"},
+			{Action: "localMedians", Status: "mined"},
+			{Action: "disputeCollectionIds:3", TxnHash: "0x03", Status: "mined"},
+		}},
+	}
+
+	gasOf := func(overrides map[string]uint64) map[string]uint64 {
+		gas := map[string]uint64{
+			"commit":           core.CommitGasEstimate,
+			"reveal":           core.RevealGasEstimate,
+			"propose":          core.ProposeGasEstimate,
+			"claimBlockReward": core.ClaimBlockRewardGasEstimate,
+			"dispute":          core.DisputeGasEstimate,
+			"finalizeDispute":  core.FinalizeDisputeGasEstimate,
+		}
+		for action, actionGas := range overrides {
+			gas[action] = actionGas
+		}
+		return gas
+	}
+
+	type args struct {
+		journal     []types.JournalEntry
+		journalErr  error
+		fileNameErr error
+		balanceErr  error
+		receiptErr  error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantGas     map[string]uint64
+		wantSources map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "Test 1: When there is no journal and the default gas is used",
+			args:        args{},
+			wantGas:     gasOf(nil),
+			wantSources: map[string]string{},
+			wantErr:     false,
+		},
+		{
+			name: "Test 2: When the gas is taken from the latest mined transactions in the journal",
+			args: args{
+				journal: journal,
+			},
+			wantGas:     gasOf(map[string]uint64{"commit": 100000, "dispute": 400000}),
+			wantSources: map[string]string{"commit": journalGasSource, "dispute": journalGasSource},
+			wantErr:     false,
+		},
+		{
+			name: "Test 3: When there is an error in getting the receipts",
+			args: args{
+				journal:    journal,
+				receiptErr: errors.New("receipt error"),
+			},
+			wantGas:     gasOf(nil),
+			wantSources: map[string]string{},
+			wantErr:     false,
+		},
+		{
+			name: "Test 4: When there is an error in getting journal file name",
+			args: args{
+				fileNameErr: errors.New("path error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in reading journal",
+			args: args{
+				journalErr: errors.New("journal error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting balance",
+			args: args{
+				balanceErr: errors.New("balance error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			clientUtilsMock := new(mocks2.ClientUtils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			utils.UtilsInterface = utilsPkgMock
+			utils.ClientInterface = clientUtilsMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			pathUtilsMock.On("GetJournalFileName", mock.AnythingOfType("string")).Return("journal.jsonl", tt.args.fileNameErr)
+			utilsPkgMock.On("ReadJournal", mock.AnythingOfType("string")).Return(tt.args.journal, tt.args.journalErr)
+			utilsPkgMock.On("BalanceAtWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("common.Address")).Return(big.NewInt(1e18), tt.args.balanceErr)
+			utilsPkgMock.On("GetGasPrice", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(gasPrice)
+			for hash, gasUsed := range receipts {
+				clientUtilsMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, hash).Return(&Types.Receipt{GasUsed: gasUsed}, tt.args.receiptErr)
+			}
+
+			ut := &UtilsStruct{}
+			got, err := ut.EstimateEpochGas(client, config, address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EstimateEpochGas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotGas := make(map[string]uint64)
+			expectedCost := big.NewInt(0)
+			maximumCost := big.NewInt(0)
+			for _, action := range got.Actions {
+				gotGas[action.Action] = action.Gas
+				wantSource := tt.wantSources[action.Action]
+				if wantSource == "" {
+					wantSource = defaultGasSource
+				}
+				if action.Source != wantSource {
+					t.Errorf("Source of %s got = %v, want %v", action.Action, action.Source, wantSource)
+				}
+				cost := new(big.Int).Mul(new(big.Int).SetUint64(tt.wantGas[action.Action]), gasPrice)
+				if action.Cost.Cmp(cost) != 0 {
+					t.Errorf("Cost of %s got = %v, want %v", action.Action, action.Cost, cost)
+				}
+				if !action.Possible {
+					expectedCost.Add(expectedCost, cost)
+				}
+				maximumCost.Add(maximumCost, cost)
+			}
+			if !reflect.DeepEqual(gotGas, tt.wantGas) {
+				t.Errorf("Gas of the actions got = %v, want %v", gotGas, tt.wantGas)
+			}
+			wantExpectedCost := new(big.Int).Mul(new(big.Int).SetUint64(tt.wantGas["commit"]+tt.wantGas["reveal"]), gasPrice)
+			if got.ExpectedCost.Cmp(wantExpectedCost) != 0 || got.ExpectedCost.Cmp(expectedCost) != 0 {
+				t.Errorf("ExpectedCost got = %v, want %v", got.ExpectedCost, wantExpectedCost)
+			}
+			if got.MaximumCost.Cmp(maximumCost) != 0 {
+				t.Errorf("MaximumCost got = %v, want %v", got.MaximumCost, maximumCost)
+			}
+			if got.Balance.Cmp(big.NewInt(1e18)) != 0 {
+				t.Errorf("Balance got = %v, want %v", got.Balance, big.NewInt(1e18))
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"os"
+	"razor/core/types"
+	"razor/utils"
+	"sort"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var fleetCmd = &cobra.Command{
+	Use:   "fleet",
+	Short: "compare the nodes of a fleet",
+	Long: `Compares the nodes of a fleet which vote with --fleet and serve their metrics with --metricsPort.
+
+Example:
+  ./razor fleet diff --nodes http://node1:2112,http://node2:2112,http://node3:2112`,
+}
+
+var fleetDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "compare the configuration and the override files of the nodes of a fleet",
+	Long: `Fetches the hashes of the effective configuration and of the override files of the jobs reported by every node and shows the ones in which a node differs from the rest of the fleet.
+The provider, read provider and signer URL are specific to each node and aren't compared. The command exits with status 1 if any node differs, so that it can be used for alerting.
+
+Example:
+  ./razor fleet diff --nodes http://node1:2112,http://node2:2112,http://node3:2112`,
+	Run: initialiseFleetDiff,
+}
+
+//A component of the fleet report in which some nodes differ from the majority of the fleet
+type fleetDrift struct {
+	Component string
+	Hashes    []string
+	Nodes     []string
+}
+
+//This function initialises the ExecuteFleetDiff function
+func initialiseFleetDiff(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteFleetDiff(cmd.Flags())
+}
+
+//This function fetches the fleet reports of the nodes, prints the components in which they differ and exits with status 1 if they do
+func (*UtilsStruct) ExecuteFleetDiff(flagSet *pflag.FlagSet) {
+	nodes, err := flagSetUtils.GetStringSliceNodes(flagSet)
+	utils.CheckError("Error in getting nodes: ", err)
+	if len(nodes) < 2 {
+		log.Fatal("At least two nodes are needed to compare a fleet")
+	}
+
+	reports := make([]types.FleetReport, len(nodes))
+	for i, node := range nodes {
+		report, err := utils.FetchFleetReport(node)
+		utils.CheckError("Error in fetching fleet report of "+node+": ", err)
+		reports[i] = report
+	}
+
+	drifts := diffFleetReports(nodes, reports)
+	if len(drifts) == 0 {
+		log.Infof("Configuration and overrides of the %d nodes match, config hash: %s, overrides hash: %s", len(nodes), reports[0].ConfigHash, reports[0].OverridesHash)
+		return
+	}
+	printFleetDrifts(nodes, drifts)
+	for _, drift := range drifts {
+		log.Errorf("%s of %v differs from the rest of the fleet", drift.Component, drift.Nodes)
+	}
+	osUtils.Exit(1)
+}
+
+//This function returns the components of the fleet reports in which some nodes differ from the hash reported by most of the nodes
+//If hashes are reported by as many nodes, the hash of the first of those nodes is taken as the hash of the fleet
+func diffFleetReports(nodes []string, reports []types.FleetReport) []fleetDrift {
+	components := []string{"version"}
+	seen := make(map[string]bool)
+	for _, report := range reports {
+		for component := range report.Components {
+			if !seen[component] {
+				seen[component] = true
+				components = append(components, component)
+			}
+		}
+	}
+	sort.Strings(components[1:])
+
+	var drifts []fleetDrift
+	for _, component := range components {
+		hashes := make([]string, len(reports))
+		counts := make(map[string]int)
+		for i, report := range reports {
+			hash := report.Components[component]
+			if component == "version" {
+				hash = report.Version
+			}
+			if hash == "" {
+				hash = "-"
+			}
+			hashes[i] = hash
+			counts[hash]++
+		}
+		if len(counts) == 1 {
+			continue
+		}
+		fleetHash := hashes[0]
+		for _, hash := range hashes {
+			if counts[hash] > counts[fleetHash] {
+				fleetHash = hash
+			}
+		}
+		drift := fleetDrift{Component: component, Hashes: hashes}
+		for i, hash := range hashes {
+			if hash != fleetHash {
+				drift.Nodes = append(drift.Nodes, nodes[i])
+			}
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts
+}
+
+//This function prints the hashes of the nodes for the components in which they differ
+func printFleetDrifts(nodes []string, drifts []fleetDrift) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(append([]string{"Component"}, nodes...))
+	for _, drift := range drifts {
+		table.Append(append([]string{drift.Component}, drift.Hashes...))
+	}
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(fleetCmd)
+	fleetCmd.AddCommand(fleetDiffCmd)
+
+	var Nodes []string
+
+	fleetDiffCmd.Flags().StringSliceVarP(&Nodes, "nodes", "", []string{}, "URLs of the metrics servers of the nodes of the fleet")
+
+	nodesErr := fleetDiffCmd.MarkFlagRequired("nodes")
+	utils.CheckError("Nodes error: ", nodesErr)
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDiffFleetReports(t *testing.T) {
+	nodes := []string{"http://node1:2112", "http://node2:2112", "http://node3:2112"}
+	report := types.FleetReport{
+		Version:       "v1.0.0",
+		ConfigHash:    "c1",
+		OverridesHash: "o1",
+		Components: map[string]string{
+			"config.BufferPercent":  "b1",
+			"overrides.assets.json": "a1",
+		},
+	}
+	staleReport := types.FleetReport{
+		Version:       "v1.0.0",
+		ConfigHash:    "c1",
+		OverridesHash: "o2",
+		Components: map[string]string{
+			"config.BufferPercent":  "b1",
+			"overrides.assets.json": "a2",
+		},
+	}
+	oldReport := types.FleetReport{
+		Version:       "v0.9.0",
+		ConfigHash:    "c2",
+		OverridesHash: "o1",
+		Components: map[string]string{
+			"config.BufferPercent":  "b2",
+			"overrides.assets.json": "a1",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		reports []types.FleetReport
+		want    []fleetDrift
+	}{
+		{
+			name:    "Test 1: When all the nodes report the same hashes",
+			reports: []types.FleetReport{report, report, report},
+			want:    nil,
+		},
+		{
+			name:    "Test 2: When a node runs stale overrides",
+			reports: []types.FleetReport{report, staleReport, report},
+			want: []fleetDrift{
+				{Component: "overrides.assets.json", Hashes: []string{"a1", "a2", "a1"}, Nodes: []string{"http://node2:2112"}},
+			},
+		},
+		{
+			name:    "Test 3: When a node runs an older version with a different configuration",
+			reports: []types.FleetReport{oldReport, report, report},
+			want: []fleetDrift{
+				{Component: "version", Hashes: []string{"v0.9.0", "v1.0.0", "v1.0.0"}, Nodes: []string{"http://node1:2112"}},
+				{Component: "config.BufferPercent", Hashes: []string{"b2", "b1", "b1"}, Nodes: []string{"http://node1:2112"}},
+			},
+		},
+		{
+			name:    "Test 4: When every node reports a different hash",
+			reports: []types.FleetReport{report, staleReport, {Version: "v1.0.0", Components: map[string]string{"config.BufferPercent": "b1"}}},
+			want: []fleetDrift{
+				{Component: "overrides.assets.json", Hashes: []string{"a1", "a2", "-"}, Nodes: []string{"http://node2:2112", "http://node3:2112"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffFleetReports(nodes, tt.reports); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffFleetReports() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteFleetDiff(t *testing.T) {
+	var flagSet *pflag.FlagSet
+
+	serveReport := func(report types.FleetReport) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/fleet" {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(report)
+		}))
+	}
+	node := serveReport(types.FleetReport{Version: "v1.0.0", ConfigHash: "c1", OverridesHash: "o1", Components: map[string]string{"overrides.assets.json": "a1"}})
+	defer node.Close()
+	sameNode := serveReport(types.FleetReport{Version: "v1.0.0", ConfigHash: "c1", OverridesHash: "o1", Components: map[string]string{"overrides.assets.json": "a1"}})
+	defer sameNode.Close()
+	staleNode := serveReport(types.FleetReport{Version: "v1.0.0", ConfigHash: "c1", OverridesHash: "o2", Components: map[string]string{"overrides.assets.json": "a2"}})
+	defer staleNode.Close()
+	notFleetNode := httptest.NewServer(http.NotFoundHandler())
+	defer notFleetNode.Close()
+
+	type args struct {
+		nodes    []string
+		nodesErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+		expectedExit  bool
+	}{
+		{
+			name: "Test 1: When the nodes of the fleet match",
+			args: args{
+				nodes: []string{node.URL, sameNode.URL},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When a node of the fleet differs",
+			args: args{
+				nodes: []string{node.URL, staleNode.URL, sameNode.URL},
+			},
+			expectedFatal: false,
+			expectedExit:  true,
+		},
+		{
+			name: "Test 3: When there is an error in getting nodes",
+			args: args{
+				nodes:    []string{node.URL, sameNode.URL},
+				nodesErr: errors.New("nodes error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When only one node is passed",
+			args: args{
+				nodes: []string{node.URL},
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When a node doesn't serve a fleet report",
+			args: args{
+				nodes: []string{node.URL, notFleetNode.URL},
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			osMock := new(mocks.OSInterface)
+
+			flagSetUtils = flagSetUtilsMock
+			osUtils = osMock
+
+			flagSetUtilsMock.On("GetStringSliceNodes", flagSet).Return(tt.args.nodes, tt.args.nodesErr)
+			osMock.On("Exit", mock.AnythingOfType("int")).Return()
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteFleetDiff(flagSet)
+
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteFleetDiff function didn't execute as expected")
+			}
+			if tt.expectedExit {
+				osMock.AssertCalled(t, "Exit", 1)
+			} else if !tt.expectedFatal {
+				osMock.AssertNotCalled(t, "Exit", mock.Anything)
+			}
+		})
+	}
+}
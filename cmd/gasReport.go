@@ -0,0 +1,178 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	gasReportCommandGroup = "command"
+	gasReportEpochGroup   = "epoch"
+)
+
+var gasReportCmd = &cobra.Command{
+	Use:   "gasReport",
+	Short: "show the gas spent by the transactions of an address",
+	Long: `Shows the number of transactions, the failed transactions, the gas used and the gas cost of the transactions sent by the address in the latest epochs, per command or per epoch.
+The report is made from the receipts which are saved in the local store when the transactions are mined, so no block explorer is needed.
+
+Example:
+  ./razor gasReport --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epochs 100
+  ./razor gasReport --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epochs 1000 --groupBy epoch`,
+	Run: initialiseGasReport,
+}
+
+//This function initialises the ExecuteGasReport function
+func initialiseGasReport(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteGasReport(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetGasReport function
+func (*UtilsStruct) ExecuteGasReport(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	epochs, err := flagSetUtils.GetUint32Epochs(flagSet)
+	utils.CheckError("Error in getting epochs: ", err)
+
+	groupBy, err := flagSetUtils.GetStringGroupBy(flagSet)
+	utils.CheckError("Error in getting group by: ", err)
+
+	report, err := cmdUtils.GetGasReport(client, address, epochs, groupBy)
+	utils.CheckError("Error in getting gas report: ", err)
+
+	if utils.IsJsonOutput() {
+		utils.CheckError("Error in printing gas report: ", utils.PrintJson(report))
+		return
+	}
+	printGasReport(report)
+}
+
+//This function returns the gas spent by the transactions of the address in the given number of latest epochs grouped by command or by epoch
+func (*UtilsStruct) GetGasReport(client *ethclient.Client, address string, epochs uint32, groupBy string) (types.GasReport, error) {
+	if !common.IsHexAddress(address) {
+		return types.GasReport{}, errors.New("invalid address")
+	}
+	if groupBy != gasReportCommandGroup && groupBy != gasReportEpochGroup {
+		return types.GasReport{}, fmt.Errorf("invalid groupBy %s, it should be %s or %s", groupBy, gasReportCommandGroup, gasReportEpochGroup)
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return types.GasReport{}, err
+	}
+	var fromEpoch uint32
+	if epochs <= epoch {
+		fromEpoch = epoch - epochs + 1
+	}
+	records, err := utils.UtilsInterface.ReadTransactionReceipts(address, fromEpoch)
+	if err != nil {
+		return types.GasReport{}, err
+	}
+	entries, total := aggregateGasReport(records, groupBy)
+	return types.GasReport{
+		Address: address,
+		GroupBy: groupBy,
+		Entries: entries,
+		Total:   total,
+	}, nil
+}
+
+//This function adds up the receipt records in the groups they belong to and in the total
+//The commands are sorted by name, the epochs are in the order of the records which are sorted by epoch
+func aggregateGasReport(records []types.TransactionReceiptRecord, groupBy string) ([]types.GasReportEntry, types.GasReportEntry) {
+	entries := []types.GasReportEntry{}
+	indexes := make(map[string]int)
+	costs := make(map[string]*big.Int)
+	totalCost := big.NewInt(0)
+	total := types.GasReportEntry{Group: "total"}
+	for _, record := range records {
+		group := record.Method
+		if groupBy == gasReportEpochGroup {
+			group = strconv.FormatUint(uint64(record.Epoch), 10)
+		}
+		index, ok := indexes[group]
+		if !ok {
+			index = len(entries)
+			indexes[group] = index
+			entries = append(entries, types.GasReportEntry{Group: group})
+			costs[group] = big.NewInt(0)
+		}
+		addReceiptRecordToGasReportEntry(&entries[index], costs[group], record)
+		addReceiptRecordToGasReportEntry(&total, totalCost, record)
+	}
+	for i := range entries {
+		entries[i].GasCost = costs[entries[i].Group].String()
+	}
+	total.GasCost = totalCost.String()
+	if groupBy == gasReportCommandGroup {
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Group < entries[j].Group })
+	}
+	return entries, total
+}
+
+//This function adds the transaction of the receipt record to the entry of the gas report and its cost in wei to the cost of the entry
+func addReceiptRecordToGasReportEntry(entry *types.GasReportEntry, cost *big.Int, record types.TransactionReceiptRecord) {
+	entry.Transactions++
+	if record.Status == 0 {
+		entry.Failed++
+	}
+	entry.GasUsed += record.GasUsed
+	if gasCost, ok := new(big.Int).SetString(record.GasCost, 10); ok {
+		cost.Add(cost, gasCost)
+	}
+}
+
+//This function prints the gas report as a table
+func printGasReport(report types.GasReport) {
+	if len(report.Entries) == 0 {
+		log.Infof("No transaction receipts found for %s", report.Address)
+		return
+	}
+	groupHeader := "Command"
+	if report.GroupBy == gasReportEpochGroup {
+		groupHeader = "Epoch"
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{groupHeader, "Transactions", "Failed", "Gas Used", "Gas Cost (ETH)"})
+	for _, entry := range append(report.Entries, report.Total) {
+		table.Append([]string{entry.Group, strconv.Itoa(entry.Transactions), strconv.Itoa(entry.Failed), strconv.FormatUint(entry.GasUsed, 10), formatGasCost(entry.GasCost)})
+	}
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(gasReportCmd)
+
+	var (
+		Address string
+		Epochs  uint32
+		GroupBy string
+	)
+
+	gasReportCmd.Flags().StringVarP(&Address, "address", "a", "", "address which sent the transactions")
+	gasReportCmd.Flags().Uint32VarP(&Epochs, "epochs", "", 100, "number of latest epochs to report")
+	gasReportCmd.Flags().StringVarP(&GroupBy, "groupBy", "", gasReportCommandGroup, "what the gas is grouped by (command or epoch)")
+
+	addrErr := gasReportCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetGasReport(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	records := []types.TransactionReceiptRecord{
+		{Epoch: 100, Method: "commit", Status: 1, GasUsed: 50000, GasCost: "500000"},
+		{Epoch: 100, Method: "reveal", Status: 1, GasUsed: 70000, GasCost: "700000"},
+		{Epoch: 101, Method: "commit", Status: 0, GasUsed: 30000, GasCost: "300000"},
+		{Epoch: 101, Method: "claimBlockReward", Status: 1, GasUsed: 20000, GasCost: "200000"},
+	}
+	total := types.GasReportEntry{Group: "total", Transactions: 4, Failed: 1, GasUsed: 170000, GasCost: "1700000"}
+
+	type args struct {
+		epoch       uint32
+		epochErr    error
+		records     []types.TransactionReceiptRecord
+		receiptsErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		address       string
+		epochs        uint32
+		groupBy       string
+		wantFromEpoch uint32
+		want          types.GasReport
+		wantErr       bool
+	}{
+		{
+			name:          "Test 1: When the gas is grouped by command",
+			args:          args{epoch: 150, records: records},
+			address:       address,
+			epochs:        100,
+			groupBy:       "command",
+			wantFromEpoch: 51,
+			want: types.GasReport{
+				Address: address,
+				GroupBy: "command",
+				Entries: []types.GasReportEntry{
+					{Group: "claimBlockReward", Transactions: 1, GasUsed: 20000, GasCost: "200000"},
+					{Group: "commit", Transactions: 2, Failed: 1, GasUsed: 80000, GasCost: "800000"},
+					{Group: "reveal", Transactions: 1, GasUsed: 70000, GasCost: "700000"},
+				},
+				Total: total,
+			},
+			wantErr: false,
+		},
+		{
+			name:          "Test 2: When the gas is grouped by epoch",
+			args:          args{epoch: 50, records: records},
+			address:       address,
+			epochs:        100,
+			groupBy:       "epoch",
+			wantFromEpoch: 0,
+			want: types.GasReport{
+				Address: address,
+				GroupBy: "epoch",
+				Entries: []types.GasReportEntry{
+					{Group: "100", Transactions: 2, GasUsed: 120000, GasCost: "1200000"},
+					{Group: "101", Transactions: 2, Failed: 1, GasUsed: 50000, GasCost: "500000"},
+				},
+				Total: total,
+			},
+			wantErr: false,
+		},
+		{
+			name:          "Test 3: When there are no receipts",
+			args:          args{epoch: 150},
+			address:       address,
+			epochs:        100,
+			groupBy:       "command",
+			wantFromEpoch: 51,
+			want: types.GasReport{
+				Address: address,
+				GroupBy: "command",
+				Entries: []types.GasReportEntry{},
+				Total:   types.GasReportEntry{Group: "total", GasCost: "0"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Test 4: When the group by is invalid",
+			address: address,
+			epochs:  100,
+			groupBy: "day",
+			wantErr: true,
+		},
+		{
+			name:    "Test 5: When the address is invalid",
+			address: "0x01",
+			epochs:  100,
+			groupBy: "command",
+			wantErr: true,
+		},
+		{
+			name:    "Test 6: When there is an error in getting epoch",
+			args:    args{epochErr: errors.New("epoch error")},
+			address: address,
+			epochs:  100,
+			groupBy: "command",
+			wantErr: true,
+		},
+		{
+			name:          "Test 7: When there is an error in reading the receipts",
+			args:          args{epoch: 150, receiptsErr: errors.New("receipts error")},
+			address:       address,
+			epochs:        100,
+			groupBy:       "command",
+			wantFromEpoch: 51,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsPkgMock.On("ReadTransactionReceipts", tt.address, tt.wantFromEpoch).Return(tt.args.records, tt.args.receiptsErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetGasReport(client, tt.address, tt.epochs, tt.groupBy)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetGasReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetGasReport() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"os"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var getEpochCmd = &cobra.Command{
+	Use:   "getEpoch",
+	Short: "current epoch and state",
+	Long: `Provides the current epoch and the state of the network in it.
+
+Example:
+  ./razor getEpoch --output json`,
+	Run: initialiseGetEpoch,
+}
+
+//This function initialises the ExecuteGetEpoch function
+func initialiseGetEpoch(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteGetEpoch(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetEpochInfo function
+func (*UtilsStruct) ExecuteGetEpoch(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	epochInfo, err := cmdUtils.GetEpochInfo(client)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	if utils.IsJsonOutput() {
+		err = utils.PrintJson(epochInfo)
+		utils.CheckError("Error in printing epoch: ", err)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Epoch", "State", "State Name"})
+	table.Append([]string{
+		strconv.Itoa(int(epochInfo.Epoch)),
+		strconv.Itoa(int(epochInfo.State)),
+		epochInfo.StateName,
+	})
+	table.Render()
+}
+
+//This function returns the current epoch and the state of the network
+func (*UtilsStruct) GetEpochInfo(client *ethclient.Client) (types.EpochInfo, error) {
+	epoch, state, err := cmdUtils.GetEpochAndState(client)
+	if err != nil {
+		return types.EpochInfo{}, err
+	}
+	return types.EpochInfo{
+		Epoch:     epoch,
+		State:     state,
+		StateName: utils.UtilsInterface.GetStateName(state),
+	}, nil
+}
+
+func init() {
+	rootCmd.AddCommand(getEpochCmd)
+}
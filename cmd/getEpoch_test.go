@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetEpochInfo(t *testing.T) {
+	var client *ethclient.Client
+
+	tests := []struct {
+		name          string
+		epoch         uint32
+		state         int64
+		epochStateErr error
+		want          types.EpochInfo
+		wantErr       bool
+	}{
+		{
+			name:  "Test 1: When the epoch and state are fetched",
+			epoch: 5,
+			state: 1,
+			want:  types.EpochInfo{Epoch: 5, State: 1, StateName: "Reveal"},
+		},
+		{
+			name:          "Test 2: When there is an error in getting the epoch and state",
+			epochStateErr: errors.New("epoch error"),
+			want:          types.EpochInfo{},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			cmdUtilsMock.On("GetEpochAndState", mock.AnythingOfType("*ethclient.Client")).Return(tt.epoch, tt.state, tt.epochStateErr)
+			utilsPkgMock.On("GetStateName", int64(1)).Return("Reveal")
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetEpochInfo(client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetEpochInfo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetEpochInfo() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteGetEpoch(t *testing.T) {
+	var client *ethclient.Client
+	var flagSet *pflag.FlagSet
+	var config types.Configurations
+
+	tests := []struct {
+		name          string
+		outputFormat  string
+		configErr     error
+		epochInfoErr  error
+		expectedFatal bool
+	}{
+		{
+			name:          "Test 1: When the epoch is printed in a table",
+			outputFormat:  utils.TableOutput,
+			expectedFatal: false,
+		},
+		{
+			name:          "Test 2: When the epoch is printed as json",
+			outputFormat:  utils.JsonOutput,
+			expectedFatal: false,
+		},
+		{
+			name:          "Test 3: When there is an error in getting config",
+			outputFormat:  utils.TableOutput,
+			configErr:     errors.New("config error"),
+			expectedFatal: true,
+		},
+		{
+			name:          "Test 4: When there is an error in getting the epoch",
+			outputFormat:  utils.TableOutput,
+			epochInfoErr:  errors.New("epoch error"),
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+	defer utils.SetOutputFormat(utils.TableOutput)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+
+			cmdUtilsMock.On("GetConfigData").Return(config, tt.configErr)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			cmdUtilsMock.On("GetEpochInfo", mock.AnythingOfType("*ethclient.Client")).Return(types.EpochInfo{Epoch: 5, State: 1, StateName: "Reveal"}, tt.epochInfoErr)
+
+			if err := utils.SetOutputFormat(tt.outputFormat); err != nil {
+				t.Fatal(err)
+			}
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteGetEpoch(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteGetEpoch function didn't execute as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	historyTableFormat = "table"
+	historyCsvFormat   = "csv"
+	historyJsonFormat  = "json"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "show the ledger of the actions of the staker",
+	Long: `Shows the ledger of the commits, reveals, proposals, disputes and claims of the staker in the latest epochs with their values, transaction hashes and gas costs.
+The ledger can be exported as CSV or JSON for accounting.
+
+Example:
+  ./razor history --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epochs 100
+  ./razor history --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epochs 1000 --format csv --exportFile ledger.csv`,
+	Run: initialiseHistory,
+}
+
+//This function initialises the ExecuteHistory function
+func initialiseHistory(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteHistory(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetHistory function
+func (*UtilsStruct) ExecuteHistory(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	epochs, err := flagSetUtils.GetUint32Epochs(flagSet)
+	utils.CheckError("Error in getting epochs: ", err)
+
+	format, err := flagSetUtils.GetStringHistoryFormat(flagSet)
+	utils.CheckError("Error in getting format: ", err)
+	if utils.IsJsonOutput() {
+		format = historyJsonFormat
+	}
+
+	exportFile, err := flagSetUtils.GetStringExportFile(flagSet)
+	utils.CheckError("Error in getting export file: ", err)
+
+	records, err := cmdUtils.GetHistory(client, address, epochs)
+	utils.CheckError("Error in getting history: ", err)
+
+	writer := io.Writer(os.Stdout)
+	if exportFile != "" {
+		file, err := os.Create(exportFile)
+		utils.CheckError("Error in creating export file: ", err)
+		defer file.Close()
+		writer = file
+	}
+	err = writeHistory(writer, records, format)
+	utils.CheckError("Error in writing history: ", err)
+	if exportFile != "" {
+		log.Infof("Exported %d ledger records to %s", len(records), exportFile)
+	}
+}
+
+//This function returns the ledger records of the address in the given number of latest epochs
+//The gas of the transactions is fetched from their receipts once and saved in the ledger
+func (*UtilsStruct) GetHistory(client *ethclient.Client, address string, epochs uint32) ([]types.LedgerRecord, error) {
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return nil, err
+	}
+	var fromEpoch uint32
+	if epochs <= epoch {
+		fromEpoch = epoch - epochs + 1
+	}
+	records, err := utils.UtilsInterface.ReadLedger(address, fromEpoch)
+	if err != nil {
+		return nil, err
+	}
+	fillLedgerGasCost(client, address, records)
+	return records, nil
+}
+
+//This function fills in the gas of the mined and failed transactions in the ledger records which don't have it yet
+func fillLedgerGasCost(client *ethclient.Client, address string, records []types.LedgerRecord) {
+	for i := range records {
+		if records[i].TxnHash == "" || records[i].GasUsed != 0 || (records[i].Status != "mined" && records[i].Status != "failed") {
+			continue
+		}
+		gasUsed, gasCost, err := getTransactionGasCost(client, records[i].TxnHash)
+		if err != nil {
+			log.Debugf("Error in getting gas cost of %s transaction %s: %s", records[i].Action, records[i].TxnHash, err)
+			continue
+		}
+		records[i].GasUsed = gasUsed
+		records[i].GasCost = gasCost.String()
+		if err := utils.UtilsInterface.SaveLedgerRecord(address, records[i]); err != nil {
+			log.Error("Error in saving gas cost to ledger: ", err)
+		}
+	}
+}
+
+//This function returns the gas used by the transaction and its cost in wei
+func getTransactionGasCost(client *ethclient.Client, txnHash string) (uint64, *big.Int, error) {
+	receipt, err := utils.ClientInterface.TransactionReceipt(client, context.Background(), common.HexToHash(txnHash))
+	if err != nil {
+		return 0, nil, err
+	}
+	txn, _, err := utils.ClientInterface.TransactionByHash(client, context.Background(), common.HexToHash(txnHash))
+	if err != nil {
+		return 0, nil, err
+	}
+	return receipt.GasUsed, new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), txn.GasPrice()), nil
+}
+
+//This function writes the ledger records in the given format
+func writeHistory(writer io.Writer, records []types.LedgerRecord, format string) error {
+	switch format {
+	case historyTableFormat:
+		table := tablewriter.NewWriter(writer)
+		table.SetHeader([]string{"Epoch", "Action", "Status", "Txn Hash", "Gas Used", "Gas Cost (ETH)", "Amount", "Values"})
+		for _, record := range records {
+			table.Append([]string{strconv.FormatUint(uint64(record.Epoch), 10), record.Action, record.Status, record.TxnHash, formatGasUsed(record.GasUsed), formatGasCost(record.GasCost), record.Amount, strconv.Itoa(len(record.Values))})
+		}
+		table.Render()
+		return nil
+	case historyCsvFormat:
+		csvWriter := csv.NewWriter(writer)
+		if err := csvWriter.Write([]string{"epoch", "action", "status", "txnHash", "gasUsed", "gasCostWei", "amount", "timestamp", "values"}); err != nil {
+			return err
+		}
+		for _, record := range records {
+			err := csvWriter.Write([]string{strconv.FormatUint(uint64(record.Epoch), 10), record.Action, record.Status, record.TxnHash, formatGasUsed(record.GasUsed), record.GasCost, record.Amount, strconv.FormatInt(record.Timestamp, 10), strings.Join(record.Values, " ")})
+			if err != nil {
+				return err
+			}
+		}
+		csvWriter.Flush()
+		return csvWriter.Error()
+	case historyJsonFormat:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		if records == nil {
+			records = []types.LedgerRecord{}
+		}
+		return encoder.Encode(records)
+	default:
+		return fmt.Errorf("invalid format %s, it should be %s, %s or %s", format, historyTableFormat, historyCsvFormat, historyJsonFormat)
+	}
+}
+
+//This function returns the gas used as text, it is empty if the gas isn't known
+func formatGasUsed(gasUsed uint64) string {
+	if gasUsed == 0 {
+		return ""
+	}
+	return strconv.FormatUint(gasUsed, 10)
+}
+
+//This function returns the gas cost in wei as text in ETH, it is empty if the cost isn't known
+func formatGasCost(gasCost string) string {
+	cost, ok := new(big.Int).SetString(gasCost, 10)
+	if !ok {
+		return ""
+	}
+	return utils.GetAmountInDecimal(cost).String()
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	var (
+		Address    string
+		Epochs     uint32
+		Format     string
+		ExportFile string
+	)
+
+	historyCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+	historyCmd.Flags().Uint32VarP(&Epochs, "epochs", "", 100, "number of latest epochs to show")
+	historyCmd.Flags().StringVarP(&Format, "format", "", historyTableFormat, "format of the history (table, csv or json)")
+	historyCmd.Flags().StringVarP(&ExportFile, "exportFile", "", "", "file to export the history to instead of printing it")
+
+	addrErr := historyCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetHistory(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+	txn := Types.NewTransaction(1, common.HexToAddress(address), big.NewInt(0), 100000, big.NewInt(10), nil)
+
+	type args struct {
+		epoch      uint32
+		epochErr   error
+		records    []types.LedgerRecord
+		ledgerErr  error
+		receiptErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		epochs        uint32
+		wantFromEpoch uint32
+		want          []types.LedgerRecord
+		wantSaved     bool
+		wantErr       bool
+	}{
+		{
+			name: "Test 1: When the gas cost of a mined transaction is filled in",
+			args: args{
+				epoch: 150,
+				records: []types.LedgerRecord{
+					{Epoch: 100, Action: "localMedians", Status: "calculated"},
+					{Epoch: 100, Action: "commit", TxnHash: "0x01", Status: "mined"},
+				},
+			},
+			epochs:        100,
+			wantFromEpoch: 51,
+			want: []types.LedgerRecord{
+				{Epoch: 100, Action: "localMedians", Status: "calculated"},
+				{Epoch: 100, Action: "commit", TxnHash: "0x01", Status: "mined", GasUsed: 50000, GasCost: "500000"},
+			},
+			wantSaved: true,
+			wantErr:   false,
+		},
+		{
+			name: "Test 2: When the epochs are more than the current epoch",
+			args: args{
+				epoch:   50,
+				records: []types.LedgerRecord{{Epoch: 10, Action: "commit", TxnHash: "0x01", Status: "mined", GasUsed: 50000, GasCost: "500000"}},
+			},
+			epochs:        100,
+			wantFromEpoch: 0,
+			want:          []types.LedgerRecord{{Epoch: 10, Action: "commit", TxnHash: "0x01", Status: "mined", GasUsed: 50000, GasCost: "500000"}},
+			wantSaved:     false,
+			wantErr:       false,
+		},
+		{
+			name: "Test 3: When the receipt of the transaction can't be fetched",
+			args: args{
+				epoch:      150,
+				records:    []types.LedgerRecord{{Epoch: 100, Action: "reveal", TxnHash: "0x02", Status: "mined"}},
+				receiptErr: errors.New("receipt error"),
+			},
+			epochs:        100,
+			wantFromEpoch: 51,
+			want:          []types.LedgerRecord{{Epoch: 100, Action: "reveal", TxnHash: "0x02", Status: "mined"}},
+			wantSaved:     false,
+			wantErr:       false,
+		},
+		{
+			name: "Test 4: When there is an error in getting epoch",
+			args: args{
+				epochErr: errors.New("epoch error"),
+			},
+			epochs:  100,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in reading the ledger",
+			args: args{
+				epoch:     150,
+				ledgerErr: errors.New("ledger error"),
+			},
+			epochs:        100,
+			wantFromEpoch: 51,
+			want:          nil,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			clientUtilsMock := new(mocks2.ClientUtils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utils.ClientInterface = clientUtilsMock
+
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsPkgMock.On("ReadLedger", address, tt.wantFromEpoch).Return(tt.args.records, tt.args.ledgerErr)
+			utilsPkgMock.On("SaveLedgerRecord", address, mock.AnythingOfType("types.LedgerRecord")).Return(nil)
+			clientUtilsMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(&Types.Receipt{GasUsed: 50000}, tt.args.receiptErr)
+			clientUtilsMock.On("TransactionByHash", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(txn, false, nil)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetHistory(client, address, tt.epochs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetHistory() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantSaved {
+				utilsPkgMock.AssertCalled(t, "SaveLedgerRecord", address, tt.want[len(tt.want)-1])
+			} else {
+				utilsPkgMock.AssertNotCalled(t, "SaveLedgerRecord", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestWriteHistory(t *testing.T) {
+	records := []types.LedgerRecord{
+		{Epoch: 100, Action: "commit", Values: []string{"100", "200"}, TxnHash: "0x01", Status: "mined", GasUsed: 50000, GasCost: "500000", Timestamp: 1700000000},
+		{Epoch: 100, Action: "claimBlockReward", TxnHash: "0x02", Status: "failed", Amount: "1.5", Timestamp: 1700000100},
+	}
+
+	var buffer bytes.Buffer
+	if err := writeHistory(&buffer, records, historyCsvFormat); err != nil {
+		t.Fatalf("writeHistory() error = %v", err)
+	}
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRows := [][]string{
+		{"epoch", "action", "status", "txnHash", "gasUsed", "gasCostWei", "amount", "timestamp", "values"},
+		{"100", "commit", "mined", "0x01", "50000", "500000", "", "1700000000", "100 200"},
+		{"100", "claimBlockReward", "failed", "0x02", "", "", "1.5", "1700000100", ""},
+	}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Errorf("writeHistory() csv = %v, want %v", rows, wantRows)
+	}
+
+	if err := writeHistory(&buffer, records, "xml"); err == nil {
+		t.Error("writeHistory() expected an error for an invalid format")
+	}
+}
@@ -42,7 +42,7 @@ func (*UtilsStruct) ImportAccount() (accounts.Account, error) {
 	privateKey = strings.TrimPrefix(privateKey, "0x")
 	log.Info("Enter password to protect keystore file")
 	log.Info("The password should be of minimum 8 characters containing least 1 uppercase, lowercase, digit and special character.")
-	password := razorUtils.PasswordPrompt()
+	password := razorUtils.AssignNewPassword()
 	razorPath, err := razorUtils.GetDefaultPath()
 	if err != nil {
 		log.Error("Error in fetching .razor directory")
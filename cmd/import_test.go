@@ -161,7 +161,7 @@ func TestImportAccount(t *testing.T) {
 			cryptoUtils = cryptoUtilsMock
 
 			utilsMock.On("PrivateKeyPrompt").Return(tt.args.privateKey)
-			utilsMock.On("PasswordPrompt").Return(tt.args.password)
+			utilsMock.On("AssignNewPassword").Return(tt.args.password)
 			utilsMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
 			cryptoUtilsMock.On("HexToECDSA", mock.AnythingOfType("string")).Return(tt.args.ecdsaPrivateKey, tt.args.ecdsaPrivateKeyErr)
 			keystoreUtilsMock.On("ImportECDSA", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.importAccount, tt.args.importAccountErr)
@@ -0,0 +1,171 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var influenceBreakdownCmd = &cobra.Command{
+	Use:   "influenceBreakdown",
+	Short: "influence of the revealing stakers in every collection",
+	Long: `Provides the influence distribution among the stakers who revealed in the current epoch for every collection, with the values they revealed and the median derived from them.
+The median is calculated in the same way as while proposing and disputing, which helps to understand why the dispute checks of a proposed block pass or fail.
+
+Example:
+  ./razor influenceBreakdown --collectionId 3`,
+	Run: initialiseInfluenceBreakdown,
+}
+
+//This function initialises the ExecuteInfluenceBreakdown function
+func initialiseInfluenceBreakdown(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteInfluenceBreakdown(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetInfluenceBreakdown function
+func (*UtilsStruct) ExecuteInfluenceBreakdown(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	collectionId, err := flagSetUtils.GetUint16CollectionId(flagSet)
+	utils.CheckError("Error in getting collectionId: ", err)
+
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	utils.CheckError("Error in getting block: ", err)
+
+	epoch, err := razorUtils.GetEpoch(client)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	breakdown, err := cmdUtils.GetInfluenceBreakdown(client, latestHeader.Number, epoch)
+	utils.CheckError("Error in getting influence breakdown: ", err)
+
+	printInfluenceBreakdown(epoch, filterInfluenceBreakdown(breakdown, collectionId))
+}
+
+//This function returns the influence of the stakers who revealed in the epoch for every collection, sorted by the leaf ids
+//The stakers of a collection are sorted by the revealed values in the order in which their influence is accumulated to calculate the median
+func (*UtilsStruct) GetInfluenceBreakdown(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.CollectionInfluence, error) {
+	revealedData, err := cmdUtils.IndexRevealEventsOfCurrentEpoch(client, blockNumber, epoch)
+	if err != nil {
+		return nil, err
+	}
+	revealedDataMaps := sortRevealedValues(revealedData)
+
+	stakersOfLeaf := make(map[uint16][]types.StakerInfluence)
+	for _, reveal := range revealedData {
+		for _, revealedValue := range reveal.RevealedValues {
+			stakersOfLeaf[revealedValue.LeafId] = append(stakersOfLeaf[revealedValue.LeafId], types.StakerInfluence{
+				StakerId:  reveal.StakerId,
+				Value:     revealedValue.Value,
+				Influence: reveal.Influence,
+			})
+		}
+	}
+	leafIds := make([]uint16, 0, len(stakersOfLeaf))
+	for leafId := range stakersOfLeaf {
+		leafIds = append(leafIds, leafId)
+	}
+	sort.Slice(leafIds, func(i, j int) bool { return leafIds[i] < leafIds[j] })
+
+	breakdown := make([]types.CollectionInfluence, 0, len(leafIds))
+	accWeight := big.NewInt(0)
+	for _, leafId := range leafIds {
+		collectionId, err := utils.UtilsInterface.GetCollectionIdFromLeafId(client, leafId)
+		if err != nil {
+			return nil, err
+		}
+		stakers := stakersOfLeaf[leafId]
+		sort.SliceStable(stakers, func(i, j int) bool {
+			if valueCmp := stakers[i].Value.Cmp(stakers[j].Value); valueCmp != 0 {
+				return valueCmp < 0
+			}
+			return stakers[i].Influence.Cmp(stakers[j].Influence) > 0
+		})
+		influenceSum := revealedDataMaps.InfluenceSum[leafId]
+		// calculateMedian divides the influence sum in place, so a copy is passed
+		median := calculateMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.VoteWeights, new(big.Int).Set(influenceSum), accWeight)
+		breakdown = append(breakdown, types.CollectionInfluence{
+			LeafId:       leafId,
+			CollectionId: collectionId,
+			InfluenceSum: influenceSum,
+			Median:       median,
+			Stakers:      stakers,
+		})
+	}
+	return breakdown, nil
+}
+
+//This function returns the influence breakdown of the collection, all the collections are returned if the collection id is 0
+func filterInfluenceBreakdown(breakdown []types.CollectionInfluence, collectionId uint16) []types.CollectionInfluence {
+	if collectionId == 0 {
+		return breakdown
+	}
+	for _, collectionInfluence := range breakdown {
+		if collectionInfluence.CollectionId == collectionId {
+			return []types.CollectionInfluence{collectionInfluence}
+		}
+	}
+	return nil
+}
+
+//This function prints the influence breakdown of every collection in a table
+func printInfluenceBreakdown(epoch uint32, breakdown []types.CollectionInfluence) {
+	if len(breakdown) == 0 {
+		log.Infof("No values are revealed for the collections in epoch %d", epoch)
+		return
+	}
+	for _, collectionInfluence := range breakdown {
+		median := "-"
+		if collectionInfluence.Median != nil {
+			median = collectionInfluence.Median.String()
+		}
+		fmt.Printf("Epoch: %d Collection Id: %d Leaf Id: %d Influence Revealed: %s Median: %s\n", epoch, collectionInfluence.CollectionId, collectionInfluence.LeafId, collectionInfluence.InfluenceSum, median)
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Staker Id", "Value", "Influence", "Share (%)", "Cumulative Share (%)"})
+		cumulativeInfluence := big.NewInt(0)
+		for _, staker := range collectionInfluence.Stakers {
+			cumulativeInfluence.Add(cumulativeInfluence, staker.Influence)
+			table.Append([]string{
+				strconv.Itoa(int(staker.StakerId)),
+				staker.Value.String(),
+				staker.Influence.String(),
+				getInfluenceShare(staker.Influence, collectionInfluence.InfluenceSum),
+				getInfluenceShare(cumulativeInfluence, collectionInfluence.InfluenceSum),
+			})
+		}
+		table.Render()
+		fmt.Println()
+	}
+}
+
+//This function returns the share of the influence in the influence sum as a percentage
+func getInfluenceShare(influence *big.Int, influenceSum *big.Int) string {
+	if influenceSum.Sign() == 0 {
+		return "0.00"
+	}
+	share := new(big.Float).Quo(new(big.Float).SetInt(influence), new(big.Float).SetInt(influenceSum))
+	return share.Mul(share, big.NewFloat(100)).Text('f', 2)
+}
+
+func init() {
+	rootCmd.AddCommand(influenceBreakdownCmd)
+
+	var CollectionId uint16
+
+	influenceBreakdownCmd.Flags().Uint16VarP(&CollectionId, "collectionId", "", 0, "collection id to show the breakdown of, all the collections are shown by default")
+}
@@ -0,0 +1,314 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetInfluenceBreakdown(t *testing.T) {
+	var client *ethclient.Client
+	blockNumber := big.NewInt(100)
+
+	revealedData := []types.RevealedStruct{
+		{
+			StakerId:  1,
+			Influence: big.NewInt(100),
+			RevealedValues: []types.AssignedAsset{
+				{LeafId: 1, Value: big.NewInt(200)},
+				{LeafId: 0, Value: big.NewInt(10)},
+			},
+		},
+		{
+			StakerId:  2,
+			Influence: big.NewInt(300),
+			RevealedValues: []types.AssignedAsset{
+				{LeafId: 1, Value: big.NewInt(150)},
+			},
+		},
+		{
+			StakerId:  3,
+			Influence: big.NewInt(100),
+			RevealedValues: []types.AssignedAsset{
+				{LeafId: 1, Value: big.NewInt(200)},
+			},
+		},
+	}
+
+	type args struct {
+		revealedData    []types.RevealedStruct
+		revealedDataErr error
+		collectionIdErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []types.CollectionInfluence
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetInfluenceBreakdown executes successfully",
+			args: args{
+				revealedData: revealedData,
+			},
+			want: []types.CollectionInfluence{
+				{
+					LeafId:       0,
+					CollectionId: 1,
+					InfluenceSum: big.NewInt(100),
+					Median:       big.NewInt(10),
+					Stakers: []types.StakerInfluence{
+						{StakerId: 1, Value: big.NewInt(10), Influence: big.NewInt(100)},
+					},
+				},
+				{
+					LeafId:       1,
+					CollectionId: 2,
+					InfluenceSum: big.NewInt(500),
+					Median:       big.NewInt(150),
+					Stakers: []types.StakerInfluence{
+						{StakerId: 2, Value: big.NewInt(150), Influence: big.NewInt(300)},
+						{StakerId: 1, Value: big.NewInt(200), Influence: big.NewInt(100)},
+						{StakerId: 3, Value: big.NewInt(200), Influence: big.NewInt(100)},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When no values are revealed",
+			args: args{
+				revealedData: nil,
+			},
+			want:    []types.CollectionInfluence{},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in indexing reveal events",
+			args: args{
+				revealedDataErr: errors.New("reveal events error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting collection id",
+			args: args{
+				revealedData:    revealedData,
+				collectionIdErr: errors.New("collectionId error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			cmdUtilsMock.On("IndexRevealEventsOfCurrentEpoch", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(tt.args.revealedData, tt.args.revealedDataErr)
+			utilsPkgMock.On("GetCollectionIdFromLeafId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint16")).Return(func(client *ethclient.Client, leafId uint16) uint16 {
+				return leafId + 1
+			}, tt.args.collectionIdErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetInfluenceBreakdown(client, blockNumber, 5)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetInfluenceBreakdown() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetInfluenceBreakdown() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterInfluenceBreakdown(t *testing.T) {
+	breakdown := []types.CollectionInfluence{
+		{LeafId: 0, CollectionId: 1},
+		{LeafId: 1, CollectionId: 3},
+	}
+	tests := []struct {
+		name         string
+		collectionId uint16
+		want         []types.CollectionInfluence
+	}{
+		{
+			name:         "Test 1: When collection id is not passed",
+			collectionId: 0,
+			want:         breakdown,
+		},
+		{
+			name:         "Test 2: When collection id is revealed",
+			collectionId: 3,
+			want:         []types.CollectionInfluence{{LeafId: 1, CollectionId: 3}},
+		},
+		{
+			name:         "Test 3: When collection id is not revealed",
+			collectionId: 2,
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := filterInfluenceBreakdown(breakdown, tt.collectionId); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterInfluenceBreakdown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetInfluenceShare(t *testing.T) {
+	tests := []struct {
+		name         string
+		influence    *big.Int
+		influenceSum *big.Int
+		want         string
+	}{
+		{
+			name:         "Test 1: When influence is a part of the influence sum",
+			influence:    big.NewInt(1),
+			influenceSum: big.NewInt(3),
+			want:         "33.33",
+		},
+		{
+			name:         "Test 2: When influence is the influence sum",
+			influence:    big.NewInt(500),
+			influenceSum: big.NewInt(500),
+			want:         "100.00",
+		},
+		{
+			name:         "Test 3: When influence sum is 0",
+			influence:    big.NewInt(0),
+			influenceSum: big.NewInt(0),
+			want:         "0.00",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getInfluenceShare(tt.influence, tt.influenceSum); got != tt.want {
+				t.Errorf("getInfluenceShare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteInfluenceBreakdown(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	var client *ethclient.Client
+	var config types.Configurations
+
+	type args struct {
+		configErr       error
+		collectionIdErr error
+		latestHeaderErr error
+		epochErr        error
+		breakdown       []types.CollectionInfluence
+		breakdownErr    error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteInfluenceBreakdown executes successfully",
+			args: args{
+				breakdown: []types.CollectionInfluence{
+					{
+						LeafId:       0,
+						CollectionId: 1,
+						InfluenceSum: big.NewInt(100),
+						Median:       big.NewInt(10),
+						Stakers: []types.StakerInfluence{
+							{StakerId: 1, Value: big.NewInt(10), Influence: big.NewInt(100)},
+						},
+					},
+				},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting config",
+			args: args{
+				configErr: errors.New("config error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting collectionId",
+			args: args{
+				collectionIdErr: errors.New("collectionId error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting block",
+			args: args{
+				latestHeaderErr: errors.New("block error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting epoch",
+			args: args{
+				epochErr: errors.New("epoch error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting influence breakdown",
+			args: args{
+				breakdownErr: errors.New("breakdown error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			cmdUtils = cmdUtilsMock
+			flagSetUtils = flagSetUtilsMock
+
+			cmdUtilsMock.On("GetConfigData").Return(config, tt.args.configErr)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetUint16CollectionId", flagSet).Return(uint16(0), tt.args.collectionIdErr)
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(100)}, tt.args.latestHeaderErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(uint32(5), tt.args.epochErr)
+			cmdUtilsMock.On("GetInfluenceBreakdown", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(tt.args.breakdown, tt.args.breakdownErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteInfluenceBreakdown(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteInfluenceBreakdown function didn't execute as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"razor/core/types"
+	"razor/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var inspectCommitCmd = &cobra.Command{
+	Use:   "inspectCommit",
+	Short: "show the raw responses from which the value of a collection was committed in an epoch",
+	Long: `Shows the raw bodies of the responses of the sources of the collection which were used for the commit of the epoch, along with the selector of every job and the value parsed from its response.
+The responses are archived while voting with --archiveResponses and are kept for --archiveRetentionDays, so that reveal mismatches and disputes can be investigated after the epoch.
+
+Example:
+  ./razor inspectCommit --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epoch 12345 --collection 1`,
+	Run: initialiseInspectCommit,
+}
+
+//This function initialises the ExecuteInspectCommit function
+func initialiseInspectCommit(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteInspectCommit(cmd.Flags())
+}
+
+//This function sets the flags appropriately, reads the archived responses of the collection and prints them
+func (*UtilsStruct) ExecuteInspectCommit(flagSet *pflag.FlagSet) {
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	encryptState, err := flagSetUtils.GetBoolEncryptState(flagSet)
+	utils.CheckError("Error in getting encryptState: ", err)
+	if encryptState {
+		password := razorUtils.AssignPassword()
+		err = utils.InitStateEncryption(password)
+		utils.CheckError("Error in initialising state encryption: ", err)
+	}
+
+	epoch, err := flagSetUtils.GetUint32Epoch(flagSet)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	collectionId, err := flagSetUtils.GetUint16Collection(flagSet)
+	utils.CheckError("Error in getting collection: ", err)
+
+	collection, err := getArchivedCollection(address, epoch, collectionId)
+	utils.CheckError("Error in getting archived responses: ", err)
+
+	if utils.IsJsonOutput() {
+		utils.CheckError("Error in printing archived responses: ", utils.PrintJson(collection))
+		return
+	}
+	printArchivedCollection(epoch, collection)
+}
+
+//This function returns the archived responses of the collection committed by the address in the epoch with their bodies
+func getArchivedCollection(address string, epoch uint32, collectionId uint16) (types.ArchivedCollection, error) {
+	if !common.IsHexAddress(address) {
+		return types.ArchivedCollection{}, errors.New("invalid address")
+	}
+	entry, err := utils.UtilsInterface.ReadResponseArchive(address, epoch)
+	if err != nil {
+		return types.ArchivedCollection{}, err
+	}
+	for _, collection := range entry.Collections {
+		if collection.CollectionId != collectionId {
+			continue
+		}
+		responses := make([]types.ArchivedResponse, len(collection.Responses))
+		for i, response := range collection.Responses {
+			body, err := utils.UtilsInterface.ReadArchivedResponseBody(address, response.BodyHash)
+			if err != nil {
+				return types.ArchivedCollection{}, err
+			}
+			response.Body = string(body)
+			responses[i] = response
+		}
+		collection.Responses = responses
+		return collection, nil
+	}
+	return types.ArchivedCollection{}, fmt.Errorf("collection %d was not committed in epoch %d", collectionId, epoch)
+}
+
+//This function archives the responses of the sources used for the commit of the epoch, the errors are only logged as the archive shouldn't stop the voting
+func archiveCommitResponses(address string, epoch uint32, collections []types.AttestedCollection) {
+	if !utils.IsResponseArchiveEnabled() {
+		return
+	}
+	err := utils.UtilsInterface.SaveResponseArchive(address, epoch, collections)
+	if err != nil {
+		log.Error("Error in archiving responses: ", err)
+		return
+	}
+	log.Debugf("Responses used for the commit of epoch %d archived", epoch)
+}
+
+//This function prints the committed value of the collection and the responses of its sources
+func printArchivedCollection(epoch uint32, collection types.ArchivedCollection) {
+	fmt.Printf("Epoch: %d\nCollection: %d\nCommitted value: %s\n", epoch, collection.CollectionId, collection.Value)
+	if len(collection.Responses) == 0 {
+		fmt.Println("The value wasn't fetched from the sources, the previous value was committed")
+		return
+	}
+	for _, response := range collection.Responses {
+		fmt.Printf("\nJob: %d (%s)\nUrl: %s\nSelector: %s\nValue: %s\nBody hash: %s\nBody (%d bytes):\n%s\n", response.JobId, response.JobName, response.Url, response.Selector, response.Value, response.BodyHash, response.BodySize, response.Body)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(inspectCommitCmd)
+
+	var (
+		Address    string
+		Epoch      uint32
+		Collection uint16
+	)
+
+	inspectCommitCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+	inspectCommitCmd.Flags().Uint32VarP(&Epoch, "epoch", "", 0, "epoch of the commit")
+	inspectCommitCmd.Flags().Uint16VarP(&Collection, "collection", "", 0, "collection id")
+
+	addrErr := inspectCommitCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+	epochErr := inspectCommitCmd.MarkFlagRequired("epoch")
+	utils.CheckError("Epoch error: ", epochErr)
+	collectionErr := inspectCommitCmd.MarkFlagRequired("collection")
+	utils.CheckError("Collection error: ", collectionErr)
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetArchivedCollection(t *testing.T) {
+	address := "0x000000000000000000000000000000000000dEaD"
+	entry := types.ResponseArchiveEntry{Epoch: 11, Collections: []types.ArchivedCollection{
+		{CollectionId: 1, Value: big.NewInt(1000), Responses: []types.ArchivedResponse{{JobId: 1, Selector: "last", BodyHash: "0x01", BodySize: 15, Value: big.NewInt(1000)}}},
+		{CollectionId: 2, Value: big.NewInt(900), Responses: []types.ArchivedResponse{}},
+	}}
+
+	type args struct {
+		address    string
+		entry      types.ResponseArchiveEntry
+		entryErr   error
+		body       []byte
+		bodyErr    error
+		collection uint16
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.ArchivedCollection
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the responses of the collection are archived",
+			args: args{
+				address:    address,
+				entry:      entry,
+				body:       []byte(`{"last":"1000"}`),
+				collection: 1,
+			},
+			want:    types.ArchivedCollection{CollectionId: 1, Value: big.NewInt(1000), Responses: []types.ArchivedResponse{{JobId: 1, Selector: "last", BodyHash: "0x01", BodySize: 15, Value: big.NewInt(1000), Body: `{"last":"1000"}`}}},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the previous value of the collection was committed",
+			args: args{
+				address:    address,
+				entry:      entry,
+				collection: 2,
+			},
+			want:    types.ArchivedCollection{CollectionId: 2, Value: big.NewInt(900), Responses: []types.ArchivedResponse{}},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the collection wasn't committed in the epoch",
+			args: args{
+				address:    address,
+				entry:      entry,
+				collection: 3,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in reading the body of a response",
+			args: args{
+				address:    address,
+				entry:      entry,
+				bodyErr:    errors.New("body error"),
+				collection: 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in reading the archive",
+			args: args{
+				address:    address,
+				entryErr:   errors.New("archive error"),
+				collection: 1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the address is invalid",
+			args: args{
+				address:    "0x01",
+				collection: 1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("ReadResponseArchive", mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(tt.args.entry, tt.args.entryErr)
+			utilsPkgMock.On("ReadArchivedResponseBody", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(tt.args.body, tt.args.bodyErr)
+
+			got, err := getArchivedCollection(tt.args.address, 11, tt.args.collection)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getArchivedCollection() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getArchivedCollection() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
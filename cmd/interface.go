@@ -4,6 +4,7 @@ package cmd
 import (
 	"context"
 	"crypto/ecdsa"
+	"io"
 	"math/big"
 	Accounts "razor/accounts"
 	"razor/core/types"
@@ -61,10 +62,13 @@ type UtilsInterface interface {
 	CalculateBlockTime(client *ethclient.Client) int64
 	GetTxnOpts(transactionData types.TransactionOptions) *bind.TransactOpts
 	AssignPassword() string
+	AssignNewPassword() string
+	ReadPassword(address string) (string, error)
 	GetStringAddress(flagSet *pflag.FlagSet) (string, error)
 	GetUint32BountyId(flagSet *pflag.FlagSet) (uint32, error)
 	ConnectToClient(provider string) *ethclient.Client
 	WaitForBlockCompletion(client *ethclient.Client, hashToRead string) error
+	WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error
 	GetNumActiveCollections(client *ethclient.Client) (uint16, error)
 	GetRogueRandomValue(value int) *big.Int
 	GetRogueRandomMedianValue() uint32
@@ -127,6 +131,12 @@ type UtilsInterface interface {
 	GetCommitDataFileName(address string) (string, error)
 	GetProposeDataFileName(address string) (string, error)
 	GetDisputeDataFileName(address string) (string, error)
+	GetCanaryFileName(address string) (string, error)
+	GetRPCDebugFileName(address string) (string, error)
+	StoreKeychainPassword(address string, password string) error
+	GetKeychainPassword(address string) (string, error)
+	DeleteKeychainPassword(address string) error
+	LockDataDir(address string) error
 }
 
 type StakeManagerInterface interface {
@@ -190,6 +200,22 @@ type FlagSetInterface interface {
 	GetFloat32GasMultiplier(flagSet *pflag.FlagSet) (float32, error)
 	GetInt32Buffer(flagSet *pflag.FlagSet) (int32, error)
 	GetInt32Wait(flagSet *pflag.FlagSet) (int32, error)
+	GetStringToIntTxnTimeouts(flagSet *pflag.FlagSet) (map[string]int, error)
+	GetStringToStringGasStrategies(flagSet *pflag.FlagSet) (map[string]string, error)
+	GetInt32MaxGasPrice(flagSet *pflag.FlagSet) (int32, error)
+	GetFloat32GasBudget(flagSet *pflag.FlagSet) (float32, error)
+	GetStringGasBudgetPeriod(flagSet *pflag.FlagSet) (string, error)
+	GetStringRequestHeaders(flagSet *pflag.FlagSet) (string, error)
+	GetStringSliceAllowedHosts(flagSet *pflag.FlagSet) ([]string, error)
+	GetStringSignerUrl(flagSet *pflag.FlagSet) (string, error)
+	GetStringKMSKey(flagSet *pflag.FlagSet) (string, error)
+	GetStringReadProvider(flagSet *pflag.FlagSet) (string, error)
+	GetInt32LogsChunkSize(flagSet *pflag.FlagSet) (int32, error)
+	GetInt32APICacheTTL(flagSet *pflag.FlagSet) (int32, error)
+	GetInt32HTTPTimeout(flagSet *pflag.FlagSet) (int32, error)
+	GetInt32HTTPRetryAttempts(flagSet *pflag.FlagSet) (int32, error)
+	GetInt32HTTPRetryDelay(flagSet *pflag.FlagSet) (int32, error)
+	GetStringHTTPProxy(flagSet *pflag.FlagSet) (string, error)
 	GetInt32GasPrice(flagSet *pflag.FlagSet) (int32, error)
 	GetFloat32GasLimit(flagSet *pflag.FlagSet) (float32, error)
 	GetStringLogLevel(flagSet *pflag.FlagSet) (string, error)
@@ -198,6 +224,22 @@ type FlagSetInterface interface {
 	GetRootFloat32GasMultiplier() (float32, error)
 	GetRootInt32Buffer() (int32, error)
 	GetRootInt32Wait() (int32, error)
+	GetRootStringToIntTxnTimeouts() (map[string]int, error)
+	GetRootStringToStringGasStrategies() (map[string]string, error)
+	GetRootInt32MaxGasPrice() (int32, error)
+	GetRootFloat32GasBudget() (float32, error)
+	GetRootStringGasBudgetPeriod() (string, error)
+	GetRootStringRequestHeaders() (string, error)
+	GetRootStringSliceAllowedHosts() ([]string, error)
+	GetRootStringSignerUrl() (string, error)
+	GetRootStringKMSKey() (string, error)
+	GetRootStringReadProvider() (string, error)
+	GetRootInt32LogsChunkSize() (int32, error)
+	GetRootInt32APICacheTTL() (int32, error)
+	GetRootInt32HTTPTimeout() (int32, error)
+	GetRootInt32HTTPRetryAttempts() (int32, error)
+	GetRootInt32HTTPRetryDelay() (int32, error)
+	GetRootStringHTTPProxy() (string, error)
 	GetRootInt32GasPrice() (int32, error)
 	GetRootStringLogLevel() (string, error)
 	GetRootFloat32GasLimit() (float32, error)
@@ -205,6 +247,8 @@ type FlagSetInterface interface {
 	GetStringTo(flagSet *pflag.FlagSet) (string, error)
 	GetStringAddress(flagSet *pflag.FlagSet) (string, error)
 	GetUint32StakerId(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint32FromStakerId(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint32ToStakerId(flagSet *pflag.FlagSet) (uint32, error)
 	GetStringName(flagSet *pflag.FlagSet) (string, error)
 	GetStringUrl(flagSet *pflag.FlagSet) (string, error)
 	GetStringSelector(flagSet *pflag.FlagSet) (string, error)
@@ -222,10 +266,73 @@ type FlagSetInterface interface {
 	GetBoolWeiRazor(flagSet *pflag.FlagSet) (bool, error)
 	GetUint32Tolerance(flagSet *pflag.FlagSet) (uint32, error)
 	GetBoolRogue(flagSet *pflag.FlagSet) (bool, error)
+	GetBoolCanary(flagSet *pflag.FlagSet) (bool, error)
 	GetStringSliceRogueMode(flagSet *pflag.FlagSet) ([]string, error)
+	GetStringFaultInjection(flagSet *pflag.FlagSet) (string, error)
+	GetBoolChaos(flagSet *pflag.FlagSet) (bool, error)
+	GetStringChaosConfig(flagSet *pflag.FlagSet) (string, error)
+	GetBoolEncryptState(flagSet *pflag.FlagSet) (bool, error)
+	GetStringLogFile(flagSet *pflag.FlagSet) (string, error)
+	GetUint32Epoch(flagSet *pflag.FlagSet) (uint32, error)
+	GetStringState(flagSet *pflag.FlagSet) (string, error)
+	GetStringLevel(flagSet *pflag.FlagSet) (string, error)
+	GetBoolFollow(flagSet *pflag.FlagSet) (bool, error)
+	GetBoolJson(flagSet *pflag.FlagSet) (bool, error)
 	GetStringExposeMetrics(flagSet *pflag.FlagSet) (string, error)
 	GetStringCertFile(flagSet *pflag.FlagSet) (string, error)
 	GetStringCertKey(flagSet *pflag.FlagSet) (string, error)
+	GetUint16Collection(flagSet *pflag.FlagSet) (uint16, error)
+	GetUint32Days(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint16Collections(flagSet *pflag.FlagSet) (uint16, error)
+	GetUint32Stakers(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint32Blocks(flagSet *pflag.FlagSet) (uint32, error)
+	GetInt32Parallelism(flagSet *pflag.FlagSet) (int32, error)
+	GetStringBenchTime(flagSet *pflag.FlagSet) (string, error)
+	GetStringSliceBenchmarks(flagSet *pflag.FlagSet) ([]string, error)
+	GetStringOutput(flagSet *pflag.FlagSet) (string, error)
+	GetStringRemoteConfigUrl(flagSet *pflag.FlagSet) (string, error)
+	GetStringRemoteConfigSigner(flagSet *pflag.FlagSet) (string, error)
+	GetUint32RemoteConfigInterval(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint32Last(flagSet *pflag.FlagSet) (uint32, error)
+	GetUintSliceSubscribedCollections(flagSet *pflag.FlagSet) ([]uint, error)
+	GetBoolAcknowledgeUnsubscribed(flagSet *pflag.FlagSet) (bool, error)
+	GetBoolForce(flagSet *pflag.FlagSet) (bool, error)
+	GetStringMetricsPort(flagSet *pflag.FlagSet) (string, error)
+	GetStringHealthPort(flagSet *pflag.FlagSet) (string, error)
+	GetBoolFleet(flagSet *pflag.FlagSet) (bool, error)
+	GetStringSliceNodes(flagSet *pflag.FlagSet) ([]string, error)
+	GetStringBlockJson(flagSet *pflag.FlagSet) (string, error)
+	GetBoolRpcDebug(flagSet *pflag.FlagSet) (bool, error)
+	GetUint32RpcDebugDuration(flagSet *pflag.FlagSet) (uint32, error)
+	GetBoolUseKeychain(flagSet *pflag.FlagSet) (bool, error)
+	GetUint32SpeedUpBlocks(flagSet *pflag.FlagSet) (uint32, error)
+	GetBoolAutoClaimBounty(flagSet *pflag.FlagSet) (bool, error)
+	GetBoolAll(flagSet *pflag.FlagSet) (bool, error)
+	GetUint32EventsDays(flagSet *pflag.FlagSet) (uint32, error)
+	GetStringNotificationTemplates(flagSet *pflag.FlagSet) (string, error)
+	GetStringSliceAlertWebhooks(flagSet *pflag.FlagSet) ([]string, error)
+	GetFloat32AlertBalanceThreshold(flagSet *pflag.FlagSet) (float32, error)
+	GetFloat32AlertRazorBalanceThreshold(flagSet *pflag.FlagSet) (float32, error)
+	GetUint32AlertRpcDownMinutes(flagSet *pflag.FlagSet) (uint32, error)
+	GetUint32Epochs(flagSet *pflag.FlagSet) (uint32, error)
+	GetStringHistoryFormat(flagSet *pflag.FlagSet) (string, error)
+	GetStringExportFile(flagSet *pflag.FlagSet) (string, error)
+	GetStringRewardsPeriod(flagSet *pflag.FlagSet) (string, error)
+	GetStringQuote(flagSet *pflag.FlagSet) (string, error)
+	GetStringGasTokenId(flagSet *pflag.FlagSet) (string, error)
+	GetBoolSchedule(flagSet *pflag.FlagSet) (bool, error)
+	GetBoolAutoWithdraw(flagSet *pflag.FlagSet) (bool, error)
+	GetStringAlias(flagSet *pflag.FlagSet) (string, error)
+	GetBoolRemove(flagSet *pflag.FlagSet) (bool, error)
+	GetStringSliceAccount(flagSet *pflag.FlagSet) ([]string, error)
+	GetStringMode(flagSet *pflag.FlagSet) (string, error)
+	GetStringGroupBy(flagSet *pflag.FlagSet) (string, error)
+	GetStringDeployment(flagSet *pflag.FlagSet) (string, error)
+	GetStringDeployments(flagSet *pflag.FlagSet) (string, error)
+	GetBoolAttest(flagSet *pflag.FlagSet) (bool, error)
+	GetStringAttestationKeyFile(flagSet *pflag.FlagSet) (string, error)
+	GetBoolArchiveResponses(flagSet *pflag.FlagSet) (bool, error)
+	GetUint32ArchiveRetentionDays(flagSet *pflag.FlagSet) (uint32, error)
 }
 
 type UtilsCmdInterface interface {
@@ -233,6 +340,22 @@ type UtilsCmdInterface interface {
 	GetProvider() (string, error)
 	GetMultiplier() (float32, error)
 	GetWaitTime() (int32, error)
+	GetTxnTimeouts() (map[string]int, error)
+	GetGasStrategies() (map[string]string, error)
+	GetMaxGasPrice() (int32, error)
+	GetGasBudget() (float32, error)
+	GetGasBudgetPeriod() (string, error)
+	GetRequestHeaders() (string, error)
+	GetAllowedHosts() ([]string, error)
+	GetSignerUrl() (string, error)
+	GetKMSKey() (string, error)
+	GetReadProvider() (string, error)
+	GetLogsChunkSize() (int32, error)
+	GetAPICacheTTL() (int32, error)
+	GetHTTPTimeout() (int32, error)
+	GetHTTPRetryAttempts() (int32, error)
+	GetHTTPRetryDelay() (int32, error)
+	GetHTTPProxy() (string, error)
 	GetGasPrice() (int32, error)
 	GetLogLevel() (string, error)
 	GetGasLimit() (float32, error)
@@ -272,6 +395,7 @@ type UtilsCmdInterface interface {
 	ExecuteUpdateJob(flagSet *pflag.FlagSet)
 	UpdateJob(client *ethclient.Client, config types.Configurations, jobInput types.CreateJobInput, jobId uint16) (common.Hash, error)
 	WaitIfCommitState(client *ethclient.Client, action string) (uint32, error)
+	WaitForTransactionOfState(client *ethclient.Client, config types.Configurations, state string, hashToRead string) (string, error)
 	ExecuteCollectionList(flagSet *pflag.FlagSet)
 	GetCollectionList(client *ethclient.Client) error
 	ExecuteStakerinfo(flagSet *pflag.FlagSet)
@@ -286,7 +410,7 @@ type UtilsCmdInterface interface {
 	GetSortedRevealedValues(client *ethclient.Client, blockNumber *big.Int, epoch uint32) (*types.RevealedDataMaps, error)
 	GetIteration(client *ethclient.Client, proposer types.ElectedProposer, bufferPercent int32) int
 	Propose(client *ethclient.Client, config types.Configurations, account types.Account, staker bindings.StructsStaker, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) (common.Hash, error)
-	GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int)
+	GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int) error
 	GetLocalMediansData(client *ethclient.Client, account types.Account, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) ([]*big.Int, []uint16, *types.RevealedDataMaps, error)
 	CheckDisputeForIds(client *ethclient.Client, transactionOpts types.TransactionOptions, epoch uint32, blockIndex uint8, idsInProposedBlock []uint16, revealedCollectionIds []uint16) (*Types.Transaction, error)
 	Dispute(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, blockIndex uint8, proposedBlock bindings.StructsBlock, leafId uint16, sortedValues []*big.Int) error
@@ -312,9 +436,11 @@ type UtilsCmdInterface interface {
 	CalculateSecret(account types.Account, epoch uint32, keystorePath string, chainId *big.Int) ([]byte, []byte, error)
 	GetLastProposedEpoch(client *ethclient.Client, blockNumber *big.Int, stakerId uint32) (uint32, error)
 	HandleBlock(client *ethclient.Client, account types.Account, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue)
+	HandleDisputeOnlyBlock(client *ethclient.Client, account types.Account, state int64, epoch uint32, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue)
 	ExecuteVote(flagSet *pflag.FlagSet)
 	Vote(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, account types.Account) error
-	HandleExit()
+	HandleExit(cancel context.CancelFunc)
+	CheckVotingEligibility(client *ethclient.Client, address string) error
 	ExecuteListAccounts(flagSet *pflag.FlagSet)
 	ClaimCommission(flagSet *pflag.FlagSet)
 	ExecuteStake(flagSet *pflag.FlagSet)
@@ -322,11 +448,90 @@ type UtilsCmdInterface interface {
 	InitiateReveal(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, staker bindings.StructsStaker, rogueData types.Rogue) error
 	InitiatePropose(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, staker bindings.StructsStaker, blockNumber *big.Int, rogueData types.Rogue) error
 	GetBountyIdFromEvents(client *ethclient.Client, blockNumber *big.Int, bountyHunter string) (uint32, error)
+	VerifyRevealedValues(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.RevealInconsistency, error)
+	GetRevealDataFromTransaction(client *ethclient.Client, txHash common.Hash) (bindings.StructsMerkleTree, []byte, error)
 	HandleClaimBounty(client *ethclient.Client, config types.Configurations, account types.Account) error
 	ExecuteContractAddresses(flagSet *pflag.FlagSet)
 	ContractAddresses()
 	ResetDispute(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32)
+	ExecuteResetDispute(flagSet *pflag.FlagSet)
 	StoreBountyId(client *ethclient.Client, account types.Account) error
+	ExecuteBacktest(flagSet *pflag.FlagSet)
+	Backtest(client *ethclient.Client, collectionId uint16, days uint32, aggregationMethod uint32) error
+	ExecuteMigrateDelegation(flagSet *pflag.FlagSet)
+	GetDelegationMigrationData(client *ethclient.Client, flagSet *pflag.FlagSet, fileName string, fromStakerId uint32, toStakerId uint32) (types.DelegationMigrationData, error)
+	MigrateDelegation(client *ethclient.Client, config types.Configurations, account types.Account, fileName string, migrationData types.DelegationMigrationData) error
+	MigrateDelegationStep(client *ethclient.Client, config types.Configurations, account types.Account, migrationData types.DelegationMigrationData) (types.DelegationMigrationData, error)
+	ExecuteLogs(flagSet *pflag.FlagSet)
+	GetStateFromName(stateName string) (int64, error)
+	ReadLogs(filePath string, filter types.LogFilter, follow bool, asJson bool, writer io.Writer) error
+	RecordJournalAction(address string, epoch uint32, action types.JournalAction)
+	ExecuteBench(flagSet *pflag.FlagSet)
+	RunBenchmarks(sizes types.BenchSizes, names []string, parallelism int32) (types.BenchReport, error)
+	ExecuteInfluenceBreakdown(flagSet *pflag.FlagSet)
+	GetInfluenceBreakdown(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.CollectionInfluence, error)
+	ExecuteActivity(flagSet *pflag.FlagSet)
+	GetActivityFeed(client *ethclient.Client, address string, days uint32) ([]types.ActivityEntry, error)
+	ExecuteOverrideInit(flagSet *pflag.FlagSet)
+	GenerateOverrideFile(client *ethclient.Client) (types.OverrideFile, error)
+	ExecuteFleetDiff(flagSet *pflag.FlagSet)
+	ExecuteDisputeSimulate(flagSet *pflag.FlagSet)
+	ExecuteCheckBlock(flagSet *pflag.FlagSet)
+	CheckProposedBlocks(client *ethclient.Client, blockNumber *big.Int, epoch uint32) (types.EpochBlockCheck, error)
+	ExecuteKeychainStore(flagSet *pflag.FlagSet)
+	ExecuteKeychainRemove(flagSet *pflag.FlagSet)
+	PollRemoteConfig(ctx context.Context, remoteConfig types.RemoteConfig)
+	ApplyRemoteConfig(config types.Configurations, values map[string]interface{}) (types.Configurations, error)
+	AutoClaimBounties(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account)
+	ClaimEligibleBounties(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32) error
+	ClaimAllBounties(client *ethclient.Client, config types.Configurations, account types.Account, eventsDays uint32) ([]types.BountyClaim, error)
+	ExecuteEstimateEpoch(flagSet *pflag.FlagSet)
+	EstimateEpochGas(client *ethclient.Client, config types.Configurations, address string) (types.EpochGasEstimate, error)
+	ExecuteRepl(flagSet *pflag.FlagSet)
+	RunRepl(input io.Reader) (int, error)
+	ExecuteReplCommand(args []string) error
+	ExecuteGetEpoch(flagSet *pflag.FlagSet)
+	GetEpochInfo(client *ethclient.Client) (types.EpochInfo, error)
+	ExecuteHistory(flagSet *pflag.FlagSet)
+	GetHistory(client *ethclient.Client, address string, epochs uint32) ([]types.LedgerRecord, error)
+	ExecuteRewards(flagSet *pflag.FlagSet)
+	GetRewards(client *ethclient.Client, address string, days uint32, period string) (types.RewardsReport, error)
+	SyncStakeChanges(client *ethclient.Client, address string, stakerId uint32, fromBlock uint64, toBlock uint64) error
+	AccumulateRewards(ctx context.Context, client *ethclient.Client, address string)
+	ExecuteDelegatorInfo(flagSet *pflag.FlagSet)
+	GetDelegatorInfo(client *ethclient.Client, address string, days uint32) ([]types.DelegatorPosition, error)
+	GetDelegatorPositions(client *ethclient.Client, address string) ([]types.DelegatorPosition, error)
+	ScheduleCommissionUpdate(client *ethclient.Client, address string, stakerId uint32, commission uint8) error
+	AutoUpdateCommission(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account)
+	ApplyCommissionSchedule(client *ethclient.Client, config types.Configurations, account types.Account) error
+	ExecuteCommissionInfo(flagSet *pflag.FlagSet)
+	GetCommissionWindow(client *ethclient.Client, stakerId uint32) (types.CommissionWindow, error)
+	ExecuteWithdrawQueue(flagSet *pflag.FlagSet)
+	AddToWithdrawQueue(client *ethclient.Client, address string, stakerId uint32, txnHash string) error
+	GetWithdrawLocks(client *ethclient.Client, address string) ([]types.WithdrawLock, error)
+	AutoWithdraw(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account)
+	ProcessWithdrawQueue(client *ethclient.Client, config types.Configurations, account types.Account) error
+	ExecuteSetAccountAlias(flagSet *pflag.FlagSet)
+	SetAccountAlias(alias string, address string) error
+	RemoveAccountAlias(alias string) error
+	GetAccountAliases() (map[string]string, error)
+	ResolveAccounts(accounts []string) ([]string, error)
+	ExecuteValidateConfig()
+	ValidateConfig(configFilePath string) error
+	ReloadConfig(config types.Configurations) (types.Configurations, error)
+	VoteAccounts(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, accounts []types.Account) error
+	ExecuteObserver(flagSet *pflag.FlagSet, config types.Configurations, client *ethclient.Client, address string)
+	Observe(ctx context.Context, config types.Configurations, client *ethclient.Client, address string) error
+	HandleObserverBlock(client *ethclient.Client, address string, blockNumber *big.Int, config types.Configurations)
+	ExecuteGasReport(flagSet *pflag.FlagSet)
+	GetGasReport(client *ethclient.Client, address string, epochs uint32, groupBy string) (types.GasReport, error)
+	ExecuteVoteDeployments(flagSet *pflag.FlagSet)
+	RunDeployments(ctx context.Context, deployments []types.Deployment) error
+	ExecuteRiskReport(flagSet *pflag.FlagSet)
+	GetRiskReport(client *ethclient.Client, stakerId uint32) (types.RiskReport, error)
+	CheckStakeSafety(client *ethclient.Client, stakerId uint32, stakeChange *big.Int, force bool) error
+	ExecuteProve(flagSet *pflag.FlagSet)
+	ExecuteInspectCommit(flagSet *pflag.FlagSet)
 }
 
 type TransactionInterface interface {
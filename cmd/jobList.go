@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"os"
+	"razor/core/types"
 	"razor/logger"
 	"razor/utils"
 	"strconv"
@@ -48,6 +49,22 @@ func (*UtilsStruct) GetJobList(client *ethclient.Client) error {
 		return err
 	}
 
+	if utils.IsJsonOutput() {
+		jobsOutput := make([]types.StructsJob, 0, len(jobs))
+		for _, job := range jobs {
+			jobsOutput = append(jobsOutput, types.StructsJob{
+				Id:           job.Id,
+				SelectorType: job.SelectorType,
+				Weight:       job.Weight,
+				Power:        job.Power,
+				Name:         job.Name,
+				Selector:     job.Selector,
+				Url:          job.Url,
+			})
+		}
+		return utils.PrintJson(jobsOutput)
+	}
+
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"Job Id", "Selector Type", "Weight", "Power", "Name", "Selector", "Url"})
 	for i := 0; i < len(jobs); i++ {
@@ -0,0 +1,102 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"path"
+	razorAccounts "razor/accounts"
+	"razor/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var keychainCmd = &cobra.Command{
+	Use:   "keychain",
+	Short: "manage the keystore passwords stored in the keychain of the OS",
+	Long: `Manages the keystore passwords stored in the Keychain on macOS, the Secret Service on Linux and the Credential Manager on Windows, so that the vote command can unlock the keystore at startup without a prompt.
+
+Example:
+  ./razor keychain store --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c
+  ./razor keychain remove --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+}
+
+var keychainStoreCmd = &cobra.Command{
+	Use:   "store",
+	Short: "store the password of the keystore of an address in the keychain of the OS",
+	Long: `Prompts for the password of the keystore of the address, checks that it unlocks the keystore and stores it in the keychain of the OS.
+
+Example:
+  ./razor keychain store --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+	Run: initialiseKeychainStore,
+}
+
+var keychainRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "remove the password of the keystore of an address from the keychain of the OS",
+	Long: `Removes the password of the keystore of the address from the keychain of the OS.
+
+Example:
+  ./razor keychain remove --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+	Run: initialiseKeychainRemove,
+}
+
+//This function initialises the ExecuteKeychainStore function
+func initialiseKeychainStore(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteKeychainStore(cmd.Flags())
+}
+
+//This function initialises the ExecuteKeychainRemove function
+func initialiseKeychainRemove(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteKeychainRemove(cmd.Flags())
+}
+
+//This function prompts for the password of the keystore, checks that it unlocks the keystore and stores it in the keychain
+func (*UtilsStruct) ExecuteKeychainStore(flagSet *pflag.FlagSet) {
+	razorUtils.AssignLogFile(flagSet)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	password := razorUtils.AssignPassword()
+
+	razorPath, err := razorUtils.GetDefaultPath()
+	utils.CheckError("Error in fetching .razor directory: ", err)
+	keystorePath := path.Join(razorPath, "keystore_files")
+	_, err = razorAccounts.AccountUtilsInterface.GetPrivateKey(address, password, keystorePath)
+	utils.CheckError("Error in unlocking keystore: ", err)
+
+	err = razorUtils.StoreKeychainPassword(address, password)
+	utils.CheckError("Error in storing password in keychain: ", err)
+	log.Infof("Password of %s is stored in the keychain, pass --useKeychain to the vote command to use it", address)
+}
+
+//This function removes the password of the keystore from the keychain
+func (*UtilsStruct) ExecuteKeychainRemove(flagSet *pflag.FlagSet) {
+	razorUtils.AssignLogFile(flagSet)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	err = razorUtils.DeleteKeychainPassword(address)
+	utils.CheckError("Error in removing password from keychain: ", err)
+	log.Infof("Password of %s is removed from the keychain", address)
+}
+
+func init() {
+	rootCmd.AddCommand(keychainCmd)
+	keychainCmd.AddCommand(keychainStoreCmd)
+	keychainCmd.AddCommand(keychainRemoveCmd)
+
+	var (
+		StoreAddress  string
+		RemoveAddress string
+	)
+
+	keychainStoreCmd.Flags().StringVarP(&StoreAddress, "address", "a", "", "address of the keystore")
+	keychainRemoveCmd.Flags().StringVarP(&RemoveAddress, "address", "a", "", "address of the keystore")
+
+	storeAddrErr := keychainStoreCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", storeAddrErr)
+	removeAddrErr := keychainRemoveCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", removeAddrErr)
+}
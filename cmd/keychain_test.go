@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	razorAccounts "razor/accounts"
+	accountsMocks "razor/accounts/mocks"
+	"razor/cmd/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteKeychainStore(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	privateKey, _ := crypto.HexToECDSA("b4a9c5e0e1d36b2a9d4f6b1a0c0e1f2a3b4c5d6e7f8091a2b3c4d5e6f708192a")
+
+	type args struct {
+		address          string
+		addressErr       error
+		password         string
+		path             string
+		pathErr          error
+		privateKey       *ecdsa.PrivateKey
+		privateKeyErr    error
+		storePasswordErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteKeychainStore executes successfully",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dea1",
+				password:   "test",
+				path:       "/home/.razor",
+				privateKey: privateKey,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting address",
+			args: args{
+				addressErr: errors.New("address error"),
+				password:   "test",
+				path:       "/home/.razor",
+				privateKey: privateKey,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting .razor directory",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dea1",
+				password:   "test",
+				pathErr:    errors.New("path error"),
+				privateKey: privateKey,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When the password doesn't unlock the keystore",
+			args: args{
+				address:       "0x000000000000000000000000000000000000dea1",
+				password:      "wrong",
+				path:          "/home/.razor",
+				privateKeyErr: errors.New("could not decrypt key with given password"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When there is an error in storing password in keychain",
+			args: args{
+				address:          "0x000000000000000000000000000000000000dea1",
+				password:         "test",
+				path:             "/home/.razor",
+				privateKey:       privateKey,
+				storePasswordErr: errors.New("keychain error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			accountUtilsMock := new(accountsMocks.AccountInterface)
+
+			razorUtils = utilsMock
+			flagSetUtils = flagSetUtilsMock
+			razorAccounts.AccountUtilsInterface = accountUtilsMock
+
+			utilsMock.On("AssignLogFile", flagSet)
+			flagSetUtilsMock.On("GetStringAddress", flagSet).Return(tt.args.address, tt.args.addressErr)
+			utilsMock.On("AssignPassword").Return(tt.args.password)
+			utilsMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			accountUtilsMock.On("GetPrivateKey", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(tt.args.privateKey, tt.args.privateKeyErr)
+			utilsMock.On("StoreKeychainPassword", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(tt.args.storePasswordErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteKeychainStore(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteKeychainStore function didn't execute as expected")
+			}
+		})
+	}
+}
+
+func TestExecuteKeychainRemove(t *testing.T) {
+	var flagSet *pflag.FlagSet
+
+	type args struct {
+		address           string
+		addressErr        error
+		deletePasswordErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteKeychainRemove executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dea1",
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting address",
+			args: args{
+				addressErr: errors.New("address error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When the password is not stored in the keychain",
+			args: args{
+				address:           "0x000000000000000000000000000000000000dea1",
+				deletePasswordErr: errors.New("password is not stored in the keychain"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+
+			razorUtils = utilsMock
+			flagSetUtils = flagSetUtilsMock
+
+			utilsMock.On("AssignLogFile", flagSet)
+			flagSetUtilsMock.On("GetStringAddress", flagSet).Return(tt.args.address, tt.args.addressErr)
+			utilsMock.On("DeleteKeychainPassword", mock.AnythingOfType("string")).Return(tt.args.deletePasswordErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteKeychainRemove(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteKeychainRemove function didn't execute as expected")
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/pflag"
 	pathPkg "path"
 	"razor/utils"
+	"sort"
+	"strings"
 )
 
 var listAccountsCmd = &cobra.Command{
@@ -28,8 +30,21 @@ func (*UtilsStruct) ExecuteListAccounts(flagSet *pflag.FlagSet) {
 	razorUtils.AssignLogFile(flagSet)
 	allAccounts, err := cmdUtils.ListAccounts()
 	utils.CheckError("ListAccounts error: ", err)
+	aliases, err := cmdUtils.GetAccountAliases()
+	if err != nil {
+		log.Error("Error in getting account aliases: ", err)
+	}
+	accountAliases := make(map[string][]string)
+	for alias, address := range aliases {
+		accountAliases[strings.ToLower(address)] = append(accountAliases[strings.ToLower(address)], alias)
+	}
 	log.Info("The available accounts are: ")
 	for _, account := range allAccounts {
+		if accountAlias, ok := accountAliases[strings.ToLower(account.Address.String())]; ok {
+			sort.Strings(accountAlias)
+			log.Infof("%s (%s)", account.Address.String(), strings.Join(accountAlias, ", "))
+			continue
+		}
 		log.Infof("%s", account.Address.String())
 	}
 }
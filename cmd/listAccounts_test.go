@@ -101,6 +101,8 @@ func TestExecuteListAccounts(t *testing.T) {
 	type args struct {
 		allAccounts    []accounts.Account
 		allAccountsErr error
+		aliases        map[string]string
+		aliasesErr     error
 	}
 
 	tests := []struct {
@@ -112,6 +114,7 @@ func TestExecuteListAccounts(t *testing.T) {
 			name: "Test 1: When ExecuteListAccounts executes successfully",
 			args: args{
 				allAccounts: accountList,
+				aliases:     map[string]string{"staker1": "0x000000000000000000000000000000000000dea1"},
 			},
 			expectedFatal: false,
 		},
@@ -122,6 +125,14 @@ func TestExecuteListAccounts(t *testing.T) {
 			},
 			expectedFatal: true,
 		},
+		{
+			name: "Test 3: When there is an error in getting account aliases",
+			args: args{
+				allAccounts: accountList,
+				aliasesErr:  errors.New("aliases error"),
+			},
+			expectedFatal: false,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -137,6 +148,7 @@ func TestExecuteListAccounts(t *testing.T) {
 
 			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
 			cmdUtilsMock.On("ListAccounts").Return(tt.args.allAccounts, tt.args.allAccountsErr)
+			cmdUtilsMock.On("GetAccountAliases").Return(tt.args.aliases, tt.args.aliasesErr)
 
 			utils := &UtilsStruct{}
 			fatal = false
@@ -0,0 +1,211 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "logs can be used to view and filter the logs stored in a log file",
+	Long: `logs reads the log file passed with the logFile flag from the razor logs directory, filters the entries by epoch, state and level and pretty prints them or prints them as JSON.
+
+Example:
+  ./razor logs --logFile voteLogs --epoch 1200 --state propose --level warn --follow`,
+	Run: initialiseLogs,
+}
+
+//This function initialises the ExecuteLogs function
+func initialiseLogs(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteLogs(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the ReadLogs function
+func (*UtilsStruct) ExecuteLogs(flagSet *pflag.FlagSet) {
+	fileName, err := flagSetUtils.GetStringLogFile(flagSet)
+	utils.CheckError("Error in getting log file name: ", err)
+	if fileName == "" {
+		utils.CheckError("Log file error: ", errors.New("logFile flag is required to view the logs"))
+	}
+
+	filePath, err := path.PathUtilsInterface.GetLogFilePath(fileName)
+	utils.CheckError("Error in getting log file path: ", err)
+
+	epoch, err := flagSetUtils.GetUint32Epoch(flagSet)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	stateName, err := flagSetUtils.GetStringState(flagSet)
+	utils.CheckError("Error in getting state: ", err)
+
+	state, err := cmdUtils.GetStateFromName(stateName)
+	utils.CheckError("State error: ", err)
+
+	levelName, err := flagSetUtils.GetStringLevel(flagSet)
+	utils.CheckError("Error in getting level: ", err)
+
+	level, err := logrus.ParseLevel(levelName)
+	utils.CheckError("Level error: ", err)
+
+	follow, err := flagSetUtils.GetBoolFollow(flagSet)
+	utils.CheckError("Error in getting follow: ", err)
+
+	asJson, err := flagSetUtils.GetBoolJson(flagSet)
+	utils.CheckError("Error in getting json: ", err)
+
+	filter := types.LogFilter{
+		Epoch: epoch,
+		State: state,
+		Level: level,
+	}
+	err = cmdUtils.ReadLogs(filePath, filter, follow, asJson, os.Stdout)
+	utils.CheckError("ReadLogs error: ", err)
+}
+
+//This function returns the state number for the state name or number, it returns -1 if no state is passed
+func (*UtilsStruct) GetStateFromName(stateName string) (int64, error) {
+	if stateName == "" {
+		return -1, nil
+	}
+	for state := int64(0); state < core.NumberOfStates; state++ {
+		if strings.EqualFold(utils.UtilsInterface.GetStateName(state), stateName) || strconv.FormatInt(state, 10) == stateName {
+			return state, nil
+		}
+	}
+	return -1, fmt.Errorf("invalid state: %s", stateName)
+}
+
+//This function reads the log file and writes the entries which match the filter
+//If follow is true, it keeps waiting for new entries after reaching the end of the file
+func (*UtilsStruct) ReadLogs(filePath string, filter types.LogFilter, follow bool, asJson bool, writer io.Writer) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		file.Close()
+	}()
+
+	reader := bufio.NewReader(file)
+	var partialLine []byte
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		partialLine = append(partialLine, line...)
+		if err == io.EOF {
+			if !follow {
+				if len(partialLine) > 0 {
+					writeLogEntry(partialLine, filter, asJson, writer)
+				}
+				return nil
+			}
+			timeUtils.Sleep(time.Second)
+			// The log file is rotated once it reaches its max size, so the new file is opened
+			if rotated, err := isLogFileRotated(file, filePath); err == nil && rotated {
+				newFile, err := os.Open(filePath)
+				if err != nil {
+					return err
+				}
+				file.Close()
+				file = newFile
+				reader = bufio.NewReader(file)
+				partialLine = nil
+			}
+			continue
+		}
+		writeLogEntry(partialLine, filter, asJson, writer)
+		partialLine = nil
+	}
+}
+
+//This function writes the log entry if it matches the filter, the lines which are not JSON log entries are skipped
+func writeLogEntry(line []byte, filter types.LogFilter, asJson bool, writer io.Writer) {
+	line = bytes.TrimSpace(line)
+	entry := types.LogEntry{State: -1}
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return
+	}
+	if !matchesLogFilter(entry, filter) {
+		return
+	}
+	if asJson {
+		fmt.Fprintln(writer, string(line))
+		return
+	}
+	var stateName string
+	if entry.State >= 0 {
+		stateName = utils.UtilsInterface.GetStateName(entry.State)
+	}
+	fmt.Fprintf(writer, "%s [%-7s] epoch=%d state=%s block=%s address=%s %s\n", entry.Time, strings.ToUpper(entry.Level), entry.Epoch, stateName, entry.BlockNumber, entry.Address, strings.TrimSpace(entry.Msg))
+}
+
+//This function checks if the log entry matches the epoch, state and level of the filter
+func matchesLogFilter(entry types.LogEntry, filter types.LogFilter) bool {
+	if filter.Epoch != 0 && entry.Epoch != filter.Epoch {
+		return false
+	}
+	if filter.State >= 0 && entry.State != filter.State {
+		return false
+	}
+	level, err := logrus.ParseLevel(entry.Level)
+	if err != nil {
+		return false
+	}
+	return level <= filter.Level
+}
+
+//This function checks if the log file at the path is not the opened file anymore or was truncated
+func isLogFileRotated(file *os.File, filePath string) (bool, error) {
+	openedFileInfo, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	fileInfo, err := os.Stat(filePath)
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(openedFileInfo, fileInfo) {
+		return true, nil
+	}
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	return fileInfo.Size() < offset, nil
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+
+	var (
+		Epoch  uint32
+		State  string
+		Level  string
+		Follow bool
+		Json   bool
+	)
+
+	logsCmd.Flags().Uint32VarP(&Epoch, "epoch", "", 0, "epoch of the log entries")
+	logsCmd.Flags().StringVarP(&State, "state", "", "", "state of the log entries (commit, reveal, propose, dispute, confirm)")
+	logsCmd.Flags().StringVarP(&Level, "level", "", "debug", "minimum level of the log entries (debug, info, warn, error)")
+	logsCmd.Flags().BoolVarP(&Follow, "follow", "f", false, "keep waiting for new log entries")
+	logsCmd.Flags().BoolVarP(&Json, "json", "", false, "print the log entries as JSON")
+}
@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+	"os"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+)
+
+func TestExecuteLogs(t *testing.T) {
+	var flagSet *pflag.FlagSet
+
+	type args struct {
+		fileName    string
+		fileNameErr error
+		filePath    string
+		filePathErr error
+		epoch       uint32
+		epochErr    error
+		state       string
+		stateErr    error
+		stateNum    int64
+		stateNumErr error
+		level       string
+		levelErr    error
+		follow      bool
+		followErr   error
+		asJson      bool
+		asJsonErr   error
+		readLogsErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteLogs executes successfully",
+			args: args{
+				fileName: "vote",
+				filePath: "/home/.razor/logs/vote.log",
+				epoch:    10,
+				state:    "commit",
+				level:    "warn",
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting log file name",
+			args: args{
+				fileNameErr: errors.New("fileName error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When log file name is not passed",
+			args: args{
+				fileName: "",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting log file path",
+			args: args{
+				fileName:    "vote",
+				filePathErr: errors.New("filePath error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When the state is invalid",
+			args: args{
+				fileName:    "vote",
+				filePath:    "/home/.razor/logs/vote.log",
+				state:       "invalid",
+				stateNumErr: errors.New("invalid state"),
+				level:       "warn",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When the level is invalid",
+			args: args{
+				fileName: "vote",
+				filePath: "/home/.razor/logs/vote.log",
+				level:    "invalid",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 7: When there is an error in reading logs",
+			args: args{
+				fileName:    "vote",
+				filePath:    "/home/.razor/logs/vote.log",
+				level:       "debug",
+				readLogsErr: errors.New("readLogs error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			flagSetUtils = flagSetUtilsMock
+			cmdUtils = cmdUtilsMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			flagSetUtilsMock.On("GetStringLogFile", flagSet).Return(tt.args.fileName, tt.args.fileNameErr)
+			pathUtilsMock.On("GetLogFilePath", mock.AnythingOfType("string")).Return(tt.args.filePath, tt.args.filePathErr)
+			flagSetUtilsMock.On("GetUint32Epoch", flagSet).Return(tt.args.epoch, tt.args.epochErr)
+			flagSetUtilsMock.On("GetStringState", flagSet).Return(tt.args.state, tt.args.stateErr)
+			cmdUtilsMock.On("GetStateFromName", mock.AnythingOfType("string")).Return(tt.args.stateNum, tt.args.stateNumErr)
+			flagSetUtilsMock.On("GetStringLevel", flagSet).Return(tt.args.level, tt.args.levelErr)
+			flagSetUtilsMock.On("GetBoolFollow", flagSet).Return(tt.args.follow, tt.args.followErr)
+			flagSetUtilsMock.On("GetBoolJson", flagSet).Return(tt.args.asJson, tt.args.asJsonErr)
+			cmdUtilsMock.On("ReadLogs", mock.AnythingOfType("string"), mock.AnythingOfType("types.LogFilter"), mock.AnythingOfType("bool"), mock.AnythingOfType("bool"), mock.Anything).Return(tt.args.readLogsErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteLogs(flagSet)
+
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteLogs function didn't execute as expected")
+			}
+		})
+	}
+}
+
+func TestGetStateFromName(t *testing.T) {
+	tests := []struct {
+		name      string
+		stateName string
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name:      "Test 1: When state is not passed",
+			stateName: "",
+			want:      -1,
+			wantErr:   false,
+		},
+		{
+			name:      "Test 2: When state name is passed",
+			stateName: "propose",
+			want:      2,
+			wantErr:   false,
+		},
+		{
+			name:      "Test 3: When state number is passed",
+			stateName: "3",
+			want:      3,
+			wantErr:   false,
+		},
+		{
+			name:      "Test 4: When state is invalid",
+			stateName: "unknown",
+			want:      -1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+
+			for state, stateName := range []string{"Commit", "Reveal", "Propose", "Dispute", "Confirm"} {
+				utilsPkgMock.On("GetStateName", int64(state)).Return(stateName)
+			}
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetStateFromName(tt.stateName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetStateFromName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetStateFromName() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadLogs(t *testing.T) {
+	logLines := `{"address":"0x1","blockNumber":100,"epoch":10,"level":"info","msg":"Committed","state":0,"time":"2022-05-01T10:00:00Z"}
+{"address":"0x1","blockNumber":101,"epoch":10,"level":"warning","msg":"Reveal failed","state":1,"time":"2022-05-01T10:04:00Z"}
+{"address":"0x1","blockNumber":102,"epoch":11,"level":"error","msg":"Propose failed","state":2,"time":"2022-05-01T10:28:00Z"}
+not a json log line
+{"level":"info","msg":"","time":"2022-05-01T09:00:00Z"}
+{"address":"0x1","blockNumber":103,"epoch":11,"level":"debug","msg":"Dispute","state":3,"time":"2022-05-01T10:30:00Z"}`
+
+	filePath := filepath.Join(t.TempDir(), "vote.log")
+	if err := os.WriteFile(filePath, []byte(logLines), 0600); err != nil {
+		t.Fatalf("Error in writing log file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		filter   types.LogFilter
+		asJson   bool
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When logs are filtered by epoch",
+			filePath: filePath,
+			filter:   types.LogFilter{Epoch: 10, State: -1, Level: logrus.DebugLevel},
+			want: "2022-05-01T10:00:00Z [INFO   ] epoch=10 state=Commit block=100 address=0x1 Committed\n" +
+				"2022-05-01T10:04:00Z [WARNING] epoch=10 state=Reveal block=101 address=0x1 Reveal failed\n",
+		},
+		{
+			name:     "Test 2: When logs are filtered by state",
+			filePath: filePath,
+			filter:   types.LogFilter{State: 3, Level: logrus.DebugLevel},
+			want:     "2022-05-01T10:30:00Z [DEBUG  ] epoch=11 state=Dispute block=103 address=0x1 Dispute\n",
+		},
+		{
+			name:     "Test 3: When logs are filtered by level and printed as JSON",
+			filePath: filePath,
+			filter:   types.LogFilter{State: -1, Level: logrus.WarnLevel},
+			asJson:   true,
+			want: `{"address":"0x1","blockNumber":101,"epoch":10,"level":"warning","msg":"Reveal failed","state":1,"time":"2022-05-01T10:04:00Z"}` + "\n" +
+				`{"address":"0x1","blockNumber":102,"epoch":11,"level":"error","msg":"Propose failed","state":2,"time":"2022-05-01T10:28:00Z"}` + "\n",
+		},
+		{
+			name:     "Test 4: When the log file doesn't exist",
+			filePath: filepath.Join(t.TempDir(), "missing.log"),
+			filter:   types.LogFilter{State: -1, Level: logrus.DebugLevel},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+
+			for state, stateName := range []string{"Commit", "Reveal", "Propose", "Dispute", "Confirm"} {
+				utilsPkgMock.On("GetStateName", int64(state)).Return(stateName)
+			}
+
+			var writer bytes.Buffer
+			ut := &UtilsStruct{}
+			err := ut.ReadLogs(tt.filePath, tt.filter, false, tt.asJson, &writer)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadLogs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if writer.String() != tt.want {
+				t.Errorf("ReadLogs() got = %q, want %q", writer.String(), tt.want)
+			}
+		})
+	}
+}
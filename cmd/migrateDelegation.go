@@ -0,0 +1,307 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var migrateDelegationCmd = &cobra.Command{
+	Use:   "migrateDelegation",
+	Short: "migrateDelegation moves your delegation from one staker to another",
+	Long: `migrateDelegation unstakes your sRZRs from a staker, waits for the unstake lock, initiates the withdrawal, waits for the withdraw lock, unlocks the razors and delegates them to another staker.
+The progress is saved after every step, so if the command is stopped it resumes the migration from the last completed step when it is run again.
+
+Example:
+  ./razor migrateDelegation --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --fromStakerId 1 --toStakerId 2 --value 1000`,
+	Run: initialiseMigrateDelegation,
+}
+
+const (
+	migrationStepUnstake          = "unstake"
+	migrationStepInitiateWithdraw = "initiateWithdraw"
+	migrationStepUnlockWithdraw   = "unlockWithdraw"
+	migrationStepDelegate         = "delegate"
+	migrationStepCompleted        = "completed"
+)
+
+//This function initialises the ExecuteMigrateDelegation function
+func initialiseMigrateDelegation(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteMigrateDelegation(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the MigrateDelegation function
+func (*UtilsStruct) ExecuteMigrateDelegation(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	logger.SetLoggerParameters(client, address)
+	razorUtils.AssignLogFile(flagSet)
+
+	password := razorUtils.AssignPassword()
+
+	encryptState, err := flagSetUtils.GetBoolEncryptState(flagSet)
+	utils.CheckError("Error in getting encryptState: ", err)
+	if encryptState {
+		err = utils.InitStateEncryption(password)
+		utils.CheckError("Error in initialising state encryption: ", err)
+	}
+
+	fromStakerId, err := flagSetUtils.GetUint32FromStakerId(flagSet)
+	utils.CheckError("Error in getting fromStakerId: ", err)
+
+	toStakerId, err := flagSetUtils.GetUint32ToStakerId(flagSet)
+	utils.CheckError("Error in getting toStakerId: ", err)
+
+	razorUtils.CheckEthBalanceIsZero(client, address)
+
+	fileName, err := path.PathUtilsInterface.GetDelegationMigrationFileName(address)
+	utils.CheckError("Error in getting delegation migration file name: ", err)
+
+	migrationData, err := cmdUtils.GetDelegationMigrationData(client, flagSet, fileName, fromStakerId, toStakerId)
+	utils.CheckError("Error in getting delegation migration data: ", err)
+
+	err = cmdUtils.MigrateDelegation(client, config, types.Account{
+		Address:  address,
+		Password: password,
+	}, fileName, migrationData)
+	utils.CheckError("MigrateDelegation error: ", err)
+}
+
+//This function returns the migration in progress from the migration file or starts a new migration
+func (*UtilsStruct) GetDelegationMigrationData(client *ethclient.Client, flagSet *pflag.FlagSet, fileName string, fromStakerId uint32, toStakerId uint32) (types.DelegationMigrationData, error) {
+	if _, err := path.OSUtilsInterface.Stat(fileName); !errors.Is(err, os.ErrNotExist) {
+		migrationData, err := utils.UtilsInterface.ReadFromDelegationMigrationFile(fileName)
+		if err != nil {
+			return types.DelegationMigrationData{}, err
+		}
+		if migrationData.Step != migrationStepCompleted {
+			if migrationData.FromStakerId != fromStakerId || migrationData.ToStakerId != toStakerId {
+				return types.DelegationMigrationData{}, fmt.Errorf("migration of delegation from staker %d to staker %d is already in progress", migrationData.FromStakerId, migrationData.ToStakerId)
+			}
+			log.Infof("Resuming migration of delegation from staker %d to staker %d at %s step", fromStakerId, toStakerId, migrationData.Step)
+			return migrationData, nil
+		}
+	}
+
+	if fromStakerId == toStakerId {
+		return types.DelegationMigrationData{}, errors.New("fromStakerId and toStakerId should be different")
+	}
+	toStaker, err := razorUtils.GetStaker(client, toStakerId)
+	if err != nil {
+		return types.DelegationMigrationData{}, err
+	}
+	if !toStaker.AcceptDelegation {
+		return types.DelegationMigrationData{}, fmt.Errorf("staker %d doesn't accept delegation", toStakerId)
+	}
+	amount, err := cmdUtils.AssignAmountInWei(flagSet)
+	if err != nil {
+		return types.DelegationMigrationData{}, err
+	}
+	if amount == nil || amount.Cmp(big.NewInt(0)) <= 0 {
+		return types.DelegationMigrationData{}, errors.New("value of sRZRs to migrate should be greater than 0")
+	}
+	return types.DelegationMigrationData{
+		FromStakerId: fromStakerId,
+		ToStakerId:   toStakerId,
+		Amount:       amount,
+		Step:         migrationStepUnstake,
+	}, nil
+}
+
+//This function runs the steps of the migration till it is completed and saves the migration data after every step
+func (*UtilsStruct) MigrateDelegation(client *ethclient.Client, config types.Configurations, account types.Account, fileName string, migrationData types.DelegationMigrationData) error {
+	for migrationData.Step != migrationStepCompleted {
+		step := migrationData.Step
+		var err error
+		migrationData, err = cmdUtils.MigrateDelegationStep(client, config, account, migrationData)
+		if err != nil {
+			return err
+		}
+		if migrationData.Step == step {
+			// The step is waiting for a lock to end
+			timeUtils.Sleep(time.Duration(core.StateLength) * time.Second)
+			continue
+		}
+		err = utils.UtilsInterface.SaveDataToDelegationMigrationFile(fileName, migrationData)
+		if err != nil {
+			return err
+		}
+		log.Infof("Delegation migration step %s completed", step)
+	}
+	log.Infof("Delegation migrated from staker %d to staker %d", migrationData.FromStakerId, migrationData.ToStakerId)
+	return nil
+}
+
+//This function executes the current step of the migration and returns the migration data with the next step
+//The step is not changed if the lock of the step is not over yet
+func (*UtilsStruct) MigrateDelegationStep(client *ethclient.Client, config types.Configurations, account types.Account, migrationData types.DelegationMigrationData) (types.DelegationMigrationData, error) {
+	switch migrationData.Step {
+	case migrationStepUnstake:
+		unstakeLock, err := razorUtils.GetLock(client, account.Address, migrationData.FromStakerId, 0)
+		if err != nil {
+			return migrationData, err
+		}
+		// An existing unstake lock means that the unstake transaction was mined before the migration was stopped
+		if unstakeLock.Amount.Cmp(big.NewInt(0)) != 0 {
+			log.Info("Unstake lock already exists, continuing the migration with it")
+		} else {
+			txnHash, err := cmdUtils.Unstake(config, client, types.UnstakeInput{
+				Address:    account.Address,
+				Password:   account.Password,
+				ValueInWei: migrationData.Amount,
+				StakerId:   migrationData.FromStakerId,
+			})
+			if err != nil {
+				return migrationData, err
+			}
+			if txnHash != core.NilHash {
+				err = razorUtils.WaitForBlockCompletion(client, txnHash.String())
+				if err != nil {
+					return migrationData, err
+				}
+			}
+		}
+		migrationData.Step = migrationStepInitiateWithdraw
+
+	case migrationStepInitiateWithdraw:
+		unstakeLock, err := razorUtils.GetLock(client, account.Address, migrationData.FromStakerId, 0)
+		if err != nil {
+			return migrationData, err
+		}
+		// The unstake lock is cleared once the withdrawal is initiated
+		if unstakeLock.UnlockAfter.Cmp(big.NewInt(0)) != 0 {
+			epoch, err := razorUtils.GetEpoch(client)
+			if err != nil {
+				return migrationData, err
+			}
+			if big.NewInt(int64(epoch)).Cmp(unstakeLock.UnlockAfter) < 0 {
+				log.Infof("Waiting for the unstake lock to end in epoch %s, current epoch is %d", unstakeLock.UnlockAfter, epoch)
+				return migrationData, nil
+			}
+			txnHash, err := cmdUtils.HandleUnstakeLock(client, account, config, migrationData.FromStakerId)
+			if err != nil {
+				return migrationData, err
+			}
+			if txnHash == core.NilHash {
+				return migrationData, errors.New("withdraw initiation period has passed, reset the unstake lock using resetUnstakeLock command and run migrateDelegation again")
+			}
+			err = razorUtils.WaitForBlockCompletion(client, txnHash.String())
+			if err != nil {
+				return migrationData, err
+			}
+		}
+		withdrawLock, err := razorUtils.GetLock(client, account.Address, migrationData.FromStakerId, 1)
+		if err != nil {
+			return migrationData, err
+		}
+		if withdrawLock.Amount.Cmp(big.NewInt(0)) == 0 {
+			return migrationData, errors.New("withdraw lock not found after initiating withdrawal")
+		}
+		migrationData.WithdrawAmount = withdrawLock.Amount
+		migrationData.Step = migrationStepUnlockWithdraw
+
+	case migrationStepUnlockWithdraw:
+		withdrawLock, err := razorUtils.GetLock(client, account.Address, migrationData.FromStakerId, 1)
+		if err != nil {
+			return migrationData, err
+		}
+		// The withdraw lock is cleared once the razors are unlocked
+		if withdrawLock.UnlockAfter.Cmp(big.NewInt(0)) != 0 {
+			epoch, err := razorUtils.GetEpoch(client)
+			if err != nil {
+				return migrationData, err
+			}
+			if big.NewInt(int64(epoch)).Cmp(withdrawLock.UnlockAfter) < 0 {
+				log.Infof("Waiting for the withdraw lock to end in epoch %s, current epoch is %d", withdrawLock.UnlockAfter, epoch)
+				return migrationData, nil
+			}
+			txnHash, err := cmdUtils.HandleWithdrawLock(client, account, config, migrationData.FromStakerId)
+			if err != nil {
+				return migrationData, err
+			}
+			if txnHash != core.NilHash {
+				err = razorUtils.WaitForBlockCompletion(client, txnHash.String())
+				if err != nil {
+					return migrationData, err
+				}
+			}
+		}
+		migrationData.Step = migrationStepDelegate
+
+	case migrationStepDelegate:
+		txnArgs := types.TransactionOptions{
+			Client:         client,
+			Password:       account.Password,
+			Amount:         migrationData.WithdrawAmount,
+			AccountAddress: account.Address,
+			ChainId:        core.ChainId,
+			Config:         config,
+		}
+		approveTxnHash, err := cmdUtils.Approve(txnArgs)
+		if err != nil {
+			return migrationData, err
+		}
+		if approveTxnHash != core.NilHash {
+			err = razorUtils.WaitForBlockCompletion(client, approveTxnHash.String())
+			if err != nil {
+				return migrationData, err
+			}
+		}
+		delegateTxnHash, err := cmdUtils.Delegate(txnArgs, migrationData.ToStakerId)
+		if err != nil {
+			return migrationData, err
+		}
+		err = razorUtils.WaitForBlockCompletion(client, delegateTxnHash.String())
+		if err != nil {
+			return migrationData, err
+		}
+		migrationData.Step = migrationStepCompleted
+
+	default:
+		return migrationData, fmt.Errorf("invalid delegation migration step: %s", migrationData.Step)
+	}
+	return migrationData, nil
+}
+
+func init() {
+	rootCmd.AddCommand(migrateDelegationCmd)
+
+	var (
+		Address      string
+		FromStakerId uint32
+		ToStakerId   uint32
+		Amount       string
+		WeiRazor     bool
+	)
+
+	migrateDelegationCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the delegator")
+	migrateDelegationCmd.Flags().Uint32VarP(&FromStakerId, "fromStakerId", "", 0, "staker id to move the delegation from")
+	migrateDelegationCmd.Flags().Uint32VarP(&ToStakerId, "toStakerId", "", 0, "staker id to move the delegation to")
+	migrateDelegationCmd.Flags().StringVarP(&Amount, "value", "v", "0", "value of sRazors to migrate, only needed to start a new migration")
+	migrateDelegationCmd.Flags().BoolVarP(&WeiRazor, "weiRazor", "", false, "value can be passed in wei")
+
+	addrErr := migrateDelegationCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+	fromStakerIdErr := migrateDelegationCmd.MarkFlagRequired("fromStakerId")
+	utils.CheckError("FromStakerId error: ", fromStakerIdErr)
+	toStakerIdErr := migrateDelegationCmd.MarkFlagRequired("toStakerId")
+	utils.CheckError("ToStakerId error: ", toStakerIdErr)
+}
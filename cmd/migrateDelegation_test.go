@@ -0,0 +1,655 @@
+package cmd
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+	"io/fs"
+	"math/big"
+	"os"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+)
+
+func TestExecuteMigrateDelegation(t *testing.T) {
+	var client *ethclient.Client
+	var flagSet *pflag.FlagSet
+
+	type args struct {
+		config               types.Configurations
+		configErr            error
+		password             string
+		address              string
+		addressErr           error
+		fromStakerId         uint32
+		fromStakerIdErr      error
+		toStakerId           uint32
+		toStakerIdErr        error
+		fileName             string
+		fileNameErr          error
+		migrationData        types.DelegationMigrationData
+		migrationDataErr     error
+		migrateDelegationErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteMigrateDelegation function executes successfully",
+			args: args{
+				config:        types.Configurations{},
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dead",
+				fromStakerId:  1,
+				toStakerId:    2,
+				fileName:      "",
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting config",
+			args: args{
+				configErr: errors.New("config error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting address",
+			args: args{
+				config:     types.Configurations{},
+				addressErr: errors.New("address error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting fromStakerId",
+			args: args{
+				config:          types.Configurations{},
+				password:        "test",
+				address:         "0x000000000000000000000000000000000000dead",
+				fromStakerIdErr: errors.New("fromStakerId error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting toStakerId",
+			args: args{
+				config:        types.Configurations{},
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dead",
+				fromStakerId:  1,
+				toStakerIdErr: errors.New("toStakerId error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting migration file name",
+			args: args{
+				config:       types.Configurations{},
+				password:     "test",
+				address:      "0x000000000000000000000000000000000000dead",
+				fromStakerId: 1,
+				toStakerId:   2,
+				fileNameErr:  errors.New("fileName error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting migration data",
+			args: args{
+				config:           types.Configurations{},
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dead",
+				fromStakerId:     1,
+				toStakerId:       2,
+				migrationDataErr: errors.New("migrationData error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 8: When there is an error in migrating delegation",
+			args: args{
+				config:               types.Configurations{},
+				password:             "test",
+				address:              "0x000000000000000000000000000000000000dead",
+				fromStakerId:         1,
+				toStakerId:           2,
+				migrationData:        types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+				migrateDelegationErr: errors.New("migrateDelegation error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			flagSetUtils = flagSetUtilsMock
+			cmdUtils = cmdUtilsMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
+			cmdUtilsMock.On("GetConfigData").Return(tt.args.config, tt.args.configErr)
+			utilsMock.On("AssignPassword").Return(tt.args.password)
+			flagSetUtilsMock.On("GetStringAddress", flagSet).Return(tt.args.address, tt.args.addressErr)
+			flagSetUtilsMock.On("GetBoolEncryptState", flagSet).Return(false, nil)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetUint32FromStakerId", flagSet).Return(tt.args.fromStakerId, tt.args.fromStakerIdErr)
+			flagSetUtilsMock.On("GetUint32ToStakerId", flagSet).Return(tt.args.toStakerId, tt.args.toStakerIdErr)
+			utilsMock.On("CheckEthBalanceIsZero", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return()
+			pathUtilsMock.On("GetDelegationMigrationFileName", mock.AnythingOfType("string")).Return(tt.args.fileName, tt.args.fileNameErr)
+			cmdUtilsMock.On("GetDelegationMigrationData", mock.AnythingOfType("*ethclient.Client"), flagSet, mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint32")).Return(tt.args.migrationData, tt.args.migrationDataErr)
+			cmdUtilsMock.On("MigrateDelegation", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(tt.args.migrateDelegationErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteMigrateDelegation(flagSet)
+
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteMigrateDelegation function didn't execute as expected")
+			}
+		})
+	}
+}
+
+func TestGetDelegationMigrationData(t *testing.T) {
+	var (
+		client   *ethclient.Client
+		flagSet  *pflag.FlagSet
+		fileInfo fs.FileInfo
+	)
+
+	type args struct {
+		fromStakerId uint32
+		toStakerId   uint32
+		statErr      error
+		fileData     types.DelegationMigrationData
+		fileDataErr  error
+		toStaker     bindings.StructsStaker
+		toStakerErr  error
+		amount       *big.Int
+		amountErr    error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.DelegationMigrationData
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When a new migration is started",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				statErr:      os.ErrNotExist,
+				toStaker:     bindings.StructsStaker{Id: 2, AcceptDelegation: true},
+				amount:       big.NewInt(1000),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When a migration in progress is resumed",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				fileData:     types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnlockWithdraw},
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnlockWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When a migration to another staker is in progress",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   3,
+				fileData:     types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnlockWithdraw},
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the previous migration is completed and a new one is started",
+			args: args{
+				fromStakerId: 2,
+				toStakerId:   3,
+				fileData:     types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepCompleted},
+				toStaker:     bindings.StructsStaker{Id: 3, AcceptDelegation: true},
+				amount:       big.NewInt(500),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 2, ToStakerId: 3, Amount: big.NewInt(500), Step: migrationStepUnstake},
+			wantErr: false,
+		},
+		{
+			name: "Test 5: When there is an error in reading the migration file",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				fileDataErr:  errors.New("read error"),
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When fromStakerId and toStakerId are same",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   1,
+				statErr:      os.ErrNotExist,
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting toStaker",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				statErr:      os.ErrNotExist,
+				toStakerErr:  errors.New("staker error"),
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When toStaker doesn't accept delegation",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				statErr:      os.ErrNotExist,
+				toStaker:     bindings.StructsStaker{Id: 2, AcceptDelegation: false},
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When there is an error in getting amount",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				statErr:      os.ErrNotExist,
+				toStaker:     bindings.StructsStaker{Id: 2, AcceptDelegation: true},
+				amountErr:    errors.New("amount error"),
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When amount is 0",
+			args: args{
+				fromStakerId: 1,
+				toStakerId:   2,
+				statErr:      os.ErrNotExist,
+				toStaker:     bindings.StructsStaker{Id: 2, AcceptDelegation: true},
+				amount:       big.NewInt(0),
+			},
+			want:    types.DelegationMigrationData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			osUtilsMock := new(pathMocks.OSInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.OSUtilsInterface = osUtilsMock
+
+			osUtilsMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			utilsPkgMock.On("ReadFromDelegationMigrationFile", mock.AnythingOfType("string")).Return(tt.args.fileData, tt.args.fileDataErr)
+			utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.toStaker, tt.args.toStakerErr)
+			cmdUtilsMock.On("AssignAmountInWei", flagSet).Return(tt.args.amount, tt.args.amountErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetDelegationMigrationData(client, flagSet, "", tt.args.fromStakerId, tt.args.toStakerId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDelegationMigrationData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDelegationMigrationData() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrateDelegation(t *testing.T) {
+	var (
+		client  *ethclient.Client
+		config  types.Configurations
+		account types.Account
+	)
+
+	type args struct {
+		migrationData types.DelegationMigrationData
+		steps         []string
+		stepErr       error
+		saveDataErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When MigrateDelegation executes all the steps successfully",
+			args: args{
+				migrationData: types.DelegationMigrationData{Step: migrationStepUnstake},
+				steps:         []string{migrationStepInitiateWithdraw, migrationStepUnlockWithdraw, migrationStepDelegate, migrationStepCompleted},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When MigrateDelegation waits for a lock to end",
+			args: args{
+				migrationData: types.DelegationMigrationData{Step: migrationStepInitiateWithdraw},
+				steps:         []string{migrationStepInitiateWithdraw, migrationStepUnlockWithdraw, migrationStepUnlockWithdraw, migrationStepDelegate, migrationStepCompleted},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the migration is already completed",
+			args: args{
+				migrationData: types.DelegationMigrationData{Step: migrationStepCompleted},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in executing a step",
+			args: args{
+				migrationData: types.DelegationMigrationData{Step: migrationStepUnstake},
+				stepErr:       errors.New("step error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in saving migration data",
+			args: args{
+				migrationData: types.DelegationMigrationData{Step: migrationStepUnstake},
+				steps:         []string{migrationStepInitiateWithdraw},
+				saveDataErr:   errors.New("save error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			timeMock := new(mocks.TimeInterface)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			timeUtils = timeMock
+
+			if tt.args.stepErr != nil {
+				cmdUtilsMock.On("MigrateDelegationStep", mock.AnythingOfType("*ethclient.Client"), config, account, mock.Anything).Return(tt.args.migrationData, tt.args.stepErr)
+			}
+			for _, step := range tt.args.steps {
+				cmdUtilsMock.On("MigrateDelegationStep", mock.AnythingOfType("*ethclient.Client"), config, account, mock.Anything).Return(types.DelegationMigrationData{Step: step}, nil).Once()
+			}
+			timeMock.On("Sleep", mock.AnythingOfType("time.Duration")).Return()
+			utilsPkgMock.On("SaveDataToDelegationMigrationFile", mock.AnythingOfType("string"), mock.Anything).Return(tt.args.saveDataErr)
+
+			ut := &UtilsStruct{}
+			if err := ut.MigrateDelegation(client, config, account, "", tt.args.migrationData); (err != nil) != tt.wantErr {
+				t.Errorf("MigrateDelegation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMigrateDelegationStep(t *testing.T) {
+	var (
+		client  *ethclient.Client
+		config  types.Configurations
+		account types.Account
+	)
+
+	type args struct {
+		migrationData      types.DelegationMigrationData
+		unstakeLock        types.Locks
+		unstakeLockErr     error
+		withdrawLock       types.Locks
+		withdrawLockErr    error
+		epoch              uint32
+		epochErr           error
+		unstakeHash        common.Hash
+		unstakeErr         error
+		unstakeLockHash    common.Hash
+		unstakeLockHashErr error
+		withdrawHash       common.Hash
+		withdrawErr        error
+		approveHash        common.Hash
+		approveErr         error
+		delegateHash       common.Hash
+		delegateErr        error
+		waitErr            error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.DelegationMigrationData
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When unstake step executes successfully",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+				unstakeLock:   types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)},
+				unstakeHash:   common.BigToHash(big.NewInt(1)),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When unstake lock already exists in unstake step",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+				unstakeLock:   types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(5)},
+				unstakeErr:    errors.New("unstake should not be called"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in unstake",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+				unstakeLock:   types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)},
+				unstakeErr:    errors.New("unstake error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepUnstake},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When unstake lock has not ended yet",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+				unstakeLock:   types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(5)},
+				epoch:         4,
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 5: When initiateWithdraw step executes successfully",
+			args: args{
+				migrationData:   types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+				unstakeLock:     types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(5)},
+				withdrawLock:    types.Locks{Amount: big.NewInt(1100), UnlockAfter: big.NewInt(10)},
+				epoch:           5,
+				unstakeLockHash: common.BigToHash(big.NewInt(1)),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 6: When withdraw initiation period has passed",
+			args: args{
+				migrationData:   types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+				unstakeLock:     types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(5)},
+				epoch:           8,
+				unstakeLockHash: core.NilHash,
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When withdraw lock is not found after initiating withdrawal",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+				unstakeLock:   types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)},
+				withdrawLock:  types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)},
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting epoch",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+				unstakeLock:   types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(5)},
+				epochErr:      errors.New("epoch error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), Step: migrationStepInitiateWithdraw},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When withdraw lock has not ended yet",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+				withdrawLock:  types.Locks{Amount: big.NewInt(1100), UnlockAfter: big.NewInt(10)},
+				epoch:         9,
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+			wantErr: false,
+		},
+		{
+			name: "Test 10: When unlockWithdraw step executes successfully",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+				withdrawLock:  types.Locks{Amount: big.NewInt(1100), UnlockAfter: big.NewInt(10)},
+				epoch:         10,
+				withdrawHash:  common.BigToHash(big.NewInt(1)),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+			wantErr: false,
+		},
+		{
+			name: "Test 11: When there is an error in unlocking withdraw",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+				withdrawLock:  types.Locks{Amount: big.NewInt(1100), UnlockAfter: big.NewInt(10)},
+				epoch:         10,
+				withdrawErr:   errors.New("withdraw error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepUnlockWithdraw},
+			wantErr: true,
+		},
+		{
+			name: "Test 12: When delegate step executes successfully",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+				approveHash:   common.BigToHash(big.NewInt(1)),
+				delegateHash:  common.BigToHash(big.NewInt(2)),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepCompleted},
+			wantErr: false,
+		},
+		{
+			name: "Test 13: When there is an error in approve",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+				approveErr:    errors.New("approve error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+			wantErr: true,
+		},
+		{
+			name: "Test 14: When there is an error in delegate",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+				approveHash:   core.NilHash,
+				delegateErr:   errors.New("delegate error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+			wantErr: true,
+		},
+		{
+			name: "Test 15: When there is an error in waiting for delegate transaction",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+				approveHash:   core.NilHash,
+				delegateHash:  common.BigToHash(big.NewInt(2)),
+				waitErr:       errors.New("wait error"),
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Amount: big.NewInt(1000), WithdrawAmount: big.NewInt(1100), Step: migrationStepDelegate},
+			wantErr: true,
+		},
+		{
+			name: "Test 16: When the step is invalid",
+			args: args{
+				migrationData: types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Step: "invalid"},
+			},
+			want:    types.DelegationMigrationData{FromStakerId: 1, ToStakerId: 2, Step: "invalid"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+
+			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), uint8(0)).Return(tt.args.unstakeLock, tt.args.unstakeLockErr)
+			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), uint8(1)).Return(tt.args.withdrawLock, tt.args.withdrawLockErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.waitErr)
+			cmdUtilsMock.On("Unstake", config, mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("types.UnstakeInput")).Return(tt.args.unstakeHash, tt.args.unstakeErr)
+			cmdUtilsMock.On("HandleUnstakeLock", mock.AnythingOfType("*ethclient.Client"), account, config, mock.AnythingOfType("uint32")).Return(tt.args.unstakeLockHash, tt.args.unstakeLockHashErr)
+			cmdUtilsMock.On("HandleWithdrawLock", mock.AnythingOfType("*ethclient.Client"), account, config, mock.AnythingOfType("uint32")).Return(tt.args.withdrawHash, tt.args.withdrawErr)
+			cmdUtilsMock.On("Approve", mock.AnythingOfType("types.TransactionOptions")).Return(tt.args.approveHash, tt.args.approveErr)
+			cmdUtilsMock.On("Delegate", mock.AnythingOfType("types.TransactionOptions"), mock.AnythingOfType("uint32")).Return(tt.args.delegateHash, tt.args.delegateErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.MigrateDelegationStep(client, config, account, tt.args.migrationData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MigrateDelegationStep() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MigrateDelegationStep() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -12,8 +12,8 @@ type FlagSetInterface struct {
 	mock.Mock
 }
 
-// GetBoolRogue provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetBoolRogue(flagSet *pflag.FlagSet) (bool, error) {
+// GetBoolAcknowledgeUnsubscribed provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolAcknowledgeUnsubscribed(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
 	var r0 bool
@@ -33,8 +33,8 @@ func (_m *FlagSetInterface) GetBoolRogue(flagSet *pflag.FlagSet) (bool, error) {
 	return r0, r1
 }
 
-// GetBoolWeiRazor provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetBoolWeiRazor(flagSet *pflag.FlagSet) (bool, error) {
+// GetBoolAll provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolAll(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
 	var r0 bool
@@ -54,15 +54,15 @@ func (_m *FlagSetInterface) GetBoolWeiRazor(flagSet *pflag.FlagSet) (bool, error
 	return r0, r1
 }
 
-// GetFloat32GasLimit provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetFloat32GasLimit(flagSet *pflag.FlagSet) (float32, error) {
+// GetBoolArchiveResponses provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolArchiveResponses(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -75,15 +75,15 @@ func (_m *FlagSetInterface) GetFloat32GasLimit(flagSet *pflag.FlagSet) (float32,
 	return r0, r1
 }
 
-// GetFloat32GasMultiplier provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetFloat32GasMultiplier(flagSet *pflag.FlagSet) (float32, error) {
+// GetBoolAttest provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolAttest(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -96,15 +96,15 @@ func (_m *FlagSetInterface) GetFloat32GasMultiplier(flagSet *pflag.FlagSet) (flo
 	return r0, r1
 }
 
-// GetInt32Buffer provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetInt32Buffer(flagSet *pflag.FlagSet) (int32, error) {
+// GetBoolAutoClaimBounty provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolAutoClaimBounty(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -117,15 +117,15 @@ func (_m *FlagSetInterface) GetInt32Buffer(flagSet *pflag.FlagSet) (int32, error
 	return r0, r1
 }
 
-// GetInt32GasPrice provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetInt32GasPrice(flagSet *pflag.FlagSet) (int32, error) {
+// GetBoolAutoWithdraw provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolAutoWithdraw(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -138,15 +138,15 @@ func (_m *FlagSetInterface) GetInt32GasPrice(flagSet *pflag.FlagSet) (int32, err
 	return r0, r1
 }
 
-// GetInt32Wait provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetInt32Wait(flagSet *pflag.FlagSet) (int32, error) {
+// GetBoolCanary provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolCanary(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -159,15 +159,15 @@ func (_m *FlagSetInterface) GetInt32Wait(flagSet *pflag.FlagSet) (int32, error)
 	return r0, r1
 }
 
-// GetInt8Power provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetInt8Power(flagSet *pflag.FlagSet) (int8, error) {
+// GetBoolChaos provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolChaos(flagSet *pflag.FlagSet) (bool, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 int8
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int8); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int8)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
@@ -180,20 +180,20 @@ func (_m *FlagSetInterface) GetInt8Power(flagSet *pflag.FlagSet) (int8, error) {
 	return r0, r1
 }
 
-// GetRootFloat32GasLimit provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootFloat32GasLimit() (float32, error) {
-	ret := _m.Called()
+// GetBoolEncryptState provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolEncryptState(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
-		r0 = rf()
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -201,20 +201,20 @@ func (_m *FlagSetInterface) GetRootFloat32GasLimit() (float32, error) {
 	return r0, r1
 }
 
-// GetRootFloat32GasMultiplier provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootFloat32GasMultiplier() (float32, error) {
-	ret := _m.Called()
+// GetBoolFleet provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolFleet(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
-		r0 = rf()
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(float32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -222,20 +222,2058 @@ func (_m *FlagSetInterface) GetRootFloat32GasMultiplier() (float32, error) {
 	return r0, r1
 }
 
-// GetRootInt32Buffer provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootInt32Buffer() (int32, error) {
-	ret := _m.Called()
+// GetBoolFollow provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolFollow(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolForce provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolForce(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolJson provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolJson(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolRemove provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolRemove(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolRogue provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolRogue(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolRpcDebug provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolRpcDebug(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolSchedule provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolSchedule(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolUseKeychain provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolUseKeychain(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBoolWeiRazor provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetBoolWeiRazor(flagSet *pflag.FlagSet) (bool, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) bool); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloat32AlertBalanceThreshold provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetFloat32AlertBalanceThreshold(flagSet *pflag.FlagSet) (float32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloat32AlertRazorBalanceThreshold provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetFloat32AlertRazorBalanceThreshold(flagSet *pflag.FlagSet) (float32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloat32GasBudget provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetFloat32GasBudget(flagSet *pflag.FlagSet) (float32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloat32GasLimit provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetFloat32GasLimit(flagSet *pflag.FlagSet) (float32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloat32GasMultiplier provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetFloat32GasMultiplier(flagSet *pflag.FlagSet) (float32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) float32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32APICacheTTL provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32APICacheTTL(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32Buffer provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32Buffer(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32GasPrice provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32GasPrice(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32HTTPRetryAttempts provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32HTTPRetryAttempts(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32HTTPRetryDelay provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32HTTPRetryDelay(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32HTTPTimeout provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32HTTPTimeout(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32LogsChunkSize provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32LogsChunkSize(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32MaxGasPrice provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32MaxGasPrice(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32Parallelism provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32Parallelism(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt32Wait provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt32Wait(flagSet *pflag.FlagSet) (int32, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int32); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInt8Power provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetInt8Power(flagSet *pflag.FlagSet) (int8, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 int8
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) int8); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(int8)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootFloat32GasBudget provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootFloat32GasBudget() (float32, error) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootFloat32GasLimit provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootFloat32GasLimit() (float32, error) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootFloat32GasMultiplier provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootFloat32GasMultiplier() (float32, error) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32APICacheTTL provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32APICacheTTL() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32Buffer provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32Buffer() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32GasPrice provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32GasPrice() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32HTTPRetryAttempts provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32HTTPRetryAttempts() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32HTTPRetryDelay provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32HTTPRetryDelay() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32HTTPTimeout provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32HTTPTimeout() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32LogsChunkSize provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32LogsChunkSize() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32MaxGasPrice provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32MaxGasPrice() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootInt32Wait provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootInt32Wait() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringGasBudgetPeriod provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringGasBudgetPeriod() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringHTTPProxy provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringHTTPProxy() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringKMSKey provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringKMSKey() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringLogLevel provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringLogLevel() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringProvider provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringProvider() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringRequestHeaders provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringRequestHeaders() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringSignerUrl provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringSignerUrl() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringReadProvider provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringReadProvider() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringSliceAllowedHosts provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringSliceAllowedHosts() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringToIntTxnTimeouts provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringToIntTxnTimeouts() (map[string]int, error) {
+	ret := _m.Called()
+
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func() map[string]int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRootStringToStringGasStrategies provides a mock function with given fields:
+func (_m *FlagSetInterface) GetRootStringToStringGasStrategies() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringAddress provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringAddress(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringAlias provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringAlias(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringAttestationKeyFile provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringAttestationKeyFile(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringBenchTime provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringBenchTime(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringBlockJson provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringBlockJson(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringCertFile provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringCertFile(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringCertKey provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringCertKey(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringChaosConfig provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringChaosConfig(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringDeployment provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringDeployment(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringDeployments provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringDeployments(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringExportFile provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringExportFile(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringExposeMetrics provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringExposeMetrics(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringFaultInjection provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringFaultInjection(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringFrom provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringFrom(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringGasBudgetPeriod provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringGasBudgetPeriod(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringGasTokenId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringGasTokenId(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringGroupBy provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringGroupBy(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringHTTPProxy provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringHTTPProxy(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringHealthPort provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringHealthPort(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringHistoryFormat provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringHistoryFormat(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringKMSKey provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringKMSKey(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringLevel provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringLevel(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringLogFile provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringLogFile(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringLogLevel provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringLogLevel(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringMetricsPort provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringMetricsPort(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringMode provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringMode(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringName provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringName(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringNotificationTemplates provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringNotificationTemplates(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringOutput provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringOutput(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringProvider provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringProvider(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringQuote provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringQuote(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringRemoteConfigSigner provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringRemoteConfigSigner(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringRemoteConfigUrl provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringRemoteConfigUrl(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringRequestHeaders provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringRequestHeaders(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringRewardsPeriod provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringRewardsPeriod(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSelector provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSelector(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSignerUrl provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSignerUrl(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringReadProvider provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringReadProvider(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceAccount provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceAccount(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceAlertWebhooks provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceAlertWebhooks(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceAllowedHosts provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceAllowedHosts(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceBenchmarks provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceBenchmarks(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceNodes provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceNodes(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringSliceRogueMode provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringSliceRogueMode(flagSet *pflag.FlagSet) ([]string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringState provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringState(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringStatus provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringStatus(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringTo provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringTo(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringToIntTxnTimeouts provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringToIntTxnTimeouts(flagSet *pflag.FlagSet) (map[string]int, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) map[string]int); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStringToStringGasStrategies provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringToStringGasStrategies(flagSet *pflag.FlagSet) (map[string]string, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func() int32); ok {
-		r0 = rf()
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) map[string]string); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -243,20 +2281,20 @@ func (_m *FlagSetInterface) GetRootInt32Buffer() (int32, error) {
 	return r0, r1
 }
 
-// GetRootInt32GasPrice provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootInt32GasPrice() (int32, error) {
-	ret := _m.Called()
+// GetStringUrl provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringUrl(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func() int32); ok {
-		r0 = rf()
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -264,20 +2302,20 @@ func (_m *FlagSetInterface) GetRootInt32GasPrice() (int32, error) {
 	return r0, r1
 }
 
-// GetRootInt32Wait provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootInt32Wait() (int32, error) {
-	ret := _m.Called()
+// GetStringValue provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetStringValue(flagSet *pflag.FlagSet) (string, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 int32
-	if rf, ok := ret.Get(0).(func() int32); ok {
-		r0 = rf()
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(int32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -285,20 +2323,20 @@ func (_m *FlagSetInterface) GetRootInt32Wait() (int32, error) {
 	return r0, r1
 }
 
-// GetRootStringLogLevel provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootStringLogLevel() (string, error) {
-	ret := _m.Called()
+// GetUint16AssetId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint16AssetId(flagSet *pflag.FlagSet) (uint16, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -306,20 +2344,20 @@ func (_m *FlagSetInterface) GetRootStringLogLevel() (string, error) {
 	return r0, r1
 }
 
-// GetRootStringProvider provides a mock function with given fields:
-func (_m *FlagSetInterface) GetRootStringProvider() (string, error) {
-	ret := _m.Called()
+// GetUint16Collection provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint16Collection(flagSet *pflag.FlagSet) (uint16, error) {
+	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
-		r0 = rf()
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
+		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -327,15 +2365,15 @@ func (_m *FlagSetInterface) GetRootStringProvider() (string, error) {
 	return r0, r1
 }
 
-// GetStringAddress provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringAddress(flagSet *pflag.FlagSet) (string, error) {
+// GetUint16CollectionId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint16CollectionId(flagSet *pflag.FlagSet) (uint16, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 error
@@ -348,15 +2386,15 @@ func (_m *FlagSetInterface) GetStringAddress(flagSet *pflag.FlagSet) (string, er
 	return r0, r1
 }
 
-// GetStringCertFile provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringCertFile(flagSet *pflag.FlagSet) (string, error) {
+// GetUint16Collections provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint16Collections(flagSet *pflag.FlagSet) (uint16, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 error
@@ -369,15 +2407,15 @@ func (_m *FlagSetInterface) GetStringCertFile(flagSet *pflag.FlagSet) (string, e
 	return r0, r1
 }
 
-// GetStringCertKey provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringCertKey(flagSet *pflag.FlagSet) (string, error) {
+// GetUint16JobId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint16JobId(flagSet *pflag.FlagSet) (uint16, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 error
@@ -390,15 +2428,15 @@ func (_m *FlagSetInterface) GetStringCertKey(flagSet *pflag.FlagSet) (string, er
 	return r0, r1
 }
 
-// GetStringExposeMetrics provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringExposeMetrics(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32Aggregation provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Aggregation(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -411,15 +2449,15 @@ func (_m *FlagSetInterface) GetStringExposeMetrics(flagSet *pflag.FlagSet) (stri
 	return r0, r1
 }
 
-// GetStringFrom provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringFrom(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32AlertRpcDownMinutes provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32AlertRpcDownMinutes(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -432,15 +2470,15 @@ func (_m *FlagSetInterface) GetStringFrom(flagSet *pflag.FlagSet) (string, error
 	return r0, r1
 }
 
-// GetStringLogLevel provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringLogLevel(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32ArchiveRetentionDays provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32ArchiveRetentionDays(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -453,15 +2491,15 @@ func (_m *FlagSetInterface) GetStringLogLevel(flagSet *pflag.FlagSet) (string, e
 	return r0, r1
 }
 
-// GetStringName provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringName(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32Blocks provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Blocks(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -474,15 +2512,15 @@ func (_m *FlagSetInterface) GetStringName(flagSet *pflag.FlagSet) (string, error
 	return r0, r1
 }
 
-// GetStringProvider provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringProvider(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32BountyId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32BountyId(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -495,15 +2533,15 @@ func (_m *FlagSetInterface) GetStringProvider(flagSet *pflag.FlagSet) (string, e
 	return r0, r1
 }
 
-// GetStringSelector provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringSelector(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32Days provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Days(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -516,17 +2554,15 @@ func (_m *FlagSetInterface) GetStringSelector(flagSet *pflag.FlagSet) (string, e
 	return r0, r1
 }
 
-// GetStringSliceRogueMode provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringSliceRogueMode(flagSet *pflag.FlagSet) ([]string, error) {
+// GetUint32Epoch provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Epoch(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -539,15 +2575,15 @@ func (_m *FlagSetInterface) GetStringSliceRogueMode(flagSet *pflag.FlagSet) ([]s
 	return r0, r1
 }
 
-// GetStringStatus provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringStatus(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32Epochs provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Epochs(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -560,15 +2596,15 @@ func (_m *FlagSetInterface) GetStringStatus(flagSet *pflag.FlagSet) (string, err
 	return r0, r1
 }
 
-// GetStringTo provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringTo(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32EventsDays provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32EventsDays(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -581,15 +2617,15 @@ func (_m *FlagSetInterface) GetStringTo(flagSet *pflag.FlagSet) (string, error)
 	return r0, r1
 }
 
-// GetStringUrl provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringUrl(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32FromStakerId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32FromStakerId(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -602,15 +2638,15 @@ func (_m *FlagSetInterface) GetStringUrl(flagSet *pflag.FlagSet) (string, error)
 	return r0, r1
 }
 
-// GetStringValue provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetStringValue(flagSet *pflag.FlagSet) (string, error) {
+// GetUint32Last provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Last(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) string); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -623,15 +2659,15 @@ func (_m *FlagSetInterface) GetStringValue(flagSet *pflag.FlagSet) (string, erro
 	return r0, r1
 }
 
-// GetUint16AssetId provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint16AssetId(flagSet *pflag.FlagSet) (uint16, error) {
+// GetUint32RemoteConfigInterval provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32RemoteConfigInterval(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -644,15 +2680,15 @@ func (_m *FlagSetInterface) GetUint16AssetId(flagSet *pflag.FlagSet) (uint16, er
 	return r0, r1
 }
 
-// GetUint16CollectionId provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint16CollectionId(flagSet *pflag.FlagSet) (uint16, error) {
+// GetUint32RpcDebugDuration provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32RpcDebugDuration(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -665,15 +2701,15 @@ func (_m *FlagSetInterface) GetUint16CollectionId(flagSet *pflag.FlagSet) (uint1
 	return r0, r1
 }
 
-// GetUint16JobId provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint16JobId(flagSet *pflag.FlagSet) (uint16, error) {
+// GetUint32SpeedUpBlocks provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32SpeedUpBlocks(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint16); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) uint32); ok {
 		r0 = rf(flagSet)
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 error
@@ -686,8 +2722,8 @@ func (_m *FlagSetInterface) GetUint16JobId(flagSet *pflag.FlagSet) (uint16, erro
 	return r0, r1
 }
 
-// GetUint32Aggregation provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint32Aggregation(flagSet *pflag.FlagSet) (uint32, error) {
+// GetUint32StakerId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32StakerId(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
 	var r0 uint32
@@ -707,8 +2743,8 @@ func (_m *FlagSetInterface) GetUint32Aggregation(flagSet *pflag.FlagSet) (uint32
 	return r0, r1
 }
 
-// GetUint32BountyId provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint32BountyId(flagSet *pflag.FlagSet) (uint32, error) {
+// GetUint32Stakers provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32Stakers(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
 	var r0 uint32
@@ -728,8 +2764,8 @@ func (_m *FlagSetInterface) GetUint32BountyId(flagSet *pflag.FlagSet) (uint32, e
 	return r0, r1
 }
 
-// GetUint32StakerId provides a mock function with given fields: flagSet
-func (_m *FlagSetInterface) GetUint32StakerId(flagSet *pflag.FlagSet) (uint32, error) {
+// GetUint32ToStakerId provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUint32ToStakerId(flagSet *pflag.FlagSet) (uint32, error) {
 	ret := _m.Called(flagSet)
 
 	var r0 uint32
@@ -856,6 +2892,29 @@ func (_m *FlagSetInterface) GetUintSliceJobIds(flagSet *pflag.FlagSet) ([]uint,
 	return r0, r1
 }
 
+// GetUintSliceSubscribedCollections provides a mock function with given fields: flagSet
+func (_m *FlagSetInterface) GetUintSliceSubscribedCollections(flagSet *pflag.FlagSet) ([]uint, error) {
+	ret := _m.Called(flagSet)
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func(*pflag.FlagSet) []uint); ok {
+		r0 = rf(flagSet)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*pflag.FlagSet) error); ok {
+		r1 = rf(flagSet)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewFlagSetInterface interface {
 	mock.TestingT
 	Cleanup(func())
@@ -19,6 +19,8 @@ import (
 
 	ethclient "github.com/ethereum/go-ethereum/ethclient"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	pflag "github.com/spf13/pflag"
@@ -31,6 +33,60 @@ type UtilsCmdInterface struct {
 	mock.Mock
 }
 
+// AccumulateRewards provides a mock function with given fields: ctx, client, address
+func (_m *UtilsCmdInterface) AccumulateRewards(ctx context.Context, client *ethclient.Client, address string) {
+	_m.Called(ctx, client, address)
+}
+
+// AddToWithdrawQueue provides a mock function with given fields: client, address, stakerId, txnHash
+func (_m *UtilsCmdInterface) AddToWithdrawQueue(client *ethclient.Client, address string, stakerId uint32, txnHash string) error {
+	ret := _m.Called(client, address, stakerId, txnHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32, string) error); ok {
+		r0 = rf(client, address, stakerId, txnHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyCommissionSchedule provides a mock function with given fields: client, config, account
+func (_m *UtilsCmdInterface) ApplyCommissionSchedule(client *ethclient.Client, config types.Configurations, account types.Account) error {
+	ret := _m.Called(client, config, account)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account) error); ok {
+		r0 = rf(client, config, account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyRemoteConfig provides a mock function with given fields: config, values
+func (_m *UtilsCmdInterface) ApplyRemoteConfig(config types.Configurations, values map[string]interface{}) (types.Configurations, error) {
+	ret := _m.Called(config, values)
+
+	var r0 types.Configurations
+	if rf, ok := ret.Get(0).(func(types.Configurations, map[string]interface{}) types.Configurations); ok {
+		r0 = rf(config, values)
+	} else {
+		r0 = ret.Get(0).(types.Configurations)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Configurations, map[string]interface{}) error); ok {
+		r1 = rf(config, values)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Approve provides a mock function with given fields: txnArgs
 func (_m *UtilsCmdInterface) Approve(txnArgs types.TransactionOptions) (common.Hash, error) {
 	ret := _m.Called(txnArgs)
@@ -100,6 +156,35 @@ func (_m *UtilsCmdInterface) AssignAmountInWei(flagSet *pflag.FlagSet) (*big.Int
 	return r0, r1
 }
 
+// AutoClaimBounties provides a mock function with given fields: ctx, client, config, account
+func (_m *UtilsCmdInterface) AutoClaimBounties(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	_m.Called(ctx, client, config, account)
+}
+
+// AutoUpdateCommission provides a mock function with given fields: ctx, client, config, account
+func (_m *UtilsCmdInterface) AutoUpdateCommission(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	_m.Called(ctx, client, config, account)
+}
+
+// AutoWithdraw provides a mock function with given fields: ctx, client, config, account
+func (_m *UtilsCmdInterface) AutoWithdraw(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	_m.Called(ctx, client, config, account)
+}
+
+// Backtest provides a mock function with given fields: client, collectionId, days, aggregationMethod
+func (_m *UtilsCmdInterface) Backtest(client *ethclient.Client, collectionId uint16, days uint32, aggregationMethod uint32) error {
+	ret := _m.Called(client, collectionId, days, aggregationMethod)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint16, uint32, uint32) error); ok {
+		r0 = rf(client, collectionId, days, aggregationMethod)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CalculateSecret provides a mock function with given fields: account, epoch, keystorePath, chainId
 func (_m *UtilsCmdInterface) CalculateSecret(account types.Account, epoch uint32, keystorePath string, chainId *big.Int) ([]byte, []byte, error) {
 	ret := _m.Called(account, epoch, keystorePath, chainId)
@@ -176,6 +261,78 @@ func (_m *UtilsCmdInterface) CheckDisputeForIds(client *ethclient.Client, transa
 	return r0, r1
 }
 
+// CheckProposedBlocks provides a mock function with given fields: client, blockNumber, epoch
+func (_m *UtilsCmdInterface) CheckProposedBlocks(client *ethclient.Client, blockNumber *big.Int, epoch uint32) (types.EpochBlockCheck, error) {
+	ret := _m.Called(client, blockNumber, epoch)
+
+	var r0 types.EpochBlockCheck
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, *big.Int, uint32) types.EpochBlockCheck); ok {
+		r0 = rf(client, blockNumber, epoch)
+	} else {
+		r0 = ret.Get(0).(types.EpochBlockCheck)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, *big.Int, uint32) error); ok {
+		r1 = rf(client, blockNumber, epoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckStakeSafety provides a mock function with given fields: client, stakerId, stakeChange, force
+func (_m *UtilsCmdInterface) CheckStakeSafety(client *ethclient.Client, stakerId uint32, stakeChange *big.Int, force bool) error {
+	ret := _m.Called(client, stakerId, stakeChange, force)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint32, *big.Int, bool) error); ok {
+		r0 = rf(client, stakerId, stakeChange, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CheckVotingEligibility provides a mock function with given fields: client, address
+func (_m *UtilsCmdInterface) CheckVotingEligibility(client *ethclient.Client, address string) error {
+	ret := _m.Called(client, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) error); ok {
+		r0 = rf(client, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimAllBounties provides a mock function with given fields: client, config, account, eventsDays
+func (_m *UtilsCmdInterface) ClaimAllBounties(client *ethclient.Client, config types.Configurations, account types.Account, eventsDays uint32) ([]types.BountyClaim, error) {
+	ret := _m.Called(client, config, account, eventsDays)
+
+	var r0 []types.BountyClaim
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account, uint32) []types.BountyClaim); ok {
+		r0 = rf(client, config, account, eventsDays)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BountyClaim)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, types.Configurations, types.Account, uint32) error); ok {
+		r1 = rf(client, config, account, eventsDays)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClaimBlockReward provides a mock function with given fields: options
 func (_m *UtilsCmdInterface) ClaimBlockReward(options types.TransactionOptions) (common.Hash, error) {
 	ret := _m.Called(options)
@@ -227,6 +384,20 @@ func (_m *UtilsCmdInterface) ClaimCommission(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ClaimEligibleBounties provides a mock function with given fields: client, config, account, epoch
+func (_m *UtilsCmdInterface) ClaimEligibleBounties(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32) error {
+	ret := _m.Called(client, config, account, epoch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account, uint32) error); ok {
+		r0 = rf(client, config, account, epoch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Commit provides a mock function with given fields: client, config, account, epoch, seed, root
 func (_m *UtilsCmdInterface) Commit(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, seed []byte, root [32]byte) (common.Hash, error) {
 	ret := _m.Called(client, config, account, epoch, seed, root)
@@ -359,6 +530,47 @@ func (_m *UtilsCmdInterface) Dispute(client *ethclient.Client, config types.Conf
 	return r0
 }
 
+// EstimateEpochGas provides a mock function with given fields: client, config, address
+func (_m *UtilsCmdInterface) EstimateEpochGas(client *ethclient.Client, config types.Configurations, address string) (types.EpochGasEstimate, error) {
+	ret := _m.Called(client, config, address)
+
+	var r0 types.EpochGasEstimate
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, string) types.EpochGasEstimate); ok {
+		r0 = rf(client, config, address)
+	} else {
+		r0 = ret.Get(0).(types.EpochGasEstimate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, types.Configurations, string) error); ok {
+		r1 = rf(client, config, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteActivity provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteActivity(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteBacktest provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteBacktest(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteBench provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteBench(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteCheckBlock provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteCheckBlock(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteClaimBounty provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteClaimBounty(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
@@ -369,6 +581,11 @@ func (_m *UtilsCmdInterface) ExecuteCollectionList(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteCommissionInfo provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteCommissionInfo(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteContractAddresses provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteContractAddresses(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
@@ -394,36 +611,155 @@ func (_m *UtilsCmdInterface) ExecuteDelegate(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteDelegatorInfo provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteDelegatorInfo(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteDisputeSimulate provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteDisputeSimulate(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteEstimateEpoch provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteEstimateEpoch(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteExtendLock provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteExtendLock(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteFleetDiff provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteFleetDiff(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteGasReport provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteGasReport(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteGetEpoch provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteGetEpoch(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteHistory provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteHistory(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteImport provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteImport(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteInfluenceBreakdown provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteInfluenceBreakdown(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteInitiateWithdraw provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteInitiateWithdraw(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteInspectCommit provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteInspectCommit(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteJobList provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteJobList(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteKeychainRemove provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteKeychainRemove(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteKeychainStore provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteKeychainStore(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteListAccounts provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteListAccounts(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteLogs provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteLogs(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteMigrateDelegation provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteMigrateDelegation(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteModifyCollectionStatus provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteModifyCollectionStatus(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteObserver provides a mock function with given fields: flagSet, config, client, address
+func (_m *UtilsCmdInterface) ExecuteObserver(flagSet *pflag.FlagSet, config types.Configurations, client *ethclient.Client, address string) {
+	_m.Called(flagSet, config, client, address)
+}
+
+// ExecuteOverrideInit provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteOverrideInit(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteProve provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteProve(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteRepl provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteRepl(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteReplCommand provides a mock function with given fields: args
+func (_m *UtilsCmdInterface) ExecuteReplCommand(args []string) error {
+	ret := _m.Called(args)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExecuteResetDispute provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteResetDispute(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteRewards provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteRewards(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteRiskReport provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteRiskReport(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteSetAccountAlias provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteSetAccountAlias(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
 // ExecuteSetDelegation provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteSetDelegation(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
@@ -469,11 +805,47 @@ func (_m *UtilsCmdInterface) ExecuteUpdateJob(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteValidateConfig provides a mock function with given fields:
+func (_m *UtilsCmdInterface) ExecuteValidateConfig() {
+	_m.Called()
+}
+
 // ExecuteVote provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) ExecuteVote(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// ExecuteVoteDeployments provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteVoteDeployments(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// ExecuteWithdrawQueue provides a mock function with given fields: flagSet
+func (_m *UtilsCmdInterface) ExecuteWithdrawQueue(flagSet *pflag.FlagSet) {
+	_m.Called(flagSet)
+}
+
+// GenerateOverrideFile provides a mock function with given fields: client
+func (_m *UtilsCmdInterface) GenerateOverrideFile(client *ethclient.Client) (types.OverrideFile, error) {
+	ret := _m.Called(client)
+
+	var r0 types.OverrideFile
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) types.OverrideFile); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(types.OverrideFile)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client) error); ok {
+		r1 = rf(client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateTreeRevealData provides a mock function with given fields: merkleTree, commitData
 func (_m *UtilsCmdInterface) GenerateTreeRevealData(merkleTree [][][]byte, commitData types.CommitData) bindings.StructsMerkleTree {
 	ret := _m.Called(merkleTree, commitData)
@@ -488,28 +860,118 @@ func (_m *UtilsCmdInterface) GenerateTreeRevealData(merkleTree [][][]byte, commi
 	return r0
 }
 
-// GetBiggestStakeAndId provides a mock function with given fields: client, address, epoch
-func (_m *UtilsCmdInterface) GetBiggestStakeAndId(client *ethclient.Client, address string, epoch uint32) (*big.Int, uint32, error) {
-	ret := _m.Called(client, address, epoch)
+// GetAPICacheTTL provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetAPICacheTTL() (int32, error) {
+	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32) *big.Int); ok {
-		r0 = rf(client, address, epoch)
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(int32)
 	}
 
-	var r1 uint32
-	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32) uint32); ok {
-		r1 = rf(client, address, epoch)
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
-		r1 = ret.Get(1).(uint32)
+		r1 = ret.Error(1)
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(*ethclient.Client, string, uint32) error); ok {
+	return r0, r1
+}
+
+// GetAccountAliases provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetAccountAliases() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActivityFeed provides a mock function with given fields: client, address, days
+func (_m *UtilsCmdInterface) GetActivityFeed(client *ethclient.Client, address string, days uint32) ([]types.ActivityEntry, error) {
+	ret := _m.Called(client, address, days)
+
+	var r0 []types.ActivityEntry
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32) []types.ActivityEntry); ok {
+		r0 = rf(client, address, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ActivityEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32) error); ok {
+		r1 = rf(client, address, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllowedHosts provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetAllowedHosts() ([]string, error) {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBiggestStakeAndId provides a mock function with given fields: client, address, epoch
+func (_m *UtilsCmdInterface) GetBiggestStakeAndId(client *ethclient.Client, address string, epoch uint32) (*big.Int, uint32, error) {
+	ret := _m.Called(client, address, epoch)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32) *big.Int); ok {
+		r0 = rf(client, address, epoch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32) uint32); ok {
+		r1 = rf(client, address, epoch)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*ethclient.Client, string, uint32) error); ok {
 		r2 = rf(client, address, epoch)
 	} else {
 		r2 = ret.Error(2)
@@ -590,6 +1052,27 @@ func (_m *UtilsCmdInterface) GetCollectionList(client *ethclient.Client) error {
 	return r0
 }
 
+// GetCommissionWindow provides a mock function with given fields: client, stakerId
+func (_m *UtilsCmdInterface) GetCommissionWindow(client *ethclient.Client, stakerId uint32) (types.CommissionWindow, error) {
+	ret := _m.Called(client, stakerId)
+
+	var r0 types.CommissionWindow
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint32) types.CommissionWindow); ok {
+		r0 = rf(client, stakerId)
+	} else {
+		r0 = ret.Get(0).(types.CommissionWindow)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, uint32) error); ok {
+		r1 = rf(client, stakerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetConfigData provides a mock function with given fields:
 func (_m *UtilsCmdInterface) GetConfigData() (types.Configurations, error) {
 	ret := _m.Called()
@@ -611,6 +1094,73 @@ func (_m *UtilsCmdInterface) GetConfigData() (types.Configurations, error) {
 	return r0, r1
 }
 
+// GetDelegationMigrationData provides a mock function with given fields: client, flagSet, fileName, fromStakerId, toStakerId
+func (_m *UtilsCmdInterface) GetDelegationMigrationData(client *ethclient.Client, flagSet *pflag.FlagSet, fileName string, fromStakerId uint32, toStakerId uint32) (types.DelegationMigrationData, error) {
+	ret := _m.Called(client, flagSet, fileName, fromStakerId, toStakerId)
+
+	var r0 types.DelegationMigrationData
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, *pflag.FlagSet, string, uint32, uint32) types.DelegationMigrationData); ok {
+		r0 = rf(client, flagSet, fileName, fromStakerId, toStakerId)
+	} else {
+		r0 = ret.Get(0).(types.DelegationMigrationData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, *pflag.FlagSet, string, uint32, uint32) error); ok {
+		r1 = rf(client, flagSet, fileName, fromStakerId, toStakerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDelegatorInfo provides a mock function with given fields: client, address, days
+func (_m *UtilsCmdInterface) GetDelegatorInfo(client *ethclient.Client, address string, days uint32) ([]types.DelegatorPosition, error) {
+	ret := _m.Called(client, address, days)
+
+	var r0 []types.DelegatorPosition
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32) []types.DelegatorPosition); ok {
+		r0 = rf(client, address, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.DelegatorPosition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32) error); ok {
+		r1 = rf(client, address, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDelegatorPositions provides a mock function with given fields: client, address
+func (_m *UtilsCmdInterface) GetDelegatorPositions(client *ethclient.Client, address string) ([]types.DelegatorPosition, error) {
+	ret := _m.Called(client, address)
+
+	var r0 []types.DelegatorPosition
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) []types.DelegatorPosition); ok {
+		r0 = rf(client, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.DelegatorPosition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string) error); ok {
+		r1 = rf(client, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEpochAndState provides a mock function with given fields: client
 func (_m *UtilsCmdInterface) GetEpochAndState(client *ethclient.Client) (uint32, int64, error) {
 	ret := _m.Called(client)
@@ -639,6 +1189,69 @@ func (_m *UtilsCmdInterface) GetEpochAndState(client *ethclient.Client) (uint32,
 	return r0, r1, r2
 }
 
+// GetEpochInfo provides a mock function with given fields: client
+func (_m *UtilsCmdInterface) GetEpochInfo(client *ethclient.Client) (types.EpochInfo, error) {
+	ret := _m.Called(client)
+
+	var r0 types.EpochInfo
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) types.EpochInfo); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(types.EpochInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client) error); ok {
+		r1 = rf(client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGasBudget provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetGasBudget() (float32, error) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGasBudgetPeriod provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetGasBudgetPeriod() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetGasLimit provides a mock function with given fields:
 func (_m *UtilsCmdInterface) GetGasLimit() (float32, error) {
 	ret := _m.Called()
@@ -681,6 +1294,180 @@ func (_m *UtilsCmdInterface) GetGasPrice() (int32, error) {
 	return r0, r1
 }
 
+// GetGasReport provides a mock function with given fields: client, address, epochs, groupBy
+func (_m *UtilsCmdInterface) GetGasReport(client *ethclient.Client, address string, epochs uint32, groupBy string) (types.GasReport, error) {
+	ret := _m.Called(client, address, epochs, groupBy)
+
+	var r0 types.GasReport
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32, string) types.GasReport); ok {
+		r0 = rf(client, address, epochs, groupBy)
+	} else {
+		r0 = ret.Get(0).(types.GasReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32, string) error); ok {
+		r1 = rf(client, address, epochs, groupBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGasStrategies provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetGasStrategies() (map[string]string, error) {
+	ret := _m.Called()
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHTTPProxy provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetHTTPProxy() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHTTPRetryAttempts provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetHTTPRetryAttempts() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHTTPRetryDelay provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetHTTPRetryDelay() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHTTPTimeout provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetHTTPTimeout() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHistory provides a mock function with given fields: client, address, epochs
+func (_m *UtilsCmdInterface) GetHistory(client *ethclient.Client, address string, epochs uint32) ([]types.LedgerRecord, error) {
+	ret := _m.Called(client, address, epochs)
+
+	var r0 []types.LedgerRecord
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32) []types.LedgerRecord); ok {
+		r0 = rf(client, address, epochs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.LedgerRecord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32) error); ok {
+		r1 = rf(client, address, epochs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInfluenceBreakdown provides a mock function with given fields: client, blockNumber, epoch
+func (_m *UtilsCmdInterface) GetInfluenceBreakdown(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.CollectionInfluence, error) {
+	ret := _m.Called(client, blockNumber, epoch)
+
+	var r0 []types.CollectionInfluence
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, *big.Int, uint32) []types.CollectionInfluence); ok {
+		r0 = rf(client, blockNumber, epoch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.CollectionInfluence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, *big.Int, uint32) error); ok {
+		r1 = rf(client, blockNumber, epoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetIteration provides a mock function with given fields: client, proposer, bufferPercent
 func (_m *UtilsCmdInterface) GetIteration(client *ethclient.Client, proposer types.ElectedProposer, bufferPercent int32) int {
 	ret := _m.Called(client, proposer, bufferPercent)
@@ -709,6 +1496,27 @@ func (_m *UtilsCmdInterface) GetJobList(client *ethclient.Client) error {
 	return r0
 }
 
+// GetKMSKey provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetKMSKey() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastProposedEpoch provides a mock function with given fields: client, blockNumber, stakerId
 func (_m *UtilsCmdInterface) GetLastProposedEpoch(client *ethclient.Client, blockNumber *big.Int, stakerId uint32) (uint32, error) {
 	ret := _m.Called(client, blockNumber, stakerId)
@@ -792,6 +1600,48 @@ func (_m *UtilsCmdInterface) GetLogLevel() (string, error) {
 	return r0, r1
 }
 
+// GetLogsChunkSize provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetLogsChunkSize() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMaxGasPrice provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetMaxGasPrice() (int32, error) {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMultiplier provides a mock function with given fields:
 func (_m *UtilsCmdInterface) GetMultiplier() (float32, error) {
 	ret := _m.Called()
@@ -834,6 +1684,99 @@ func (_m *UtilsCmdInterface) GetProvider() (string, error) {
 	return r0, r1
 }
 
+// GetRequestHeaders provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetRequestHeaders() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRevealDataFromTransaction provides a mock function with given fields: client, txHash
+func (_m *UtilsCmdInterface) GetRevealDataFromTransaction(client *ethclient.Client, txHash common.Hash) (bindings.StructsMerkleTree, []byte, error) {
+	ret := _m.Called(client, txHash)
+
+	var r0 bindings.StructsMerkleTree
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, common.Hash) bindings.StructsMerkleTree); ok {
+		r0 = rf(client, txHash)
+	} else {
+		r0 = ret.Get(0).(bindings.StructsMerkleTree)
+	}
+
+	var r1 []byte
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, common.Hash) []byte); ok {
+		r1 = rf(client, txHash)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*ethclient.Client, common.Hash) error); ok {
+		r2 = rf(client, txHash)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetRewards provides a mock function with given fields: client, address, days, period
+func (_m *UtilsCmdInterface) GetRewards(client *ethclient.Client, address string, days uint32, period string) (types.RewardsReport, error) {
+	ret := _m.Called(client, address, days, period)
+
+	var r0 types.RewardsReport
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32, string) types.RewardsReport); ok {
+		r0 = rf(client, address, days, period)
+	} else {
+		r0 = ret.Get(0).(types.RewardsReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, uint32, string) error); ok {
+		r1 = rf(client, address, days, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRiskReport provides a mock function with given fields: client, stakerId
+func (_m *UtilsCmdInterface) GetRiskReport(client *ethclient.Client, stakerId uint32) (types.RiskReport, error) {
+	ret := _m.Called(client, stakerId)
+
+	var r0 types.RiskReport
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint32) types.RiskReport); ok {
+		r0 = rf(client, stakerId)
+	} else {
+		r0 = ret.Get(0).(types.RiskReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, uint32) error); ok {
+		r1 = rf(client, stakerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSalt provides a mock function with given fields: client, epoch
 func (_m *UtilsCmdInterface) GetSalt(client *ethclient.Client, epoch uint32) ([32]byte, error) {
 	ret := _m.Called(client, epoch)
@@ -857,6 +1800,48 @@ func (_m *UtilsCmdInterface) GetSalt(client *ethclient.Client, epoch uint32) ([3
 	return r0, r1
 }
 
+// GetSignerUrl provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetSignerUrl() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReadProvider provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetReadProvider() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSmallestStakeAndId provides a mock function with given fields: client, epoch
 func (_m *UtilsCmdInterface) GetSmallestStakeAndId(client *ethclient.Client, epoch uint32) (*big.Int, uint32, error) {
 	ret := _m.Called(client, epoch)
@@ -901,8 +1886,43 @@ func (_m *UtilsCmdInterface) GetSortedRevealedValues(client *ethclient.Client, b
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*ethclient.Client, *big.Int, uint32) error); ok {
-		r1 = rf(client, blockNumber, epoch)
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, *big.Int, uint32) error); ok {
+		r1 = rf(client, blockNumber, epoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStakerInfo provides a mock function with given fields: client, stakerId
+func (_m *UtilsCmdInterface) GetStakerInfo(client *ethclient.Client, stakerId uint32) error {
+	ret := _m.Called(client, stakerId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint32) error); ok {
+		r0 = rf(client, stakerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetStateFromName provides a mock function with given fields: stateName
+func (_m *UtilsCmdInterface) GetStateFromName(stateName string) (int64, error) {
+	ret := _m.Called(stateName)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(stateName)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(stateName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -910,18 +1930,27 @@ func (_m *UtilsCmdInterface) GetSortedRevealedValues(client *ethclient.Client, b
 	return r0, r1
 }
 
-// GetStakerInfo provides a mock function with given fields: client, stakerId
-func (_m *UtilsCmdInterface) GetStakerInfo(client *ethclient.Client, stakerId uint32) error {
-	ret := _m.Called(client, stakerId)
+// GetTxnTimeouts provides a mock function with given fields:
+func (_m *UtilsCmdInterface) GetTxnTimeouts() (map[string]int, error) {
+	ret := _m.Called()
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*ethclient.Client, uint32) error); ok {
-		r0 = rf(client, stakerId)
+	var r0 map[string]int
+	if rf, ok := ret.Get(0).(func() map[string]int); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // GetWaitTime provides a mock function with given fields:
@@ -945,9 +1974,41 @@ func (_m *UtilsCmdInterface) GetWaitTime() (int32, error) {
 	return r0, r1
 }
 
+// GetWithdrawLocks provides a mock function with given fields: client, address
+func (_m *UtilsCmdInterface) GetWithdrawLocks(client *ethclient.Client, address string) ([]types.WithdrawLock, error) {
+	ret := _m.Called(client, address)
+
+	var r0 []types.WithdrawLock
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) []types.WithdrawLock); ok {
+		r0 = rf(client, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.WithdrawLock)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string) error); ok {
+		r1 = rf(client, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GiveSorted provides a mock function with given fields: client, blockManager, txnOpts, epoch, assetId, sortedStakers
-func (_m *UtilsCmdInterface) GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int) {
-	_m.Called(client, blockManager, txnOpts, epoch, assetId, sortedStakers)
+func (_m *UtilsCmdInterface) GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int) error {
+	ret := _m.Called(client, blockManager, txnOpts, epoch, assetId, sortedStakers)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, *bindings.BlockManager, *bind.TransactOpts, uint32, uint16, []*big.Int) error); ok {
+		r0 = rf(client, blockManager, txnOpts, epoch, assetId, sortedStakers)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
 // HandleBlock provides a mock function with given fields: client, account, blockNumber, config, rogueData
@@ -1004,9 +2065,19 @@ func (_m *UtilsCmdInterface) HandleDispute(client *ethclient.Client, config type
 	return r0
 }
 
-// HandleExit provides a mock function with given fields:
-func (_m *UtilsCmdInterface) HandleExit() {
-	_m.Called()
+// HandleDisputeOnlyBlock provides a mock function with given fields: client, account, state, epoch, blockNumber, config, rogueData
+func (_m *UtilsCmdInterface) HandleDisputeOnlyBlock(client *ethclient.Client, account types.Account, state int64, epoch uint32, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue) {
+	_m.Called(client, account, state, epoch, blockNumber, config, rogueData)
+}
+
+// HandleExit provides a mock function with given fields: cancel
+func (_m *UtilsCmdInterface) HandleExit(cancel context.CancelFunc) {
+	_m.Called(cancel)
+}
+
+// HandleObserverBlock provides a mock function with given fields: client, address, blockNumber, config
+func (_m *UtilsCmdInterface) HandleObserverBlock(client *ethclient.Client, address string, blockNumber *big.Int, config types.Configurations) {
+	_m.Called(client, address, blockNumber, config)
 }
 
 // HandleRevealState provides a mock function with given fields: client, staker, epoch
@@ -1272,6 +2343,41 @@ func (_m *UtilsCmdInterface) MakeBlock(client *ethclient.Client, blockNumber *bi
 	return r0, r1, r2, r3
 }
 
+// MigrateDelegation provides a mock function with given fields: client, config, account, fileName, migrationData
+func (_m *UtilsCmdInterface) MigrateDelegation(client *ethclient.Client, config types.Configurations, account types.Account, fileName string, migrationData types.DelegationMigrationData) error {
+	ret := _m.Called(client, config, account, fileName, migrationData)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account, string, types.DelegationMigrationData) error); ok {
+		r0 = rf(client, config, account, fileName, migrationData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MigrateDelegationStep provides a mock function with given fields: client, config, account, migrationData
+func (_m *UtilsCmdInterface) MigrateDelegationStep(client *ethclient.Client, config types.Configurations, account types.Account, migrationData types.DelegationMigrationData) (types.DelegationMigrationData, error) {
+	ret := _m.Called(client, config, account, migrationData)
+
+	var r0 types.DelegationMigrationData
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account, types.DelegationMigrationData) types.DelegationMigrationData); ok {
+		r0 = rf(client, config, account, migrationData)
+	} else {
+		r0 = ret.Get(0).(types.DelegationMigrationData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, types.Configurations, types.Account, types.DelegationMigrationData) error); ok {
+		r1 = rf(client, config, account, migrationData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ModifyCollectionStatus provides a mock function with given fields: client, config, modifyCollectionInput
 func (_m *UtilsCmdInterface) ModifyCollectionStatus(client *ethclient.Client, config types.Configurations, modifyCollectionInput types.ModifyCollectionInput) (common.Hash, error) {
 	ret := _m.Called(client, config, modifyCollectionInput)
@@ -1295,6 +2401,39 @@ func (_m *UtilsCmdInterface) ModifyCollectionStatus(client *ethclient.Client, co
 	return r0, r1
 }
 
+// Observe provides a mock function with given fields: ctx, config, client, address
+func (_m *UtilsCmdInterface) Observe(ctx context.Context, config types.Configurations, client *ethclient.Client, address string) error {
+	ret := _m.Called(ctx, config, client, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.Configurations, *ethclient.Client, string) error); ok {
+		r0 = rf(ctx, config, client, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PollRemoteConfig provides a mock function with given fields: ctx, remoteConfig
+func (_m *UtilsCmdInterface) PollRemoteConfig(ctx context.Context, remoteConfig types.RemoteConfig) {
+	_m.Called(ctx, remoteConfig)
+}
+
+// ProcessWithdrawQueue provides a mock function with given fields: client, config, account
+func (_m *UtilsCmdInterface) ProcessWithdrawQueue(client *ethclient.Client, config types.Configurations, account types.Account) error {
+	ret := _m.Called(client, config, account)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, types.Account) error); ok {
+		r0 = rf(client, config, account)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Propose provides a mock function with given fields: client, config, account, staker, epoch, blockNumber, rogueData
 func (_m *UtilsCmdInterface) Propose(client *ethclient.Client, config types.Configurations, account types.Account, staker bindings.StructsStaker, epoch uint32, blockNumber *big.Int, rogueData types.Rogue) (common.Hash, error) {
 	ret := _m.Called(client, config, account, staker, epoch, blockNumber, rogueData)
@@ -1318,6 +2457,60 @@ func (_m *UtilsCmdInterface) Propose(client *ethclient.Client, config types.Conf
 	return r0, r1
 }
 
+// ReadLogs provides a mock function with given fields: filePath, filter, follow, asJson, writer
+func (_m *UtilsCmdInterface) ReadLogs(filePath string, filter types.LogFilter, follow bool, asJson bool, writer io.Writer) error {
+	ret := _m.Called(filePath, filter, follow, asJson, writer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.LogFilter, bool, bool, io.Writer) error); ok {
+		r0 = rf(filePath, filter, follow, asJson, writer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordJournalAction provides a mock function with given fields: address, epoch, action
+func (_m *UtilsCmdInterface) RecordJournalAction(address string, epoch uint32, action types.JournalAction) {
+	_m.Called(address, epoch, action)
+}
+
+// ReloadConfig provides a mock function with given fields: config
+func (_m *UtilsCmdInterface) ReloadConfig(config types.Configurations) (types.Configurations, error) {
+	ret := _m.Called(config)
+
+	var r0 types.Configurations
+	if rf, ok := ret.Get(0).(func(types.Configurations) types.Configurations); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Get(0).(types.Configurations)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.Configurations) error); ok {
+		r1 = rf(config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveAccountAlias provides a mock function with given fields: alias
+func (_m *UtilsCmdInterface) RemoveAccountAlias(alias string) error {
+	ret := _m.Called(alias)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetDispute provides a mock function with given fields: client, blockManager, txnOpts, epoch
 func (_m *UtilsCmdInterface) ResetDispute(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32) {
 	_m.Called(client, blockManager, txnOpts, epoch)
@@ -1346,6 +2539,29 @@ func (_m *UtilsCmdInterface) ResetUnstakeLock(client *ethclient.Client, config t
 	return r0, r1
 }
 
+// ResolveAccounts provides a mock function with given fields: accounts
+func (_m *UtilsCmdInterface) ResolveAccounts(accounts []string) ([]string, error) {
+	ret := _m.Called(accounts)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(accounts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(accounts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Reveal provides a mock function with given fields: client, config, account, epoch, commitData, signature
 func (_m *UtilsCmdInterface) Reveal(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, commitData types.CommitData, signature []byte) (common.Hash, error) {
 	ret := _m.Called(client, config, account, epoch, commitData, signature)
@@ -1369,6 +2585,90 @@ func (_m *UtilsCmdInterface) Reveal(client *ethclient.Client, config types.Confi
 	return r0, r1
 }
 
+// RunBenchmarks provides a mock function with given fields: sizes, names, parallelism
+func (_m *UtilsCmdInterface) RunBenchmarks(sizes types.BenchSizes, names []string, parallelism int32) (types.BenchReport, error) {
+	ret := _m.Called(sizes, names, parallelism)
+
+	var r0 types.BenchReport
+	if rf, ok := ret.Get(0).(func(types.BenchSizes, []string, int32) types.BenchReport); ok {
+		r0 = rf(sizes, names, parallelism)
+	} else {
+		r0 = ret.Get(0).(types.BenchReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(types.BenchSizes, []string, int32) error); ok {
+		r1 = rf(sizes, names, parallelism)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunDeployments provides a mock function with given fields: ctx, deployments
+func (_m *UtilsCmdInterface) RunDeployments(ctx context.Context, deployments []types.Deployment) error {
+	ret := _m.Called(ctx, deployments)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.Deployment) error); ok {
+		r0 = rf(ctx, deployments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunRepl provides a mock function with given fields: input
+func (_m *UtilsCmdInterface) RunRepl(input io.Reader) (int, error) {
+	ret := _m.Called(input)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(io.Reader) int); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.Reader) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScheduleCommissionUpdate provides a mock function with given fields: client, address, stakerId, commission
+func (_m *UtilsCmdInterface) ScheduleCommissionUpdate(client *ethclient.Client, address string, stakerId uint32, commission uint8) error {
+	ret := _m.Called(client, address, stakerId, commission)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32, uint8) error); ok {
+		r0 = rf(client, address, stakerId, commission)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetAccountAlias provides a mock function with given fields: alias, address
+func (_m *UtilsCmdInterface) SetAccountAlias(alias string, address string) error {
+	ret := _m.Called(alias, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(alias, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetConfig provides a mock function with given fields: flagSet
 func (_m *UtilsCmdInterface) SetConfig(flagSet *pflag.FlagSet) error {
 	ret := _m.Called(flagSet)
@@ -1443,6 +2743,20 @@ func (_m *UtilsCmdInterface) StoreBountyId(client *ethclient.Client, account typ
 	return r0
 }
 
+// SyncStakeChanges provides a mock function with given fields: client, address, stakerId, fromBlock, toBlock
+func (_m *UtilsCmdInterface) SyncStakeChanges(client *ethclient.Client, address string, stakerId uint32, fromBlock uint64, toBlock uint64) error {
+	ret := _m.Called(client, address, stakerId, fromBlock, toBlock)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, uint32, uint64, uint64) error); ok {
+		r0 = rf(client, address, stakerId, fromBlock, toBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Transfer provides a mock function with given fields: client, config, transferInput
 func (_m *UtilsCmdInterface) Transfer(client *ethclient.Client, config types.Configurations, transferInput types.TransferInput) (common.Hash, error) {
 	ret := _m.Called(client, config, transferInput)
@@ -1572,6 +2886,43 @@ func (_m *UtilsCmdInterface) UpdateJob(client *ethclient.Client, config types.Co
 	return r0, r1
 }
 
+// ValidateConfig provides a mock function with given fields: configFilePath
+func (_m *UtilsCmdInterface) ValidateConfig(configFilePath string) error {
+	ret := _m.Called(configFilePath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(configFilePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VerifyRevealedValues provides a mock function with given fields: client, blockNumber, epoch
+func (_m *UtilsCmdInterface) VerifyRevealedValues(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.RevealInconsistency, error) {
+	ret := _m.Called(client, blockNumber, epoch)
+
+	var r0 []types.RevealInconsistency
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, *big.Int, uint32) []types.RevealInconsistency); ok {
+		r0 = rf(client, blockNumber, epoch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.RevealInconsistency)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, *big.Int, uint32) error); ok {
+		r1 = rf(client, blockNumber, epoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Vote provides a mock function with given fields: ctx, config, client, rogueData, account
 func (_m *UtilsCmdInterface) Vote(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, account types.Account) error {
 	ret := _m.Called(ctx, config, client, rogueData, account)
@@ -1586,6 +2937,20 @@ func (_m *UtilsCmdInterface) Vote(ctx context.Context, config types.Configuratio
 	return r0
 }
 
+// VoteAccounts provides a mock function with given fields: ctx, config, client, rogueData, accounts
+func (_m *UtilsCmdInterface) VoteAccounts(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, accounts []types.Account) error {
+	ret := _m.Called(ctx, config, client, rogueData, accounts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.Configurations, *ethclient.Client, types.Rogue, []types.Account) error); ok {
+		r0 = rf(ctx, config, client, rogueData, accounts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitForAppropriateState provides a mock function with given fields: client, action, states
 func (_m *UtilsCmdInterface) WaitForAppropriateState(client *ethclient.Client, action string, states ...int) (uint32, error) {
 	_va := make([]interface{}, len(states))
@@ -1614,6 +2979,27 @@ func (_m *UtilsCmdInterface) WaitForAppropriateState(client *ethclient.Client, a
 	return r0, r1
 }
 
+// WaitForTransactionOfState provides a mock function with given fields: client, config, state, hashToRead
+func (_m *UtilsCmdInterface) WaitForTransactionOfState(client *ethclient.Client, config types.Configurations, state string, hashToRead string) (string, error) {
+	ret := _m.Called(client, config, state, hashToRead)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, types.Configurations, string, string) string); ok {
+		r0 = rf(client, config, state, hashToRead)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, types.Configurations, string, string) error); ok {
+		r1 = rf(client, config, state, hashToRead)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WaitIfCommitState provides a mock function with given fields: client, action
 func (_m *UtilsCmdInterface) WaitIfCommitState(client *ethclient.Client, action string) (uint32, error) {
 	ret := _m.Called(client, action)
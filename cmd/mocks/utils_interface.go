@@ -17,6 +17,8 @@ import (
 	pflag "github.com/spf13/pflag"
 
 	types "razor/core/types"
+
+	time "time"
 )
 
 // UtilsInterface is an autogenerated mock type for the UtilsInterface type
@@ -57,6 +59,20 @@ func (_m *UtilsInterface) AssignLogFile(flagSet *pflag.FlagSet) {
 	_m.Called(flagSet)
 }
 
+// AssignNewPassword provides a mock function with given fields:
+func (_m *UtilsInterface) AssignNewPassword() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AssignPassword provides a mock function with given fields:
 func (_m *UtilsInterface) AssignPassword() string {
 	ret := _m.Called()
@@ -251,6 +267,20 @@ func (_m *UtilsInterface) DeleteJobFromJSON(s string, jobId string) error {
 	return r0
 }
 
+// DeleteKeychainPassword provides a mock function with given fields: address
+func (_m *UtilsInterface) DeleteKeychainPassword(address string) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FetchBalance provides a mock function with given fields: client, accountAddress
 func (_m *UtilsInterface) FetchBalance(client *ethclient.Client, accountAddress string) (*big.Int, error) {
 	ret := _m.Called(client, accountAddress)
@@ -368,6 +398,27 @@ func (_m *UtilsInterface) GetBlockManager(client *ethclient.Client) *bindings.Bl
 	return r0
 }
 
+// GetCanaryFileName provides a mock function with given fields: address
+func (_m *UtilsInterface) GetCanaryFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCollections provides a mock function with given fields: client
 func (_m *UtilsInterface) GetCollections(client *ethclient.Client) ([]bindings.StructsCollection, error) {
 	ret := _m.Called(client)
@@ -670,6 +721,27 @@ func (_m *UtilsInterface) GetJobs(client *ethclient.Client) ([]bindings.StructsJ
 	return r0, r1
 }
 
+// GetKeychainPassword provides a mock function with given fields: address
+func (_m *UtilsInterface) GetKeychainPassword(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLock provides a mock function with given fields: client, address, stakerId, lockType
 func (_m *UtilsInterface) GetLock(client *ethclient.Client, address string, stakerId uint32, lockType uint8) (types.Locks, error) {
 	ret := _m.Called(client, address, stakerId, lockType)
@@ -852,6 +924,27 @@ func (_m *UtilsInterface) GetProposedBlock(client *ethclient.Client, epoch uint3
 	return r0, r1
 }
 
+// GetRPCDebugFileName provides a mock function with given fields: address
+func (_m *UtilsInterface) GetRPCDebugFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRogueRandomMedianValue provides a mock function with given fields:
 func (_m *UtilsInterface) GetRogueRandomMedianValue() uint32 {
 	ret := _m.Called()
@@ -1192,6 +1285,20 @@ func (_m *UtilsInterface) IsFlagPassed(name string) bool {
 	return r0
 }
 
+// LockDataDir provides a mock function with given fields: address
+func (_m *UtilsInterface) LockDataDir(address string) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PasswordPrompt provides a mock function with given fields:
 func (_m *UtilsInterface) PasswordPrompt() string {
 	ret := _m.Called()
@@ -1283,6 +1390,27 @@ func (_m *UtilsInterface) ReadFromProposeJsonFile(filePath string) (types.Propos
 	return r0, r1
 }
 
+// ReadPassword provides a mock function with given fields: address
+func (_m *UtilsInterface) ReadPassword(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveDataToCommitJsonFile provides a mock function with given fields: flePath, epoch, commitFileData
 func (_m *UtilsInterface) SaveDataToCommitJsonFile(flePath string, epoch uint32, commitFileData types.CommitData) error {
 	ret := _m.Called(flePath, epoch, commitFileData)
@@ -1339,6 +1467,20 @@ func (_m *UtilsInterface) SecondsToReadableTime(time int) string {
 	return r0
 }
 
+// StoreKeychainPassword provides a mock function with given fields: address, password
+func (_m *UtilsInterface) StoreKeychainPassword(address string, password string) error {
+	ret := _m.Called(address, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(address, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitForBlockCompletion provides a mock function with given fields: client, hashToRead
 func (_m *UtilsInterface) WaitForBlockCompletion(client *ethclient.Client, hashToRead string) error {
 	ret := _m.Called(client, hashToRead)
@@ -1353,6 +1495,20 @@ func (_m *UtilsInterface) WaitForBlockCompletion(client *ethclient.Client, hashT
 	return r0
 }
 
+// WaitForBlockCompletionWithTimeout provides a mock function with given fields: client, hashToRead, timeout
+func (_m *UtilsInterface) WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error {
+	ret := _m.Called(client, hashToRead, timeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, time.Duration) error); ok {
+		r0 = rf(client, hashToRead, timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitTillNextNSecs provides a mock function with given fields: seconds
 func (_m *UtilsInterface) WaitTillNextNSecs(seconds int32) {
 	_m.Called(seconds)
@@ -0,0 +1,209 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"razor/core/types"
+	"razor/logger"
+	"razor/metrics"
+	"razor/utils"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+var (
+	observedBlocksEpoch       uint32
+	observedConfirmationEpoch uint32
+)
+
+//This function runs the vote command in observer mode, in which no password is read and no transaction is signed or sent
+//The node follows the epochs, verifies the proposed blocks and alerts on the anomalies, so that a staker can be cross-checked from a machine without its keys
+func (*UtilsStruct) ExecuteObserver(flagSet *pflag.FlagSet, config types.Configurations, client *ethclient.Client, address string) {
+	logger.SetLoggerParameters(client, address)
+	razorUtils.AssignLogFile(flagSet)
+	log.Infof("Running in observer mode, the network and the staker of %s are watched without signing any transaction", address)
+
+	notificationTemplatesFile, err := flagSetUtils.GetStringNotificationTemplates(flagSet)
+	utils.CheckError("Error in getting notification templates file: ", err)
+	if notificationTemplatesFile != "" {
+		err = utils.LoadNotificationTemplates(notificationTemplatesFile)
+		utils.CheckError("Error in loading notification templates: ", err)
+	}
+
+	alertWebhooks, err := flagSetUtils.GetStringSliceAlertWebhooks(flagSet)
+	utils.CheckError("Error in getting alert webhooks: ", err)
+	if len(alertWebhooks) == 0 {
+		alertWebhooks = getConfigAlertWebhooks()
+		alertWebhooksFromConfig = true
+	}
+	err = utils.SetAlertWebhooks(alertWebhooks)
+	utils.CheckError("Error in setting alert webhooks: ", err)
+	if !utils.IsAlertingEnabled() {
+		log.Warn("No alert webhook is set, the anomalies are only logged")
+	}
+
+	alertRpcDownMinutes, err := flagSetUtils.GetUint32AlertRpcDownMinutes(flagSet)
+	utils.CheckError("Error in getting alert rpc down minutes: ", err)
+	config.AlertRpcDownMinutes = alertRpcDownMinutes
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if configFilePath := viper.ConfigFileUsed(); configFilePath != "" {
+		err = watchConfigFile(ctx, configFilePath)
+		if err != nil {
+			log.Warn("Error in watching config file, the changes of the config file are applied at the next restart: ", err)
+		}
+	}
+
+	healthPort, err := flagSetUtils.GetStringHealthPort(flagSet)
+	utils.CheckError("Error in getting health port: ", err)
+	if healthPort != "" {
+		go func() {
+			if err := metrics.RunHealthServer(healthPort); err != nil {
+				log.Error("Error in serving health: ", err)
+			}
+		}()
+	}
+
+	metricsPort, err := flagSetUtils.GetStringMetricsPort(flagSet)
+	utils.CheckError("Error in getting metrics port: ", err)
+	if metricsPort != "" {
+		go func() {
+			if err := metrics.Run(metricsPort, "", ""); err != nil {
+				log.Error("Error in serving metrics: ", err)
+			}
+		}()
+	}
+
+	if utils.IsWebSocketProvider(config.Provider) {
+		go utils.SubscribeNewHeads(ctx, client)
+	}
+
+	cmdUtils.HandleExit(cancel)
+
+	err = cmdUtils.Observe(ctx, config, client, address)
+	if err != nil {
+		log.Errorf("%s\n", err)
+		osUtils.Exit(1)
+	}
+}
+
+//This function calls HandleObserverBlock on every new block until the observer is stopped
+func (*UtilsStruct) Observe(ctx context.Context, config types.Configurations, client *ethclient.Client, address string) error {
+	return voteLoop(ctx, config, client, func(config types.Configurations, blockNumber *big.Int) {
+		cmdUtils.HandleObserverBlock(client, address, blockNumber, config)
+	})
+}
+
+//This function handles the block in observer mode
+//The proposed blocks are verified in the dispute state, the confirmation of the block of the previous epoch is checked once its commit state is over and the reveals and the blocks of the staker are checked as while voting
+func (*UtilsStruct) HandleObserverBlock(client *ethclient.Client, address string, blockNumber *big.Int, config types.Configurations) {
+	state, err := razorUtils.GetDelayedState(client, config.BufferPercent)
+	if err != nil {
+		log.Error("Error in getting state: ", err)
+		return
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		log.Error("Error in getting epoch: ", err)
+		return
+	}
+	log.Infof("State: %s Epoch: %d Running in observer mode", utils.UtilsInterface.GetStateName(state), epoch)
+	metrics.UpdateNodeStatus(func(status *types.NodeStatus) {
+		status.Epoch = epoch
+		status.State = state
+		status.StateName = utils.UtilsInterface.GetStateName(state)
+	})
+
+	if state >= revealState && epoch > 1 {
+		checkMissingConfirmation(client, epoch-1)
+	}
+	if state == disputeState {
+		checkProposedBlocksOfEpoch(client, epoch, blockNumber)
+	}
+
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		log.Error("Error in getting staker id: ", err)
+	} else if stakerId != 0 {
+		if state >= proposeState {
+			checkMissedReveal(client, address, epoch, stakerId)
+		}
+		if state == confirmState {
+			checkDisputedBlocks(client, address, epoch, stakerId)
+		}
+	}
+	razorUtils.WaitTillNextNSecs(config.WaitTime)
+}
+
+//This function alerts for every block proposed in the epoch which the node would dispute, it is checked once as all the blocks of the epoch are proposed by the dispute state
+//The blocks which are already disputed aren't alerted as the network has handled them
+func checkProposedBlocksOfEpoch(client *ethclient.Client, epoch uint32, blockNumber *big.Int) {
+	if observedBlocksEpoch >= epoch {
+		return
+	}
+	epochBlockCheck, err := cmdUtils.CheckProposedBlocks(client, blockNumber, epoch)
+	if err != nil {
+		log.Error("Error in checking proposed blocks: ", err)
+		return
+	}
+	observedBlocksEpoch = epoch
+	for _, blockCheck := range epochBlockCheck.Blocks {
+		if blockCheck.Error != "" {
+			log.Errorf("Error in checking block %d of epoch %d: %s", blockCheck.BlockId, epoch, blockCheck.Error)
+			continue
+		}
+		if blockCheck.Dispute == "none" || blockCheck.Dispute == "alreadyDisputed" {
+			continue
+		}
+		metrics.ObserverAnomaliesMetric.WithLabelValues(blockCheck.Dispute).Inc()
+		utils.Notify(types.Notification{
+			Event:  "invalidBlock",
+			Epoch:  epoch,
+			Status: fmt.Sprintf("block %d proposed by staker %d should be disputed for %s", blockCheck.BlockId, blockCheck.ProposerId, blockCheck.Dispute),
+		})
+	}
+}
+
+//This function alerts if no block of the epoch is confirmed although a valid block was proposed in it
+//The block is confirmed by the claim of the block reward in the confirm state or by the first commit of the next epoch, so it is checked once the commit state of the next epoch is over
+func checkMissingConfirmation(client *ethclient.Client, epoch uint32) {
+	if observedConfirmationEpoch >= epoch {
+		return
+	}
+	observedConfirmationEpoch = epoch
+	block, err := utils.UtilsInterface.GetBlock(client, epoch)
+	if err != nil {
+		log.Errorf("Error in getting confirmed block of epoch %d: %s", epoch, err)
+		return
+	}
+	if block.ProposerId != 0 {
+		return
+	}
+	sortedProposedBlockIds, err := razorUtils.GetSortedProposedBlockIds(client, epoch)
+	if err != nil {
+		log.Error("Error in getting sorted proposed block ids: ", err)
+		return
+	}
+	for _, blockId := range sortedProposedBlockIds {
+		proposedBlock, err := razorUtils.GetProposedBlock(client, epoch, blockId)
+		if err != nil {
+			log.Error("Error in getting proposed block: ", err)
+			return
+		}
+		if proposedBlock.Valid {
+			metrics.ObserverAnomaliesMetric.WithLabelValues("missingConfirmation").Inc()
+			utils.Notify(types.Notification{
+				Event:  "missingConfirmation",
+				Epoch:  epoch,
+				Status: fmt.Sprintf("block %d proposed by staker %d is valid but no block of the epoch is confirmed", blockId, proposedBlock.ProposerId),
+			})
+			return
+		}
+	}
+}
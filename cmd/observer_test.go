@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleObserverBlock(t *testing.T) {
+	var client *ethclient.Client
+	var config types.Configurations
+	address := "0x000000000000000000000000000000000000dea1"
+	blockNumber := big.NewInt(100)
+	defer func() {
+		observedBlocksEpoch = 0
+		observedConfirmationEpoch = 0
+	}()
+
+	type args struct {
+		state    int64
+		stateErr error
+		epoch    uint32
+		epochErr error
+	}
+	tests := []struct {
+		name            string
+		args            args
+		wantBlocksCheck bool
+	}{
+		{
+			name: "Test 1: When the proposed blocks are checked in the dispute state",
+			args: args{
+				state: disputeState,
+				epoch: 5,
+			},
+			wantBlocksCheck: true,
+		},
+		{
+			name: "Test 2: When the state is not the dispute state",
+			args: args{
+				state: revealState,
+				epoch: 5,
+			},
+			wantBlocksCheck: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting state",
+			args: args{
+				stateErr: errors.New("state error"),
+			},
+			wantBlocksCheck: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting epoch",
+			args: args{
+				state:    disputeState,
+				epochErr: errors.New("epoch error"),
+			},
+			wantBlocksCheck: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			// The confirmation of the previous epoch is already checked
+			observedBlocksEpoch = 0
+			observedConfirmationEpoch = tt.args.epoch - 1
+
+			utilsMock.On("GetDelayedState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(tt.args.state, tt.args.stateErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(uint32(0), nil)
+			utilsMock.On("WaitTillNextNSecs", mock.AnythingOfType("int32")).Return()
+			utilsPkgMock.On("GetStateName", mock.AnythingOfType("int64")).Return("")
+			cmdUtilsMock.On("CheckProposedBlocks", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(types.EpochBlockCheck{Epoch: tt.args.epoch}, nil)
+
+			ut := &UtilsStruct{}
+			ut.HandleObserverBlock(client, address, blockNumber, config)
+
+			if tt.wantBlocksCheck {
+				cmdUtilsMock.AssertCalled(t, "CheckProposedBlocks", client, blockNumber, tt.args.epoch)
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "CheckProposedBlocks", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCheckProposedBlocksOfEpoch(t *testing.T) {
+	var client *ethclient.Client
+	blockNumber := big.NewInt(100)
+	defer func() { observedBlocksEpoch = 0 }()
+
+	blockCheck := types.EpochBlockCheck{
+		Epoch: 5,
+		Blocks: []types.BlockCheck{
+			{BlockId: 1, ProposerId: 2, Dispute: "none"},
+			{BlockId: 2, ProposerId: 3, Dispute: "median"},
+			{BlockId: 3, ProposerId: 4, Error: "block error"},
+		},
+	}
+
+	tests := []struct {
+		name                    string
+		observedBlocksEpoch     uint32
+		blockCheckErr           error
+		wantCheck               bool
+		wantObservedBlocksEpoch uint32
+	}{
+		{
+			name:                    "Test 1: When the proposed blocks of the epoch are checked",
+			wantCheck:               true,
+			wantObservedBlocksEpoch: 5,
+		},
+		{
+			name:                    "Test 2: When the proposed blocks of the epoch are already checked",
+			observedBlocksEpoch:     5,
+			wantCheck:               false,
+			wantObservedBlocksEpoch: 5,
+		},
+		{
+			name:                    "Test 3: When there is an error in checking the proposed blocks",
+			blockCheckErr:           errors.New("block check error"),
+			wantCheck:               true,
+			wantObservedBlocksEpoch: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtils = cmdUtilsMock
+			observedBlocksEpoch = tt.observedBlocksEpoch
+
+			cmdUtilsMock.On("CheckProposedBlocks", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("uint32")).Return(blockCheck, tt.blockCheckErr)
+
+			checkProposedBlocksOfEpoch(client, 5, blockNumber)
+
+			if tt.wantCheck {
+				cmdUtilsMock.AssertCalled(t, "CheckProposedBlocks", client, blockNumber, uint32(5))
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "CheckProposedBlocks", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if observedBlocksEpoch != tt.wantObservedBlocksEpoch {
+				t.Errorf("observedBlocksEpoch = %d, want %d", observedBlocksEpoch, tt.wantObservedBlocksEpoch)
+			}
+		})
+	}
+}
+
+func TestCheckMissingConfirmation(t *testing.T) {
+	var client *ethclient.Client
+	defer func() { observedConfirmationEpoch = 0 }()
+
+	type args struct {
+		observedConfirmationEpoch uint32
+		block                     bindings.StructsBlock
+		blockErr                  error
+		sortedProposedBlockIds    []uint32
+		proposedBlocks            map[uint32]bindings.StructsBlock
+	}
+	tests := []struct {
+		name                   string
+		args                   args
+		wantProposedBlockCalls int
+	}{
+		{
+			name: "Test 1: When a block of the epoch is confirmed",
+			args: args{
+				block:                  bindings.StructsBlock{ProposerId: 2, Valid: true},
+				sortedProposedBlockIds: []uint32{1, 2},
+			},
+			wantProposedBlockCalls: 0,
+		},
+		{
+			name: "Test 2: When no block is confirmed although a valid block was proposed",
+			args: args{
+				sortedProposedBlockIds: []uint32{1, 2, 3},
+				proposedBlocks: map[uint32]bindings.StructsBlock{
+					1: {ProposerId: 2, Valid: false},
+					2: {ProposerId: 3, Valid: true},
+					3: {ProposerId: 4, Valid: true},
+				},
+			},
+			wantProposedBlockCalls: 2,
+		},
+		{
+			name: "Test 3: When all the proposed blocks are invalidated by disputes",
+			args: args{
+				sortedProposedBlockIds: []uint32{1, 2},
+				proposedBlocks: map[uint32]bindings.StructsBlock{
+					1: {ProposerId: 2, Valid: false},
+					2: {ProposerId: 3, Valid: false},
+				},
+			},
+			wantProposedBlockCalls: 2,
+		},
+		{
+			name: "Test 4: When the confirmation of the epoch is already checked",
+			args: args{
+				observedConfirmationEpoch: 5,
+				sortedProposedBlockIds:    []uint32{1},
+				proposedBlocks:            map[uint32]bindings.StructsBlock{1: {ProposerId: 2, Valid: true}},
+			},
+			wantProposedBlockCalls: 0,
+		},
+		{
+			name: "Test 5: When there is an error in getting the confirmed block",
+			args: args{
+				blockErr:               errors.New("block error"),
+				sortedProposedBlockIds: []uint32{1},
+			},
+			wantProposedBlockCalls: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			observedConfirmationEpoch = tt.args.observedConfirmationEpoch
+
+			utilsPkgMock.On("GetBlock", mock.AnythingOfType("*ethclient.Client"), uint32(5)).Return(tt.args.block, tt.args.blockErr)
+			utilsMock.On("GetSortedProposedBlockIds", mock.AnythingOfType("*ethclient.Client"), uint32(5)).Return(tt.args.sortedProposedBlockIds, nil)
+			for blockId, proposedBlock := range tt.args.proposedBlocks {
+				utilsMock.On("GetProposedBlock", mock.AnythingOfType("*ethclient.Client"), uint32(5), blockId).Return(proposedBlock, nil)
+			}
+
+			checkMissingConfirmation(client, 5)
+
+			utilsMock.AssertNumberOfCalls(t, "GetProposedBlock", tt.wantProposedBlockCalls)
+			if observedConfirmationEpoch != 5 {
+				t.Errorf("observedConfirmationEpoch = %d, want 5", observedConfirmationEpoch)
+			}
+		})
+	}
+}
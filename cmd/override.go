@@ -0,0 +1,136 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var overrideCmd = &cobra.Command{
+	Use:   "override",
+	Short: "manage the local override file of the jobs",
+	Long: `Manages the assets.json file in which the jobs of the collections can be overridden with your own endpoints and custom jobs can be added.
+
+Example:
+  ./razor override init`,
+}
+
+var overrideInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "generate the override file from the jobs and collections on chain",
+	Long: `Reads all the jobs and collections on chain and generates an assets.json file in which every job of every collection is filled with its URL, selector, power and weight.
+The generated file overrides the jobs with their on-chain values, so it can be edited to replace only the endpoints you want to change.
+
+Example:
+  ./razor override init
+  ./razor override init --output assets.template.json
+  ./razor override init --force`,
+	Run: initialiseOverrideInit,
+}
+
+//This function initialises the ExecuteOverrideInit function
+func initialiseOverrideInit(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteOverrideInit(cmd.Flags())
+}
+
+//This function sets the flags appropriately, executes the GenerateOverrideFile function and writes the override file
+func (*UtilsStruct) ExecuteOverrideInit(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	output, err := flagSetUtils.GetStringOutput(flagSet)
+	utils.CheckError("Error in getting output: ", err)
+
+	force, err := flagSetUtils.GetBoolForce(flagSet)
+	utils.CheckError("Error in getting force: ", err)
+
+	if output == "" {
+		output, err = path.PathUtilsInterface.GetJobFilePath()
+		utils.CheckError("Error in getting assets.json path: ", err)
+	}
+	if _, err := os.Stat(output); err == nil && !force {
+		log.Fatalf("%s already exists, pass --force to overwrite it", output)
+	}
+
+	overrideFile, err := cmdUtils.GenerateOverrideFile(client)
+	utils.CheckError("Error in generating override file: ", err)
+
+	overrideData, err := json.MarshalIndent(overrideFile, "", "  ")
+	utils.CheckError("Error in marshalling override file: ", err)
+
+	err = os.WriteFile(output, overrideData, 0600)
+	utils.CheckError("Error in writing override file: ", err)
+	log.Infof("Override file of %d collections written to %s", len(overrideFile.Assets.Collection), output)
+}
+
+//This function returns the override file in which every job of every collection is filled with its on-chain values
+func (*UtilsStruct) GenerateOverrideFile(client *ethclient.Client) (types.OverrideFile, error) {
+	jobs, err := razorUtils.GetJobs(client)
+	if err != nil {
+		return types.OverrideFile{}, err
+	}
+	collections, err := razorUtils.GetCollections(client)
+	if err != nil {
+		return types.OverrideFile{}, err
+	}
+
+	jobsById := make(map[uint16]types.CustomJob)
+	for _, job := range jobs {
+		jobsById[job.Id] = types.CustomJob{
+			URL:      job.Url,
+			Selector: job.Selector,
+			Power:    job.Power,
+			Weight:   job.Weight,
+		}
+	}
+
+	overrideFile := types.OverrideFile{
+		Assets: types.OverrideAssets{Collection: make(map[string]types.OverrideCollection)},
+	}
+	for _, collection := range collections {
+		if _, ok := overrideFile.Assets.Collection[collection.Name]; ok {
+			return types.OverrideFile{}, errors.New("duplicate collection name " + collection.Name)
+		}
+		officialJobs := make(map[string]types.CustomJob)
+		for _, jobId := range collection.JobIDs {
+			job, ok := jobsById[jobId]
+			if !ok {
+				log.Warnf("Job %d of collection %s is not found", jobId, collection.Name)
+				continue
+			}
+			officialJobs[strconv.Itoa(int(jobId))] = job
+		}
+		overrideFile.Assets.Collection[collection.Name] = types.OverrideCollection{
+			Power:        collection.Power,
+			OfficialJobs: officialJobs,
+			CustomJobs:   []types.CustomJob{},
+		}
+	}
+	return overrideFile, nil
+}
+
+func init() {
+	rootCmd.AddCommand(overrideCmd)
+	overrideCmd.AddCommand(overrideInitCmd)
+
+	var (
+		Output string
+		Force  bool
+	)
+
+	overrideInitCmd.Flags().StringVarP(&Output, "output", "", "", "file to write the override file to, assets.json in the razor directory by default")
+	overrideInitCmd.Flags().BoolVarP(&Force, "force", "", false, "overwrite the file if it already exists")
+}
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/pkg/bindings"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGenerateOverrideFile(t *testing.T) {
+	var client *ethclient.Client
+
+	jobs := []bindings.StructsJob{
+		{Id: 1, Url: "https://api.example.com/eth", Selector: "data.price", Power: 2, Weight: 100},
+		{Id: 2, Url: "https://api.example.org/eth", Selector: "price", Power: 3, Weight: 50},
+	}
+	collections := []bindings.StructsCollection{
+		{Id: 1, Name: "ethCollectionMean", Power: 2, JobIDs: []uint16{1, 2}},
+		{Id: 2, Name: "btcCollectionMean", Power: 3, JobIDs: []uint16{3}},
+	}
+
+	type args struct {
+		jobs           []bindings.StructsJob
+		jobsErr        error
+		collections    []bindings.StructsCollection
+		collectionsErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.OverrideFile
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GenerateOverrideFile executes successfully",
+			args: args{
+				jobs:        jobs,
+				collections: collections,
+			},
+			want: types.OverrideFile{
+				Assets: types.OverrideAssets{
+					Collection: map[string]types.OverrideCollection{
+						"ethCollectionMean": {
+							Power: 2,
+							OfficialJobs: map[string]types.CustomJob{
+								"1": {URL: "https://api.example.com/eth", Selector: "data.price", Power: 2, Weight: 100},
+								"2": {URL: "https://api.example.org/eth", Selector: "price", Power: 3, Weight: 50},
+							},
+							CustomJobs: []types.CustomJob{},
+						},
+						"btcCollectionMean": {
+							Power:        3,
+							OfficialJobs: map[string]types.CustomJob{},
+							CustomJobs:   []types.CustomJob{},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting jobs",
+			args: args{
+				jobsErr: errors.New("jobs error"),
+			},
+			want:    types.OverrideFile{},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting collections",
+			args: args{
+				jobs:           jobs,
+				collectionsErr: errors.New("collections error"),
+			},
+			want:    types.OverrideFile{},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When collections have the same name",
+			args: args{
+				jobs: jobs,
+				collections: []bindings.StructsCollection{
+					{Id: 1, Name: "ethCollectionMean", JobIDs: []uint16{1}},
+					{Id: 2, Name: "ethCollectionMean", JobIDs: []uint16{2}},
+				},
+			},
+			want:    types.OverrideFile{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			razorUtils = utilsMock
+
+			utilsMock.On("GetJobs", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.jobs, tt.args.jobsErr)
+			utilsMock.On("GetCollections", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.collections, tt.args.collectionsErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GenerateOverrideFile(client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GenerateOverrideFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GenerateOverrideFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteOverrideInit(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	var client *ethclient.Client
+	var config types.Configurations
+
+	overrideFile := types.OverrideFile{
+		Assets: types.OverrideAssets{
+			Collection: map[string]types.OverrideCollection{
+				"ethCollectionMean": {
+					Power: 2,
+					OfficialJobs: map[string]types.CustomJob{
+						"1": {URL: "https://api.example.com/eth", Selector: "data.price", Power: 2, Weight: 100},
+					},
+					CustomJobs: []types.CustomJob{},
+				},
+			},
+		},
+	}
+
+	type args struct {
+		configErr       error
+		output          string
+		outputErr       error
+		force           bool
+		forceErr        error
+		existingFile    bool
+		jobFilePathErr  error
+		overrideFileErr error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+		wantWritten   bool
+	}{
+		{
+			name: "Test 1: When ExecuteOverrideInit writes the override file to the output",
+			args: args{
+				output: "assets.template.json",
+			},
+			expectedFatal: false,
+			wantWritten:   true,
+		},
+		{
+			name:          "Test 2: When ExecuteOverrideInit writes the override file to the default path",
+			args:          args{},
+			expectedFatal: false,
+			wantWritten:   true,
+		},
+		{
+			name: "Test 3: When the file exists and force is not passed",
+			args: args{
+				existingFile: true,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When the file exists and force is passed",
+			args: args{
+				existingFile: true,
+				force:        true,
+			},
+			expectedFatal: false,
+			wantWritten:   true,
+		},
+		{
+			name: "Test 5: When there is an error in getting config",
+			args: args{
+				configErr: errors.New("config error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting output",
+			args: args{
+				outputErr: errors.New("output error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting force",
+			args: args{
+				forceErr: errors.New("force error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting assets.json path",
+			args: args{
+				jobFilePathErr: errors.New("path error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 9: When there is an error in generating override file",
+			args: args{
+				overrideFileErr: errors.New("override file error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			defaultPath := filepath.Join(dir, "assets.json")
+			output := ""
+			if tt.args.output != "" {
+				output = filepath.Join(dir, tt.args.output)
+			}
+			writtenPath := defaultPath
+			if output != "" {
+				writtenPath = output
+			}
+			if tt.args.existingFile {
+				if err := os.WriteFile(writtenPath, []byte("{}"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			flagSetUtils = flagSetUtilsMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			cmdUtilsMock.On("GetConfigData").Return(config, tt.args.configErr)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetStringOutput", flagSet).Return(output, tt.args.outputErr)
+			flagSetUtilsMock.On("GetBoolForce", flagSet).Return(tt.args.force, tt.args.forceErr)
+			pathUtilsMock.On("GetJobFilePath").Return(defaultPath, tt.args.jobFilePathErr)
+			cmdUtilsMock.On("GenerateOverrideFile", mock.AnythingOfType("*ethclient.Client")).Return(overrideFile, tt.args.overrideFileErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteOverrideInit(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteOverrideInit function didn't execute as expected")
+			}
+			if tt.wantWritten {
+				data, err := os.ReadFile(writtenPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				var got types.OverrideFile
+				if err := json.Unmarshal(data, &got); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, overrideFile) {
+					t.Errorf("Override file got = %v, want %v", got, overrideFile)
+				}
+			}
+		})
+	}
+}
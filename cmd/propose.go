@@ -14,10 +14,12 @@ import (
 	"razor/pkg/bindings"
 	"razor/utils"
 	"sort"
+	"sync"
 	"time"
 )
 
 var (
+	bigIntTwo              = big.NewInt(2)
 	_mediansData           []*big.Int
 	_revealedCollectionIds []uint16
 	_revealedDataMaps      *types.RevealedDataMaps
@@ -143,6 +145,9 @@ func (*UtilsStruct) Propose(client *ethclient.Client, config types.Configuration
 		log.Errorf("Error in saving data to file %s: %t", fileName, err)
 		return core.NilHash, nil
 	}
+	if err := utils.InjectStateFileCorruption(core.ProposeFaultPoint, fileName); err != nil {
+		log.Error("Error in injecting state file corruption: ", err)
+	}
 	log.Debug("Data saved!")
 
 	log.Debugf("Medians: %d", medians)
@@ -279,42 +284,88 @@ func (*UtilsStruct) GetSortedRevealedValues(client *ethclient.Client, blockNumbe
 	if err != nil {
 		return nil, err
 	}
+	return sortRevealedValues(assignedAsset), nil
+}
+
+//This function sorts the revealed values of every collection and calculates the vote weights and the influence sums
+//The values of the collections are sorted and deduplicated concurrently as they are independent of each other
+func sortRevealedValues(assignedAsset []types.RevealedStruct) *types.RevealedDataMaps {
 	revealedValuesWithIndex := make(map[uint16][]*big.Int)
 	voteWeights := make(map[string]*big.Int)
 	influenceSum := make(map[uint16]*big.Int)
 	for _, asset := range assignedAsset {
 		for _, assetValue := range asset.RevealedValues {
-			if revealedValuesWithIndex[assetValue.LeafId] == nil {
-				revealedValuesWithIndex[assetValue.LeafId] = []*big.Int{assetValue.Value}
-			} else {
-				if !utils.ContainsBigInteger(revealedValuesWithIndex[assetValue.LeafId], assetValue.Value) {
-					revealedValuesWithIndex[assetValue.LeafId] = append(revealedValuesWithIndex[assetValue.LeafId], assetValue.Value)
-				}
+			revealedValuesWithIndex[assetValue.LeafId] = append(revealedValuesWithIndex[assetValue.LeafId], assetValue.Value)
+
+			//Calculate vote weights, the weights and sums are only created here so they are accumulated in place
+			value := assetValue.Value.String()
+			voteWeight, ok := voteWeights[value]
+			if !ok {
+				voteWeight = big.NewInt(0)
+				voteWeights[value] = voteWeight
 			}
-			//Calculate vote weights
-			if voteWeights[assetValue.Value.String()] == nil {
-				voteWeights[assetValue.Value.String()] = big.NewInt(0)
-			}
-			voteWeights[assetValue.Value.String()] = big.NewInt(0).Add(voteWeights[assetValue.Value.String()], asset.Influence)
+			voteWeight.Add(voteWeight, asset.Influence)
 
 			//Calculate influence sum
-			if influenceSum[assetValue.LeafId] == nil {
-				influenceSum[assetValue.LeafId] = big.NewInt(0)
+			leafInfluenceSum, ok := influenceSum[assetValue.LeafId]
+			if !ok {
+				leafInfluenceSum = big.NewInt(0)
+				influenceSum[assetValue.LeafId] = leafInfluenceSum
 			}
-			influenceSum[assetValue.LeafId] = big.NewInt(0).Add(influenceSum[assetValue.LeafId], asset.Influence)
+			leafInfluenceSum.Add(leafInfluenceSum, asset.Influence)
 		}
 	}
-	//sort revealed values
-	for _, element := range revealedValuesWithIndex {
-		sort.Slice(element, func(i, j int) bool {
-			return element[i].Cmp(element[j]) == -1
-		})
+
+	leafIds := make([]uint16, 0, len(revealedValuesWithIndex))
+	for leafId := range revealedValuesWithIndex {
+		leafIds = append(leafIds, leafId)
+	}
+	sortedRevealedValues := make([][]*big.Int, len(leafIds))
+	runConcurrently(len(leafIds), func(index int) {
+		sortedRevealedValues[index] = sortUniqueValues(revealedValuesWithIndex[leafIds[index]])
+	})
+	for index, leafId := range leafIds {
+		revealedValuesWithIndex[leafId] = sortedRevealedValues[index]
 	}
 	return &types.RevealedDataMaps{
 		SortedRevealedValues: revealedValuesWithIndex,
 		VoteWeights:          voteWeights,
 		InfluenceSum:         influenceSum,
-	}, nil
+	}
+}
+
+//This function sorts the values in place and returns them without the repeated values
+func sortUniqueValues(values []*big.Int) []*big.Int {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Cmp(values[j]) == -1
+	})
+	uniqueValues := values[:0]
+	for _, value := range values {
+		if len(uniqueValues) == 0 || uniqueValues[len(uniqueValues)-1].Cmp(value) != 0 {
+			uniqueValues = append(uniqueValues, value)
+		}
+	}
+	return uniqueValues
+}
+
+//This function calls run for every index up to n with core.MaxConcurrentMedianCalculations workers and returns once all the indexes are run
+func runConcurrently(n int, run func(index int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < core.MaxConcurrentMedianCalculations && worker < n; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				run(index)
+			}
+		}()
+	}
+	for index := 0; index < n; index++ {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
 }
 
 //This function returns the medians, idsRevealedInThisEpoch and revealedDataMaps
@@ -332,27 +383,45 @@ func (*UtilsStruct) MakeBlock(client *ethclient.Client, blockNumber *big.Int, ep
 	var (
 		medians                []*big.Int
 		idsRevealedInThisEpoch []uint16
+		revealedLeafIds        []uint16
 	)
-
 	for leafId := uint16(0); leafId < uint16(len(activeCollections)); leafId++ {
 		influenceSum := revealedDataMaps.InfluenceSum[leafId]
-		if influenceSum != nil && influenceSum.Cmp(big.NewInt(0)) != 0 {
+		if influenceSum != nil && influenceSum.Sign() != 0 {
+			revealedLeafIds = append(revealedLeafIds, leafId)
+		}
+	}
+
+	//The slices stay nil if nothing is revealed
+	if len(revealedLeafIds) > 0 {
+		medians = make([]*big.Int, 0, len(revealedLeafIds))
+		idsRevealedInThisEpoch = make([]uint16, 0, len(revealedLeafIds))
+	}
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "medians") {
+		for _, leafId := range revealedLeafIds {
 			idsRevealedInThisEpoch = append(idsRevealedInThisEpoch, activeCollections[leafId])
-			if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "medians") {
-				medians = append(medians, razorUtils.GetRogueRandomValue(10000000))
-				continue
-			}
-			accWeight := big.NewInt(0)
-			for i := 0; i < len(revealedDataMaps.SortedRevealedValues[leafId]); i++ {
-				revealedValue := revealedDataMaps.SortedRevealedValues[leafId][i]
-				accWeight = accWeight.Add(accWeight, revealedDataMaps.VoteWeights[revealedValue.String()])
-				if accWeight.Cmp(influenceSum.Div(influenceSum, big.NewInt(2))) > 0 {
-					medians = append(medians, revealedValue)
-					break
-				}
+			medians = append(medians, razorUtils.GetRogueRandomValue(10000000))
+		}
+	} else {
+		//The medians of the collections are calculated concurrently, each with its own buffer for the accumulated weight
+		leafMedians := make([]*big.Int, len(revealedLeafIds))
+		runConcurrently(len(revealedLeafIds), func(index int) {
+			leafId := revealedLeafIds[index]
+			leafMedians[index] = calculateMedian(revealedDataMaps.SortedRevealedValues[leafId], revealedDataMaps.VoteWeights, revealedDataMaps.InfluenceSum[leafId], new(big.Int))
+		})
+		for index, leafId := range revealedLeafIds {
+			idsRevealedInThisEpoch = append(idsRevealedInThisEpoch, activeCollections[leafId])
+			if leafMedians[index] != nil {
+				medians = append(medians, leafMedians[index])
 			}
 		}
 	}
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "biasedMedians") {
+		//Biasing every median by the percentage if rogueMode == biasedMedians
+		for i := range medians {
+			medians[i] = biasMedian(medians[i], rogueData.MedianBiasPercent)
+		}
+	}
 	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "missingIds") {
 		//Replacing the last ID: id with id+1 in idsRevealed array if rogueMode == missingIds
 		idsRevealedInThisEpoch[len(idsRevealedInThisEpoch)-1] = idsRevealedInThisEpoch[len(idsRevealedInThisEpoch)-1] + 1
@@ -362,6 +431,25 @@ func (*UtilsStruct) MakeBlock(client *ethclient.Client, blockNumber *big.Int, ep
 		medians = append(medians, razorUtils.GetRogueRandomValue(10000000))
 		idsRevealedInThisEpoch = append(idsRevealedInThisEpoch, idsRevealedInThisEpoch[len(idsRevealedInThisEpoch)-1]+1)
 	}
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "droppedId") && len(idsRevealedInThisEpoch) > 0 && len(medians) > 0 {
+		//Dropping the last id and its median if rogueMode == droppedId
+		idsRevealedInThisEpoch = idsRevealedInThisEpoch[:len(idsRevealedInThisEpoch)-1]
+		medians = medians[:len(medians)-1]
+	}
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "duplicatedId") && len(idsRevealedInThisEpoch) > 0 && len(medians) > 0 {
+		//Appending the last id and its median again if rogueMode == duplicatedId
+		idsRevealedInThisEpoch = append(idsRevealedInThisEpoch, idsRevealedInThisEpoch[len(idsRevealedInThisEpoch)-1])
+		medians = append(medians, medians[len(medians)-1])
+	}
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "reversedIds") && len(idsRevealedInThisEpoch) > 1 {
+		//Reversing the order of the ids and of their medians if rogueMode == reversedIds
+		for i, j := 0, len(idsRevealedInThisEpoch)-1; i < j; i, j = i+1, j-1 {
+			idsRevealedInThisEpoch[i], idsRevealedInThisEpoch[j] = idsRevealedInThisEpoch[j], idsRevealedInThisEpoch[i]
+		}
+		for i, j := 0, len(medians)-1; i < j; i, j = i+1, j-1 {
+			medians[i], medians[j] = medians[j], medians[i]
+		}
+	}
 	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "unsortedIds") && len(idsRevealedInThisEpoch) > 1 {
 		//Interchanging the first 2 elements of idsRevealed array
 		temp := idsRevealedInThisEpoch[0]
@@ -371,6 +459,20 @@ func (*UtilsStruct) MakeBlock(client *ethclient.Client, blockNumber *big.Int, ep
 	return medians, idsRevealedInThisEpoch, revealedDataMaps, nil
 }
 
+//This function returns the median of the sorted revealed values of a collection weighted by the vote weights
+//The influence sum is halved in place while comparing, accWeight is used as the buffer for the accumulated weight
+func calculateMedian(sortedRevealedValues []*big.Int, voteWeights map[string]*big.Int, influenceSum *big.Int, accWeight *big.Int) *big.Int {
+	accWeight.SetInt64(0)
+	for i := 0; i < len(sortedRevealedValues); i++ {
+		revealedValue := sortedRevealedValues[i]
+		accWeight.Add(accWeight, voteWeights[revealedValue.String()])
+		if accWeight.Cmp(influenceSum.Div(influenceSum, bigIntTwo)) > 0 {
+			return revealedValue
+		}
+	}
+	return nil
+}
+
 //This function returns the influenced median
 func (*UtilsStruct) InfluencedMedian(sortedVotes []*big.Int, totalInfluenceRevealed *big.Int) *big.Int {
 	accProd := big.NewInt(0)
@@ -378,7 +480,7 @@ func (*UtilsStruct) InfluencedMedian(sortedVotes []*big.Int, totalInfluenceRevea
 	for _, vote := range sortedVotes {
 		accProd = accProd.Add(accProd, vote)
 	}
-	if totalInfluenceRevealed.Cmp(big.NewInt(0)) == 0 {
+	if totalInfluenceRevealed.Sign() == 0 {
 		return accProd
 	}
 	return accProd.Div(accProd, totalInfluenceRevealed)
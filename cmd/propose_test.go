@@ -15,6 +15,7 @@ import (
 	"razor/utils"
 	Mocks "razor/utils/mocks"
 	"reflect"
+	"runtime"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -1089,6 +1090,118 @@ func TestMakeBlock(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Test 6: When no collection is revealed in the epoch",
+			args: args{
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{},
+					VoteWeights:          map[string]*big.Int{},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(0)},
+				},
+				activeCollections: []uint16{1, 2},
+			},
+			want:  nil,
+			want1: nil,
+			want2: &types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{},
+				VoteWeights:          map[string]*big.Int{},
+				InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(0)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 7: When MakeBlock executes successfully and there is biasedMedians rogue mode",
+			args: args{
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+					VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(500), 1: big.NewInt(10000), 2: big.NewInt(10000)},
+				},
+				activeCollections: []uint16{0, 1, 2},
+				rogueData: types.Rogue{
+					IsRogue:           true,
+					RogueMode:         []string{"biasedMedians"},
+					MedianBiasPercent: 10,
+				},
+			},
+			want:  []*big.Int{big.NewInt(1), big.NewInt(110), big.NewInt(220)},
+			want1: []uint16{0, 1, 2},
+			want2: &types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+				VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+				InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(250), 1: big.NewInt(2500), 2: big.NewInt(2500)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 8: When MakeBlock executes successfully and there is droppedId rogue mode",
+			args: args{
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+					VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(500), 1: big.NewInt(10000), 2: big.NewInt(10000)},
+				},
+				activeCollections: []uint16{0, 1, 2},
+				rogueData: types.Rogue{
+					IsRogue:   true,
+					RogueMode: []string{"droppedId"},
+				},
+			},
+			want:  []*big.Int{big.NewInt(1), big.NewInt(100)},
+			want1: []uint16{0, 1},
+			want2: &types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+				VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+				InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(250), 1: big.NewInt(2500), 2: big.NewInt(2500)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 9: When MakeBlock executes successfully and there is duplicatedId rogue mode",
+			args: args{
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+					VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(500), 1: big.NewInt(10000), 2: big.NewInt(10000)},
+				},
+				activeCollections: []uint16{0, 1, 2},
+				rogueData: types.Rogue{
+					IsRogue:   true,
+					RogueMode: []string{"duplicatedId"},
+				},
+			},
+			want:  []*big.Int{big.NewInt(1), big.NewInt(100), big.NewInt(200), big.NewInt(200)},
+			want1: []uint16{0, 1, 2, 2},
+			want2: &types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+				VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+				InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(250), 1: big.NewInt(2500), 2: big.NewInt(2500)},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 10: When MakeBlock executes successfully and there is reversedIds rogue mode",
+			args: args{
+				revealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+					VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(500), 1: big.NewInt(10000), 2: big.NewInt(10000)},
+				},
+				activeCollections: []uint16{0, 1, 2},
+				rogueData: types.Rogue{
+					IsRogue:   true,
+					RogueMode: []string{"reversedIds"},
+				},
+			},
+			want:  []*big.Int{big.NewInt(200), big.NewInt(100), big.NewInt(1)},
+			want1: []uint16{2, 1, 0},
+			want2: &types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(1), big.NewInt(1)}, 1: {big.NewInt(100), big.NewInt(100)}, 2: {big.NewInt(200), big.NewInt(200)}},
+				VoteWeights:          map[string]*big.Int{big.NewInt(1).String(): big.NewInt(1000), big.NewInt(100).String(): big.NewInt(2000), big.NewInt(200).String(): big.NewInt(3000)},
+				InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(250), 1: big.NewInt(2500), 2: big.NewInt(2500)},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1394,14 +1507,17 @@ func BenchmarkGetSortedRevealedValues(b *testing.B) {
 	}
 	for _, v := range table {
 		b.Run(fmt.Sprintf("Number_Of_Assigned_Assets_%d, Number_Of_Revealed_Votes_%d", v.numOfAssignedAssets, v.numOfRevealedValues), func(b *testing.B) {
-			for i := 0; i < b.N; i++ {
-				cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 
-				cmdUtils = cmdUtilsMock
-				asset := GetDummyRevealedValues(v.numOfRevealedValues)
+			cmdUtils = cmdUtilsMock
+			asset := GetDummyRevealedValues(v.numOfRevealedValues)
 
-				cmdUtilsMock.On("IndexRevealEventsOfCurrentEpoch", mock.Anything, mock.Anything, mock.Anything).Return(GetDummyAssignedAssets(asset, v.numOfAssignedAssets), nil)
-				ut := &UtilsStruct{}
+			cmdUtilsMock.On("IndexRevealEventsOfCurrentEpoch", mock.Anything, mock.Anything, mock.Anything).Return(GetDummyAssignedAssets(asset, v.numOfAssignedAssets), nil)
+			ut := &UtilsStruct{}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
 				_, err := ut.GetSortedRevealedValues(client, blockNumber, epoch)
 				if err != nil {
 					log.Fatal(err)
@@ -1429,22 +1545,85 @@ func BenchmarkMakeBlock(b *testing.B) {
 	}
 	for _, v := range table {
 		b.Run(fmt.Sprintf("Number_Of_Votes_%d", v.numOfVotes), func(b *testing.B) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+
+			votes := GetDummyVotes(v.numOfVotes)
+			influenceSum := big.NewInt(100)
+
+			cmdUtilsMock.On("GetSortedRevealedValues", mock.Anything, mock.Anything, mock.Anything).Return(&types.RevealedDataMaps{
+				SortedRevealedValues: map[uint16][]*big.Int{0: votes},
+				VoteWeights:          map[string]*big.Int{(big.NewInt(1).Mul(big.NewInt(697718000), big.NewInt(1e18))).String(): big.NewInt(100)},
+				InfluenceSum:         map[uint16]*big.Int{0: influenceSum},
+			}, nil)
+			utilsMock.On("GetActiveCollections", mock.Anything).Return([]uint16{1}, nil)
+			ut := &UtilsStruct{}
+
+			b.ReportAllocs()
+			b.ResetTimer()
 			for i := 0; i < b.N; i++ {
-				utilsMock := new(mocks.UtilsInterface)
-				cmdUtilsMock := new(mocks.UtilsCmdInterface)
+				//MakeBlock halves the influence sum while finding the median, so it is reset for every run
+				b.StopTimer()
+				influenceSum.SetInt64(100)
+				b.StartTimer()
 
-				razorUtils = utilsMock
-				cmdUtils = cmdUtilsMock
+				_, _, _, err := ut.MakeBlock(client, blockNumber, epoch, types.Rogue{IsRogue: false})
+				if err != nil {
+					log.Fatal(err)
+				}
+			}
+		})
+	}
+}
 
-				votes := GetDummyVotes(v.numOfVotes)
+func BenchmarkMakeBlockConcurrency(b *testing.B) {
+	var (
+		client      *ethclient.Client
+		blockNumber *big.Int
+		epoch       uint32
+	)
+	const (
+		numOfCollections = 1000
+		numOfStakers     = 1000
+	)
+	revealedValues := getBenchRevealedValues(numOfCollections, numOfStakers)
+	activeCollections := make([]uint16, numOfCollections)
+	for i := range activeCollections {
+		activeCollections[i] = uint16(i + 1)
+	}
 
-				cmdUtilsMock.On("GetSortedRevealedValues", mock.Anything, mock.Anything, mock.Anything).Return(&types.RevealedDataMaps{
-					SortedRevealedValues: map[uint16][]*big.Int{0: votes},
-					VoteWeights:          map[string]*big.Int{(big.NewInt(1).Mul(big.NewInt(697718000), big.NewInt(1e18))).String(): big.NewInt(100)},
-					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(100)},
-				}, nil)
-				utilsMock.On("GetActiveCollections", mock.Anything).Return([]uint16{1}, nil)
-				ut := &UtilsStruct{}
+	defer func(maxConcurrentMedianCalculations int) {
+		core.MaxConcurrentMedianCalculations = maxConcurrentMedianCalculations
+	}(core.MaxConcurrentMedianCalculations)
+	table := []struct {
+		name    string
+		workers int
+	}{
+		{name: "Sequential", workers: 1},
+		{name: "Concurrent", workers: runtime.NumCPU()},
+	}
+	for _, v := range table {
+		b.Run(fmt.Sprintf("%s_Collections_%d_Stakers_%d", v.name, numOfCollections, numOfStakers), func(b *testing.B) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			core.MaxConcurrentMedianCalculations = v.workers
+
+			//The revealed values are sorted in every run, as MakeBlock halves the influence sums while finding the medians
+			cmdUtilsMock.On("GetSortedRevealedValues", mock.Anything, mock.Anything, mock.Anything).Return(func(*ethclient.Client, *big.Int, uint32) *types.RevealedDataMaps {
+				return sortRevealedValues(revealedValues)
+			}, nil)
+			utilsMock.On("GetActiveCollections", mock.Anything).Return(activeCollections, nil)
+			ut := &UtilsStruct{}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
 				_, _, _, err := ut.MakeBlock(client, blockNumber, epoch, types.Rogue{IsRogue: false})
 				if err != nil {
 					log.Fatal(err)
@@ -1455,7 +1634,7 @@ func BenchmarkMakeBlock(b *testing.B) {
 }
 
 func GetDummyVotes(numOfVotes int) []*big.Int {
-	var result []*big.Int
+	result := make([]*big.Int, 0, numOfVotes)
 	for i := 0; i < numOfVotes; i++ {
 		result = append(result, big.NewInt(1).Mul(big.NewInt(697718000), big.NewInt(1e18)))
 	}
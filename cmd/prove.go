@@ -0,0 +1,184 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"razor/accounts"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	"razor/utils"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var proveCmd = &cobra.Command{
+	Use:   "prove",
+	Short: "export the signed attestation of the values committed in an epoch",
+	Long: `Exports the attestation of the values committed by the staker in the epoch, which is signed with the staker key or the attestation key while voting with --attest.
+The attestation has the raw responses of the sources of every committed collection, the values parsed from them and the committed values with their merkle root and commit transaction, so that the staker can prove to delegators and auditors what was voted and why.
+The signature of the attestation is verified before it is exported.
+
+Example:
+  ./razor prove --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epoch 12345
+  ./razor prove --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --epoch 12345 --output attestation.json`,
+	Run: initialiseProve,
+}
+
+//This function initialises the ExecuteProve function
+func initialiseProve(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteProve(cmd.Flags())
+}
+
+//This function sets the flags appropriately, reads the attestation of the epoch and prints or writes it
+func (*UtilsStruct) ExecuteProve(flagSet *pflag.FlagSet) {
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	epoch, err := flagSetUtils.GetUint32Epoch(flagSet)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	output, err := flagSetUtils.GetStringOutput(flagSet)
+	utils.CheckError("Error in getting output: ", err)
+
+	attestation, err := getAttestation(address, epoch)
+	utils.CheckError("Error in getting attestation: ", err)
+
+	attestationData, err := json.MarshalIndent(attestation, "", "  ")
+	utils.CheckError("Error in marshalling attestation: ", err)
+
+	if output == "" {
+		fmt.Println(string(attestationData))
+		return
+	}
+	err = os.WriteFile(output, attestationData, 0600)
+	utils.CheckError("Error in writing attestation: ", err)
+	log.Infof("Attestation of epoch %d signed by %s written to %s", epoch, attestation.Signer, output)
+}
+
+//This function returns the attestation of the values committed by the address in the epoch after verifying its signature
+func getAttestation(address string, epoch uint32) (types.Attestation, error) {
+	if !common.IsHexAddress(address) {
+		return types.Attestation{}, errors.New("invalid address")
+	}
+	fileName, err := path.PathUtilsInterface.GetAttestationFileName(address)
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	attestations, err := utils.UtilsInterface.ReadAttestations(fileName)
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	for _, attestation := range attestations {
+		if attestation.Payload.Epoch != epoch {
+			continue
+		}
+		err = utils.VerifyAttestation(attestation)
+		if err != nil {
+			return types.Attestation{}, err
+		}
+		return attestation, nil
+	}
+	return types.Attestation{}, fmt.Errorf("no attestation of epoch %d for %s, the values are attested while voting with --attest", epoch, address)
+}
+
+//This function signs the attestation of the commit of the epoch and saves it, the errors are only logged as the attestations shouldn't stop the voting
+//The attestation has the responses of the sources recorded while the values of the commit were fetched
+func saveCommitAttestation(account types.Account, epoch uint32, stakerId uint32, keystorePath string, commitData types.CommitData, merkleRoot [32]byte, commitTxn common.Hash, collections []types.AttestedCollection) {
+	if !utils.IsAttestationEnabled() {
+		return
+	}
+	payload := types.AttestationPayload{
+		Epoch:         epoch,
+		ChainId:       core.ChainId.String(),
+		Staker:        common.HexToAddress(account.Address).Hex(),
+		StakerId:      stakerId,
+		CommitTxnHash: commitTxn.Hex(),
+		MerkleRoot:    hexutil.Encode(merkleRoot[:]),
+		Leaves:        commitData.Leaves,
+		Collections:   collections,
+		CreatedAt:     time.Now().Unix(),
+	}
+	attestation, err := signAttestation(account, keystorePath, payload)
+	if err != nil {
+		log.Error("Error in signing attestation: ", err)
+		return
+	}
+	fileName, err := path.PathUtilsInterface.GetAttestationFileName(account.Address)
+	if err != nil {
+		log.Error("Error in getting attestation file name: ", err)
+		return
+	}
+	err = utils.UtilsInterface.SaveAttestation(fileName, attestation)
+	if err != nil {
+		log.Error("Error in saving attestation: ", err)
+		return
+	}
+	log.Debugf("Attestation of the values committed in epoch %d saved", epoch)
+}
+
+//This function signs the hash of the attestation payload with the attestation key if it is set and with the key of the staker otherwise
+//The KMS signature of the attestation isn't saved, so that the saved signature of the secret is kept for the reveal
+func signAttestation(account types.Account, keystorePath string, payload types.AttestationPayload) (types.Attestation, error) {
+	hash, err := utils.HashAttestationPayload(payload)
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	var (
+		signer    = common.HexToAddress(account.Address).Hex()
+		signature []byte
+	)
+	if utils.HasAttestationKey() {
+		signer, signature, err = utils.SignWithAttestationKey(hash)
+	} else if utils.IsRemoteSignerEnabled() {
+		signature, err = utils.SignDataRemotely(account.Address, hash)
+	} else if utils.IsKMSSignerEnabled() {
+		signature, err = utils.SignAttestationWithKMS(account.Address, hash)
+	} else {
+		signature, err = accounts.AccountUtilsInterface.SignData(utils.SignHash(hash), account, keystorePath)
+	}
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	if len(signature) == 65 && (signature[64] == 0 || signature[64] == 1) {
+		signature[64] += 27
+	}
+	attestation := types.Attestation{
+		Payload:     payload,
+		PayloadHash: hexutil.Encode(hash),
+		Signer:      signer,
+		Signature:   hexutil.Encode(signature),
+	}
+	// The signature is checked as it is done by the prove command, so that an attestation which can't be verified isn't saved
+	err = utils.VerifyAttestation(attestation)
+	if err != nil {
+		return types.Attestation{}, err
+	}
+	return attestation, nil
+}
+
+func init() {
+	rootCmd.AddCommand(proveCmd)
+
+	var (
+		Address string
+		Epoch   uint32
+		Output  string
+	)
+
+	proveCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+	proveCmd.Flags().Uint32VarP(&Epoch, "epoch", "", 0, "epoch of the attested commit")
+	proveCmd.Flags().StringVarP(&Output, "output", "", "", "file to write the attestation to, it is printed if not passed")
+
+	addrErr := proveCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+	epochErr := proveCmd.MarkFlagRequired("epoch")
+	utils.CheckError("Epoch error: ", epochErr)
+}
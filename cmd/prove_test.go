@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAttestation(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := func(epoch uint32) types.Attestation {
+		payload := types.AttestationPayload{
+			Epoch:       epoch,
+			ChainId:     "1",
+			Staker:      crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Leaves:      []*big.Int{big.NewInt(100)},
+			Collections: []types.AttestedCollection{{CollectionId: 1, Value: big.NewInt(100), Sources: []types.AttestedSource{{JobId: 1, Response: `{"last":"100"}`, Value: big.NewInt(100)}}}},
+		}
+		hash, err := utils.HashAttestationPayload(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature, err := crypto.Sign(utils.SignHash(hash), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return types.Attestation{Payload: payload, PayloadHash: hexutil.Encode(hash), Signer: payload.Staker, Signature: hexutil.Encode(signature)}
+	}
+	tampered := attestation(11)
+	tampered.Payload.Collections = []types.AttestedCollection{{CollectionId: 1, Value: big.NewInt(101), Sources: []types.AttestedSource{}}}
+
+	address := "0x000000000000000000000000000000000000dEaD"
+	type args struct {
+		address         string
+		fileNameErr     error
+		attestations    []types.Attestation
+		attestationsErr error
+		epoch           uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.Attestation
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the attestation of the epoch is signed by its signer",
+			args: args{
+				address:      address,
+				attestations: []types.Attestation{attestation(10), attestation(11)},
+				epoch:        11,
+			},
+			want:    attestation(11),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is no attestation of the epoch",
+			args: args{
+				address:      address,
+				attestations: []types.Attestation{attestation(10)},
+				epoch:        11,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When the attestation of the epoch is changed after it is signed",
+			args: args{
+				address:      address,
+				attestations: []types.Attestation{attestation(10), tampered},
+				epoch:        11,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in reading the attestations",
+			args: args{
+				address:         address,
+				attestationsErr: errors.New("read error"),
+				epoch:           11,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the attestation file name",
+			args: args{
+				address:     address,
+				fileNameErr: errors.New("path error"),
+				epoch:       11,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the address is invalid",
+			args: args{
+				address: "0x01",
+				epoch:   11,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			pathUtilsMock.On("GetAttestationFileName", mock.AnythingOfType("string")).Return("attestations.jsonl", tt.args.fileNameErr)
+			utilsPkgMock.On("ReadAttestations", mock.AnythingOfType("string")).Return(tt.args.attestations, tt.args.attestationsErr)
+
+			got, err := getAttestation(tt.args.address, tt.args.epoch)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getAttestation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getAttestation() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignAttestation(t *testing.T) {
+	dir, _ := os.Getwd()
+	razorPath := filepath.Dir(dir)
+	testKeystorePath := filepath.Join(razorPath, "utils/test_accounts")
+	payload := types.AttestationPayload{Epoch: 9021, ChainId: "31337", Staker: "0x57Baf83BAD5bee0F7F44d84669A50C35c57E3576", Leaves: []*big.Int{big.NewInt(100)}}
+
+	tests := []struct {
+		name    string
+		account types.Account
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When the attestation is signed with the staker key",
+			account: types.Account{Address: "0x57baf83bad5bee0f7f44d84669a50c35c57e3576", Password: "Test@123"},
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When the password of the staker key is wrong",
+			account: types.Account{Address: "0x57Baf83BAD5bee0F7F44d84669A50C35c57E3576", Password: "Test"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			InitializeInterfaces()
+			got, err := signAttestation(tt.account, testKeystorePath, payload)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("signAttestation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Signer != "0x57Baf83BAD5bee0F7F44d84669A50C35c57E3576" || !reflect.DeepEqual(got.Payload, payload) {
+				t.Errorf("signAttestation() got = %+v, want the payload signed by the staker", got)
+			}
+			if err := utils.VerifyAttestation(got); err != nil {
+				t.Errorf("VerifyAttestation() of the signed attestation error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"razor/core/types"
+	"razor/utils"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//Keys of the configuration which can be changed by the remote configuration while voting
+var hotReloadableConfigKeys = []string{"gasmultiplier", "buffer", "wait", "gasprice", "gasLimit", "logLevel", "txnTimeouts", "gasStrategies", "maxGasPrice", "gasBudget", "gasBudgetPeriod"}
+
+var (
+	remoteConfigValues         map[string]interface{}
+	remoteConfigVersion        uint64
+	appliedRemoteConfigVersion uint64
+	remoteConfigMutex          sync.Mutex
+)
+
+//This function fetches the remote configuration every interval and stores it if it has changed, the errors are only logged and the last configuration is kept
+func (*UtilsStruct) PollRemoteConfig(ctx context.Context, remoteConfig types.RemoteConfig) {
+	for {
+		values, err := utils.FetchRemoteConfig(remoteConfig)
+		if err != nil {
+			log.Error("Error in fetching remote config: ", err)
+		} else {
+			storeRemoteConfig(values)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(remoteConfig.Interval) * time.Second):
+		}
+	}
+}
+
+//This function stores the remote configuration values if they are different from the last values
+func storeRemoteConfig(values map[string]interface{}) {
+	remoteConfigMutex.Lock()
+	defer remoteConfigMutex.Unlock()
+	if reflect.DeepEqual(values, remoteConfigValues) {
+		return
+	}
+	remoteConfigValues = values
+	remoteConfigVersion++
+	log.Info("Fetched new remote config")
+}
+
+//This function applies the remote configuration fetched last if it hasn't been applied yet
+//If the remote configuration is invalid, the error is logged and the config is not changed
+func applyLatestRemoteConfig(config types.Configurations) types.Configurations {
+	remoteConfigMutex.Lock()
+	values, version := remoteConfigValues, remoteConfigVersion
+	remoteConfigMutex.Unlock()
+	if version == appliedRemoteConfigVersion {
+		return config
+	}
+	appliedRemoteConfigVersion = version
+
+	newConfig, err := cmdUtils.ApplyRemoteConfig(config, values)
+	if err != nil {
+		log.Error("Error in applying remote config: ", err)
+		return config
+	}
+	log.Infof("Applied remote config, Gas Multiplier: %.2f, Buffer Percent: %d, Wait Time: %d, Gas Price: %d, Gas Limit: %.2f, Log Level: %s, Txn Timeouts: %v, Gas Strategies: %v, Max Gas Price: %d, Gas Budget: %v, Gas Budget Period: %s", newConfig.GasMultiplier, newConfig.BufferPercent, newConfig.WaitTime, newConfig.GasPrice, newConfig.GasLimitMultiplier, newConfig.LogLevel, newConfig.TxnTimeouts, newConfig.GasStrategies, newConfig.MaxGasPrice, newConfig.GasBudget, newConfig.GasBudgetPeriod)
+	return newConfig
+}
+
+//This function returns the config with the values of the remote configuration
+//All the keys should be hot reloadable and all the values should be valid, otherwise none of them are applied
+func (*UtilsStruct) ApplyRemoteConfig(config types.Configurations, values map[string]interface{}) (types.Configurations, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var logLevelChanged bool
+	for _, key := range keys {
+		value := values[key]
+		switch key {
+		case "gasmultiplier":
+			gasMultiplier, err := getRemoteConfigNumber(key, value)
+			if err != nil {
+				return config, err
+			}
+			config.GasMultiplier = float32(gasMultiplier)
+		case "gasLimit":
+			gasLimit, err := getRemoteConfigNumber(key, value)
+			if err != nil {
+				return config, err
+			}
+			config.GasLimitMultiplier = float32(gasLimit)
+		case "gasBudget":
+			gasBudget, err := getRemoteConfigNumber(key, value)
+			if err != nil {
+				return config, err
+			}
+			config.GasBudget = float32(gasBudget)
+		case "buffer", "wait", "gasprice", "maxGasPrice":
+			number, err := getRemoteConfigInteger(key, value)
+			if err != nil {
+				return config, err
+			}
+			switch key {
+			case "buffer":
+				config.BufferPercent = int32(number)
+			case "wait":
+				config.WaitTime = int32(number)
+			case "gasprice":
+				config.GasPrice = int32(number)
+			case "maxGasPrice":
+				config.MaxGasPrice = int32(number)
+			}
+		case "logLevel":
+			logLevel, ok := value.(string)
+			if !ok {
+				return config, fmt.Errorf("value of %s should be a string", key)
+			}
+			config.LogLevel = logLevel
+			logLevelChanged = true
+		case "gasBudgetPeriod":
+			gasBudgetPeriod, ok := value.(string)
+			if !ok {
+				return config, fmt.Errorf("value of %s should be a string", key)
+			}
+			if err := utils.ValidateGasBudgetPeriod(gasBudgetPeriod); err != nil {
+				return config, err
+			}
+			config.GasBudgetPeriod = gasBudgetPeriod
+		case "txnTimeouts":
+			timeoutValues, ok := value.(map[string]interface{})
+			if !ok {
+				return config, fmt.Errorf("value of %s should be an object of states and timeouts", key)
+			}
+			txnTimeouts := make(map[string]int)
+			for state, timeoutValue := range timeoutValues {
+				timeout, err := getRemoteConfigInteger(key+"."+state, timeoutValue)
+				if err != nil {
+					return config, err
+				}
+				txnTimeouts[state] = int(timeout)
+			}
+			if err := validateTxnTimeouts(txnTimeouts); err != nil {
+				return config, err
+			}
+			config.TxnTimeouts = txnTimeouts
+		case "gasStrategies":
+			strategyValues, ok := value.(map[string]interface{})
+			if !ok {
+				return config, fmt.Errorf("value of %s should be an object of classes and gas strategies", key)
+			}
+			gasStrategies := make(map[string]string)
+			for class, strategyValue := range strategyValues {
+				strategy, ok := strategyValue.(string)
+				if !ok {
+					return config, fmt.Errorf("value of %s.%s should be a string", key, class)
+				}
+				gasStrategies[class] = strategy
+			}
+			if err := utils.ValidateGasStrategies(gasStrategies); err != nil {
+				return config, err
+			}
+			config.GasStrategies = gasStrategies
+		default:
+			return config, fmt.Errorf("key %s can't be changed by the remote config, keys which can be changed are %s", key, strings.Join(hotReloadableConfigKeys, ", "))
+		}
+	}
+
+	if logLevelChanged {
+		if config.LogLevel == "debug" {
+			log.SetLevel(logrus.DebugLevel)
+		} else {
+			log.SetLevel(logrus.InfoLevel)
+		}
+	}
+	return config, nil
+}
+
+//This function returns the value of the key as a non negative number
+func getRemoteConfigNumber(key string, value interface{}) (float64, error) {
+	number, ok := value.(float64)
+	if !ok || number < 0 {
+		return 0, fmt.Errorf("value of %s should be a non negative number", key)
+	}
+	return number, nil
+}
+
+//This function returns the value of the key as a non negative integer
+func getRemoteConfigInteger(key string, value interface{}) (float64, error) {
+	number, err := getRemoteConfigNumber(key, value)
+	if err != nil || number != math.Trunc(number) || number > math.MaxInt32 {
+		return 0, fmt.Errorf("value of %s should be a non negative integer", key)
+	}
+	return number, nil
+}
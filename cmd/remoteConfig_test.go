@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestApplyRemoteConfig(t *testing.T) {
+	config := types.Configurations{
+		Provider:           "http://127.0.0.1",
+		GasMultiplier:      1,
+		BufferPercent:      20,
+		WaitTime:           1,
+		GasPrice:           1,
+		LogLevel:           "",
+		GasLimitMultiplier: 2,
+	}
+
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		want    types.Configurations
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When all the keys are hot reloadable and valid",
+			values: map[string]interface{}{
+				"gasmultiplier": 1.5,
+				"buffer":        float64(10),
+				"wait":          float64(2),
+				"gasprice":      float64(0),
+				"gasLimit":      2.5,
+			},
+			want: types.Configurations{
+				Provider:           "http://127.0.0.1",
+				GasMultiplier:      1.5,
+				BufferPercent:      10,
+				WaitTime:           2,
+				GasPrice:           0,
+				LogLevel:           "",
+				GasLimitMultiplier: 2.5,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When there are no values",
+			values:  map[string]interface{}{},
+			want:    config,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When a key is not hot reloadable",
+			values: map[string]interface{}{
+				"buffer":   float64(10),
+				"provider": "http://127.0.0.2",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When buffer is negative",
+			values: map[string]interface{}{
+				"buffer": float64(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When wait is not an integer",
+			values: map[string]interface{}{
+				"wait": 1.5,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When gasmultiplier is a string",
+			values: map[string]interface{}{
+				"gasmultiplier": "1.5",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When txnTimeouts has an invalid state",
+			values: map[string]interface{}{
+				"txnTimeouts": map[string]interface{}{"invalidState": float64(10)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When logLevel is not a string",
+			values: map[string]interface{}{
+				"logLevel": float64(1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When the gas strategies and the maximum gas price are changed",
+			values: map[string]interface{}{
+				"gasStrategies": map[string]interface{}{"dispute": "fast", "claim": "slow"},
+				"maxGasPrice":   float64(100),
+			},
+			want: types.Configurations{
+				Provider:           "http://127.0.0.1",
+				GasMultiplier:      1,
+				BufferPercent:      20,
+				WaitTime:           1,
+				GasPrice:           1,
+				LogLevel:           "",
+				GasLimitMultiplier: 2,
+				GasStrategies:      map[string]string{"dispute": "fast", "claim": "slow"},
+				MaxGasPrice:        100,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 10: When gasStrategies has an invalid strategy",
+			values: map[string]interface{}{
+				"gasStrategies": map[string]interface{}{"dispute": "fastest"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 11: When the gas budget and its period are changed",
+			values: map[string]interface{}{
+				"gasBudget":       float64(0.5),
+				"gasBudgetPeriod": "day",
+			},
+			want: types.Configurations{
+				Provider:           "http://127.0.0.1",
+				GasMultiplier:      1,
+				BufferPercent:      20,
+				WaitTime:           1,
+				GasPrice:           1,
+				LogLevel:           "",
+				GasLimitMultiplier: 2,
+				GasBudget:          0.5,
+				GasBudgetPeriod:    "day",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 12: When gasBudgetPeriod is invalid",
+			values: map[string]interface{}{
+				"gasBudgetPeriod": "week",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := &UtilsStruct{}
+			got, err := ut.ApplyRemoteConfig(config, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyRemoteConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyRemoteConfig() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyLatestRemoteConfig(t *testing.T) {
+	config := types.Configurations{BufferPercent: 20}
+	newConfig := types.Configurations{BufferPercent: 10}
+
+	defer func() {
+		remoteConfigValues, remoteConfigVersion, appliedRemoteConfigVersion = nil, 0, 0
+	}()
+
+	tests := []struct {
+		name                 string
+		values               map[string]interface{}
+		applyRemoteConfigErr error
+		want                 types.Configurations
+	}{
+		{
+			name:   "Test 1: When a new remote config is fetched",
+			values: map[string]interface{}{"buffer": float64(10)},
+			want:   newConfig,
+		},
+		{
+			name:   "Test 2: When the same remote config is fetched again",
+			values: map[string]interface{}{"buffer": float64(10)},
+			want:   config,
+		},
+		{
+			name:                 "Test 3: When there is an error in applying the remote config",
+			values:               map[string]interface{}{"provider": "http://127.0.0.2"},
+			applyRemoteConfigErr: errors.New("applyRemoteConfig error"),
+			want:                 config,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtils = cmdUtilsMock
+
+			cmdUtilsMock.On("ApplyRemoteConfig", mock.Anything, mock.Anything).Return(newConfig, tt.applyRemoteConfigErr)
+
+			storeRemoteConfig(tt.values)
+			got := applyLatestRemoteConfig(config)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("applyLatestRemoteConfig() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"razor/utils"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var replCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "execute the razor commands read from stdin in a single session",
+	Long: `Reads razor commands from stdin, one per line, and executes them in a single session. The client is connected, the password is prompted and the keystore is unlocked only once for all the commands, so that multi-step operations can be scripted.
+Empty lines and lines starting with # are skipped, the session ends at the end of the input or with exit. A failed command doesn't end the session.
+
+Example:
+  ./razor repl --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --useKeychain < steps.txt`,
+	Run: initialiseRepl,
+}
+
+//replCommandExit is raised instead of exiting the process when a command of the session fails
+type replCommandExit struct {
+	code int
+}
+
+//This function initialises the ExecuteRepl function
+func initialiseRepl(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteRepl(cmd.Flags())
+}
+
+//This function starts the session and executes the commands read from stdin
+func (*UtilsStruct) ExecuteRepl(flagSet *pflag.FlagSet) {
+	razorUtils.AssignLogFile(flagSet)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	useKeychain, err := flagSetUtils.GetBoolUseKeychain(flagSet)
+	utils.CheckError("Error in getting useKeychain: ", err)
+
+	utils.StartSession()
+	defer utils.EndSession()
+
+	if useKeychain {
+		if address == "" {
+			log.Fatal("Address is required to read the password from the keychain")
+		}
+		password, err := razorUtils.GetKeychainPassword(address)
+		utils.CheckError("Error in getting password from keychain: ", err)
+		utils.SetSessionPassword(password)
+	}
+
+	failed, err := cmdUtils.RunRepl(os.Stdin)
+	utils.CheckError("Error in reading commands: ", err)
+	if failed > 0 {
+		log.Warnf("%d commands of the session failed", failed)
+	}
+}
+
+//This function executes the commands read from the input one by one and returns the number of commands which failed
+func (*UtilsStruct) RunRepl(input io.Reader) (int, error) {
+	failed := 0
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		args, err := splitReplLine(scanner.Text())
+		if err != nil {
+			log.Error("Error in parsing command: ", err)
+			failed++
+			continue
+		}
+		// The lines can be copied from the examples, which start with the name of the binary
+		if len(args) > 0 && filepath.Base(args[0]) == "razor" {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			continue
+		}
+		if args[0] == "exit" || args[0] == "quit" {
+			break
+		}
+		if args[0] == replCmd.Name() {
+			log.Error("repl can't be executed in a repl session")
+			failed++
+			continue
+		}
+
+		log.Info("Executing: ", strings.Join(args, " "))
+		err = cmdUtils.ExecuteReplCommand(args)
+		if err != nil {
+			log.Errorf("Error in executing %s: %s", args[0], err)
+			failed++
+		}
+	}
+	return failed, scanner.Err()
+}
+
+//This function executes the command with the root command
+//The errors which would exit the process are recovered, so that the session goes on with the next command
+func (*UtilsStruct) ExecuteReplCommand(args []string) (err error) {
+	resetCommandFlags(rootCmd)
+
+	// The flags which are checked to be passed are looked up in the arguments of the process
+	osArgs := os.Args
+	os.Args = append([]string{osArgs[0]}, args...)
+	exitFunc := log.ExitFunc
+	log.ExitFunc = func(code int) {
+		panic(replCommandExit{code: code})
+	}
+	defer func() {
+		log.ExitFunc = exitFunc
+		os.Args = osArgs
+		if r := recover(); r != nil {
+			exit, ok := r.(replCommandExit)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("command exited with code %d", exit.code)
+		}
+	}()
+
+	rootCmd.SetArgs(args)
+	return rootCmd.Execute()
+}
+
+//This function resets the flags passed to the previous command, as the values of the flags are kept by the commands
+func resetCommandFlags(command *cobra.Command) {
+	resetFlag := func(flag *pflag.Flag) {
+		if !flag.Changed {
+			return
+		}
+		// The slices are appended to once they are set and all of them are empty by default
+		if sliceValue, ok := flag.Value.(pflag.SliceValue); ok {
+			_ = sliceValue.Replace([]string{})
+		} else if flag.Value.Type() != "stringToInt" {
+			_ = flag.Value.Set(flag.DefValue)
+		}
+		flag.Changed = false
+	}
+	command.Flags().VisitAll(resetFlag)
+	command.PersistentFlags().VisitAll(resetFlag)
+	for _, subCommand := range command.Commands() {
+		resetCommandFlags(subCommand)
+	}
+	// The map of the txnTimeouts is merged into once it is set and can only be emptied in place
+	for state := range TxnTimeouts {
+		delete(TxnTimeouts, state)
+	}
+}
+
+//This function splits the line into the arguments of the command, the arguments can be quoted with ' or "
+func splitReplLine(line string) ([]string, error) {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+	var (
+		args    []string
+		current strings.Builder
+		quote   rune
+		inArg   bool
+	)
+	for _, char := range line {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			} else {
+				current.WriteRune(char)
+			}
+		case char == '\'' || char == '"':
+			quote = char
+			inArg = true
+		case char == ' ' || char == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(char)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated quote in " + line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func init() {
+	rootCmd.AddCommand(replCmd)
+
+	var (
+		ReplAddress     string
+		ReplUseKeychain bool
+	)
+
+	replCmd.Flags().StringVarP(&ReplAddress, "address", "a", "", "address whose password is read from the keychain for the session")
+	replCmd.Flags().BoolVarP(&ReplUseKeychain, "useKeychain", "", false, "read the password of the keystore from the keychain of the OS instead of prompting for it")
+}
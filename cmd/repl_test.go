@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"errors"
+	"razor/cmd/mocks"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecuteRepl(t *testing.T) {
+	var flagSet *pflag.FlagSet
+
+	type args struct {
+		address        string
+		addressErr     error
+		useKeychain    bool
+		useKeychainErr error
+		password       string
+		passwordErr    error
+		failed         int
+		replErr        error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		expectedFatal bool
+	}{
+		{
+			name: "Test 1: When ExecuteRepl executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dea1",
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 2: When the password is read from the keychain",
+			args: args{
+				address:     "0x000000000000000000000000000000000000dea1",
+				useKeychain: true,
+				password:    "test",
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting address",
+			args: args{
+				addressErr: errors.New("address error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting useKeychain",
+			args: args{
+				useKeychainErr: errors.New("useKeychain error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 5: When the keychain is used without an address",
+			args: args{
+				useKeychain: true,
+				password:    "test",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting password from keychain",
+			args: args{
+				address:     "0x000000000000000000000000000000000000dea1",
+				useKeychain: true,
+				passwordErr: errors.New("password is not stored in the keychain"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 7: When some commands of the session fail",
+			args: args{
+				failed: 2,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 8: When there is an error in reading commands",
+			args: args{
+				replErr: errors.New("read error"),
+			},
+			expectedFatal: true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			flagSetUtils = flagSetUtilsMock
+			cmdUtils = cmdUtilsMock
+
+			utilsMock.On("AssignLogFile", flagSet)
+			flagSetUtilsMock.On("GetStringAddress", flagSet).Return(tt.args.address, tt.args.addressErr)
+			flagSetUtilsMock.On("GetBoolUseKeychain", flagSet).Return(tt.args.useKeychain, tt.args.useKeychainErr)
+			utilsMock.On("GetKeychainPassword", mock.AnythingOfType("string")).Return(tt.args.password, tt.args.passwordErr)
+			cmdUtilsMock.On("RunRepl", mock.Anything).Return(tt.args.failed, tt.args.replErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteRepl(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteRepl function didn't execute as expected")
+			}
+		})
+	}
+}
+
+func TestRunRepl(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		commandErr   error
+		wantCommands [][]string
+		wantFailed   int
+	}{
+		{
+			name:  "Test 1: When the commands are executed",
+			input: "stakerInfo --stakerId 1\n\n# transfer the rewards\n./razor transfer --value 10 --to 0x01 --from 0x02\n",
+			wantCommands: [][]string{
+				{"stakerInfo", "--stakerId", "1"},
+				{"transfer", "--value", "10", "--to", "0x01", "--from", "0x02"},
+			},
+			wantFailed: 0,
+		},
+		{
+			name:         "Test 2: When the session is ended with exit",
+			input:        "stakerInfo --stakerId 1\nexit\nstakerInfo --stakerId 2\n",
+			wantCommands: [][]string{{"stakerInfo", "--stakerId", "1"}},
+			wantFailed:   0,
+		},
+		{
+			name:       "Test 3: When the commands fail",
+			input:      "stakerInfo --stakerId 1\nstakerInfo --stakerId 2\n",
+			commandErr: errors.New("command exited with code 1"),
+			wantCommands: [][]string{
+				{"stakerInfo", "--stakerId", "1"},
+				{"stakerInfo", "--stakerId", "2"},
+			},
+			wantFailed: 2,
+		},
+		{
+			name:         "Test 4: When repl is executed in the session",
+			input:        "repl\nstakerInfo --stakerId 1\n",
+			wantCommands: [][]string{{"stakerInfo", "--stakerId", "1"}},
+			wantFailed:   1,
+		},
+		{
+			name:         "Test 5: When a line can't be parsed",
+			input:        "createJob --name 'eth\nstakerInfo --stakerId 1\n",
+			wantCommands: [][]string{{"stakerInfo", "--stakerId", "1"}},
+			wantFailed:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtils = cmdUtilsMock
+
+			var commands [][]string
+			cmdUtilsMock.On("ExecuteReplCommand", mock.Anything).Run(func(args mock.Arguments) {
+				commands = append(commands, args.Get(0).([]string))
+			}).Return(tt.commandErr)
+
+			utils := &UtilsStruct{}
+			failed, err := utils.RunRepl(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("RunRepl() error = %v", err)
+			}
+			if failed != tt.wantFailed {
+				t.Errorf("RunRepl() failed = %d, want %d", failed, tt.wantFailed)
+			}
+			if !reflect.DeepEqual(commands, tt.wantCommands) {
+				t.Errorf("RunRepl() executed %v, want %v", commands, tt.wantCommands)
+			}
+		})
+	}
+}
+
+func TestSplitReplLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the arguments are separated by spaces and tabs",
+			line: "  stakerInfo  --stakerId\t1 ",
+			want: []string{"stakerInfo", "--stakerId", "1"},
+		},
+		{
+			name: "Test 2: When the arguments are quoted",
+			line: `createJob --name "eth usd" --selector '$["data"]' --url ""`,
+			want: []string{"createJob", "--name", "eth usd", "--selector", `$["data"]`, "--url", ""},
+		},
+		{
+			name: "Test 3: When the line is a comment",
+			line: "# stake the rewards",
+			want: nil,
+		},
+		{
+			name: "Test 4: When the line is empty",
+			line: "",
+			want: nil,
+		},
+		{
+			name:    "Test 5: When a quote is not terminated",
+			line:    `createJob --name "eth usd`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitReplLine(tt.line)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("splitReplLine() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitReplLine() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResetCommandFlags(t *testing.T) {
+	var (
+		value    string
+		count    int32
+		hosts    []string
+		subValue bool
+	)
+	// The flags are parsed without executing the command, so that the initializers of the root command aren't run
+	root := &cobra.Command{Use: "razor"}
+	root.PersistentFlags().StringSliceVarP(&hosts, "allowedHosts", "", []string{}, "")
+	subCommand := &cobra.Command{Use: "sub", Run: func(*cobra.Command, []string) {}}
+	subCommand.Flags().StringVarP(&value, "value", "", "", "")
+	subCommand.Flags().Int32VarP(&count, "count", "", -1, "")
+	subCommand.Flags().BoolVarP(&subValue, "force", "", false, "")
+	root.AddCommand(subCommand)
+
+	if err := subCommand.ParseFlags([]string{"--value", "10", "--count", "5", "--force", "--allowedHosts", "api.gemini.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if value != "10" || count != 5 || !subValue || !reflect.DeepEqual(hosts, []string{"api.gemini.com"}) {
+		t.Fatalf("Flags are not parsed, got value = %q, count = %d, force = %v, allowedHosts = %v", value, count, subValue, hosts)
+	}
+
+	resetCommandFlags(root)
+	if value != "" || count != -1 || subValue || len(hosts) != 0 {
+		t.Errorf("Flags are not reset, got value = %q, count = %d, force = %v, allowedHosts = %v", value, count, subValue, hosts)
+	}
+	for _, name := range []string{"value", "count", "force", "allowedHosts"} {
+		if subCommand.Flags().Changed(name) {
+			t.Errorf("Flag %s is still changed", name)
+		}
+	}
+
+	if err := subCommand.ParseFlags([]string{"--allowedHosts", "api.kraken.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(hosts, []string{"api.kraken.com"}) {
+		t.Errorf("allowedHosts of the previous command are kept, got %v", hosts)
+	}
+}
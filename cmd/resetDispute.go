@@ -0,0 +1,79 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/pkg/bindings"
+	"razor/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var resetDisputeCmd = &cobra.Command{
+	Use:   "resetDispute",
+	Short: "resetDispute resets the dispute of the current epoch and clears the saved giveSorted progress",
+	Long: `The sorted values submitted by giveSorted in a dispute are saved, so that a restarted node resumes from the last submitted chunk. resetDispute resets the dispute of the current epoch and clears the saved progress, so that the values are submitted again from the start.
+
+Example:
+  ./razor resetDispute --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c
+`,
+	Run: initialiseResetDispute,
+}
+
+//This function initialises the ExecuteResetDispute function
+func initialiseResetDispute(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteResetDispute(cmd.Flags())
+}
+
+//This function sets the flags appropriately, resets the dispute of the current epoch and clears the saved giveSorted progress
+func (*UtilsStruct) ExecuteResetDispute(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	logger.SetLoggerParameters(client, address)
+	razorUtils.AssignLogFile(flagSet)
+
+	password := razorUtils.AssignPassword()
+
+	epoch, err := razorUtils.GetEpoch(client)
+	utils.CheckError("Error in getting epoch: ", err)
+
+	txnOpts := razorUtils.GetTxnOpts(types.TransactionOptions{
+		Client:          client,
+		Password:        password,
+		AccountAddress:  address,
+		ChainId:         core.ChainId,
+		Config:          config,
+		ContractAddress: core.BlockManagerAddress,
+		MethodName:      "resetDispute",
+		Parameters:      []interface{}{epoch},
+		ABI:             bindings.BlockManagerABI,
+	})
+	blockManager := razorUtils.GetBlockManager(client)
+	cmdUtils.ResetDispute(client, blockManager, txnOpts, epoch)
+
+	err = clearGiveSortedProgress(address)
+	utils.CheckError("Error in clearing giveSorted progress: ", err)
+	log.Info("GiveSorted progress has been cleared")
+}
+
+func init() {
+	rootCmd.AddCommand(resetDisputeCmd)
+
+	var (
+		Address string
+	)
+
+	resetDisputeCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+
+	addrErr := resetDisputeCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
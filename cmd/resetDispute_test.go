@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/mock"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+)
+
+func TestExecuteResetDispute(t *testing.T) {
+	var flagSet *pflag.FlagSet
+	var config types.Configurations
+	var client *ethclient.Client
+	var blockManager *bindings.BlockManager
+	txnOpts := &bind.TransactOpts{}
+
+	type args struct {
+		config              types.Configurations
+		configErr           error
+		password            string
+		address             string
+		addressErr          error
+		epoch               uint32
+		epochErr            error
+		progressFileNameErr error
+		clearProgressErr    error
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantResetDispute bool
+		expectedFatal    bool
+	}{
+		{
+			name: "Test 1: When ExecuteResetDispute function executes successfully",
+			args: args{
+				config:   config,
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dea1",
+				epoch:    5,
+			},
+			wantResetDispute: true,
+			expectedFatal:    false,
+		},
+		{
+			name: "Test 2: When there is an error in getting config",
+			args: args{
+				configErr: errors.New("config error"),
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dea1",
+				epoch:     5,
+			},
+			wantResetDispute: false,
+			expectedFatal:    true,
+		},
+		{
+			name: "Test 3: When there is an error in getting address from flags",
+			args: args{
+				config:     config,
+				password:   "test",
+				addressErr: errors.New("address error"),
+				epoch:      5,
+			},
+			wantResetDispute: false,
+			expectedFatal:    true,
+		},
+		{
+			name: "Test 4: When there is an error in getting epoch",
+			args: args{
+				config:   config,
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dea1",
+				epochErr: errors.New("epoch error"),
+			},
+			wantResetDispute: false,
+			expectedFatal:    true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the giveSorted progress file name",
+			args: args{
+				config:              config,
+				password:            "test",
+				address:             "0x000000000000000000000000000000000000dea1",
+				epoch:               5,
+				progressFileNameErr: errors.New("path error"),
+			},
+			wantResetDispute: true,
+			expectedFatal:    true,
+		},
+		{
+			name: "Test 6: When there is an error in clearing the giveSorted progress",
+			args: args{
+				config:           config,
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dea1",
+				epoch:            5,
+				clearProgressErr: errors.New("write error"),
+			},
+			wantResetDispute: true,
+			expectedFatal:    true,
+		},
+	}
+
+	defer func() { log.ExitFunc = nil }()
+	var fatal bool
+	log.ExitFunc = func(int) { fatal = true }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			flagSetUtils = flagSetUtilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathMock
+
+			cmdUtilsMock.On("GetConfigData").Return(tt.args.config, tt.args.configErr)
+			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagSetUtilsMock.On("GetStringAddress", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.address, tt.args.addressErr)
+			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
+			utilsMock.On("AssignPassword").Return(tt.args.password)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(txnOpts)
+			utilsMock.On("GetBlockManager", mock.AnythingOfType("*ethclient.Client")).Return(blockManager)
+			cmdUtilsMock.On("ResetDispute", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, tt.args.epoch)
+			pathMock.On("GetGiveSortedProgressFileName", mock.AnythingOfType("string")).Return("/home/data_files/giveSortedProgress.json", tt.args.progressFileNameErr)
+			utilsPkgMock.On("SaveDataToGiveSortedProgressFile", mock.AnythingOfType("string"), types.GiveSortedProgress{}).Return(tt.args.clearProgressErr)
+
+			utils := &UtilsStruct{}
+			fatal = false
+
+			utils.ExecuteResetDispute(flagSet)
+			if fatal != tt.expectedFatal {
+				t.Error("The ExecuteResetDispute function didn't execute as expected")
+			}
+			if tt.wantResetDispute {
+				cmdUtilsMock.AssertCalled(t, "ResetDispute", client, blockManager, txnOpts, tt.args.epoch)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"math/big"
 	"razor/core"
@@ -12,7 +14,9 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	solsha3 "github.com/miguelmota/go-solidity-sha3"
 )
 
 //This function handles the reveal state
@@ -35,7 +39,12 @@ func (*UtilsStruct) Reveal(client *ethclient.Client, config types.Configurations
 		return core.NilHash, err
 	}
 
-	merkleTree := utils.MerkleInterface.CreateMerkle(commitData.Leaves)
+	// The merkle tree cached at commit is reused, it is only built again if it isn't the tree of the leaves whose root is committed
+	merkleTree := commitData.MerkleTree
+	if !isMerkleTreeOfLeaves(merkleTree, commitData.Leaves) || !isCommittedMerkleRoot(client, account.Address, utils.MerkleInterface.GetMerkleRoot(merkleTree), signature) {
+		log.Debug("Merkle tree of the committed data is not cached, creating it again")
+		merkleTree = utils.MerkleInterface.CreateMerkle(commitData.Leaves)
+	}
 	treeRevealData := cmdUtils.GenerateTreeRevealData(merkleTree, commitData)
 
 	log.Debugf("Revealing vote for epoch: %d, commitAccount: %s, treeRevealData: %v, root: %v",
@@ -67,30 +76,52 @@ func (*UtilsStruct) Reveal(client *ethclient.Client, config types.Configurations
 	return transactionUtils.Hash(txn), nil
 }
 
-//This function generates the tree reveal data
+//This function returns if the merkle tree is of the leaves, the leaf level of the tree is compared with the hashes of the leaves
+func isMerkleTreeOfLeaves(merkleTree [][][]byte, leaves []*big.Int) bool {
+	if len(merkleTree) == 0 || len(leaves) == 0 || len(merkleTree[len(merkleTree)-1]) != len(leaves) {
+		return false
+	}
+	leafLevel := merkleTree[len(merkleTree)-1]
+	for i, leaf := range leaves {
+		if !bytes.Equal(leafLevel[i], solsha3.SoliditySHA3([]string{"uint256"}, []interface{}{leaf})) {
+			return false
+		}
+	}
+	return true
+}
+
+//This function returns if the root is the one committed by the staker in the epoch along with the secret derived from the signature
+func isCommittedMerkleRoot(client *ethclient.Client, address string, root [32]byte, signature []byte) bool {
+	commitment, err := razorUtils.GetCommitments(client, address)
+	if err != nil {
+		log.Error("Error in getting commitment: ", err)
+		return false
+	}
+	seed := crypto.Keccak256(signature)
+	expectedCommitment := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(root[:]), "0x" + hex.EncodeToString(seed)})
+	return bytes.Equal(expectedCommitment, commitment[:])
+}
+
+//This function generates the tree reveal data, the proofs of all the allotted collections are generated in one pass over the tree
 func (*UtilsStruct) GenerateTreeRevealData(merkleTree [][][]byte, commitData types.CommitData) bindings.StructsMerkleTree {
 	if merkleTree == nil || commitData.SeqAllottedCollections == nil || commitData.Leaves == nil {
 		log.Error("No data present for construction of StructsMerkleTree")
 		return bindings.StructsMerkleTree{}
 	}
-	var (
-		values []bindings.StructsAssignedAsset
-		proofs [][][32]byte
-	)
-
+	values := make([]bindings.StructsAssignedAsset, 0, len(commitData.SeqAllottedCollections))
+	leafIds := make([]uint16, 0, len(commitData.SeqAllottedCollections))
 	for i := 0; i < len(commitData.SeqAllottedCollections); i++ {
 		value := bindings.StructsAssignedAsset{
 			LeafId: uint16(commitData.SeqAllottedCollections[i].Uint64()),
 			Value:  big.NewInt(commitData.Leaves[commitData.SeqAllottedCollections[i].Uint64()].Int64()),
 		}
-		proof := utils.MerkleInterface.GetProofPath(merkleTree, value.LeafId)
 		values = append(values, value)
-		proofs = append(proofs, proof)
+		leafIds = append(leafIds, value.LeafId)
 	}
 
 	return bindings.StructsMerkleTree{
 		Values: values,
-		Proofs: proofs,
+		Proofs: utils.MerkleInterface.GetProofPaths(merkleTree, leafIds),
 		Root:   utils.MerkleInterface.GetMerkleRoot(merkleTree),
 	}
 }
@@ -108,7 +139,7 @@ func (*UtilsStruct) IndexRevealEventsOfCurrentEpoch(client *ethclient.Client, bl
 			common.HexToAddress(core.VoteManagerAddress),
 		},
 	}
-	logs, err := utils.UtilsInterface.FilterLogsWithRetry(client, query)
+	logs, err := utils.UtilsInterface.FilterLogsInChunks(client, query)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +170,10 @@ func (*UtilsStruct) IndexRevealEventsOfCurrentEpoch(client *ethclient.Client, bl
 				RevealedValues: revealedValues,
 				Influence:      data[1].(*big.Int),
 			}
+			// topics[1] gives the staker id of the revealer
+			if len(vLog.Topics) > 1 {
+				consolidatedRevealedData.StakerId = uint32(vLog.Topics[1].Big().Uint64())
+			}
 			revealedData = append(revealedData, consolidatedRevealedData)
 		}
 	}
@@ -4,13 +4,16 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	solsha3 "github.com/miguelmota/go-solidity-sha3"
 	"github.com/stretchr/testify/mock"
 	"math/big"
 	"razor/cmd/mocks"
@@ -94,7 +97,6 @@ func TestHandleRevealState(t *testing.T) {
 
 func TestReveal(t *testing.T) {
 	var client *ethclient.Client
-	var commitData types.CommitData
 	var signature []byte
 	var account types.Account
 	var config types.Configurations
@@ -103,9 +105,18 @@ func TestReveal(t *testing.T) {
 	privateKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
 
+	leaves := []*big.Int{big.NewInt(1), big.NewInt(2)}
+	merkleTree := (&utils2.MerkleTreeStruct{}).CreateMerkle(leaves)
+	root := (&utils2.MerkleTreeStruct{}).GetMerkleRoot(merkleTree)
+	var commitment [32]byte
+	copy(commitment[:], solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(root[:]), "0x" + hex.EncodeToString(crypto.Keccak256(signature))}))
+
 	type args struct {
 		state          int64
 		stateErr       error
+		commitData     types.CommitData
+		commitment     [32]byte
+		commitmentErr  error
 		merkleTree     [][][]byte
 		treeRevealData bindings.StructsMerkleTree
 		txnOpts        *bind.TransactOpts
@@ -157,6 +168,73 @@ func TestReveal(t *testing.T) {
 			want:    core.NilHash,
 			wantErr: errors.New("reveal error"),
 		},
+		{
+			name: "Test 7: When the merkle tree of the committed data is cached",
+			args: args{
+				state: 1,
+				commitData: types.CommitData{
+					Leaves:     leaves,
+					MerkleTree: merkleTree,
+				},
+				commitment: commitment,
+				txnOpts:    txnOpts,
+				revealTxn:  &Types.Transaction{},
+				hash:       common.BigToHash(big.NewInt(1)),
+			},
+			want:    common.BigToHash(big.NewInt(1)),
+			wantErr: nil,
+		},
+		{
+			name: "Test 8: When the cached merkle tree is of other leaves with the same number of leaves",
+			args: args{
+				state: 1,
+				commitData: types.CommitData{
+					Leaves:     []*big.Int{big.NewInt(1), big.NewInt(3)},
+					MerkleTree: merkleTree,
+				},
+				commitment: commitment,
+				merkleTree: (&utils2.MerkleTreeStruct{}).CreateMerkle([]*big.Int{big.NewInt(1), big.NewInt(3)}),
+				txnOpts:    txnOpts,
+				revealTxn:  &Types.Transaction{},
+				hash:       common.BigToHash(big.NewInt(1)),
+			},
+			want:    common.BigToHash(big.NewInt(1)),
+			wantErr: nil,
+		},
+		{
+			name: "Test 9: When the root of the cached merkle tree is not committed",
+			args: args{
+				state: 1,
+				commitData: types.CommitData{
+					Leaves:     leaves,
+					MerkleTree: merkleTree,
+				},
+				commitment: common.BigToHash(big.NewInt(1)),
+				merkleTree: merkleTree,
+				txnOpts:    txnOpts,
+				revealTxn:  &Types.Transaction{},
+				hash:       common.BigToHash(big.NewInt(1)),
+			},
+			want:    common.BigToHash(big.NewInt(1)),
+			wantErr: nil,
+		},
+		{
+			name: "Test 10: When there is an error in getting the commitment of the cached merkle tree",
+			args: args{
+				state: 1,
+				commitData: types.CommitData{
+					Leaves:     leaves,
+					MerkleTree: merkleTree,
+				},
+				commitmentErr: errors.New("commitment error"),
+				merkleTree:    merkleTree,
+				txnOpts:       txnOpts,
+				revealTxn:     &Types.Transaction{},
+				hash:          common.BigToHash(big.NewInt(1)),
+			},
+			want:    common.BigToHash(big.NewInt(1)),
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -174,7 +252,9 @@ func TestReveal(t *testing.T) {
 			utils2.MerkleInterface = merkleInterface
 
 			utilsMock.On("GetDelayedState", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int32")).Return(tt.args.state, tt.args.stateErr)
+			utilsMock.On("GetCommitments", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.commitment, tt.args.commitmentErr)
 			merkleInterface.On("CreateMerkle", mock.Anything).Return(tt.args.merkleTree)
+			merkleInterface.On("GetMerkleRoot", mock.Anything).Return(root)
 			cmdUtilsMock.On("GenerateTreeRevealData", mock.Anything, mock.Anything).Return(tt.args.treeRevealData)
 			utilsMock.On("GetTxnOpts", mock.AnythingOfType("types.TransactionOptions")).Return(tt.args.txnOpts)
 			voteManagerUtilsMock.On("Reveal", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*bind.TransactOpts"), mock.AnythingOfType("uint32"), mock.Anything, mock.Anything).Return(tt.args.revealTxn, tt.args.revealErr)
@@ -182,7 +262,15 @@ func TestReveal(t *testing.T) {
 
 			utils := &UtilsStruct{}
 
-			got, err := utils.Reveal(client, config, account, epoch, commitData, signature)
+			got, err := utils.Reveal(client, config, account, epoch, tt.args.commitData, signature)
+			if tt.args.merkleTree == nil && tt.args.commitData.MerkleTree != nil {
+				merkleInterface.AssertNotCalled(t, "CreateMerkle", mock.Anything)
+				cmdUtilsMock.AssertCalled(t, "GenerateTreeRevealData", tt.args.commitData.MerkleTree, tt.args.commitData)
+			}
+			if tt.args.merkleTree != nil && tt.args.commitData.MerkleTree != nil {
+				merkleInterface.AssertCalled(t, "CreateMerkle", tt.args.commitData.Leaves)
+				cmdUtilsMock.AssertCalled(t, "GenerateTreeRevealData", tt.args.merkleTree, tt.args.commitData)
+			}
 			if got != tt.want {
 				t.Errorf("Txn hash for Reveal function, got = %v, want = %v", got, tt.want)
 			}
@@ -203,7 +291,7 @@ func TestGenerateTreeRevealData(t *testing.T) {
 	type args struct {
 		merkleTree [][][]byte
 		commitData types.CommitData
-		proof      [][32]byte
+		proofs     [][][32]byte
 		root       [32]byte
 	}
 	tests := []struct {
@@ -228,8 +316,8 @@ func TestGenerateTreeRevealData(t *testing.T) {
 					SeqAllottedCollections: []*big.Int{big.NewInt(1)},
 					Leaves:                 []*big.Int{big.NewInt(1), big.NewInt(2)},
 				},
-				proof: [][32]byte{},
-				root:  [32]byte{},
+				proofs: [][][32]byte{{}},
+				root:   [32]byte{},
 			},
 			want: bindings.StructsMerkleTree{
 				Values: []bindings.StructsAssignedAsset{{LeafId: 1, Value: big.NewInt(2)}},
@@ -244,7 +332,7 @@ func TestGenerateTreeRevealData(t *testing.T) {
 
 			utils2.MerkleInterface = merkleInterface
 
-			merkleInterface.On("GetProofPath", mock.Anything, mock.Anything).Return(tt.args.proof)
+			merkleInterface.On("GetProofPaths", mock.Anything, mock.Anything).Return(tt.args.proofs)
 			merkleInterface.On("GetMerkleRoot", mock.Anything).Return(tt.args.root)
 			ut := &UtilsStruct{}
 			if got := ut.GenerateTreeRevealData(tt.args.merkleTree, tt.args.commitData); !reflect.DeepEqual(got, tt.want) {
@@ -325,7 +413,7 @@ func TestIndexRevealEventsOfCurrentEpoch(t *testing.T) {
 			utils2.ABIInterface = abiUtilsMock
 
 			utilsPkgMock.On("CalculateBlockNumberAtEpochBeginning", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.fromBlock, tt.args.fromBlockErr)
-			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
+			utilsPkgMock.On("FilterLogsInChunks", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
 			abiUtilsMock.On("Parse", mock.Anything).Return(tt.args.contractAbi, tt.args.contractAbiErr)
 			abiUtilsMock.On("Unpack", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.data, tt.args.unpackErr)
 			ut := &UtilsStruct{}
@@ -357,7 +445,7 @@ func BenchmarkGenerateTreeRevealData(b *testing.B) {
 			merkleInterface := new(mocks2.MerkleTreeInterface)
 			utils2.MerkleInterface = merkleInterface
 
-			merkleInterface.On("GetProofPath", mock.Anything, mock.Anything).Return([][32]byte{[32]byte{1, 2, 3}, {4, 5, 6}})
+			merkleInterface.On("GetProofPaths", mock.Anything, mock.Anything).Return(make([][][32]byte, v.numOfAllottedCollections))
 			merkleInterface.On("GetMerkleRoot", mock.Anything).Return([32]byte{100})
 
 			ut := &UtilsStruct{}
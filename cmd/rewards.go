@@ -0,0 +1,463 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/pkg/bindings"
+	"razor/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	rewardsEpochPeriod = "epoch"
+	rewardsDayPeriod   = "day"
+	rewardsWeekPeriod  = "week"
+
+	//Status of the ledger records of the stake changes read from the chain
+	stakeChangeStatus = "emitted"
+)
+
+//Names of the reasons of the StakeChange event of the stake manager
+var stakeChangeReasons = map[uint8]string{
+	0: "blockReward",
+	1: "inactivityPenalty",
+	2: "slashed",
+}
+
+var rewardsCmd = &cobra.Command{
+	Use:   "rewards",
+	Short: "show the rewards and the profitability of the staker",
+	Long: `Shows the staking rewards, penalties, bounties claimed, gas spent and net profitability of the staker per epoch, day or week.
+The stake changes are read from the chain into the local ledger once and the gas is taken from the receipts of the transactions in the ledger.
+With a quote, the net profitability is also shown in that currency at the current prices.
+
+Example:
+  ./razor rewards --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --days 30
+  ./razor rewards --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --days 90 --period week --quote usd`,
+	Run: initialiseRewards,
+}
+
+//This function initialises the ExecuteRewards function
+func initialiseRewards(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteRewards(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetRewards function
+func (*UtilsStruct) ExecuteRewards(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	days, err := flagSetUtils.GetUint32Days(flagSet)
+	utils.CheckError("Error in getting days: ", err)
+
+	period, err := flagSetUtils.GetStringRewardsPeriod(flagSet)
+	utils.CheckError("Error in getting period: ", err)
+
+	quote, err := flagSetUtils.GetStringQuote(flagSet)
+	utils.CheckError("Error in getting quote: ", err)
+
+	gasTokenId, err := flagSetUtils.GetStringGasTokenId(flagSet)
+	utils.CheckError("Error in getting gas token id: ", err)
+
+	report, err := cmdUtils.GetRewards(client, address, days, period)
+	utils.CheckError("Error in getting rewards: ", err)
+
+	if quote != "" {
+		err = setRewardsQuote(&report, quote, gasTokenId)
+		utils.CheckError("Error in getting prices: ", err)
+	}
+
+	if utils.IsJsonOutput() {
+		utils.CheckError("Error in printing rewards: ", utils.PrintJson(report))
+		return
+	}
+	printRewards(report)
+}
+
+//This function returns the rewards, penalties, bounties and gas of the address over the last days grouped by the period
+//The stake changes of the staker in the days which aren't in the ledger yet are read from the chain first
+func (*UtilsStruct) GetRewards(client *ethclient.Client, address string, days uint32, period string) (types.RewardsReport, error) {
+	if !common.IsHexAddress(address) {
+		return types.RewardsReport{}, errors.New("invalid address")
+	}
+	if days == 0 {
+		return types.RewardsReport{}, errors.New("days should be greater than 0")
+	}
+	if period != rewardsEpochPeriod && period != rewardsDayPeriod && period != rewardsWeekPeriod {
+		return types.RewardsReport{}, fmt.Errorf("invalid period %s, it should be %s, %s or %s", period, rewardsEpochPeriod, rewardsDayPeriod, rewardsWeekPeriod)
+	}
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return types.RewardsReport{}, err
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return types.RewardsReport{}, err
+	}
+	var fromEpoch uint32
+	epochsInRange := uint32(int64(days) * 24 * 60 * 60 / core.EpochLength)
+	if epoch > epochsInRange {
+		fromEpoch = epoch - epochsInRange
+	}
+	blocksInRange := uint64(time.Duration(days) * 24 * time.Hour / utils.UtilsInterface.GetAverageBlockTime(client))
+	var fromBlock uint64
+	if latestHeader.Number.Uint64() > blocksInRange {
+		fromBlock = latestHeader.Number.Uint64() - blocksInRange
+	}
+
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		return types.RewardsReport{}, err
+	}
+	if stakerId != 0 {
+		err = cmdUtils.SyncStakeChanges(client, address, stakerId, fromBlock, latestHeader.Number.Uint64())
+		if err != nil {
+			return types.RewardsReport{}, err
+		}
+	}
+	records, err := utils.UtilsInterface.ReadLedger(address, fromEpoch)
+	if err != nil {
+		return types.RewardsReport{}, err
+	}
+	fillLedgerGasCost(client, address, records)
+
+	periods, total := aggregateRewards(records, period)
+	return types.RewardsReport{
+		Address: address,
+		Period:  period,
+		Periods: periods,
+		Total:   total,
+	}, nil
+}
+
+//This function records the StakeChange events of the staker between the blocks in the ledger of the address
+//Only the blocks out of the range which is already synced are read from the chain
+func (*UtilsStruct) SyncStakeChanges(client *ethclient.Client, address string, stakerId uint32, fromBlock uint64, toBlock uint64) error {
+	syncRange, err := utils.UtilsInterface.GetStakeChangeSyncRange(address)
+	if err != nil {
+		return err
+	}
+	gaps, syncRange := getStakeChangeSyncGaps(syncRange, fromBlock, toBlock)
+	for _, gap := range gaps {
+		if err := recordStakeChanges(client, address, stakerId, gap.FromBlock, gap.ToBlock); err != nil {
+			return err
+		}
+	}
+	return utils.UtilsInterface.SaveStakeChangeSyncRange(address, syncRange)
+}
+
+//This function returns the ranges of blocks between fromBlock and toBlock which are out of the synced range and the synced range including them
+func getStakeChangeSyncGaps(syncRange types.StakeChangeSyncRange, fromBlock uint64, toBlock uint64) ([]types.StakeChangeSyncRange, types.StakeChangeSyncRange) {
+	if syncRange.ToBlock == 0 {
+		newRange := types.StakeChangeSyncRange{FromBlock: fromBlock, ToBlock: toBlock}
+		return []types.StakeChangeSyncRange{newRange}, newRange
+	}
+	var gaps []types.StakeChangeSyncRange
+	if fromBlock < syncRange.FromBlock {
+		gaps = append(gaps, types.StakeChangeSyncRange{FromBlock: fromBlock, ToBlock: syncRange.FromBlock - 1})
+		syncRange.FromBlock = fromBlock
+	}
+	if toBlock > syncRange.ToBlock {
+		gaps = append(gaps, types.StakeChangeSyncRange{FromBlock: syncRange.ToBlock + 1, ToBlock: toBlock})
+		syncRange.ToBlock = toBlock
+	}
+	return gaps, syncRange
+}
+
+//This function reads the StakeChange events of the staker between the blocks and saves them as records in the ledger of the address
+func recordStakeChanges(client *ethclient.Client, address string, stakerId uint32, fromBlock uint64, toBlock uint64) error {
+	contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(bindings.StakeManagerABI))
+	if err != nil {
+		return err
+	}
+	stakeChangeEvent, ok := contractAbi.Events["StakeChange"]
+	if !ok {
+		return errors.New("StakeChange event not found in stake manager ABI")
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: []common.Address{common.HexToAddress(core.StakeManagerAddress)},
+		Topics:    [][]common.Hash{{stakeChangeEvent.ID}, {common.BigToHash(big.NewInt(int64(stakerId)))}},
+	}
+	logIterator := utils.NewLogIterator(client, query)
+	for logIterator.Next() {
+		vLog := logIterator.Log()
+		_, args, err := decodeActivityEvent(contractAbi, vLog)
+		if err != nil {
+			log.Debugf("Error in decoding StakeChange event of transaction %s: %s", vLog.TxHash.Hex(), err)
+			continue
+		}
+		record, err := getStakeChangeRecord(args, vLog.BlockNumber, vLog.TxHash.Hex())
+		if err != nil {
+			log.Debugf("Error in reading StakeChange event of transaction %s: %s", vLog.TxHash.Hex(), err)
+			continue
+		}
+		if err := utils.UtilsInterface.SaveLedgerRecord(address, record); err != nil {
+			return err
+		}
+	}
+	return logIterator.Error()
+}
+
+//This function returns the ledger record of the decoded StakeChange event
+//The previous and the new stake are kept as the values and the change as the amount
+func getStakeChangeRecord(args map[string]interface{}, blockNumber uint64, txnHash string) (types.LedgerRecord, error) {
+	epoch, ok := args["epoch"].(uint32)
+	if !ok {
+		return types.LedgerRecord{}, errors.New("invalid epoch")
+	}
+	reason, ok := args["reason"].(uint8)
+	if !ok {
+		return types.LedgerRecord{}, errors.New("invalid reason")
+	}
+	prevStake, ok := args["prevStake"].(*big.Int)
+	if !ok {
+		return types.LedgerRecord{}, errors.New("invalid previous stake")
+	}
+	newStake, ok := args["newStake"].(*big.Int)
+	if !ok {
+		return types.LedgerRecord{}, errors.New("invalid new stake")
+	}
+	reasonName, ok := stakeChangeReasons[reason]
+	if !ok {
+		reasonName = "stakeChange"
+	}
+	var timestamp int64
+	if eventTimestamp, ok := args["timestamp"].(*big.Int); ok {
+		timestamp = eventTimestamp.Int64()
+	}
+	return types.LedgerRecord{
+		Epoch:     epoch,
+		Action:    fmt.Sprintf("%s:%d", reasonName, blockNumber),
+		Values:    []string{prevStake.String(), newStake.String()},
+		TxnHash:   txnHash,
+		Status:    stakeChangeStatus,
+		Amount:    utils.GetAmountInDecimal(new(big.Int).Sub(newStake, prevStake)).String(),
+		Timestamp: timestamp,
+	}, nil
+}
+
+//This function records the stake changes of the staker in the ledger once every epoch until the context is done
+//It keeps the ledger up to date while voting, so that the rewards command doesn't have to read a long range of blocks
+func (*UtilsStruct) AccumulateRewards(ctx context.Context, client *ethclient.Client, address string) {
+	var lastSyncEpoch uint32
+	for {
+		epoch, err := razorUtils.GetEpoch(client)
+		if err != nil {
+			log.Error("Error in getting epoch: ", err)
+		} else if epoch > lastSyncEpoch {
+			if err := syncLatestStakeChanges(client, address); err != nil {
+				log.Error("Error in recording stake changes: ", err)
+			} else {
+				lastSyncEpoch = epoch
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(core.StateLength) * time.Second):
+		}
+	}
+}
+
+//This function records the stake changes of the staker of the address up to the latest block
+//The blocks since the last synced block are read, or the blocks of the last epoch if nothing is synced yet
+func syncLatestStakeChanges(client *ethclient.Client, address string) error {
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		return err
+	}
+	if stakerId == 0 {
+		return nil
+	}
+	latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return err
+	}
+	blocksInEpoch := uint64(time.Duration(core.EpochLength) * time.Second / utils.UtilsInterface.GetAverageBlockTime(client))
+	var fromBlock uint64
+	if latestHeader.Number.Uint64() > blocksInEpoch {
+		fromBlock = latestHeader.Number.Uint64() - blocksInEpoch
+	}
+	return cmdUtils.SyncStakeChanges(client, address, stakerId, fromBlock, latestHeader.Number.Uint64())
+}
+
+//This function adds up the ledger records in the periods they belong to and in the total
+//The records are sorted by epoch, so the records of a period are next to each other
+func aggregateRewards(records []types.LedgerRecord, period string) ([]types.RewardsPeriod, types.RewardsPeriod) {
+	var periods []types.RewardsPeriod
+	total := newRewardsPeriod("total")
+	for _, record := range records {
+		name := getRewardsPeriodName(record.Epoch, period)
+		if len(periods) == 0 || periods[len(periods)-1].Period != name {
+			periods = append(periods, newRewardsPeriod(name))
+		}
+		addLedgerRecordToPeriod(&periods[len(periods)-1], record)
+		addLedgerRecordToPeriod(&total, record)
+	}
+	return periods, total
+}
+
+//This function returns a period with all the amounts set to zero
+func newRewardsPeriod(name string) types.RewardsPeriod {
+	return types.RewardsPeriod{
+		Period:    name,
+		Rewards:   new(big.Float),
+		Penalties: new(big.Float),
+		Bounties:  new(big.Float),
+		GasCost:   new(big.Float),
+		NetRazor:  new(big.Float),
+	}
+}
+
+//This function returns the name of the period of the epoch, the days and weeks are in UTC
+func getRewardsPeriodName(epoch uint32, period string) string {
+	start := time.Unix(int64(epoch)*core.EpochLength, 0).UTC()
+	switch period {
+	case rewardsDayPeriod:
+		return start.Format("2006-01-02")
+	case rewardsWeekPeriod:
+		year, week := start.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	default:
+		return strconv.FormatUint(uint64(epoch), 10)
+	}
+}
+
+//This function adds the stake change, the bounty claimed and the gas of the ledger record to the period
+//The net profitability in RAZOR doesn't include the gas as it is paid in the gas token of the chain
+func addLedgerRecordToPeriod(period *types.RewardsPeriod, record types.LedgerRecord) {
+	switch {
+	case record.Status == stakeChangeStatus && len(record.Values) == 2:
+		prevStake, prevOk := new(big.Int).SetString(record.Values[0], 10)
+		newStake, newOk := new(big.Int).SetString(record.Values[1], 10)
+		if !prevOk || !newOk {
+			return
+		}
+		change := utils.GetAmountInDecimal(new(big.Int).Sub(newStake, prevStake))
+		if change.Sign() >= 0 {
+			period.Rewards.Add(period.Rewards, change)
+		} else {
+			period.Penalties.Sub(period.Penalties, change)
+		}
+		period.NetRazor.Add(period.NetRazor, change)
+	case strings.HasPrefix(record.Action, "claimBounty") && record.Status == "mined":
+		if amount, ok := new(big.Float).SetString(record.Amount); ok {
+			period.Bounties.Add(period.Bounties, amount)
+			period.NetRazor.Add(period.NetRazor, amount)
+		}
+	}
+	if gasCost, ok := new(big.Int).SetString(record.GasCost, 10); ok {
+		period.GasCost.Add(period.GasCost, utils.GetAmountInDecimal(gasCost))
+	}
+}
+
+//This function fetches the current prices of RAZOR and of the gas token in the quote and sets the net profitability in the quote
+//The gas isn't priced if the gas token id isn't given, as the gas of the SKALE chains is free
+func setRewardsQuote(report *types.RewardsReport, quote string, gasTokenId string) error {
+	quote = strings.ToLower(quote)
+	ids := core.RazorPriceId
+	if gasTokenId != "" {
+		ids = ids + "," + gasTokenId
+	}
+	response, err := utils.UtilsInterface.GetDataFromAPI(fmt.Sprintf("%s?ids=%s&vs_currencies=%s", core.PriceApiUrl, ids, quote), nil)
+	if err != nil {
+		return err
+	}
+	var prices map[string]map[string]float64
+	if err := json.Unmarshal(response, &prices); err != nil {
+		return err
+	}
+	razorPrice, ok := prices[core.RazorPriceId][quote]
+	if !ok {
+		return fmt.Errorf("price of RAZOR in %s not found", quote)
+	}
+	var gasTokenPrice float64
+	if gasTokenId != "" {
+		gasTokenPrice, ok = prices[gasTokenId][quote]
+		if !ok {
+			return fmt.Errorf("price of %s in %s not found", gasTokenId, quote)
+		}
+	}
+	report.Quote = quote
+	report.RazorPrice = razorPrice
+	report.GasTokenPrice = gasTokenPrice
+	for i := range report.Periods {
+		setNetQuote(&report.Periods[i], razorPrice, gasTokenPrice)
+	}
+	setNetQuote(&report.Total, razorPrice, gasTokenPrice)
+	return nil
+}
+
+//This function sets the net profitability of the period in the quote, which includes the gas
+func setNetQuote(period *types.RewardsPeriod, razorPrice float64, gasTokenPrice float64) {
+	netQuote := new(big.Float).Mul(period.NetRazor, big.NewFloat(razorPrice))
+	period.NetQuote = netQuote.Sub(netQuote, new(big.Float).Mul(period.GasCost, big.NewFloat(gasTokenPrice)))
+}
+
+//This function prints the rewards of the periods and their total in a table
+func printRewards(report types.RewardsReport) {
+	if len(report.Periods) == 0 {
+		log.Infof("No rewards found for %s", report.Address)
+		return
+	}
+	header := []string{"Period", "Rewards (RZR)", "Penalties (RZR)", "Bounties (RZR)", "Gas Cost", "Net (RZR)"}
+	if report.Quote != "" {
+		header = append(header, fmt.Sprintf("Net (%s)", strings.ToUpper(report.Quote)))
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+	for _, period := range append(report.Periods, report.Total) {
+		row := []string{period.Period, period.Rewards.String(), period.Penalties.String(), period.Bounties.String(), period.GasCost.String(), period.NetRazor.String()}
+		if report.Quote != "" {
+			row = append(row, period.NetQuote.Text('f', 2))
+		}
+		table.Append(row)
+	}
+	table.Render()
+}
+
+func init() {
+	rootCmd.AddCommand(rewardsCmd)
+
+	var (
+		Address    string
+		Days       uint32
+		Period     string
+		Quote      string
+		GasTokenId string
+	)
+
+	rewardsCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+	rewardsCmd.Flags().Uint32VarP(&Days, "days", "", 30, "number of latest days to show")
+	rewardsCmd.Flags().StringVarP(&Period, "period", "", rewardsDayPeriod, "period to group the rewards by (epoch, day or week)")
+	rewardsCmd.Flags().StringVarP(&Quote, "quote", "", "", "fiat currency to show the net profitability in at the current prices, e.g. usd")
+	rewardsCmd.Flags().StringVarP(&GasTokenId, "gasTokenId", "", "", "CoinGecko id of the gas token to price the gas in the quote, the gas isn't priced if it isn't set")
+
+	addrErr := rewardsCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
@@ -0,0 +1,441 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+const rewardsTestAbi = `[
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"epoch","type":"uint32"},{"indexed":true,"name":"stakerId","type":"uint32"},{"indexed":false,"name":"reason","type":"uint8"},{"indexed":false,"name":"prevStake","type":"uint256"},{"indexed":false,"name":"newStake","type":"uint256"},{"indexed":false,"name":"timestamp","type":"uint256"}],"name":"StakeChange","type":"event"}
+]`
+
+//This function returns the amount in RZR as a big float
+func getRazorAmount(amount string) *big.Float {
+	value, _ := new(big.Float).SetString(amount)
+	return value
+}
+
+func TestGetRewards(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+	records := []types.LedgerRecord{
+		{Epoch: 100, Action: "blockReward:50", Values: []string{"1000000000000000000000", "1002000000000000000000"}, Status: stakeChangeStatus},
+		{Epoch: 100, Action: "commit", TxnHash: "0x01", Status: "mined", GasUsed: 50000, GasCost: "500000000000000"},
+		{Epoch: 101, Action: "claimBounty", TxnHash: "0x02", Status: "mined", Amount: "3.5", GasUsed: 50000, GasCost: "500000000000000"},
+	}
+
+	type args struct {
+		address        string
+		days           uint32
+		period         string
+		latestBlockErr error
+		epochErr       error
+		stakerId       uint32
+		stakerIdErr    error
+		syncErr        error
+		ledgerErr      error
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     types.RewardsReport
+		wantSync bool
+		wantErr  bool
+	}{
+		{
+			name: "Test 1: When the rewards of the staker are grouped by epoch",
+			args: args{
+				address:  address,
+				days:     1,
+				period:   rewardsEpochPeriod,
+				stakerId: 3,
+			},
+			want: types.RewardsReport{
+				Address: address,
+				Period:  rewardsEpochPeriod,
+				Periods: []types.RewardsPeriod{
+					{Period: "100", Rewards: getRazorAmount("2"), Penalties: new(big.Float), Bounties: new(big.Float), GasCost: getRazorAmount("0.0005"), NetRazor: getRazorAmount("2")},
+					{Period: "101", Rewards: new(big.Float), Penalties: new(big.Float), Bounties: getRazorAmount("3.5"), GasCost: getRazorAmount("0.0005"), NetRazor: getRazorAmount("3.5")},
+				},
+				Total: types.RewardsPeriod{Period: "total", Rewards: getRazorAmount("2"), Penalties: new(big.Float), Bounties: getRazorAmount("3.5"), GasCost: getRazorAmount("0.001"), NetRazor: getRazorAmount("5.5")},
+			},
+			wantSync: true,
+			wantErr:  false,
+		},
+		{
+			name: "Test 2: When the address isn't a staker, the stake changes aren't synced",
+			args: args{
+				address: address,
+				days:    1,
+				period:  rewardsDayPeriod,
+			},
+			want: types.RewardsReport{
+				Address: address,
+				Period:  rewardsDayPeriod,
+				Periods: []types.RewardsPeriod{
+					{Period: getRewardsPeriodName(100, rewardsDayPeriod), Rewards: getRazorAmount("2"), Penalties: new(big.Float), Bounties: getRazorAmount("3.5"), GasCost: getRazorAmount("0.001"), NetRazor: getRazorAmount("5.5")},
+				},
+				Total: types.RewardsPeriod{Period: "total", Rewards: getRazorAmount("2"), Penalties: new(big.Float), Bounties: getRazorAmount("3.5"), GasCost: getRazorAmount("0.001"), NetRazor: getRazorAmount("5.5")},
+			},
+			wantSync: false,
+			wantErr:  false,
+		},
+		{
+			name: "Test 3: When the address is invalid",
+			args: args{
+				address: "0x123",
+				days:    1,
+				period:  rewardsDayPeriod,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the period is invalid",
+			args: args{
+				address: address,
+				days:    1,
+				period:  "month",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When days is 0",
+			args: args{
+				address: address,
+				period:  rewardsDayPeriod,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting latest block",
+			args: args{
+				address:        address,
+				days:           1,
+				period:         rewardsDayPeriod,
+				latestBlockErr: errors.New("block error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting epoch",
+			args: args{
+				address:  address,
+				days:     1,
+				period:   rewardsDayPeriod,
+				epochErr: errors.New("epoch error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting staker id",
+			args: args{
+				address:     address,
+				days:        1,
+				period:      rewardsDayPeriod,
+				stakerIdErr: errors.New("stakerId error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When there is an error in syncing the stake changes",
+			args: args{
+				address:  address,
+				days:     1,
+				period:   rewardsDayPeriod,
+				stakerId: 3,
+				syncErr:  errors.New("sync error"),
+			},
+			wantSync: true,
+			wantErr:  true,
+		},
+		{
+			name: "Test 10: When there is an error in reading the ledger",
+			args: args{
+				address:   address,
+				days:      1,
+				period:    rewardsDayPeriod,
+				ledgerErr: errors.New("ledger error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(100000)}, tt.args.latestBlockErr)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(uint32(200), tt.args.epochErr)
+			utilsPkgMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), tt.args.address).Return(tt.args.stakerId, tt.args.stakerIdErr)
+			cmdUtilsMock.On("SyncStakeChanges", mock.AnythingOfType("*ethclient.Client"), tt.args.address, tt.args.stakerId, uint64(100000-24*60*60), uint64(100000)).Return(tt.args.syncErr)
+			utilsPkgMock.On("ReadLedger", tt.args.address, uint32(200-24*60*60/core.EpochLength)).Return(records, tt.args.ledgerErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetRewards(client, tt.args.address, tt.args.days, tt.args.period)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRewards() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// The amounts are compared by their value, as the big floats of the same value can differ in their precision
+			if !tt.wantErr && fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("GetRewards() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantSync {
+				cmdUtilsMock.AssertCalled(t, "SyncStakeChanges", mock.AnythingOfType("*ethclient.Client"), tt.args.address, tt.args.stakerId, uint64(100000-24*60*60), uint64(100000))
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "SyncStakeChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestSyncStakeChanges(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	contractAbi, err := abi.JSON(strings.NewReader(rewardsTestAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakeChange := contractAbi.Events["StakeChange"]
+	rewardData, err := stakeChange.Inputs.NonIndexed().Pack(uint32(100), uint8(0), big.NewInt(1000), big.NewInt(1200), big.NewInt(1700000000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	penaltyData, err := stakeChange.Inputs.NonIndexed().Pack(uint32(101), uint8(1), big.NewInt(1200), big.NewInt(1100), big.NewInt(1700001200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := []Types.Log{
+		{Topics: []common.Hash{stakeChange.ID, common.BigToHash(big.NewInt(3))}, Data: rewardData, BlockNumber: 150, TxHash: common.HexToHash("0xa1")},
+		{Topics: []common.Hash{stakeChange.ID, common.BigToHash(big.NewInt(3))}, Data: penaltyData, BlockNumber: 160, TxHash: common.HexToHash("0xb1")},
+	}
+
+	type args struct {
+		syncRange      types.StakeChangeSyncRange
+		syncRangeErr   error
+		contractAbiErr error
+		logsErr        error
+		saveErr        error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantRecords   []types.LedgerRecord
+		wantSyncRange types.StakeChangeSyncRange
+		wantErr       bool
+	}{
+		{
+			name: "Test 1: When the stake changes are recorded in the ledger",
+			args: args{
+				syncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 140},
+			},
+			wantRecords: []types.LedgerRecord{
+				{Epoch: 100, Action: "blockReward:150", Values: []string{"1000", "1200"}, TxnHash: common.HexToHash("0xa1").Hex(), Status: stakeChangeStatus, Amount: "2e-16", Timestamp: 1700000000},
+				{Epoch: 101, Action: "inactivityPenalty:160", Values: []string{"1200", "1100"}, TxnHash: common.HexToHash("0xb1").Hex(), Status: stakeChangeStatus, Amount: "-1e-16", Timestamp: 1700001200},
+			},
+			wantSyncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200},
+			wantErr:       false,
+		},
+		{
+			name: "Test 2: When the blocks are already synced",
+			args: args{
+				syncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 300},
+			},
+			wantSyncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 300},
+			wantErr:       false,
+		},
+		{
+			name: "Test 3: When there is an error in getting the synced range",
+			args: args{
+				syncRangeErr: errors.New("sync range error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in parsing contract abi",
+			args: args{
+				contractAbiErr: errors.New("abi error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in fetching logs",
+			args: args{
+				logsErr: errors.New("logs error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in saving a record in the ledger",
+			args: args{
+				saveErr: errors.New("save error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			abiUtilsMock := new(mocks2.ABIUtils)
+
+			utils.UtilsInterface = utilsPkgMock
+			utils.ABIInterface = abiUtilsMock
+
+			utilsPkgMock.On("GetStakeChangeSyncRange", address).Return(tt.args.syncRange, tt.args.syncRangeErr)
+			abiUtilsMock.On("Parse", mock.Anything).Return(contractAbi, tt.args.contractAbiErr)
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(logs, tt.args.logsErr).Once()
+			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return([]Types.Log{}, nil)
+			utilsPkgMock.On("SaveLedgerRecord", address, mock.AnythingOfType("types.LedgerRecord")).Return(tt.args.saveErr)
+			utilsPkgMock.On("SaveStakeChangeSyncRange", address, mock.AnythingOfType("types.StakeChangeSyncRange")).Return(nil)
+
+			ut := &UtilsStruct{}
+			err := ut.SyncStakeChanges(client, address, 3, 150, 200)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SyncStakeChanges() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				utilsPkgMock.AssertNotCalled(t, "SaveStakeChangeSyncRange", mock.Anything, mock.Anything)
+				return
+			}
+			for _, record := range tt.wantRecords {
+				utilsPkgMock.AssertCalled(t, "SaveLedgerRecord", address, record)
+			}
+			if tt.wantRecords == nil {
+				utilsPkgMock.AssertNotCalled(t, "SaveLedgerRecord", mock.Anything, mock.Anything)
+			}
+			utilsPkgMock.AssertCalled(t, "SaveStakeChangeSyncRange", address, tt.wantSyncRange)
+		})
+	}
+}
+
+func TestGetStakeChangeSyncGaps(t *testing.T) {
+	tests := []struct {
+		name          string
+		syncRange     types.StakeChangeSyncRange
+		fromBlock     uint64
+		toBlock       uint64
+		wantGaps      []types.StakeChangeSyncRange
+		wantSyncRange types.StakeChangeSyncRange
+	}{
+		{
+			name:          "Test 1: When nothing is synced yet",
+			fromBlock:     100,
+			toBlock:       200,
+			wantGaps:      []types.StakeChangeSyncRange{{FromBlock: 100, ToBlock: 200}},
+			wantSyncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200},
+		},
+		{
+			name:          "Test 2: When the blocks are on both sides of the synced range",
+			syncRange:     types.StakeChangeSyncRange{FromBlock: 120, ToBlock: 180},
+			fromBlock:     100,
+			toBlock:       200,
+			wantGaps:      []types.StakeChangeSyncRange{{FromBlock: 100, ToBlock: 119}, {FromBlock: 181, ToBlock: 200}},
+			wantSyncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200},
+		},
+		{
+			name:          "Test 3: When the blocks are in the synced range",
+			syncRange:     types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200},
+			fromBlock:     120,
+			toBlock:       180,
+			wantGaps:      nil,
+			wantSyncRange: types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gaps, syncRange := getStakeChangeSyncGaps(tt.syncRange, tt.fromBlock, tt.toBlock)
+			if !reflect.DeepEqual(gaps, tt.wantGaps) {
+				t.Errorf("getStakeChangeSyncGaps() gaps = %v, want %v", gaps, tt.wantGaps)
+			}
+			if syncRange != tt.wantSyncRange {
+				t.Errorf("getStakeChangeSyncGaps() syncRange = %v, want %v", syncRange, tt.wantSyncRange)
+			}
+		})
+	}
+}
+
+func TestSetRewardsQuote(t *testing.T) {
+	newReport := func() types.RewardsReport {
+		period := types.RewardsPeriod{Period: "total", Rewards: getRazorAmount("10"), Penalties: new(big.Float), Bounties: new(big.Float), GasCost: getRazorAmount("0.5"), NetRazor: getRazorAmount("10")}
+		return types.RewardsReport{Periods: []types.RewardsPeriod{period}, Total: period}
+	}
+
+	tests := []struct {
+		name         string
+		gasTokenId   string
+		response     string
+		responseErr  error
+		wantNetQuote string
+		wantErr      bool
+	}{
+		{
+			name:         "Test 1: When the gas isn't priced",
+			response:     `{"razor-network":{"usd":0.5}}`,
+			wantNetQuote: "5.00",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 2: When the gas is priced in the gas token",
+			gasTokenId:   "ethereum",
+			response:     `{"razor-network":{"usd":0.5},"ethereum":{"usd":2}}`,
+			wantNetQuote: "4.00",
+			wantErr:      false,
+		},
+		{
+			name:       "Test 3: When the price of the gas token isn't found",
+			gasTokenId: "ethereum",
+			response:   `{"razor-network":{"usd":0.5}}`,
+			wantErr:    true,
+		},
+		{
+			name:        "Test 4: When there is an error in fetching the prices",
+			responseErr: errors.New("api error"),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsPkgMock := new(mocks2.Utils)
+			utils.UtilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("GetDataFromAPI", mock.AnythingOfType("string"), mock.Anything).Return([]byte(tt.response), tt.responseErr)
+
+			report := newReport()
+			err := setRewardsQuote(&report, "USD", tt.gasTokenId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("setRewardsQuote() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if report.Quote != "usd" || report.Periods[0].NetQuote.Text('f', 2) != tt.wantNetQuote || report.Total.NetQuote.Text('f', 2) != tt.wantNetQuote {
+				t.Errorf("setRewardsQuote() net quote = %v, want %s in usd", report.Total.NetQuote, tt.wantNetQuote)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/utils"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var riskReportCmd = &cobra.Command{
+	Use:   "riskReport",
+	Short: "show the slash exposure of a staker",
+	Long: `Shows the stake of the staker against the minimum safe razor and the minimum stake needed to vote, its age and maturity, and the share of the stake which is lost if the staker is slashed with the current slashing parameters.
+
+Example:
+  ./razor riskReport --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c`,
+	Run: initialiseRiskReport,
+}
+
+//This function initialises the ExecuteRiskReport function
+func initialiseRiskReport(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteRiskReport(cmd.Flags())
+}
+
+//This function sets the flags appropriately and executes the GetRiskReport function
+func (*UtilsStruct) ExecuteRiskReport(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	logger.SetLoggerParameters(client, address)
+
+	stakerId, err := razorUtils.AssignStakerId(flagSet, client, address)
+	utils.CheckError("StakerId error: ", err)
+	if stakerId == 0 {
+		log.Fatalf("Staker doesn't exist for %s", address)
+	}
+
+	report, err := cmdUtils.GetRiskReport(client, stakerId)
+	utils.CheckError("Error in getting risk report: ", err)
+
+	if utils.IsJsonOutput() {
+		utils.CheckError("Error in printing risk report: ", utils.PrintJson(report))
+		return
+	}
+	printRiskReport(report)
+}
+
+//This function returns the stake of the staker against the minimum stake needed to vote, its age and maturity and its slash exposure with the current slashing parameters
+func (*UtilsStruct) GetRiskReport(client *ethclient.Client, stakerId uint32) (types.RiskReport, error) {
+	staker, err := razorUtils.GetStaker(client, stakerId)
+	if err != nil {
+		return types.RiskReport{}, err
+	}
+	minSafeRazor, err := utils.UtilsInterface.GetMinSafeRazor(client)
+	if err != nil {
+		return types.RiskReport{}, err
+	}
+	minStake, err := utils.UtilsInterface.GetMinStakeAmount(client)
+	if err != nil {
+		return types.RiskReport{}, err
+	}
+	slashNums, err := utils.UtilsInterface.GetSlashNums(client)
+	if err != nil {
+		return types.RiskReport{}, err
+	}
+	callOpts := razorUtils.GetOptions()
+	maturity, err := stakeManagerUtils.GetMaturity(client, &callOpts, staker.Age)
+	if err != nil {
+		return types.RiskReport{}, err
+	}
+	minVotingStake := getMinVotingStake(minSafeRazor, minStake)
+	keptStake := new(big.Int).Div(new(big.Int).Mul(staker.Stake, big.NewInt(int64(slashNums.Keep))), big.NewInt(int64(core.BaseDenominator)))
+	report := types.RiskReport{
+		StakerId:           stakerId,
+		Address:            staker.Address.String(),
+		IsSlashed:          staker.IsSlashed,
+		Stake:              staker.Stake,
+		MinSafeRazor:       minSafeRazor,
+		MinStake:           minStake,
+		MinVotingStake:     minVotingStake,
+		StakeHeadroom:      new(big.Int).Sub(staker.Stake, minVotingStake),
+		Age:                staker.Age,
+		Maturity:           maturity,
+		SlashBountyPercent: getSlashPercent(slashNums.Bounty),
+		SlashBurnPercent:   getSlashPercent(slashNums.Burn),
+		SlashKeepPercent:   getSlashPercent(slashNums.Keep),
+		StakeAtRisk:        new(big.Int).Sub(staker.Stake, keptStake),
+		Warnings:           []string{},
+	}
+	if report.IsSlashed {
+		report.Warnings = append(report.Warnings, "staker is slashed and can't earn rewards anymore, unstake and withdraw the remaining stake")
+	} else if report.StakeHeadroom.Sign() < 0 {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("stake is below the %g razors needed to vote, the staker can't commit and its age is reduced by the inactivity penalties", utils.GetAmountInDecimal(minVotingStake)))
+	}
+	return report, nil
+}
+
+//This function returns the minimum stake with which the staker can vote and earn rewards, which is the larger of the minimum safe razor and the minimum stake
+func getMinVotingStake(minSafeRazor *big.Int, minStake *big.Int) *big.Int {
+	if minStake.Cmp(minSafeRazor) > 0 {
+		return minStake
+	}
+	return minSafeRazor
+}
+
+//This function returns the slash fraction out of core.BaseDenominator in percent
+func getSlashPercent(slashNum uint32) float64 {
+	return float64(slashNum) * 100 / float64(core.BaseDenominator)
+}
+
+//This function returns the warnings and the refusals of changing the stake of the staker of the risk report by the amount in razors, which is negative for an unstake
+//Adding stake to a slashed staker and an unstake which pushes the stake below the minimum stake needed to vote are refused
+func getStakeSafetyIssues(report types.RiskReport, stakeChange *big.Int) ([]string, []string) {
+	var warnings, refusals []string
+	newStake := new(big.Int).Add(report.Stake, stakeChange)
+	isBelowMinimum := report.Stake.Cmp(report.MinVotingStake) < 0
+	isNewBelowMinimum := newStake.Cmp(report.MinVotingStake) < 0
+	if stakeChange.Sign() > 0 {
+		if report.IsSlashed {
+			refusals = append(refusals, fmt.Sprintf("staker %d is slashed, the stake added can't earn rewards", report.StakerId))
+		} else if isNewBelowMinimum {
+			warnings = append(warnings, fmt.Sprintf("stake of %g razors after staking is still below the %g razors needed to vote", utils.GetAmountInDecimal(newStake), utils.GetAmountInDecimal(report.MinVotingStake)))
+		}
+		return warnings, refusals
+	}
+	if report.IsSlashed || !isNewBelowMinimum {
+		return warnings, refusals
+	}
+	message := fmt.Sprintf("stake of %g razors left after unstaking is below the %g razors needed to vote, the staker can't commit and its age of %d with maturity %d is reduced by the inactivity penalties", utils.GetAmountInDecimal(newStake), utils.GetAmountInDecimal(report.MinVotingStake), report.Age, report.Maturity)
+	if isBelowMinimum {
+		warnings = append(warnings, message)
+	} else {
+		refusals = append(refusals, message)
+	}
+	return warnings, refusals
+}
+
+//This function checks that changing the stake of the staker by the amount in razors, which is negative for an unstake, is safe
+//The warnings are logged, and the refusals are returned as an error unless force is set, in which case they are logged as warnings
+func (*UtilsStruct) CheckStakeSafety(client *ethclient.Client, stakerId uint32, stakeChange *big.Int, force bool) error {
+	report, err := cmdUtils.GetRiskReport(client, stakerId)
+	if err != nil {
+		return err
+	}
+	warnings, refusals := getStakeSafetyIssues(report, stakeChange)
+	for _, warning := range warnings {
+		log.Warn(warning)
+	}
+	if len(refusals) > 0 && !force {
+		return errors.New(strings.Join(refusals, ", ") + ", pass --force to go ahead")
+	}
+	for _, refusal := range refusals {
+		log.Warnf("Going ahead as --force is passed: %s", refusal)
+	}
+	newStake := new(big.Int).Add(report.Stake, stakeChange)
+	if newStake.Sign() > 0 && report.Stake.Sign() > 0 {
+		// The share of the stake which is lost on a slash doesn't depend on the stake
+		stakeAtRisk := new(big.Int).Div(new(big.Int).Mul(report.StakeAtRisk, newStake), report.Stake)
+		log.Infof("%g razors of the stake of %g razors are lost if the staker is slashed", utils.GetAmountInDecimal(stakeAtRisk), utils.GetAmountInDecimal(newStake))
+	}
+	return nil
+}
+
+//This function prints the risk report as a table
+func printRiskReport(report types.RiskReport) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Staker Id", strconv.Itoa(int(report.StakerId))})
+	table.AppendBulk([][]string{
+		{"Staker Address", report.Address},
+		{"Slashed", strconv.FormatBool(report.IsSlashed)},
+		{"Stake (RZR)", utils.GetAmountInDecimal(report.Stake).String()},
+		{"Min Safe Razor (RZR)", utils.GetAmountInDecimal(report.MinSafeRazor).String()},
+		{"Min Stake (RZR)", utils.GetAmountInDecimal(report.MinStake).String()},
+		{"Headroom Above Min (RZR)", utils.GetAmountInDecimal(report.StakeHeadroom).String()},
+		{"Age", strconv.Itoa(int(report.Age))},
+		{"Maturity", strconv.Itoa(int(report.Maturity))},
+		{"Slash Bounty / Burn / Keep (%)", fmt.Sprintf("%g / %g / %g", report.SlashBountyPercent, report.SlashBurnPercent, report.SlashKeepPercent)},
+		{"Stake At Risk (RZR)", utils.GetAmountInDecimal(report.StakeAtRisk).String()},
+	})
+	table.Render()
+	for _, warning := range report.Warnings {
+		log.Warn(warning)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(riskReportCmd)
+
+	var (
+		Address  string
+		StakerId uint32
+	)
+
+	riskReportCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
+	riskReportCmd.Flags().Uint32VarP(&StakerId, "stakerId", "", 0, "staker id, the staker of the address is used if it isn't passed")
+
+	addrErr := riskReportCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
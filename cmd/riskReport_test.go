@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetRiskReport(t *testing.T) {
+	var client *ethclient.Client
+	var callOpts bind.CallOpts
+	address := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	stake := new(big.Int).Mul(big.NewInt(2000), big.NewInt(1e18))
+	minSafeRazor := new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+	minStake := new(big.Int).Mul(big.NewInt(1500), big.NewInt(1e18))
+	slashNums := types.SlashNums{Bounty: 500000, Burn: 8500000, Keep: 1000000}
+
+	type args struct {
+		staker          bindings.StructsStaker
+		stakerErr       error
+		minSafeRazorErr error
+		minStakeErr     error
+		slashNumsErr    error
+		maturity        uint16
+		maturityErr     error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.RiskReport
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the stake is above the minimum",
+			args: args{
+				staker:   bindings.StructsStaker{Id: 1, Address: address, Age: 100000, Stake: stake},
+				maturity: 50,
+			},
+			want: types.RiskReport{
+				StakerId:           1,
+				Address:            address.String(),
+				Stake:              stake,
+				MinSafeRazor:       minSafeRazor,
+				MinStake:           minStake,
+				MinVotingStake:     minStake,
+				StakeHeadroom:      new(big.Int).Mul(big.NewInt(500), big.NewInt(1e18)),
+				Age:                100000,
+				Maturity:           50,
+				SlashBountyPercent: 5,
+				SlashBurnPercent:   85,
+				SlashKeepPercent:   10,
+				StakeAtRisk:        new(big.Int).Mul(big.NewInt(1800), big.NewInt(1e18)),
+				Warnings:           []string{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the staker is slashed",
+			args: args{
+				staker:   bindings.StructsStaker{Id: 1, Address: address, IsSlashed: true, Stake: stake},
+				maturity: 0,
+			},
+			want: types.RiskReport{
+				StakerId:           1,
+				Address:            address.String(),
+				IsSlashed:          true,
+				Stake:              stake,
+				MinSafeRazor:       minSafeRazor,
+				MinStake:           minStake,
+				MinVotingStake:     minStake,
+				StakeHeadroom:      new(big.Int).Mul(big.NewInt(500), big.NewInt(1e18)),
+				SlashBountyPercent: 5,
+				SlashBurnPercent:   85,
+				SlashKeepPercent:   10,
+				StakeAtRisk:        new(big.Int).Mul(big.NewInt(1800), big.NewInt(1e18)),
+				Warnings:           []string{"staker is slashed and can't earn rewards anymore, unstake and withdraw the remaining stake"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting staker",
+			args: args{
+				stakerErr: errors.New("staker error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting minSafeRazor",
+			args: args{
+				staker:          bindings.StructsStaker{Id: 1, Address: address, Stake: stake},
+				minSafeRazorErr: errors.New("minSafeRazor error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting minStake",
+			args: args{
+				staker:      bindings.StructsStaker{Id: 1, Address: address, Stake: stake},
+				minStakeErr: errors.New("minStake error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting slashNums",
+			args: args{
+				staker:       bindings.StructsStaker{Id: 1, Address: address, Stake: stake},
+				slashNumsErr: errors.New("slashNums error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting maturity",
+			args: args{
+				staker:      bindings.StructsStaker{Id: 1, Address: address, Stake: stake},
+				maturityErr: errors.New("maturity error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			stakeManagerMock := new(mocks.StakeManagerInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			stakeManagerUtils = stakeManagerMock
+
+			utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.staker, tt.args.stakerErr)
+			utilsPkgMock.On("GetMinSafeRazor", mock.AnythingOfType("*ethclient.Client")).Return(minSafeRazor, tt.args.minSafeRazorErr)
+			utilsPkgMock.On("GetMinStakeAmount", mock.AnythingOfType("*ethclient.Client")).Return(minStake, tt.args.minStakeErr)
+			utilsPkgMock.On("GetSlashNums", mock.AnythingOfType("*ethclient.Client")).Return(slashNums, tt.args.slashNumsErr)
+			utilsMock.On("GetOptions").Return(callOpts)
+			stakeManagerMock.On("GetMaturity", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*bind.CallOpts"), mock.AnythingOfType("uint32")).Return(tt.args.maturity, tt.args.maturityErr)
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetRiskReport(client, 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRiskReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRiskReport() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStakeSafetyIssues(t *testing.T) {
+	minVotingStake := big.NewInt(1000)
+	report := func(stake int64, isSlashed bool) types.RiskReport {
+		return types.RiskReport{StakerId: 1, IsSlashed: isSlashed, Stake: big.NewInt(stake), MinVotingStake: minVotingStake}
+	}
+	tests := []struct {
+		name         string
+		report       types.RiskReport
+		stakeChange  *big.Int
+		wantWarnings int
+		wantRefusals int
+	}{
+		{
+			name:        "Test 1: When stake is added above the minimum",
+			report:      report(1000, false),
+			stakeChange: big.NewInt(500),
+		},
+		{
+			name:         "Test 2: When stake is added to a slashed staker",
+			report:       report(1000, true),
+			stakeChange:  big.NewInt(500),
+			wantRefusals: 1,
+		},
+		{
+			name:         "Test 3: When the stake is still below the minimum after staking",
+			report:       report(100, false),
+			stakeChange:  big.NewInt(500),
+			wantWarnings: 1,
+		},
+		{
+			name:        "Test 4: When the stake left after unstaking is above the minimum",
+			report:      report(2000, false),
+			stakeChange: big.NewInt(-1000),
+		},
+		{
+			name:         "Test 5: When the unstake pushes the stake below the minimum",
+			report:       report(2000, false),
+			stakeChange:  big.NewInt(-1500),
+			wantRefusals: 1,
+		},
+		{
+			name:         "Test 6: When the stake is below the minimum before unstaking",
+			report:       report(500, false),
+			stakeChange:  big.NewInt(-100),
+			wantWarnings: 1,
+		},
+		{
+			name:        "Test 7: When a slashed staker unstakes",
+			report:      report(2000, true),
+			stakeChange: big.NewInt(-2000),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, refusals := getStakeSafetyIssues(tt.report, tt.stakeChange)
+			if len(warnings) != tt.wantWarnings || len(refusals) != tt.wantRefusals {
+				t.Errorf("getStakeSafetyIssues() warnings = %v, refusals = %v, want %d warnings and %d refusals", warnings, refusals, tt.wantWarnings, tt.wantRefusals)
+			}
+		})
+	}
+}
+
+func TestCheckStakeSafety(t *testing.T) {
+	var client *ethclient.Client
+	report := types.RiskReport{StakerId: 1, Stake: big.NewInt(2000), MinVotingStake: big.NewInt(1000), StakeAtRisk: big.NewInt(1800)}
+
+	tests := []struct {
+		name        string
+		reportErr   error
+		stakeChange *big.Int
+		force       bool
+		wantErr     bool
+	}{
+		{
+			name:        "Test 1: When the unstake is safe",
+			stakeChange: big.NewInt(-500),
+			wantErr:     false,
+		},
+		{
+			name:        "Test 2: When the unstake pushes the stake below the minimum",
+			stakeChange: big.NewInt(-1500),
+			wantErr:     true,
+		},
+		{
+			name:        "Test 3: When the unsafe unstake is forced",
+			stakeChange: big.NewInt(-1500),
+			force:       true,
+			wantErr:     false,
+		},
+		{
+			name:        "Test 4: When there is an error in getting the risk report",
+			reportErr:   errors.New("risk report error"),
+			stakeChange: big.NewInt(500),
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtils = cmdUtilsMock
+
+			cmdUtilsMock.On("GetRiskReport", mock.AnythingOfType("*ethclient.Client"), uint32(1)).Return(report, tt.reportErr)
+
+			ut := &UtilsStruct{}
+			err := ut.CheckStakeSafety(client, 1, tt.stakeChange, tt.force)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckStakeSafety() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
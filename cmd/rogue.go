@@ -0,0 +1,52 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils"
+	"strconv"
+	"strings"
+)
+
+//This function returns the rogue data of the rogue modes, the arguments of the biasedMedians and delayedPropose modes are parsed from their mode as biasedMedians:<percent> and delayedPropose:<seconds>
+func getRogueData(isRogue bool, rogueModes []string) (types.Rogue, error) {
+	rogueData := types.Rogue{
+		IsRogue:           isRogue,
+		MedianBiasPercent: core.DefaultRogueMedianBiasPercent,
+		ProposeDelay:      core.DefaultRogueProposeDelay,
+	}
+	for _, rogueMode := range rogueModes {
+		nameAndArgument := strings.SplitN(rogueMode, ":", 2)
+		name := strings.TrimSpace(nameAndArgument[0])
+		if !utils.Contains(core.RogueModes, name) {
+			return types.Rogue{}, fmt.Errorf("invalid rogue mode %s, valid rogue modes are %s", rogueMode, strings.Join(core.RogueModes, ", "))
+		}
+		rogueData.RogueMode = append(rogueData.RogueMode, name)
+		if len(nameAndArgument) == 1 {
+			continue
+		}
+		argument, err := strconv.Atoi(strings.TrimSpace(nameAndArgument[1]))
+		switch {
+		case name == "biasedMedians" && err == nil && argument > -100:
+			rogueData.MedianBiasPercent = argument
+		case name == "delayedPropose" && err == nil && argument >= 0:
+			rogueData.ProposeDelay = argument
+		case name == "biasedMedians":
+			return types.Rogue{}, fmt.Errorf("invalid rogue mode %s, it should be of the form biasedMedians:<percent> with a percent greater than -100", rogueMode)
+		case name == "delayedPropose":
+			return types.Rogue{}, fmt.Errorf("invalid rogue mode %s, it should be of the form delayedPropose:<seconds>", rogueMode)
+		default:
+			return types.Rogue{}, fmt.Errorf("invalid rogue mode %s, only the biasedMedians and delayedPropose modes take an argument", rogueMode)
+		}
+	}
+	return rogueData, nil
+}
+
+//This function returns the median biased by the percentage, the median itself is not modified as it is a revealed value
+func biasMedian(median *big.Int, percent int) *big.Int {
+	biasedMedian := new(big.Int).Mul(median, big.NewInt(int64(100+percent)))
+	return biasedMedian.Div(biasedMedian, big.NewInt(100))
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestGetRogueData(t *testing.T) {
+	type args struct {
+		isRogue    bool
+		rogueModes []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.Rogue
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the rogue modes take no argument",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"commit", "droppedId", "skipReveal"},
+			},
+			want: types.Rogue{
+				IsRogue:           true,
+				RogueMode:         []string{"commit", "droppedId", "skipReveal"},
+				MedianBiasPercent: core.DefaultRogueMedianBiasPercent,
+				ProposeDelay:      core.DefaultRogueProposeDelay,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the biasedMedians and delayedPropose modes take an argument",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"biasedMedians:-20", "delayedPropose: 90"},
+			},
+			want: types.Rogue{
+				IsRogue:           true,
+				RogueMode:         []string{"biasedMedians", "delayedPropose"},
+				MedianBiasPercent: -20,
+				ProposeDelay:      90,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is no rogue mode",
+			args: args{
+				isRogue: false,
+			},
+			want: types.Rogue{
+				MedianBiasPercent: core.DefaultRogueMedianBiasPercent,
+				ProposeDelay:      core.DefaultRogueProposeDelay,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When the rogue mode is invalid",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"wrongMode"},
+			},
+			want:    types.Rogue{},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the bias percent is invalid",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"biasedMedians:-100"},
+			},
+			want:    types.Rogue{},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the propose delay is invalid",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"delayedPropose:soon"},
+			},
+			want:    types.Rogue{},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When an argument is given to a rogue mode which takes none",
+			args: args{
+				isRogue:    true,
+				rogueModes: []string{"reveal:10"},
+			},
+			want:    types.Rogue{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getRogueData(tt.args.isRogue, tt.args.rogueModes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getRogueData() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getRogueData() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBiasMedian(t *testing.T) {
+	tests := []struct {
+		name    string
+		median  *big.Int
+		percent int
+		want    *big.Int
+	}{
+		{
+			name:    "Test 1: When the median is biased upwards",
+			median:  big.NewInt(1000),
+			percent: 10,
+			want:    big.NewInt(1100),
+		},
+		{
+			name:    "Test 2: When the median is biased downwards",
+			median:  big.NewInt(1000),
+			percent: -25,
+			want:    big.NewInt(750),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			median := new(big.Int).Set(tt.median)
+			got := biasMedian(median, tt.percent)
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("biasMedian() = %v, want %v", got, tt.want)
+			}
+			if median.Cmp(tt.median) != 0 {
+				t.Errorf("biasMedian() modified the median to %v", median)
+			}
+		})
+	}
+}
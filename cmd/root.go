@@ -2,6 +2,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -10,6 +11,7 @@ import (
 	"razor/core"
 	"razor/logger"
 	"razor/path"
+	"razor/utils"
 )
 
 var (
@@ -21,6 +23,30 @@ var (
 	LogLevel           string
 	GasLimitMultiplier float32
 	LogFile            string
+	TxnTimeouts        map[string]int
+	GasStrategies      map[string]string
+	MaxGasPrice        int32
+	GasBudget          float32
+	GasBudgetPeriod    string
+	EncryptState       bool
+	RequestHeaders     string
+	AllowedHosts       []string
+	SignerUrl          string
+	KMSKey             string
+	ReadProvider       string
+	LogsChunkSize      int32
+	APICacheTTL        int32
+	HTTPTimeout        int32
+	HTTPRetryAttempts  int32
+	HTTPRetryDelay     int32
+	HTTPProxy          string
+	DataDir            string
+	DryRun             bool
+	OutputFormat       string
+	PasswordSource     string
+	ConfigFile         string
+	Network            string
+	AddressesFile      string
 )
 
 var log = logger.NewLogger()
@@ -52,6 +78,7 @@ func Execute() {
 //This function add the following command to the root command
 func init() {
 	cobra.OnInitialize(initConfig)
+	rootCmd.PersistentPreRun = persistentPreRun
 
 	rootCmd.PersistentFlags().StringVarP(&Provider, "provider", "p", "", "provider name")
 	rootCmd.PersistentFlags().Float32VarP(&GasMultiplier, "gasmultiplier", "g", -1, "gas multiplier value")
@@ -61,34 +88,105 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&LogLevel, "logLevel", "", "", "log level")
 	rootCmd.PersistentFlags().Float32VarP(&GasLimitMultiplier, "gasLimit", "", -1, "gas limit percentage increase")
 	rootCmd.PersistentFlags().StringVarP(&LogFile, "logFile", "", "", "name of log file")
+	rootCmd.PersistentFlags().StringToIntVarP(&TxnTimeouts, "txnTimeouts", "", map[string]int{}, "maximum time (in secs) to wait for the transactions of each state, e.g. commit=60,reveal=60")
+	rootCmd.PersistentFlags().StringToStringVarP(&GasStrategies, "gasStrategies", "", map[string]string{}, "gas strategy of the transactions of each class (suggested, slow, standard, fast, oracle:<url>#<selector>, fixed), e.g. reveal=fast,dispute=fast,claim=slow")
+	rootCmd.PersistentFlags().Int32VarP(&MaxGasPrice, "maxGasPrice", "", -1, "maximum gas price (in gwei) of the transactions, 0 disables it")
+	rootCmd.PersistentFlags().Float32VarP(&GasBudget, "gasBudget", "", -1, "gas budget (in the native token) of the transactions of each period, the claims and resets are skipped if it is exceeded, 0 disables it")
+	rootCmd.PersistentFlags().StringVarP(&GasBudgetPeriod, "gasBudgetPeriod", "", "", "period of the gas budget (epoch, day)")
+	rootCmd.PersistentFlags().StringVarP(&RequestHeaders, "requestHeaders", "", "", "mode of sending identifying request headers (omit, randomize)")
+	rootCmd.PersistentFlags().StringSliceVarP(&AllowedHosts, "allowedHosts", "", []string{}, "hosts which the APIs of the jobs are allowed to be fetched from, all hosts are allowed if not passed")
+	rootCmd.PersistentFlags().StringVarP(&SignerUrl, "signerUrl", "", "", "url of the external signer (clef or web3signer) which signs the transactions instead of the local keystore")
+	rootCmd.PersistentFlags().StringVarP(&KMSKey, "kmsKey", "", "", "key of AWS KMS (awskms://<region>/<key id>) or GCP Cloud KMS (gcpkms://<key version>) which signs the transactions instead of the local keystore")
+	rootCmd.PersistentFlags().StringVarP(&ReadProvider, "readProvider", "", "", "provider which serves the heavy reads such as the log scans instead of the provider")
+	rootCmd.PersistentFlags().Int32VarP(&LogsChunkSize, "logsChunkSize", "", -1, "maximum number of blocks whose logs are fetched in a single query, set it to the block range limit of the provider")
+	rootCmd.PersistentFlags().Int32VarP(&APICacheTTL, "apiCacheTTL", "", -1, "time (in secs) for which the responses of the APIs are reused without being fetched again, 0 disables it")
+	rootCmd.PersistentFlags().Int32VarP(&HTTPTimeout, "httpTimeout", "", -1, "time (in secs) after which the requests to the APIs of the jobs time out")
+	rootCmd.PersistentFlags().Int32VarP(&HTTPRetryAttempts, "httpRetryAttempts", "", -1, "number of attempts at a request to the APIs of the jobs")
+	rootCmd.PersistentFlags().Int32VarP(&HTTPRetryDelay, "httpRetryDelay", "", -1, "delay (in secs) before a failed request to the APIs of the jobs is retried")
+	rootCmd.PersistentFlags().StringVarP(&HTTPProxy, "httpProxy", "", "", "http(s) or socks5 proxy which the requests to the APIs of the jobs are sent through, HTTPS_PROXY is used if not passed")
+	rootCmd.PersistentFlags().StringVarP(&DataDir, "datadir", "", "", "directory of the state files, logs and local database, use a separate one for each staker on the host")
+	rootCmd.PersistentFlags().BoolVarP(&EncryptState, "encryptState", "", false, "encrypt the state files and local database in the razor directory using a key derived from the password")
+	rootCmd.PersistentFlags().StringVarP(&OutputFormat, "output", "", "", "format in which the results are printed (table, json), the transactions are printed with their hash, status and gas used in json")
+	rootCmd.PersistentFlags().BoolVarP(&DryRun, "dry-run", "", false, "build, estimate and log the transactions with their gas cost without sending them")
+	rootCmd.PersistentFlags().StringSliceP("account", "", []string{}, "address or alias of the account the command is run for, the vote command can take several accounts")
+	rootCmd.PersistentFlags().StringVarP(&PasswordSource, "passwordSource", "", "", "source from which the password is read instead of prompting for it (prompt, env:<variable>, file:<path>, keyring)")
+	rootCmd.PersistentFlags().StringVarP(&ConfigFile, "config", "", "", "YAML or TOML config file which is read and written instead of razor.yaml in the razor directory, RAZOR_CONFIG is used if not passed")
+	rootCmd.PersistentFlags().StringVarP(&Network, "network", "", "", "network whose contracts are called (mainnet, testnet, custom), mainnet is used if not passed")
+	rootCmd.PersistentFlags().StringVarP(&AddressesFile, "addressesFile", "", "", "file of the contract addresses and the ChainId of the testnet or custom network, in the format of addresses.json")
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 }
 
+//This function runs before every command, it selects the account of the command and the source of its password
+func persistentPreRun(cmd *cobra.Command, args []string) {
+	selectAccount(cmd, args)
+	setPasswordSource(cmd)
+}
+
+//This function sets the source from which the password of the account of the command is read
+func setPasswordSource(cmd *cobra.Command) {
+	var address string
+	if addressFlag := cmd.Flags().Lookup("address"); addressFlag != nil {
+		address = addressFlag.Value.String()
+	}
+	err := utils.SetPasswordSource(PasswordSource, address)
+	utils.CheckError("Error in setting password source: ", err)
+}
+
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
-	home, err := path.PathUtilsInterface.GetDefaultPath()
+	if ConfigFile == "" {
+		ConfigFile = os.Getenv("RAZOR_CONFIG")
+	}
+	path.SetConfigFile(ConfigFile)
+	configFilePath, err := path.PathUtilsInterface.GetConfigFilePath()
 	if err != nil {
-		log.Fatal("Error in fetching .razor directory: ", err)
+		log.Fatal("Error in fetching config file path: ", err)
 	}
-	// Search config in home directory with name "razor.yaml".
-	viper.AddConfigPath(home)
-	viper.SetConfigName("razor")
-	viper.SetConfigType("yaml")
-
-	viper.AutomaticEnv() // read in environment variables that match
+	// The type of the config file, YAML or TOML, is given by its extension
+	viper.SetConfigFile(configFilePath)
 
 	// If a config file is found, read it.
 	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+		if errors.Is(err, os.ErrNotExist) {
 			log.Warn("No config file found")
 		} else {
-			log.Warn("error in reading config")
+			log.Warn("error in reading config, check it with the config validate command")
 		}
 	}
+	registerConfigAliases(viper.GetViper())
+	// read in the RAZOR_* environment variables which override the config file
+	bindConfigEnv(viper.GetViper())
+
+	path.SetDataDir(DataDir)
+	if err := setNetwork(); err != nil {
+		log.Fatal("Error in setting network: ", err)
+	}
+	if DryRun {
+		utils.EnableDryRunMode()
+	}
+	if err := utils.SetOutputFormat(OutputFormat); err != nil {
+		log.Fatal("Error in setting output format: ", err)
+	}
 
 	setLogLevel()
 }
 
+//This function selects the network from the flags or from the config, the mainnet is used if none is given
+func setNetwork() error {
+	network := Network
+	if network == "" {
+		network = viper.GetString("network")
+	}
+	if network == "" {
+		network = core.MainnetNetwork
+	}
+	addressesFile := AddressesFile
+	if addressesFile == "" {
+		addressesFile = viper.GetString("addressesFile")
+	}
+	return utils.SetNetwork(network, addressesFile)
+}
+
 //This function sets the log level
 func setLogLevel() {
 	config, err := cmdUtils.GetConfigData()
@@ -108,4 +206,20 @@ func setLogLevel() {
 	log.Debugf("Gas Price: %d", config.GasPrice)
 	log.Debugf("Log Level: %s", config.LogLevel)
 	log.Debugf("Gas Limit: %.2f", config.GasLimitMultiplier)
+	log.Debugf("Txn Timeouts: %v", config.TxnTimeouts)
+	log.Debugf("Gas Strategies: %v", config.GasStrategies)
+	log.Debugf("Max Gas Price: %d", config.MaxGasPrice)
+	log.Debugf("Gas Budget: %v", config.GasBudget)
+	log.Debugf("Gas Budget Period: %s", config.GasBudgetPeriod)
+	log.Debugf("Request Headers: %s", config.RequestHeaders)
+	log.Debugf("Allowed Hosts: %v", config.AllowedHosts)
+	log.Debugf("Signer Url: %s", config.SignerUrl)
+	log.Debugf("KMS Key: %s", config.KMSKey)
+	log.Debugf("Read Provider: %s", config.ReadProvider)
+	log.Debugf("Logs Chunk Size: %d", config.LogsChunkSize)
+	log.Debugf("API Cache TTL: %d", config.APICacheTTL)
+	log.Debugf("HTTP Timeout: %d", config.HTTPTimeout)
+	log.Debugf("HTTP Retry Attempts: %d", config.HTTPRetryAttempts)
+	log.Debugf("HTTP Retry Delay: %d", config.HTTPRetryDelay)
+	log.Debugf("HTTP Proxy: %s", config.HTTPProxy)
 }
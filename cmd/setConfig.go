@@ -18,7 +18,7 @@ var setConfig = &cobra.Command{
 Setting the gas multiplier value enables the CLI to multiply the gas with that value for all the transactions
 
 Example:
-  ./razor setConfig --provider https://infura/v3/matic --gasmultiplier 1.5 --buffer 20 --wait 70 --gasprice 1 --logLevel debug --gasLimit 5
+  ./razor setConfig --provider https://infura/v3/matic --gasmultiplier 1.5 --buffer 20 --wait 70 --gasprice 1 --logLevel debug --gasLimit 5 --txnTimeouts commit=60,reveal=60
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		err := cmdUtils.SetConfig(cmd.Flags())
@@ -57,6 +57,142 @@ func (*UtilsStruct) SetConfig(flagSet *pflag.FlagSet) error {
 	if err != nil {
 		return err
 	}
+	txnTimeouts, err := flagSetUtils.GetStringToIntTxnTimeouts(flagSet)
+	if err != nil {
+		return err
+	}
+	err = validateTxnTimeouts(txnTimeouts)
+	if err != nil {
+		return err
+	}
+	gasStrategies, err := flagSetUtils.GetStringToStringGasStrategies(flagSet)
+	if err != nil {
+		return err
+	}
+	err = utils.ValidateGasStrategies(gasStrategies)
+	if err != nil {
+		return err
+	}
+	maxGasPrice, err := flagSetUtils.GetInt32MaxGasPrice(flagSet)
+	if err != nil {
+		return err
+	}
+	if maxGasPrice != -1 {
+		err = validateMaxGasPrice(maxGasPrice)
+		if err != nil {
+			return err
+		}
+	}
+	gasBudget, err := flagSetUtils.GetFloat32GasBudget(flagSet)
+	if err != nil {
+		return err
+	}
+	if gasBudget != -1 {
+		err = validateGasBudget(gasBudget)
+		if err != nil {
+			return err
+		}
+	}
+	gasBudgetPeriod, err := flagSetUtils.GetStringGasBudgetPeriod(flagSet)
+	if err != nil {
+		return err
+	}
+	if gasBudgetPeriod != "" {
+		err = utils.ValidateGasBudgetPeriod(gasBudgetPeriod)
+		if err != nil {
+			return err
+		}
+	}
+	requestHeaders, err := flagSetUtils.GetStringRequestHeaders(flagSet)
+	if err != nil {
+		return err
+	}
+	err = validateRequestHeaders(requestHeaders)
+	if err != nil {
+		return err
+	}
+	allowedHosts, err := flagSetUtils.GetStringSliceAllowedHosts(flagSet)
+	if err != nil {
+		return err
+	}
+	signerUrl, err := flagSetUtils.GetStringSignerUrl(flagSet)
+	if err != nil {
+		return err
+	}
+	err = validateSignerUrl(signerUrl)
+	if err != nil {
+		return err
+	}
+	kmsKey, err := flagSetUtils.GetStringKMSKey(flagSet)
+	if err != nil {
+		return err
+	}
+	err = utils.ValidateKMSKey(kmsKey)
+	if err != nil {
+		return err
+	}
+	readProvider, err := flagSetUtils.GetStringReadProvider(flagSet)
+	if err != nil {
+		return err
+	}
+	logsChunkSize, err := flagSetUtils.GetInt32LogsChunkSize(flagSet)
+	if err != nil {
+		return err
+	}
+	if logsChunkSize != -1 {
+		err = validateLogsChunkSize(logsChunkSize)
+		if err != nil {
+			return err
+		}
+	}
+	apiCacheTTL, err := flagSetUtils.GetInt32APICacheTTL(flagSet)
+	if err != nil {
+		return err
+	}
+	if apiCacheTTL != -1 {
+		err = validateAPICacheTTL(apiCacheTTL)
+		if err != nil {
+			return err
+		}
+	}
+	httpTimeout, err := flagSetUtils.GetInt32HTTPTimeout(flagSet)
+	if err != nil {
+		return err
+	}
+	if httpTimeout != -1 {
+		err = validateHTTPTimeout(httpTimeout)
+		if err != nil {
+			return err
+		}
+	}
+	httpRetryAttempts, err := flagSetUtils.GetInt32HTTPRetryAttempts(flagSet)
+	if err != nil {
+		return err
+	}
+	if httpRetryAttempts != -1 {
+		err = validateHTTPRetryAttempts(httpRetryAttempts)
+		if err != nil {
+			return err
+		}
+	}
+	httpRetryDelay, err := flagSetUtils.GetInt32HTTPRetryDelay(flagSet)
+	if err != nil {
+		return err
+	}
+	if httpRetryDelay != -1 {
+		err = validateHTTPRetryDelay(httpRetryDelay)
+		if err != nil {
+			return err
+		}
+	}
+	httpProxy, err := flagSetUtils.GetStringHTTPProxy(flagSet)
+	if err != nil {
+		return err
+	}
+	err = validateHTTPProxy(httpProxy)
+	if err != nil {
+		return err
+	}
 
 	path, pathErr := razorUtils.GetConfigFilePath()
 	if pathErr != nil {
@@ -112,7 +248,55 @@ func (*UtilsStruct) SetConfig(flagSet *pflag.FlagSet) error {
 	if gasLimit != -1 {
 		viper.Set("gasLimit", gasLimit)
 	}
-	if provider == "" && gasMultiplier == -1 && bufferPercent == 0 && waitTime == -1 && gasPrice == -1 && logLevel == "" && gasLimit == -1 {
+	if len(txnTimeouts) != 0 {
+		viper.Set("txnTimeouts", txnTimeouts)
+	}
+	if len(gasStrategies) != 0 {
+		viper.Set("gasStrategies", gasStrategies)
+	}
+	if maxGasPrice != -1 {
+		viper.Set("maxGasPrice", maxGasPrice)
+	}
+	if gasBudget != -1 {
+		viper.Set("gasBudget", gasBudget)
+	}
+	if gasBudgetPeriod != "" {
+		viper.Set("gasBudgetPeriod", gasBudgetPeriod)
+	}
+	if requestHeaders != "" {
+		viper.Set("requestHeaders", requestHeaders)
+	}
+	if len(allowedHosts) != 0 {
+		viper.Set("allowedHosts", allowedHosts)
+	}
+	if signerUrl != "" {
+		viper.Set("signerUrl", signerUrl)
+	}
+	if kmsKey != "" {
+		viper.Set("kmsKey", kmsKey)
+	}
+	if readProvider != "" {
+		viper.Set("readProvider", readProvider)
+	}
+	if logsChunkSize != -1 {
+		viper.Set("logsChunkSize", logsChunkSize)
+	}
+	if apiCacheTTL != -1 {
+		viper.Set("apiCacheTTL", apiCacheTTL)
+	}
+	if httpTimeout != -1 {
+		viper.Set("httpTimeout", httpTimeout)
+	}
+	if httpRetryAttempts != -1 {
+		viper.Set("httpRetryAttempts", httpRetryAttempts)
+	}
+	if httpRetryDelay != -1 {
+		viper.Set("httpRetryDelay", httpRetryDelay)
+	}
+	if httpProxy != "" {
+		viper.Set("httpProxy", httpProxy)
+	}
+	if provider == "" && gasMultiplier == -1 && bufferPercent == 0 && waitTime == -1 && gasPrice == -1 && logLevel == "" && gasLimit == -1 && len(txnTimeouts) == 0 && len(gasStrategies) == 0 && maxGasPrice == -1 && gasBudget == -1 && gasBudgetPeriod == "" && requestHeaders == "" && len(allowedHosts) == 0 && signerUrl == "" && kmsKey == "" && readProvider == "" && logsChunkSize == -1 && apiCacheTTL == -1 && httpTimeout == -1 && httpRetryAttempts == -1 && httpRetryDelay == -1 && httpProxy == "" {
 		viper.Set("provider", "http://127.0.0.1:8545")
 		viper.Set("gasmultiplier", 1.0)
 		viper.Set("buffer", 20)
@@ -146,6 +330,22 @@ func init() {
 		ExposeMetrics      string
 		CertFile           string
 		CertKey            string
+		TxnTimeouts        map[string]int
+		GasStrategies      map[string]string
+		MaxGasPrice        int32
+		GasBudget          float32
+		GasBudgetPeriod    string
+		RequestHeaders     string
+		AllowedHosts       []string
+		SignerUrl          string
+		KMSKey             string
+		ReadProvider       string
+		LogsChunkSize      int32
+		APICacheTTL        int32
+		HTTPTimeout        int32
+		HTTPRetryAttempts  int32
+		HTTPRetryDelay     int32
+		HTTPProxy          string
 	)
 	setConfig.Flags().StringVarP(&Provider, "provider", "p", "", "provider name")
 	setConfig.Flags().Float32VarP(&GasMultiplier, "gasmultiplier", "g", -1, "gas multiplier value")
@@ -157,5 +357,21 @@ func init() {
 	setConfig.Flags().StringVarP(&ExposeMetrics, "exposeMetrics", "", "", "port number")
 	setConfig.Flags().StringVarP(&CertFile, "certFile", "", "", "ssl certificate path")
 	setConfig.Flags().StringVarP(&CertKey, "certKey", "", "", "ssl certificate key path")
+	setConfig.Flags().StringToIntVarP(&TxnTimeouts, "txnTimeouts", "", map[string]int{}, "maximum time (in secs) to wait for the transactions of each state, e.g. commit=60,reveal=60")
+	setConfig.Flags().StringToStringVarP(&GasStrategies, "gasStrategies", "", map[string]string{}, "gas strategy of the transactions of each class (suggested, slow, standard, fast, oracle:<url>#<selector>, fixed), e.g. reveal=fast,dispute=fast,claim=slow")
+	setConfig.Flags().Int32VarP(&MaxGasPrice, "maxGasPrice", "", -1, "maximum gas price (in gwei) of the transactions, 0 disables it")
+	setConfig.Flags().Float32VarP(&GasBudget, "gasBudget", "", -1, "gas budget (in the native token) of the transactions of each period, the claims and resets are skipped if it is exceeded, 0 disables it")
+	setConfig.Flags().StringVarP(&GasBudgetPeriod, "gasBudgetPeriod", "", "", "period of the gas budget (epoch, day)")
+	setConfig.Flags().StringVarP(&RequestHeaders, "requestHeaders", "", "", "mode of sending identifying request headers (omit, randomize)")
+	setConfig.Flags().StringSliceVarP(&AllowedHosts, "allowedHosts", "", []string{}, "hosts which the APIs of the jobs are allowed to be fetched from")
+	setConfig.Flags().StringVarP(&SignerUrl, "signerUrl", "", "", "url of the external signer (clef or web3signer) which signs the transactions instead of the local keystore")
+	setConfig.Flags().StringVarP(&KMSKey, "kmsKey", "", "", "key of AWS KMS (awskms://<region>/<key id>) or GCP Cloud KMS (gcpkms://<key version>) which signs the transactions instead of the local keystore")
+	setConfig.Flags().StringVarP(&ReadProvider, "readProvider", "", "", "provider which serves the heavy reads such as the log scans instead of the provider")
+	setConfig.Flags().Int32VarP(&LogsChunkSize, "logsChunkSize", "", -1, "maximum number of blocks whose logs are fetched in a single query, set it to the block range limit of the provider")
+	setConfig.Flags().Int32VarP(&APICacheTTL, "apiCacheTTL", "", -1, "time (in secs) for which the responses of the APIs are reused without being fetched again, 0 disables it")
+	setConfig.Flags().Int32VarP(&HTTPTimeout, "httpTimeout", "", -1, "time (in secs) after which the requests to the APIs of the jobs time out")
+	setConfig.Flags().Int32VarP(&HTTPRetryAttempts, "httpRetryAttempts", "", -1, "number of attempts at a request to the APIs of the jobs")
+	setConfig.Flags().Int32VarP(&HTTPRetryDelay, "httpRetryDelay", "", -1, "delay (in secs) before a failed request to the APIs of the jobs is retried")
+	setConfig.Flags().StringVarP(&HTTPProxy, "httpProxy", "", "", "http(s) or socks5 proxy which the requests to the APIs of the jobs are sent through")
 
 }
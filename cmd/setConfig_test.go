@@ -38,6 +38,38 @@ func TestSetConfig(t *testing.T) {
 		certFileErr           error
 		certKey               string
 		certKeyErr            error
+		txnTimeouts           map[string]int
+		txnTimeoutsErr        error
+		gasStrategies         map[string]string
+		gasStrategiesErr      error
+		maxGasPrice           int32
+		maxGasPriceErr        error
+		gasBudget             float32
+		gasBudgetErr          error
+		gasBudgetPeriod       string
+		gasBudgetPeriodErr    error
+		requestHeaders        string
+		requestHeadersErr     error
+		allowedHosts          []string
+		allowedHostsErr       error
+		signerUrl             string
+		signerUrlErr          error
+		readProvider          string
+		readProviderErr       error
+		apiCacheTTL           int32
+		apiCacheTTLErr        error
+		httpTimeout           int32
+		httpTimeoutErr        error
+		httpRetryAttempts     int32
+		httpRetryAttemptsErr  error
+		httpRetryDelay        int32
+		httpRetryDelayErr     error
+		httpProxy             string
+		httpProxyErr          error
+		logsChunkSize         int32
+		logsChunkSizeErr      error
+		kmsKey                string
+		kmsKeyErr             error
 	}
 	tests := []struct {
 		name    string
@@ -262,6 +294,354 @@ func TestSetConfig(t *testing.T) {
 			},
 			wantErr: errors.New("error in getting port"),
 		},
+		{
+			name: "Test 16: When txnTimeouts are passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				txnTimeouts:        map[string]int{"commit": 60, "reveal": 60},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 17: When there is an error in getting txnTimeouts",
+			args: args{
+				txnTimeoutsErr: errors.New("txnTimeouts error"),
+			},
+			wantErr: errors.New("txnTimeouts error"),
+		},
+		{
+			name: "Test 18: When txnTimeouts are passed for an invalid state",
+			args: args{
+				txnTimeouts: map[string]int{"vote": 60},
+			},
+			wantErr: errors.New("invalid state vote in txnTimeouts, valid states are commit, reveal, propose, dispute, confirm"),
+		},
+		{
+			name: "Test 19: When requestHeaders and allowedHosts are passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				requestHeaders:     "omit",
+				allowedHosts:       []string{"api.gemini.com"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 20: When there is an error in getting requestHeaders",
+			args: args{
+				requestHeadersErr: errors.New("requestHeaders error"),
+			},
+			wantErr: errors.New("requestHeaders error"),
+		},
+		{
+			name: "Test 21: When requestHeaders is invalid",
+			args: args{
+				requestHeaders: "hide",
+			},
+			wantErr: errors.New("invalid requestHeaders hide, valid values are omit, randomize"),
+		},
+		{
+			name: "Test 22: When there is an error in getting allowedHosts",
+			args: args{
+				allowedHostsErr: errors.New("allowedHosts error"),
+			},
+			wantErr: errors.New("allowedHosts error"),
+		},
+		{
+			name: "Test 23: When signerUrl is passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				signerUrl:          "http://localhost:9000",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 24: When there is an error in getting signerUrl",
+			args: args{
+				signerUrlErr: errors.New("signerUrl error"),
+			},
+			wantErr: errors.New("signerUrl error"),
+		},
+		{
+			name: "Test 25: When signerUrl is not an http url",
+			args: args{
+				signerUrl: "/home/user/.clef/clef.ipc",
+			},
+			wantErr: errors.New("invalid signerUrl /home/user/.clef/clef.ipc, the external signer must be reached over http or https"),
+		},
+		{
+			name: "Test 26: When readProvider is passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				readProvider:       "https://read.example.com",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 27: When there is an error in getting readProvider",
+			args: args{
+				readProviderErr: errors.New("readProvider error"),
+			},
+			wantErr: errors.New("readProvider error"),
+		},
+		{
+			name: "Test 28: When apiCacheTTL is passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				apiCacheTTL:        30,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 29: When there is an error in getting apiCacheTTL",
+			args: args{
+				apiCacheTTLErr: errors.New("apiCacheTTL error"),
+			},
+			wantErr: errors.New("apiCacheTTL error"),
+		},
+		{
+			name: "Test 30: When apiCacheTTL is negative",
+			args: args{
+				apiCacheTTL: -5,
+			},
+			wantErr: errors.New("apiCacheTTL -5 cannot be negative"),
+		},
+		{
+			name: "Test 31: When the HTTP options are passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				apiCacheTTL:        -1,
+				path:               "/home/config",
+				httpTimeout:        20,
+				httpRetryAttempts:  3,
+				httpRetryDelay:     1,
+				httpProxy:          "http://proxy.example.com:3128",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 32: When there is an error in getting httpTimeout",
+			args: args{
+				httpTimeoutErr: errors.New("httpTimeout error"),
+			},
+			wantErr: errors.New("httpTimeout error"),
+		},
+		{
+			name: "Test 33: When httpTimeout is negative",
+			args: args{
+				httpTimeout: -5,
+			},
+			wantErr: errors.New("httpTimeout -5 should be greater than 0"),
+		},
+		{
+			name: "Test 34: When there is an error in getting httpRetryAttempts",
+			args: args{
+				httpRetryAttemptsErr: errors.New("httpRetryAttempts error"),
+			},
+			wantErr: errors.New("httpRetryAttempts error"),
+		},
+		{
+			name: "Test 35: When httpRetryAttempts is negative",
+			args: args{
+				httpRetryAttempts: -3,
+			},
+			wantErr: errors.New("httpRetryAttempts -3 should be at least 1"),
+		},
+		{
+			name: "Test 36: When there is an error in getting httpRetryDelay",
+			args: args{
+				httpRetryDelayErr: errors.New("httpRetryDelay error"),
+			},
+			wantErr: errors.New("httpRetryDelay error"),
+		},
+		{
+			name: "Test 37: When httpRetryDelay is negative",
+			args: args{
+				httpRetryDelay: -5,
+			},
+			wantErr: errors.New("httpRetryDelay -5 cannot be negative"),
+		},
+		{
+			name: "Test 38: When there is an error in getting httpProxy",
+			args: args{
+				httpProxyErr: errors.New("httpProxy error"),
+			},
+			wantErr: errors.New("httpProxy error"),
+		},
+		{
+			name: "Test 39: When the scheme of httpProxy is not supported",
+			args: args{
+				httpProxy: "ftp://proxy.example.com",
+			},
+			wantErr: errors.New("invalid httpProxy ftp://proxy.example.com, the proxy must be a url with one of the schemes http, https, socks5"),
+		},
+		{
+			name: "Test 40: When kmsKey is passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				kmsKey:             "awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 41: When there is an error in getting kmsKey",
+			args: args{
+				kmsKeyErr: errors.New("kmsKey error"),
+			},
+			wantErr: errors.New("kmsKey error"),
+		},
+		{
+			name: "Test 42: When the KMS of kmsKey is not supported",
+			args: args{
+				kmsKey: "vault://127.0.0.1:8200/transit/keys/staker1",
+			},
+			wantErr: errors.New("invalid kmsKey vault://127.0.0.1:8200/transit/keys/staker1, it should be awskms://<region>/<key id> or gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>/cryptoKeyVersions/<version>"),
+		},
+		{
+			name: "Test 43: When gasStrategies and maxGasPrice are passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				gasStrategies:      map[string]string{"dispute": "fast", "claim": "slow"},
+				maxGasPrice:        100,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 44: When there is an error in getting gasStrategies",
+			args: args{
+				gasStrategiesErr: errors.New("gasStrategies error"),
+			},
+			wantErr: errors.New("gasStrategies error"),
+		},
+		{
+			name: "Test 45: When a gas strategy is invalid",
+			args: args{
+				gasStrategies: map[string]string{"claim": "cheapest"},
+			},
+			wantErr: errors.New("invalid gas strategy cheapest of claim class, valid strategies are suggested, slow, standard, fast, oracle, fixed"),
+		},
+		{
+			name: "Test 46: When maxGasPrice is negative",
+			args: args{
+				maxGasPrice: -5,
+			},
+			wantErr: errors.New("maxGasPrice -5 cannot be negative"),
+		},
+		{
+			name: "Test 47: When there is an error in getting maxGasPrice",
+			args: args{
+				maxGasPriceErr: errors.New("maxGasPrice error"),
+			},
+			wantErr: errors.New("maxGasPrice error"),
+		},
+		{
+			name: "Test 48: When gasBudget and gasBudgetPeriod are passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				path:               "/home/config",
+				gasBudget:          0.5,
+				gasBudgetPeriod:    "day",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 49: When gasBudget is negative",
+			args: args{
+				gasBudget: -2,
+			},
+			wantErr: errors.New("gasBudget -2 cannot be negative"),
+		},
+		{
+			name: "Test 50: When there is an error in getting gasBudget",
+			args: args{
+				gasBudgetErr: errors.New("gasBudget error"),
+			},
+			wantErr: errors.New("gasBudget error"),
+		},
+		{
+			name: "Test 51: When gasBudgetPeriod is invalid",
+			args: args{
+				gasBudgetPeriod: "week",
+			},
+			wantErr: errors.New("invalid gasBudgetPeriod week, valid periods are epoch and day"),
+		},
+		{
+			name: "Test 52: When there is an error in getting gasBudgetPeriod",
+			args: args{
+				gasBudgetPeriodErr: errors.New("gasBudgetPeriod error"),
+			},
+			wantErr: errors.New("gasBudgetPeriod error"),
+		},
+		{
+			name: "Test 53: When logsChunkSize is passed",
+			args: args{
+				provider:           "",
+				gasmultiplier:      -1,
+				waitTime:           -1,
+				gasPrice:           -1,
+				gasLimitMultiplier: -1,
+				apiCacheTTL:        -1,
+				path:               "/home/config",
+				logsChunkSize:      2000,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Test 54: When logsChunkSize is negative",
+			args: args{
+				logsChunkSize: -5,
+			},
+			wantErr: errors.New("logsChunkSize -5 should be at least 1"),
+		},
+		{
+			name: "Test 55: When there is an error in getting logsChunkSize",
+			args: args{
+				logsChunkSizeErr: errors.New("logsChunkSize error"),
+			},
+			wantErr: errors.New("logsChunkSize error"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -276,6 +656,12 @@ func TestSetConfig(t *testing.T) {
 			flagSetUtils = flagSetUtilsMock
 			viperUtils = viperMock
 
+			// The gas budget which is not given by a test is not passed
+			gasBudget := tt.args.gasBudget
+			if gasBudget == 0 {
+				gasBudget = -1
+			}
+
 			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
 			flagSetUtilsMock.On("GetStringProvider", flagSet).Return(tt.args.provider, tt.args.providerErr)
 			flagSetUtilsMock.On("GetFloat32GasMultiplier", flagSet).Return(tt.args.gasmultiplier, tt.args.gasmultiplierErr)
@@ -284,6 +670,22 @@ func TestSetConfig(t *testing.T) {
 			flagSetUtilsMock.On("GetInt32GasPrice", flagSet).Return(tt.args.gasPrice, tt.args.gasPriceErr)
 			flagSetUtilsMock.On("GetStringLogLevel", flagSet).Return(tt.args.logLevel, tt.args.logLevelErr)
 			flagSetUtilsMock.On("GetFloat32GasLimit", flagSet).Return(tt.args.gasLimitMultiplier, tt.args.gasLimitMultiplierErr)
+			flagSetUtilsMock.On("GetStringToIntTxnTimeouts", flagSet).Return(tt.args.txnTimeouts, tt.args.txnTimeoutsErr)
+			flagSetUtilsMock.On("GetStringToStringGasStrategies", flagSet).Return(tt.args.gasStrategies, tt.args.gasStrategiesErr)
+			flagSetUtilsMock.On("GetInt32MaxGasPrice", flagSet).Return(notPassedIfZero(tt.args.maxGasPrice), tt.args.maxGasPriceErr)
+			flagSetUtilsMock.On("GetFloat32GasBudget", flagSet).Return(gasBudget, tt.args.gasBudgetErr)
+			flagSetUtilsMock.On("GetStringGasBudgetPeriod", flagSet).Return(tt.args.gasBudgetPeriod, tt.args.gasBudgetPeriodErr)
+			flagSetUtilsMock.On("GetStringRequestHeaders", flagSet).Return(tt.args.requestHeaders, tt.args.requestHeadersErr)
+			flagSetUtilsMock.On("GetStringSliceAllowedHosts", flagSet).Return(tt.args.allowedHosts, tt.args.allowedHostsErr)
+			flagSetUtilsMock.On("GetStringSignerUrl", flagSet).Return(tt.args.signerUrl, tt.args.signerUrlErr)
+			flagSetUtilsMock.On("GetStringKMSKey", flagSet).Return(tt.args.kmsKey, tt.args.kmsKeyErr)
+			flagSetUtilsMock.On("GetStringReadProvider", flagSet).Return(tt.args.readProvider, tt.args.readProviderErr)
+			flagSetUtilsMock.On("GetInt32LogsChunkSize", flagSet).Return(notPassedIfZero(tt.args.logsChunkSize), tt.args.logsChunkSizeErr)
+			flagSetUtilsMock.On("GetInt32APICacheTTL", flagSet).Return(tt.args.apiCacheTTL, tt.args.apiCacheTTLErr)
+			flagSetUtilsMock.On("GetInt32HTTPTimeout", flagSet).Return(notPassedIfZero(tt.args.httpTimeout), tt.args.httpTimeoutErr)
+			flagSetUtilsMock.On("GetInt32HTTPRetryAttempts", flagSet).Return(notPassedIfZero(tt.args.httpRetryAttempts), tt.args.httpRetryAttemptsErr)
+			flagSetUtilsMock.On("GetInt32HTTPRetryDelay", flagSet).Return(notPassedIfZero(tt.args.httpRetryDelay), tt.args.httpRetryDelayErr)
+			flagSetUtilsMock.On("GetStringHTTPProxy", flagSet).Return(tt.args.httpProxy, tt.args.httpProxyErr)
 			flagSetUtilsMock.On("GetStringExposeMetrics", flagSet).Return(tt.args.port, tt.args.portErr)
 			flagSetUtilsMock.On("GetStringCertFile", flagSet).Return(tt.args.certFile, tt.args.certFileErr)
 			flagSetUtilsMock.On("GetStringCertKey", flagSet).Return(tt.args.certKey, tt.args.certKeyErr)
@@ -305,3 +707,11 @@ func TestSetConfig(t *testing.T) {
 		})
 	}
 }
+
+//This function returns -1, the value of the flags which are not passed, for the HTTP options, the logs chunk size and the maximum gas price which are not given by a test
+func notPassedIfZero(value int32) int32 {
+	if value == 0 {
+		return -1
+	}
+	return value
+}
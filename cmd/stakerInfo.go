@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"os"
+	"razor/core/types"
 	"razor/logger"
 	"razor/utils"
 	"strconv"
@@ -62,6 +63,16 @@ func (*UtilsStruct) GetStakerInfo(client *ethclient.Client, stakerId uint32) err
 	if err != nil {
 		return err
 	}
+	if utils.IsJsonOutput() {
+		return utils.PrintJson(types.StakerInfoOutput{
+			StakerId:  stakerInfo.Id,
+			Address:   stakerInfo.Address.String(),
+			Stake:     stakerInfo.Stake,
+			Age:       stakerInfo.Age,
+			Maturity:  maturity,
+			Influence: influence,
+		})
+	}
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"Staker Id", "Staker Address", "Stake", "Age", "Maturity", "Influence"})
 	table.Append([]string{
@@ -91,6 +91,16 @@ func (u Utils) AssignPassword() string {
 	return utils.AssignPassword()
 }
 
+//This function returns the password of a new keystore
+func (u Utils) AssignNewPassword() string {
+	return utils.AssignNewPassword()
+}
+
+//This function reads the password of the address from the password source
+func (u Utils) ReadPassword(address string) (string, error) {
+	return utils.ReadPassword(address)
+}
+
 //This function returns the string address
 func (u Utils) GetStringAddress(flagSet *pflag.FlagSet) (string, error) {
 	return flagSet.GetString("address")
@@ -111,6 +121,11 @@ func (u Utils) WaitForBlockCompletion(client *ethclient.Client, hashToRead strin
 	return utilsInterface.WaitForBlockCompletion(client, hashToRead)
 }
 
+//This function waits for the block completion for at most the given timeout
+func (u Utils) WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error {
+	return utilsInterface.WaitForBlockCompletionWithTimeout(client, hashToRead, timeout)
+}
+
 //This function returns the number of active collections
 func (u Utils) GetNumActiveCollections(client *ethclient.Client) (uint16, error) {
 	return utilsInterface.GetNumActiveCollections(client)
@@ -421,6 +436,36 @@ func (u Utils) GetDisputeDataFileName(address string) (string, error) {
 	return path.PathUtilsInterface.GetDisputeDataFileName(address)
 }
 
+//This function returns the canary file name
+func (u Utils) GetCanaryFileName(address string) (string, error) {
+	return path.PathUtilsInterface.GetCanaryFileName(address)
+}
+
+//This function returns the file name of the capture file of the RPC requests and responses
+func (u Utils) GetRPCDebugFileName(address string) (string, error) {
+	return path.PathUtilsInterface.GetRPCDebugFileName(address)
+}
+
+//This function stores the password of the keystore of the address in the keychain of the OS
+func (u Utils) StoreKeychainPassword(address string, password string) error {
+	return utils.StoreKeychainPassword(address, password)
+}
+
+//This function returns the password of the keystore of the address from the keychain of the OS
+func (u Utils) GetKeychainPassword(address string) (string, error) {
+	return utils.GetKeychainPassword(address)
+}
+
+//This function removes the password of the keystore of the address from the keychain of the OS
+func (u Utils) DeleteKeychainPassword(address string) error {
+	return utils.DeleteKeychainPassword(address)
+}
+
+//This function locks the data directory for the address
+func (u Utils) LockDataDir(address string) error {
+	return path.PathUtilsInterface.LockDataDir(address)
+}
+
 //This function returns the hash
 func (transactionUtils TransactionUtils) Hash(txn *Types.Transaction) common.Hash {
 	return txn.Hash()
@@ -769,6 +814,86 @@ func (flagSetUtils FLagSetUtils) GetInt32Wait(flagSet *pflag.FlagSet) (int32, er
 	return flagSet.GetInt32("wait")
 }
 
+//This function returns the txnTimeouts in map of state to seconds
+func (flagSetUtils FLagSetUtils) GetStringToIntTxnTimeouts(flagSet *pflag.FlagSet) (map[string]int, error) {
+	return flagSet.GetStringToInt("txnTimeouts")
+}
+
+//This function returns the gasStrategies in map of class to gas strategy
+func (flagSetUtils FLagSetUtils) GetStringToStringGasStrategies(flagSet *pflag.FlagSet) (map[string]string, error) {
+	return flagSet.GetStringToString("gasStrategies")
+}
+
+//This function returns the maximum gas price in Int32
+func (flagSetUtils FLagSetUtils) GetInt32MaxGasPrice(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("maxGasPrice")
+}
+
+//This function returns the gas budget in Float32
+func (flagSetUtils FLagSetUtils) GetFloat32GasBudget(flagSet *pflag.FlagSet) (float32, error) {
+	return flagSet.GetFloat32("gasBudget")
+}
+
+//This function returns the period of the gas budget in string
+func (flagSetUtils FLagSetUtils) GetStringGasBudgetPeriod(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("gasBudgetPeriod")
+}
+
+//This function returns the request headers mode in string
+func (flagSetUtils FLagSetUtils) GetStringRequestHeaders(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("requestHeaders")
+}
+
+//This function returns the allowed hosts in string slice
+func (flagSetUtils FLagSetUtils) GetStringSliceAllowedHosts(flagSet *pflag.FlagSet) ([]string, error) {
+	return flagSet.GetStringSlice("allowedHosts")
+}
+
+//This function returns the url of the external signer in string
+func (flagSetUtils FLagSetUtils) GetStringSignerUrl(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("signerUrl")
+}
+
+//This function returns the key of the KMS in string
+func (flagSetUtils FLagSetUtils) GetStringKMSKey(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("kmsKey")
+}
+
+//This function returns the read provider in string
+func (flagSetUtils FLagSetUtils) GetStringReadProvider(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("readProvider")
+}
+
+//This function returns the maximum number of blocks whose logs are fetched in a single query in Int32
+func (flagSetUtils FLagSetUtils) GetInt32LogsChunkSize(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("logsChunkSize")
+}
+
+//This function returns the cache TTL of the API responses in Int32
+func (flagSetUtils FLagSetUtils) GetInt32APICacheTTL(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("apiCacheTTL")
+}
+
+//This function returns the timeout of the requests to the APIs in Int32
+func (flagSetUtils FLagSetUtils) GetInt32HTTPTimeout(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("httpTimeout")
+}
+
+//This function returns the attempts at a request to the APIs in Int32
+func (flagSetUtils FLagSetUtils) GetInt32HTTPRetryAttempts(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("httpRetryAttempts")
+}
+
+//This function returns the delay before a request to the APIs is retried in Int32
+func (flagSetUtils FLagSetUtils) GetInt32HTTPRetryDelay(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("httpRetryDelay")
+}
+
+//This function returns the proxy of the requests to the APIs in string
+func (flagSetUtils FLagSetUtils) GetStringHTTPProxy(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("httpProxy")
+}
+
 //This function returns GasPrice in Int32
 func (flagSetUtils FLagSetUtils) GetInt32GasPrice(flagSet *pflag.FlagSet) (int32, error) {
 	return flagSet.GetInt32("gasprice")
@@ -809,6 +934,86 @@ func (flagSetUtils FLagSetUtils) GetRootInt32Wait() (int32, error) {
 	return rootCmd.PersistentFlags().GetInt32("wait")
 }
 
+//This function returns the txnTimeouts of root in map of state to seconds
+func (flagSetUtils FLagSetUtils) GetRootStringToIntTxnTimeouts() (map[string]int, error) {
+	return rootCmd.PersistentFlags().GetStringToInt("txnTimeouts")
+}
+
+//This function returns the gasStrategies of root in map of class to gas strategy
+func (flagSetUtils FLagSetUtils) GetRootStringToStringGasStrategies() (map[string]string, error) {
+	return rootCmd.PersistentFlags().GetStringToString("gasStrategies")
+}
+
+//This function returns the maximum gas price of root in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32MaxGasPrice() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("maxGasPrice")
+}
+
+//This function returns the gas budget of root in Float32
+func (flagSetUtils FLagSetUtils) GetRootFloat32GasBudget() (float32, error) {
+	return rootCmd.PersistentFlags().GetFloat32("gasBudget")
+}
+
+//This function returns the period of the gas budget of root in string
+func (flagSetUtils FLagSetUtils) GetRootStringGasBudgetPeriod() (string, error) {
+	return rootCmd.PersistentFlags().GetString("gasBudgetPeriod")
+}
+
+//This function returns the request headers mode of the root command in string
+func (flagSetUtils FLagSetUtils) GetRootStringRequestHeaders() (string, error) {
+	return rootCmd.PersistentFlags().GetString("requestHeaders")
+}
+
+//This function returns the allowed hosts of the root command in string slice
+func (flagSetUtils FLagSetUtils) GetRootStringSliceAllowedHosts() ([]string, error) {
+	return rootCmd.PersistentFlags().GetStringSlice("allowedHosts")
+}
+
+//This function returns the url of the external signer of the root command in string
+func (flagSetUtils FLagSetUtils) GetRootStringSignerUrl() (string, error) {
+	return rootCmd.PersistentFlags().GetString("signerUrl")
+}
+
+//This function returns the key of the KMS of the root command in string
+func (flagSetUtils FLagSetUtils) GetRootStringKMSKey() (string, error) {
+	return rootCmd.PersistentFlags().GetString("kmsKey")
+}
+
+//This function returns the read provider of the root command in string
+func (flagSetUtils FLagSetUtils) GetRootStringReadProvider() (string, error) {
+	return rootCmd.PersistentFlags().GetString("readProvider")
+}
+
+//This function returns the maximum number of blocks whose logs are fetched in a single query of the root command in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32LogsChunkSize() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("logsChunkSize")
+}
+
+//This function returns the cache TTL of the API responses of the root command in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32APICacheTTL() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("apiCacheTTL")
+}
+
+//This function returns the timeout of the requests to the APIs of the root command in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32HTTPTimeout() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("httpTimeout")
+}
+
+//This function returns the attempts at a request to the APIs of the root command in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32HTTPRetryAttempts() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("httpRetryAttempts")
+}
+
+//This function returns the delay before a request to the APIs is retried of the root command in Int32
+func (flagSetUtils FLagSetUtils) GetRootInt32HTTPRetryDelay() (int32, error) {
+	return rootCmd.PersistentFlags().GetInt32("httpRetryDelay")
+}
+
+//This function returns the proxy of the requests to the APIs of the root command in string
+func (flagSetUtils FLagSetUtils) GetRootStringHTTPProxy() (string, error) {
+	return rootCmd.PersistentFlags().GetString("httpProxy")
+}
+
 //This function returns the gas price of root in Int32
 func (flagSetUtils FLagSetUtils) GetRootInt32GasPrice() (int32, error) {
 	return rootCmd.PersistentFlags().GetInt32("gasprice")
@@ -844,6 +1049,16 @@ func (flagSetUtils FLagSetUtils) GetUint32StakerId(flagSet *pflag.FlagSet) (uint
 	return flagSet.GetUint32("stakerId")
 }
 
+//This function returns the fromStakerId in Uint32
+func (flagSetUtils FLagSetUtils) GetUint32FromStakerId(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("fromStakerId")
+}
+
+//This function returns the toStakerId in Uint32
+func (flagSetUtils FLagSetUtils) GetUint32ToStakerId(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("toStakerId")
+}
+
 //This function returns the name in string
 func (flagSetUtils FLagSetUtils) GetStringName(flagSet *pflag.FlagSet) (string, error) {
 	return flagSet.GetString("name")
@@ -929,11 +1144,66 @@ func (flagSetUtils FLagSetUtils) GetBoolRogue(flagSet *pflag.FlagSet) (bool, err
 	return flagSet.GetBool("rogue")
 }
 
+//This function is used to check if canary is passed or not
+func (flagSetUtils FLagSetUtils) GetBoolCanary(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("canary")
+}
+
 //This function is used to check if rogueMode is passed or not
 func (flagSetUtils FLagSetUtils) GetStringSliceRogueMode(flagSet *pflag.FlagSet) ([]string, error) {
 	return flagSet.GetStringSlice("rogueMode")
 }
 
+//This function is used to check if faultInjection is passed or not
+func (flagSetUtils FLagSetUtils) GetStringFaultInjection(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("faultInjection")
+}
+
+//This function returns the chaos flag in bool
+func (flagSetUtils FLagSetUtils) GetBoolChaos(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("chaos")
+}
+
+//This function returns the chaos config file
+func (flagSetUtils FLagSetUtils) GetStringChaosConfig(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("chaosConfig")
+}
+
+//This function returns the encryptState flag in bool
+func (flagSetUtils FLagSetUtils) GetBoolEncryptState(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("encryptState")
+}
+
+//This function returns the log file name in string
+func (flagSetUtils FLagSetUtils) GetStringLogFile(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("logFile")
+}
+
+//This function returns the epoch in Uint32
+func (flagSetUtils FLagSetUtils) GetUint32Epoch(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("epoch")
+}
+
+//This function returns the state in string
+func (flagSetUtils FLagSetUtils) GetStringState(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("state")
+}
+
+//This function returns the log level in string
+func (flagSetUtils FLagSetUtils) GetStringLevel(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("level")
+}
+
+//This function returns the follow flag in bool
+func (flagSetUtils FLagSetUtils) GetBoolFollow(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("follow")
+}
+
+//This function returns the json flag in bool
+func (flagSetUtils FLagSetUtils) GetBoolJson(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("json")
+}
+
 //This function is used to check if exposeMetrics is passed or not
 func (flagSetUtils FLagSetUtils) GetStringExposeMetrics(flagSet *pflag.FlagSet) (string, error) {
 	return flagSet.GetString("exposeMetrics")
@@ -949,6 +1219,86 @@ func (flagSetUtils FLagSetUtils) GetStringCertKey(flagSet *pflag.FlagSet) (strin
 	return flagSet.GetString("certKey")
 }
 
+//This function returns the collection id passed in the collection flag
+func (flagSetUtils FLagSetUtils) GetUint16Collection(flagSet *pflag.FlagSet) (uint16, error) {
+	return flagSet.GetUint16("collection")
+}
+
+//This function returns the number of days
+func (flagSetUtils FLagSetUtils) GetUint32Days(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("days")
+}
+
+//This function returns the number of collections
+func (flagSetUtils FLagSetUtils) GetUint16Collections(flagSet *pflag.FlagSet) (uint16, error) {
+	return flagSet.GetUint16("collections")
+}
+
+//This function returns the number of stakers
+func (flagSetUtils FLagSetUtils) GetUint32Stakers(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("stakers")
+}
+
+//This function returns the number of blocks
+func (flagSetUtils FLagSetUtils) GetUint32Blocks(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("blocks")
+}
+
+//This function returns the parallelism
+func (flagSetUtils FLagSetUtils) GetInt32Parallelism(flagSet *pflag.FlagSet) (int32, error) {
+	return flagSet.GetInt32("parallelism")
+}
+
+//This function returns the benchtime in string
+func (flagSetUtils FLagSetUtils) GetStringBenchTime(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("benchtime")
+}
+
+//This function returns the benchmarks in string slice
+func (flagSetUtils FLagSetUtils) GetStringSliceBenchmarks(flagSet *pflag.FlagSet) ([]string, error) {
+	return flagSet.GetStringSlice("benchmarks")
+}
+
+//This function returns the output file in string
+func (flagSetUtils FLagSetUtils) GetStringOutput(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("output")
+}
+
+//This function returns the remote config url in string
+func (flagSetUtils FLagSetUtils) GetStringRemoteConfigUrl(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("remoteConfigUrl")
+}
+
+//This function returns the remote config signer in string
+func (flagSetUtils FLagSetUtils) GetStringRemoteConfigSigner(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("remoteConfigSigner")
+}
+
+//This function returns the remote config interval
+func (flagSetUtils FLagSetUtils) GetUint32RemoteConfigInterval(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("remoteConfigInterval")
+}
+
+//This function returns the number of latest activities
+func (flagSetUtils FLagSetUtils) GetUint32Last(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("last")
+}
+
+//This function returns the ids of the subscribed collections
+func (flagSetUtils FLagSetUtils) GetUintSliceSubscribedCollections(flagSet *pflag.FlagSet) ([]uint, error) {
+	return flagSet.GetUintSlice("subscribedCollections")
+}
+
+//This function returns if the penalties of the collections which are not subscribed are acknowledged
+func (flagSetUtils FLagSetUtils) GetBoolAcknowledgeUnsubscribed(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("acknowledgeUnsubscribed")
+}
+
+//This function returns if the existing file is overwritten
+func (flagSetUtils FLagSetUtils) GetBoolForce(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("force")
+}
+
 //This function returns the accounts
 func (keystoreUtils KeystoreUtils) Accounts(path string) []ethAccounts.Account {
 	ks := keystore.NewKeyStore(path, keystore.StandardScryptN, keystore.StandardScryptP)
@@ -967,13 +1317,13 @@ func (c CryptoUtils) HexToECDSA(hexKey string) (*ecdsa.PrivateKey, error) {
 }
 
 //This function is used to give the sorted Ids
-func (*UtilsStruct) GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int) {
-	GiveSorted(client, blockManager, txnOpts, epoch, assetId, sortedStakers)
+func (*UtilsStruct) GiveSorted(client *ethclient.Client, blockManager *bindings.BlockManager, txnOpts *bind.TransactOpts, epoch uint32, assetId uint16, sortedStakers []*big.Int) error {
+	return GiveSorted(client, blockManager, txnOpts, epoch, assetId, sortedStakers)
 }
 
 //This function is used to write config as
 func (v ViperUtils) ViperWriteConfigAs(path string) error {
-	return viper.WriteConfigAs(path)
+	return writeConfigFile(viper.GetViper(), path)
 }
 
 //This function is used for sleep
@@ -995,3 +1345,183 @@ func (a AbiUtils) Unpack(abi abi.ABI, name string, data []byte) ([]interface{},
 func (o OSUtils) Exit(code int) {
 	os.Exit(code)
 }
+
+//This function returns the port at which the metrics are served
+func (flagSetUtils FLagSetUtils) GetStringMetricsPort(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("metricsPort")
+}
+
+//This function returns the port at which the health and status of voting are served
+func (flagSetUtils FLagSetUtils) GetStringHealthPort(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("healthPort")
+}
+
+//This function is used to check if fleet is passed or not
+func (flagSetUtils FLagSetUtils) GetBoolFleet(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("fleet")
+}
+
+//This function returns the nodes of the fleet
+func (flagSetUtils FLagSetUtils) GetStringSliceNodes(flagSet *pflag.FlagSet) ([]string, error) {
+	return flagSet.GetStringSlice("nodes")
+}
+
+//This function returns the file of the proposed block to simulate the dispute of
+func (flagSetUtils FLagSetUtils) GetStringBlockJson(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("block-json")
+}
+
+//This function returns the status of capturing the RPC requests and responses
+func (flagSetUtils FLagSetUtils) GetBoolRpcDebug(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("rpcDebug")
+}
+
+//This function returns the duration in seconds for which the RPC requests and responses are captured
+func (flagSetUtils FLagSetUtils) GetUint32RpcDebugDuration(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("rpcDebugDuration")
+}
+
+//This function returns if the password is read from the keychain of the OS
+func (flagSetUtils FLagSetUtils) GetBoolUseKeychain(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("useKeychain")
+}
+
+//This function returns the number of blocks after which a pending transaction is sped up
+func (flagSetUtils FLagSetUtils) GetUint32SpeedUpBlocks(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("speedUpBlocks")
+}
+
+//This function returns if the eligible bounties are claimed automatically
+func (flagSetUtils FLagSetUtils) GetBoolAutoClaimBounty(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("autoClaimBounty")
+}
+
+//This function returns if all the claimable bounties are claimed
+func (flagSetUtils FLagSetUtils) GetBoolAll(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("all")
+}
+
+//This function returns the number of days of events to look back for bounties
+func (flagSetUtils FLagSetUtils) GetUint32EventsDays(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("eventsDays")
+}
+
+//This function returns the file of the notification templates
+func (flagSetUtils FLagSetUtils) GetStringNotificationTemplates(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("notificationTemplates")
+}
+
+//This function returns the webhooks which the alerts are sent to
+func (flagSetUtils FLagSetUtils) GetStringSliceAlertWebhooks(flagSet *pflag.FlagSet) ([]string, error) {
+	return flagSet.GetStringSlice("alertWebhooks")
+}
+
+//This function returns the ETH balance below which an alert is sent
+func (flagSetUtils FLagSetUtils) GetFloat32AlertBalanceThreshold(flagSet *pflag.FlagSet) (float32, error) {
+	return flagSet.GetFloat32("alertBalanceThreshold")
+}
+
+//This function returns the RZR balance below which an alert is sent
+func (flagSetUtils FLagSetUtils) GetFloat32AlertRazorBalanceThreshold(flagSet *pflag.FlagSet) (float32, error) {
+	return flagSet.GetFloat32("alertRazorBalanceThreshold")
+}
+
+//This function returns the minutes for which the provider is down after which an alert is sent
+func (flagSetUtils FLagSetUtils) GetUint32AlertRpcDownMinutes(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("alertRpcDownMinutes")
+}
+
+//This function returns the number of latest epochs whose history is shown
+func (flagSetUtils FLagSetUtils) GetUint32Epochs(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("epochs")
+}
+
+//This function returns the format in which the history is shown
+func (flagSetUtils FLagSetUtils) GetStringHistoryFormat(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("format")
+}
+
+//This function returns the file which the history is exported to
+func (flagSetUtils FLagSetUtils) GetStringExportFile(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("exportFile")
+}
+
+//This function returns the period by which the rewards are grouped
+func (flagSetUtils FLagSetUtils) GetStringRewardsPeriod(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("period")
+}
+
+//This function returns the fiat currency in which the net profitability is shown
+func (flagSetUtils FLagSetUtils) GetStringQuote(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("quote")
+}
+
+//This function returns the CoinGecko id of the gas token which is used to price the gas
+func (flagSetUtils FLagSetUtils) GetStringGasTokenId(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("gasTokenId")
+}
+
+//This function returns the flag which tells whether the commission update should be scheduled
+func (flagSetUtils FLagSetUtils) GetBoolSchedule(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("schedule")
+}
+
+//This function returns the flag which tells whether the withdrawals in the withdraw queue should be initiated and unlocked while voting
+func (flagSetUtils FLagSetUtils) GetBoolAutoWithdraw(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("autoWithdraw")
+}
+
+//This function returns the alias of the account
+func (flagSetUtils FLagSetUtils) GetStringAlias(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("alias")
+}
+
+//This function returns the flag which tells whether the alias should be removed
+func (flagSetUtils FLagSetUtils) GetBoolRemove(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("remove")
+}
+
+//This function returns the addresses or aliases of the accounts selected with --account
+func (flagSetUtils FLagSetUtils) GetStringSliceAccount(flagSet *pflag.FlagSet) ([]string, error) {
+	return flagSet.GetStringSlice("account")
+}
+
+//This function returns the mode of the vote command in string
+func (flagSetUtils FLagSetUtils) GetStringMode(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("mode")
+}
+
+//This function returns what the gas report is grouped by in string
+func (flagSetUtils FLagSetUtils) GetStringGroupBy(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("groupBy")
+}
+
+//This function returns the name of the deployment whose label is added to the metrics
+func (flagSetUtils FLagSetUtils) GetStringDeployment(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("deployment")
+}
+
+//This function returns the deployments file
+func (flagSetUtils FLagSetUtils) GetStringDeployments(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("deployments")
+}
+
+//This function returns if the committed values are attested
+func (flagSetUtils FLagSetUtils) GetBoolAttest(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("attest")
+}
+
+//This function returns the key file of the attestation key which signs the attestations instead of the staker key
+func (flagSetUtils FLagSetUtils) GetStringAttestationKeyFile(flagSet *pflag.FlagSet) (string, error) {
+	return flagSet.GetString("attestationKeyFile")
+}
+
+//This function returns if the responses used for the commits are archived
+func (flagSetUtils FLagSetUtils) GetBoolArchiveResponses(flagSet *pflag.FlagSet) (bool, error) {
+	return flagSet.GetBool("archiveResponses")
+}
+
+//This function returns the number of days for which the archived responses are kept
+func (flagSetUtils FLagSetUtils) GetUint32ArchiveRetentionDays(flagSet *pflag.FlagSet) (uint32, error) {
+	return flagSet.GetUint32("archiveRetentionDays")
+}
@@ -56,6 +56,20 @@ func (*UtilsStruct) ExecuteUnstake(flagSet *pflag.FlagSet) {
 	stakerId, err := razorUtils.AssignStakerId(flagSet, client, address)
 	utils.CheckError("StakerId error: ", err)
 
+	staker, err := razorUtils.GetStaker(client, stakerId)
+	utils.CheckError("Error in getting staker: ", err)
+	totalSupply, err := utils.UtilsInterface.GetSRZRTotalSupply(client, staker)
+	utils.CheckError("Error in getting sRZR total supply: ", err)
+	if totalSupply.Sign() == 0 {
+		log.Fatal("No sRZR of the staker is minted, there is nothing to unstake")
+	}
+	unstakeAmount := razorUtils.ConvertSRZRToRZR(valueInWei, staker.Stake, totalSupply)
+
+	force, err := flagSetUtils.GetBoolForce(flagSet)
+	utils.CheckError("Error in getting force: ", err)
+	err = cmdUtils.CheckStakeSafety(client, stakerId, new(big.Int).Neg(unstakeAmount), force)
+	utils.CheckError("Unstake is unsafe: ", err)
+
 	unstakeInput := types.UnstakeInput{
 		Address:    address,
 		Password:   password,
@@ -68,6 +82,10 @@ func (*UtilsStruct) ExecuteUnstake(flagSet *pflag.FlagSet) {
 	if txnHash != core.NilHash {
 		err = razorUtils.WaitForBlockCompletion(client, txnHash.String())
 		utils.CheckError("Error in WaitForBlockCompletion for unstake: ", err)
+		err = cmdUtils.AddToWithdrawQueue(client, address, stakerId, txnHash.Hex())
+		if err != nil {
+			log.Error("Error in adding the unstake lock to the withdraw queue: ", err)
+		}
 	}
 }
 
@@ -150,12 +168,14 @@ func init() {
 		AmountToUnStake string
 		WeiRazor        bool
 		StakerId        uint32
+		Force           bool
 	)
 
 	unstakeCmd.Flags().StringVarP(&Address, "address", "a", "", "user's address")
 	unstakeCmd.Flags().StringVarP(&AmountToUnStake, "value", "v", "0", "value of sRazors to un-stake")
 	unstakeCmd.Flags().BoolVarP(&WeiRazor, "weiRazor", "", false, "value can be passed in wei")
 	unstakeCmd.Flags().Uint32VarP(&StakerId, "stakerId", "", 0, "staker id")
+	unstakeCmd.Flags().BoolVarP(&Force, "force", "", false, "unstake even if the stake left is below the minimum stake needed to vote")
 
 	addrErr := unstakeCmd.MarkFlagRequired("address")
 	utils.CheckError("Address error: ", addrErr)
@@ -16,6 +16,8 @@ import (
 	"razor/core"
 	"razor/core/types"
 	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
 	"reflect"
 	"testing"
 )
@@ -170,19 +172,23 @@ func TestExecuteUnstake(t *testing.T) {
 	var flagSet *pflag.FlagSet
 
 	type args struct {
-		config      types.Configurations
-		configErr   error
-		password    string
-		address     string
-		addressErr  error
-		value       *big.Int
-		valueErr    error
-		stakerId    uint32
-		stakerIdErr error
-		lock        types.Locks
-		lockErr     error
-		unstakeHash common.Hash
-		unstakeErr  error
+		config                types.Configurations
+		configErr             error
+		password              string
+		address               string
+		addressErr            error
+		value                 *big.Int
+		valueErr              error
+		stakerId              uint32
+		stakerIdErr           error
+		lock                  types.Locks
+		lockErr               error
+		unstakeHash           common.Hash
+		unstakeErr            error
+		addToWithdrawQueueErr error
+		stakerErr             error
+		totalSupplyErr        error
+		stakeSafetyErr        error
 	}
 	tests := []struct {
 		name          string
@@ -294,6 +300,58 @@ func TestExecuteUnstake(t *testing.T) {
 			},
 			expectedFatal: false,
 		},
+		{
+			name: "Test 7: When there is an error in adding the unstake lock to the withdraw queue",
+			args: args{
+				config:   types.Configurations{},
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dead",
+				value:    big.NewInt(10000),
+				stakerId: 1,
+				lock: types.Locks{
+					Amount: big.NewInt(0),
+				},
+				unstakeHash:           common.BigToHash(big.NewInt(1)),
+				addToWithdrawQueueErr: errors.New("withdraw queue error"),
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 8: When there is an error in getting staker",
+			args: args{
+				config:    types.Configurations{},
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dead",
+				value:     big.NewInt(10000),
+				stakerId:  1,
+				stakerErr: errors.New("staker error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 9: When there is an error in getting sRZR total supply",
+			args: args{
+				config:         types.Configurations{},
+				password:       "test",
+				address:        "0x000000000000000000000000000000000000dead",
+				value:          big.NewInt(10000),
+				stakerId:       1,
+				totalSupplyErr: errors.New("total supply error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 10: When the unstake would push the stake below the minimum stake",
+			args: args{
+				config:         types.Configurations{},
+				password:       "test",
+				address:        "0x000000000000000000000000000000000000dead",
+				value:          big.NewInt(10000),
+				stakerId:       1,
+				stakeSafetyErr: errors.New("stake below minimum"),
+			},
+			expectedFatal: true,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -308,12 +366,14 @@ func TestExecuteUnstake(t *testing.T) {
 			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 			transactionUtilsMock := new(mocks.TransactionInterface)
 			flagSetUtilsMock := new(mocks.FlagSetInterface)
+			utilsPkgMock := new(mocks2.Utils)
 
 			razorUtils = utilsMock
 			stakeManagerUtils = stakeManagerUtilsMock
 			cmdUtils = cmdUtilsMock
 			transactionUtils = transactionUtilsMock
 			flagSetUtils = flagSetUtilsMock
+			utils.UtilsInterface = utilsPkgMock
 
 			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
 			cmdUtilsMock.On("GetConfigData").Return(tt.args.config, tt.args.configErr)
@@ -323,14 +383,20 @@ func TestExecuteUnstake(t *testing.T) {
 			cmdUtilsMock.On("AssignAmountInWei", flagSet).Return(tt.args.value, tt.args.valueErr)
 			utilsMock.On("CheckEthBalanceIsZero", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return()
 			utilsMock.On("AssignStakerId", flagSet, mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.stakerId, tt.args.stakerIdErr)
+			utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(bindings.StructsStaker{Stake: big.NewInt(10000)}, tt.args.stakerErr)
+			utilsPkgMock.On("GetSRZRTotalSupply", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(big.NewInt(10000), tt.args.totalSupplyErr)
+			utilsMock.On("ConvertSRZRToRZR", mock.AnythingOfType("*big.Int"), mock.AnythingOfType("*big.Int"), mock.AnythingOfType("*big.Int")).Return(tt.args.value)
+			flagSetUtilsMock.On("GetBoolForce", flagSet).Return(false, nil)
+			cmdUtilsMock.On("CheckStakeSafety", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32"), mock.AnythingOfType("*big.Int"), false).Return(tt.args.stakeSafetyErr)
 			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32")).Return(tt.args.lock, tt.args.lockErr)
 			cmdUtilsMock.On("Unstake", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.unstakeHash, tt.args.unstakeErr)
 			utilsMock.On("WaitForBlockCompletion", client, mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("AddToWithdrawQueue", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), mock.AnythingOfType("string")).Return(tt.args.addToWithdrawQueueErr)
 
-			utils := &UtilsStruct{}
+			ut := &UtilsStruct{}
 			fatal = false
 
-			utils.ExecuteUnstake(flagSet)
+			ut.ExecuteUnstake(flagSet)
 
 			if fatal != tt.expectedFatal {
 				t.Error("The ExecuteUnstake function didn't execute as expected")
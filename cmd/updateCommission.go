@@ -2,14 +2,19 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/pflag"
+	"os"
 	"razor/core"
 	"razor/core/types"
 	"razor/logger"
+	"razor/path"
 	"razor/pkg/bindings"
 	"razor/utils"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -19,8 +24,11 @@ var updateCommissionCmd = &cobra.Command{
 	Use:   "updateCommission",
 	Short: "updateCommission allows a staker to add/update the commission value",
 	Long: `Using updateCommission stakers can add or update the commission charged by them
+With --schedule the update is saved and applied by the vote command as soon as the epoch limit for updating the commission is over.
+
 Example:
-  ./razor updateCommission --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --commission 10`,
+  ./razor updateCommission --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --commission 10
+  ./razor updateCommission --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --commission 10 --schedule`,
 	Run: initialiseUpdateCommission,
 }
 
@@ -42,14 +50,22 @@ func (*UtilsStruct) ExecuteUpdateCommission(flagSet *pflag.FlagSet) {
 	logger.SetLoggerParameters(client, address)
 	razorUtils.AssignLogFile(flagSet)
 
-	password := razorUtils.AssignPassword()
-
 	commission, err := flagSetUtils.GetUint8Commission(flagSet)
 	utils.CheckError("Error in getting commission", err)
 
 	stakerId, err := razorUtils.GetStakerId(client, address)
 	utils.CheckError("Error in getting stakerId", err)
 
+	schedule, err := flagSetUtils.GetBoolSchedule(flagSet)
+	utils.CheckError("Error in getting schedule: ", err)
+	if schedule {
+		err = cmdUtils.ScheduleCommissionUpdate(client, address, stakerId, commission)
+		utils.CheckError("Error in scheduling commission update: ", err)
+		return
+	}
+
+	password := razorUtils.AssignPassword()
+
 	err = cmdUtils.UpdateCommission(config, client, types.UpdateCommissionInput{
 		Commission: commission,
 		Address:    address,
@@ -125,16 +141,113 @@ func (*UtilsStruct) UpdateCommission(config types.Configurations, client *ethcli
 	return nil
 }
 
+//This function saves the commission update of the staker to be applied by the vote command once the epoch limit for updating the commission is over
+func (*UtilsStruct) ScheduleCommissionUpdate(client *ethclient.Client, address string, stakerId uint32, commission uint8) error {
+	if stakerId == 0 {
+		return errors.New("staker doesn't exist")
+	}
+	window, err := cmdUtils.GetCommissionWindow(client, stakerId)
+	if err != nil {
+		return err
+	}
+	if commission < window.MinCommission || commission > window.MaxCommission {
+		return fmt.Errorf("commission out of range, it should be between %d and %d", window.MinCommission, window.MaxCommission)
+	}
+	if commission == window.Commission {
+		return fmt.Errorf("commission is already %d", commission)
+	}
+	fileName, err := path.PathUtilsInterface.GetCommissionScheduleFileName(address)
+	if err != nil {
+		return err
+	}
+	err = utils.UtilsInterface.SaveDataToCommissionScheduleFile(fileName, types.CommissionSchedule{
+		StakerId:       stakerId,
+		Commission:     commission,
+		ApplyFromEpoch: window.NextUpdateEpoch,
+		Status:         core.CommissionScheduled,
+	})
+	if err != nil {
+		return err
+	}
+	if window.CanUpdate {
+		log.Infof("Commission update to %d%% is scheduled, the vote command will apply it in the current epoch", commission)
+	} else {
+		timeRemaining := int64(window.NextUpdateEpoch-window.Epoch) * core.EpochLength
+		log.Infof("Commission update to %d%% is scheduled, the vote command will apply it in epoch %d (approximately %s)", commission, window.NextUpdateEpoch, razorUtils.SecondsToReadableTime(int(timeRemaining)))
+	}
+	return nil
+}
+
+//This function applies the scheduled commission update of the staker once the epoch limit for updating the commission is over, it checks every state until the context is done
+func (*UtilsStruct) AutoUpdateCommission(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	for {
+		if err := cmdUtils.ApplyCommissionSchedule(client, config, account); err != nil {
+			log.Error("Error in applying scheduled commission update: ", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(core.StateLength) * time.Second):
+		}
+	}
+}
+
+//This function updates the commission if an update is scheduled and the epoch limit for updating the commission is over
+//The schedule is marked as failed if the update fails, so that the transaction isn't sent again every state
+func (*UtilsStruct) ApplyCommissionSchedule(client *ethclient.Client, config types.Configurations, account types.Account) error {
+	fileName, err := path.PathUtilsInterface.GetCommissionScheduleFileName(account.Address)
+	if err != nil {
+		return err
+	}
+	schedule, err := utils.UtilsInterface.ReadFromCommissionScheduleFile(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if schedule.Status != core.CommissionScheduled {
+		return nil
+	}
+	window, err := cmdUtils.GetCommissionWindow(client, schedule.StakerId)
+	if err != nil {
+		return err
+	}
+	if !window.CanUpdate {
+		log.Debugf("Scheduled commission update to %d%% can be applied in epoch %d", schedule.Commission, window.NextUpdateEpoch)
+		return nil
+	}
+
+	transactionMutex.Lock()
+	err = cmdUtils.UpdateCommission(config, client, types.UpdateCommissionInput{
+		Commission: schedule.Commission,
+		Address:    account.Address,
+		Password:   account.Password,
+		StakerId:   schedule.StakerId,
+	})
+	transactionMutex.Unlock()
+	schedule.Status = core.CommissionApplied
+	if err != nil {
+		log.Errorf("Error in applying scheduled commission update to %d%%: %s", schedule.Commission, err)
+		schedule.Status = core.CommissionFailed
+	} else {
+		log.Infof("Scheduled commission update to %d%% is applied", schedule.Commission)
+	}
+	return utils.UtilsInterface.SaveDataToCommissionScheduleFile(fileName, schedule)
+}
+
 func init() {
 	var (
 		Address    string
 		Commission uint8
+		Schedule   bool
 	)
 
 	rootCmd.AddCommand(updateCommissionCmd)
 
 	updateCommissionCmd.Flags().StringVarP(&Address, "address", "a", "", "your account address")
 	updateCommissionCmd.Flags().Uint8VarP(&Commission, "commission", "c", 0, "commission")
+	updateCommissionCmd.Flags().BoolVarP(&Schedule, "schedule", "", false, "schedule the update to be applied by the vote command once the epoch limit for updating the commission is over")
 
 	addrErr := updateCommissionCmd.MarkFlagRequired("address")
 	utils.CheckError("Address error: ", addrErr)
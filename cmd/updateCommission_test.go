@@ -5,10 +5,17 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"errors"
+	"io/fs"
 	"math/big"
 	"razor/cmd/mocks"
+	"razor/core"
 	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
 	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -253,6 +260,9 @@ func TestExecuteUpdateCommission(t *testing.T) {
 		commissionErr       error
 		stakerId            uint32
 		stakerIdErr         error
+		schedule            bool
+		scheduleErr         error
+		scheduleUpdateErr   error
 		UpdateCommissionErr error
 	}
 
@@ -336,6 +346,40 @@ func TestExecuteUpdateCommission(t *testing.T) {
 			},
 			expectedFatal: true,
 		},
+		{
+			name: "Test 7: When the commission update is scheduled",
+			args: args{
+				config:     config,
+				address:    "0x000000000000000000000000000000000000dea1",
+				commission: 10,
+				stakerId:   1,
+				schedule:   true,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 8: When there is an error in fetching schedule",
+			args: args{
+				config:      config,
+				address:     "0x000000000000000000000000000000000000dea1",
+				commission:  10,
+				stakerId:    1,
+				scheduleErr: errors.New("error in fetching schedule"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 9: When there is an error in scheduling the commission update",
+			args: args{
+				config:            config,
+				address:           "0x000000000000000000000000000000000000dea1",
+				commission:        10,
+				stakerId:          1,
+				schedule:          true,
+				scheduleUpdateErr: errors.New("commission out of range"),
+			},
+			expectedFatal: true,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -360,15 +404,280 @@ func TestExecuteUpdateCommission(t *testing.T) {
 			flagsetUtilsMock.On("GetUint8Commission", flagSet).Return(tt.args.commission, tt.args.commissionErr)
 			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.stakerId, tt.args.stakerIdErr)
 			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
+			flagsetUtilsMock.On("GetBoolSchedule", flagSet).Return(tt.args.schedule, tt.args.scheduleErr)
+			cmdUtilsMock.On("ScheduleCommissionUpdate", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string"), mock.AnythingOfType("uint32"), mock.AnythingOfType("uint8")).Return(tt.args.scheduleUpdateErr)
 			cmdUtilsMock.On("UpdateCommission", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.UpdateCommissionErr)
 
 			utils := &UtilsStruct{}
 			fatal = false
 
 			utils.ExecuteUpdateCommission(flagSet)
+			if tt.args.schedule && tt.args.scheduleErr == nil {
+				utilsMock.AssertNotCalled(t, "AssignPassword")
+				cmdUtilsMock.AssertNotCalled(t, "UpdateCommission", mock.Anything, mock.Anything, mock.Anything)
+			}
 			if fatal != tt.expectedFatal {
 				t.Error("The ExecuteUpdateCommission function didn't execute as expected")
 			}
 		})
 	}
 }
+
+func TestScheduleCommissionUpdate(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dea1"
+
+	type args struct {
+		stakerId    uint32
+		commission  uint8
+		window      types.CommissionWindow
+		windowErr   error
+		fileNameErr error
+		saveErr     error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantSaved types.CommissionSchedule
+		wantErr   bool
+	}{
+		{
+			name: "Test 1: When the commission update is scheduled for the epoch after the epoch limit",
+			args: args{
+				stakerId:   1,
+				commission: 10,
+				window:     types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20, Epoch: 100, NextUpdateEpoch: 106},
+			},
+			wantSaved: types.CommissionSchedule{StakerId: 1, Commission: 10, ApplyFromEpoch: 106, Status: core.CommissionScheduled},
+			wantErr:   false,
+		},
+		{
+			name: "Test 2: When the commission can be updated in the current epoch",
+			args: args{
+				stakerId:   1,
+				commission: 10,
+				window:     types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20, Epoch: 100, NextUpdateEpoch: 100, CanUpdate: true},
+			},
+			wantSaved: types.CommissionSchedule{StakerId: 1, Commission: 10, ApplyFromEpoch: 100, Status: core.CommissionScheduled},
+			wantErr:   false,
+		},
+		{
+			name: "Test 3: When the staker doesn't exist",
+			args: args{
+				stakerId:   0,
+				commission: 10,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting the commission window",
+			args: args{
+				stakerId:   1,
+				commission: 10,
+				windowErr:  errors.New("window error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the commission is more than the max commission",
+			args: args{
+				stakerId:   1,
+				commission: 30,
+				window:     types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the commission is the same as the current commission",
+			args: args{
+				stakerId:   1,
+				commission: 5,
+				window:     types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in getting the file name",
+			args: args{
+				stakerId:    1,
+				commission:  10,
+				window:      types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20},
+				fileNameErr: errors.New("path error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in saving the schedule",
+			args: args{
+				stakerId:   1,
+				commission: 10,
+				window:     types.CommissionWindow{StakerId: 1, Commission: 5, MinCommission: 1, MaxCommission: 20, Epoch: 100, NextUpdateEpoch: 100, CanUpdate: true},
+				saveErr:    errors.New("save error"),
+			},
+			wantSaved: types.CommissionSchedule{StakerId: 1, Commission: 10, ApplyFromEpoch: 100, Status: core.CommissionScheduled},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var saved types.CommissionSchedule
+			cmdUtilsMock.On("GetCommissionWindow", mock.AnythingOfType("*ethclient.Client"), tt.args.stakerId).Return(tt.args.window, tt.args.windowErr)
+			pathUtilsMock.On("GetCommissionScheduleFileName", address).Return("", tt.args.fileNameErr)
+			utilsPkgMock.On("SaveDataToCommissionScheduleFile", mock.Anything, mock.AnythingOfType("types.CommissionSchedule")).Run(func(args mock.Arguments) {
+				saved = args.Get(1).(types.CommissionSchedule)
+			}).Return(tt.args.saveErr)
+			utilsMock.On("SecondsToReadableTime", mock.AnythingOfType("int")).Return("2h0m0s")
+
+			ut := &UtilsStruct{}
+			err := ut.ScheduleCommissionUpdate(client, address, tt.args.stakerId, tt.args.commission)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ScheduleCommissionUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(saved, tt.wantSaved) {
+				t.Errorf("Saved schedule = %v, want %v", saved, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestApplyCommissionSchedule(t *testing.T) {
+	var client *ethclient.Client
+	var config types.Configurations
+	account := types.Account{Address: "0x000000000000000000000000000000000000dea1", Password: "test"}
+	schedule := types.CommissionSchedule{StakerId: 1, Commission: 10, ApplyFromEpoch: 106, Status: core.CommissionScheduled}
+
+	type args struct {
+		schedule            types.CommissionSchedule
+		scheduleErr         error
+		window              types.CommissionWindow
+		windowErr           error
+		updateCommissionErr error
+		saveErr             error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantUpdated bool
+		wantStatus  string
+		wantErr     bool
+	}{
+		{
+			name: "Test 1: When the scheduled commission update is applied",
+			args: args{
+				schedule: schedule,
+				window:   types.CommissionWindow{StakerId: 1, Epoch: 106, NextUpdateEpoch: 106, CanUpdate: true},
+			},
+			wantUpdated: true,
+			wantStatus:  core.CommissionApplied,
+			wantErr:     false,
+		},
+		{
+			name: "Test 2: When the epoch limit for updating the commission isn't over",
+			args: args{
+				schedule: schedule,
+				window:   types.CommissionWindow{StakerId: 1, Epoch: 100, NextUpdateEpoch: 106},
+			},
+			wantUpdated: false,
+			wantErr:     false,
+		},
+		{
+			name: "Test 3: When no commission update is scheduled",
+			args: args{
+				scheduleErr: fs.ErrNotExist,
+			},
+			wantUpdated: false,
+			wantErr:     false,
+		},
+		{
+			name: "Test 4: When the scheduled commission update is already applied",
+			args: args{
+				schedule: types.CommissionSchedule{StakerId: 1, Commission: 10, ApplyFromEpoch: 106, Status: core.CommissionApplied},
+			},
+			wantUpdated: false,
+			wantErr:     false,
+		},
+		{
+			name: "Test 5: When the commission update fails",
+			args: args{
+				schedule:            schedule,
+				window:              types.CommissionWindow{StakerId: 1, Epoch: 106, NextUpdateEpoch: 106, CanUpdate: true},
+				updateCommissionErr: errors.New("update error"),
+			},
+			wantUpdated: true,
+			wantStatus:  core.CommissionFailed,
+			wantErr:     false,
+		},
+		{
+			name: "Test 6: When there is an error in reading the schedule",
+			args: args{
+				scheduleErr: errors.New("read error"),
+			},
+			wantUpdated: false,
+			wantErr:     true,
+		},
+		{
+			name: "Test 7: When there is an error in getting the commission window",
+			args: args{
+				schedule:  schedule,
+				windowErr: errors.New("window error"),
+			},
+			wantUpdated: false,
+			wantErr:     true,
+		},
+		{
+			name: "Test 8: When there is an error in saving the schedule",
+			args: args{
+				schedule: schedule,
+				window:   types.CommissionWindow{StakerId: 1, Epoch: 106, NextUpdateEpoch: 106, CanUpdate: true},
+				saveErr:  errors.New("save error"),
+			},
+			wantUpdated: true,
+			wantStatus:  core.CommissionApplied,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			cmdUtils = cmdUtilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var savedStatus string
+			pathUtilsMock.On("GetCommissionScheduleFileName", account.Address).Return("", nil)
+			utilsPkgMock.On("ReadFromCommissionScheduleFile", mock.Anything).Return(tt.args.schedule, tt.args.scheduleErr)
+			cmdUtilsMock.On("GetCommissionWindow", mock.AnythingOfType("*ethclient.Client"), uint32(1)).Return(tt.args.window, tt.args.windowErr)
+			cmdUtilsMock.On("UpdateCommission", mock.Anything, mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("types.UpdateCommissionInput")).Return(tt.args.updateCommissionErr)
+			utilsPkgMock.On("SaveDataToCommissionScheduleFile", mock.Anything, mock.AnythingOfType("types.CommissionSchedule")).Run(func(args mock.Arguments) {
+				savedStatus = args.Get(1).(types.CommissionSchedule).Status
+			}).Return(tt.args.saveErr)
+
+			ut := &UtilsStruct{}
+			err := ut.ApplyCommissionSchedule(client, config, account)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyCommissionSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantUpdated {
+				cmdUtilsMock.AssertCalled(t, "UpdateCommission", config, client, types.UpdateCommissionInput{Commission: 10, Address: account.Address, Password: account.Password, StakerId: 1})
+			} else {
+				cmdUtilsMock.AssertNotCalled(t, "UpdateCommission", mock.Anything, mock.Anything, mock.Anything)
+			}
+			if savedStatus != tt.wantStatus {
+				t.Errorf("Saved status = %v, want %v", savedStatus, tt.wantStatus)
+			}
+		})
+	}
+}
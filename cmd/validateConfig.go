@@ -0,0 +1,65 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"fmt"
+	"razor/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "manage the config file",
+	Long: `Manages the YAML or TOML config file, which is razor.yaml in the razor directory or the file passed with --config.
+
+Example:
+  ./razor config validate
+  ./razor config validate --config /etc/razor/razor.toml`,
+}
+
+var validateConfigCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "validate the config file",
+	Long: `Checks that the config file can be parsed, that it has only known keys, each set once with a value of the right kind, and that the config with the RAZOR_* environment variables and the flags is valid.
+
+Example:
+  ./razor config validate
+  RAZOR_GAS_MULTIPLIER=1.5 ./razor config validate --config /etc/razor/razor.toml`,
+	Run: initialiseValidateConfig,
+}
+
+//This function initialises the ExecuteValidateConfig function
+func initialiseValidateConfig(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteValidateConfig()
+}
+
+//This function validates the config file and logs that it is valid
+func (*UtilsStruct) ExecuteValidateConfig() {
+	configFilePath, err := razorUtils.GetConfigFilePath()
+	utils.CheckError("Error in fetching config file path: ", err)
+
+	err = cmdUtils.ValidateConfig(configFilePath)
+	utils.CheckError("Invalid config: ", err)
+	log.Infof("Config file %s is valid", configFilePath)
+}
+
+//This function checks the config file and the config which is read from it with the environment variables and the flags
+func (*UtilsStruct) ValidateConfig(configFilePath string) error {
+	file := viper.New()
+	file.SetConfigFile(configFilePath)
+	if err := file.ReadInConfig(); err != nil {
+		return fmt.Errorf("error in reading config file %s: %w", configFilePath, err)
+	}
+	if err := validateConfigFile(file); err != nil {
+		return err
+	}
+	_, err := cmdUtils.GetConfigData()
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(validateConfigCmd)
+}
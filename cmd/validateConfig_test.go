@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"testing"
+)
+
+func TestValidateConfig(t *testing.T) {
+	type args struct {
+		content       string
+		noFile        bool
+		configDataErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the config is valid",
+			args: args{
+				content: `rpc:
+  provider: https://rpc.razor.network
+gas:
+  multiplier: 1.5
+`,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the config file doesn't exist",
+			args: args{
+				noFile: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When the config file can't be parsed",
+			args: args{
+				content: "rpc: [provider",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the config file has an unknown key",
+			args: args{
+				content: "gasmultiplyer: 1.5\n",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the config is invalid",
+			args: args{
+				content:       "requestHeaders: hide\n",
+				configDataErr: errors.New("invalid requestHeaders"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			cmdUtils = cmdUtilsMock
+
+			configFilePath := filepath.Join(t.TempDir(), "razor.yaml")
+			if !tt.args.noFile {
+				if err := os.WriteFile(configFilePath, []byte(tt.args.content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cmdUtilsMock.On("GetConfigData").Return(types.Configurations{}, tt.args.configDataErr)
+
+			ut := &UtilsStruct{}
+			err := ut.ValidateConfig(configFilePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
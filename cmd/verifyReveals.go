@@ -0,0 +1,163 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	solsha3 "github.com/miguelmota/go-solidity-sha3"
+)
+
+/*
+VerifyRevealedValues checks the reveals of all the stakers in the current epoch locally.
+For every reveal it verifies that the revealed merkle root and signature match the commitment of the staker,
+that the revealed leaves are the ones assigned to the staker and that the merkle proofs of the revealed values are valid.
+The inconsistencies found are only logged, as they indicate a client bug or an attack even if there is nothing to dispute.
+*/
+func (*UtilsStruct) VerifyRevealedValues(client *ethclient.Client, blockNumber *big.Int, epoch uint32) ([]types.RevealInconsistency, error) {
+	fromBlock, err := utils.UtilsInterface.CalculateBlockNumberAtEpochBeginning(client, core.EpochLength, blockNumber)
+	if err != nil {
+		return nil, errors.New("Not able to Fetch Block: " + err.Error())
+	}
+	query := ethereum.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   blockNumber,
+		Addresses: []common.Address{
+			common.HexToAddress(core.VoteManagerAddress),
+		},
+	}
+	logs, err := utils.UtilsInterface.FilterLogsInChunks(client, query)
+	if err != nil {
+		return nil, err
+	}
+	contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(bindings.VoteManagerABI))
+	if err != nil {
+		return nil, err
+	}
+	salt, err := cmdUtils.GetSalt(client, epoch)
+	if err != nil {
+		return nil, err
+	}
+	numActiveCollections, err := utils.UtilsInterface.GetNumActiveCollections(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var inconsistencies []types.RevealInconsistency
+	for _, vLog := range logs {
+		// topics[0] is the event id and topics[1] gives the staker id of the revealer
+		if len(vLog.Topics) < 2 || vLog.Topics[0] != contractAbi.Events["Revealed"].ID {
+			continue
+		}
+		data, unpackErr := abiUtils.Unpack(contractAbi, "Revealed", vLog.Data)
+		if unpackErr != nil {
+			log.Error(unpackErr)
+			continue
+		}
+		if epoch != data[0].(uint32) {
+			continue
+		}
+		stakerId := uint32(vLog.Topics[1].Big().Uint64())
+
+		tree, signature, err := cmdUtils.GetRevealDataFromTransaction(client, vLog.TxHash)
+		if err != nil {
+			log.Errorf("Error in fetching reveal data of staker %d: %s", stakerId, err)
+			continue
+		}
+		commitment, err := utils.VoteManagerInterface.Commitments(client, stakerId)
+		if err != nil {
+			log.Errorf("Error in fetching commitment of staker %d: %s", stakerId, err)
+			continue
+		}
+
+		secret := crypto.Keccak256(signature)
+		seed := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(salt[:]), "0x" + hex.EncodeToString(secret)})
+
+		var reasons []string
+		expectedCommitment := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(tree.Root[:]), "0x" + hex.EncodeToString(seed)})
+		if commitment.Epoch != epoch || !bytes.Equal(expectedCommitment, commitment.CommitmentHash[:]) {
+			reasons = append(reasons, "revealed merkle root and signature don't match the commitment")
+		}
+
+		_, seqAllottedCollections, err := utils.UtilsInterface.GetAssignedCollections(client, numActiveCollections, seed)
+		if err != nil {
+			log.Errorf("Error in fetching assigned collections of staker %d: %s", stakerId, err)
+			continue
+		}
+		if len(seqAllottedCollections) != len(tree.Values) {
+			reasons = append(reasons, fmt.Sprintf("%d values are revealed but %d collections are assigned", len(tree.Values), len(seqAllottedCollections)))
+		} else {
+			for i := range tree.Values {
+				if uint64(tree.Values[i].LeafId) != seqAllottedCollections[i].Uint64() {
+					reasons = append(reasons, fmt.Sprintf("leaf %d is revealed in place of assigned leaf %d", tree.Values[i].LeafId, seqAllottedCollections[i]))
+				}
+			}
+		}
+
+		if len(tree.Proofs) != len(tree.Values) {
+			reasons = append(reasons, fmt.Sprintf("%d proofs are revealed for %d values", len(tree.Proofs), len(tree.Values)))
+		} else {
+			for i, value := range tree.Values {
+				if !utils.MerkleInterface.VerifyProof(tree.Proofs[i], tree.Root, value.Value, value.LeafId, numActiveCollections) {
+					reasons = append(reasons, fmt.Sprintf("merkle proof of leaf %d is invalid", value.LeafId))
+				}
+			}
+		}
+
+		for _, reason := range reasons {
+			log.Warnf("Inconsistent reveal by staker %d in epoch %d: %s", stakerId, epoch, reason)
+			inconsistencies = append(inconsistencies, types.RevealInconsistency{
+				StakerId: stakerId,
+				Reason:   reason,
+			})
+		}
+	}
+	return inconsistencies, nil
+}
+
+//This function returns the merkle tree and the signature revealed in a reveal transaction
+func (*UtilsStruct) GetRevealDataFromTransaction(client *ethclient.Client, txHash common.Hash) (bindings.StructsMerkleTree, []byte, error) {
+	txn, _, err := utils.ClientInterface.TransactionByHash(client, context.Background(), txHash)
+	if err != nil {
+		return bindings.StructsMerkleTree{}, nil, err
+	}
+	contractAbi, err := utils.ABIInterface.Parse(strings.NewReader(bindings.VoteManagerABI))
+	if err != nil {
+		return bindings.StructsMerkleTree{}, nil, err
+	}
+	method, ok := contractAbi.Methods["reveal"]
+	if !ok {
+		return bindings.StructsMerkleTree{}, nil, errors.New("reveal method not found in VoteManager ABI")
+	}
+	input := txn.Data()
+	if len(input) < 4 || !bytes.Equal(input[:4], method.ID) {
+		return bindings.StructsMerkleTree{}, nil, errors.New("transaction is not a reveal transaction")
+	}
+	var revealData struct {
+		Epoch     uint32
+		Tree      bindings.StructsMerkleTree
+		Signature []byte
+	}
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return bindings.StructsMerkleTree{}, nil, err
+	}
+	err = method.Inputs.Copy(&revealData, values)
+	if err != nil {
+		return bindings.StructsMerkleTree{}, nil, err
+	}
+	return revealData.Tree, revealData.Signature, nil
+}
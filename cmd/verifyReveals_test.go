@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	solsha3 "github.com/miguelmota/go-solidity-sha3"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"testing"
+)
+
+func TestVerifyRevealedValues(t *testing.T) {
+	var (
+		client      *ethclient.Client
+		blockNumber *big.Int
+	)
+	epoch := uint32(5)
+	stakerId := uint32(3)
+	numActiveCollections := uint16(4)
+
+	merkleTree := &utils.MerkleTreeStruct{}
+	tree := merkleTree.CreateMerkle([]*big.Int{big.NewInt(10), big.NewInt(0), big.NewInt(30), big.NewInt(0)})
+	revealedTree := bindings.StructsMerkleTree{
+		Values: []bindings.StructsAssignedAsset{
+			{LeafId: 0, Value: big.NewInt(10)},
+			{LeafId: 2, Value: big.NewInt(30)},
+		},
+		Proofs: [][][32]byte{merkleTree.GetProofPath(tree, 0), merkleTree.GetProofPath(tree, 2)},
+		Root:   merkleTree.GetMerkleRoot(tree),
+	}
+	tamperedTree := bindings.StructsMerkleTree{
+		Values: []bindings.StructsAssignedAsset{
+			{LeafId: 0, Value: big.NewInt(10)},
+			{LeafId: 2, Value: big.NewInt(31)},
+		},
+		Proofs: revealedTree.Proofs,
+		Root:   revealedTree.Root,
+	}
+
+	salt := [32]byte{1}
+	signature := []byte{1, 2, 3}
+	seed := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(salt[:]), "0x" + hex.EncodeToString(crypto.Keccak256(signature))})
+	commitmentHash := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(revealedTree.Root[:]), "0x" + hex.EncodeToString(seed)})
+	commitment := types.Commitment{Epoch: epoch}
+	copy(commitment.CommitmentHash[:], commitmentHash)
+
+	revealedEventId := common.HexToHash("0x01")
+	contractAbi := abi.ABI{
+		Events: map[string]abi.Event{
+			"Revealed": {ID: revealedEventId},
+		},
+	}
+	logs := []Types.Log{
+		{
+			Topics: []common.Hash{revealedEventId, common.BigToHash(big.NewInt(int64(stakerId)))},
+		},
+	}
+
+	type args struct {
+		fromBlockErr            error
+		logs                    []Types.Log
+		logsErr                 error
+		contractAbiErr          error
+		salt                    [32]byte
+		saltErr                 error
+		numActiveCollectionsErr error
+		data                    []interface{}
+		tree                    bindings.StructsMerkleTree
+		signature               []byte
+		revealDataErr           error
+		commitment              types.Commitment
+		commitmentErr           error
+		seqAllottedCollections  []*big.Int
+	}
+	tests := []struct {
+		name                string
+		args                args
+		wantInconsistencies int
+		wantErr             bool
+	}{
+		{
+			name: "Test 1: When the reveal is consistent",
+			args: args{
+				logs:                   logs,
+				salt:                   salt,
+				data:                   []interface{}{epoch},
+				tree:                   revealedTree,
+				signature:              signature,
+				commitment:             commitment,
+				seqAllottedCollections: []*big.Int{big.NewInt(0), big.NewInt(2)},
+			},
+			wantInconsistencies: 0,
+			wantErr:             false,
+		},
+		{
+			name: "Test 2: When the reveal doesn't match the commitment",
+			args: args{
+				logs:                   logs,
+				salt:                   salt,
+				data:                   []interface{}{epoch},
+				tree:                   revealedTree,
+				signature:              signature,
+				commitment:             types.Commitment{Epoch: epoch, CommitmentHash: [32]byte{2}},
+				seqAllottedCollections: []*big.Int{big.NewInt(0), big.NewInt(2)},
+			},
+			wantInconsistencies: 1,
+			wantErr:             false,
+		},
+		{
+			name: "Test 3: When the revealed leaves are not the assigned ones",
+			args: args{
+				logs:                   logs,
+				salt:                   salt,
+				data:                   []interface{}{epoch},
+				tree:                   revealedTree,
+				signature:              signature,
+				commitment:             commitment,
+				seqAllottedCollections: []*big.Int{big.NewInt(0), big.NewInt(3)},
+			},
+			wantInconsistencies: 1,
+			wantErr:             false,
+		},
+		{
+			name: "Test 4: When the number of revealed values doesn't match the number of assigned collections",
+			args: args{
+				logs:                   logs,
+				salt:                   salt,
+				data:                   []interface{}{epoch},
+				tree:                   revealedTree,
+				signature:              signature,
+				commitment:             commitment,
+				seqAllottedCollections: []*big.Int{big.NewInt(0)},
+			},
+			wantInconsistencies: 1,
+			wantErr:             false,
+		},
+		{
+			name: "Test 5: When the merkle proof of a revealed value is invalid",
+			args: args{
+				logs:                   logs,
+				salt:                   salt,
+				data:                   []interface{}{epoch},
+				tree:                   tamperedTree,
+				signature:              signature,
+				commitment:             commitment,
+				seqAllottedCollections: []*big.Int{big.NewInt(0), big.NewInt(2)},
+			},
+			wantInconsistencies: 1,
+			wantErr:             false,
+		},
+		{
+			name: "Test 6: When the reveal belongs to a different epoch",
+			args: args{
+				logs: logs,
+				salt: salt,
+				data: []interface{}{epoch - 1},
+			},
+			wantInconsistencies: 0,
+			wantErr:             false,
+		},
+		{
+			name: "Test 7: When there is an error in fetching reveal data from transaction",
+			args: args{
+				logs:          logs,
+				salt:          salt,
+				data:          []interface{}{epoch},
+				revealDataErr: errors.New("transaction error"),
+			},
+			wantInconsistencies: 0,
+			wantErr:             false,
+		},
+		{
+			name: "Test 8: When there is an error in fetching commitment",
+			args: args{
+				logs:          logs,
+				salt:          salt,
+				data:          []interface{}{epoch},
+				tree:          revealedTree,
+				signature:     signature,
+				commitmentErr: errors.New("commitment error"),
+			},
+			wantInconsistencies: 0,
+			wantErr:             false,
+		},
+		{
+			name: "Test 9: When there is an error in getting fromBlock",
+			args: args{
+				fromBlockErr: errors.New("fromBlock error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When there is an error in getting logs",
+			args: args{
+				logsErr: errors.New("logs error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 11: When there is an error in getting contractAbi",
+			args: args{
+				contractAbiErr: errors.New("abi error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 12: When there is an error in getting salt",
+			args: args{
+				saltErr: errors.New("salt error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 13: When there is an error in getting number of active collections",
+			args: args{
+				salt:                    salt,
+				numActiveCollectionsErr: errors.New("numActiveCollections error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+			abiMock := new(mocks.AbiInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			abiUtilsMock := new(mocks2.ABIUtils)
+			voteManagerMock := new(mocks2.VoteManagerUtils)
+
+			cmdUtils = cmdUtilsMock
+			abiUtils = abiMock
+			utils.UtilsInterface = utilsPkgMock
+			utils.ABIInterface = abiUtilsMock
+			utils.VoteManagerInterface = voteManagerMock
+			utils.MerkleInterface = merkleTree
+
+			utilsPkgMock.On("CalculateBlockNumberAtEpochBeginning", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(big.NewInt(0), tt.args.fromBlockErr)
+			utilsPkgMock.On("FilterLogsInChunks", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
+			abiUtilsMock.On("Parse", mock.Anything).Return(contractAbi, tt.args.contractAbiErr)
+			cmdUtilsMock.On("GetSalt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.salt, tt.args.saltErr)
+			utilsPkgMock.On("GetNumActiveCollections", mock.AnythingOfType("*ethclient.Client")).Return(numActiveCollections, tt.args.numActiveCollectionsErr)
+			abiMock.On("Unpack", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(tt.args.data, nil)
+			cmdUtilsMock.On("GetRevealDataFromTransaction", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.tree, tt.args.signature, tt.args.revealDataErr)
+			voteManagerMock.On("Commitments", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.commitment, tt.args.commitmentErr)
+			utilsPkgMock.On("GetAssignedCollections", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint16"), mock.Anything).Return(nil, tt.args.seqAllottedCollections, nil)
+
+			ut := &UtilsStruct{}
+			got, err := ut.VerifyRevealedValues(client, blockNumber, epoch)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyRevealedValues() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantInconsistencies {
+				t.Errorf("VerifyRevealedValues() got %d inconsistencies = %v, want %d", len(got), got, tt.wantInconsistencies)
+			}
+			for _, inconsistency := range got {
+				if inconsistency.StakerId != stakerId {
+					t.Errorf("VerifyRevealedValues() got stakerId = %d, want %d", inconsistency.StakerId, stakerId)
+				}
+			}
+		})
+	}
+}
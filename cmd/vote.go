@@ -15,18 +15,22 @@ import (
 	"razor/core"
 	"razor/core/types"
 	"razor/logger"
+	"razor/metrics"
 	"razor/pkg/bindings"
 	"razor/utils"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/spf13/pflag"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	solsha3 "github.com/miguelmota/go-solidity-sha3"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var voteCmd = &cobra.Command{
@@ -54,10 +58,68 @@ func (*UtilsStruct) ExecuteVote(flagSet *pflag.FlagSet) {
 	address, err := flagSetUtils.GetStringAddress(flagSet)
 	utils.CheckError("Error in getting address: ", err)
 
+	mode, err := flagSetUtils.GetStringMode(flagSet)
+	utils.CheckError("Error in getting mode: ", err)
+	if !utils.Contains(core.VoteModes, mode) {
+		log.Fatalf("Mode should be one of %s", strings.Join(core.VoteModes, ", "))
+	}
+	if mode == core.ObserverMode {
+		// No account is unlocked in observer mode, the address is only watched
+		cmdUtils.ExecuteObserver(flagSet, config, client, address)
+		return
+	}
+
+	// Several accounts selected with --account are voted for in one process, the address is the first of them
+	accountNames, err := flagSetUtils.GetStringSliceAccount(flagSet)
+	utils.CheckError("Error in getting accounts: ", err)
+	addresses := []string{address}
+	if len(accountNames) > 1 {
+		addresses, err = cmdUtils.ResolveAccounts(accountNames)
+		utils.CheckError("Error in selecting accounts: ", err)
+	}
+
+	err = razorUtils.LockDataDir(strings.Join(addresses, ","))
+	utils.CheckError("Error in locking data directory: ", err)
+
 	logger.SetLoggerParameters(client, address)
 	razorUtils.AssignLogFile(flagSet)
 
-	password := razorUtils.AssignPassword()
+	for _, accountAddress := range addresses {
+		err = cmdUtils.CheckVotingEligibility(client, accountAddress)
+		utils.CheckError("Staker can't vote: ", err)
+	}
+
+	useKeychain, err := flagSetUtils.GetBoolUseKeychain(flagSet)
+	utils.CheckError("Error in getting useKeychain: ", err)
+	var password string
+	if useKeychain {
+		password, err = razorUtils.GetKeychainPassword(address)
+		utils.CheckError("Error in getting password from keychain: ", err)
+	} else {
+		password = razorUtils.AssignPassword()
+	}
+	accounts := []types.Account{{Address: address, Password: password}}
+	for _, accountAddress := range addresses[1:] {
+		var accountPassword string
+		if useKeychain {
+			accountPassword, err = razorUtils.GetKeychainPassword(accountAddress)
+			utils.CheckError("Error in getting password from keychain: ", err)
+		} else {
+			log.Infof("Reading the password of %s", accountAddress)
+			accountPassword, err = razorUtils.ReadPassword(accountAddress)
+			utils.CheckError("Error in reading password: ", err)
+		}
+		accounts = append(accounts, types.Account{Address: accountAddress, Password: accountPassword})
+	}
+
+	encryptState, err := flagSetUtils.GetBoolEncryptState(flagSet)
+	utils.CheckError("Error in getting encryptState: ", err)
+	if encryptState && len(accounts) > 1 {
+		log.Fatal("State encryption can't be used with several accounts as the state is encrypted with the password of one account")
+	} else if encryptState {
+		err = utils.InitStateEncryption(password)
+		utils.CheckError("Error in initialising state encryption: ", err)
+	}
 
 	isRogue, err := flagSetUtils.GetBoolRogue(flagSet)
 	utils.CheckError("Error in getting rogue status: ", err)
@@ -65,74 +127,419 @@ func (*UtilsStruct) ExecuteVote(flagSet *pflag.FlagSet) {
 	rogueMode, err := flagSetUtils.GetStringSliceRogueMode(flagSet)
 	utils.CheckError("Error in getting rogue modes: ", err)
 
-	rogueData := types.Rogue{
-		IsRogue:   isRogue,
-		RogueMode: rogueMode,
+	faultInjectionFile, err := flagSetUtils.GetStringFaultInjection(flagSet)
+	utils.CheckError("Error in getting fault injection config file: ", err)
+	if faultInjectionFile != "" {
+		err = utils.LoadFaultInjectionConfig(faultInjectionFile)
+		utils.CheckError("Error in loading fault injection config: ", err)
+	}
+
+	isChaos, err := flagSetUtils.GetBoolChaos(flagSet)
+	utils.CheckError("Error in getting chaos status: ", err)
+
+	chaosConfigFile, err := flagSetUtils.GetStringChaosConfig(flagSet)
+	utils.CheckError("Error in getting chaos config file: ", err)
+	if isChaos {
+		err = utils.EnableChaosMode(chaosConfigFile)
+		utils.CheckError("Error in enabling chaos mode: ", err)
+	}
+
+	notificationTemplatesFile, err := flagSetUtils.GetStringNotificationTemplates(flagSet)
+	utils.CheckError("Error in getting notification templates file: ", err)
+	if notificationTemplatesFile != "" {
+		err = utils.LoadNotificationTemplates(notificationTemplatesFile)
+		utils.CheckError("Error in loading notification templates: ", err)
+	}
+
+	alertWebhooks, err := flagSetUtils.GetStringSliceAlertWebhooks(flagSet)
+	utils.CheckError("Error in getting alert webhooks: ", err)
+	if len(alertWebhooks) == 0 {
+		// The webhooks of the config file are set again when the config file is reloaded
+		alertWebhooks = getConfigAlertWebhooks()
+		alertWebhooksFromConfig = true
+	}
+	err = utils.SetAlertWebhooks(alertWebhooks)
+	utils.CheckError("Error in setting alert webhooks: ", err)
+
+	alertBalanceThreshold, err := flagSetUtils.GetFloat32AlertBalanceThreshold(flagSet)
+	utils.CheckError("Error in getting alert balance threshold: ", err)
+	config.AlertBalanceThreshold = alertBalanceThreshold
+
+	alertRazorBalanceThreshold, err := flagSetUtils.GetFloat32AlertRazorBalanceThreshold(flagSet)
+	utils.CheckError("Error in getting alert RZR balance threshold: ", err)
+	config.AlertRazorBalanceThreshold = alertRazorBalanceThreshold
+
+	alertRpcDownMinutes, err := flagSetUtils.GetUint32AlertRpcDownMinutes(flagSet)
+	utils.CheckError("Error in getting alert rpc down minutes: ", err)
+	config.AlertRpcDownMinutes = alertRpcDownMinutes
+
+	isCanary, err := flagSetUtils.GetBoolCanary(flagSet)
+	utils.CheckError("Error in getting canary status: ", err)
+	if isCanary && len(accounts) > 1 {
+		log.Fatal("Canary mode can't be used with several accounts")
+	}
+	if isCanary {
+		canaryFileName, err := razorUtils.GetCanaryFileName(address)
+		utils.CheckError("Error in getting canary file name: ", err)
+		utils.EnableCanaryMode(canaryFileName)
+	}
+
+	rpcDebug, err := flagSetUtils.GetBoolRpcDebug(flagSet)
+	utils.CheckError("Error in getting rpcDebug status: ", err)
+	if rpcDebug {
+		rpcDebugDuration, err := flagSetUtils.GetUint32RpcDebugDuration(flagSet)
+		utils.CheckError("Error in getting rpcDebugDuration: ", err)
+		if rpcDebugDuration == 0 {
+			log.Fatal("RPC debug duration should be greater than 0")
+		}
+		if !strings.HasPrefix(config.Provider, "http://") && !strings.HasPrefix(config.Provider, "https://") {
+			log.Warn("RPC debug capture is only supported for http(s) providers, no requests will be captured")
+		}
+		rpcDebugFileName, err := razorUtils.GetRPCDebugFileName(address)
+		utils.CheckError("Error in getting RPC debug file name: ", err)
+		utils.EnableRPCDebugCapture(rpcDebugFileName, time.Duration(rpcDebugDuration)*time.Second)
+	}
+
+	attest, err := flagSetUtils.GetBoolAttest(flagSet)
+	utils.CheckError("Error in getting attest status: ", err)
+	if attest {
+		attestationKeyFile, err := flagSetUtils.GetStringAttestationKeyFile(flagSet)
+		utils.CheckError("Error in getting attestation key file: ", err)
+		err = utils.EnableAttestations(attestationKeyFile)
+		utils.CheckError("Error in enabling attestations: ", err)
+	}
+
+	archiveResponses, err := flagSetUtils.GetBoolArchiveResponses(flagSet)
+	utils.CheckError("Error in getting archiveResponses status: ", err)
+	if archiveResponses {
+		archiveRetentionDays, err := flagSetUtils.GetUint32ArchiveRetentionDays(flagSet)
+		utils.CheckError("Error in getting archive retention days: ", err)
+		err = utils.EnableResponseArchive(archiveRetentionDays)
+		utils.CheckError("Error in enabling response archive: ", err)
+	}
+
+	subscribedCollections, err := flagSetUtils.GetUintSliceSubscribedCollections(flagSet)
+	utils.CheckError("Error in getting subscribed collections: ", err)
+	if len(subscribedCollections) > 0 {
+		acknowledgeUnsubscribed, err := flagSetUtils.GetBoolAcknowledgeUnsubscribed(flagSet)
+		utils.CheckError("Error in getting acknowledgeUnsubscribed: ", err)
+		if !acknowledgeUnsubscribed {
+			log.Fatal("The previous values are committed for the assigned collections which are not subscribed and are penalised if they deviate from the medians, pass --acknowledgeUnsubscribed to accept it")
+		}
+		var collectionIds []uint16
+		for _, collectionId := range subscribedCollections {
+			collectionIds = append(collectionIds, uint16(collectionId))
+		}
+		utils.SetSubscribedCollections(collectionIds)
+	}
+
+	// The context is cancelled on SIGINT or SIGTERM so that the voting stops gracefully
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if configFilePath := viper.ConfigFileUsed(); configFilePath != "" {
+		err = watchConfigFile(ctx, configFilePath)
+		if err != nil {
+			log.Warn("Error in watching config file, the changes of the config file are applied at the next restart: ", err)
+		}
+	}
+
+	remoteConfigUrl, err := flagSetUtils.GetStringRemoteConfigUrl(flagSet)
+	utils.CheckError("Error in getting remote config url: ", err)
+	if remoteConfigUrl != "" {
+		remoteConfigSigner, err := flagSetUtils.GetStringRemoteConfigSigner(flagSet)
+		utils.CheckError("Error in getting remote config signer: ", err)
+		if !common.IsHexAddress(remoteConfigSigner) {
+			log.Fatal("Remote config signer should be a valid address")
+		}
+		remoteConfigInterval, err := flagSetUtils.GetUint32RemoteConfigInterval(flagSet)
+		utils.CheckError("Error in getting remote config interval: ", err)
+		if remoteConfigInterval == 0 {
+			log.Fatal("Remote config interval should be greater than 0")
+		}
+		remoteConfig := types.RemoteConfig{
+			Url:      remoteConfigUrl,
+			Signer:   remoteConfigSigner,
+			Interval: remoteConfigInterval,
+		}
+		go cmdUtils.PollRemoteConfig(ctx, remoteConfig)
+	}
+
+	speedUpBlocks, err := flagSetUtils.GetUint32SpeedUpBlocks(flagSet)
+	utils.CheckError("Error in getting speedUpBlocks: ", err)
+	config.SpeedUpBlocks = speedUpBlocks
+
+	healthPort, err := flagSetUtils.GetStringHealthPort(flagSet)
+	utils.CheckError("Error in getting health port: ", err)
+	if healthPort != "" {
+		go func() {
+			if err := metrics.RunHealthServer(healthPort); err != nil {
+				log.Error("Error in serving health: ", err)
+			}
+		}()
+	}
+
+	deployment, err := flagSetUtils.GetStringDeployment(flagSet)
+	utils.CheckError("Error in getting deployment: ", err)
+	if deployment != "" {
+		metrics.SetDeployment(deployment)
+	}
+
+	metricsPort, err := flagSetUtils.GetStringMetricsPort(flagSet)
+	utils.CheckError("Error in getting metrics port: ", err)
+	if metricsPort != "" {
+		go func() {
+			if err := metrics.Run(metricsPort, "", ""); err != nil {
+				log.Error("Error in serving metrics: ", err)
+			}
+		}()
+	}
+
+	isFleet, err := flagSetUtils.GetBoolFleet(flagSet)
+	utils.CheckError("Error in getting fleet status: ", err)
+	if isFleet && metricsPort == "" {
+		log.Fatal("Fleet mode needs the metrics port as the fleet report is served by the metrics server")
 	}
+	utils.SetFleetMode(isFleet)
+
+	rogueData, err := getRogueData(isRogue, rogueMode)
+	utils.CheckError("Error in getting rogue data: ", err)
 
-	account := types.Account{Address: address, Password: password}
+	autoClaimBounty, err := flagSetUtils.GetBoolAutoClaimBounty(flagSet)
+	utils.CheckError("Error in getting autoClaimBounty: ", err)
+
+	autoWithdraw, err := flagSetUtils.GetBoolAutoWithdraw(flagSet)
+	utils.CheckError("Error in getting autoWithdraw: ", err)
+
+	for _, account := range accounts {
+		if autoClaimBounty {
+			go cmdUtils.AutoClaimBounties(ctx, client, config, account)
+		}
+		if autoWithdraw {
+			go cmdUtils.AutoWithdraw(ctx, client, config, account)
+		}
 
-	cmdUtils.HandleExit()
+		// The stake changes are recorded in the ledger while voting, so that the rewards command has them already
+		go cmdUtils.AccumulateRewards(ctx, client, account.Address)
 
-	if err := cmdUtils.Vote(context.Background(), config, client, rogueData, account); err != nil {
+		// A commission update scheduled with updateCommission --schedule is applied once the epoch limit allows it
+		go cmdUtils.AutoUpdateCommission(ctx, client, config, account)
+	}
+
+	if utils.IsWebSocketProvider(config.Provider) {
+		go utils.SubscribeNewHeads(ctx, client)
+	}
+
+	cmdUtils.HandleExit(cancel)
+
+	if len(accounts) > 1 {
+		err = cmdUtils.VoteAccounts(ctx, config, client, rogueData, accounts)
+	} else {
+		err = cmdUtils.Vote(ctx, config, client, rogueData, accounts[0])
+	}
+	utils.CloseStateDB()
+	if err != nil {
 		log.Errorf("%s\n", err)
 		osUtils.Exit(1)
 	}
 }
 
-//This function handles the exit and listens for CTRL+C
-func (*UtilsStruct) HandleExit() {
-	// listen for CTRL+C
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
+//This function sets the stake and balance metrics of the staker from their values in ether denomination
+func setStakerMetrics(stake *big.Float, sRZRBalance *big.Float, ethBalance *big.Float) {
+	stakeValue, _ := stake.Float64()
+	metrics.StakeMetric.Set(stakeValue)
+	sRZRBalanceValue, _ := sRZRBalance.Float64()
+	metrics.BalanceMetric.WithLabelValues("sRZR").Set(sRZRBalanceValue)
+	ethBalanceValue, _ := ethBalance.Float64()
+	metrics.BalanceMetric.WithLabelValues("eth").Set(ethBalanceValue)
+}
+
+//This function handles the exit and listens for CTRL+C and SIGTERM
+//On the first signal the voting is stopped once the block being handled and its transactions are completed, the second signal terminates immediately
+func (*UtilsStruct) HandleExit(cancel context.CancelFunc) {
+	// listen for CTRL+C and the termination by the service manager
 	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt)
-	defer func() {
-		signal.Stop(signalChan)
-		cancel()
-	}()
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		select {
-		case <-signalChan:
-			log.Warn("If you don't unstake and withdraw your coins, you may get inactivity penalty!")
-			log.Info("Press CTRL+C again to terminate.")
-		case <-ctx.Done():
-		}
+		sig := <-signalChan
+		log.Warnf("Received %s, stopping after the block being handled and its transactions are completed", sig)
+		log.Warn("If you don't unstake and withdraw your coins, you may get inactivity penalty!")
+		log.Info("Press CTRL+C again to terminate immediately.")
+		cancel()
 		<-signalChan // second signal, hard exit
 		os.Exit(2)
 	}()
 }
 
+//This function checks that the staker can earn rewards before voting, so that no gas is wasted on commits which can't be rewarded
+func (*UtilsStruct) CheckVotingEligibility(client *ethclient.Client, address string) error {
+	if utilsInterface.IsFlagPassed("disputeOnly") {
+		// Disputes are raised without any stake
+		return nil
+	}
+	stakerId, err := razorUtils.GetStakerId(client, address)
+	if err != nil {
+		return err
+	}
+	if stakerId == 0 {
+		return fmt.Errorf("staker doesn't exist for %s, stake at least the minimum safe razor with the addStake command before voting", address)
+	}
+	staker, err := razorUtils.GetStaker(client, stakerId)
+	if err != nil {
+		return err
+	}
+	if staker.IsSlashed {
+		return errors.New("staker is slashed and can't earn rewards anymore, unstake and withdraw the remaining stake instead of voting")
+	}
+
+	minSafeRazor, err := utils.UtilsInterface.GetMinSafeRazor(client)
+	if err != nil {
+		return err
+	}
+	minStakeAmount, err := utils.UtilsInterface.GetMinStakeAmount(client)
+	if err != nil {
+		return err
+	}
+	minVotingStake := getMinVotingStake(minSafeRazor, minStakeAmount)
+	if staker.Stake.Cmp(minVotingStake) < 0 {
+		missingStake := new(big.Int).Sub(minVotingStake, staker.Stake)
+		return fmt.Errorf("stake of %g razors is below the %g razors required to earn rewards, add at least %g razors with the addStake command before voting", utils.GetAmountInDecimal(staker.Stake), utils.GetAmountInDecimal(minVotingStake), utils.GetAmountInDecimal(missingStake))
+	}
+	return nil
+}
+
 //This function handles all the states of voting
 func (*UtilsStruct) Vote(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, account types.Account) error {
+	return voteLoop(ctx, config, client, func(config types.Configurations, blockNumber *big.Int) {
+		cmdUtils.HandleBlock(client, account, blockNumber, config, rogueData)
+	})
+}
+
+//This function calls handleBlock on every new block until the voting is stopped
+func voteLoop(ctx context.Context, config types.Configurations, client *ethclient.Client, handleBlock func(config types.Configurations, blockNumber *big.Int)) error {
 	header, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
 	utils.CheckError("Error in getting block: ", err)
 	for {
 		select {
 		case <-ctx.Done():
+			waitForInFlightTransactions()
 			return nil
 		default:
-			latestHeader, err := utils.UtilsInterface.GetLatestBlockWithRetry(client)
-			if err != nil {
-				log.Error("Error in fetching block: ", err)
-				continue
+			// The heads are polled if they aren't subscribed or if no head arrived from the subscription in time
+			latestHeader, subscribed := utils.WaitForNewHead(header.Number, time.Duration(core.HeadSubscriptionTimeout)*time.Second)
+			if !subscribed {
+				latestHeader, err = utils.UtilsInterface.GetLatestBlockWithRetry(client)
+				checkRpcDown(err, config.AlertRpcDownMinutes, time.Now())
+				if err != nil {
+					log.Error("Error in fetching block: ", err)
+					metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.RpcConnected = false })
+					continue
+				}
 			}
 			if latestHeader.Number.Cmp(header.Number) != 0 {
 				header = latestHeader
-				cmdUtils.HandleBlock(client, account, latestHeader.Number, config, rogueData)
+				metrics.UpdateNodeStatus(func(status *types.NodeStatus) {
+					status.RpcConnected = true
+					status.BlockNumber = latestHeader.Number.Uint64()
+					status.LastUpdated = time.Now().Unix()
+				})
+				config = applyConfigFileChanges(client, config)
+				config = applyLatestRemoteConfig(config)
+				if utils.IsFleetMode() {
+					utils.ReportFleetConfig(config)
+				}
+				if !checkChainSync(client, latestHeader) {
+					continue
+				}
+				// The contract calls cached in the previous epoch are read again in the new one
+				utils.SetContractCacheEpoch(uint32(latestHeader.Time / uint64(core.EpochLength)))
+				if err := utils.IndexEvents(client, latestHeader); err != nil {
+					log.Error("Error in indexing events, the events are filtered from the chain: ", err)
+				}
+				if ctx.Err() != nil {
+					// No action is started on the new block once the voting is stopped
+					continue
+				}
+				transactionMutex.Lock()
+				handleBlock(config, latestHeader.Number)
+				transactionMutex.Unlock()
+			} else {
+				// A new block can't be fetched before the average block time of the chain
+				timeUtils.Sleep(utils.UtilsInterface.GetAverageBlockTime(client))
 			}
 		}
 	}
 }
 
+//This function waits for the transaction of a bounty claim which is in flight when the voting is stopped
+//The transactions of the blocks are completed before the vote loop checks the context again
+func waitForInFlightTransactions() {
+	transactionMutex.Lock()
+	defer transactionMutex.Unlock()
+	log.Info("Voting is stopped, the next start resumes from the saved state")
+}
+
+//Whether the voting is paused as the provider has a stale view of the chain
+var chainSyncPaused bool
+
+//This function returns false while the provider is syncing or its latest block is too old, so that no action is taken on a stale view of the chain
+//The voting is paused and resumed with an alert
+func checkChainSync(client *ethclient.Client, header *Types.Header) bool {
+	epoch := uint32(header.Time / uint64(core.EpochLength))
+	err := utils.CheckChainSync(client, header)
+	if err != nil {
+		log.Warn("Voting is paused as the provider has a stale view of the chain: ", err)
+		if !chainSyncPaused {
+			chainSyncPaused = true
+			metrics.ChainSyncPausedMetric.Set(1)
+			metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.ChainSyncPaused = true })
+			utils.Notify(types.Notification{
+				Event:  "chainSync",
+				Epoch:  epoch,
+				Status: "paused, " + err.Error(),
+			})
+		}
+		return false
+	}
+	if chainSyncPaused {
+		chainSyncPaused = false
+		metrics.ChainSyncPausedMetric.Set(0)
+		metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.ChainSyncPaused = false })
+		log.Info("Provider has caught up with the chain, voting is resumed")
+		utils.Notify(types.Notification{
+			Event:  "chainSync",
+			Epoch:  epoch,
+			Status: "resumed",
+		})
+	}
+	return true
+}
+
 var (
 	_commitData      types.CommitData
 	lastVerification uint32
 	blockConfirmed   uint32
 	disputeData      types.DisputeFileData
+	// In canary and dry run mode, the epochs in which the node last took the actions as the transactions of the actions aren't sent
+	canaryLastEpochs = make(map[string]uint32)
 )
 
+//This function returns the last epoch in which the action was taken, in canary and dry run mode it is the last epoch in which the node took the action
+func getLastActionEpoch(action string, lastEpochOnChain uint32) uint32 {
+	if !utils.IsNoSendMode() {
+		return lastEpochOnChain
+	}
+	return canaryLastEpochs[action]
+}
+
+//This function records the epoch in which the node took the action in canary and dry run mode
+func setCanaryLastEpoch(action string, epoch uint32) {
+	if utils.IsNoSendMode() {
+		canaryLastEpochs[action] = epoch
+	}
+}
+
 //This function handles the block
 func (*UtilsStruct) HandleBlock(client *ethclient.Client, account types.Account, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue) {
 	state, err := razorUtils.GetDelayedState(client, config.BufferPercent)
@@ -146,13 +553,20 @@ func (*UtilsStruct) HandleBlock(client *ethclient.Client, account types.Account,
 		return
 	}
 
+	if utilsInterface.IsFlagPassed("disputeOnly") {
+		cmdUtils.HandleDisputeOnlyBlock(client, account, state, epoch, blockNumber, config, rogueData)
+		razorUtils.WaitTillNextNSecs(config.WaitTime)
+		return
+	}
+
 	stakerId, err := razorUtils.GetStakerId(client, account.Address)
 	if err != nil {
 		log.Error("Error in getting staker id: ", err)
 		return
 	}
 	if stakerId == 0 {
-		log.Error("Staker doesn't exist")
+		// The contracts take the staker of a commit, reveal or propose from the sender, so the votes of a staker can't be sent by another key
+		log.Errorf("Staker doesn't exist for %s, votes can only be sent by the address which staked", account.Address)
 		return
 	}
 	staker, err := razorUtils.GetStaker(client, stakerId)
@@ -196,83 +610,59 @@ func (*UtilsStruct) HandleBlock(client *ethclient.Client, account types.Account,
 	}
 
 	log.Infof("State: %s Staker ID: %d Stake: %f sRZR Balance: %f Eth Balance: %f", utils.UtilsInterface.GetStateName(state), stakerId, actualStake, sRZRInEth, actualBalance)
+	setStakerMetrics(actualStake, sRZRInEth, actualBalance)
+	checkLowBalance(account.Address, epoch, "ETH", actualBalance, config.AlertBalanceThreshold)
+	if config.AlertRazorBalanceThreshold > 0 {
+		// The RZR balance is only fetched if its alert is enabled
+		razorBalance, err := razorUtils.FetchBalance(client, account.Address)
+		if err != nil {
+			log.Error("Error in fetching RZR balance of the account: ", err)
+		} else {
+			checkLowBalance(account.Address, epoch, "RZR", utils.GetAmountInDecimal(razorBalance), config.AlertRazorBalanceThreshold)
+		}
+	}
+	metrics.UpdateNodeStatus(func(status *types.NodeStatus) {
+		status.Epoch = epoch
+		status.State = state
+		status.StateName = utils.UtilsInterface.GetStateName(state)
+		status.StakerId = stakerId
+		status.EthBalance = ethBalance
+	})
 
 	if staker.IsSlashed {
 		log.Error("Staker is slashed.... cannot continue to vote!")
+		utils.Notify(types.Notification{
+			Event:   "slashed",
+			Address: account.Address,
+			Epoch:   epoch,
+			Status:  fmt.Sprintf("staker %d is slashed, voting is stopped", stakerId),
+		})
 		osUtils.Exit(0)
 	}
 
-	switch state {
-	case 0:
-		err := cmdUtils.InitiateCommit(client, config, account, epoch, stakerId, rogueData)
-		if err != nil {
-			log.Error(err)
-			break
-		}
-	case 1:
-		err := cmdUtils.InitiateReveal(client, config, account, epoch, staker, rogueData)
-		if err != nil {
-			log.Error(err)
-			break
-		}
-	case 2:
-		err := cmdUtils.InitiatePropose(client, config, account, epoch, staker, blockNumber, rogueData)
-		if err != nil {
-			log.Error(err)
-			break
-		}
-	case 3:
-		if lastVerification >= epoch {
-			break
-		}
-
-		err := cmdUtils.HandleDispute(client, config, account, epoch, blockNumber, rogueData)
-		if err != nil {
-			log.Error(err)
-			break
+	// The RPC calls of the checks are only made if the alerts can be sent
+	if utils.IsAlertingEnabled() {
+		if state >= proposeState {
+			checkMissedReveal(client, account.Address, epoch, stakerId)
 		}
-
-		lastVerification = epoch
-
-		if utilsInterface.IsFlagPassed("autoClaimBounty") {
-			err = cmdUtils.HandleClaimBounty(client, config, account)
-			if err != nil {
-				log.Error(err)
-				break
-			}
+		if state == confirmState {
+			checkDisputedBlocks(client, account.Address, epoch, stakerId)
 		}
+	}
 
-	case 4:
-		if lastVerification == epoch && blockConfirmed < epoch {
-			txn, err := cmdUtils.ClaimBlockReward(types.TransactionOptions{
-				Client:          client,
-				Password:        account.Password,
-				AccountAddress:  account.Address,
-				ChainId:         core.ChainId,
-				Config:          config,
-				ContractAddress: core.BlockManagerAddress,
-				MethodName:      "claimBlockReward",
-				ABI:             bindings.BlockManagerABI,
-			})
-
-			if err != nil {
-				log.Error("ClaimBlockReward error: ", err)
-				break
-			}
-			if txn != core.NilHash {
-				waitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, txn.Hex())
-				if waitForBlockCompletionErr != nil {
-					log.Error("Error in WaitForBlockCompletion for claimBlockReward: ", err)
-					break
-				}
-				blockConfirmed = epoch
-			}
-		}
-	case -1:
-		if config.WaitTime > 5 {
-			timeUtils.Sleep(5 * time.Second)
-			return
-		}
+	voteStates.handle(state, voteStateContext{
+		client:      client,
+		config:      config,
+		account:     account,
+		epoch:       epoch,
+		stakerId:    stakerId,
+		staker:      staker,
+		blockNumber: blockNumber,
+		rogueData:   rogueData,
+	})
+	if state == bufferState && config.WaitTime > 5 {
+		timeUtils.Sleep(5 * time.Second)
+		return
 	}
 	razorUtils.WaitTillNextNSecs(config.WaitTime)
 	fmt.Println()
@@ -280,6 +670,9 @@ func (*UtilsStruct) HandleBlock(client *ethclient.Client, account types.Account,
 
 //This function initiates the commit
 func (*UtilsStruct) InitiateCommit(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, stakerId uint32, rogueData types.Rogue) error {
+	if err := utils.InjectFault(core.CommitFaultPoint, core.RPCTimeoutFault); err != nil {
+		return err
+	}
 	staker, err := razorUtils.GetStaker(client, stakerId)
 	if err != nil {
 		log.Error(err)
@@ -299,10 +692,15 @@ func (*UtilsStruct) InitiateCommit(client *ethclient.Client, config types.Config
 	if err != nil {
 		return errors.New("Error in fetching last commit: " + err.Error())
 	}
+	lastCommit = getLastActionEpoch("commit", lastCommit)
 	if lastCommit >= epoch {
 		log.Debugf("Cannot commit in epoch %d because last committed epoch is %d", epoch, lastCommit)
 		return nil
 	}
+	err = checkRevealAffordable(client, config, account.Address, epoch)
+	if err != nil {
+		return err
+	}
 	razorPath, err := razorUtils.GetDefaultPath()
 	if err != nil {
 		return err
@@ -321,26 +719,18 @@ func (*UtilsStruct) InitiateCommit(client *ethclient.Client, config types.Config
 
 	seed := solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{"0x" + hex.EncodeToString(salt[:]), "0x" + hex.EncodeToString(secret)})
 
+	utils.StartAttestation()
 	commitData, err := cmdUtils.HandleCommitState(client, epoch, seed, rogueData)
 	if err != nil {
 		return errors.New("Error in getting active assets: " + err.Error())
 	}
 
-	_commitData = commitData
-
+	// The merkle tree is cached with the committed data, so that it is not created again at reveal
 	merkleTree := utils.MerkleInterface.CreateMerkle(commitData.Leaves)
-	commitTxn, err := cmdUtils.Commit(client, config, account, epoch, seed, utils.MerkleInterface.GetMerkleRoot(merkleTree))
-	if err != nil {
-		return errors.New("Error in committing data: " + err.Error())
-	}
-	if commitTxn != core.NilHash {
-		waitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, commitTxn.String())
-		if waitForBlockCompletionErr != nil {
-			log.Error("Error in WaitForBlockCompletion for commit: ", err)
-			return errors.New("error in sending commit transaction")
-		}
-	}
+	commitData.MerkleTree = merkleTree
+	_commitData = commitData
 
+	// The committed data is saved before sending the commit so that it can be revealed after a restart while the commit is in flight
 	log.Debug("Saving committed data for recovery")
 	fileName, err := razorUtils.GetCommitDataFileName(account.Address)
 	if err != nil {
@@ -351,12 +741,54 @@ func (*UtilsStruct) InitiateCommit(client *ethclient.Client, config types.Config
 	if err != nil {
 		return errors.New("Error in saving data to file" + fileName + ": " + err.Error())
 	}
+	err = utils.InjectStateFileCorruption(core.CommitFaultPoint, fileName)
+	if err != nil {
+		return err
+	}
 	log.Debug("Data saved!")
+
+	merkleRoot := utils.MerkleInterface.GetMerkleRoot(merkleTree)
+	commitTxn, err := cmdUtils.Commit(client, config, account, epoch, seed, merkleRoot)
+	if err != nil {
+		return errors.New("Error in committing data: " + err.Error())
+	}
+	if commitTxn != core.NilHash {
+		collections := utils.TakeAttestedCollections()
+		saveCommitAttestation(account, epoch, stakerId, keystorePath, commitData, merkleRoot, commitTxn, collections)
+		archiveCommitResponses(account.Address, epoch, collections)
+		commitTxnHash, waitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(client, config, "commit", commitTxn.String())
+		if waitForBlockCompletionErr == nil {
+			waitForBlockCompletionErr = utils.InjectFault(core.CommitFaultPoint, core.RevertedTransactionFault)
+		}
+		cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+			Action: "commit",
+			Hashes: map[string]string{
+				"values":                 utils.HashJournalData(commitData.Leaves),
+				"assignedCollections":    utils.HashJournalData(commitData.AssignedCollections),
+				"seqAllottedCollections": utils.HashJournalData(commitData.SeqAllottedCollections),
+			},
+			Values:  commitData.Leaves,
+			TxnHash: commitTxnHash,
+			Status:  GetJournalTxnStatus(waitForBlockCompletionErr),
+		})
+		if errors.Is(waitForBlockCompletionErr, utils.ErrTransactionMiningTimeout) {
+			log.Warn("Committed data is saved in case the unresolved commit transaction gets mined")
+		} else if waitForBlockCompletionErr != nil {
+			log.Error("Error in WaitForBlockCompletion for commit: ", err)
+			return errors.New("error in sending commit transaction")
+		}
+		setCanaryLastEpoch("commit", epoch)
+		metrics.SetLastActionEpoch("commit", epoch)
+	}
+
 	return nil
 }
 
 //This function initiates the reveal
 func (*UtilsStruct) InitiateReveal(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, staker bindings.StructsStaker, rogueData types.Rogue) error {
+	if err := utils.InjectFault(core.RevealFaultPoint, core.RPCTimeoutFault); err != nil {
+		return err
+	}
 	stakedAmount := staker.Stake
 	minStakeAmount, err := utils.UtilsInterface.GetMinStakeAmount(client)
 	if err != nil {
@@ -371,11 +803,17 @@ func (*UtilsStruct) InitiateReveal(client *ethclient.Client, config types.Config
 	if err != nil {
 		return errors.New("Error in fetching last reveal: " + err.Error())
 	}
+	lastReveal = getLastActionEpoch("reveal", lastReveal)
 	if lastReveal >= epoch {
 		log.Debugf("Since last reveal was at epoch: %d, won't reveal again in epoch: %d", lastReveal, epoch)
 		return nil
 	}
 
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "skipReveal") {
+		log.Warnf("Not revealing in epoch %d as the rogue mode is skipReveal", epoch)
+		return nil
+	}
+
 	if err := cmdUtils.HandleRevealState(client, staker, epoch); err != nil {
 		log.Error(err)
 		return err
@@ -400,6 +838,7 @@ func (*UtilsStruct) InitiateReveal(client *ethclient.Client, config types.Config
 		_commitData.AssignedCollections = committedDataFromFile.AssignedCollections
 		_commitData.SeqAllottedCollections = committedDataFromFile.SeqAllottedCollections
 		_commitData.Leaves = committedDataFromFile.Leaves
+		_commitData.MerkleTree = committedDataFromFile.MerkleTree
 	}
 	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "reveal") {
 		var rogueCommittedData []*big.Int
@@ -407,6 +846,8 @@ func (*UtilsStruct) InitiateReveal(client *ethclient.Client, config types.Config
 			rogueCommittedData = append(rogueCommittedData, razorUtils.GetRogueRandomValue(10000000))
 		}
 		_commitData.Leaves = rogueCommittedData
+		// The cached tree is of the committed leaves, so the tree of the rogue leaves is created at reveal
+		_commitData.MerkleTree = nil
 	}
 
 	razorPath, err := razorUtils.GetDefaultPath()
@@ -424,17 +865,37 @@ func (*UtilsStruct) InitiateReveal(client *ethclient.Client, config types.Config
 		return errors.New("Reveal error: " + err.Error())
 	}
 	if revealTxn != core.NilHash {
-		waitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, revealTxn.String())
+		revealTxnHash, waitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(client, config, "reveal", revealTxn.String())
+		if waitForBlockCompletionErr == nil {
+			waitForBlockCompletionErr = utils.InjectFault(core.RevealFaultPoint, core.RevertedTransactionFault)
+		}
+		cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+			Action: "reveal",
+			Hashes: map[string]string{
+				"values": utils.HashJournalData(_commitData.Leaves),
+			},
+			Values:  _commitData.Leaves,
+			TxnHash: revealTxnHash,
+			Status:  GetJournalTxnStatus(waitForBlockCompletionErr),
+		})
+		if errors.Is(waitForBlockCompletionErr, utils.ErrTransactionMiningTimeout) {
+			return nil
+		}
 		if waitForBlockCompletionErr != nil {
 			log.Error("Error in WaitForBlockCompletionErr for reveal: ", err)
 			return err
 		}
+		setCanaryLastEpoch("reveal", epoch)
+		metrics.SetLastActionEpoch("reveal", epoch)
 	}
 	return nil
 }
 
 //This function initiates the propose
 func (*UtilsStruct) InitiatePropose(client *ethclient.Client, config types.Configurations, account types.Account, epoch uint32, staker bindings.StructsStaker, blockNumber *big.Int, rogueData types.Rogue) error {
+	if err := utils.InjectFault(core.ProposeFaultPoint, core.RPCTimeoutFault); err != nil {
+		return err
+	}
 	stakedAmount := staker.Stake
 	minStakeAmount, err := utils.UtilsInterface.GetMinStakeAmount(client)
 	if err != nil {
@@ -449,6 +910,7 @@ func (*UtilsStruct) InitiatePropose(client *ethclient.Client, config types.Confi
 	if err != nil {
 		return errors.New("Error in fetching last proposal: " + err.Error())
 	}
+	lastProposal = getLastActionEpoch("propose", lastProposal)
 	if lastProposal >= epoch {
 		log.Debugf("Since last propose was at epoch: %d, won't propose again in epoch: %d", epoch, lastProposal)
 		return nil
@@ -462,16 +924,39 @@ func (*UtilsStruct) InitiatePropose(client *ethclient.Client, config types.Confi
 		return nil
 	}
 
+	if rogueData.IsRogue && utils.Contains(rogueData.RogueMode, "delayedPropose") {
+		log.Warnf("Delaying the propose by %d seconds as the rogue mode is delayedPropose", rogueData.ProposeDelay)
+		timeUtils.Sleep(time.Duration(rogueData.ProposeDelay) * time.Second)
+	}
+
 	proposeTxn, err := cmdUtils.Propose(client, config, account, staker, epoch, blockNumber, rogueData)
 	if err != nil {
 		return errors.New("Propose error: " + err.Error())
 	}
 	if proposeTxn != core.NilHash {
-		waitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, proposeTxn.String())
+		proposeTxnHash, waitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(client, config, "propose", proposeTxn.String())
+		if waitForBlockCompletionErr == nil {
+			waitForBlockCompletionErr = utils.InjectFault(core.ProposeFaultPoint, core.RevertedTransactionFault)
+		}
+		cmdUtils.RecordJournalAction(account.Address, epoch, types.JournalAction{
+			Action: "propose",
+			Hashes: map[string]string{
+				"medians":               utils.HashJournalData(_mediansData),
+				"revealedCollectionIds": utils.HashJournalData(_revealedCollectionIds),
+			},
+			Values:  _mediansData,
+			TxnHash: proposeTxnHash,
+			Status:  GetJournalTxnStatus(waitForBlockCompletionErr),
+		})
+		if errors.Is(waitForBlockCompletionErr, utils.ErrTransactionMiningTimeout) {
+			return nil
+		}
 		if waitForBlockCompletionErr != nil {
 			log.Error("Error in WaitForBlockCompletionErr for propose: ", err)
 			return err
 		}
+		setCanaryLastEpoch("propose", epoch)
+		metrics.SetLastActionEpoch("propose", epoch)
 	}
 	return nil
 }
@@ -489,7 +974,7 @@ func (*UtilsStruct) GetLastProposedEpoch(client *ethclient.Client, blockNumber *
 			common.HexToAddress(core.BlockManagerAddress),
 		},
 	}
-	logs, err := utils.UtilsInterface.FilterLogsWithRetry(client, query)
+	logs, err := utils.FilterIndexedLogs(client, query)
 	if err != nil {
 		return 0, err
 	}
@@ -546,7 +1031,19 @@ func (*UtilsStruct) CalculateSecret(account types.Account, epoch uint32, keystor
 	hash := solsha3.SoliditySHA3([]string{"address", "uint32", "uint256", "string"}, []interface{}{common.HexToAddress(account.Address), epoch, chainId, "razororacle"})
 	ethHash := utils.SignHash(hash)
 
-	signedData, err := accounts.AccountUtilsInterface.SignData(ethHash, account, keystorePath)
+	var (
+		signedData []byte
+		err        error
+	)
+	if utils.IsRemoteSignerEnabled() {
+		// The external signer hashes the data as a personal message, which gives the same hash as ethHash
+		signedData, err = utils.SignDataRemotely(account.Address, hash)
+	} else if utils.IsKMSSignerEnabled() {
+		// The signature of the KMS is saved at commit and reused at reveal, so that the secret of the epoch is the same
+		signedData, err = utils.SignDataWithKMS(account.Address, hash)
+	} else {
+		signedData, err = accounts.AccountUtilsInterface.SignData(ethHash, account, keystorePath)
+	}
 	if err != nil {
 		return nil, nil, errors.New("Error in signing the data: " + err.Error())
 	}
@@ -573,13 +1070,92 @@ func init() {
 		Rogue           bool
 		RogueMode       []string
 		AutoClaimBounty bool
+		AutoWithdraw    bool
+		DisputeOnly     bool
+		Mode            string
+		Canary          bool
+		FaultInjection  string
+		Chaos           bool
+		ChaosConfig     string
+
+		SubscribedCollections   []uint
+		AcknowledgeUnsubscribed bool
+
+		RemoteConfigUrl      string
+		RemoteConfigSigner   string
+		RemoteConfigInterval uint32
+
+		MetricsPort string
+		Deployment  string
+		Fleet       bool
+		HealthPort  string
+
+		RpcDebug         bool
+		RpcDebugDuration uint32
+
+		Attest             bool
+		AttestationKeyFile string
+
+		ArchiveResponses     bool
+		ArchiveRetentionDays uint32
+
+		UseKeychain bool
+
+		SpeedUpBlocks uint32
+
+		NotificationTemplates string
+
+		AlertWebhooks              []string
+		AlertBalanceThreshold      float32
+		AlertRazorBalanceThreshold float32
+		AlertRpcDownMinutes        uint32
 	)
 
 	voteCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the staker")
 	voteCmd.Flags().BoolVarP(&Rogue, "rogue", "r", false, "enable rogue mode to report wrong values")
-	voteCmd.Flags().StringSliceVarP(&RogueMode, "rogueMode", "", []string{}, "type of rogue mode")
-	voteCmd.Flags().BoolVarP(&AutoClaimBounty, "autoClaimBounty", "", false, "auto claim bounty")
+	voteCmd.Flags().StringSliceVarP(&RogueMode, "rogueMode", "", []string{}, "type of rogue mode, biasedMedians and delayedPropose take an argument as biasedMedians:<percent> and delayedPropose:<seconds>")
+	voteCmd.Flags().BoolVarP(&AutoClaimBounty, "autoClaimBounty", "", false, "claim the bounties stored in the dispute data file once their lock period is over")
+	voteCmd.Flags().BoolVarP(&AutoWithdraw, "autoWithdraw", "", false, "initiate and unlock the withdrawals in the withdraw queue once their locks are over")
+	voteCmd.Flags().BoolVarP(&DisputeOnly, "disputeOnly", "", false, "only watch proposed blocks and dispute invalid ones, without committing or revealing")
+	voteCmd.Flags().StringVarP(&Mode, "mode", "", core.StakerMode, "mode of voting, staker or observer which only verifies the proposed blocks and alerts on anomalies without signing any transaction")
+	voteCmd.Flags().BoolVarP(&Canary, "canary", "", false, "run the full pipeline and export the transactions which would be sent without sending them")
+	voteCmd.Flags().UintSliceVarP(&SubscribedCollections, "subscribedCollections", "", []uint{}, "ids of the collections to fetch, the previous values are committed for the other assigned collections")
+	voteCmd.Flags().BoolVarP(&AcknowledgeUnsubscribed, "acknowledgeUnsubscribed", "", false, "acknowledge that the previous values committed for the collections which are not subscribed can be penalised")
+
+	voteCmd.Flags().StringVarP(&FaultInjection, "faultInjection", "", "", "fault injection config file for resilience tests")
+	voteCmd.Flags().BoolVarP(&Chaos, "chaos", "", false, "inject dropped RPC calls, delayed responses, nonce errors and transaction timeouts at random to test the retry and resume logic on testnets")
+	voteCmd.Flags().StringVarP(&ChaosConfig, "chaosConfig", "", "", "file of the probabilities of the faults of the chaos mode, the defaults are used if it isn't passed")
+	voteCmd.Flags().StringVarP(&RemoteConfigUrl, "remoteConfigUrl", "", "", "https or s3 url of the signed remote config with the hot reloadable keys")
+	voteCmd.Flags().StringVarP(&RemoteConfigSigner, "remoteConfigSigner", "", "", "address which signs the remote config")
+	voteCmd.Flags().Uint32VarP(&RemoteConfigInterval, "remoteConfigInterval", "", 300, "interval in seconds at which the remote config is fetched")
+
+	voteCmd.Flags().StringVarP(&MetricsPort, "metricsPort", "", "", "port at which the prometheus metrics of voting are served, the metrics aren't served if it isn't passed")
+	voteCmd.Flags().StringVarP(&Deployment, "deployment", "", "", "name of the deployment which is added as the deployment label to the metrics, it is set by voteDeployments")
+	voteCmd.Flags().BoolVarP(&Fleet, "fleet", "", false, "report the hashes of the configuration and of the override files of the jobs at the metrics port to compare them across a fleet")
+	voteCmd.Flags().StringVarP(&HealthPort, "healthPort", "", "", "port at which the /healthz and /status endpoints of the vote loop are served, they aren't served if it isn't passed")
+
+	voteCmd.Flags().BoolVarP(&RpcDebug, "rpcDebug", "", false, "capture the requests sent to the RPC provider and their responses with the secrets redacted")
+	voteCmd.Flags().Uint32VarP(&RpcDebugDuration, "rpcDebugDuration", "", 600, "duration in seconds for which the RPC requests and responses are captured")
+
+	voteCmd.Flags().BoolVarP(&Attest, "attest", "", false, "sign the committed values with the raw responses of their sources and save them, so that they can be exported with the prove command")
+	voteCmd.Flags().StringVarP(&AttestationKeyFile, "attestationKeyFile", "", "", "file of the hex private key which signs the attestations instead of the staker key")
+
+	voteCmd.Flags().BoolVarP(&ArchiveResponses, "archiveResponses", "", false, "archive the raw responses of the sources used for the commits, so that they can be inspected with the inspectCommit command")
+	voteCmd.Flags().Uint32VarP(&ArchiveRetentionDays, "archiveRetentionDays", "", 7, "number of days for which the archived responses are kept")
+
+	voteCmd.Flags().BoolVarP(&UseKeychain, "useKeychain", "", false, "read the password of the keystore from the keychain of the OS instead of prompting for it")
+
+	voteCmd.Flags().Uint32VarP(&SpeedUpBlocks, "speedUpBlocks", "", 3, "number of blocks after which a pending transaction is replaced with a higher gas price, 0 disables it")
+
+	voteCmd.Flags().StringVarP(&NotificationTemplates, "notificationTemplates", "", "", "file of the text/template templates with which the notifications are rendered, the default templates are used for the events it doesn't define")
+
+	voteCmd.Flags().StringSliceVarP(&AlertWebhooks, "alertWebhooks", "", []string{}, "Slack, Discord, Telegram or other webhooks which the critical events such as a missed reveal, a disputed block or a slash are sent to")
+	voteCmd.Flags().Float32VarP(&AlertBalanceThreshold, "alertBalanceThreshold", "", 0, "ETH balance below which an alert is sent, 0 disables it")
+	voteCmd.Flags().Float32VarP(&AlertRazorBalanceThreshold, "alertRazorBalanceThreshold", "", 0, "RZR balance below which an alert is sent, 0 disables it")
+	voteCmd.Flags().Uint32VarP(&AlertRpcDownMinutes, "alertRpcDownMinutes", "", 5, "minutes for which the provider is down after which an alert is sent, 0 disables it")
 
 	addrErr := voteCmd.MarkFlagRequired("address")
 	utils.CheckError("Address error: ", addrErr)
+	faultInjectionErr := voteCmd.Flags().MarkHidden("faultInjection")
+	utils.CheckError("Fault injection flag error: ", faultInjectionErr)
 }
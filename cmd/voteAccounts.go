@@ -0,0 +1,112 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"math/big"
+	"razor/core/types"
+	"razor/logger"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//The voting state of an account which is kept in memory between the blocks
+//The state of an account is restored into the voting globals before its block is handled and saved from them afterwards
+//The globals of the RPC, the config and the chain sync aren't kept per account as they are of the process
+type accountVoteState struct {
+	account                   types.Account
+	commitData                types.CommitData
+	lastVerification          uint32
+	blockConfirmed            uint32
+	claimAttempts             claimBlockRewardAttempts
+	disputeData               types.DisputeFileData
+	canaryLastEpochs          map[string]uint32
+	mediansData               []*big.Int
+	revealedCollectionIds     []uint16
+	revealedDataMaps          *types.RevealedDataMaps
+	lowBalanceAlerted         map[string]bool
+	missedRevealCheckedEpoch  uint32
+	disputedBlockCheckedEpoch uint32
+	giveSortedLeafIds         []int
+	disputedFlag              bool
+	disputeComparisons        *disputeComparisonCache
+	observedBlocksEpoch       uint32
+	observedConfirmationEpoch uint32
+}
+
+//This function returns the initial voting state of the account
+func newAccountVoteState(account types.Account) *accountVoteState {
+	return &accountVoteState{
+		account:           account,
+		canaryLastEpochs:  make(map[string]uint32),
+		lowBalanceAlerted: make(map[string]bool),
+	}
+}
+
+//This function restores the voting state of the account into the voting globals and logs for the account
+func (state *accountVoteState) restore(client *ethclient.Client) {
+	_commitData = state.commitData
+	lastVerification = state.lastVerification
+	blockConfirmed = state.blockConfirmed
+	claimAttempts = state.claimAttempts
+	disputeData = state.disputeData
+	canaryLastEpochs = state.canaryLastEpochs
+	_mediansData = state.mediansData
+	_revealedCollectionIds = state.revealedCollectionIds
+	_revealedDataMaps = state.revealedDataMaps
+	lowBalanceAlerted = state.lowBalanceAlerted
+	missedRevealCheckedEpoch = state.missedRevealCheckedEpoch
+	disputedBlockCheckedEpoch = state.disputedBlockCheckedEpoch
+	giveSortedLeafIds = state.giveSortedLeafIds
+	disputedFlag = state.disputedFlag
+	disputeComparisons = state.disputeComparisons
+	observedBlocksEpoch = state.observedBlocksEpoch
+	observedConfirmationEpoch = state.observedConfirmationEpoch
+	logger.SetLoggerParameters(client, state.account.Address)
+}
+
+//This function saves the voting state of the account from the voting globals
+func (state *accountVoteState) save() {
+	state.commitData = _commitData
+	state.lastVerification = lastVerification
+	state.blockConfirmed = blockConfirmed
+	state.claimAttempts = claimAttempts
+	state.disputeData = disputeData
+	state.canaryLastEpochs = canaryLastEpochs
+	state.mediansData = _mediansData
+	state.revealedCollectionIds = _revealedCollectionIds
+	state.revealedDataMaps = _revealedDataMaps
+	state.lowBalanceAlerted = lowBalanceAlerted
+	state.missedRevealCheckedEpoch = missedRevealCheckedEpoch
+	state.disputedBlockCheckedEpoch = disputedBlockCheckedEpoch
+	state.giveSortedLeafIds = giveSortedLeafIds
+	state.disputedFlag = disputedFlag
+	state.disputeComparisons = disputeComparisons
+	state.observedBlocksEpoch = observedBlocksEpoch
+	state.observedConfirmationEpoch = observedConfirmationEpoch
+}
+
+//This function handles all the states of voting for several accounts in one process
+//Every block is handled for one account after the other, each with its own voting state, data files and nonces
+func (*UtilsStruct) VoteAccounts(ctx context.Context, config types.Configurations, client *ethclient.Client, rogueData types.Rogue, accounts []types.Account) error {
+	var states []*accountVoteState
+	for _, account := range accounts {
+		states = append(states, newAccountVoteState(account))
+	}
+	return voteLoop(ctx, config, client, func(config types.Configurations, blockNumber *big.Int) {
+		handleAccountsBlock(ctx, client, states, blockNumber, config, rogueData)
+	})
+}
+
+//This function handles the block for every account with its own voting state
+func handleAccountsBlock(ctx context.Context, client *ethclient.Client, states []*accountVoteState, blockNumber *big.Int, config types.Configurations, rogueData types.Rogue) {
+	for _, state := range states {
+		if ctx.Err() != nil {
+			// No account is handled on the block once the voting is stopped
+			return
+		}
+		state.restore(client)
+		cmdUtils.HandleBlock(client, state.account, blockNumber, config, rogueData)
+		state.save()
+	}
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccountVoteState(t *testing.T) {
+	var client *ethclient.Client
+
+	defer func() {
+		_commitData = types.CommitData{}
+		lastVerification = 0
+		missedRevealCheckedEpoch = 0
+		canaryLastEpochs = make(map[string]uint32)
+	}()
+
+	staker1 := newAccountVoteState(types.Account{Address: "0x000000000000000000000000000000000000dea1"})
+	staker2 := newAccountVoteState(types.Account{Address: "0x000000000000000000000000000000000000dea2"})
+
+	staker1.restore(client)
+	_commitData = types.CommitData{AssignedCollections: map[int]bool{1: true}}
+	lastVerification = 10
+	missedRevealCheckedEpoch = 10
+	canaryLastEpochs["commit"] = 10
+	staker1.save()
+
+	staker2.restore(client)
+	if lastVerification != 0 || missedRevealCheckedEpoch != 0 || _commitData.AssignedCollections != nil || len(canaryLastEpochs) != 0 {
+		t.Error("The voting state of the first account is used for the second account")
+	}
+	lastVerification = 11
+	staker2.save()
+
+	staker1.restore(client)
+	if lastVerification != 10 || missedRevealCheckedEpoch != 10 || !_commitData.AssignedCollections[1] || canaryLastEpochs["commit"] != 10 {
+		t.Error("The voting state of the first account isn't restored")
+	}
+	if staker2.lastVerification != 11 {
+		t.Error("The voting state of the second account isn't saved")
+	}
+}
+
+func TestHandleAccountsBlockWithDisputes(t *testing.T) {
+	var client *ethclient.Client
+	var config types.Configurations
+	var rogueData types.Rogue
+	staker1 := types.Account{Address: "0x000000000000000000000000000000000000dea1"}
+	staker2 := types.Account{Address: "0x000000000000000000000000000000000000dea2"}
+
+	defer func() {
+		giveSortedLeafIds = nil
+		disputedFlag = false
+		disputeComparisons = nil
+		observedBlocksEpoch = 0
+		observedConfirmationEpoch = 0
+	}()
+
+	type disputeState struct {
+		giveSortedLeafIds  []int
+		disputedFlag       bool
+		disputeComparisons *disputeComparisonCache
+	}
+	// The dispute of the first account is stopped after its giveSorted, the second account disputes another leaf in the same epoch
+	comparisons1 := newDisputeComparisonCache()
+	comparisons2 := newDisputeComparisonCache()
+	disputes := map[string]disputeState{
+		staker1.Address: {giveSortedLeafIds: []int{3}, disputedFlag: true, disputeComparisons: comparisons1},
+		staker2.Address: {giveSortedLeafIds: []int{5}, disputedFlag: true, disputeComparisons: comparisons2},
+	}
+	var seen []disputeState
+
+	cmdUtilsMock := new(mocks.UtilsCmdInterface)
+	cmdUtils = cmdUtilsMock
+	cmdUtilsMock.On("HandleBlock", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		account := args.Get(1).(types.Account)
+		seen = append(seen, disputeState{giveSortedLeafIds: giveSortedLeafIds, disputedFlag: disputedFlag, disputeComparisons: disputeComparisons})
+		dispute := disputes[account.Address]
+		giveSortedLeafIds = dispute.giveSortedLeafIds
+		disputedFlag = dispute.disputedFlag
+		disputeComparisons = dispute.disputeComparisons
+		observedBlocksEpoch = 10
+	})
+
+	states := []*accountVoteState{newAccountVoteState(staker1), newAccountVoteState(staker2)}
+	handleAccountsBlock(context.Background(), client, states, big.NewInt(100), config, rogueData)
+	handleAccountsBlock(context.Background(), client, states, big.NewInt(101), config, rogueData)
+
+	want := []disputeState{
+		{},
+		{},
+		disputes[staker1.Address],
+		disputes[staker2.Address],
+	}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("The dispute state seen by the accounts = %+v, want %+v", seen, want)
+	}
+	if states[0].observedBlocksEpoch != 10 || states[1].observedBlocksEpoch != 10 {
+		t.Error("The observer state of the accounts isn't saved")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	handleAccountsBlock(ctx, client, states, big.NewInt(102), config, rogueData)
+	if len(seen) != len(want) {
+		t.Error("An account is handled after the voting is stopped")
+	}
+}
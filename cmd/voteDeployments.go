@@ -0,0 +1,303 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var voteDeploymentsCmd = &cobra.Command{
+	Use:   "voteDeployments",
+	Short: "vote on several Razor deployments from one node with one vote process per deployment",
+	Long: `Runs the vote loop against each deployment of the deployments file, e.g. the mainnet and the testnet or the deployments on two chains.
+Each deployment is voted for by its own vote process which is started and stopped by this command, with its own network, config, data directory, accounts and metrics port.
+The output of each deployment is prefixed with its name and its metrics have a deployment label with its name.
+
+Example:
+  ./razor voteDeployments --deployments deployments.json`,
+	Run: initialiseVoteDeployments,
+}
+
+//These vote flags are set from the fields of the deployment, so they can't be passed in its args
+var deploymentFlags = []string{"network", "addressesFile", "config", "datadir", "address", "account", "passwordSource", "metricsPort", "deployment"}
+
+//deploymentCommand returns the vote process of a deployment which runs the executable of this process with the args
+var deploymentCommand = func(args []string) (*exec.Cmd, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	return exec.Command(executable, args...), nil
+}
+
+//This function initialises the ExecuteVoteDeployments function
+func initialiseVoteDeployments(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteVoteDeployments(cmd.Flags())
+}
+
+//This function reads the deployments file and runs the vote processes of the deployments until they exit or a signal is received
+func (*UtilsStruct) ExecuteVoteDeployments(flagSet *pflag.FlagSet) {
+	deploymentsFile, err := flagSetUtils.GetStringDeployments(flagSet)
+	utils.CheckError("Error in getting deployments file: ", err)
+
+	deployments, err := readDeployments(deploymentsFile)
+	utils.CheckError("Error in reading deployments: ", err)
+
+	// The first signal stops the deployments after the blocks being handled, the second one terminates this process
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		log.Warn("Stopping the deployments after the blocks being handled and their transactions are completed")
+		stop()
+	}()
+
+	err = cmdUtils.RunDeployments(ctx, deployments)
+	if err != nil {
+		log.Errorf("%s\n", err)
+		osUtils.Exit(1)
+	}
+}
+
+//This function reads the deployments from the deployments file and checks that they are isolated from each other
+func readDeployments(filePath string) ([]types.Deployment, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var deploymentsFile types.DeploymentsFile
+	err = json.Unmarshal(data, &deploymentsFile)
+	if err != nil {
+		return nil, err
+	}
+	deployments := deploymentsFile.Deployments
+	for i := range deployments {
+		if deployments[i].Network == "" {
+			deployments[i].Network = core.MainnetNetwork
+		}
+	}
+	err = validateDeployments(deployments)
+	if err != nil {
+		return nil, err
+	}
+	return deployments, nil
+}
+
+//This function checks that each deployment has its own name, data directory and metrics port, and that an account isn't voted for twice on the same contracts
+//The password must be read from a source which isn't the prompt as the deployments are started together
+func validateDeployments(deployments []types.Deployment) error {
+	if len(deployments) == 0 {
+		return errors.New("no deployments in the deployments file")
+	}
+	names := make(map[string]bool)
+	dataDirs := make(map[string]string)
+	metricsPorts := make(map[string]string)
+	accounts := make(map[string]string)
+	for _, deployment := range deployments {
+		if deployment.Name == "" || strings.ContainsAny(deployment.Name, " \t\n") {
+			return fmt.Errorf("invalid deployment name %q, it should be set and have no spaces", deployment.Name)
+		}
+		if names[deployment.Name] {
+			return fmt.Errorf("deployment %s is defined twice", deployment.Name)
+		}
+		names[deployment.Name] = true
+		if !utils.Contains(core.Networks, deployment.Network) {
+			return fmt.Errorf("invalid network %s of deployment %s, valid networks are %s", deployment.Network, deployment.Name, strings.Join(core.Networks, ", "))
+		}
+		if deployment.DataDir == "" {
+			return fmt.Errorf("deployment %s needs a datadir as its data files are kept apart from the other deployments", deployment.Name)
+		}
+		dataDir, err := filepath.Abs(deployment.DataDir)
+		if err != nil {
+			return err
+		}
+		if other, ok := dataDirs[dataDir]; ok {
+			return fmt.Errorf("deployments %s and %s have the same datadir %s", other, deployment.Name, deployment.DataDir)
+		}
+		dataDirs[dataDir] = deployment.Name
+		if deployment.MetricsPort != "" {
+			if other, ok := metricsPorts[deployment.MetricsPort]; ok {
+				return fmt.Errorf("deployments %s and %s have the same metrics port %s", other, deployment.Name, deployment.MetricsPort)
+			}
+			metricsPorts[deployment.MetricsPort] = deployment.Name
+		}
+		if len(deployment.Accounts) == 0 {
+			return fmt.Errorf("deployment %s has no accounts", deployment.Name)
+		}
+		for _, account := range deployment.Accounts {
+			// The same account can vote on different contracts, e.g. with the same key on two chains
+			key := strings.Join([]string{deployment.Network, deployment.AddressesFile, strings.ToLower(account)}, "|")
+			if other, ok := accounts[key]; ok {
+				return fmt.Errorf("account %s is voted for on the same contracts by deployments %s and %s", account, other, deployment.Name)
+			}
+			accounts[key] = deployment.Name
+		}
+		if !isUnattendedPasswordSource(deployment.PasswordSource) {
+			return fmt.Errorf("deployment %s needs a passwordSource which is env:<variable>, file:<path> or keyring as the password can't be prompted for", deployment.Name)
+		}
+		for _, arg := range deployment.Args {
+			flag := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+			if strings.HasPrefix(arg, "-") && utils.Contains(deploymentFlags, flag) {
+				return fmt.Errorf("flag %s of deployment %s should be set with its field in the deployments file", arg, deployment.Name)
+			}
+		}
+	}
+	return nil
+}
+
+//This function returns if the password is read from the source without prompting for it
+func isUnattendedPasswordSource(source string) bool {
+	return source == utils.PasswordSourceKeyring || strings.HasPrefix(source, utils.PasswordSourceEnv+":") || strings.HasPrefix(source, utils.PasswordSourceFile+":")
+}
+
+//This function returns the args of the vote process of the deployment
+//The first account is the address of the staker, all the accounts are passed with --account if there are several
+func deploymentArgs(deployment types.Deployment) []string {
+	args := []string{"vote", "--deployment", deployment.Name, "--network", deployment.Network, "--datadir", deployment.DataDir, "--address", deployment.Accounts[0], "--passwordSource", deployment.PasswordSource}
+	if deployment.AddressesFile != "" {
+		args = append(args, "--addressesFile", deployment.AddressesFile)
+	}
+	if deployment.Config != "" {
+		args = append(args, "--config", deployment.Config)
+	}
+	if len(deployment.Accounts) > 1 {
+		args = append(args, "--account", strings.Join(deployment.Accounts, ","))
+	}
+	if deployment.MetricsPort != "" {
+		args = append(args, "--metricsPort", deployment.MetricsPort)
+	}
+	return append(args, deployment.Args...)
+}
+
+//This function starts the vote processes of the deployments and waits for them to exit, they are stopped when the context is done
+//A deployment which fails doesn't stop the others, the deployments which failed are returned in the error
+func (*UtilsStruct) RunDeployments(ctx context.Context, deployments []types.Deployment) error {
+	var (
+		outputMutex sync.Mutex
+		wg          sync.WaitGroup
+		failedMutex sync.Mutex
+		failed      []string
+		processes   []*os.Process
+	)
+	fail := func(name string, err error) {
+		log.Errorf("Deployment %s failed: %s", name, err)
+		failedMutex.Lock()
+		failed = append(failed, name)
+		failedMutex.Unlock()
+	}
+	for _, deployment := range deployments {
+		command, err := deploymentCommand(deploymentArgs(deployment))
+		if err != nil {
+			fail(deployment.Name, err)
+			continue
+		}
+		stdout := &deploymentWriter{name: deployment.Name, out: os.Stdout, mutex: &outputMutex}
+		stderr := &deploymentWriter{name: deployment.Name, out: os.Stderr, mutex: &outputMutex}
+		command.Stdout, command.Stderr = stdout, stderr
+		setDeploymentProcessGroup(command)
+		if err := command.Start(); err != nil {
+			fail(deployment.Name, err)
+			continue
+		}
+		log.Infof("Started deployment %s on the %s network with pid %d", deployment.Name, deployment.Network, command.Process.Pid)
+		processes = append(processes, command.Process)
+		wg.Add(1)
+		go func(name string, command *exec.Cmd) {
+			defer wg.Done()
+			err := command.Wait()
+			stdout.flush()
+			stderr.flush()
+			if err != nil {
+				fail(name, err)
+				return
+			}
+			log.Infof("Deployment %s exited", name)
+		}(deployment.Name, command)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			for _, process := range processes {
+				if err := stopDeploymentProcess(process); err != nil {
+					log.Errorf("Error in stopping the process %d: %s", process.Pid, err)
+				}
+			}
+		case <-done:
+		}
+	}()
+	wg.Wait()
+	close(done)
+
+	if len(failed) > 0 {
+		return fmt.Errorf("deployments %s failed", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+//deploymentWriter writes the output of the vote process of a deployment line by line with the name of the deployment as prefix
+//The mutex is shared by the writers of all the deployments so that their lines aren't interleaved
+type deploymentWriter struct {
+	name   string
+	out    io.Writer
+	mutex  *sync.Mutex
+	buffer []byte
+}
+
+func (w *deploymentWriter) Write(p []byte) (int, error) {
+	w.buffer = append(w.buffer, p...)
+	for {
+		index := bytes.IndexByte(w.buffer, '\n')
+		if index == -1 {
+			return len(p), nil
+		}
+		if err := w.writeLine(w.buffer[:index+1]); err != nil {
+			return 0, err
+		}
+		w.buffer = w.buffer[index+1:]
+	}
+}
+
+//This function writes the last line of the output if it doesn't end with a new line
+func (w *deploymentWriter) flush() {
+	if len(w.buffer) > 0 {
+		_ = w.writeLine(append(w.buffer, '\n'))
+		w.buffer = nil
+	}
+}
+
+func (w *deploymentWriter) writeLine(line []byte) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	_, err := fmt.Fprintf(w.out, "[%s] %s", w.name, line)
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(voteDeploymentsCmd)
+
+	var Deployments string
+
+	voteDeploymentsCmd.Flags().StringVarP(&Deployments, "deployments", "", "", "JSON file of the deployments to vote on with their network, config, datadir, accounts, passwordSource, metricsPort and additional vote args")
+
+	deploymentsErr := voteDeploymentsCmd.MarkFlagRequired("deployments")
+	utils.CheckError("Deployments error: ", deploymentsErr)
+}
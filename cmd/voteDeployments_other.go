@@ -0,0 +1,17 @@
+//go:build !darwin && !linux
+// +build !darwin,!linux
+
+package cmd
+
+import (
+	"os"
+	"os/exec"
+)
+
+//The vote processes of the deployments share the console of this process, so they are left in its process group
+func setDeploymentProcessGroup(command *exec.Cmd) {}
+
+//The vote processes of the deployments receive the interrupt of the console themselves, so they aren't signalled again
+func stopDeploymentProcess(process *os.Process) error {
+	return nil
+}
@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReadDeployments(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileData string
+		noFile   bool
+		want     []types.Deployment
+		wantErr  bool
+	}{
+		{
+			name: "Test 1: When the deployments are isolated",
+			fileData: `{"deployments": [
+  {"name": "mainnet", "datadir": "/var/razor/mainnet", "accounts": ["0x000000000000000000000000000000000000dEaD"], "passwordSource": "env:RAZOR_MAINNET_PASSWORD", "metricsPort": "2112"},
+  {"name": "testnet", "network": "testnet", "addressesFile": "/etc/razor/testnet.json", "datadir": "/var/razor/testnet", "accounts": ["0x000000000000000000000000000000000000dEaD"], "passwordSource": "file:/etc/razor/testnet-password", "metricsPort": "2113"}
+]}`,
+			want: []types.Deployment{
+				{Name: "mainnet", Network: core.MainnetNetwork, DataDir: "/var/razor/mainnet", Accounts: []string{"0x000000000000000000000000000000000000dEaD"}, PasswordSource: "env:RAZOR_MAINNET_PASSWORD", MetricsPort: "2112"},
+				{Name: "testnet", Network: core.TestnetNetwork, AddressesFile: "/etc/razor/testnet.json", DataDir: "/var/razor/testnet", Accounts: []string{"0x000000000000000000000000000000000000dEaD"}, PasswordSource: "file:/etc/razor/testnet-password", MetricsPort: "2113"},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Test 2: When there are no deployments",
+			fileData: `{"deployments": []}`,
+			wantErr:  true,
+		},
+		{
+			name: "Test 3: When two deployments have the same datadir",
+			fileData: `{"deployments": [
+  {"name": "a", "datadir": "/var/razor", "accounts": ["0x01"], "passwordSource": "keyring"},
+  {"name": "b", "network": "testnet", "datadir": "/var/razor/", "accounts": ["0x02"], "passwordSource": "keyring"}
+]}`,
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When two deployments have the same metrics port",
+			fileData: `{"deployments": [
+  {"name": "a", "datadir": "/var/razor/a", "accounts": ["0x01"], "passwordSource": "keyring", "metricsPort": "2112"},
+  {"name": "b", "network": "testnet", "datadir": "/var/razor/b", "accounts": ["0x02"], "passwordSource": "keyring", "metricsPort": "2112"}
+]}`,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When an account is voted for twice on the same contracts",
+			fileData: `{"deployments": [
+  {"name": "a", "datadir": "/var/razor/a", "accounts": ["0xAB"], "passwordSource": "keyring"},
+  {"name": "b", "datadir": "/var/razor/b", "accounts": ["0xab"], "passwordSource": "keyring"}
+]}`,
+			wantErr: true,
+		},
+		{
+			name:     "Test 6: When the password would be prompted for",
+			fileData: `{"deployments": [{"name": "a", "datadir": "/var/razor/a", "accounts": ["0x01"], "passwordSource": "prompt"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 7: When a flag set by the deployment is passed in its args",
+			fileData: `{"deployments": [{"name": "a", "datadir": "/var/razor/a", "accounts": ["0x01"], "passwordSource": "keyring", "args": ["--datadir=/tmp"]}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 8: When the network is invalid",
+			fileData: `{"deployments": [{"name": "a", "network": "devnet", "datadir": "/var/razor/a", "accounts": ["0x01"], "passwordSource": "keyring"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 9: When the deployment has no name",
+			fileData: `{"deployments": [{"datadir": "/var/razor/a", "accounts": ["0x01"], "passwordSource": "keyring"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:    "Test 10: When the deployments file doesn't exist",
+			noFile:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "deployments.json")
+			if !tt.noFile {
+				if err := os.WriteFile(filePath, []byte(tt.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := readDeployments(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("readDeployments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("readDeployments() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment types.Deployment
+		want       []string
+	}{
+		{
+			name:       "Test 1: When the deployment has one account on the mainnet",
+			deployment: types.Deployment{Name: "mainnet", Network: core.MainnetNetwork, DataDir: "/var/razor/mainnet", Accounts: []string{"0x01"}, PasswordSource: "keyring"},
+			want:       []string{"vote", "--deployment", "mainnet", "--network", "mainnet", "--datadir", "/var/razor/mainnet", "--address", "0x01", "--passwordSource", "keyring"},
+		},
+		{
+			name: "Test 2: When the deployment has several accounts, its config and its args",
+			deployment: types.Deployment{
+				Name:           "testnet",
+				Network:        core.TestnetNetwork,
+				AddressesFile:  "/etc/razor/testnet.json",
+				Config:         "/etc/razor/testnet.yaml",
+				DataDir:        "/var/razor/testnet",
+				Accounts:       []string{"0x01", "0x02"},
+				PasswordSource: "env:RAZOR_PASSWORD",
+				MetricsPort:    "2113",
+				Args:           []string{"--autoClaimBounty"},
+			},
+			want: []string{"vote", "--deployment", "testnet", "--network", "testnet", "--datadir", "/var/razor/testnet", "--address", "0x01", "--passwordSource", "env:RAZOR_PASSWORD", "--addressesFile", "/etc/razor/testnet.json", "--config", "/etc/razor/testnet.yaml", "--account", "0x01,0x02", "--metricsPort", "2113", "--autoClaimBounty"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deploymentArgs(tt.deployment); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("deploymentArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunDeployments(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is needed to run the deployments")
+	}
+	defaultDeploymentCommand := deploymentCommand
+	defer func() { deploymentCommand = defaultDeploymentCommand }()
+
+	deployment := func(name string) types.Deployment {
+		return types.Deployment{Name: name, Network: core.MainnetNetwork, DataDir: "/var/razor/" + name, Accounts: []string{"0x01"}, PasswordSource: "keyring"}
+	}
+	tests := []struct {
+		name        string
+		scripts     map[string]string
+		deployments []types.Deployment
+		cancel      bool
+		wantErr     bool
+	}{
+		{
+			name:        "Test 1: When all the deployments exit",
+			scripts:     map[string]string{"a": "exit 0", "b": "exit 0"},
+			deployments: []types.Deployment{deployment("a"), deployment("b")},
+			wantErr:     false,
+		},
+		{
+			name:        "Test 2: When a deployment fails the other one still runs",
+			scripts:     map[string]string{"a": "exit 1", "b": "sleep 0.2"},
+			deployments: []types.Deployment{deployment("a"), deployment("b")},
+			wantErr:     true,
+		},
+		{
+			name:        "Test 3: When the deployments are stopped",
+			scripts:     map[string]string{"a": "trap 'kill $!; exit 0' TERM; sleep 10 & wait", "b": "trap 'kill $!; exit 0' TERM; sleep 10 & wait"},
+			deployments: []types.Deployment{deployment("a"), deployment("b")},
+			cancel:      true,
+			wantErr:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentCommand = func(args []string) (*exec.Cmd, error) {
+				// The name of the deployment follows --deployment in the args
+				return exec.Command("sh", "-c", tt.scripts[args[2]]), nil
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancel {
+				time.AfterFunc(200*time.Millisecond, cancel)
+			}
+			start := time.Now()
+			ut := &UtilsStruct{}
+			err := ut.RunDeployments(ctx, tt.deployments)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RunDeployments() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && err.Error() != "deployments a failed" {
+				t.Errorf("RunDeployments() error = %v, want the failed deployment a", err)
+			}
+			if tt.cancel && time.Since(start) > 5*time.Second {
+				t.Errorf("RunDeployments() didn't stop the deployments")
+			}
+		})
+	}
+}
+
+func TestDeploymentWriter(t *testing.T) {
+	var out bytes.Buffer
+	writer := &deploymentWriter{name: "testnet", out: &out, mutex: &sync.Mutex{}}
+	for _, data := range []string{"first li", "ne\nsecond line\nlast", " line"} {
+		if _, err := writer.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writer.flush()
+	want := "[testnet] first line\n[testnet] second line\n[testnet] last line\n"
+	if out.String() != want {
+		t.Errorf("deploymentWriter wrote %q, want %q", out.String(), want)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build darwin || linux
+// +build darwin linux
+
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+//This function starts the vote process of the deployment in its own process group, so that a CTRL+C in the terminal reaches only this process which stops the deployments
+func setDeploymentProcessGroup(command *exec.Cmd) {
+	command.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+//This function stops the vote process of the deployment after the block being handled, as it stops on a SIGTERM
+func stopDeploymentProcess(process *os.Process) error {
+	return process.Signal(syscall.SIGTERM)
+}
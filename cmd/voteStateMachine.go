@@ -0,0 +1,248 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/pkg/bindings"
+	"razor/utils"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//States of the epoch, the buffer state is the time at the boundaries of the states in which no transaction is sent
+const (
+	bufferState  int64 = -1
+	commitState  int64 = 0
+	revealState  int64 = 1
+	proposeState int64 = 2
+	disputeState int64 = 3
+	confirmState int64 = 4
+)
+
+//The state which an account is in before its first block is handled
+const unknownState int64 = -2
+
+//The block which a state of the epoch is handled on for an account
+//The context is done once the timeout of the state has passed, the transactions of the account aren't sent after it
+type voteStateContext struct {
+	ctx         context.Context
+	client      *ethclient.Client
+	config      types.Configurations
+	account     types.Account
+	epoch       uint32
+	stakerId    uint32
+	staker      bindings.StructsStaker
+	blockNumber *big.Int
+	rogueData   types.Rogue
+}
+
+//A handler of a state of the epoch, it is called on every block of the state
+type voteStateHandler interface {
+	handleState(stateContext voteStateContext) error
+}
+
+//A function which handles a state of the epoch
+type voteStateHandlerFunc func(stateContext voteStateContext) error
+
+func (handler voteStateHandlerFunc) handleState(stateContext voteStateContext) error {
+	return handler(stateContext)
+}
+
+//A hook which is called when an account enters a state, the state which the account was in is unknownState for its first block
+type voteTransitionHook func(from int64, to int64, stateContext voteStateContext)
+
+//A state of the epoch with its handler and the time which its handler can take on a block, no timeout is checked if it is 0
+type voteState struct {
+	name    string
+	handler voteStateHandler
+	timeout time.Duration
+}
+
+//The state and the epoch which an account was last handled in
+type voteStatePosition struct {
+	epoch uint32
+	state int64
+}
+
+//The state machine which handles the states of the epoch for the accounts
+//The transition hooks are called when an account enters a state, which is also the case when the same state is handled in a new epoch
+type voteStateMachine struct {
+	states          map[int64]voteState
+	transitionHooks []voteTransitionHook
+	positions       map[string]voteStatePosition
+}
+
+var voteStates = newVoteStateMachine()
+
+//This function returns the state machine with the handlers of the states of the epoch
+func newVoteStateMachine() *voteStateMachine {
+	stateLength := time.Duration(core.StateLength) * time.Second
+	stateMachine := &voteStateMachine{
+		states:    make(map[int64]voteState),
+		positions: make(map[string]voteStatePosition),
+	}
+	stateMachine.registerHandler(commitState, "commit", voteStateHandlerFunc(onCommitState), stateLength)
+	stateMachine.registerHandler(revealState, "reveal", voteStateHandlerFunc(onRevealState), stateLength)
+	stateMachine.registerHandler(proposeState, "propose", voteStateHandlerFunc(onProposeState), stateLength)
+	stateMachine.registerHandler(disputeState, "dispute", voteStateHandlerFunc(onDisputeState), stateLength)
+	stateMachine.registerHandler(confirmState, "confirm", voteStateHandlerFunc(onConfirmState), stateLength)
+	stateMachine.registerHandler(bufferState, "buffer", voteStateHandlerFunc(onBufferState), 0)
+	stateMachine.addTransitionHook(stateMachine.logTransition)
+	return stateMachine
+}
+
+//This function sets the handler of the state, the handler which the state had is replaced
+func (stateMachine *voteStateMachine) registerHandler(state int64, name string, handler voteStateHandler, timeout time.Duration) {
+	stateMachine.states[state] = voteState{
+		name:    name,
+		handler: handler,
+		timeout: timeout,
+	}
+}
+
+//This function adds a hook which is called when an account enters a state
+func (stateMachine *voteStateMachine) addTransitionHook(hook voteTransitionHook) {
+	stateMachine.transitionHooks = append(stateMachine.transitionHooks, hook)
+}
+
+//This function calls the handler of the state for the account, the transition hooks are called first if the account enters the state
+//The handler is given a context with the timeout of the state as its deadline, no transaction of the account is sent after it
+//The time taken by the handler is recorded and a warning is logged if it is more than the timeout of the state
+func (stateMachine *voteStateMachine) handle(state int64, stateContext voteStateContext) {
+	voteState, ok := stateMachine.states[state]
+	if !ok {
+		log.Errorf("No handler for state %d", state)
+		return
+	}
+
+	position := voteStatePosition{epoch: stateContext.epoch, state: state}
+	previousPosition, ok := stateMachine.positions[stateContext.account.Address]
+	if !ok || previousPosition != position {
+		from := unknownState
+		if ok {
+			from = previousPosition.state
+		}
+		stateMachine.positions[stateContext.account.Address] = position
+		for _, hook := range stateMachine.transitionHooks {
+			hook(from, state, stateContext)
+		}
+	}
+
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if voteState.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, voteState.timeout)
+	}
+	defer cancel()
+	stateContext.ctx = ctx
+	utils.SetTransactionContext(stateContext.account.Address, ctx)
+	defer utils.ClearTransactionContext(stateContext.account.Address)
+
+	start := time.Now()
+	err := voteState.handler.handleState(stateContext)
+	duration := time.Since(start)
+	metrics.StateHandlerDurationMetric.WithLabelValues(voteState.name).Observe(duration.Seconds())
+	if err != nil {
+		metrics.StateHandlerErrorsMetric.WithLabelValues(voteState.name).Inc()
+		log.Error(err)
+	}
+	if voteState.timeout > 0 && duration > voteState.timeout {
+		log.Warnf("Handler of %s state took %s which is more than its timeout of %s, its transactions after the timeout weren't sent", voteState.name, duration.Round(time.Second), voteState.timeout)
+	}
+}
+
+//This function returns the name of the state, the states without a handler are unknown
+func (stateMachine *voteStateMachine) stateName(state int64) string {
+	if voteState, ok := stateMachine.states[state]; ok {
+		return voteState.name
+	}
+	return "unknown"
+}
+
+//This function logs the state which the account enters
+func (stateMachine *voteStateMachine) logTransition(from int64, to int64, stateContext voteStateContext) {
+	log.Debugf("%s entered %s state of epoch %d from %s state", stateContext.account.Address, stateMachine.stateName(to), stateContext.epoch, stateMachine.stateName(from))
+}
+
+//This function handles the commit state, the commit is sent with escalated gas if it confirms the block of the previous epoch as a backup of its proposer
+func onCommitState(stateContext voteStateContext) error {
+	config := getBackupConfirmConfig(stateContext.client, stateContext.config, stateContext.epoch, stateContext.stakerId)
+	return cmdUtils.InitiateCommit(stateContext.client, config, stateContext.account, stateContext.epoch, stateContext.stakerId, stateContext.rogueData)
+}
+
+//This function handles the reveal state
+func onRevealState(stateContext voteStateContext) error {
+	return cmdUtils.InitiateReveal(stateContext.client, stateContext.config, stateContext.account, stateContext.epoch, stateContext.staker, stateContext.rogueData)
+}
+
+//This function handles the propose state
+func onProposeState(stateContext voteStateContext) error {
+	return cmdUtils.InitiatePropose(stateContext.client, stateContext.config, stateContext.account, stateContext.epoch, stateContext.staker, stateContext.blockNumber, stateContext.rogueData)
+}
+
+//This function handles the dispute state, the proposed blocks are only verified once in an epoch
+func onDisputeState(stateContext voteStateContext) error {
+	if lastVerification >= stateContext.epoch {
+		return nil
+	}
+	err := cmdUtils.HandleDispute(stateContext.client, stateContext.config, stateContext.account, stateContext.epoch, stateContext.blockNumber, stateContext.rogueData)
+	if err != nil {
+		return err
+	}
+	lastVerification = stateContext.epoch
+	return nil
+}
+
+//This function handles the confirm state, the block reward is claimed once in an epoch if the proposed blocks were verified
+//A failed claim is retried with backoff in the remaining confirm state unless the block is confirmed already or the claim can't succeed in the epoch
+func onConfirmState(stateContext voteStateContext) error {
+	epoch := stateContext.epoch
+	if lastVerification != epoch || blockConfirmed >= epoch || !claimAttempts.canClaim(epoch, time.Now()) {
+		return nil
+	}
+	txn, err := cmdUtils.ClaimBlockReward(types.TransactionOptions{
+		Client:          stateContext.client,
+		Password:        stateContext.account.Password,
+		AccountAddress:  stateContext.account.Address,
+		ChainId:         core.ChainId,
+		Config:          stateContext.config,
+		ContractAddress: core.BlockManagerAddress,
+		MethodName:      "claimBlockReward",
+		ABI:             bindings.BlockManagerABI,
+	})
+	if err != nil {
+		return handleClaimBlockRewardError(stateContext.client, epoch, fmt.Errorf("ClaimBlockReward error: %w", err))
+	}
+	if txn == core.NilHash {
+		return nil
+	}
+	claimTxnHash, waitForBlockCompletionErr := cmdUtils.WaitForTransactionOfState(stateContext.client, stateContext.config, "confirm", txn.Hex())
+	cmdUtils.RecordJournalAction(stateContext.account.Address, epoch, types.JournalAction{
+		Action:  "claimBlockReward",
+		TxnHash: claimTxnHash,
+		Status:  GetJournalTxnStatus(waitForBlockCompletionErr),
+	})
+	// An unresolved claim is not sent again in this epoch as it would revert once the pending one is mined
+	if waitForBlockCompletionErr != nil && !errors.Is(waitForBlockCompletionErr, utils.ErrTransactionMiningTimeout) {
+		return handleClaimBlockRewardError(stateContext.client, epoch, errors.New("Error in WaitForBlockCompletion for claimBlockReward: "+waitForBlockCompletionErr.Error()))
+	}
+	if waitForBlockCompletionErr != nil {
+		metrics.ClaimBlockRewardAttemptsMetric.WithLabelValues("unresolved").Inc()
+	} else {
+		metrics.ClaimBlockRewardAttemptsMetric.WithLabelValues("confirmed").Inc()
+	}
+	blockConfirmed = epoch
+	metrics.SetLastActionEpoch("claimBlockReward", epoch)
+	return nil
+}
+
+//This function handles the buffer state, no transaction is sent in it
+func onBufferState(stateContext voteStateContext) error {
+	return nil
+}
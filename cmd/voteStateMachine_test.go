@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"errors"
+	"razor/core/types"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestVoteStateMachineHandle(t *testing.T) {
+	type transition struct {
+		from int64
+		to   int64
+	}
+	type handledBlock struct {
+		address string
+		epoch   uint32
+		state   int64
+	}
+	tests := []struct {
+		name            string
+		blocks          []handledBlock
+		handlerErr      error
+		wantHandled     []int64
+		wantTransitions []transition
+	}{
+		{
+			name:            "Test 1: When the first block of an account is handled",
+			blocks:          []handledBlock{{address: "0x1", epoch: 10, state: commitState}},
+			wantHandled:     []int64{commitState},
+			wantTransitions: []transition{{from: unknownState, to: commitState}},
+		},
+		{
+			name: "Test 2: When the blocks of a state are handled",
+			blocks: []handledBlock{
+				{address: "0x1", epoch: 10, state: commitState},
+				{address: "0x1", epoch: 10, state: commitState},
+				{address: "0x1", epoch: 10, state: revealState},
+			},
+			wantHandled:     []int64{commitState, commitState, revealState},
+			wantTransitions: []transition{{from: unknownState, to: commitState}, {from: commitState, to: revealState}},
+		},
+		{
+			name: "Test 3: When the same state is handled in the next epoch",
+			blocks: []handledBlock{
+				{address: "0x1", epoch: 10, state: commitState},
+				{address: "0x1", epoch: 11, state: commitState},
+			},
+			wantHandled:     []int64{commitState, commitState},
+			wantTransitions: []transition{{from: unknownState, to: commitState}, {from: commitState, to: commitState}},
+		},
+		{
+			name: "Test 4: When the blocks of two accounts are handled",
+			blocks: []handledBlock{
+				{address: "0x1", epoch: 10, state: commitState},
+				{address: "0x2", epoch: 10, state: commitState},
+				{address: "0x1", epoch: 10, state: commitState},
+			},
+			wantHandled:     []int64{commitState, commitState, commitState},
+			wantTransitions: []transition{{from: unknownState, to: commitState}, {from: unknownState, to: commitState}},
+		},
+		{
+			name:            "Test 5: When the state has no handler",
+			blocks:          []handledBlock{{address: "0x1", epoch: 10, state: 7}},
+			wantTransitions: nil,
+		},
+		{
+			name:            "Test 6: When the handler returns an error",
+			blocks:          []handledBlock{{address: "0x1", epoch: 10, state: revealState}},
+			handlerErr:      errors.New("reveal error"),
+			wantHandled:     []int64{revealState},
+			wantTransitions: []transition{{from: unknownState, to: revealState}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled []int64
+			var transitions []transition
+			stateMachine := &voteStateMachine{
+				states:    make(map[int64]voteState),
+				positions: make(map[string]voteStatePosition),
+			}
+			for state, name := range map[int64]string{commitState: "commit", revealState: "reveal"} {
+				state := state
+				stateMachine.registerHandler(state, name, voteStateHandlerFunc(func(stateContext voteStateContext) error {
+					handled = append(handled, state)
+					return tt.handlerErr
+				}), time.Minute)
+			}
+			stateMachine.addTransitionHook(func(from int64, to int64, stateContext voteStateContext) {
+				transitions = append(transitions, transition{from: from, to: to})
+			})
+
+			for _, block := range tt.blocks {
+				stateMachine.handle(block.state, voteStateContext{
+					account: types.Account{Address: block.address},
+					epoch:   block.epoch,
+				})
+			}
+			if !reflect.DeepEqual(handled, tt.wantHandled) {
+				t.Errorf("handle() handled states = %v, want %v", handled, tt.wantHandled)
+			}
+			if !reflect.DeepEqual(transitions, tt.wantTransitions) {
+				t.Errorf("handle() transitions = %v, want %v", transitions, tt.wantTransitions)
+			}
+		})
+	}
+}
+
+func TestVoteStateMachineHandleDeadline(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeout     time.Duration
+		sleep       time.Duration
+		wantDone    bool
+		wantNoLimit bool
+	}{
+		{
+			name:    "Test 1: When the handler finishes before the timeout",
+			timeout: time.Minute,
+		},
+		{
+			name:     "Test 2: When the handler takes more than the timeout",
+			timeout:  10 * time.Millisecond,
+			sleep:    50 * time.Millisecond,
+			wantDone: true,
+		},
+		{
+			name:        "Test 3: When the state has no timeout",
+			wantNoLimit: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handledContext voteStateContext
+			var done bool
+			stateMachine := &voteStateMachine{
+				states:    make(map[int64]voteState),
+				positions: make(map[string]voteStatePosition),
+			}
+			stateMachine.registerHandler(revealState, "reveal", voteStateHandlerFunc(func(stateContext voteStateContext) error {
+				time.Sleep(tt.sleep)
+				handledContext = stateContext
+				done = stateContext.ctx.Err() != nil
+				return nil
+			}), tt.timeout)
+
+			stateMachine.handle(revealState, voteStateContext{
+				account: types.Account{Address: "0x1"},
+				epoch:   10,
+			})
+			if handledContext.ctx == nil {
+				t.Fatal("handle() didn't pass a context to the handler")
+			}
+			if done != tt.wantDone {
+				t.Errorf("handle() context done in handler = %v, want %v", done, tt.wantDone)
+			}
+			if _, ok := handledContext.ctx.Deadline(); ok == tt.wantNoLimit {
+				t.Errorf("handle() context has deadline = %v, want %v", ok, !tt.wantNoLimit)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -19,6 +20,7 @@ import (
 	mocks2 "razor/utils/mocks"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestExecuteVote(t *testing.T) {
@@ -26,6 +28,11 @@ func TestExecuteVote(t *testing.T) {
 	var flagSet *pflag.FlagSet
 	var config types.Configurations
 
+	notificationTemplatesFile := path.Join(t.TempDir(), "notifications.tmpl")
+	if err := os.WriteFile(notificationTemplatesFile, []byte(`{{define "commit"}}Commit de l'époque {{.Epoch}} : {{.Status}}{{end}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
 	type args struct {
 		config       types.Configurations
 		configErr    error
@@ -37,6 +44,76 @@ func TestExecuteVote(t *testing.T) {
 		address      string
 		addressErr   error
 		voteErr      error
+
+		faultInjectionFile    string
+		faultInjectionFileErr error
+
+		chaos              bool
+		chaosErr           error
+		chaosConfigFile    string
+		chaosConfigFileErr error
+
+		encryptState    bool
+		encryptStateErr error
+
+		canary            bool
+		canaryErr         error
+		canaryFileNameErr error
+
+		lockDataDirErr error
+
+		subscribedCollections      []uint
+		subscribedCollectionsErr   error
+		acknowledgeUnsubscribed    bool
+		acknowledgeUnsubscribedErr error
+
+		remoteConfigUrl         string
+		remoteConfigUrlErr      error
+		remoteConfigSigner      string
+		remoteConfigSignerErr   error
+		remoteConfigInterval    uint32
+		remoteConfigIntervalErr error
+
+		metricsPortErr error
+		healthPortErr  error
+
+		fleet    bool
+		fleetErr error
+
+		rpcDebug            bool
+		rpcDebugErr         error
+		rpcDebugDuration    uint32
+		rpcDebugDurationErr error
+		rpcDebugFileNameErr error
+
+		useKeychain         bool
+		useKeychainErr      error
+		keychainPasswordErr error
+
+		speedUpBlocksErr error
+
+		autoClaimBounty    bool
+		autoClaimBountyErr error
+
+		notificationTemplates    string
+		notificationTemplatesErr error
+
+		alertWebhooks                 []string
+		alertWebhooksErr              error
+		alertBalanceThresholdErr      error
+		alertRazorBalanceThresholdErr error
+		alertRpcDownMinutesErr        error
+
+		votingEligibilityErr error
+
+		accountNames       []string
+		accountNamesErr    error
+		resolvedAccounts   []string
+		resolveAccountsErr error
+		voteAccountsErr    error
+
+		mode    string
+		modeErr error
 	}
 	tests := []struct {
 		name          string
@@ -50,7 +127,7 @@ func TestExecuteVote(t *testing.T) {
 				password:    "test",
 				address:     "0x000000000000000000000000000000000000dea1",
 				rogueStatus: true,
-				rogueMode:   []string{"propose", "commit"},
+				rogueMode:   []string{"delayedPropose", "commit"},
 				voteErr:     nil,
 			},
 			expectedFatal: false,
@@ -62,7 +139,7 @@ func TestExecuteVote(t *testing.T) {
 				password:    "test",
 				address:     "0x000000000000000000000000000000000000dea1",
 				rogueStatus: true,
-				rogueMode:   []string{"propose", "commit"},
+				rogueMode:   []string{"delayedPropose", "commit"},
 				voteErr:     nil,
 			},
 			expectedFatal: true,
@@ -75,7 +152,7 @@ func TestExecuteVote(t *testing.T) {
 				address:     "",
 				addressErr:  errors.New("address error"),
 				rogueStatus: true,
-				rogueMode:   []string{"propose", "commit"},
+				rogueMode:   []string{"delayedPropose", "commit"},
 				voteErr:     nil,
 			},
 			expectedFatal: true,
@@ -87,7 +164,7 @@ func TestExecuteVote(t *testing.T) {
 				password:  "test",
 				address:   "0x000000000000000000000000000000000000dea1",
 				rogueErr:  errors.New("rogue status error"),
-				rogueMode: []string{"propose", "commit"},
+				rogueMode: []string{"delayedPropose", "commit"},
 				voteErr:   nil,
 			},
 			expectedFatal: true,
@@ -112,11 +189,559 @@ func TestExecuteVote(t *testing.T) {
 				password:    "test",
 				address:     "0x000000000000000000000000000000000000dea1",
 				rogueStatus: true,
-				rogueMode:   []string{"propose", "commit"},
+				rogueMode:   []string{"delayedPropose", "commit"},
 				voteErr:     errors.New("vote error"),
 			},
 			expectedFatal: false,
 		},
+		{
+			name: "Test 7: When there is an error in getting fault injection config file",
+			args: args{
+				config:                config,
+				password:              "test",
+				address:               "0x000000000000000000000000000000000000dea1",
+				rogueStatus:           true,
+				rogueMode:             []string{"delayedPropose", "commit"},
+				faultInjectionFileErr: errors.New("faultInjection error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 8: When the fault injection config file doesn't exist",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				rogueStatus:        true,
+				rogueMode:          []string{"delayedPropose", "commit"},
+				faultInjectionFile: "nonexistent_faults.json",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 9: When there is an error in getting encryptState",
+			args: args{
+				config:          config,
+				password:        "test",
+				address:         "0x000000000000000000000000000000000000dea1",
+				rogueStatus:     true,
+				rogueMode:       []string{"delayedPropose", "commit"},
+				encryptStateErr: errors.New("encryptState error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 10: When state encryption is enabled without a password",
+			args: args{
+				config:       config,
+				address:      "0x000000000000000000000000000000000000dea1",
+				rogueStatus:  true,
+				rogueMode:    []string{"delayedPropose", "commit"},
+				encryptState: true,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 11: When remote config is set",
+			args: args{
+				config:               config,
+				password:             "test",
+				address:              "0x000000000000000000000000000000000000dea1",
+				rogueMode:            []string{},
+				remoteConfigUrl:      "https://example.com/config.json",
+				remoteConfigSigner:   "0x000000000000000000000000000000000000dea2",
+				remoteConfigInterval: 300,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 12: When there is an error in getting remote config url",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				rogueMode:          []string{},
+				remoteConfigUrlErr: errors.New("remoteConfigUrl error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 13: When remote config signer is not an address",
+			args: args{
+				config:               config,
+				password:             "test",
+				address:              "0x000000000000000000000000000000000000dea1",
+				rogueMode:            []string{},
+				remoteConfigUrl:      "https://example.com/config.json",
+				remoteConfigSigner:   "signer",
+				remoteConfigInterval: 300,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 14: When remote config interval is 0",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				rogueMode:          []string{},
+				remoteConfigUrl:    "https://example.com/config.json",
+				remoteConfigSigner: "0x000000000000000000000000000000000000dea2",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 15: When there is an error in getting canary status",
+			args: args{
+				config:    config,
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dea1",
+				rogueMode: []string{},
+				canaryErr: errors.New("canary error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 16: When there is an error in getting canary file name",
+			args: args{
+				config:            config,
+				password:          "test",
+				address:           "0x000000000000000000000000000000000000dea1",
+				rogueMode:         []string{},
+				canary:            true,
+				canaryFileNameErr: errors.New("canary file name error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 17: When data directory is locked by another process",
+			args: args{
+				config:         config,
+				password:       "test",
+				address:        "0x000000000000000000000000000000000000dea1",
+				rogueMode:      []string{},
+				lockDataDirErr: errors.New("data directory is in use by another process"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 18: When there is an error in getting subscribed collections",
+			args: args{
+				config:                   config,
+				password:                 "test",
+				address:                  "0x000000000000000000000000000000000000dea1",
+				rogueMode:                []string{},
+				subscribedCollectionsErr: errors.New("subscribed collections error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 19: When collections are subscribed without acknowledging the penalties",
+			args: args{
+				config:                config,
+				password:              "test",
+				address:               "0x000000000000000000000000000000000000dea1",
+				rogueMode:             []string{},
+				subscribedCollections: []uint{1, 2},
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 20: When collections are subscribed and the penalties are acknowledged",
+			args: args{
+				config:                  config,
+				password:                "test",
+				address:                 "0x000000000000000000000000000000000000dea1",
+				rogueMode:               []string{},
+				subscribedCollections:   []uint{1, 2},
+				acknowledgeUnsubscribed: true,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 21: When there is an error in getting metrics port",
+			args: args{
+				config:         config,
+				password:       "test",
+				address:        "0x000000000000000000000000000000000000dea1",
+				rogueMode:      []string{},
+				metricsPortErr: errors.New("metricsPort error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 22: When there is an error in getting rpcDebug status",
+			args: args{
+				config:      config,
+				password:    "test",
+				address:     "0x000000000000000000000000000000000000dea1",
+				rogueMode:   []string{},
+				rpcDebugErr: errors.New("rpcDebug error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 23: When there is an error in getting rpcDebugDuration",
+			args: args{
+				config:              config,
+				password:            "test",
+				address:             "0x000000000000000000000000000000000000dea1",
+				rogueMode:           []string{},
+				rpcDebug:            true,
+				rpcDebugDurationErr: errors.New("rpcDebugDuration error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 24: When rpcDebugDuration is 0",
+			args: args{
+				config:    config,
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dea1",
+				rogueMode: []string{},
+				rpcDebug:  true,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 25: When there is an error in getting RPC debug file name",
+			args: args{
+				config:              config,
+				password:            "test",
+				address:             "0x000000000000000000000000000000000000dea1",
+				rogueMode:           []string{},
+				rpcDebug:            true,
+				rpcDebugDuration:    600,
+				rpcDebugFileNameErr: errors.New("rpc debug file name error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 26: When there is an error in getting useKeychain",
+			args: args{
+				config:         config,
+				address:        "0x000000000000000000000000000000000000dea1",
+				rogueMode:      []string{},
+				useKeychainErr: errors.New("useKeychain error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 27: When the password is read from the keychain",
+			args: args{
+				config:      config,
+				password:    "test",
+				address:     "0x000000000000000000000000000000000000dea1",
+				rogueMode:   []string{},
+				useKeychain: true,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 28: When there is an error in getting password from the keychain",
+			args: args{
+				config:              config,
+				address:             "0x000000000000000000000000000000000000dea1",
+				rogueMode:           []string{},
+				useKeychain:         true,
+				keychainPasswordErr: errors.New("password is not stored in the keychain"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 29: When there is an error in getting speedUpBlocks",
+			args: args{
+				config:           config,
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dea1",
+				rogueMode:        []string{},
+				speedUpBlocksErr: errors.New("speedUpBlocks error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 30: When the eligible bounties are claimed automatically",
+			args: args{
+				config:          config,
+				password:        "test",
+				address:         "0x000000000000000000000000000000000000dea1",
+				rogueMode:       []string{},
+				autoClaimBounty: true,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 31: When there is an error in getting autoClaimBounty",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				rogueMode:          []string{},
+				autoClaimBountyErr: errors.New("autoClaimBounty error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 32: When the notifications are rendered with the templates of a file",
+			args: args{
+				config:                config,
+				password:              "test",
+				address:               "0x000000000000000000000000000000000000dea1",
+				rogueMode:             []string{},
+				notificationTemplates: notificationTemplatesFile,
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 33: When there is an error in getting notificationTemplates",
+			args: args{
+				config:                   config,
+				password:                 "test",
+				address:                  "0x000000000000000000000000000000000000dea1",
+				rogueMode:                []string{},
+				notificationTemplatesErr: errors.New("notificationTemplates error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 34: When the notification templates file doesn't exist",
+			args: args{
+				config:                config,
+				password:              "test",
+				address:               "0x000000000000000000000000000000000000dea1",
+				rogueMode:             []string{},
+				notificationTemplates: path.Join(t.TempDir(), "missing.tmpl"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 35: When the staker can't earn rewards by voting",
+			args: args{
+				config:               config,
+				password:             "test",
+				address:              "0x000000000000000000000000000000000000dea1",
+				rogueMode:            []string{},
+				votingEligibilityErr: errors.New("stake is below the minimum safe razor"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 36: When there is an error in getting fleet status",
+			args: args{
+				config:    config,
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dea1",
+				rogueMode: []string{},
+				fleetErr:  errors.New("fleet error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 37: When fleet mode is enabled without the metrics port",
+			args: args{
+				config:    config,
+				password:  "test",
+				address:   "0x000000000000000000000000000000000000dea1",
+				rogueMode: []string{},
+				fleet:     true,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 38: When there is an error in getting health port",
+			args: args{
+				config:        config,
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dea1",
+				rogueMode:     []string{},
+				healthPortErr: errors.New("healthPort error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 39: When the alerts are sent to the webhooks",
+			args: args{
+				config:        config,
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dea1",
+				rogueMode:     []string{},
+				alertWebhooks: []string{"https://hooks.slack.com/services/T000/B000/XXXX"},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 40: When an alert webhook is invalid",
+			args: args{
+				config:        config,
+				password:      "test",
+				address:       "0x000000000000000000000000000000000000dea1",
+				rogueMode:     []string{},
+				alertWebhooks: []string{"hooks.slack.com/services"},
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 41: When there is an error in getting alert webhooks",
+			args: args{
+				config:           config,
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dea1",
+				rogueMode:        []string{},
+				alertWebhooksErr: errors.New("alertWebhooks error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 42: When there is an error in getting alert balance threshold",
+			args: args{
+				config:                   config,
+				password:                 "test",
+				address:                  "0x000000000000000000000000000000000000dea1",
+				rogueMode:                []string{},
+				alertBalanceThresholdErr: errors.New("alertBalanceThreshold error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 43: When there is an error in getting alert rpc down minutes",
+			args: args{
+				config:                 config,
+				password:               "test",
+				address:                "0x000000000000000000000000000000000000dea1",
+				rogueMode:              []string{},
+				alertRpcDownMinutesErr: errors.New("alertRpcDownMinutes error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 44: When several accounts are voted for",
+			args: args{
+				config:           config,
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dea1",
+				rogueMode:        []string{},
+				accountNames:     []string{"staker1", "staker2"},
+				resolvedAccounts: []string{"0x000000000000000000000000000000000000dea1", "0x000000000000000000000000000000000000dea2"},
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 45: When there is an error in getting accounts",
+			args: args{
+				config:          config,
+				password:        "test",
+				address:         "0x000000000000000000000000000000000000dea1",
+				rogueMode:       []string{},
+				accountNamesErr: errors.New("account error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 46: When there is an error in resolving the accounts",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				rogueMode:          []string{},
+				accountNames:       []string{"staker1", "staker1"},
+				resolvedAccounts:   []string{"0x000000000000000000000000000000000000dea1"},
+				resolveAccountsErr: errors.New("account is selected more than once"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 47: When the state is encrypted for several accounts",
+			args: args{
+				config:           config,
+				password:         "test",
+				address:          "0x000000000000000000000000000000000000dea1",
+				rogueMode:        []string{},
+				accountNames:     []string{"staker1", "staker2"},
+				resolvedAccounts: []string{"0x000000000000000000000000000000000000dea1", "0x000000000000000000000000000000000000dea2"},
+				encryptState:     true,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 48: When there is an error in getting alert RZR balance threshold",
+			args: args{
+				config:                        config,
+				password:                      "test",
+				address:                       "0x000000000000000000000000000000000000dea1",
+				rogueMode:                     []string{},
+				alertRazorBalanceThresholdErr: errors.New("alertRazorBalanceThreshold error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 49: When the vote command is run in observer mode",
+			args: args{
+				config:  config,
+				address: "0x000000000000000000000000000000000000dea1",
+				mode:    "observer",
+			},
+			expectedFatal: false,
+		},
+		{
+			name: "Test 50: When there is an error in getting mode",
+			args: args{
+				config:  config,
+				address: "0x000000000000000000000000000000000000dea1",
+				modeErr: errors.New("mode error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 51: When the mode is invalid",
+			args: args{
+				config:  config,
+				address: "0x000000000000000000000000000000000000dea1",
+				mode:    "validator",
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 52: When the rogue mode is invalid",
+			args: args{
+				config:      config,
+				password:    "test",
+				address:     "0x000000000000000000000000000000000000dea1",
+				rogueStatus: true,
+				rogueMode:   []string{"propose"},
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 53: When there is an error in getting chaos status",
+			args: args{
+				config:   config,
+				password: "test",
+				address:  "0x000000000000000000000000000000000000dea1",
+				chaosErr: errors.New("chaos error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 54: When there is an error in getting chaos config file",
+			args: args{
+				config:             config,
+				password:           "test",
+				address:            "0x000000000000000000000000000000000000dea1",
+				chaosConfigFileErr: errors.New("chaosConfig error"),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 55: When the chaos config file doesn't exist",
+			args: args{
+				config:          config,
+				password:        "test",
+				address:         "0x000000000000000000000000000000000000dea1",
+				chaos:           true,
+				chaosConfigFile: "nonexistent_chaos.json",
+			},
+			expectedFatal: true,
+		},
 	}
 
 	defer func() { log.ExitFunc = nil }()
@@ -139,12 +764,62 @@ func TestExecuteVote(t *testing.T) {
 			utilsMock.On("AssignLogFile", mock.AnythingOfType("*pflag.FlagSet"))
 			cmdUtilsMock.On("GetConfigData").Return(tt.args.config, tt.args.configErr)
 			utilsMock.On("AssignPassword").Return(tt.args.password)
+			flagSetUtilsMock.On("GetBoolUseKeychain", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.useKeychain, tt.args.useKeychainErr)
+			utilsMock.On("GetKeychainPassword", mock.AnythingOfType("string")).Return(tt.args.password, tt.args.keychainPasswordErr)
 			flagSetUtilsMock.On("GetStringAddress", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.address, tt.args.addressErr)
+			flagSetUtilsMock.On("GetStringSliceAccount", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.accountNames, tt.args.accountNamesErr)
+			mode := tt.args.mode
+			if mode == "" {
+				mode = "staker"
+			}
+			flagSetUtilsMock.On("GetStringMode", mock.AnythingOfType("*pflag.FlagSet")).Return(mode, tt.args.modeErr)
+			cmdUtilsMock.On("ExecuteObserver", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			cmdUtilsMock.On("ResolveAccounts", mock.Anything).Return(tt.args.resolvedAccounts, tt.args.resolveAccountsErr)
+			utilsMock.On("ReadPassword", mock.AnythingOfType("string")).Return(tt.args.password, nil)
 			utilsMock.On("ConnectToClient", mock.AnythingOfType("string")).Return(client)
 			flagSetUtilsMock.On("GetBoolRogue", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.rogueStatus, tt.args.rogueErr)
 			flagSetUtilsMock.On("GetStringSliceRogueMode", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.rogueMode, tt.args.rogueModeErr)
-			cmdUtilsMock.On("HandleExit").Return()
+			flagSetUtilsMock.On("GetStringFaultInjection", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.faultInjectionFile, tt.args.faultInjectionFileErr)
+			flagSetUtilsMock.On("GetBoolChaos", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.chaos, tt.args.chaosErr)
+			flagSetUtilsMock.On("GetStringChaosConfig", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.chaosConfigFile, tt.args.chaosConfigFileErr)
+			flagSetUtilsMock.On("GetStringNotificationTemplates", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.notificationTemplates, tt.args.notificationTemplatesErr)
+			flagSetUtilsMock.On("GetStringSliceAlertWebhooks", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.alertWebhooks, tt.args.alertWebhooksErr)
+			defer utils.SetAlertWebhooks(nil)
+			flagSetUtilsMock.On("GetFloat32AlertBalanceThreshold", mock.AnythingOfType("*pflag.FlagSet")).Return(float32(0), tt.args.alertBalanceThresholdErr)
+			flagSetUtilsMock.On("GetFloat32AlertRazorBalanceThreshold", mock.AnythingOfType("*pflag.FlagSet")).Return(float32(0), tt.args.alertRazorBalanceThresholdErr)
+			flagSetUtilsMock.On("GetUint32AlertRpcDownMinutes", mock.AnythingOfType("*pflag.FlagSet")).Return(uint32(5), tt.args.alertRpcDownMinutesErr)
+			flagSetUtilsMock.On("GetBoolEncryptState", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.encryptState, tt.args.encryptStateErr)
+			flagSetUtilsMock.On("GetBoolCanary", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.canary, tt.args.canaryErr)
+			utilsMock.On("LockDataDir", mock.AnythingOfType("string")).Return(tt.args.lockDataDirErr)
+			cmdUtilsMock.On("CheckVotingEligibility", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.votingEligibilityErr)
+			utilsMock.On("GetCanaryFileName", mock.AnythingOfType("string")).Return("", tt.args.canaryFileNameErr)
+			flagSetUtilsMock.On("GetBoolAttest", mock.AnythingOfType("*pflag.FlagSet")).Return(false, nil)
+			flagSetUtilsMock.On("GetBoolArchiveResponses", mock.AnythingOfType("*pflag.FlagSet")).Return(false, nil)
+			flagSetUtilsMock.On("GetUintSliceSubscribedCollections", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.subscribedCollections, tt.args.subscribedCollectionsErr)
+			flagSetUtilsMock.On("GetBoolAcknowledgeUnsubscribed", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.acknowledgeUnsubscribed, tt.args.acknowledgeUnsubscribedErr)
+			defer utils.SetSubscribedCollections(nil)
+			flagSetUtilsMock.On("GetStringRemoteConfigUrl", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.remoteConfigUrl, tt.args.remoteConfigUrlErr)
+			flagSetUtilsMock.On("GetStringRemoteConfigSigner", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.remoteConfigSigner, tt.args.remoteConfigSignerErr)
+			flagSetUtilsMock.On("GetUint32RemoteConfigInterval", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.remoteConfigInterval, tt.args.remoteConfigIntervalErr)
+			flagSetUtilsMock.On("GetBoolRpcDebug", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.rpcDebug, tt.args.rpcDebugErr)
+			flagSetUtilsMock.On("GetUint32RpcDebugDuration", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.rpcDebugDuration, tt.args.rpcDebugDurationErr)
+			utilsMock.On("GetRPCDebugFileName", mock.AnythingOfType("string")).Return("", tt.args.rpcDebugFileNameErr)
+			flagSetUtilsMock.On("GetUint32SpeedUpBlocks", mock.AnythingOfType("*pflag.FlagSet")).Return(uint32(3), tt.args.speedUpBlocksErr)
+			flagSetUtilsMock.On("GetStringHealthPort", mock.AnythingOfType("*pflag.FlagSet")).Return("", tt.args.healthPortErr)
+			flagSetUtilsMock.On("GetStringMetricsPort", mock.AnythingOfType("*pflag.FlagSet")).Return("", tt.args.metricsPortErr)
+			flagSetUtilsMock.On("GetStringDeployment", mock.AnythingOfType("*pflag.FlagSet")).Return("", nil)
+			flagSetUtilsMock.On("GetBoolFleet", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.fleet, tt.args.fleetErr)
+			defer utils.SetFleetMode(false)
+			cmdUtilsMock.On("PollRemoteConfig", mock.Anything, mock.Anything).Return()
+			flagSetUtilsMock.On("GetBoolAutoClaimBounty", mock.AnythingOfType("*pflag.FlagSet")).Return(tt.args.autoClaimBounty, tt.args.autoClaimBountyErr)
+			cmdUtilsMock.On("AutoClaimBounties", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			flagSetUtilsMock.On("GetBoolAutoWithdraw", mock.AnythingOfType("*pflag.FlagSet")).Return(false, nil)
+			cmdUtilsMock.On("AutoWithdraw", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			cmdUtilsMock.On("AccumulateRewards", mock.Anything, mock.Anything, mock.Anything).Return()
+			cmdUtilsMock.On("AutoUpdateCommission", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+			cmdUtilsMock.On("HandleExit", mock.Anything).Return()
 			cmdUtilsMock.On("Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.voteErr)
+			cmdUtilsMock.On("VoteAccounts", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.voteAccountsErr)
 			osMock.On("Exit", mock.AnythingOfType("int")).Return()
 
 			utils := &UtilsStruct{}
@@ -154,6 +829,144 @@ func TestExecuteVote(t *testing.T) {
 			if fatal != tt.expectedFatal {
 				t.Error("The ExecuteVote function didn't execute as expected")
 			}
+			if tt.args.mode == "observer" {
+				cmdUtilsMock.AssertCalled(t, "ExecuteObserver", flagSet, tt.args.config, client, tt.args.address)
+				utilsMock.AssertNotCalled(t, "AssignPassword")
+				cmdUtilsMock.AssertNotCalled(t, "Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestCheckVotingEligibility(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dea1"
+
+	type args struct {
+		disputeOnly       bool
+		stakerId          uint32
+		stakerIdErr       error
+		staker            bindings.StructsStaker
+		stakerErr         error
+		minSafeRazor      *big.Int
+		minSafeRazorErr   error
+		minStakeAmount    *big.Int
+		minStakeAmountErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the staker can earn rewards by voting",
+			args: args{
+				stakerId:       1,
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(2000)},
+				minSafeRazor:   big.NewInt(1000),
+				minStakeAmount: big.NewInt(500),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the stake is below the minimum safe razor",
+			args: args{
+				stakerId:       1,
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(900)},
+				minSafeRazor:   big.NewInt(1000),
+				minStakeAmount: big.NewInt(500),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When the stake is below the minimum stake amount",
+			args: args{
+				stakerId:       1,
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(1500)},
+				minSafeRazor:   big.NewInt(1000),
+				minStakeAmount: big.NewInt(2000),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When the staker is slashed",
+			args: args{
+				stakerId:       1,
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(2000), IsSlashed: true},
+				minSafeRazor:   big.NewInt(1000),
+				minStakeAmount: big.NewInt(500),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the staker doesn't exist",
+			args: args{
+				stakerId: 0,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the staker doesn't exist in dispute only mode",
+			args: args{
+				disputeOnly: true,
+				stakerId:    0,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 7: When there is an error in getting the staker id",
+			args: args{
+				stakerIdErr: errors.New("stakerId error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting the staker",
+			args: args{
+				stakerId:  1,
+				stakerErr: errors.New("staker error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 9: When there is an error in getting the minimum safe razor",
+			args: args{
+				stakerId:        1,
+				staker:          bindings.StructsStaker{Id: 1, Stake: big.NewInt(2000)},
+				minSafeRazorErr: errors.New("minSafeRazor error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 10: When there is an error in getting the minimum stake amount",
+			args: args{
+				stakerId:          1,
+				staker:            bindings.StructsStaker{Id: 1, Stake: big.NewInt(2000)},
+				minSafeRazor:      big.NewInt(1000),
+				minStakeAmountErr: errors.New("minStakeAmount error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			utilsInterface = utilsPkgMock
+
+			utilsPkgMock.On("IsFlagPassed", "disputeOnly").Return(tt.args.disputeOnly)
+			utilsMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), address).Return(tt.args.stakerId, tt.args.stakerIdErr)
+			utilsMock.On("GetStaker", mock.AnythingOfType("*ethclient.Client"), tt.args.stakerId).Return(tt.args.staker, tt.args.stakerErr)
+			utilsPkgMock.On("GetMinSafeRazor", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.minSafeRazor, tt.args.minSafeRazorErr)
+			utilsPkgMock.On("GetMinStakeAmount", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.minStakeAmount, tt.args.minStakeAmountErr)
+
+			ut := &UtilsStruct{}
+			if err := ut.CheckVotingEligibility(client, address); (err != nil) != tt.wantErr {
+				t.Errorf("CheckVotingEligibility() error = %v, wantErr %v", err, tt.wantErr)
+			}
 		})
 	}
 }
@@ -301,7 +1114,7 @@ func TestGetLastProposedEpoch(t *testing.T) {
 
 			utilsPkgMock.On("CalculateBlockNumberAtEpochBeginning", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.fromBlock, tt.args.fromBlockErr)
 			abiUtilsMock.On("Parse", mock.Anything).Return(tt.args.contractAbi, tt.args.parseErr)
-			utilsPkgMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
+			utilsPkgMock.On("FilterLogsInChunks", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(tt.args.logs, tt.args.logsErr)
 			abiMock.On("Unpack", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.unpackedData, tt.args.unpackErr)
 			cmdUtilsMock.On("GetBufferPercent").Return(tt.args.bufferPercent, tt.args.bufferPercentErr)
 			utilsPkgMock2.On("GetRemainingTimeOfCurrentState", mock.Anything, mock.Anything).Return(tt.args.time, tt.args.timeErr)
@@ -502,6 +1315,8 @@ func TestInitiateCommit(t *testing.T) {
 		fileName                  string
 		fileNameErr               error
 		saveErr                   error
+		epochGasEstimate          types.EpochGasEstimate
+		epochGasEstimateErr       error
 	}
 	tests := []struct {
 		name    string
@@ -671,6 +1486,58 @@ func TestInitiateCommit(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Test 14: When the commit transaction is unresolved after the maximum wait time",
+			args: args{
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
+				minStakeAmount: big.NewInt(100),
+				epoch:          5,
+				lastCommit:     2,
+				secret:         []byte{1},
+				salt:           [32]byte{},
+				commitData: types.CommitData{
+					AssignedCollections:    nil,
+					SeqAllottedCollections: nil,
+					Leaves:                 nil,
+				},
+				merkleTree:                [][][]byte{},
+				commitTxn:                 common.BigToHash(big.NewInt(1)),
+				waitForBlockCompletionErr: utils.ErrTransactionMiningTimeout,
+				fileName:                  "",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 15: When the ETH balance can't pay for the commit and the reveal",
+			args: args{
+				staker:           bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
+				minStakeAmount:   big.NewInt(100),
+				epoch:            5,
+				lastCommit:       2,
+				epochGasEstimate: types.EpochGasEstimate{Balance: big.NewInt(1e14), ExpectedCost: big.NewInt(1e15)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 16: When the cost of the commit and the reveal can't be estimated",
+			args: args{
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
+				minStakeAmount: big.NewInt(100),
+				epoch:          5,
+				lastCommit:     2,
+				secret:         []byte{1},
+				salt:           [32]byte{},
+				commitData: types.CommitData{
+					AssignedCollections:    nil,
+					SeqAllottedCollections: nil,
+					Leaves:                 nil,
+				},
+				merkleTree:          [][][]byte{},
+				commitTxn:           common.BigToHash(big.NewInt(1)),
+				epochGasEstimateErr: errors.New("journal error"),
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -694,9 +1561,15 @@ func TestInitiateCommit(t *testing.T) {
 			merkleInterface.On("GetMerkleRoot", mock.Anything).Return(tt.args.merkleRoot)
 			utilsMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
 			cmdUtilsMock.On("Commit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.commitTxn, tt.args.commitTxnErr)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.waitForBlockCompletionErr)
+			cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", tt.args.waitForBlockCompletionErr)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 			utilsMock.On("GetCommitDataFileName", mock.AnythingOfType("string")).Return(tt.args.fileName, tt.args.fileNameErr)
 			utilsMock.On("SaveDataToCommitJsonFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.saveErr)
+			epochGasEstimate := tt.args.epochGasEstimate
+			if epochGasEstimate.Balance == nil {
+				epochGasEstimate = types.EpochGasEstimate{Balance: big.NewInt(1e18), ExpectedCost: big.NewInt(1e15)}
+			}
+			cmdUtilsMock.On("EstimateEpochGas", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string")).Return(epochGasEstimate, tt.args.epochGasEstimateErr)
 			ut := &UtilsStruct{}
 			if err := ut.InitiateCommit(client, config, account, tt.args.epoch, stakerId, rogueData); (err != nil) != tt.wantErr {
 				t.Errorf("InitiateCommit() error = %v, wantErr %v", err, tt.wantErr)
@@ -885,6 +1758,18 @@ func TestInitiateReveal(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Test 13: When the reveal is skipped as rogueMode is skipReveal",
+			args: args{
+				epoch: 5,
+				rogueData: types.Rogue{
+					IsRogue:   true,
+					RogueMode: []string{"skipReveal"},
+				},
+				lastReveal: 2,
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -905,11 +1790,15 @@ func TestInitiateReveal(t *testing.T) {
 			utilsMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
 			cmdUtilsMock.On("CalculateSecret", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.signature, tt.args.secret, tt.args.secretErr)
 			cmdUtilsMock.On("Reveal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.revealTxn, tt.args.revealTxnErr)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 			ut := &UtilsStruct{}
 			if err := ut.InitiateReveal(client, config, account, tt.args.epoch, tt.args.staker, tt.args.rogueData); (err != nil) != tt.wantErr {
 				t.Errorf("InitiateReveal() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if utils.Contains(tt.args.rogueData.RogueMode, "skipReveal") {
+				cmdUtilsMock.AssertNotCalled(t, "Reveal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
 		})
 	}
 }
@@ -920,7 +1809,6 @@ func TestInitiatePropose(t *testing.T) {
 		config      types.Configurations
 		account     types.Account
 		blockNumber *big.Int
-		rogueData   types.Rogue
 	)
 	type args struct {
 		staker            bindings.StructsStaker
@@ -933,6 +1821,7 @@ func TestInitiatePropose(t *testing.T) {
 		lastRevealErr     error
 		proposeTxn        common.Hash
 		proposeTxnErr     error
+		rogueData         types.Rogue
 	}
 	tests := []struct {
 		name    string
@@ -1015,26 +1904,53 @@ func TestInitiatePropose(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Test 9: When the propose is delayed as rogueMode is delayedPropose",
+			args: args{
+				staker:         bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
+				minStakeAmount: big.NewInt(100),
+				epoch:          5,
+				lastProposal:   4,
+				lastReveal:     6,
+				proposeTxn:     common.BigToHash(big.NewInt(1)),
+				rogueData: types.Rogue{
+					IsRogue:      true,
+					RogueMode:    []string{"delayedPropose"},
+					ProposeDelay: 30,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			utilsMock := new(mocks.UtilsInterface)
 			cmdUtilsMock := new(mocks.UtilsCmdInterface)
 			utilsPkgMock := new(mocks2.Utils)
+			timeMock := new(mocks.TimeInterface)
 
 			razorUtils = utilsMock
 			cmdUtils = cmdUtilsMock
 			utils.UtilsInterface = utilsPkgMock
+			timeUtils = timeMock
+
+			timeMock.On("Sleep", mock.AnythingOfType("time.Duration")).Return()
 
 			utilsPkgMock.On("GetMinStakeAmount", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.minStakeAmount, tt.args.minStakeAmountErr)
 			cmdUtilsMock.On("GetLastProposedEpoch", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("*big.Int"), mock.AnythingOfType("uint32")).Return(tt.args.lastProposal, tt.args.lastProposalErr)
 			utilsMock.On("GetEpochLastRevealed", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(tt.args.lastReveal, tt.args.lastRevealErr)
 			cmdUtilsMock.On("Propose", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.proposeTxn, tt.args.proposeTxnErr)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 			ut := &UtilsStruct{}
-			if err := ut.InitiatePropose(client, config, account, tt.args.epoch, tt.args.staker, blockNumber, rogueData); (err != nil) != tt.wantErr {
+			if err := ut.InitiatePropose(client, config, account, tt.args.epoch, tt.args.staker, blockNumber, tt.args.rogueData); (err != nil) != tt.wantErr {
 				t.Errorf("InitiatePropose() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if utils.Contains(tt.args.rogueData.RogueMode, "delayedPropose") {
+				timeMock.AssertCalled(t, "Sleep", 30*time.Second)
+			} else {
+				timeMock.AssertNotCalled(t, "Sleep", mock.Anything)
+			}
 		})
 	}
 }
@@ -1048,33 +1964,32 @@ func TestHandleBlock(t *testing.T) {
 	)
 
 	type args struct {
-		config               types.Configurations
-		state                int64
-		stateErr             error
-		epoch                uint32
-		epochErr             error
-		stateName            string
-		stakerId             uint32
-		stakerIdErr          error
-		staker               bindings.StructsStaker
-		stakerErr            error
-		ethBalance           *big.Int
-		ethBalanceErr        error
-		actualStake          *big.Float
-		actualStakeErr       error
-		actualBalance        *big.Float
-		sRZRBalance          *big.Int
-		sRZRBalanceErr       error
-		sRZRInEth            *big.Float
-		initiateCommitErr    error
-		initiateRevealErr    error
-		initiateProposeErr   error
-		handleDisputeErr     error
-		claimBlockRewardTxn  common.Hash
-		claimBlockRewardErr  error
-		lastVerification     uint32
-		isFlagPassed         bool
-		handleClaimBountyErr error
+		config              types.Configurations
+		state               int64
+		stateErr            error
+		epoch               uint32
+		epochErr            error
+		stateName           string
+		stakerId            uint32
+		stakerIdErr         error
+		staker              bindings.StructsStaker
+		stakerErr           error
+		ethBalance          *big.Int
+		ethBalanceErr       error
+		actualStake         *big.Float
+		actualStakeErr      error
+		actualBalance       *big.Float
+		sRZRBalance         *big.Int
+		sRZRBalanceErr      error
+		sRZRInEth           *big.Float
+		initiateCommitErr   error
+		initiateRevealErr   error
+		initiateProposeErr  error
+		handleDisputeErr    error
+		claimBlockRewardTxn common.Hash
+		claimBlockRewardErr error
+		lastVerification    uint32
+		disputeOnly         bool
 	}
 	tests := []struct {
 		name string
@@ -1283,40 +2198,7 @@ func TestHandleBlock(t *testing.T) {
 			},
 		},
 		{
-			name: "Test 17: When there is no error in dispute and HandleClaimBounty flag is passed",
-			args: args{
-				state:         3,
-				epoch:         1,
-				stateName:     "dispute",
-				stakerId:      1,
-				staker:        bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
-				ethBalance:    big.NewInt(1000),
-				actualStake:   big.NewFloat(10000),
-				actualBalance: big.NewFloat(1000),
-				sRZRBalance:   big.NewInt(10000),
-				sRZRInEth:     big.NewFloat(100),
-				isFlagPassed:  true,
-			},
-		},
-		{
-			name: "Test 18: When there is no error in dispute but HandleClaimBounty throws error",
-			args: args{
-				state:                3,
-				epoch:                1,
-				stateName:            "dispute",
-				stakerId:             1,
-				staker:               bindings.StructsStaker{Id: 1, Stake: big.NewInt(10000)},
-				ethBalance:           big.NewInt(1000),
-				actualStake:          big.NewFloat(10000),
-				actualBalance:        big.NewFloat(1000),
-				sRZRBalance:          big.NewInt(10000),
-				sRZRInEth:            big.NewFloat(100),
-				isFlagPassed:         true,
-				handleClaimBountyErr: errors.New("error in handleClaimBounty"),
-			},
-		},
-		{
-			name: "Test 19: When claimBlockReward executes successfully in confirm state",
+			name: "Test 17: When claimBlockReward executes successfully in confirm state",
 			args: args{
 				state:               4,
 				epoch:               1,
@@ -1333,7 +2215,7 @@ func TestHandleBlock(t *testing.T) {
 			},
 		},
 		{
-			name: "Test 20: When there is an error in claimBlockReward",
+			name: "Test 18: When there is an error in claimBlockReward",
 			args: args{
 				state:               4,
 				epoch:               2,
@@ -1350,7 +2232,7 @@ func TestHandleBlock(t *testing.T) {
 			},
 		},
 		{
-			name: "Test 21: When lastVerification is greater than the current epoch in dispute state",
+			name: "Test 19: When lastVerification is greater than the current epoch in dispute state",
 			args: args{
 				state:            3,
 				epoch:            1,
@@ -1366,7 +2248,7 @@ func TestHandleBlock(t *testing.T) {
 			},
 		},
 		{
-			name: "Test 22: When waitTime is more than 5 in -1 state",
+			name: "Test 20: When waitTime is more than 5 in -1 state",
 			args: args{
 				state:            -1,
 				epoch:            1,
@@ -1382,6 +2264,15 @@ func TestHandleBlock(t *testing.T) {
 				config:           types.Configurations{WaitTime: 6},
 			},
 		},
+		{
+			name: "Test 21: When node is running in dispute only mode",
+			args: args{
+				state:       3,
+				epoch:       1,
+				stateName:   "dispute",
+				disputeOnly: true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1411,15 +2302,106 @@ func TestHandleBlock(t *testing.T) {
 			cmdUtilsMock.On("InitiateReveal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.initiateRevealErr)
 			cmdUtilsMock.On("InitiatePropose", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.initiateProposeErr)
 			cmdUtilsMock.On("HandleDispute", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(tt.args.handleDisputeErr)
-			utilsPkgMock.On("IsFlagPassed", mock.AnythingOfType("string")).Return(tt.args.isFlagPassed)
-			cmdUtilsMock.On("HandleClaimBounty", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.handleClaimBountyErr)
+			utilsPkgMock.On("IsFlagPassed", "disputeOnly").Return(tt.args.disputeOnly)
+			cmdUtilsMock.On("HandleDisputeOnlyBlock", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
 			cmdUtilsMock.On("ClaimBlockReward", mock.Anything).Return(tt.args.claimBlockRewardTxn, tt.args.claimBlockRewardErr)
-			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(nil)
+			utilsPkgMock.On("GetBlock", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("uint32")).Return(bindings.StructsBlock{}, nil)
+			cmdUtilsMock.On("WaitForTransactionOfState", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return("", nil)
+			cmdUtilsMock.On("RecordJournalAction", mock.Anything, mock.Anything, mock.Anything)
 			timeMock.On("Sleep", mock.Anything).Return()
 			utilsMock.On("WaitTillNextNSecs", mock.AnythingOfType("int32")).Return()
 			lastVerification = tt.args.lastVerification
+			claimAttempts = claimBlockRewardAttempts{}
 			ut := &UtilsStruct{}
 			ut.HandleBlock(client, account, blockNumber, tt.args.config, rogueData)
 		})
 	}
 }
+
+func TestCheckChainSync(t *testing.T) {
+	var client *ethclient.Client
+
+	clientUtilsMock := new(mocks2.ClientUtils)
+	utils.ClientInterface = clientUtilsMock
+	clientUtilsMock.On("SyncProgress", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(nil, nil)
+
+	staleHeader := &Types.Header{Number: big.NewInt(100), Time: uint64(time.Now().Add(-time.Hour).Unix())}
+	latestHeader := &Types.Header{Number: big.NewInt(101), Time: uint64(time.Now().Unix())}
+
+	steps := []struct {
+		name       string
+		header     *Types.Header
+		want       bool
+		wantPaused bool
+	}{
+		{
+			name:       "Test 1: When the latest block of the provider is too old, voting is paused",
+			header:     staleHeader,
+			want:       false,
+			wantPaused: true,
+		},
+		{
+			name:       "Test 2: When the provider is still lagging, voting stays paused",
+			header:     staleHeader,
+			want:       false,
+			wantPaused: true,
+		},
+		{
+			name:       "Test 3: When the provider has caught up, voting is resumed",
+			header:     latestHeader,
+			want:       true,
+			wantPaused: false,
+		},
+	}
+	defer func() { chainSyncPaused = false }()
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			if got := checkChainSync(client, step.header); got != step.want {
+				t.Errorf("checkChainSync() = %v, want %v", got, step.want)
+			}
+			if chainSyncPaused != step.wantPaused {
+				t.Errorf("checkChainSync() paused = %v, want %v", chainSyncPaused, step.wantPaused)
+			}
+		})
+	}
+}
+
+func TestVoteStopsAfterInFlightTransactions(t *testing.T) {
+	var (
+		client    *ethclient.Client
+		config    types.Configurations
+		rogueData types.Rogue
+		account   types.Account
+	)
+
+	utilsPkgMock := new(mocks2.Utils)
+	utils.UtilsInterface = utilsPkgMock
+	utilsPkgMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(&Types.Header{Number: big.NewInt(100)}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// A bounty claim is in flight when the voting is stopped
+	transactionMutex.Lock()
+	done := make(chan error)
+	ut := &UtilsStruct{}
+	go func() {
+		done <- ut.Vote(ctx, config, client, rogueData, account)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Vote() returned before the transaction in flight is completed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	transactionMutex.Unlock()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Vote() error = %v, want nil", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Vote() didn't return after the voting is stopped")
+	}
+}
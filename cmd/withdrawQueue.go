@@ -0,0 +1,342 @@
+//Package cmd provides all functions related to command line
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/logger"
+	"razor/path"
+	"razor/utils"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+//States in which the withdrawal can be initiated
+var withdrawInitiationStates = []int{0, 1, 4}
+
+var withdrawQueueCmd = &cobra.Command{
+	Use:   "withdrawQueue",
+	Short: "pending unstake and withdraw locks of an address",
+	Long: `Lists the unstaked amounts tracked in the withdraw queue with the stage of their locks and the epochs left before the next step. The amounts unstaked with the unstake command are added to the queue, pass --stakerId to track the unstake lock of any other staker.
+The vote command with --autoWithdraw initiates the withdrawal and unlocks it as soon as the locks allow it.
+
+Example:
+  ./razor withdrawQueue --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c
+  ./razor withdrawQueue --address 0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c --stakerId 2`,
+	Run: initialiseWithdrawQueue,
+}
+
+//This function initialises the ExecuteWithdrawQueue function
+func initialiseWithdrawQueue(cmd *cobra.Command, args []string) {
+	cmdUtils.ExecuteWithdrawQueue(cmd.Flags())
+}
+
+//This function sets the flags appropriately and prints the pending locks in the withdraw queue
+func (*UtilsStruct) ExecuteWithdrawQueue(flagSet *pflag.FlagSet) {
+	config, err := cmdUtils.GetConfigData()
+	utils.CheckError("Error in getting config: ", err)
+
+	client := razorUtils.ConnectToClient(config.Provider)
+	logger.SetLoggerParameters(client, "")
+
+	address, err := flagSetUtils.GetStringAddress(flagSet)
+	utils.CheckError("Error in getting address: ", err)
+
+	stakerId, err := flagSetUtils.GetUint32StakerId(flagSet)
+	utils.CheckError("Error in getting stakerId: ", err)
+	if stakerId != 0 {
+		err = cmdUtils.AddToWithdrawQueue(client, address, stakerId, "")
+		utils.CheckError("Error in adding to withdraw queue: ", err)
+	}
+
+	locks, err := cmdUtils.GetWithdrawLocks(client, address)
+	utils.CheckError("Error in getting withdraw locks: ", err)
+
+	if utils.IsJsonOutput() {
+		if locks == nil {
+			locks = []types.WithdrawLock{}
+		}
+		utils.CheckError("Error in printing withdraw locks: ", utils.PrintJson(locks))
+		return
+	}
+	printWithdrawLocks(address, locks)
+}
+
+//This function adds the unstake lock of the address for the staker to the withdraw queue, replacing the entry of the staker if it is already queued
+func (*UtilsStruct) AddToWithdrawQueue(client *ethclient.Client, address string, stakerId uint32, txnHash string) error {
+	unstakeLock, err := razorUtils.GetLock(client, address, stakerId, 0)
+	if err != nil {
+		return err
+	}
+	if unstakeLock.Amount == nil || unstakeLock.Amount.Sign() == 0 {
+		return fmt.Errorf("no unstake lock found for staker %d", stakerId)
+	}
+	fileName, err := path.PathUtilsInterface.GetWithdrawQueueFileName(address)
+	if err != nil {
+		return err
+	}
+	queue, err := utils.UtilsInterface.ReadFromWithdrawQueueFile(fileName)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	entry := types.WithdrawQueueEntry{
+		StakerId:    stakerId,
+		UnlockAfter: uint32(unstakeLock.UnlockAfter.Uint64()),
+		TxnHash:     txnHash,
+	}
+	var newQueue []types.WithdrawQueueEntry
+	for _, queuedEntry := range queue {
+		if queuedEntry.StakerId != stakerId {
+			newQueue = append(newQueue, queuedEntry)
+		}
+	}
+	newQueue = append(newQueue, entry)
+	err = utils.UtilsInterface.SaveDataToWithdrawQueueFile(fileName, newQueue)
+	if err != nil {
+		return err
+	}
+	log.Infof("Unstake lock of staker %d is added to the withdraw queue, it is unlocked after epoch %d", stakerId, entry.UnlockAfter)
+	return nil
+}
+
+//This function returns the pending locks of the stakers in the withdraw queue of the address
+//The stakers which have no lock left are removed from the queue
+func (*UtilsStruct) GetWithdrawLocks(client *ethclient.Client, address string) ([]types.WithdrawLock, error) {
+	fileName, err := path.PathUtilsInterface.GetWithdrawQueueFileName(address)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := utils.UtilsInterface.ReadFromWithdrawQueueFile(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(queue) == 0 {
+		return nil, nil
+	}
+	epoch, err := razorUtils.GetEpoch(client)
+	if err != nil {
+		return nil, err
+	}
+	withdrawInitiationPeriod, err := razorUtils.GetWithdrawInitiationPeriod(client)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		locks    []types.WithdrawLock
+		newQueue []types.WithdrawQueueEntry
+	)
+	for _, entry := range queue {
+		unstakeLock, err := razorUtils.GetLock(client, address, entry.StakerId, 0)
+		if err != nil {
+			return nil, err
+		}
+		withdrawLock, err := razorUtils.GetLock(client, address, entry.StakerId, 1)
+		if err != nil {
+			return nil, err
+		}
+		stakerLocks := getWithdrawLocks(entry.StakerId, epoch, withdrawInitiationPeriod, unstakeLock, withdrawLock)
+		if len(stakerLocks) == 0 {
+			log.Debugf("Staker %d has no lock left, removing it from the withdraw queue", entry.StakerId)
+			continue
+		}
+		locks = append(locks, stakerLocks...)
+		newQueue = append(newQueue, entry)
+	}
+	if len(newQueue) != len(queue) {
+		err = utils.UtilsInterface.SaveDataToWithdrawQueueFile(fileName, newQueue)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return locks, nil
+}
+
+//This function returns the stage of the unstake and withdraw locks of the staker in the epoch, the locks with no amount are left out
+//The withdrawal can be initiated from the epoch after which the unstake lock is unlocked until the withdraw initiation period is over
+func getWithdrawLocks(stakerId uint32, epoch uint32, withdrawInitiationPeriod uint16, unstakeLock types.Locks, withdrawLock types.Locks) []types.WithdrawLock {
+	var locks []types.WithdrawLock
+	if unstakeLock.Amount != nil && unstakeLock.Amount.Sign() != 0 {
+		lock := types.WithdrawLock{
+			StakerId:       stakerId,
+			Amount:         unstakeLock.Amount,
+			UnlockAfter:    uint32(unstakeLock.UnlockAfter.Uint64()),
+			WithdrawBefore: uint32(unstakeLock.UnlockAfter.Uint64()) + uint32(withdrawInitiationPeriod),
+			Epoch:          epoch,
+		}
+		switch {
+		case epoch < lock.UnlockAfter:
+			lock.Stage = core.WithdrawStageUnstakeLocked
+			lock.EpochsRemaining = lock.UnlockAfter - epoch
+		case epoch <= lock.WithdrawBefore:
+			lock.Stage = core.WithdrawStageWithdrawable
+		default:
+			lock.Stage = core.WithdrawStageExpired
+		}
+		locks = append(locks, lock)
+	}
+	if withdrawLock.Amount != nil && withdrawLock.Amount.Sign() != 0 {
+		lock := types.WithdrawLock{
+			StakerId:    stakerId,
+			Amount:      withdrawLock.Amount,
+			UnlockAfter: uint32(withdrawLock.UnlockAfter.Uint64()),
+			Epoch:       epoch,
+			Stage:       core.WithdrawStageUnlockable,
+		}
+		if epoch < lock.UnlockAfter {
+			lock.Stage = core.WithdrawStageWithdrawLocked
+			lock.EpochsRemaining = lock.UnlockAfter - epoch
+		}
+		locks = append(locks, lock)
+	}
+	return locks
+}
+
+//This function initiates the withdrawals and unlocks the withdrawals in the withdraw queue of the account once their locks allow it, it checks every state until the context is done
+func (*UtilsStruct) AutoWithdraw(ctx context.Context, client *ethclient.Client, config types.Configurations, account types.Account) {
+	for {
+		if err := cmdUtils.ProcessWithdrawQueue(client, config, account); err != nil {
+			log.Error("Error in processing withdraw queue: ", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(core.StateLength) * time.Second):
+		}
+	}
+}
+
+//This function initiates the withdrawal of the unlocked unstake locks and unlocks the unlocked withdraw locks in the withdraw queue of the account
+//The withdrawal is only initiated in the states in which it is allowed, so that the vote loop isn't blocked while waiting for them
+func (*UtilsStruct) ProcessWithdrawQueue(client *ethclient.Client, config types.Configurations, account types.Account) error {
+	locks, err := cmdUtils.GetWithdrawLocks(client, account.Address)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		switch lock.Stage {
+		case core.WithdrawStageWithdrawable:
+			state, err := razorUtils.GetDelayedState(client, config.BufferPercent)
+			if err != nil {
+				return err
+			}
+			if !isWithdrawInitiationState(state) {
+				log.Debugf("Withdrawal of staker %d can't be initiated in state %d, it is retried in the next state", lock.StakerId, state)
+				continue
+			}
+			transactionMutex.Lock()
+			txn, err := cmdUtils.HandleUnstakeLock(client, account, config, lock.StakerId)
+			completeWithdrawQueueTxn(client, account.Address, lock, "initiateWithdraw", txn.Hex(), err)
+			transactionMutex.Unlock()
+		case core.WithdrawStageUnlockable:
+			transactionMutex.Lock()
+			txn, err := cmdUtils.HandleWithdrawLock(client, account, config, lock.StakerId)
+			completeWithdrawQueueTxn(client, account.Address, lock, "unlockWithdraw", txn.Hex(), err)
+			transactionMutex.Unlock()
+		case core.WithdrawStageExpired:
+			log.Warnf("Withdraw initiation period of the unstake lock of staker %d ended in epoch %d, reset the unstake lock with resetUnstakeLock", lock.StakerId, lock.WithdrawBefore)
+		}
+	}
+	return nil
+}
+
+//This function waits for the transaction sent for the lock in the withdraw queue to be mined and records it in the journal
+func completeWithdrawQueueTxn(client *ethclient.Client, address string, lock types.WithdrawLock, action string, txnHash string, err error) {
+	if err != nil {
+		log.Errorf("Error in %s for staker %d: %s", action, lock.StakerId, err)
+		return
+	}
+	if txnHash == core.NilHash.Hex() {
+		return
+	}
+	waitForBlockCompletionErr := razorUtils.WaitForBlockCompletion(client, txnHash)
+	cmdUtils.RecordJournalAction(address, lock.Epoch, types.JournalAction{
+		Action:  action,
+		TxnHash: txnHash,
+		Amount:  utils.GetAmountInDecimal(lock.Amount).String(),
+		Status:  GetJournalTxnStatus(waitForBlockCompletionErr),
+	})
+	if waitForBlockCompletionErr != nil {
+		log.Errorf("Error in WaitForBlockCompletion for %s of staker %d: %s", action, lock.StakerId, waitForBlockCompletionErr)
+	}
+}
+
+//This function returns true if the withdrawal can be initiated in the state
+func isWithdrawInitiationState(state int64) bool {
+	for _, withdrawInitiationState := range withdrawInitiationStates {
+		if int64(withdrawInitiationState) == state {
+			return true
+		}
+	}
+	return false
+}
+
+//This function prints the pending locks of the address in a table
+func printWithdrawLocks(address string, locks []types.WithdrawLock) {
+	if len(locks) == 0 {
+		log.Infof("No pending locks found in the withdraw queue of %s", address)
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Staker Id", "Stage", "Amount", "Unlock After", "Withdraw Before", "Countdown"})
+	for _, lock := range locks {
+		withdrawBefore := "-"
+		if lock.WithdrawBefore != 0 {
+			withdrawBefore = strconv.Itoa(int(lock.WithdrawBefore))
+		}
+		table.Append([]string{
+			strconv.Itoa(int(lock.StakerId)),
+			lock.Stage,
+			formatWithdrawLockAmount(lock),
+			strconv.Itoa(int(lock.UnlockAfter)),
+			withdrawBefore,
+			formatWithdrawLockCountdown(lock),
+		})
+	}
+	table.Render()
+}
+
+//This function returns the amount of the lock as text, the unstake lock is in sRZR and the withdraw lock is in RZR
+func formatWithdrawLockAmount(lock types.WithdrawLock) string {
+	unit := "sRZR"
+	if lock.Stage == core.WithdrawStageWithdrawLocked || lock.Stage == core.WithdrawStageUnlockable {
+		unit = "RZR"
+	}
+	return fmt.Sprintf("%s %s", utils.GetAmountInDecimal(lock.Amount), unit)
+}
+
+//This function returns the epochs left before the next step of the lock as text, it is "-" if the next step can be taken now
+func formatWithdrawLockCountdown(lock types.WithdrawLock) string {
+	if lock.EpochsRemaining == 0 {
+		return "-"
+	}
+	timeRemaining := int64(lock.EpochsRemaining) * core.EpochLength
+	return fmt.Sprintf("%d epochs (approximately %s)", lock.EpochsRemaining, razorUtils.SecondsToReadableTime(int(timeRemaining)))
+}
+
+func init() {
+	rootCmd.AddCommand(withdrawQueueCmd)
+
+	var (
+		Address  string
+		StakerId uint32
+	)
+
+	withdrawQueueCmd.Flags().StringVarP(&Address, "address", "a", "", "address of the user")
+	withdrawQueueCmd.Flags().Uint32VarP(&StakerId, "stakerId", "", 0, "staker id whose unstake lock is added to the withdraw queue")
+
+	addrErr := withdrawQueueCmd.MarkFlagRequired("address")
+	utils.CheckError("Address error: ", addrErr)
+}
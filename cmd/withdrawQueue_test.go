@@ -0,0 +1,361 @@
+package cmd
+
+import (
+	"errors"
+	"io/fs"
+	"math/big"
+	"razor/cmd/mocks"
+	"razor/core"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"razor/utils"
+	mocks2 "razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAddToWithdrawQueue(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dead"
+
+	type args struct {
+		unstakeLock types.Locks
+		lockErr     error
+		queue       []types.WithdrawQueueEntry
+		queueErr    error
+		saveErr     error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantSaved []types.WithdrawQueueEntry
+		wantErr   bool
+	}{
+		{
+			name: "Test 1: When the unstake lock is added to an empty withdraw queue",
+			args: args{
+				unstakeLock: types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(110)},
+				queueErr:    fs.ErrNotExist,
+			},
+			wantSaved: []types.WithdrawQueueEntry{{StakerId: 2, UnlockAfter: 110, TxnHash: "0x01"}},
+			wantErr:   false,
+		},
+		{
+			name: "Test 2: When the entry of the staker is replaced",
+			args: args{
+				unstakeLock: types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(110)},
+				queue:       []types.WithdrawQueueEntry{{StakerId: 2, UnlockAfter: 50}, {StakerId: 3, UnlockAfter: 60}},
+			},
+			wantSaved: []types.WithdrawQueueEntry{{StakerId: 3, UnlockAfter: 60}, {StakerId: 2, UnlockAfter: 110, TxnHash: "0x01"}},
+			wantErr:   false,
+		},
+		{
+			name: "Test 3: When there is no unstake lock",
+			args: args{
+				unstakeLock: types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting the unstake lock",
+			args: args{
+				lockErr: errors.New("lock error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in reading the withdraw queue",
+			args: args{
+				unstakeLock: types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(110)},
+				queueErr:    errors.New("read error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in saving the withdraw queue",
+			args: args{
+				unstakeLock: types.Locks{Amount: big.NewInt(1000), UnlockAfter: big.NewInt(110)},
+				queueErr:    fs.ErrNotExist,
+				saveErr:     errors.New("save error"),
+			},
+			wantSaved: []types.WithdrawQueueEntry{{StakerId: 2, UnlockAfter: 110, TxnHash: "0x01"}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var saved []types.WithdrawQueueEntry
+			utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, uint32(2), uint8(0)).Return(tt.args.unstakeLock, tt.args.lockErr)
+			pathUtilsMock.On("GetWithdrawQueueFileName", address).Return("", nil)
+			utilsPkgMock.On("ReadFromWithdrawQueueFile", mock.Anything).Return(tt.args.queue, tt.args.queueErr)
+			utilsPkgMock.On("SaveDataToWithdrawQueueFile", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).([]types.WithdrawQueueEntry)
+			}).Return(tt.args.saveErr)
+
+			ut := &UtilsStruct{}
+			err := ut.AddToWithdrawQueue(client, address, 2, "0x01")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddToWithdrawQueue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(saved, tt.wantSaved) {
+				t.Errorf("Saved withdraw queue = %v, want %v", saved, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestGetWithdrawLocks(t *testing.T) {
+	var client *ethclient.Client
+	address := "0x000000000000000000000000000000000000dead"
+	noLock := types.Locks{Amount: big.NewInt(0), UnlockAfter: big.NewInt(0)}
+
+	type args struct {
+		queue         []types.WithdrawQueueEntry
+		queueErr      error
+		epoch         uint32
+		epochErr      error
+		unstakeLocks  map[uint32]types.Locks
+		withdrawLocks map[uint32]types.Locks
+		lockErr       error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		want      []types.WithdrawLock
+		wantSaved []types.WithdrawQueueEntry
+		wantErr   bool
+	}{
+		{
+			name: "Test 1: When the locks are in every stage",
+			args: args{
+				queue: []types.WithdrawQueueEntry{{StakerId: 1}, {StakerId: 2}, {StakerId: 3}, {StakerId: 4}},
+				epoch: 100,
+				unstakeLocks: map[uint32]types.Locks{
+					1: {Amount: big.NewInt(10), UnlockAfter: big.NewInt(104)},
+					2: {Amount: big.NewInt(20), UnlockAfter: big.NewInt(97)},
+					3: {Amount: big.NewInt(30), UnlockAfter: big.NewInt(90)},
+					4: noLock,
+				},
+				withdrawLocks: map[uint32]types.Locks{
+					1: noLock,
+					2: {Amount: big.NewInt(5), UnlockAfter: big.NewInt(100)},
+					3: noLock,
+					4: {Amount: big.NewInt(40), UnlockAfter: big.NewInt(102)},
+				},
+			},
+			want: []types.WithdrawLock{
+				{StakerId: 1, Stage: core.WithdrawStageUnstakeLocked, Amount: big.NewInt(10), UnlockAfter: 104, WithdrawBefore: 109, Epoch: 100, EpochsRemaining: 4},
+				{StakerId: 2, Stage: core.WithdrawStageWithdrawable, Amount: big.NewInt(20), UnlockAfter: 97, WithdrawBefore: 102, Epoch: 100},
+				{StakerId: 2, Stage: core.WithdrawStageUnlockable, Amount: big.NewInt(5), UnlockAfter: 100, Epoch: 100},
+				{StakerId: 3, Stage: core.WithdrawStageExpired, Amount: big.NewInt(30), UnlockAfter: 90, WithdrawBefore: 95, Epoch: 100},
+				{StakerId: 4, Stage: core.WithdrawStageWithdrawLocked, Amount: big.NewInt(40), UnlockAfter: 102, Epoch: 100, EpochsRemaining: 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the staker with no lock left is removed from the withdraw queue",
+			args: args{
+				queue:         []types.WithdrawQueueEntry{{StakerId: 1}, {StakerId: 2}},
+				epoch:         100,
+				unstakeLocks:  map[uint32]types.Locks{1: noLock, 2: {Amount: big.NewInt(20), UnlockAfter: big.NewInt(104)}},
+				withdrawLocks: map[uint32]types.Locks{1: noLock, 2: noLock},
+			},
+			want: []types.WithdrawLock{
+				{StakerId: 2, Stage: core.WithdrawStageUnstakeLocked, Amount: big.NewInt(20), UnlockAfter: 104, WithdrawBefore: 109, Epoch: 100, EpochsRemaining: 4},
+			},
+			wantSaved: []types.WithdrawQueueEntry{{StakerId: 2}},
+			wantErr:   false,
+		},
+		{
+			name: "Test 3: When there is no withdraw queue",
+			args: args{
+				queueErr: fs.ErrNotExist,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in reading the withdraw queue",
+			args: args{
+				queueErr: errors.New("read error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting epoch",
+			args: args{
+				queue:    []types.WithdrawQueueEntry{{StakerId: 1}},
+				epochErr: errors.New("epoch error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is an error in getting the locks",
+			args: args{
+				queue:   []types.WithdrawQueueEntry{{StakerId: 1}},
+				epoch:   100,
+				lockErr: errors.New("lock error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			utilsPkgMock := new(mocks2.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
+
+			razorUtils = utilsMock
+			utils.UtilsInterface = utilsPkgMock
+			path.PathUtilsInterface = pathUtilsMock
+
+			var saved []types.WithdrawQueueEntry
+			pathUtilsMock.On("GetWithdrawQueueFileName", address).Return("", nil)
+			utilsPkgMock.On("ReadFromWithdrawQueueFile", mock.Anything).Return(tt.args.queue, tt.args.queueErr)
+			utilsPkgMock.On("SaveDataToWithdrawQueueFile", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				saved = args.Get(1).([]types.WithdrawQueueEntry)
+			}).Return(nil)
+			utilsMock.On("GetEpoch", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.epoch, tt.args.epochErr)
+			utilsMock.On("GetWithdrawInitiationPeriod", mock.AnythingOfType("*ethclient.Client")).Return(uint16(5), nil)
+			for _, entry := range tt.args.queue {
+				utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, entry.StakerId, uint8(0)).Return(tt.args.unstakeLocks[entry.StakerId], tt.args.lockErr)
+				utilsMock.On("GetLock", mock.AnythingOfType("*ethclient.Client"), address, entry.StakerId, uint8(1)).Return(tt.args.withdrawLocks[entry.StakerId], tt.args.lockErr)
+			}
+
+			ut := &UtilsStruct{}
+			got, err := ut.GetWithdrawLocks(client, address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetWithdrawLocks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetWithdrawLocks() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(saved, tt.wantSaved) {
+				t.Errorf("Saved withdraw queue = %v, want %v", saved, tt.wantSaved)
+			}
+		})
+	}
+}
+
+func TestProcessWithdrawQueue(t *testing.T) {
+	var client *ethclient.Client
+	config := types.Configurations{BufferPercent: 20}
+	account := types.Account{Address: "0x000000000000000000000000000000000000dead", Password: "test"}
+	txnHash := common.BigToHash(big.NewInt(1))
+
+	type args struct {
+		locks       []types.WithdrawLock
+		locksErr    error
+		state       int64
+		stateErr    error
+		unstakeErr  error
+		withdrawErr error
+	}
+	tests := []struct {
+		name                string
+		args                args
+		wantInitiations     int
+		wantUnlocks         int
+		wantJournalRecorded int
+		wantErr             bool
+	}{
+		{
+			name: "Test 1: When the withdrawal is initiated and unlocked",
+			args: args{
+				locks: []types.WithdrawLock{
+					{StakerId: 1, Stage: core.WithdrawStageWithdrawable, Amount: big.NewInt(10)},
+					{StakerId: 2, Stage: core.WithdrawStageUnlockable, Amount: big.NewInt(20)},
+					{StakerId: 3, Stage: core.WithdrawStageUnstakeLocked, Amount: big.NewInt(30)},
+					{StakerId: 4, Stage: core.WithdrawStageExpired, Amount: big.NewInt(40)},
+				},
+				state: 0,
+			},
+			wantInitiations:     1,
+			wantUnlocks:         1,
+			wantJournalRecorded: 2,
+			wantErr:             false,
+		},
+		{
+			name: "Test 2: When the withdrawal can't be initiated in the state",
+			args: args{
+				locks: []types.WithdrawLock{{StakerId: 1, Stage: core.WithdrawStageWithdrawable, Amount: big.NewInt(10)}},
+				state: 2,
+			},
+			wantInitiations: 0,
+			wantErr:         false,
+		},
+		{
+			name: "Test 3: When the withdrawal transactions fail",
+			args: args{
+				locks: []types.WithdrawLock{
+					{StakerId: 1, Stage: core.WithdrawStageWithdrawable, Amount: big.NewInt(10)},
+					{StakerId: 2, Stage: core.WithdrawStageUnlockable, Amount: big.NewInt(20)},
+				},
+				state:       4,
+				unstakeErr:  errors.New("initiateWithdraw error"),
+				withdrawErr: errors.New("unlockWithdraw error"),
+			},
+			wantInitiations:     1,
+			wantUnlocks:         1,
+			wantJournalRecorded: 0,
+			wantErr:             false,
+		},
+		{
+			name: "Test 4: When there is an error in getting the withdraw locks",
+			args: args{
+				locksErr: errors.New("locks error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the state",
+			args: args{
+				locks:    []types.WithdrawLock{{StakerId: 1, Stage: core.WithdrawStageWithdrawable, Amount: big.NewInt(10)}},
+				stateErr: errors.New("state error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.UtilsInterface)
+			cmdUtilsMock := new(mocks.UtilsCmdInterface)
+
+			razorUtils = utilsMock
+			cmdUtils = cmdUtilsMock
+
+			cmdUtilsMock.On("GetWithdrawLocks", mock.AnythingOfType("*ethclient.Client"), account.Address).Return(tt.args.locks, tt.args.locksErr)
+			utilsMock.On("GetDelayedState", mock.AnythingOfType("*ethclient.Client"), config.BufferPercent).Return(tt.args.state, tt.args.stateErr)
+			cmdUtilsMock.On("HandleUnstakeLock", mock.AnythingOfType("*ethclient.Client"), account, config, uint32(1)).Return(txnHash, tt.args.unstakeErr)
+			cmdUtilsMock.On("HandleWithdrawLock", mock.AnythingOfType("*ethclient.Client"), account, config, uint32(2)).Return(txnHash, tt.args.withdrawErr)
+			utilsMock.On("WaitForBlockCompletion", mock.AnythingOfType("*ethclient.Client"), txnHash.Hex()).Return(nil)
+			cmdUtilsMock.On("RecordJournalAction", account.Address, mock.AnythingOfType("uint32"), mock.AnythingOfType("types.JournalAction"))
+
+			ut := &UtilsStruct{}
+			err := ut.ProcessWithdrawQueue(client, config, account)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProcessWithdrawQueue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			cmdUtilsMock.AssertNumberOfCalls(t, "HandleUnstakeLock", tt.wantInitiations)
+			cmdUtilsMock.AssertNumberOfCalls(t, "HandleWithdrawLock", tt.wantUnlocks)
+			cmdUtilsMock.AssertNumberOfCalls(t, "RecordJournalAction", tt.wantJournalRecorded)
+		})
+	}
+}
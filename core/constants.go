@@ -2,6 +2,8 @@ package core
 
 import (
 	"math/big"
+	"runtime"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -13,3 +15,236 @@ var StateLength = uint64(EpochLength / NumberOfStates)
 var MaxRetries uint = 8
 var NilHash = common.Hash{0x00}
 var BlockCompletionTimeout = 30
+var DefaultBlockTime = time.Second
+var BlockTimeSampleSize int64 = 100
+
+//Seconds after which the heads are polled when no head arrives from the subscription and after which a failed subscription is made again
+var HeadSubscriptionTimeout = 30
+var HeadResubscribeInterval = 5
+
+//Seconds to wait for the external signer, which can ask the operator to approve the request
+var RemoteSignerTimeout = 60
+var TxnTimeoutStates = []string{"commit", "reveal", "propose", "dispute", "confirm"}
+var CollectionHistoryLength = int(30 * 24 * 60 * 60 / EpochLength)
+var JournalLength = int(30 * 24 * 60 * 60 / EpochLength)
+
+//Version of the schema of the state store, the state files of earlier versions are migrated to the store when they are read
+var StateStoreSchemaVersion = 1
+
+//Number of earlier versions of every state which are kept in the state store
+var StateHistoryLength = 10
+
+//Attempts to open the state store while it is held by another command of the staker
+var StateStoreOpenAttempts uint = 10
+
+//API and CoinGecko id used to price the rewards in a fiat quote
+var PriceApiUrl = "https://api.coingecko.com/api/v3/simple/price"
+var RazorPriceId = "razor-network"
+var JobFailureThreshold = 3
+var JobQuarantineDuration = 10 * time.Minute
+var MaxJobQuarantineDuration = 24 * time.Hour
+
+//Minimum interval between the requests sent to the same host of the jobs, and the number of consecutive 429/5xx responses after which the host is blacklisted for HostBlacklistDuration
+var HostRequestInterval = 100 * time.Millisecond
+var HostFailureThreshold = 3
+var HostBlacklistDuration = 5 * time.Minute
+var MaxHostBlacklistDuration = time.Hour
+
+//Duration after which the node is reported unhealthy at the health endpoint if no new block is handled by the vote loop
+var HealthStaleDuration = 10 * time.Minute
+var MaxConcurrentLogQueries = 4
+var MaxConcurrentBlockVerifications = 4
+var MaxConcurrentMedianCalculations = runtime.NumCPU()
+var EventIndexReorgBlocks uint64 = 5
+var SpeedUpGasPriceBumpPercent int64 = 20
+var MaxSpeedUps = 3
+var MaxMonitoredTransactions = 32
+
+//Percentage by which the gas multiplier of the commit is escalated for every step when the commit confirms the block of the previous epoch as a backup of its proposer, and the maximum number of steps
+var BackupConfirmGasEscalationPercent int64 = 20
+var MaxBackupConfirmEscalations = 3
+
+//Revert reason of claimBlockReward when the block of the epoch is confirmed already, and the reasons with which the claim can't succeed again in the epoch
+var BlockAlreadyConfirmedReason = "Block already confirmed"
+var NonRetriableClaimRevertReasons = []string{"Block Proposer mismatches", "incorrect state", "Structs.Staker does not exist"}
+
+//Delay after which a failed claim of the block reward is retried, it is doubled after every failed attempt, and the attempts to claim the block reward in an epoch
+var ClaimBlockRewardRetryDelay = 5 * time.Second
+var MaxClaimBlockRewardAttempts = 5
+
+//Age of the latest block of the provider after which it is considered to be lagging, and interval at which the provider is checked for syncing
+var MaxHeadAge = 2 * time.Minute
+var ChainSyncCheckInterval = time.Minute
+
+//Age after which the last value of a WebSocket feed isn't used, time to wait for the first value of a feed, interval after which a failed feed is reconnected,
+//time after which a feed without messages is reconnected and time after which a feed whose values aren't read is closed
+var WebSocketFeedMaxAge = time.Minute
+var WebSocketFeedFirstValueTimeout = 10 * time.Second
+var WebSocketFeedReconnectInterval = 5 * time.Second
+var WebSocketFeedReadTimeout = time.Minute
+var WebSocketFeedIdleTimeout = 10 * time.Minute
+
+//Percentage of the state length after the state opened from which the inclusion of a commit, reveal or propose transaction is alerted
+var InclusionLatencyAlertPercent uint64 = 80
+
+//Events whose notifications are sent to the alert webhooks, and the time after which the request to a webhook times out
+var AlertEvents = []string{"missedReveal", "disputedBlock", "slashed", "lowBalance", "insufficientBalance", "rpcDown", "claimBounty", "invalidBlock", "missingConfirmation"}
+var AlertWebhookTimeout = 10 * time.Second
+
+//Modes of the vote command, the observer mode follows the epochs and alerts on the anomalies of the network without signing any transaction
+var (
+	StakerMode   = "staker"
+	ObserverMode = "observer"
+	VoteModes    = []string{StakerMode, ObserverMode}
+)
+
+//Modes of sending the identifying request headers, omit removes them and randomize sends a random common browser User-Agent
+var (
+	OmitRequestHeaders      = "omit"
+	RandomizeRequestHeaders = "randomize"
+	RequestHeadersModes     = []string{OmitRequestHeaders, RandomizeRequestHeaders}
+)
+
+//Defaults of the time in seconds after which the requests to the APIs time out, of the attempts at a request and of the delay in seconds before it is retried
+//The schemes of the proxy which the requests to the APIs can be sent through
+var (
+	DefaultHTTPTimeout       int32 = 10
+	DefaultHTTPRetryAttempts int32 = 2
+	DefaultHTTPRetryDelay    int32 = 2
+	HTTPProxySchemes               = []string{"http", "https", "socks5"}
+)
+
+//Default of the maximum number of blocks whose logs are fetched in a single query, the providers which cap the block range of the queries below it are queried in smaller ranges
+var DefaultLogsChunkSize int32 = 100
+
+//Gas strategies of the transactions and the classes of the transactions which a strategy can be selected for, the default strategy is used for the classes which have none
+//The slow, standard and fast strategies pay the base fee and a percentile of the priority fees of the last FeeHistoryBlocks blocks
+var (
+	SuggestedGasStrategy = "suggested"
+	SlowGasStrategy      = "slow"
+	StandardGasStrategy  = "standard"
+	FastGasStrategy      = "fast"
+	OracleGasStrategy    = "oracle"
+	FixedGasStrategy     = "fixed"
+	GasStrategies        = []string{SuggestedGasStrategy, SlowGasStrategy, StandardGasStrategy, FastGasStrategy, OracleGasStrategy, FixedGasStrategy}
+
+	DefaultGasStrategyClass = "default"
+	GasStrategyClasses      = []string{"commit", "reveal", "propose", "dispute", "confirm", "claim", DefaultGasStrategyClass}
+
+	GasStrategyPercentiles = map[string]float64{SlowGasStrategy: 10, StandardGasStrategy: 50, FastGasStrategy: 90}
+	FeeHistoryBlocks       = 20
+	GasOracleTimeout       = 10 * time.Second
+)
+
+//Periods of the gas budget, the spend is counted from the start of the epoch or of the UTC day
+//The non critical transactions are skipped if their cost exceeds the remaining gas budget, the others are always sent so that the staker isn't penalised
+var (
+	EpochGasBudgetPeriod = "epoch"
+	DayGasBudgetPeriod   = "day"
+	GasBudgetPeriods     = []string{EpochGasBudgetPeriod, DayGasBudgetPeriod}
+
+	NonCriticalTransactions = []string{"claimBlockReward", "claimStakerReward", "redeemBounty", "unlockWithdraw", "resetDispute", "resetUnstakeLock"}
+)
+
+//Strategies of aggregating the values of the sources of a job, trimmedMean drops DefaultSourceTrimPercent of the values from each end if trimPercent isn't set
+var (
+	MedianSourceAggregation       = "median"
+	TrimmedMeanSourceAggregation  = "trimmedMean"
+	WeightedMeanSourceAggregation = "weightedMean"
+	DefaultSourceTrimPercent      = 20.0
+)
+
+//Conversions of the values of the jobs, timestampToUnix converts a timestamp to seconds since the unix epoch
+var (
+	PercentToBasisPointsConversion = "percentToBasisPoints"
+	BasisPointsToPercentConversion = "basisPointsToPercent"
+	WeiToEthConversion             = "weiToEth"
+	EthToWeiConversion             = "ethToWei"
+	CelsiusToFahrenheitConversion  = "celsiusToFahrenheit"
+	FahrenheitToCelsiusConversion  = "fahrenheitToCelsius"
+	TimestampToUnixConversion      = "timestampToUnix"
+	JobConversions                 = []string{PercentToBasisPointsConversion, BasisPointsToPercentConversion, WeiToEthConversion, EthToWeiConversion, CelsiusToFahrenheitConversion, FahrenheitToCelsiusConversion, TimestampToUnixConversion}
+)
+
+//Fault injection points in the epoch loop and the faults that can be injected at them
+var (
+	CommitFaultPoint  = "commit"
+	RevealFaultPoint  = "reveal"
+	ProposeFaultPoint = "propose"
+	DisputeFaultPoint = "dispute"
+
+	RPCTimeoutFault          = "rpcTimeout"
+	RevertedTransactionFault = "revertedTransaction"
+	CorruptStateFileFault    = "corruptStateFile"
+)
+
+//Probabilities of the faults of the chaos mode when no chaos config file is given, and the delay in seconds of a delayed response
+var (
+	DefaultChaosDroppedCallProbability        = 0.05
+	DefaultChaosDelayedResponseProbability    = 0.1
+	DefaultChaosResponseDelay                 = 5
+	DefaultChaosNonceErrorProbability         = 0.05
+	DefaultChaosTransactionTimeoutProbability = 0.05
+)
+
+//Statuses of the bounties in the summary of claimBounty
+var (
+	BountyClaimClaimed  = "claimed"
+	BountyClaimLocked   = "locked"
+	BountyClaimRedeemed = "redeemed"
+	BountyClaimFailed   = "failed"
+)
+
+//Statuses of the commission update scheduled with updateCommission --schedule
+var (
+	CommissionScheduled = "scheduled"
+	CommissionApplied   = "applied"
+	CommissionFailed    = "failed"
+)
+
+//Stages of the unstaked amounts tracked in the withdraw queue
+var (
+	WithdrawStageUnstakeLocked  = "unstakeLocked"
+	WithdrawStageWithdrawable   = "withdrawable"
+	WithdrawStageExpired        = "expired"
+	WithdrawStageWithdrawLocked = "withdrawLocked"
+	WithdrawStageUnlockable     = "unlockable"
+)
+
+//Gas used by the actions of an epoch in estimateEpoch when no mined transaction of the action is found in the journal
+var (
+	CommitGasEstimate           uint64 = 250000
+	RevealGasEstimate           uint64 = 1000000
+	ProposeGasEstimate          uint64 = 1000000
+	ClaimBlockRewardGasEstimate uint64 = 300000
+	DisputeGasEstimate          uint64 = 2000000
+	FinalizeDisputeGasEstimate  uint64 = 500000
+)
+
+//Gas of a giveSorted transaction and of every sorted value submitted in it, the sorted values of a median dispute are split in chunks which fit in the block gas limit
+var (
+	GiveSortedBaseGasEstimate  uint64 = 100000
+	GiveSortedValueGasEstimate uint64 = 20000
+)
+
+//Number of blocks which every transaction of a dispute is expected to be mined in, the transactions of a dispute are only sent if they can all be mined in the rest of the dispute state
+var DisputeTransactionBlocks = 2
+
+//Rogue modes of the vote command, the biasedMedians and delayedPropose modes take an argument as biasedMedians:<percent> and delayedPropose:<seconds>
+var (
+	RogueModes = []string{"commit", "reveal", "skipReveal", "medians", "biasedMedians", "missingIds", "droppedId", "extraIds", "duplicatedId", "unsortedIds", "reversedIds", "biggestStakerId", "delayedPropose"}
+
+	DefaultRogueMedianBiasPercent = 10
+	DefaultRogueProposeDelay      = 60
+)
+
+//Networks which the node can be run on, the contract addresses and the chain id of the mainnet are compiled in and those of the testnet and of a custom deployment are read from an addresses file
+var (
+	MainnetNetwork = "mainnet"
+	TestnetNetwork = "testnet"
+	CustomNetwork  = "custom"
+	Networks       = []string{MainnetNetwork, TestnetNetwork, CustomNetwork}
+)
+
+//Denominator of the slash fractions of the StakeManager, the BASE_DENOMINATOR of the contracts
+var BaseDenominator uint32 = 10000000
@@ -0,0 +1,9 @@
+package types
+
+type ActivityEntry struct {
+	Epoch       uint32 `json:"epoch"`
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+	TxnHash     string `json:"txnHash,omitempty"`
+	Source      string `json:"source"`
+	Description string `json:"description"`
+}
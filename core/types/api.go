@@ -0,0 +1,8 @@
+package types
+
+type APICacheData struct {
+	ETag         string
+	LastModified string
+	Body         []byte
+	FetchedAt    int64 `json:",omitempty"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 	"razor/pkg/bindings"
 )
@@ -29,6 +30,12 @@ type Asset struct {
 	Collection bindings.StructsCollection
 }
 
+type CollectionDisplayData struct {
+	Power    int8
+	MinValue *big.Float
+	MaxValue *big.Float
+}
+
 type Locks struct {
 	Amount      *big.Int
 	UnlockAfter *big.Int
@@ -50,8 +57,104 @@ type AssignedAsset struct {
 }
 
 type CustomJob struct {
-	URL      string `json:"URL"`
-	Selector string `json:"selector"`
-	Power    int8   `json:"power"`
-	Weight   uint8  `json:"weight"`
+	URL               string                `json:"URL"`
+	Selector          string                `json:"selector"`
+	Power             int8                  `json:"power"`
+	Weight            uint8                 `json:"weight"`
+	ParseOptions      *JobParseOptions      `json:"parseOptions,omitempty"`
+	Sources           []JobSource           `json:"sources,omitempty"`
+	SourceAggregation *JobSourceAggregation `json:"sourceAggregation,omitempty"`
+	Headers           map[string]string     `json:"headers,omitempty"`
+	Conversion        *JobConversion        `json:"conversion,omitempty"`
+	PostProcess       string                `json:"postProcess,omitempty"`
+	GraphQL           *GraphQLQuery         `json:"graphql,omitempty"`
+	WebSocket         *WebSocketFeed        `json:"websocket,omitempty"`
+}
+
+//JobOptions are the options of an official or custom job set in assets.json, which change how the value of the job is fetched and computed
+type JobOptions struct {
+	ParseOptions  *JobParseOptions
+	Sources       *JobSources
+	Headers       map[string]string
+	Conversion    *JobConversion
+	PostProcess   string
+	GraphQLQuery  *GraphQLQuery
+	WebSocketFeed *WebSocketFeed
+}
+
+//JobSource is an additional URL of a job, its value is aggregated with the values of the other sources of the job
+type JobSource struct {
+	URL      string            `json:"URL"`
+	Selector string            `json:"selector"`
+	Weight   uint8             `json:"weight"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
+//JobSourceAggregation is how the values of the sources of a job are aggregated, the sources further than maxDeviationPercent from their median are rejected as outliers
+type JobSourceAggregation struct {
+	Strategy            string  `json:"strategy"`
+	TrimPercent         float64 `json:"trimPercent"`
+	MaxDeviationPercent float64 `json:"maxDeviationPercent"`
+	MinSources          int     `json:"minSources"`
+}
+
+//JobSources are the additional sources of a job and the aggregation of the values of all its sources
+type JobSources struct {
+	Sources     []JobSource
+	Aggregation JobSourceAggregation
+}
+
+//JobParseOptions are the options of the tolerant decoding of the responses of a job which doesn't return plain JSON numbers
+type JobParseOptions struct {
+	StripPrefix      string `json:"stripPrefix"`
+	Jsonp            bool   `json:"jsonp"`
+	NumericStrings   bool   `json:"numericStrings"`
+	DecimalSeparator string `json:"decimalSeparator"`
+}
+
+//JobConversion converts the values of a job to another unit, the layout and timezone are only used to parse the timestamps of the timestampToUnix conversion
+type JobConversion struct {
+	Type     string `json:"type"`
+	Layout   string `json:"layout,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+//GraphQLQuery is the query which is posted to the URL of a job, the value of the job is selected from the JSON response of the query
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+//WebSocketFeed is the subscription of a job whose URL is a WebSocket feed, the subscribe message is sent after connecting and the last value selected from the messages is used for up to maxAge seconds
+type WebSocketFeed struct {
+	Subscribe json.RawMessage `json:"subscribe,omitempty"`
+	MaxAge    uint32          `json:"maxAge,omitempty"`
+}
+
+type OverrideCollection struct {
+	Power        int8                 `json:"power"`
+	OfficialJobs map[string]CustomJob `json:"official jobs"`
+	CustomJobs   []CustomJob          `json:"custom jobs"`
+}
+
+type OverrideAssets struct {
+	Collection map[string]OverrideCollection `json:"collection"`
+}
+
+type OverrideFile struct {
+	Assets OverrideAssets `json:"assets"`
+}
+
+//DataOverride pins the value of a collection to a static value or fetches it from a custom URL and selector instead of its jobs
+//The value is in the units of the collection and is multiplied with the power, which is the power of the collection if it is not set
+type DataOverride struct {
+	Value        json.Number `json:"value,omitempty"`
+	URL          string      `json:"URL,omitempty"`
+	Selector     string      `json:"selector,omitempty"`
+	SelectorType uint8       `json:"selectorType,omitempty"`
+	Power        *int8       `json:"power,omitempty"`
+}
+
+type DataOverrideFile struct {
+	Collections map[string]DataOverride `json:"collections"`
 }
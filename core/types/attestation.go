@@ -0,0 +1,39 @@
+package types
+
+import "math/big"
+
+type AttestedSource struct {
+	JobId     uint16   `json:"jobId"`
+	JobName   string   `json:"jobName"`
+	Url       string   `json:"url"`
+	Selector  string   `json:"selector"`
+	Response  string   `json:"response"`
+	Value     *big.Int `json:"value"`
+	FetchedAt int64    `json:"fetchedAt"`
+	Body      []byte   `json:"-"`
+}
+
+type AttestedCollection struct {
+	CollectionId uint16           `json:"collectionId"`
+	Value        *big.Int         `json:"value"`
+	Sources      []AttestedSource `json:"sources"`
+}
+
+type AttestationPayload struct {
+	Epoch         uint32               `json:"epoch"`
+	ChainId       string               `json:"chainId"`
+	Staker        string               `json:"staker"`
+	StakerId      uint32               `json:"stakerId"`
+	CommitTxnHash string               `json:"commitTxnHash"`
+	MerkleRoot    string               `json:"merkleRoot"`
+	Leaves        []*big.Int           `json:"leaves"`
+	Collections   []AttestedCollection `json:"collections"`
+	CreatedAt     int64                `json:"createdAt"`
+}
+
+type Attestation struct {
+	Payload     AttestationPayload `json:"payload"`
+	PayloadHash string             `json:"payloadHash"`
+	Signer      string             `json:"signer"`
+	Signature   string             `json:"signature"`
+}
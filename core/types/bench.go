@@ -0,0 +1,26 @@
+package types
+
+type BenchSizes struct {
+	Collections uint16 `json:"collections"`
+	Stakers     uint32 `json:"stakers"`
+	Blocks      uint32 `json:"blocks"`
+}
+
+type BenchResult struct {
+	Name        string `json:"name"`
+	Iterations  int    `json:"iterations"`
+	NsPerOp     int64  `json:"nsPerOp"`
+	AllocsPerOp int64  `json:"allocsPerOp"`
+	BytesPerOp  int64  `json:"bytesPerOp"`
+}
+
+type BenchReport struct {
+	GoVersion   string        `json:"goVersion"`
+	OS          string        `json:"os"`
+	Arch        string        `json:"arch"`
+	NumCPU      int           `json:"numCPU"`
+	GoMaxProcs  int           `json:"goMaxProcs"`
+	Parallelism int32         `json:"parallelism"`
+	Sizes       BenchSizes    `json:"sizes"`
+	Results     []BenchResult `json:"results"`
+}
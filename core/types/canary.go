@@ -0,0 +1,15 @@
+package types
+
+type CanaryTransaction struct {
+	Timestamp       int64  `json:"timestamp"`
+	Method          string `json:"method"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Nonce           uint64 `json:"nonce"`
+	GasLimit        uint64 `json:"gasLimit"`
+	GasPrice        string `json:"gasPrice"`
+	Value           string `json:"value"`
+	Data            string `json:"data"`
+	TxnHash         string `json:"txnHash"`
+	EstimationError string `json:"estimationError,omitempty"`
+}
@@ -1,11 +1,31 @@
 package types
 
 type Configurations struct {
-	Provider           string
-	GasMultiplier      float32
-	BufferPercent      int32
-	WaitTime           int32
-	GasPrice           int32
-	LogLevel           string
-	GasLimitMultiplier float32
+	Provider                   string
+	GasMultiplier              float32
+	BufferPercent              int32
+	WaitTime                   int32
+	GasPrice                   int32
+	LogLevel                   string
+	GasLimitMultiplier         float32
+	TxnTimeouts                map[string]int
+	GasStrategies              map[string]string
+	MaxGasPrice                int32
+	GasBudget                  float32
+	GasBudgetPeriod            string
+	RequestHeaders             string
+	AllowedHosts               []string
+	SignerUrl                  string
+	KMSKey                     string
+	ReadProvider               string
+	LogsChunkSize              int32
+	APICacheTTL                int32
+	HTTPTimeout                int32
+	HTTPRetryAttempts          int32
+	HTTPRetryDelay             int32
+	HTTPProxy                  string
+	SpeedUpBlocks              uint32
+	AlertBalanceThreshold      float32
+	AlertRazorBalanceThreshold float32
+	AlertRpcDownMinutes        uint32
 }
@@ -0,0 +1,63 @@
+package types
+
+import "math/big"
+
+//DisputeSimulationInput is a proposed block along with the data of the epoch which the node checks it against
+//The collection ids of activeCollections are in the order of their leaf ids
+type DisputeSimulationInput struct {
+	Epoch             uint32            `json:"epoch"`
+	BlockIndex        uint8             `json:"blockIndex"`
+	Block             SimulatedBlock    `json:"block"`
+	BiggestStake      *big.Int          `json:"biggestStake"`
+	BiggestStakerId   uint32            `json:"biggestStakerId"`
+	ActiveCollections []uint16          `json:"activeCollections"`
+	Reveals           []SimulatedReveal `json:"reveals"`
+}
+
+type SimulatedBlock struct {
+	Ids          []uint16   `json:"ids"`
+	Medians      []*big.Int `json:"medians"`
+	BiggestStake *big.Int   `json:"biggestStake"`
+	Valid        bool       `json:"valid"`
+}
+
+type SimulatedReveal struct {
+	StakerId  uint32          `json:"stakerId"`
+	Influence *big.Int        `json:"influence"`
+	Values    []AssignedAsset `json:"values"`
+}
+
+//DisputeSimulation is the dispute the node would raise on a proposed block and the transactions it would send for it
+type DisputeSimulation struct {
+	Dispute      string               `json:"dispute"`
+	Transactions []DisputeTransaction `json:"transactions"`
+}
+
+type DisputeTransaction struct {
+	MethodName string        `json:"methodName"`
+	Parameters []interface{} `json:"parameters"`
+}
+
+//EpochBlockCheck is the data of an epoch which the node checks the proposed blocks against, along with the dispute it would raise on every proposed block
+type EpochBlockCheck struct {
+	Epoch                 uint32       `json:"epoch"`
+	BiggestStake          *big.Int     `json:"biggestStake"`
+	BiggestStakerId       uint32       `json:"biggestStakerId"`
+	RevealedCollectionIds []uint16     `json:"revealedCollectionIds"`
+	Medians               []*big.Int   `json:"medians"`
+	Blocks                []BlockCheck `json:"blocks"`
+}
+
+//BlockCheck is a proposed block of the epoch and the dispute the node would raise on it
+type BlockCheck struct {
+	BlockId      uint32               `json:"blockId"`
+	BlockIndex   uint8                `json:"blockIndex"`
+	ProposerId   uint32               `json:"proposerId"`
+	Valid        bool                 `json:"valid"`
+	BiggestStake *big.Int             `json:"biggestStake"`
+	Ids          []uint16             `json:"ids"`
+	Medians      []*big.Int           `json:"medians"`
+	Dispute      string               `json:"dispute"`
+	Transactions []DisputeTransaction `json:"transactions"`
+	Error        string               `json:"error,omitempty"`
+}
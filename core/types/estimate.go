@@ -0,0 +1,19 @@
+package types
+
+import "math/big"
+
+type ActionGasEstimate struct {
+	Action   string   `json:"action"`
+	Gas      uint64   `json:"gas"`
+	Source   string   `json:"source"`
+	Possible bool     `json:"possible"`
+	Cost     *big.Int `json:"cost"`
+}
+
+type EpochGasEstimate struct {
+	Actions      []ActionGasEstimate `json:"actions"`
+	GasPrice     *big.Int            `json:"gasPrice"`
+	ExpectedCost *big.Int            `json:"expectedCost"`
+	MaximumCost  *big.Int            `json:"maximumCost"`
+	Balance      *big.Int            `json:"balance"`
+}
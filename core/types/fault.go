@@ -0,0 +1,19 @@
+package types
+
+type Fault struct {
+	Point string `json:"point"`
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+type FaultInjectionConfig struct {
+	Faults []Fault `json:"faults"`
+}
+
+type ChaosConfig struct {
+	DroppedCallProbability        float64 `json:"droppedCallProbability"`
+	DelayedResponseProbability    float64 `json:"delayedResponseProbability"`
+	ResponseDelay                 int     `json:"responseDelay"`
+	NonceErrorProbability         float64 `json:"nonceErrorProbability"`
+	TransactionTimeoutProbability float64 `json:"transactionTimeoutProbability"`
+}
@@ -0,0 +1,8 @@
+package types
+
+type FleetReport struct {
+	Version       string
+	ConfigHash    string
+	OverridesHash string
+	Components    map[string]string
+}
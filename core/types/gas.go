@@ -0,0 +1,8 @@
+package types
+
+import "math/big"
+
+type FeeHistory struct {
+	BaseFees []*big.Int
+	Rewards  [][]*big.Int
+}
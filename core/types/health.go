@@ -0,0 +1,29 @@
+package types
+
+import "math/big"
+
+type NodeStatus struct {
+	Epoch               uint32            `json:"epoch"`
+	State               int64             `json:"state"`
+	StateName           string            `json:"stateName"`
+	StakerId            uint32            `json:"stakerId"`
+	LastActionEpochs    map[string]uint32 `json:"lastActionEpochs"`
+	PendingTransactions int               `json:"pendingTransactions"`
+	RpcConnected        bool              `json:"rpcConnected"`
+	ChainSyncPaused     bool              `json:"chainSyncPaused"`
+	BlockNumber         uint64            `json:"blockNumber"`
+	EthBalance          *big.Int          `json:"ethBalance"`
+	QuarantinedJobs     []QuarantinedJob  `json:"quarantinedJobs"`
+	LastUpdated         int64             `json:"lastUpdated"`
+}
+
+type QuarantinedJob struct {
+	Id               uint16 `json:"id"`
+	Name             string `json:"name"`
+	QuarantinedUntil int64  `json:"quarantinedUntil"`
+}
+
+type HealthCheck struct {
+	Healthy bool   `json:"healthy"`
+	Reason  string `json:"reason,omitempty"`
+}
@@ -0,0 +1,14 @@
+package types
+
+import "math/big"
+
+type CollectionHistoryData struct {
+	Epoch   uint32
+	Values  []*big.Int
+	Weights []uint8
+}
+
+type CollectionHistoryFileData struct {
+	CollectionId uint16
+	History      []CollectionHistoryData
+}
@@ -0,0 +1,17 @@
+package types
+
+import "math/big"
+
+type JournalAction struct {
+	Action  string            `json:"action"`
+	Hashes  map[string]string `json:"hashes,omitempty"`
+	TxnHash string            `json:"txnHash,omitempty"`
+	Amount  string            `json:"amount,omitempty"`
+	Status  string            `json:"status"`
+	Values  []*big.Int        `json:"-"`
+}
+
+type JournalEntry struct {
+	Epoch   uint32          `json:"epoch"`
+	Actions []JournalAction `json:"actions"`
+}
@@ -0,0 +1,13 @@
+package types
+
+type LedgerRecord struct {
+	Epoch     uint32   `json:"epoch"`
+	Action    string   `json:"action"`
+	Values    []string `json:"values,omitempty"`
+	TxnHash   string   `json:"txnHash,omitempty"`
+	Status    string   `json:"status"`
+	Amount    string   `json:"amount,omitempty"`
+	GasUsed   uint64   `json:"gasUsed,omitempty"`
+	GasCost   string   `json:"gasCost,omitempty"`
+	Timestamp int64    `json:"timestamp"`
+}
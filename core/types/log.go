@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/sirupsen/logrus"
+	"math/big"
+)
+
+type LogEntry struct {
+	Time        string   `json:"time"`
+	Level       string   `json:"level"`
+	Msg         string   `json:"msg"`
+	Address     string   `json:"address"`
+	Epoch       uint32   `json:"epoch"`
+	State       int64    `json:"state"`
+	BlockNumber *big.Int `json:"blockNumber"`
+}
+
+type LogFilter struct {
+	Epoch uint32
+	State int64
+	Level logrus.Level
+}
@@ -0,0 +1,11 @@
+package types
+
+import "math/big"
+
+type DelegationMigrationData struct {
+	FromStakerId   uint32
+	ToStakerId     uint32
+	Amount         *big.Int
+	WithdrawAmount *big.Int
+	Step           string
+}
@@ -0,0 +1,26 @@
+package types
+
+type ContractAddresses struct {
+	ChainId           int64  `json:"ChainId"`
+	StakeManager      string `json:"StakeManager"`
+	RAZOR             string `json:"RAZOR"`
+	CollectionManager string `json:"CollectionManager"`
+	VoteManager       string `json:"VoteManager"`
+	BlockManager      string `json:"BlockManager"`
+}
+
+type Deployment struct {
+	Name           string   `json:"name"`
+	Network        string   `json:"network"`
+	AddressesFile  string   `json:"addressesFile"`
+	Config         string   `json:"config"`
+	DataDir        string   `json:"datadir"`
+	Accounts       []string `json:"accounts"`
+	PasswordSource string   `json:"passwordSource"`
+	MetricsPort    string   `json:"metricsPort"`
+	Args           []string `json:"args"`
+}
+
+type DeploymentsFile struct {
+	Deployments []Deployment `json:"deployments"`
+}
@@ -0,0 +1,11 @@
+package types
+
+type Notification struct {
+	Event   string
+	Address string
+	Epoch   uint32
+	Status  string
+	TxnHash string
+	Hashes  map[string]string
+	Amount  string
+}
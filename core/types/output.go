@@ -0,0 +1,35 @@
+package types
+
+import "math/big"
+
+type TransactionOutput struct {
+	TxnHash     string `json:"txnHash"`
+	Status      string `json:"status"`
+	GasUsed     uint64 `json:"gasUsed,omitempty"`
+	BlockNumber uint64 `json:"blockNumber,omitempty"`
+}
+
+type StakerInfoOutput struct {
+	StakerId  uint32   `json:"stakerId"`
+	Address   string   `json:"address"`
+	Stake     *big.Int `json:"stake"`
+	Age       uint32   `json:"age"`
+	Maturity  uint16   `json:"maturity"`
+	Influence *big.Int `json:"influence"`
+}
+
+type CollectionOutput struct {
+	Active            bool     `json:"active"`
+	CollectionId      uint16   `json:"collectionId"`
+	Power             int8     `json:"power"`
+	AggregationMethod uint32   `json:"aggregationMethod"`
+	JobIds            []uint16 `json:"jobIds"`
+	Name              string   `json:"name"`
+	Tolerance         uint32   `json:"tolerance"`
+}
+
+type EpochInfo struct {
+	Epoch     uint32 `json:"epoch"`
+	State     int64  `json:"state"`
+	StateName string `json:"stateName"`
+}
@@ -0,0 +1,37 @@
+package types
+
+type TransactionReceiptRecord struct {
+	TxnHash           string       `json:"txnHash"`
+	From              string       `json:"from"`
+	To                string       `json:"to,omitempty"`
+	Method            string       `json:"method"`
+	Epoch             uint32       `json:"epoch"`
+	BlockNumber       uint64       `json:"blockNumber"`
+	Status            uint64       `json:"status"`
+	GasUsed           uint64       `json:"gasUsed"`
+	EffectiveGasPrice string       `json:"effectiveGasPrice"`
+	GasCost           string       `json:"gasCost"`
+	Logs              []ReceiptLog `json:"logs,omitempty"`
+	Timestamp         int64        `json:"timestamp"`
+}
+
+type ReceiptLog struct {
+	Address string   `json:"address"`
+	Topics  []string `json:"topics"`
+	Data    string   `json:"data"`
+}
+
+type GasReportEntry struct {
+	Group        string `json:"group"`
+	Transactions int    `json:"transactions"`
+	Failed       int    `json:"failed"`
+	GasUsed      uint64 `json:"gasUsed"`
+	GasCost      string `json:"gasCost"`
+}
+
+type GasReport struct {
+	Address string           `json:"address"`
+	GroupBy string           `json:"groupBy"`
+	Entries []GasReportEntry `json:"entries"`
+	Total   GasReportEntry   `json:"total"`
+}
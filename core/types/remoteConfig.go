@@ -0,0 +1,7 @@
+package types
+
+type RemoteConfig struct {
+	Url      string
+	Signer   string
+	Interval uint32
+}
@@ -0,0 +1,26 @@
+package types
+
+import "math/big"
+
+type ArchivedResponse struct {
+	JobId     uint16   `json:"jobId"`
+	JobName   string   `json:"jobName"`
+	Url       string   `json:"url"`
+	Selector  string   `json:"selector"`
+	BodyHash  string   `json:"bodyHash"`
+	BodySize  int      `json:"bodySize"`
+	Value     *big.Int `json:"value"`
+	FetchedAt int64    `json:"fetchedAt"`
+	Body      string   `json:"body,omitempty"`
+}
+
+type ArchivedCollection struct {
+	CollectionId uint16             `json:"collectionId"`
+	Value        *big.Int           `json:"value"`
+	Responses    []ArchivedResponse `json:"responses"`
+}
+
+type ResponseArchiveEntry struct {
+	Epoch       uint32               `json:"epoch"`
+	Collections []ArchivedCollection `json:"collections"`
+}
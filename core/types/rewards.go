@@ -0,0 +1,28 @@
+package types
+
+import "math/big"
+
+type StakeChangeSyncRange struct {
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+}
+
+type RewardsPeriod struct {
+	Period    string     `json:"period"`
+	Rewards   *big.Float `json:"rewards"`
+	Penalties *big.Float `json:"penalties"`
+	Bounties  *big.Float `json:"bounties"`
+	GasCost   *big.Float `json:"gasCost"`
+	NetRazor  *big.Float `json:"netRazor"`
+	NetQuote  *big.Float `json:"netQuote,omitempty"`
+}
+
+type RewardsReport struct {
+	Address       string          `json:"address"`
+	Period        string          `json:"period"`
+	Quote         string          `json:"quote,omitempty"`
+	RazorPrice    float64         `json:"razorPrice,omitempty"`
+	GasTokenPrice float64         `json:"gasTokenPrice,omitempty"`
+	Periods       []RewardsPeriod `json:"periods"`
+	Total         RewardsPeriod   `json:"total"`
+}
@@ -0,0 +1,13 @@
+package types
+
+import "encoding/json"
+
+type RPCDebugEntry struct {
+	Timestamp  int64           `json:"timestamp"`
+	Provider   string          `json:"provider"`
+	Latency    int64           `json:"latencyMs"`
+	StatusCode int             `json:"statusCode,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
@@ -19,8 +19,92 @@ type Staker struct {
 	StakerReward                    *big.Int
 }
 
+type SlashNums struct {
+	Bounty uint32
+	Burn   uint32
+	Keep   uint32
+}
+
+type RiskReport struct {
+	StakerId           uint32   `json:"stakerId"`
+	Address            string   `json:"address"`
+	IsSlashed          bool     `json:"isSlashed"`
+	Stake              *big.Int `json:"stake"`
+	MinSafeRazor       *big.Int `json:"minSafeRazor"`
+	MinStake           *big.Int `json:"minStake"`
+	MinVotingStake     *big.Int `json:"minVotingStake"`
+	StakeHeadroom      *big.Int `json:"stakeHeadroom"`
+	Age                uint32   `json:"age"`
+	Maturity           uint16   `json:"maturity"`
+	SlashBountyPercent float64  `json:"slashBountyPercent"`
+	SlashBurnPercent   float64  `json:"slashBurnPercent"`
+	SlashKeepPercent   float64  `json:"slashKeepPercent"`
+	StakeAtRisk        *big.Int `json:"stakeAtRisk"`
+	Warnings           []string `json:"warnings"`
+}
+
 type BountyLock struct {
 	RedeemAfter  uint32
 	BountyHunter common.Address
 	Amount       *big.Int
 }
+
+type BountyClaim struct {
+	BountyId    uint32
+	Amount      *big.Int
+	RedeemAfter uint32
+	Status      string
+	TxnHash     string
+}
+
+type DelegatorPosition struct {
+	StakerId         uint32     `json:"stakerId"`
+	StakerAddress    string     `json:"stakerAddress"`
+	AcceptDelegation bool       `json:"acceptDelegation"`
+	IsSlashed        bool       `json:"isSlashed"`
+	Commission       uint8      `json:"commission"`
+	StakerStake      *big.Int   `json:"stakerStake"`
+	SRZRBalance      *big.Int   `json:"sRZRBalance"`
+	ShareValue       *big.Float `json:"shareValue"`
+	RZRValue         *big.Int   `json:"rzrValue"`
+	UnstakeLock      Locks      `json:"unstakeLock"`
+	WithdrawLock     Locks      `json:"withdrawLock"`
+	BlockRewards     *big.Int   `json:"blockRewards"`
+	EstimatedAPR     float64    `json:"estimatedAPR"`
+}
+
+type CommissionSchedule struct {
+	StakerId       uint32 `json:"stakerId"`
+	Commission     uint8  `json:"commission"`
+	ApplyFromEpoch uint32 `json:"applyFromEpoch"`
+	Status         string `json:"status"`
+	TxnHash        string `json:"txnHash,omitempty"`
+}
+
+type CommissionWindow struct {
+	StakerId                      uint32 `json:"stakerId"`
+	Commission                    uint8  `json:"commission"`
+	MinCommission                 uint8  `json:"minCommission"`
+	MaxCommission                 uint8  `json:"maxCommission"`
+	EpochCommissionLastUpdated    uint32 `json:"epochCommissionLastUpdated"`
+	EpochLimitForUpdateCommission uint16 `json:"epochLimitForUpdateCommission"`
+	Epoch                         uint32 `json:"epoch"`
+	NextUpdateEpoch               uint32 `json:"nextUpdateEpoch"`
+	CanUpdate                     bool   `json:"canUpdate"`
+}
+
+type WithdrawQueueEntry struct {
+	StakerId    uint32 `json:"stakerId"`
+	UnlockAfter uint32 `json:"unlockAfter"`
+	TxnHash     string `json:"txnHash,omitempty"`
+}
+
+type WithdrawLock struct {
+	StakerId        uint32   `json:"stakerId"`
+	Stage           string   `json:"stage"`
+	Amount          *big.Int `json:"amount"`
+	UnlockAfter     uint32   `json:"unlockAfter"`
+	WithdrawBefore  uint32   `json:"withdrawBefore,omitempty"`
+	Epoch           uint32   `json:"epoch"`
+	EpochsRemaining uint32   `json:"epochsRemaining"`
+}
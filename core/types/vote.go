@@ -18,19 +18,23 @@ type Commitment struct {
 }
 
 type Rogue struct {
-	IsRogue   bool
-	RogueMode []string
+	IsRogue           bool
+	RogueMode         []string
+	MedianBiasPercent int
+	ProposeDelay      int
 }
 
 type CommitData struct {
 	AssignedCollections    map[int]bool
 	SeqAllottedCollections []*big.Int
 	Leaves                 []*big.Int
+	MerkleTree             [][][]byte
 }
 
 type RevealedStruct struct {
 	RevealedValues []AssignedAsset
 	Influence      *big.Int
+	StakerId       uint32
 }
 
 type RevealedDataMaps struct {
@@ -43,6 +47,14 @@ type DisputeFileData struct {
 	BountyIdQueue []uint32
 }
 
+//GiveSortedProgress is the number of sorted values of the leaf id which are already submitted by giveSorted in the epoch and the size of the chunks which fit in the gas limit
+type GiveSortedProgress struct {
+	Epoch           uint32
+	LeafId          uint16
+	SubmittedValues int
+	ChunkSize       int
+}
+
 type ProposeData struct {
 	MediansData           []*big.Int
 	RevealedCollectionIds []uint16
@@ -54,6 +66,7 @@ type CommitFileData struct {
 	AssignedCollections    map[int]bool
 	SeqAllottedCollections []*big.Int
 	Leaves                 []*big.Int
+	MerkleTree             [][][]byte
 }
 
 type ProposeFileData struct {
@@ -62,3 +75,22 @@ type ProposeFileData struct {
 	RevealedCollectionIds []uint16
 	RevealedDataMaps      *RevealedDataMaps
 }
+
+type RevealInconsistency struct {
+	StakerId uint32
+	Reason   string
+}
+
+type StakerInfluence struct {
+	StakerId  uint32
+	Value     *big.Int
+	Influence *big.Int
+}
+
+type CollectionInfluence struct {
+	LeafId       uint16
+	CollectionId uint16
+	InfluenceSum *big.Int
+	Median       *big.Int
+	Stakers      []StakerInfluence
+}
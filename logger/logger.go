@@ -26,6 +26,7 @@ var standardLogger = &StandardLogger{logrus.New()}
 var Address string
 var Epoch uint32
 var BlockNumber *big.Int
+var State int64 = -1
 var FileName string
 var Client *ethclient.Client
 
@@ -89,6 +90,7 @@ func (logger *StandardLogger) Error(args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Errorln(args...)
 }
@@ -99,6 +101,7 @@ func (logger *StandardLogger) Info(args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Infoln(args...)
 }
@@ -109,16 +112,29 @@ func (logger *StandardLogger) Debug(args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Debugln(args...)
 }
 
+func (logger *StandardLogger) Warn(args ...interface{}) {
+	SetEpochAndBlockNumber(Client)
+	var logFields = logrus.Fields{
+		"address":     Address,
+		"epoch":       Epoch,
+		"blockNumber": BlockNumber,
+		"state":       State,
+	}
+	logger.WithFields(logFields).Warnln(args...)
+}
+
 func (logger *StandardLogger) Fatal(args ...interface{}) {
 	SetEpochAndBlockNumber(Client)
 	var logFields = logrus.Fields{
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	errMsg := joinString(args)
 	err := errors.New(errMsg)
@@ -131,6 +147,7 @@ func (logger *StandardLogger) Errorf(format string, args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Errorf(format, args...)
 }
@@ -141,6 +158,7 @@ func (logger *StandardLogger) Infof(format string, args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Infof(format, args...)
 }
@@ -151,16 +169,29 @@ func (logger *StandardLogger) Debugf(format string, args ...interface{}) {
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	logger.WithFields(logFields).Debugf(format, args...)
 }
 
+func (logger *StandardLogger) Warnf(format string, args ...interface{}) {
+	SetEpochAndBlockNumber(Client)
+	var logFields = logrus.Fields{
+		"address":     Address,
+		"epoch":       Epoch,
+		"blockNumber": BlockNumber,
+		"state":       State,
+	}
+	logger.WithFields(logFields).Warnf(format, args...)
+}
+
 func (logger *StandardLogger) Fatalf(format string, args ...interface{}) {
 	SetEpochAndBlockNumber(Client)
 	var logFields = logrus.Fields{
 		"address":     Address,
 		"epoch":       Epoch,
 		"blockNumber": BlockNumber,
+		"state":       State,
 	}
 	errMsg := joinString(args)
 	err := errors.New(errMsg)
@@ -178,6 +209,7 @@ func SetEpochAndBlockNumber(client *ethclient.Client) {
 
 		epoch := latestHeader.Time / uint64(core.EpochLength)
 		Epoch = uint32(epoch)
+		State = int64(latestHeader.Time/core.StateLength) % core.NumberOfStates
 	}
 }
 
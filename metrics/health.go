@@ -0,0 +1,93 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"razor/core"
+	"razor/core/types"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	//HealthEndpoint serves the liveness of the vote loop and StatusEndpoint serves the status of the node
+	HealthEndpoint = "/healthz"
+	StatusEndpoint = "/status"
+
+	nodeStatus      = types.NodeStatus{LastActionEpochs: make(map[string]uint32)}
+	nodeStatusMutex sync.Mutex
+)
+
+//RunHealthServer runs the http server of the health and status endpoints of the vote loop
+func RunHealthServer(port string) error {
+	portNumber := ":" + port
+	logrus.Infof("Starting http server to serve health at port '%s', endpoints '%s' and '%s'", portNumber, HealthEndpoint, StatusEndpoint)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthEndpoint, serveHealth)
+	mux.HandleFunc(StatusEndpoint, serveStatus)
+	return http.ListenAndServe(portNumber, mux)
+}
+
+//UpdateNodeStatus updates the status of the node served at the status endpoint
+func UpdateNodeStatus(update func(status *types.NodeStatus)) {
+	nodeStatusMutex.Lock()
+	defer nodeStatusMutex.Unlock()
+	update(&nodeStatus)
+}
+
+//SetLastActionEpoch records the epoch in which the action was last taken successfully
+func SetLastActionEpoch(action string, epoch uint32) {
+	UpdateNodeStatus(func(status *types.NodeStatus) {
+		status.LastActionEpochs[action] = epoch
+	})
+}
+
+//getNodeStatus returns a copy of the status of the node
+func getNodeStatus() types.NodeStatus {
+	nodeStatusMutex.Lock()
+	defer nodeStatusMutex.Unlock()
+	status := nodeStatus
+	status.LastActionEpochs = make(map[string]uint32, len(nodeStatus.LastActionEpochs))
+	for action, epoch := range nodeStatus.LastActionEpochs {
+		status.LastActionEpochs[action] = epoch
+	}
+	return status
+}
+
+//checkHealth returns the node as unhealthy if the provider can't be reached or if the vote loop hasn't handled a block in HealthStaleDuration
+func checkHealth(status types.NodeStatus, now time.Time) types.HealthCheck {
+	if status.LastUpdated == 0 {
+		return types.HealthCheck{Healthy: false, Reason: "no block is handled yet"}
+	}
+	if !status.RpcConnected {
+		return types.HealthCheck{Healthy: false, Reason: "provider can't be reached"}
+	}
+	if lastUpdated := time.Unix(status.LastUpdated, 0); now.Sub(lastUpdated) > core.HealthStaleDuration {
+		return types.HealthCheck{Healthy: false, Reason: fmt.Sprintf("no block is handled since %s", lastUpdated.Format(time.RFC3339))}
+	}
+	return types.HealthCheck{Healthy: true}
+}
+
+//serveHealth serves the health of the node, service unavailable is returned if it is unhealthy
+func serveHealth(w http.ResponseWriter, r *http.Request) {
+	healthCheck := checkHealth(getNodeStatus(), time.Now())
+	w.Header().Set("Content-Type", "application/json")
+	if !healthCheck.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(healthCheck); err != nil {
+		logrus.Error("Error in serving health: ", err)
+	}
+}
+
+//serveStatus serves the status of the node
+func serveStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(getNodeStatus()); err != nil {
+		logrus.Error("Error in serving status: ", err)
+	}
+}
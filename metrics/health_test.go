@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"razor/core"
+	"razor/core/types"
+	"testing"
+	"time"
+)
+
+func TestCheckHealth(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		status types.NodeStatus
+		want   bool
+	}{
+		{
+			name:   "Test 1: When the vote loop handled a block recently",
+			status: types.NodeStatus{RpcConnected: true, LastUpdated: now.Add(-time.Minute).Unix()},
+			want:   true,
+		},
+		{
+			name:   "Test 2: When no block is handled yet",
+			status: types.NodeStatus{RpcConnected: true},
+			want:   false,
+		},
+		{
+			name:   "Test 3: When the provider can't be reached",
+			status: types.NodeStatus{RpcConnected: false, LastUpdated: now.Add(-time.Minute).Unix()},
+			want:   false,
+		},
+		{
+			name:   "Test 4: When the vote loop hasn't handled a block for long",
+			status: types.NodeStatus{RpcConnected: true, LastUpdated: now.Add(-core.HealthStaleDuration - time.Minute).Unix()},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkHealth(tt.status, now)
+			if got.Healthy != tt.want {
+				t.Errorf("checkHealth() = %+v, want healthy %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeHealth(t *testing.T) {
+	defer UpdateNodeStatus(func(status *types.NodeStatus) { *status = types.NodeStatus{LastActionEpochs: make(map[string]uint32)} })
+
+	recorder := httptest.NewRecorder()
+	serveHealth(recorder, httptest.NewRequest(http.MethodGet, HealthEndpoint, nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("serveHealth() status code = %d before a block is handled, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+
+	UpdateNodeStatus(func(status *types.NodeStatus) {
+		status.RpcConnected = true
+		status.LastUpdated = time.Now().Unix()
+	})
+	SetLastActionEpoch("commit", 5)
+	recorder = httptest.NewRecorder()
+	serveHealth(recorder, httptest.NewRequest(http.MethodGet, HealthEndpoint, nil))
+	if recorder.Code != http.StatusOK {
+		t.Errorf("serveHealth() status code = %d, want %d", recorder.Code, http.StatusOK)
+	}
+	if status := getNodeStatus(); status.LastActionEpochs["commit"] != 5 {
+		t.Errorf("last commit epoch = %d, want 5", status.LastActionEpochs["commit"])
+	}
+}
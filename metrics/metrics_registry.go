@@ -23,10 +23,146 @@ var (
 			"go_version":       runtime.Version(),
 		},
 	})
+
+	APICacheRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_cache_requests",
+		Help: "Number of API requests answered from the local cache (hit) or downloaded again (miss)",
+	}, []string{"result"})
+
+	ContractCacheRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "contract_cache_requests",
+		Help: "Number of contract calls answered from the cache of the epoch (hit) or read from the chain (miss)",
+	}, []string{"result"})
+
+	EventIndexRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "event_index_requests",
+		Help: "Number of log queries answered from the index of the events of the epoch (hit) or filtered from the chain (miss)",
+	}, []string{"result"})
+
+	JobQuarantinedMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "job_quarantined",
+		Help: "Whether a job is quarantined after repeated failures in fetching its data",
+	}, []string{"job_id", "job_name"})
+
+	HostBlacklistedMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "host_blacklisted",
+		Help: "Whether a host of the jobs is blacklisted after repeated 429/5xx responses",
+	}, []string{"host"})
+
+	UnresolvedTransactionsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "unresolved_transactions",
+		Help: "Number of transactions that were still pending after the maximum wait time of their state",
+	}, []string{"state"})
+
+	SpedUpTransactionsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "sped_up_transactions",
+		Help: "Number of transactions that were replaced with a higher gas price as they were not mined within speedUpBlocks blocks",
+	}, []string{"state"})
+
+	ProposeDataFallbacksMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "propose_data_fallbacks",
+		Help: "Number of disputes in which the block proposed by the staker was recomputed from the reveals on chain as the propose data file couldn't be used",
+	}, []string{"reason"})
+
+	VoteTransactionsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vote_transactions",
+		Help: "Number of commit, reveal, propose, dispute and claim transactions sent while voting by their status",
+	}, []string{"action", "status"})
+
+	VoteTransactionEpochMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vote_transaction_epoch",
+		Help: "Epoch in which the last transaction of an action was sent while voting",
+	}, []string{"action"})
+
+	TransactionGasUsedMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "transaction_gas_used",
+		Help:    "Gas used by the mined transactions",
+		Buckets: prometheus.ExponentialBuckets(25000, 2, 10),
+	})
+
+	InclusionLatencyMetric = promauto.NewSummaryVec(prometheus.SummaryOpts{
+		Name:       "inclusion_latency_seconds",
+		Help:       "Seconds from the opening of the state to the inclusion of the commit, reveal and propose transactions",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	}, []string{"action"})
+
+	InclusionLatencyAlertsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "inclusion_latency_alerts",
+		Help: "Number of commit, reveal and propose transactions included close to the end of their state",
+	}, []string{"action"})
+
+	RPCLatencyMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "rpc_latency_seconds",
+		Help:    "Latency of the requests sent to the RPC provider by their method",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	BalanceMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "balance",
+		Help: "Balance of the staker in ether",
+	}, []string{"token"})
+
+	StakeMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "stake",
+		Help: "Stake of the staker in RZR",
+	})
+
+	ChainSyncPausedMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "chain_sync_paused",
+		Help: "Whether the voting is paused as the provider is syncing or its latest block is too old",
+	})
+
+	FleetConfigMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fleet_config",
+		Help: "Hashes of the effective configuration and of the override files of the jobs reported in fleet mode",
+	}, []string{"config_hash", "overrides_hash"})
+
+	StateHandlerDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "state_handler_duration_seconds",
+		Help:    "Seconds taken by the handler of each state of the epoch on a block",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"state"})
+
+	StateHandlerErrorsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "state_handler_errors",
+		Help: "Number of errors returned by the handler of each state of the epoch",
+	}, []string{"state"})
+
+	ClaimBlockRewardAttemptsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "claim_block_reward_attempts",
+		Help: "Number of attempts to claim the block reward by result",
+	}, []string{"result"})
+
+	ObserverAnomaliesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "observer_anomalies",
+		Help: "Number of anomalies of the network found in observer mode, by the dispute a proposed block should get or a missing confirmation",
+	}, []string{"anomaly"})
 )
 
 func init() {
 	//create a registry
 	RazorRegistry = prometheus.NewRegistry()
 	RazorRegistry.MustRegister(ClientMetric)
+	RazorRegistry.MustRegister(APICacheRequestsMetric)
+	RazorRegistry.MustRegister(ContractCacheRequestsMetric)
+	RazorRegistry.MustRegister(EventIndexRequestsMetric)
+	RazorRegistry.MustRegister(JobQuarantinedMetric)
+	RazorRegistry.MustRegister(HostBlacklistedMetric)
+	RazorRegistry.MustRegister(UnresolvedTransactionsMetric)
+	RazorRegistry.MustRegister(SpedUpTransactionsMetric)
+	RazorRegistry.MustRegister(ProposeDataFallbacksMetric)
+	RazorRegistry.MustRegister(VoteTransactionsMetric)
+	RazorRegistry.MustRegister(VoteTransactionEpochMetric)
+	RazorRegistry.MustRegister(TransactionGasUsedMetric)
+	RazorRegistry.MustRegister(InclusionLatencyMetric)
+	RazorRegistry.MustRegister(InclusionLatencyAlertsMetric)
+	RazorRegistry.MustRegister(RPCLatencyMetric)
+	RazorRegistry.MustRegister(BalanceMetric)
+	RazorRegistry.MustRegister(StakeMetric)
+	RazorRegistry.MustRegister(ChainSyncPausedMetric)
+	RazorRegistry.MustRegister(FleetConfigMetric)
+	RazorRegistry.MustRegister(StateHandlerDurationMetric)
+	RazorRegistry.MustRegister(StateHandlerErrorsMetric)
+	RazorRegistry.MustRegister(ClaimBlockRewardAttemptsMetric)
+	RazorRegistry.MustRegister(ObserverAnomaliesMetric)
 }
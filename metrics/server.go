@@ -1,22 +1,42 @@
 package metrics
 
 import (
+	"encoding/json"
 	"net/http"
+	"razor/core/types"
+	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/sirupsen/logrus"
 )
 
 var (
 	endpoint = "/metrics"
+	//FleetEndpoint serves the hashes of the configuration and of the override files reported in fleet mode
+	FleetEndpoint = "/fleet"
+
+	fleetReport      *types.FleetReport
+	fleetReportMutex sync.Mutex
+
+	deployment string
 )
 
+//deploymentLabel is the label of the deployment which is added to all the metrics when several deployments are voted for on one host
+const deploymentLabel = "deployment"
+
 //Run runs metrics http server
 func Run(port string, certFile string, certKey string) error {
 	portNumber := ":" + port
 	logrus.Infof("Starting http server to serve metrics at port '%s', endpoint '%s'", portNumber, endpoint)
 
-	http.Handle(endpoint, promhttp.Handler())
+	handler := promhttp.Handler()
+	if deployment != "" {
+		handler = promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer, promhttp.HandlerFor(deploymentGatherer{gatherer: prometheus.DefaultGatherer, deployment: deployment}, promhttp.HandlerOpts{}))
+	}
+	http.Handle(endpoint, handler)
+	http.HandleFunc(FleetEndpoint, serveFleetReport)
 
 	if certFile != "" && certKey != "" {
 		// start an https server using the mux server
@@ -26,3 +46,48 @@ func Run(port string, certFile string, certKey string) error {
 		return http.ListenAndServe(portNumber, nil)
 	}
 }
+
+//SetFleetReport sets the fleet report served at the fleet endpoint
+func SetFleetReport(report types.FleetReport) {
+	fleetReportMutex.Lock()
+	defer fleetReportMutex.Unlock()
+	fleetReport = &report
+}
+
+//serveFleetReport serves the fleet report, not found is returned if no report is set as the node isn't in fleet mode
+func serveFleetReport(w http.ResponseWriter, r *http.Request) {
+	fleetReportMutex.Lock()
+	report := fleetReport
+	fleetReportMutex.Unlock()
+	if report == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logrus.Error("Error in serving fleet report: ", err)
+	}
+}
+
+//SetDeployment sets the deployment whose label is added to all the metrics served, so that the metrics of the deployments can be told apart
+func SetDeployment(name string) {
+	deployment = name
+}
+
+//deploymentGatherer adds the label of the deployment to the metrics of the gatherer
+type deploymentGatherer struct {
+	gatherer   prometheus.Gatherer
+	deployment string
+}
+
+//Gather returns the metrics of the gatherer with the label of the deployment added to each of them
+func (g deploymentGatherer) Gather() ([]*dto.MetricFamily, error) {
+	families, err := g.gatherer.Gather()
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			name, value := deploymentLabel, g.deployment
+			metric.Label = append(metric.Label, &dto.LabelPair{Name: &name, Value: &value})
+		}
+	}
+	return families, err
+}
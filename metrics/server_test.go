@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestDeploymentGatherer(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "test_requests",
+		Help: "Number of test requests",
+	}, []string{"result"})
+	registry.MustRegister(counter)
+	counter.WithLabelValues("hit").Inc()
+	counter.WithLabelValues("miss").Inc()
+
+	families, err := deploymentGatherer{gatherer: registry, deployment: "testnet"}.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+	if len(families) != 1 || len(families[0].Metric) != 2 {
+		t.Fatalf("Gather() returned %d families, want 1 with 2 metrics", len(families))
+	}
+	for _, metric := range families[0].Metric {
+		labels := make(map[string]string)
+		for _, label := range metric.Label {
+			labels[label.GetName()] = label.GetValue()
+		}
+		if labels[deploymentLabel] != "testnet" || labels["result"] == "" {
+			t.Errorf("Gather() labels = %v, want the result and the deployment testnet", labels)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package path
+
+import (
+	"fmt"
+	"io"
+	"os"
+	pathPkg "path"
+	"strings"
+)
+
+//Lock file of the data directory, it is kept open as the lock is held till the process exits
+var dataDirLockFile *os.File
+
+//This function locks the data directory so that it can't be used by another process while the lock is held
+//The address is recorded in the lock file and the data directory can't be locked for another address later, so that the state files of different stakers don't collide
+//Nothing is locked if no data directory is set, as the default path is shared by all the stakers on the host
+func (PathUtils) LockDataDir(address string) error {
+	if dataDir == "" {
+		return nil
+	}
+	dir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return err
+	}
+	file, err := OSUtilsInterface.OpenFile(pathPkg.Join(dir, "razor.lock"), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	if err := lockFile(file); err != nil {
+		file.Close()
+		return fmt.Errorf("data directory %s is in use by another process: %w", dir, err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	owner := strings.TrimSpace(string(data))
+	if owner != "" && !strings.EqualFold(owner, address) {
+		file.Close()
+		return fmt.Errorf("data directory %s belongs to %s and can't be used for %s", dir, owner, address)
+	}
+	if owner == "" {
+		if _, err := file.WriteAt([]byte(address+"\n"), 0); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	dataDirLockFile = file
+	return nil
+}
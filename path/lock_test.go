@@ -0,0 +1,54 @@
+package path
+
+import (
+	"os"
+	pathPkg "path"
+	"strings"
+	"testing"
+)
+
+func TestLockDataDir(t *testing.T) {
+	OSUtilsInterface = OSUtils{}
+	PathUtilsInterface = PathUtils{}
+	defer SetDataDir("")
+
+	releaseLock := func() {
+		if dataDirLockFile != nil {
+			dataDirLockFile.Close()
+			dataDirLockFile = nil
+		}
+	}
+	defer releaseLock()
+
+	pa := PathUtils{}
+
+	SetDataDir("")
+	if err := pa.LockDataDir("0x000000000000000000000000000000000000dea1"); err != nil || dataDirLockFile != nil {
+		t.Errorf("LockDataDir() without data directory, error = %v, want nil and no lock", err)
+	}
+
+	dir := t.TempDir()
+	SetDataDir(dir)
+	if err := pa.LockDataDir("0x000000000000000000000000000000000000dea1"); err != nil {
+		t.Fatalf("LockDataDir() error = %v, want nil", err)
+	}
+	data, _ := os.ReadFile(pathPkg.Join(dir, "razor.lock"))
+	if strings.TrimSpace(string(data)) != "0x000000000000000000000000000000000000dea1" {
+		t.Errorf("LockDataDir() recorded owner = %s", data)
+	}
+
+	lockFile := dataDirLockFile
+	if err := pa.LockDataDir("0x000000000000000000000000000000000000dea1"); err == nil {
+		t.Error("LockDataDir() when data directory is locked by another lock, error = nil, want error")
+	}
+	dataDirLockFile = lockFile
+	releaseLock()
+
+	if err := pa.LockDataDir("0x000000000000000000000000000000000000dea2"); err == nil {
+		t.Error("LockDataDir() when data directory belongs to another address, error = nil, want error")
+	}
+
+	if err := pa.LockDataDir("0x000000000000000000000000000000000000DEA1"); err != nil {
+		t.Errorf("LockDataDir() for the owner address after the lock is released, error = %v, want nil", err)
+	}
+}
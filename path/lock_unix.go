@@ -0,0 +1,14 @@
+//go:build !windows
+// +build !windows
+
+package path
+
+import (
+	"os"
+	"syscall"
+)
+
+//This function takes an exclusive lock on the file without waiting for it
+func lockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+}
@@ -0,0 +1,11 @@
+//go:build windows
+// +build windows
+
+package path
+
+import "os"
+
+//File locks are not taken on windows, only the address of the data directory is checked
+func lockFile(file *os.File) error {
+	return nil
+}
@@ -42,6 +42,20 @@ func (_m *OSInterface) Mkdir(name string, perm fs.FileMode) error {
 	return r0
 }
 
+// MkdirAll provides a mock function with given fields: path, perm
+func (_m *OSInterface) MkdirAll(path string, perm fs.FileMode) error {
+	ret := _m.Called(path, perm)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, fs.FileMode) error); ok {
+		r0 = rf(path, perm)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Open provides a mock function with given fields: name
 func (_m *OSInterface) Open(name string) (*os.File, error) {
 	ret := _m.Called(name)
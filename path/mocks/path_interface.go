@@ -9,6 +9,132 @@ type PathInterface struct {
 	mock.Mock
 }
 
+// GetAPICacheDBPath provides a mock function with given fields:
+func (_m *PathInterface) GetAPICacheDBPath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountAliasesFilePath provides a mock function with given fields:
+func (_m *PathInterface) GetAccountAliasesFilePath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttestationFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetAttestationFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCanaryFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetCanaryFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionHistoryFileName provides a mock function with given fields: collectionId
+func (_m *PathInterface) GetCollectionHistoryFileName(collectionId uint16) (string, error) {
+	ret := _m.Called(collectionId)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(uint16) string); ok {
+		r0 = rf(collectionId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint16) error); ok {
+		r1 = rf(collectionId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCommissionScheduleFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetCommissionScheduleFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCommitDataFileName provides a mock function with given fields: address
 func (_m *PathInterface) GetCommitDataFileName(address string) (string, error) {
 	ret := _m.Called(address)
@@ -51,6 +177,48 @@ func (_m *PathInterface) GetConfigFilePath() (string, error) {
 	return r0, r1
 }
 
+// GetDataDir provides a mock function with given fields:
+func (_m *PathInterface) GetDataDir() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataOverrideFilePath provides a mock function with given fields:
+func (_m *PathInterface) GetDataOverrideFilePath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDefaultPath provides a mock function with given fields:
 func (_m *PathInterface) GetDefaultPath() (string, error) {
 	ret := _m.Called()
@@ -72,6 +240,27 @@ func (_m *PathInterface) GetDefaultPath() (string, error) {
 	return r0, r1
 }
 
+// GetDelegationMigrationFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetDelegationMigrationFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDisputeDataFileName provides a mock function with given fields: address
 func (_m *PathInterface) GetDisputeDataFileName(address string) (string, error) {
 	ret := _m.Called(address)
@@ -93,6 +282,27 @@ func (_m *PathInterface) GetDisputeDataFileName(address string) (string, error)
 	return r0, r1
 }
 
+// GetGiveSortedProgressFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetGiveSortedProgressFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetJobFilePath provides a mock function with given fields:
 func (_m *PathInterface) GetJobFilePath() (string, error) {
 	ret := _m.Called()
@@ -114,6 +324,27 @@ func (_m *PathInterface) GetJobFilePath() (string, error) {
 	return r0, r1
 }
 
+// GetJournalFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetJournalFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLogFilePath provides a mock function with given fields: fileName
 func (_m *PathInterface) GetLogFilePath(fileName string) (string, error) {
 	ret := _m.Called(fileName)
@@ -156,6 +387,125 @@ func (_m *PathInterface) GetProposeDataFileName(address string) (string, error)
 	return r0, r1
 }
 
+// GetRPCDebugFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetRPCDebugFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSecretsFilePath provides a mock function with given fields:
+func (_m *PathInterface) GetSecretsFilePath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateDBPath provides a mock function with given fields:
+func (_m *PathInterface) GetStateDBPath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStateEncryptionSaltFilePath provides a mock function with given fields:
+func (_m *PathInterface) GetStateEncryptionSaltFilePath() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWithdrawQueueFileName provides a mock function with given fields: address
+func (_m *PathInterface) GetWithdrawQueueFileName(address string) (string, error) {
+	ret := _m.Called(address)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LockDataDir provides a mock function with given fields: address
+func (_m *PathInterface) LockDataDir(address string) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewPathInterface interface {
 	mock.TestingT
 	Cleanup(func())
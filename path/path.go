@@ -4,6 +4,7 @@ package path
 import (
 	"os"
 	pathPkg "path"
+	"strconv"
 )
 
 //This function returns the default path
@@ -22,9 +23,28 @@ func (PathUtils) GetDefaultPath() (string, error) {
 	return defaultPath, nil
 }
 
+//This function sets the data directory in which the state files, logs and the local database are stored
+func SetDataDir(dir string) {
+	dataDir = dir
+}
+
+//This function returns the data directory, which is the default path if no data directory is set
+func (PathUtils) GetDataDir() (string, error) {
+	if dataDir == "" {
+		return PathUtilsInterface.GetDefaultPath()
+	}
+	if _, err := OSUtilsInterface.Stat(dataDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.MkdirAll(dataDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return dataDir, nil
+}
+
 //This function returns the log file path
 func (PathUtils) GetLogFilePath(fileName string) (string, error) {
-	razorPath, err := PathUtilsInterface.GetDefaultPath()
+	razorPath, err := PathUtilsInterface.GetDataDir()
 	if err != nil {
 		return "", err
 	}
@@ -45,8 +65,16 @@ func (PathUtils) GetLogFilePath(fileName string) (string, error) {
 	return logFilepath, nil
 }
 
+//This function sets the YAML or TOML config file which is read and written instead of razor.yaml in the default path
+func SetConfigFile(file string) {
+	configFile = file
+}
+
 //This function returns the config file path
 func (PathUtils) GetConfigFilePath() (string, error) {
+	if configFile != "" {
+		return configFile, nil
+	}
 	razorPath, err := PathUtilsInterface.GetDefaultPath()
 	if err != nil {
 		return "", err
@@ -64,9 +92,36 @@ func (PathUtils) GetJobFilePath() (string, error) {
 	return filePath, nil
 }
 
+//This function returns the path of the file in which the values of the collections are overridden
+func (PathUtils) GetDataOverrideFilePath() (string, error) {
+	razorPath, err := PathUtilsInterface.GetDefaultPath()
+	if err != nil {
+		return "", err
+	}
+	return pathPkg.Join(razorPath, "dataOverride.json"), nil
+}
+
+//This function returns the path of the file in which the aliases of the accounts are stored
+func (PathUtils) GetAccountAliasesFilePath() (string, error) {
+	razorPath, err := PathUtilsInterface.GetDefaultPath()
+	if err != nil {
+		return "", err
+	}
+	return pathPkg.Join(razorPath, "accountAliases.json"), nil
+}
+
+//This function returns the path of the file of the secrets which are referenced in the headers of the jobs
+func (PathUtils) GetSecretsFilePath() (string, error) {
+	razorPath, err := PathUtilsInterface.GetDefaultPath()
+	if err != nil {
+		return "", err
+	}
+	return pathPkg.Join(razorPath, "secrets.json"), nil
+}
+
 //This function returns the file name of commit data file
 func (PathUtils) GetCommitDataFileName(address string) (string, error) {
-	razorDir, err := PathUtilsInterface.GetDefaultPath()
+	razorDir, err := PathUtilsInterface.GetDataDir()
 	if err != nil {
 		return "", err
 	}
@@ -83,7 +138,7 @@ func (PathUtils) GetCommitDataFileName(address string) (string, error) {
 
 //This function returns the file name of propose data file
 func (PathUtils) GetProposeDataFileName(address string) (string, error) {
-	razorDir, err := PathUtilsInterface.GetDefaultPath()
+	razorDir, err := PathUtilsInterface.GetDataDir()
 	if err != nil {
 		return "", err
 	}
@@ -99,7 +154,7 @@ func (PathUtils) GetProposeDataFileName(address string) (string, error) {
 
 //This function returns the file name of dispute data file
 func (PathUtils) GetDisputeDataFileName(address string) (string, error) {
-	razorDir, err := PathUtilsInterface.GetDefaultPath()
+	razorDir, err := PathUtilsInterface.GetDataDir()
 	if err != nil {
 		return "", err
 	}
@@ -112,3 +167,195 @@ func (PathUtils) GetDisputeDataFileName(address string) (string, error) {
 	}
 	return pathPkg.Join(dataFileDir, address+"_disputeData.json"), nil
 }
+
+//This function returns the file name of the progress of the sorted values submitted by giveSorted in a dispute
+func (PathUtils) GetGiveSortedProgressFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_giveSortedProgress.json"), nil
+}
+
+//This function returns the file name of delegation migration data file
+func (PathUtils) GetDelegationMigrationFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_delegationMigration.json"), nil
+}
+
+//This function returns the file name of commission schedule data file
+func (PathUtils) GetCommissionScheduleFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_commissionSchedule.json"), nil
+}
+
+//This function returns the file name of withdraw queue data file
+func (PathUtils) GetWithdrawQueueFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_withdrawQueue.json"), nil
+}
+
+//This function returns the file name of journal file of the actions taken in every epoch
+func (PathUtils) GetJournalFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_journal.jsonl"), nil
+}
+
+//This function returns the file name of the signed attestations of the values committed in every epoch with the responses of their sources
+func (PathUtils) GetAttestationFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_attestations.jsonl"), nil
+}
+
+//This function returns the file name of the export file of the transactions which are not sent in canary mode
+func (PathUtils) GetCanaryFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_canary.jsonl"), nil
+}
+
+//This function returns the file name of the capture file of the requests sent to the RPC provider and their responses
+func (PathUtils) GetRPCDebugFileName(address string) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, address+"_rpc_debug.jsonl"), nil
+}
+
+//This function returns the file name of history data file of a collection
+func (PathUtils) GetCollectionHistoryFileName(collectionId uint16) (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, strconv.Itoa(int(collectionId))+"_collectionHistory.json"), nil
+}
+
+//This function returns the path of the database which stores the commit, propose and dispute data
+func (PathUtils) GetStateDBPath() (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, "state_db"), nil
+}
+
+//This function returns the path of the database which stores the cached API responses
+func (PathUtils) GetAPICacheDBPath() (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, "api_cache"), nil
+}
+
+//This function returns the path of the file which stores the salt used to derive the state encryption key
+func (PathUtils) GetStateEncryptionSaltFilePath() (string, error) {
+	razorDir, err := PathUtilsInterface.GetDataDir()
+	if err != nil {
+		return "", err
+	}
+	dataFileDir := pathPkg.Join(razorDir, "data_files")
+	if _, err := OSUtilsInterface.Stat(dataFileDir); OSUtilsInterface.IsNotExist(err) {
+		mkdirErr := OSUtilsInterface.Mkdir(dataFileDir, 0700)
+		if mkdirErr != nil {
+			return "", mkdirErr
+		}
+	}
+	return pathPkg.Join(dataFileDir, "state_encryption.salt"), nil
+}
@@ -12,14 +12,37 @@ import (
 var PathUtilsInterface PathInterface
 var OSUtilsInterface OSInterface
 
+//Data directory set by the datadir flag, the default path is used if it is empty
+var dataDir string
+
+//Config file set by the config flag, razor.yaml in the default path is used if it is empty
+var configFile string
+
 type PathInterface interface {
 	GetDefaultPath() (string, error)
+	GetDataDir() (string, error)
+	LockDataDir(address string) error
 	GetLogFilePath(fileName string) (string, error)
 	GetConfigFilePath() (string, error)
 	GetJobFilePath() (string, error)
+	GetDataOverrideFilePath() (string, error)
+	GetAccountAliasesFilePath() (string, error)
+	GetSecretsFilePath() (string, error)
 	GetCommitDataFileName(address string) (string, error)
 	GetProposeDataFileName(address string) (string, error)
 	GetDisputeDataFileName(address string) (string, error)
+	GetGiveSortedProgressFileName(address string) (string, error)
+	GetDelegationMigrationFileName(address string) (string, error)
+	GetCommissionScheduleFileName(address string) (string, error)
+	GetWithdrawQueueFileName(address string) (string, error)
+	GetJournalFileName(address string) (string, error)
+	GetCanaryFileName(address string) (string, error)
+	GetAttestationFileName(address string) (string, error)
+	GetRPCDebugFileName(address string) (string, error)
+	GetCollectionHistoryFileName(collectionId uint16) (string, error)
+	GetAPICacheDBPath() (string, error)
+	GetStateEncryptionSaltFilePath() (string, error)
+	GetStateDBPath() (string, error)
 }
 
 type OSInterface interface {
@@ -27,6 +50,7 @@ type OSInterface interface {
 	Stat(name string) (fs.FileInfo, error)
 	IsNotExist(err error) bool
 	Mkdir(name string, perm fs.FileMode) error
+	MkdirAll(path string, perm fs.FileMode) error
 	OpenFile(name string, flag int, perm fs.FileMode) (*os.File, error)
 	Open(name string) (*os.File, error)
 }
@@ -54,6 +78,11 @@ func (o OSUtils) Mkdir(name string, perm fs.FileMode) error {
 	return os.Mkdir(name, perm)
 }
 
+//This function is used to make a new directory along with any missing parents
+func (o OSUtils) MkdirAll(path string, perm fs.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
 //This function is used to open the file and this is generalized open call
 func (o OSUtils) OpenFile(name string, flag int, perm fs.FileMode) (*os.File, error) {
 	return os.OpenFile(name, flag, perm)
@@ -94,6 +94,101 @@ func TestGetDefaultPath(t *testing.T) {
 	}
 }
 
+func TestGetDataDir(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		dataDir    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When data directory is not set",
+			args: args{
+				path: "/home/.razor",
+			},
+			want:    "/home/.razor",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When data directory is not set and there is an error in getting path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data directory is set",
+			args: args{
+				dataDir: "/data/staker1",
+			},
+			want:    "/data/staker1",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data directory is not present and mkdirAll creates it with its parents",
+			args: args{
+				dataDir:    "/data/staker1",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/data/staker1",
+			wantErr: nil,
+		},
+		{
+			name: "Test 5: When data directory is not present and there is an error in creating it",
+			args: args{
+				dataDir:    "/data/staker1",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	defer SetDataDir("")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+			SetDataDir(tt.args.dataDir)
+
+			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("MkdirAll", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := PathUtils{}
+			got, err := pa.GetDataDir()
+			if got != tt.want {
+				t.Errorf("GetDataDir got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetDataDir, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetDataDir, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
 func TestGetLogFilePath(t *testing.T) {
 	var fileInfo fs.FileInfo
 	type args struct {
@@ -169,7 +264,7 @@ func TestGetLogFilePath(t *testing.T) {
 			OSUtilsInterface = osMock
 			PathUtilsInterface = pathMock
 
-			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
 			osMock.On("OpenFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.file, tt.args.fileErr)
 			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
 			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
@@ -195,8 +290,9 @@ func TestGetLogFilePath(t *testing.T) {
 
 func TestGetConfigFilePath(t *testing.T) {
 	type args struct {
-		path    string
-		pathErr error
+		configFile string
+		path       string
+		pathErr    error
 	}
 	tests := []struct {
 		name    string
@@ -220,11 +316,22 @@ func TestGetConfigFilePath(t *testing.T) {
 			want:    "",
 			wantErr: errors.New("path error"),
 		},
+		{
+			name: "Test 3: When the config file is set",
+			args: args{
+				configFile: "/etc/razor/razor.toml",
+				pathErr:    errors.New("path error"),
+			},
+			want:    "/etc/razor/razor.toml",
+			wantErr: nil,
+		},
 	}
+	defer SetConfigFile("")
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			pathMock := new(mocks.PathInterface)
 			PathUtilsInterface = pathMock
+			SetConfigFile(tt.args.configFile)
 
 			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
 			pa := PathUtils{}
@@ -299,6 +406,168 @@ func TestGetJobFilePath(t *testing.T) {
 	}
 }
 
+func TestGetDataOverrideFilePath(t *testing.T) {
+	type args struct {
+		path    string
+		pathErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetDataOverrideFilePath executes successfully",
+			args: args{
+				path: "/home/.razor",
+			},
+			want:    "/home/.razor/dataOverride.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting home path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+			PathUtilsInterface = pathMock
+			OSUtilsInterface = osMock
+
+			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pa := PathUtils{}
+			got, err := pa.GetDataOverrideFilePath()
+			if got != tt.want {
+				t.Errorf("GetDataOverrideFilePath(), got = %v, want = %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetDataOverrideFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetDataOverrideFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAccountAliasesFilePath(t *testing.T) {
+	type args struct {
+		path    string
+		pathErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetAccountAliasesFilePath executes successfully",
+			args: args{
+				path: "/home/.razor",
+			},
+			want:    "/home/.razor/accountAliases.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting home path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+			PathUtilsInterface = pathMock
+			OSUtilsInterface = osMock
+
+			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pa := PathUtils{}
+			got, err := pa.GetAccountAliasesFilePath()
+			if got != tt.want {
+				t.Errorf("GetAccountAliasesFilePath(), got = %v, want = %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetAccountAliasesFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetAccountAliasesFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetSecretsFilePath(t *testing.T) {
+	type args struct {
+		path    string
+		pathErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetSecretsFilePath executes successfully",
+			args: args{
+				path: "/home/.razor",
+			},
+			want:    "/home/.razor/secrets.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting home path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+			PathUtilsInterface = pathMock
+			OSUtilsInterface = osMock
+
+			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pa := PathUtils{}
+			got, err := pa.GetSecretsFilePath()
+			if got != tt.want {
+				t.Errorf("GetSecretsFilePath(), got = %v, want = %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetSecretsFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetSecretsFilePath function, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
 func TestGetCommitDataFileName(t *testing.T) {
 	var fileInfo fs.FileInfo
 	type args struct {
@@ -366,7 +635,7 @@ func TestGetCommitDataFileName(t *testing.T) {
 			OSUtilsInterface = osMock
 			PathUtilsInterface = pathMock
 
-			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
 			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
 			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
 			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
@@ -456,7 +725,7 @@ func TestGetProposeDataFileName(t *testing.T) {
 			OSUtilsInterface = osMock
 			PathUtilsInterface = pathMock
 
-			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
 			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
 			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
 			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
@@ -546,7 +815,7 @@ func TestGetDisputeDataFileName(t *testing.T) {
 			OSUtilsInterface = osMock
 			PathUtilsInterface = pathMock
 
-			pathMock.On("GetDefaultPath").Return(tt.args.path, tt.args.pathErr)
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
 			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
 			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
 			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
@@ -568,3 +837,1027 @@ func TestGetDisputeDataFileName(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGiveSortedProgressFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetGiveSortedProgressFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_giveSortedProgress.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_giveSortedProgress.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetGiveSortedProgressFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetGiveSortedProgressFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetGiveSortedProgressFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetGiveSortedProgressFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetDelegationMigrationFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetDelegationMigrationFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_delegationMigration.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_delegationMigration.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetDelegationMigrationFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetDelegationMigrationFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetDelegationMigrationFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetDelegationMigrationFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCommissionScheduleFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetCommissionScheduleFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_commissionSchedule.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_commissionSchedule.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetCommissionScheduleFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetCommissionScheduleFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetCommissionScheduleFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetCommissionScheduleFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetWithdrawQueueFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetWithdrawQueueFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_withdrawQueue.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_withdrawQueue.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetWithdrawQueueFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetWithdrawQueueFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetWithdrawQueueFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetWithdrawQueueFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetJournalFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetJournalFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_journal.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_journal.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetJournalFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetJournalFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetJournalFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetJournalFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCanaryFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetCanaryFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_canary.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_canary.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetCanaryFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetCanaryFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetCanaryFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetCanaryFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAttestationFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetAttestationFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_attestations.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_attestations.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetAttestationFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetAttestationFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetAttestationFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetAttestationFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRPCDebugFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		address    string
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetRPCDebugFileName executes successfully",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				path:    "/home",
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_rpc_debug.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				address: "0x000000000000000000000000000000000000dead",
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and mkdir creates it",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+			},
+			want:    "/home/data_files/0x000000000000000000000000000000000000dead_rpc_debug.jsonl",
+			wantErr: nil,
+		},
+		{
+			name: "Test 4: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				address:    "0x000000000000000000000000000000000000dead",
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetRPCDebugFileName(tt.args.address)
+			if got != tt.want {
+				t.Errorf("GetRPCDebugFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetRPCDebugFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetRPCDebugFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetCollectionHistoryFileName(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		collectionId uint16
+		path         string
+		pathErr      error
+		statErr      error
+		isNotExist   bool
+		mkdirErr     error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetCollectionHistoryFileName executes successfully",
+			args: args{
+				collectionId: 3,
+				path:         "/home",
+			},
+			want:    "/home/data_files/3_collectionHistory.json",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				collectionId: 3,
+				pathErr:      errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				collectionId: 3,
+				path:         "/home",
+				statErr:      errors.New("not exists"),
+				isNotExist:   true,
+				mkdirErr:     errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetCollectionHistoryFileName(tt.args.collectionId)
+			if got != tt.want {
+				t.Errorf("GetCollectionHistoryFileName got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetCollectionHistoryFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetCollectionHistoryFileName, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetAPICacheDBPath(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetAPICacheDBPath executes successfully",
+			args: args{
+				path: "/home",
+			},
+			want:    "/home/data_files/api_cache",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetAPICacheDBPath()
+			if got != tt.want {
+				t.Errorf("GetAPICacheDBPath got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetAPICacheDBPath, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetAPICacheDBPath, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetStateEncryptionSaltFilePath(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetStateEncryptionSaltFilePath executes successfully",
+			args: args{
+				path: "/home",
+			},
+			want:    "/home/data_files/state_encryption.salt",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetStateEncryptionSaltFilePath()
+			if got != tt.want {
+				t.Errorf("GetStateEncryptionSaltFilePath got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetStateEncryptionSaltFilePath, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetStateEncryptionSaltFilePath, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestGetStateDBPath(t *testing.T) {
+	var fileInfo fs.FileInfo
+	type args struct {
+		path       string
+		pathErr    error
+		statErr    error
+		isNotExist bool
+		mkdirErr   error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "Test 1: When GetStateDBPath executes successfully",
+			args: args{
+				path: "/home",
+			},
+			want:    "/home/data_files/state_db",
+			wantErr: nil,
+		},
+		{
+			name: "Test 2: When there is an error in getting path",
+			args: args{
+				pathErr: errors.New("path error"),
+			},
+			want:    "",
+			wantErr: errors.New("path error"),
+		},
+		{
+			name: "Test 3: When data_files directory is not present and there is an error in creating new one",
+			args: args{
+				path:       "/home",
+				statErr:    errors.New("not exists"),
+				isNotExist: true,
+				mkdirErr:   errors.New("mkdir error"),
+			},
+			want:    "",
+			wantErr: errors.New("mkdir error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			pathMock := new(mocks.PathInterface)
+			osMock := new(mocks.OSInterface)
+
+			OSUtilsInterface = osMock
+			PathUtilsInterface = pathMock
+
+			pathMock.On("GetDataDir").Return(tt.args.path, tt.args.pathErr)
+			osMock.On("Stat", mock.AnythingOfType("string")).Return(fileInfo, tt.args.statErr)
+			osMock.On("IsNotExist", mock.Anything).Return(tt.args.isNotExist)
+			osMock.On("Mkdir", mock.Anything, mock.Anything).Return(tt.args.mkdirErr)
+
+			pa := &PathUtils{}
+			got, err := pa.GetStateDBPath()
+			if got != tt.want {
+				t.Errorf("GetStateDBPath got = %v, want %v", got, tt.want)
+			}
+			if err == nil || tt.wantErr == nil {
+				if err != tt.wantErr {
+					t.Errorf("Error for GetStateDBPath, got = %v, want = %v", err, tt.wantErr)
+				}
+			} else {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("Error for GetStateDBPath, got = %v, want = %v", err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
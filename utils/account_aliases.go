@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"errors"
+	"os"
+)
+
+//This function returns the aliases of the accounts stored in the file mapped to their addresses, there are no aliases if the file doesn't exist
+func (*UtilsStruct) ReadAccountAliases(fileName string) (map[string]string, error) {
+	var aliases = map[string]string{}
+	file, err := OS.ReadFile(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return aliases, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(file) == 0 {
+		return aliases, nil
+	}
+	err = JsonInterface.Unmarshal(file, &aliases)
+	if err != nil {
+		return nil, err
+	}
+	return aliases, nil
+}
+
+//This function stores the aliases of the accounts mapped to their addresses in the file
+func (*UtilsStruct) SaveAccountAliases(fileName string, aliases map[string]string) error {
+	jsonString, err := JsonInterface.Marshal(aliases)
+	if err != nil {
+		return err
+	}
+	return OS.WriteFile(fileName, jsonString, 0600)
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAccountAliases(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, JsonInterface: JsonStruct{}})
+	fileName := filepath.Join(t.TempDir(), "accountAliases.json")
+	ut := &UtilsStruct{}
+
+	aliases, err := ut.ReadAccountAliases(fileName)
+	if err != nil || len(aliases) != 0 {
+		t.Errorf("ReadAccountAliases() = %v, %v before any alias is set, want no aliases", aliases, err)
+	}
+	want := map[string]string{
+		"staker1": "0x000000000000000000000000000000000000dEaD",
+		"staker2": "0x000000000000000000000000000000000000bEEF",
+	}
+	if err := ut.SaveAccountAliases(fileName, want); err != nil {
+		t.Fatalf("SaveAccountAliases() error = %v", err)
+	}
+	aliases, err = ut.ReadAccountAliases(fileName)
+	if err != nil {
+		t.Fatalf("ReadAccountAliases() error = %v", err)
+	}
+	if !reflect.DeepEqual(aliases, want) {
+		t.Errorf("ReadAccountAliases() got = %v, want %v", aliases, want)
+	}
+}
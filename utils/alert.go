@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"razor/core"
+	"razor/core/types"
+	"strings"
+	"sync"
+)
+
+var (
+	alertWebhooks     []string
+	alertWebhookMutex sync.RWMutex
+)
+
+//This function sets the webhooks which the notifications of the critical events are sent to
+//The Slack, Discord and Telegram webhooks are recognised by their hosts, the notification is posted as JSON to the other webhooks
+func SetAlertWebhooks(webhooks []string) error {
+	for _, webhook := range webhooks {
+		webhookUrl, err := url.Parse(webhook)
+		if err != nil {
+			return fmt.Errorf("invalid alert webhook %s: %w", webhook, err)
+		}
+		if (webhookUrl.Scheme != "https" && webhookUrl.Scheme != "http") || webhookUrl.Host == "" {
+			return fmt.Errorf("invalid alert webhook %s, it should be an http(s) url", webhook)
+		}
+	}
+	alertWebhookMutex.Lock()
+	defer alertWebhookMutex.Unlock()
+	alertWebhooks = webhooks
+	return nil
+}
+
+//This function returns if any alert webhook is set
+func IsAlertingEnabled() bool {
+	alertWebhookMutex.RLock()
+	defer alertWebhookMutex.RUnlock()
+	return len(alertWebhooks) > 0
+}
+
+//This function sends the message of the notification to all the alert webhooks if its event is critical
+//The errors are only logged as the alerts shouldn't stop the voting
+func sendAlerts(notification types.Notification, message string) {
+	if !Contains(core.AlertEvents, notification.Event) {
+		return
+	}
+	alertWebhookMutex.RLock()
+	webhooks := alertWebhooks
+	alertWebhookMutex.RUnlock()
+
+	client := &http.Client{Timeout: core.AlertWebhookTimeout}
+	for _, webhook := range webhooks {
+		if err := sendAlert(client, webhook, notification, message); err != nil {
+			log.Errorf("Error in sending %s alert: %s", notification.Event, err)
+		}
+	}
+}
+
+func sendAlert(client *http.Client, webhook string, notification types.Notification, message string) error {
+	payload, err := getAlertPayload(webhook, notification, message)
+	if err != nil {
+		return err
+	}
+	response, err := client.Post(webhook, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		// The url of the webhook has its secret, so only the host is logged
+		webhookUrl, _ := url.Parse(webhook)
+		return fmt.Errorf("unable to reach alert webhook of %s", webhookUrl.Host)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		webhookUrl, _ := url.Parse(webhook)
+		return fmt.Errorf("alert webhook of %s responded with status code %d", webhookUrl.Host, response.StatusCode)
+	}
+	return nil
+}
+
+//This function returns the body which the webhook expects for the message
+func getAlertPayload(webhook string, notification types.Notification, message string) ([]byte, error) {
+	webhookUrl, err := url.Parse(webhook)
+	if err != nil {
+		return nil, err
+	}
+	host := strings.ToLower(webhookUrl.Host)
+	switch {
+	case host == "hooks.slack.com":
+		return json.Marshal(map[string]string{"text": message})
+	case host == "discord.com" || host == "discordapp.com":
+		return json.Marshal(map[string]string{"content": message})
+	case host == "api.telegram.org":
+		// The chat is passed in the chat_id query parameter of the sendMessage url of the bot
+		return json.Marshal(map[string]string{"chat_id": webhookUrl.Query().Get("chat_id"), "text": message})
+	default:
+		return json.Marshal(map[string]interface{}{"message": message, "notification": notification})
+	}
+}
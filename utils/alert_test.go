@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"razor/core/types"
+	"testing"
+)
+
+func TestGetAlertPayload(t *testing.T) {
+	notification := types.Notification{Event: "slashed", Epoch: 10, Status: "staker 1 is slashed"}
+
+	tests := []struct {
+		name    string
+		webhook string
+		want    map[string]interface{}
+	}{
+		{
+			name:    "Test 1: When the webhook is of Slack",
+			webhook: "https://hooks.slack.com/services/T000/B000/XXXX",
+			want:    map[string]interface{}{"text": "message"},
+		},
+		{
+			name:    "Test 2: When the webhook is of Discord",
+			webhook: "https://discord.com/api/webhooks/1/XXXX",
+			want:    map[string]interface{}{"content": "message"},
+		},
+		{
+			name:    "Test 3: When the webhook is of Telegram",
+			webhook: "https://api.telegram.org/bot123:XXXX/sendMessage?chat_id=-100123",
+			want:    map[string]interface{}{"chat_id": "-100123", "text": "message"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := getAlertPayload(tt.webhook, notification, "message")
+			if err != nil {
+				t.Fatalf("getAlertPayload() error = %v", err)
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(payload, &got); err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("getAlertPayload() got = %v, want %v", got, tt.want)
+			}
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("getAlertPayload() got = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSendAlerts(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+	}))
+	defer server.Close()
+
+	if err := SetAlertWebhooks([]string{server.URL}); err != nil {
+		t.Fatalf("SetAlertWebhooks() error = %v", err)
+	}
+	defer SetAlertWebhooks(nil)
+
+	sendAlerts(types.Notification{Event: "commit", Epoch: 10}, "Committed in epoch 10")
+	if len(bodies) != 0 {
+		t.Fatalf("the notification of a non critical event is sent: %v", bodies)
+	}
+	sendAlerts(types.Notification{Event: "missedReveal", Epoch: 10}, "Missed the reveal of epoch 10")
+	if len(bodies) != 1 {
+		t.Fatalf("sendAlerts() sent %d alerts, want 1", len(bodies))
+	}
+	var payload struct {
+		Message      string             `json:"message"`
+		Notification types.Notification `json:"notification"`
+	}
+	if err := json.Unmarshal([]byte(bodies[0]), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Message != "Missed the reveal of epoch 10" || payload.Notification.Event != "missedReveal" {
+		t.Errorf("sendAlerts() sent %s", bodies[0])
+	}
+}
+
+func TestSetAlertWebhooks(t *testing.T) {
+	defer SetAlertWebhooks(nil)
+
+	if err := SetAlertWebhooks([]string{"ftp://hooks.slack.com/services"}); err == nil {
+		t.Error("SetAlertWebhooks() expected an error for a webhook which isn't http(s)")
+	}
+	if IsAlertingEnabled() {
+		t.Error("IsAlertingEnabled() = true after an invalid webhook")
+	}
+	if err := SetAlertWebhooks([]string{"https://hooks.slack.com/services/T000/B000/XXXX"}); err != nil {
+		t.Errorf("SetAlertWebhooks() error = %v", err)
+	}
+	if !IsAlertingEnabled() {
+		t.Error("IsAlertingEnabled() = false, want true")
+	}
+}
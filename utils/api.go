@@ -1,54 +1,255 @@
 package utils
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/path"
+	"sync"
 	"time"
 
-	"github.com/PaesslerAG/jsonpath"
 	"github.com/avast/retry-go"
 	"github.com/gocolly/colly"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/tidwall/gjson"
 )
 
-func (*UtilsStruct) GetDataFromAPI(url string) ([]byte, error) {
-	client := http.Client{
-		Timeout: 10 * time.Second,
+var (
+	apiCacheDB      *leveldb.DB
+	apiCacheDBMutex sync.Mutex
+)
+
+func (*UtilsStruct) GetDataFromAPI(url string, headers map[string]string) ([]byte, error) {
+	if err := CheckAllowedHost(url); err != nil {
+		return nil, err
+	}
+	// The responses are cached by the url and the headers of the request
+	cacheKey := apiCacheKey(url, headers)
+	// The responses fetched within the TTL are reused without sending a request
+	if body, ok := getFreshAPIResponse(cacheKey); ok {
+		log.Debugf("API: %s was fetched within the cache TTL, using cached response", url)
+		metrics.APICacheRequestsMetric.WithLabelValues("hit").Inc()
+		return body, nil
+	}
+	client := getAPIClient()
+	cachedData, err := UtilsInterface.GetAPICacheData(cacheKey)
+	if err != nil {
+		log.Debug("Error in fetching cached response of API: ", err)
+	}
+	if cachedData.Body != nil && isCachedAPIResponseFresh(cachedData.FetchedAt) {
+		log.Debugf("API: %s was fetched within the cache TTL, using cached response", url)
+		metrics.APICacheRequestsMetric.WithLabelValues("hit").Inc()
+		storeAPIResponse(cacheKey, cachedData.Body, time.Unix(cachedData.FetchedAt, 0))
+		return cachedData.Body, nil
 	}
 	var body []byte
-	err := retry.Do(
+	err = retry.Do(
 		func() error {
-			response, err := client.Get(url)
+			if err := waitForHost(url); err != nil {
+				return retry.Unrecoverable(err)
+			}
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			if err != nil {
+				return err
+			}
+			setRequestHeaders(request.Header)
+			for name, value := range headers {
+				request.Header.Set(name, value)
+			}
+			if cachedData.ETag != "" {
+				request.Header.Set("If-None-Match", cachedData.ETag)
+			}
+			if cachedData.LastModified != "" {
+				request.Header.Set("If-Modified-Since", cachedData.LastModified)
+			}
+			response, err := client.Do(request)
 			if err != nil {
 				return err
 			}
 			defer response.Body.Close()
+			blacklisted := updateHostLimiter(url, response)
+			if response.StatusCode == http.StatusNotModified && cachedData.Body != nil {
+				log.Debugf("API: %s responded with status code %d, using cached response", url, response.StatusCode)
+				metrics.APICacheRequestsMetric.WithLabelValues("hit").Inc()
+				body = cachedData.Body
+				storeAPIResponse(cacheKey, body, time.Now())
+				return nil
+			}
 			if response.StatusCode != 200 {
 				log.Errorf("API: %s responded with status code %d", url, response.StatusCode)
-				return errors.New("unable to reach API")
+				return hostResponseError(blacklisted, response)
 			}
 			body, err = IOInterface.ReadAll(response.Body)
 			if err != nil {
 				return err
 			}
+			metrics.APICacheRequestsMetric.WithLabelValues("miss").Inc()
+			fetchedAt := time.Now()
+			storeAPIResponse(cacheKey, body, fetchedAt)
+			eTag := response.Header.Get("ETag")
+			lastModified := response.Header.Get("Last-Modified")
+			// The responses are also kept on disk with their fetch time while the TTL is set, so that they are reused after a restart
+			if eTag != "" || lastModified != "" || IsAPICacheTTLEnabled() {
+				cacheData := types.APICacheData{
+					ETag:         eTag,
+					LastModified: lastModified,
+					Body:         body,
+				}
+				if IsAPICacheTTLEnabled() {
+					cacheData.FetchedAt = fetchedAt.Unix()
+				}
+				err = UtilsInterface.SaveAPICacheData(cacheKey, cacheData)
+				if err != nil {
+					log.Debug("Error in caching response of API: ", err)
+				}
+			}
 			return nil
-		}, retry.Attempts(2), retry.Delay(time.Second*2))
+		}, getAPIRetryOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+//This function posts the GraphQL query to the url and returns the JSON response, an error is returned if the response has GraphQL errors
+//The responses are reused within the cache TTL by the url, the query and the headers, they aren't kept in the on-disk cache
+func (*UtilsStruct) GetDataFromGraphQL(url string, query types.GraphQLQuery, headers map[string]string) ([]byte, error) {
+	if err := CheckAllowedHost(url); err != nil {
+		return nil, err
+	}
+	requestBody, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := apiCacheKey(url, headers) + "\x00" + string(requestBody)
+	if body, ok := getFreshAPIResponse(cacheKey); ok {
+		log.Debugf("GraphQL API: %s was fetched within the cache TTL, using cached response", url)
+		metrics.APICacheRequestsMetric.WithLabelValues("hit").Inc()
+		return body, nil
+	}
+	client := getAPIClient()
+	var body []byte
+	err = retry.Do(
+		func() error {
+			if err := waitForHost(url); err != nil {
+				return retry.Unrecoverable(err)
+			}
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(requestBody))
+			if err != nil {
+				return err
+			}
+			setRequestHeaders(request.Header)
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("Accept", "application/json")
+			for name, value := range headers {
+				request.Header.Set(name, value)
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				return err
+			}
+			defer response.Body.Close()
+			blacklisted := updateHostLimiter(url, response)
+			if response.StatusCode != 200 {
+				log.Errorf("GraphQL API: %s responded with status code %d", url, response.StatusCode)
+				return hostResponseError(blacklisted, response)
+			}
+			body, err = IOInterface.ReadAll(response.Body)
+			return err
+		}, getAPIRetryOptions()...)
 	if err != nil {
 		return nil, err
 	}
+	// The errors of a GraphQL query are returned with the status code 200
+	if graphQLErrors := gjson.GetBytes(body, "errors"); graphQLErrors.IsArray() && len(graphQLErrors.Array()) > 0 {
+		return nil, errors.New("GraphQL query failed: " + graphQLErrors.Array()[0].Get("message").String())
+	}
+	metrics.APICacheRequestsMetric.WithLabelValues("miss").Inc()
+	storeAPIResponse(cacheKey, body, time.Now())
 	return body, nil
 }
 
-func (*UtilsStruct) GetDataFromJSON(jsonObject map[string]interface{}, selector string) (interface{}, error) {
-	if selector[0] == '[' {
-		selector = "$" + selector
-	} else {
-		selector = "$." + selector
+//This function returns the cached response of an API along with its ETag and Last-Modified headers
+func (*UtilsStruct) GetAPICacheData(url string) (types.APICacheData, error) {
+	db, err := getAPICacheDB()
+	if err != nil {
+		return types.APICacheData{}, err
+	}
+	data, err := db.Get([]byte(url), nil)
+	if err == leveldb.ErrNotFound {
+		return types.APICacheData{}, nil
+	}
+	if err != nil {
+		return types.APICacheData{}, err
 	}
-	return jsonpath.Get(selector, jsonObject)
+	data, err = DecryptStateData(data)
+	if err != nil {
+		return types.APICacheData{}, err
+	}
+	var cachedData types.APICacheData
+	err = JsonInterface.Unmarshal(data, &cachedData)
+	if err != nil {
+		return types.APICacheData{}, err
+	}
+	return cachedData, nil
 }
 
-func (*UtilsStruct) GetDataFromXHTML(url string, selector string) (string, error) {
+//This function stores the response of an API along with its ETag and Last-Modified headers in the local database
+func (*UtilsStruct) SaveAPICacheData(url string, cachedData types.APICacheData) error {
+	db, err := getAPICacheDB()
+	if err != nil {
+		return err
+	}
+	data, err := JsonInterface.Marshal(cachedData)
+	if err != nil {
+		return err
+	}
+	data, err = EncryptStateData(data)
+	if err != nil {
+		return err
+	}
+	return db.Put([]byte(url), data, nil)
+}
+
+//This function opens the database of cached API responses once and returns it for later calls
+func getAPICacheDB() (*leveldb.DB, error) {
+	apiCacheDBMutex.Lock()
+	defer apiCacheDBMutex.Unlock()
+	if apiCacheDB != nil {
+		return apiCacheDB, nil
+	}
+	dbPath, err := path.PathUtilsInterface.GetAPICacheDBPath()
+	if err != nil {
+		return nil, err
+	}
+	db, err := leveldb.OpenFile(dbPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	apiCacheDB = db
+	return apiCacheDB, nil
+}
+
+func (*UtilsStruct) GetDataFromJSON(jsonObject interface{}, selector string) (interface{}, error) {
+	return evaluateJSONSelector(jsonObject, selector)
+}
+
+func (*UtilsStruct) GetDataFromXHTML(url string, selector string, headers map[string]string) (string, error) {
+	if err := CheckAllowedHost(url); err != nil {
+		return "", err
+	}
 	c := colly.NewCollector()
+	if userAgent, ok := getUserAgent(); ok {
+		c.UserAgent = userAgent
+	}
+	c.OnRequest(func(r *colly.Request) {
+		for name, value := range headers {
+			r.Headers.Set(name, value)
+		}
+	})
 	var priceData string
 	c.OnXML(selector, func(e *colly.XMLElement) {
 		priceData = e.Text
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+)
+
+//apiResponse is a response of an API kept in memory along with the time it was fetched at
+type apiResponse struct {
+	body      []byte
+	fetchedAt time.Time
+}
+
+var (
+	apiCacheTTL           time.Duration
+	apiCacheClearedAt     time.Time
+	apiResponses          = make(map[string]apiResponse)
+	apiResponseCacheMutex sync.Mutex
+)
+
+//This function sets the seconds for which the responses of the APIs are reused without being fetched again, the responses are not reused if it is 0
+func SetAPICacheTTL(ttl int32) {
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	if time.Duration(ttl)*time.Second == apiCacheTTL {
+		return
+	}
+	apiCacheTTL = time.Duration(ttl) * time.Second
+	apiResponses = make(map[string]apiResponse)
+}
+
+//This function returns true if the responses of the APIs are reused within the TTL
+func IsAPICacheTTLEnabled() bool {
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	return apiCacheTTL > 0
+}
+
+//This function drops the responses of the APIs kept within the TTL, so that the next requests fetch them again
+//The responses of the on-disk cache fetched before are not reused within the TTL either
+func ClearAPICache() {
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	apiResponses = make(map[string]apiResponse)
+	apiCacheClearedAt = time.Now()
+}
+
+//This function returns the key by which the response of the API is cached, the hash of the sorted headers is added to the url if the request has headers
+//The responses of the requests with different headers, e.g. API keys, are cached separately and the header values aren't kept in the keys on disk
+func apiCacheKey(url string, headers map[string]string) string {
+	if len(headers) == 0 {
+		return url
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name + "\x00" + headers[name] + "\x00"))
+	}
+	return url + "\x00" + hex.EncodeToString(hash.Sum(nil))
+}
+
+//This function returns the response of the API if it was fetched within the TTL
+func getFreshAPIResponse(url string) ([]byte, bool) {
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	response, ok := apiResponses[url]
+	if !ok || !isFresh(response.fetchedAt) {
+		return nil, false
+	}
+	return response.body, true
+}
+
+//This function keeps the response of the API in memory to be reused within the TTL
+func storeAPIResponse(url string, body []byte, fetchedAt time.Time) {
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	if apiCacheTTL <= 0 {
+		return
+	}
+	apiResponses[url] = apiResponse{
+		body:      body,
+		fetchedAt: fetchedAt,
+	}
+}
+
+//This function returns true if the response fetched at the time of the on-disk cache can still be reused
+func isCachedAPIResponseFresh(fetchedAt int64) bool {
+	if fetchedAt == 0 {
+		return false
+	}
+	apiResponseCacheMutex.Lock()
+	defer apiResponseCacheMutex.Unlock()
+	return isFresh(time.Unix(fetchedAt, 0))
+}
+
+func isFresh(fetchedAt time.Time) bool {
+	return apiCacheTTL > 0 && fetchedAt.After(apiCacheClearedAt) && time.Since(fetchedAt) < apiCacheTTL
+}
@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetDataFromAPIWithCacheTTL(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write(getAPIByteArray(0))
+	}))
+	defer server.Close()
+
+	type args struct {
+		ttl        int32
+		cachedData types.APICacheData
+		clearCache bool
+		headers    [2]map[string]string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          []byte
+		wantRequests  int
+		wantFetchedAt bool
+	}{
+		{
+			name: "Test 1: When the TTL is not set the API is fetched every time",
+			args: args{
+				ttl: 0,
+			},
+			want:         getAPIByteArray(0),
+			wantRequests: 2,
+		},
+		{
+			name: "Test 2: When the TTL is set the response is reused",
+			args: args{
+				ttl: 60,
+			},
+			want:          getAPIByteArray(0),
+			wantRequests:  1,
+			wantFetchedAt: true,
+		},
+		{
+			name: "Test 3: When the cache is cleared the API is fetched again",
+			args: args{
+				ttl:        60,
+				clearCache: true,
+			},
+			want:          getAPIByteArray(0),
+			wantRequests:  2,
+			wantFetchedAt: true,
+		},
+		{
+			name: "Test 4: When the response on disk was fetched within the TTL",
+			args: args{
+				ttl: 60,
+				cachedData: types.APICacheData{
+					Body:      getAPIByteArray(1),
+					FetchedAt: time.Now().Unix(),
+				},
+			},
+			want:         getAPIByteArray(1),
+			wantRequests: 0,
+		},
+		{
+			name: "Test 5: When the response on disk was fetched before the TTL",
+			args: args{
+				ttl: 60,
+				cachedData: types.APICacheData{
+					Body:      getAPIByteArray(1),
+					FetchedAt: time.Now().Add(-2 * time.Minute).Unix(),
+				},
+			},
+			want:          getAPIByteArray(0),
+			wantRequests:  1,
+			wantFetchedAt: true,
+		},
+		{
+			name: "Test 6: When the TTL is set and the requests have different headers the API is fetched for both",
+			args: args{
+				ttl:     60,
+				headers: [2]map[string]string{{"x-api-key": "key1"}, {"x-api-key": "key2"}},
+			},
+			want:          getAPIByteArray(0),
+			wantRequests:  2,
+			wantFetchedAt: true,
+		},
+		{
+			name: "Test 7: When the TTL is set and the requests have the same headers the response is reused",
+			args: args{
+				ttl:     60,
+				headers: [2]map[string]string{{"x-api-key": "key1", "accept": "application/json"}, {"accept": "application/json", "x-api-key": "key1"}},
+			},
+			want:          getAPIByteArray(0),
+			wantRequests:  1,
+			wantFetchedAt: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			ioMock := new(mocks.IOUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+				IOInterface:    ioMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			SetAPICacheTTL(tt.args.ttl)
+			apiResponses = make(map[string]apiResponse)
+			apiCacheClearedAt = time.Time{}
+			defer SetAPICacheTTL(0)
+			requests = 0
+
+			var savedData types.APICacheData
+			utilsMock.On("GetAPICacheData", mock.AnythingOfType("string")).Return(tt.args.cachedData, nil)
+			utilsMock.On("SaveAPICacheData", mock.AnythingOfType("string"), mock.Anything).Run(func(args mock.Arguments) {
+				savedData = args.Get(1).(types.APICacheData)
+			}).Return(nil)
+			ioMock.On("ReadAll", mock.Anything).Return(getAPIByteArray(0), nil)
+
+			for i := 0; i < 2; i++ {
+				if i == 1 && tt.args.clearCache {
+					ClearAPICache()
+				}
+				got, err := utils.GetDataFromAPI(server.URL, tt.args.headers[i])
+				if err != nil {
+					t.Fatalf("GetDataFromAPI() error = %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("GetDataFromAPI() got = %s, want %s", got, tt.want)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("GetDataFromAPI() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+			if (savedData.FetchedAt != 0) != tt.wantFetchedAt {
+				t.Errorf("GetDataFromAPI() saved fetch time %d, want saved %v", savedData.FetchedAt, tt.wantFetchedAt)
+			}
+		})
+	}
+}
+
+func TestAPICacheKey(t *testing.T) {
+	if got := apiCacheKey("https://api.example.com", nil); got != "https://api.example.com" {
+		t.Errorf("apiCacheKey() = %s without headers, want the url", got)
+	}
+	key := apiCacheKey("https://api.example.com", map[string]string{"x-api-key": "secret"})
+	if key == "https://api.example.com" || key != apiCacheKey("https://api.example.com", map[string]string{"x-api-key": "secret"}) {
+		t.Errorf("apiCacheKey() = %s, want the same key with the hash of the headers", key)
+	}
+	if strings.Contains(key, "secret") {
+		t.Errorf("apiCacheKey() = %s, want the header values hashed", key)
+	}
+	if key == apiCacheKey("https://api.example.com", map[string]string{"x-api-key": "other"}) {
+		t.Error("apiCacheKey() returned the same key for different headers")
+	}
+}
@@ -3,6 +3,12 @@ package utils
 import (
 	"errors"
 	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
 	"razor/utils/mocks"
 	"reflect"
 	"testing"
@@ -96,9 +102,11 @@ func TestGetDataFromAPI(t *testing.T) {
 			}
 			utils := StartRazor(optionsPackageStruct)
 
+			utilsMock.On("GetAPICacheData", mock.AnythingOfType("string")).Return(types.APICacheData{}, nil)
+			utilsMock.On("SaveAPICacheData", mock.AnythingOfType("string"), mock.Anything).Return(nil)
 			ioMock.On("ReadAll", mock.Anything).Return(tt.args.body, tt.args.bodyErr)
 
-			got, err := utils.GetDataFromAPI(tt.args.url)
+			got, err := utils.GetDataFromAPI(tt.args.url, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetDataFromAPI() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -110,9 +118,227 @@ func TestGetDataFromAPI(t *testing.T) {
 	}
 }
 
+func TestGetDataFromAPIWithCache(t *testing.T) {
+	eTag := `"razor"`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == eTag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", eTag)
+		_, _ = w.Write(getAPIByteArray(0))
+	}))
+	defer server.Close()
+
+	type args struct {
+		cachedData    types.APICacheData
+		cachedDataErr error
+		body          []byte
+		saveCacheErr  error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          []byte
+		wantSaveCache bool
+		wantErr       bool
+	}{
+		{
+			name: "Test 1: When response is not cached",
+			args: args{
+				body: getAPIByteArray(0),
+			},
+			want:          getAPIByteArray(0),
+			wantSaveCache: true,
+			wantErr:       false,
+		},
+		{
+			name: "Test 2: When response is not modified and cached response is used",
+			args: args{
+				cachedData: types.APICacheData{
+					ETag: eTag,
+					Body: getAPIByteArray(1),
+				},
+			},
+			want:          getAPIByteArray(1),
+			wantSaveCache: false,
+			wantErr:       false,
+		},
+		{
+			name: "Test 3: When cached ETag is stale",
+			args: args{
+				cachedData: types.APICacheData{
+					ETag: `"stale"`,
+					Body: getAPIByteArray(1),
+				},
+				body: getAPIByteArray(0),
+			},
+			want:          getAPIByteArray(0),
+			wantSaveCache: true,
+			wantErr:       false,
+		},
+		{
+			name: "Test 4: When there is an error in fetching cached response",
+			args: args{
+				cachedDataErr: errors.New("cache error"),
+				body:          getAPIByteArray(0),
+			},
+			want:          getAPIByteArray(0),
+			wantSaveCache: true,
+			wantErr:       false,
+		},
+		{
+			name: "Test 5: When there is an error in saving response to cache",
+			args: args{
+				body:         getAPIByteArray(0),
+				saveCacheErr: errors.New("save error"),
+			},
+			want:          getAPIByteArray(0),
+			wantSaveCache: true,
+			wantErr:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			ioMock := new(mocks.IOUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+				IOInterface:    ioMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetAPICacheData", mock.AnythingOfType("string")).Return(tt.args.cachedData, tt.args.cachedDataErr)
+			utilsMock.On("SaveAPICacheData", mock.AnythingOfType("string"), mock.Anything).Return(tt.args.saveCacheErr)
+			ioMock.On("ReadAll", mock.Anything).Return(tt.args.body, nil)
+
+			got, err := utils.GetDataFromAPI(server.URL, nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDataFromAPI() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDataFromAPI() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantSaveCache {
+				utilsMock.AssertCalled(t, "SaveAPICacheData", server.URL, types.APICacheData{ETag: eTag, Body: tt.args.body})
+			} else {
+				utilsMock.AssertNotCalled(t, "SaveAPICacheData", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestGetDataFromAPIWithHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-CMC_PRO_API_KEY") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write(getAPIByteArray(0))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    []byte
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When the API key is sent in the headers",
+			headers: map[string]string{"X-CMC_PRO_API_KEY": "secret"},
+			want:    getAPIByteArray(0),
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When the API key is wrong",
+			headers: map[string]string{"X-CMC_PRO_API_KEY": "wrong"},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Test 3: When no headers are sent",
+			headers: nil,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			ioMock := new(mocks.IOUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+				IOInterface:    ioMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetAPICacheData", mock.AnythingOfType("string")).Return(types.APICacheData{}, nil)
+			utilsMock.On("SaveAPICacheData", mock.AnythingOfType("string"), mock.Anything).Return(nil)
+			ioMock.On("ReadAll", mock.Anything).Return(getAPIByteArray(0), nil)
+
+			got, err := utils.GetDataFromAPI(server.URL, tt.headers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDataFromAPI() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDataFromAPI() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+func TestAPICacheData(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "api_cache")
+	url := "https://jsonplaceholder.typicode.com/todos/1"
+	cachedData := types.APICacheData{
+		ETag:         `"razor"`,
+		LastModified: "Wed, 21 Oct 2015 07:28:00 GMT",
+		Body:         getAPIByteArray(0),
+	}
+
+	pathMock := new(pathMocks.PathInterface)
+	path.PathUtilsInterface = pathMock
+	pathMock.On("GetAPICacheDBPath").Return(dbPath, nil)
+
+	optionsPackageStruct := OptionsPackageStruct{
+		JsonInterface: JsonStruct{},
+	}
+	utils := StartRazor(optionsPackageStruct)
+
+	apiCacheDB = nil
+	defer func() {
+		apiCacheDB.Close()
+		apiCacheDB = nil
+	}()
+
+	got, err := utils.GetAPICacheData(url)
+	if err != nil {
+		t.Errorf("GetAPICacheData() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, types.APICacheData{}) {
+		t.Errorf("GetAPICacheData() got = %v, want empty data for uncached url", got)
+	}
+
+	err = utils.SaveAPICacheData(url, cachedData)
+	if err != nil {
+		t.Errorf("SaveAPICacheData() error = %v", err)
+	}
+
+	got, err = utils.GetAPICacheData(url)
+	if err != nil {
+		t.Errorf("GetAPICacheData() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, cachedData) {
+		t.Errorf("GetAPICacheData() got = %v, want %v", got, cachedData)
+	}
+}
+
 func TestGetDataFromJSON(t *testing.T) {
 	type args struct {
-		jsonObject map[string]interface{}
+		jsonObject interface{}
 		selector   string
 	}
 	tests := []struct {
@@ -192,6 +418,100 @@ func TestGetDataFromJSON(t *testing.T) {
 			want:    "81.1496",
 			wantErr: false,
 		},
+		{
+			name: "Filter on an array response",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "$[?(@.symbol=='ETH')].price",
+			},
+			want:    2697.15,
+			wantErr: false,
+		},
+		{
+			name: "Filter on a nested array",
+			args: args{
+				jsonObject: map[string]interface{}{"data": []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}}},
+				selector:   `$.data[?(@.symbol=="BTC")].price`,
+			},
+			want:    "37176.33",
+			wantErr: false,
+		},
+		{
+			name: "Slice of an array",
+			args: args{
+				jsonObject: map[string]interface{}{"data": []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}}},
+				selector:   "$.data[-1:].symbol",
+			},
+			want:    "ETH",
+			wantErr: false,
+		},
+		{
+			name: "Arithmetic with rounding",
+			args: args{
+				jsonObject: map[string]interface{}{"bid": "2695.07", "ask": "2696.23"},
+				selector:   "round(($.bid + $.ask) / 2, 2)",
+			},
+			want:    2695.65,
+			wantErr: false,
+		},
+		{
+			name: "Arithmetic on a filtered value",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "floor(one($[?(@.symbol=='ETH')].price) * 100)",
+			},
+			want:    269715.0,
+			wantErr: false,
+		},
+		{
+			name: "Selector of an index in an array response",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "[1][`symbol`]",
+			},
+			want:    "ETH",
+			wantErr: false,
+		},
+		{
+			name: "Filter which matches several values",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "$[?(@.bid > 0)].price",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Filter which matches no value",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "$[?(@.symbol=='SOL')].price",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unknown key",
+			args: args{
+				jsonObject: map[string]interface{}{"last": "2697.15"},
+				selector:   "price",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Unterminated string",
+			args: args{
+				jsonObject: []interface{}{map[string]interface{}{"symbol": "BTC", "price": "37176.33", "bid": "37179.05", "ask": "37196.47"}, map[string]interface{}{"symbol": "ETH", "price": 2697.15, "bid": "2695.07", "ask": "2696.23"}},
+				selector:   "$[?(@.symbol=='ETH)].price",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Empty selector",
+			args: args{
+				jsonObject: map[string]interface{}{"last": "2697.15"},
+				selector:   "",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -252,7 +572,7 @@ func TestGetDataFromHTML(t *testing.T) {
 			}
 			utils := StartRazor(optionsPackageStruct)
 
-			got, err := utils.GetDataFromXHTML(tt.args.url, tt.args.selector)
+			got, err := utils.GetDataFromXHTML(tt.args.url, tt.args.selector, nil)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetDataFromHTML() error = %v, wantErr %v", err, tt.wantErr)
 				return
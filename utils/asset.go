@@ -4,8 +4,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"os"
+	"razor/aggregator"
 	"razor/core"
 	"razor/core/types"
 	"razor/path"
@@ -27,6 +29,10 @@ func (*UtilsStruct) GetCollectionManagerWithOpts(client *ethclient.Client) (*bin
 }
 
 func (*UtilsStruct) GetNumCollections(client *ethclient.Client) (uint16, error) {
+	cacheKey := contractCallKey("numCollections")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint16), nil
+	}
 	var (
 		numCollections uint16
 		err            error
@@ -43,6 +49,7 @@ func (*UtilsStruct) GetNumCollections(client *ethclient.Client) (uint16, error)
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, numCollections)
 	return numCollections, nil
 }
 
@@ -99,6 +106,10 @@ func (*UtilsStruct) GetAllCollections(client *ethclient.Client) ([]bindings.Stru
 }
 
 func (*UtilsStruct) GetCollection(client *ethclient.Client, collectionId uint16) (bindings.StructsCollection, error) {
+	cacheKey := contractCallKey("collection", collectionId)
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(bindings.StructsCollection), nil
+	}
 	var (
 		collection bindings.StructsCollection
 		err        error
@@ -115,6 +126,7 @@ func (*UtilsStruct) GetCollection(client *ethclient.Client, collectionId uint16)
 	if err != nil {
 		return bindings.StructsCollection{}, err
 	}
+	storeContractCallResult(cacheKey, collection)
 	return collection, nil
 }
 
@@ -144,6 +156,19 @@ func (*UtilsStruct) GetAggregatedDataOfCollection(client *ethclient.Client, coll
 		log.Error(err)
 		return nil, err
 	}
+	// The value of an overridden collection is taken from its override instead of its jobs
+	overrideFilePath, err := path.PathUtilsInterface.GetDataOverrideFilePath()
+	if err != nil {
+		return nil, err
+	}
+	overrideFile, err := UtilsInterface.ReadDataOverrideFile(overrideFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if override, ok := overrideFile.Collections[strconv.Itoa(int(collectionId))]; ok {
+		log.Debugf("Value of collection %d is overridden in %s", collectionId, overrideFilePath)
+		return UtilsInterface.GetDataOfOverride(activeCollection, override)
+	}
 	//Supply previous epoch to Aggregate in case if last reported value is required.
 	collectionData, aggregationError := UtilsInterface.Aggregate(client, epoch-1, activeCollection)
 	if aggregationError != nil {
@@ -155,6 +180,8 @@ func (*UtilsStruct) GetAggregatedDataOfCollection(client *ethclient.Client, coll
 func (*UtilsStruct) Aggregate(client *ethclient.Client, previousEpoch uint32, collection bindings.StructsCollection) (*big.Int, error) {
 	var jobs []bindings.StructsJob
 	var overriddenJobIds []uint16
+	var aggregatorName string
+	displayData := types.CollectionDisplayData{Power: collection.Power}
 
 	// Checks if assets.JSON file exists
 	assetsFilePath, err := path.PathUtilsInterface.GetJobFilePath()
@@ -178,6 +205,8 @@ func (*UtilsStruct) Aggregate(client *ethclient.Client, previousEpoch uint32, co
 		if powerFromJSONFile != 0 {
 			collection.Power = int8(powerFromJSONFile)
 		}
+		displayData = GetCollectionDisplayDataFromJSONFile(collection.Name, displayData.Power, dataString)
+		aggregatorName = gjson.Get(dataString, "assets.collection."+collection.Name+".aggregator").String()
 
 		// Overriding the jobs from contracts with official jobs present in asset.go
 		overrideJobs, overriddenJobIdsFromJSONfile := UtilsInterface.HandleOfficialJobsFromJSONFile(client, collection, dataString)
@@ -214,10 +243,38 @@ func (*UtilsStruct) Aggregate(client *ethclient.Client, previousEpoch uint32, co
 		}
 		return prevCommitmentData, nil
 	}
-	return performAggregation(dataToCommit, weight, collection.AggregationMethod)
+
+	// Storing the job data of the collection so that aggregation settings can be backtested later
+	historyFilePath, err := path.PathUtilsInterface.GetCollectionHistoryFileName(collection.Id)
+	if err == nil {
+		err = UtilsInterface.SaveDataToCollectionHistoryFile(historyFilePath, collection.Id, types.CollectionHistoryData{
+			Epoch:   previousEpoch + 1,
+			Values:  dataToCommit,
+			Weights: weight,
+		})
+	}
+	if err != nil {
+		log.Error("Error in saving collection history: ", err)
+	}
+	aggregatedValue, err := AggregateWithAggregator(aggregatorName, dataToCommit, weight, aggregator.Metadata{
+		CollectionId:      collection.Id,
+		CollectionName:    collection.Name,
+		Power:             collection.Power,
+		Epoch:             previousEpoch + 1,
+		AggregationMethod: collection.AggregationMethod,
+	})
+	if err != nil {
+		return nil, err
+	}
+	CheckValueBounds(collection.Name, aggregatedValue, displayData)
+	return aggregatedValue, nil
 }
 
 func (*UtilsStruct) GetActiveJob(client *ethclient.Client, jobId uint16) (bindings.StructsJob, error) {
+	cacheKey := contractCallKey("job", jobId)
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(bindings.StructsJob), nil
+	}
 	var (
 		job bindings.StructsJob
 		err error
@@ -234,6 +291,7 @@ func (*UtilsStruct) GetActiveJob(client *ethclient.Client, jobId uint16) (bindin
 	if err != nil {
 		return bindings.StructsJob{}, err
 	}
+	storeContractCallResult(cacheKey, job)
 	return job, nil
 }
 
@@ -254,7 +312,12 @@ func (*UtilsStruct) GetDataToCommitFromJobs(jobs []bindings.StructsJob) ([]*big.
 		weight []uint8
 	)
 	for _, job := range jobs {
+		if UtilsInterface.IsJobQuarantined(job.Id) {
+			log.Debugf("Job %d is quarantined, skipping it", job.Id)
+			continue
+		}
 		dataToAppend, err := UtilsInterface.GetDataToCommitFromJob(job)
+		UtilsInterface.UpdateJobCircuitBreaker(job, err == nil)
 		if err != nil {
 			continue
 		}
@@ -265,17 +328,60 @@ func (*UtilsStruct) GetDataToCommitFromJobs(jobs []bindings.StructsJob) ([]*big.
 }
 
 func (*UtilsStruct) GetDataToCommitFromJob(job bindings.StructsJob) (*big.Int, error) {
-	var parsedJSON map[string]interface{}
+	options := getJobOptions(job)
+
+	// The jobs with additional sources are fetched from all of them and their values are aggregated
+	if options.Sources != nil {
+		return getDataToCommitFromJobSources(job, options)
+	}
+	return getDataToCommitFromSource(job, options)
+}
+
+//This function fetches the value of the job from its URL with the headers whose secrets are resolved and converts it with the conversion of the job if it has one
+//The value is then post-processed with the expression of the job if it has one
+func getDataToCommitFromSource(job bindings.StructsJob, options types.JobOptions) (*big.Int, error) {
+	// The responses of the jobs with parse options are decoded tolerantly
+	var parseOptions types.JobParseOptions
+	tolerant := options.ParseOptions != nil
+	if tolerant {
+		parseOptions = *options.ParseOptions
+	}
+	conversion := options.Conversion
+
+	var parsedJSON interface{}
 	var (
 		response []byte
 		apiErr   error
 	)
 
+	resolvedHeaders, err := resolveJobHeaders(options.Headers)
+	if err != nil {
+		log.Errorf("Error in resolving headers of job %s: %s", job.Name, err)
+		return nil, err
+	}
+
+	// The timestamps are parsed by their conversion instead of as numbers
+	parsesTimestamp := conversion != nil && conversion.Type == core.TimestampToUnixConversion
+
 	// Fetch data from API with retry mechanism
 	var parsedData interface{}
-	if job.SelectorType == 0 {
+	// The jobs with a GraphQL query post it to their URL and select the value from its JSON response
+	isGraphQL := options.GraphQLQuery != nil
+	if IsWebSocketProvider(job.Url) {
+		// The jobs with a WebSocket URL use the last value streamed by the feed instead of fetching it
+		parsedData, err = getDataFromWebSocketFeed(job, options.WebSocketFeed, resolvedHeaders)
+		if err != nil {
+			log.Error("Error in fetching value from WebSocket feed: ", err)
+			return nil, err
+		}
+		response = []byte(fmt.Sprint(parsedData))
+	} else if job.SelectorType == 0 || isGraphQL {
 		start := time.Now()
-		response, apiErr = UtilsInterface.GetDataFromAPI(job.Url)
+		if isGraphQL {
+			response, apiErr = UtilsInterface.GetDataFromGraphQL(job.Url, *options.GraphQLQuery, resolvedHeaders)
+		} else {
+			response, apiErr = UtilsInterface.GetDataFromAPI(job.Url, resolvedHeaders)
+		}
 		if apiErr != nil {
 			log.Error("Error in fetching data from API: ", apiErr)
 			return nil, apiErr
@@ -283,7 +389,11 @@ func (*UtilsStruct) GetDataToCommitFromJob(job bindings.StructsJob) (*big.Int, e
 		elapsed := time.Since(start).Seconds()
 		log.Debugf("Time taken to fetch the data from API : %s was %f", job.Url, elapsed)
 
-		err := json.Unmarshal(response, &parsedJSON)
+		if tolerant {
+			parsedJSON, err = decodeTolerantJSON(response, parseOptions)
+		} else {
+			err = json.Unmarshal(response, &parsedJSON)
+		}
 		if err != nil {
 			log.Error("Error in parsing data from API: ", err)
 			return nil, err
@@ -295,22 +405,54 @@ func (*UtilsStruct) GetDataToCommitFromJob(job bindings.StructsJob) (*big.Int, e
 		}
 	} else {
 		//TODO: Add retry here.
-		dataPoint, err := UtilsInterface.GetDataFromXHTML(job.Url, job.Selector)
+		dataPoint, err := UtilsInterface.GetDataFromXHTML(job.Url, job.Selector, resolvedHeaders)
 		if err != nil {
 			log.Error("Error in fetching value from parsed XHTML: ", err)
 			return nil, err
 		}
-		// remove "," and currency symbols
-		parsedData = regexp.MustCompile(`[\p{Sc},]`).ReplaceAllString(dataPoint, "")
+		response = []byte(dataPoint)
+		if tolerant || parsesTimestamp {
+			parsedData = dataPoint
+		} else {
+			// remove "," and currency symbols
+			parsedData = regexp.MustCompile(`[\p{Sc},]`).ReplaceAllString(dataPoint, "")
+		}
 	}
 
-	datum, err := UtilsInterface.ConvertToNumber(parsedData)
-	if err != nil {
-		log.Error("Result is not a number")
-		return nil, err
+	if tolerant && !parsesTimestamp {
+		parsedData, err = parseTolerantNumber(parsedData, parseOptions)
+		if err != nil {
+			log.Error("Error in parsing value: ", err)
+			return nil, err
+		}
 	}
 
-	return MultiplyWithPower(datum, job.Power), err
+	var datum *big.Float
+	if conversion != nil {
+		datum, err = convertJobValue(parsedData, *conversion)
+		if err != nil {
+			log.Errorf("Error in converting value with %s conversion: %s", conversion.Type, err)
+			return nil, err
+		}
+	} else {
+		datum, err = UtilsInterface.ConvertToNumber(parsedData)
+		if err != nil {
+			log.Error("Result is not a number")
+			return nil, err
+		}
+	}
+
+	if options.PostProcess != "" {
+		datum, err = postProcessJobValue(options.PostProcess, datum, parsedJSON)
+		if err != nil {
+			log.Errorf("Error in post-processing value with %s: %s", options.PostProcess, err)
+			return nil, err
+		}
+	}
+
+	value := MultiplyWithPower(datum, job.Power)
+	recordAttestedSource(job, response, value)
+	return value, err
 }
 
 func (*UtilsStruct) GetAssignedCollections(client *ethclient.Client, numActiveCollections uint16, seed []byte) (map[int]bool, []*big.Int, error) {
@@ -329,6 +471,10 @@ func (*UtilsStruct) GetAssignedCollections(client *ethclient.Client, numActiveCo
 }
 
 func (*UtilsStruct) GetLeafIdOfACollection(client *ethclient.Client, collectionId uint16) (uint16, error) {
+	cacheKey := contractCallKey("leafIdOfACollection", collectionId)
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint16), nil
+	}
 	var (
 		leafId uint16
 		err    error
@@ -345,6 +491,7 @@ func (*UtilsStruct) GetLeafIdOfACollection(client *ethclient.Client, collectionI
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, leafId)
 	return leafId, nil
 }
 
@@ -369,6 +516,10 @@ func (*UtilsStruct) GetCollectionIdFromIndex(client *ethclient.Client, medianInd
 }
 
 func (*UtilsStruct) GetCollectionIdFromLeafId(client *ethclient.Client, leafId uint16) (uint16, error) {
+	cacheKey := contractCallKey("collectionIdFromLeafId", leafId)
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint16), nil
+	}
 	var (
 		collectionId uint16
 		err          error
@@ -385,6 +536,7 @@ func (*UtilsStruct) GetCollectionIdFromLeafId(client *ethclient.Client, leafId u
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, collectionId)
 	return collectionId, nil
 }
 
@@ -409,12 +561,47 @@ func GetCustomJobsFromJSONFile(collection string, jsonFileData string) []binding
 			Selector: selector,
 			Weight:   weight,
 		})
+		// The custom jobs have no id, they are named by their collection and their index so that their options are kept apart
+		job.Name = collection + " custom job " + strconv.Itoa(i)
+		SetJobOptions(job, getJobOptionsFromJSONFile(customJobsData))
 		collectionCustomJobs = append(collectionCustomJobs, job)
 	}
 
 	return collectionCustomJobs
 }
 
+//This function returns the power and the bounds in human units used to display and sanity check the values of a collection
+//The values are still reported with the on-chain power, the displayPower from assets.json is only used locally
+func GetCollectionDisplayDataFromJSONFile(collectionName string, power int8, dataString string) types.CollectionDisplayData {
+	displayData := types.CollectionDisplayData{Power: power}
+	collectionData := gjson.Get(dataString, "assets.collection."+collectionName)
+	if displayPower := collectionData.Get("displayPower"); displayPower.Exists() {
+		displayData.Power = int8(displayPower.Int())
+	}
+	if minValue := collectionData.Get("minValue"); minValue.Exists() {
+		displayData.MinValue = big.NewFloat(minValue.Float())
+	}
+	if maxValue := collectionData.Get("maxValue"); maxValue.Exists() {
+		displayData.MaxValue = big.NewFloat(maxValue.Float())
+	}
+	return displayData
+}
+
+//This function checks if the value of a collection in human units lies within the bounds set in assets.json
+func CheckValueBounds(collectionName string, value *big.Int, displayData types.CollectionDisplayData) bool {
+	humanValue := DivideWithPower(value, displayData.Power)
+	log.Debugf("Aggregated value of collection %s: %s (%s in human units)", collectionName, value, humanValue.Text('f', -1))
+	if displayData.MinValue != nil && humanValue.Cmp(displayData.MinValue) < 0 {
+		log.Warnf("Value %s of collection %s is below the minimum value %s", humanValue.Text('f', -1), collectionName, displayData.MinValue.Text('f', -1))
+		return false
+	}
+	if displayData.MaxValue != nil && humanValue.Cmp(displayData.MaxValue) > 0 {
+		log.Warnf("Value %s of collection %s is above the maximum value %s", humanValue.Text('f', -1), collectionName, displayData.MaxValue.Text('f', -1))
+		return false
+	}
+	return true
+}
+
 func ConvertCustomJobToStructJob(customJob types.CustomJob) bindings.StructsJob {
 	return bindings.StructsJob{
 		Url:      customJob.URL,
@@ -443,6 +630,7 @@ func (*UtilsStruct) HandleOfficialJobsFromJSONFile(client *ethclient.Client, col
 			job.Selector = gjson.Get(officialJobs, "selector").String()
 			job.Weight = uint8(gjson.Get(officialJobs, "weight").Int())
 			job.Power = int8(gjson.Get(officialJobs, "power").Int())
+			SetJobOptions(job, getJobOptionsFromJSONFile(officialJobs))
 
 			overrideJobs = append(overrideJobs, job)
 			overriddenJobIds = append(overriddenJobIds, jobIds[i])
@@ -20,6 +20,8 @@ import (
 )
 
 func TestAggregate(t *testing.T) {
+	registerTestAggregators()
+
 	var client *ethclient.Client
 	var previousEpoch uint32
 	var fileInfo fs.FileInfo
@@ -164,6 +166,20 @@ func TestAggregate(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test 10: When the aggregator of the collection is selected in json file",
+			args: args{
+				collection:    collection,
+				activeJob:     job,
+				dataToCommit:  []*big.Int{big.NewInt(2), big.NewInt(5)},
+				weight:        []uint8{50, 50},
+				assetFilePath: "./razor/assets.json",
+				jsonFile:      &os.File{},
+				fileData:      []byte(`{"assets": {"collection": {"ethCollectionMean": {"aggregator": "testMax"}}}}`),
+			},
+			want:    big.NewInt(5),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -188,6 +204,8 @@ func TestAggregate(t *testing.T) {
 			osUtilsMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
 			ioMock.On("ReadAll", mock.Anything).Return(tt.args.fileData, tt.args.fileDataErr)
 			utilsMock.On("HandleOfficialJobsFromJSONFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.overrrideJobs, tt.args.overrideJobIds)
+			pathUtilsMock.On("GetCollectionHistoryFileName", mock.AnythingOfType("uint16")).Return("", nil)
+			utilsMock.On("SaveDataToCollectionHistoryFile", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 			got, err := utils.Aggregate(client, previousEpoch, tt.args.collection)
 			if (err != nil) != tt.wantErr {
@@ -565,6 +583,7 @@ func TestGetDataToCommitFromJobs(t *testing.T) {
 		overrideJobDataErr error
 		dataToAppend       *big.Int
 		dataToAppendErr    error
+		isJobQuarantined   bool
 	}
 	tests := []struct {
 		name    string
@@ -616,6 +635,17 @@ func TestGetDataToCommitFromJobs(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "Test 5: When jobs are quarantined",
+			args: args{
+				jobPath:          "",
+				overrideJobData:  map[string]*types.StructsJob{},
+				dataToAppend:     big.NewInt(1),
+				isJobQuarantined: true,
+			},
+			want:    nil,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -630,7 +660,9 @@ func TestGetDataToCommitFromJobs(t *testing.T) {
 
 			pathMock.On("GetJobFilePath").Return(tt.args.jobPath, tt.args.jobPathErr)
 			utilsMock.On("ReadJSONData", mock.AnythingOfType("string")).Return(tt.args.overrideJobData, tt.args.overrideJobDataErr)
+			utilsMock.On("IsJobQuarantined", mock.AnythingOfType("uint16")).Return(tt.args.isJobQuarantined)
 			utilsMock.On("GetDataToCommitFromJob", mock.Anything).Return(tt.args.dataToAppend, tt.args.dataToAppendErr)
+			utilsMock.On("UpdateJobCircuitBreaker", mock.Anything, mock.AnythingOfType("bool"))
 
 			got, _, err := utils.GetDataToCommitFromJobs(jobsArray)
 			if (err != nil) != tt.wantErr {
@@ -662,8 +694,19 @@ func TestGetDataToCommitFromJob(t *testing.T) {
   			"completed": false
 	}`)
 
+	job3 := bindings.StructsJob{Id: 2, SelectorType: 0, Weight: 100,
+		Power: 2, Name: "ethusd_quirky", Selector: "last",
+		Url: "https://api.quirky.com/ticker?callback=cb",
+	}
+	job4 := bindings.StructsJob{Id: 3, SelectorType: 1, Weight: 100,
+		Power: 2, Name: "ethusd_quirky_html", Selector: "/html/body/span",
+		Url: "https://quirky.com/ethusd",
+	}
+	parseOptions := &types.JobParseOptions{Jsonp: true, NumericStrings: true, DecimalSeparator: ","}
+
 	type args struct {
 		job           bindings.StructsJob
+		parseOptions  *types.JobParseOptions
 		response      []byte
 		responseErr   error
 		parsedData    interface{}
@@ -672,6 +715,11 @@ func TestGetDataToCommitFromJob(t *testing.T) {
 		dataPointErr  error
 		datum         *big.Float
 		datumErr      error
+		postProcess   string
+
+		graphQLQuery       *types.GraphQLQuery
+		graphQLResponse    []byte
+		graphQLResponseErr error
 	}
 	tests := []struct {
 		name    string
@@ -775,6 +823,114 @@ func TestGetDataToCommitFromJob(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test 9: When the JSONP response of a job with parse options is decoded",
+			args: args{
+				job:          job3,
+				parseOptions: parseOptions,
+				response:     []byte(`cb({"last": "1.234,5", "high": NaN});`),
+				parsedData:   "1.234,5",
+				datum:        big.NewFloat(1234.5),
+			},
+			want:    big.NewInt(123450),
+			wantErr: false,
+		},
+		{
+			name: "Test 10: When the response of a job with parse options is not padded",
+			args: args{
+				job:          job3,
+				parseOptions: parseOptions,
+				response:     []byte(`{"last": "1.234,5"}`),
+				parsedData:   "1.234,5",
+				datum:        big.NewFloat(1234.5),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 11: When the value of a job with parse options is NaN",
+			args: args{
+				job:          job3,
+				parseOptions: parseOptions,
+				response:     []byte(`cb({"last": NaN})`),
+				parsedData:   nil,
+				datum:        big.NewFloat(0),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 12: When the XHTML value of a job with parse options is not a number",
+			args: args{
+				job:          job4,
+				parseOptions: parseOptions,
+				dataPoint:    "n/a",
+				datum:        big.NewFloat(0),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 13: When the value of a job is selected from the response of its GraphQL query",
+			args: args{
+				job:             job4,
+				graphQLQuery:    &types.GraphQLQuery{Query: `{ bundle(id: "1") { ethPriceUSD } }`},
+				graphQLResponse: []byte(`{"data": {"bundle": {"ethPriceUSD": "1834.52"}}}`),
+				responseErr:     errors.New("not fetched with GET"),
+				dataPointErr:    errors.New("not scraped"),
+				parsedData:      "1834.52",
+				datum:           big.NewFloat(1834.52),
+			},
+			want:    big.NewInt(183452),
+			wantErr: false,
+		},
+		{
+			name: "Test 14: When there is an error in posting the GraphQL query of a job",
+			args: args{
+				job:                job2,
+				graphQLQuery:       &types.GraphQLQuery{Query: `{ bundle(id: "1") { ethPriceUSD } }`},
+				graphQLResponseErr: errors.New("GraphQL query failed: bundle not found"),
+				response:           response,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 15: When the value of a job is post-processed with the fields of its response",
+			args: args{
+				job:         job2,
+				response:    []byte(`{"last": "0.5", "scale": 10}`),
+				parsedData:  "0.5",
+				datum:       big.NewFloat(0.5),
+				postProcess: "1 / value * response.scale",
+			},
+			want:    big.NewInt(2000),
+			wantErr: false,
+		},
+		{
+			name: "Test 16: When the post-processed value of a job is not finite",
+			args: args{
+				job:         job2,
+				response:    []byte(`{"last": "0"}`),
+				parsedData:  "0",
+				datum:       big.NewFloat(0),
+				postProcess: "1 / value",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 17: When the post-processing expression of a job is invalid",
+			args: args{
+				job:         job2,
+				response:    []byte(`{"last": "0.5"}`),
+				parsedData:  "0.5",
+				datum:       big.NewFloat(0.5),
+				postProcess: "1 / value *",
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -785,10 +941,18 @@ func TestGetDataToCommitFromJob(t *testing.T) {
 			}
 			utils := StartRazor(optionsPackageStruct)
 
-			utilsMock.On("GetDataFromAPI", mock.AnythingOfType("string")).Return(tt.args.response, tt.args.responseErr)
+			utilsMock.On("GetDataFromAPI", mock.AnythingOfType("string"), mock.Anything).Return(tt.args.response, tt.args.responseErr)
 			utilsMock.On("GetDataFromJSON", mock.Anything, mock.AnythingOfType("string")).Return(tt.args.parsedData, tt.args.parsedDataErr)
-			utilsMock.On("GetDataFromXHTML", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(tt.args.dataPoint, tt.args.dataPointErr)
+			utilsMock.On("GetDataFromXHTML", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.Anything).Return(tt.args.dataPoint, tt.args.dataPointErr)
 			utilsMock.On("ConvertToNumber", mock.Anything).Return(tt.args.datum, tt.args.datumErr)
+			utilsMock.On("GetDataFromGraphQL", mock.AnythingOfType("string"), mock.AnythingOfType("types.GraphQLQuery"), mock.Anything).Return(tt.args.graphQLResponse, tt.args.graphQLResponseErr)
+
+			SetJobOptions(tt.args.job, types.JobOptions{
+				ParseOptions: tt.args.parseOptions,
+				GraphQLQuery: tt.args.graphQLQuery,
+				PostProcess:  tt.args.postProcess,
+			})
+			defer SetJobOptions(tt.args.job, types.JobOptions{})
 
 			got, err := utils.GetDataToCommitFromJob(tt.args.job)
 			if (err != nil) != tt.wantErr {
@@ -1046,12 +1210,14 @@ func TestGetCustomJobsFromJSONFile(t *testing.T) {
 			},
 			want: []bindings.StructsJob{
 				{
+					Name:     "ethCollection custom job 0",
 					Url:      "http://127.0.0.1/eth1",
 					Selector: "eth1",
 					Power:    2,
 					Weight:   3,
 				},
 				{
+					Name:     "ethCollection custom job 1",
 					Url:      "http://127.0.0.1/eth2",
 					Selector: "eth2",
 					Power:    2,
@@ -1078,6 +1244,130 @@ func TestGetCustomJobsFromJSONFile(t *testing.T) {
 	}
 }
 
+func TestGetCollectionDisplayDataFromJSONFile(t *testing.T) {
+	type args struct {
+		collection   string
+		power        int8
+		jsonFileData string
+	}
+	tests := []struct {
+		name string
+		args args
+		want types.CollectionDisplayData
+	}{
+		{
+			name: "Test 1: When display power and bounds are present in json file string",
+			args: args{
+				collection:   "ethCollection",
+				power:        2,
+				jsonFileData: `{"assets": {"collection": {"ethCollection": {"power": 2, "displayPower": 3, "minValue": 1000, "maxValue": 5000.5}}}}`,
+			},
+			want: types.CollectionDisplayData{
+				Power:    3,
+				MinValue: big.NewFloat(1000),
+				MaxValue: big.NewFloat(5000.5),
+			},
+		},
+		{
+			name: "Test 2: When only bounds are present in json file string",
+			args: args{
+				collection:   "ethCollection",
+				power:        2,
+				jsonFileData: `{"assets": {"collection": {"ethCollection": {"minValue": 1000}}}}`,
+			},
+			want: types.CollectionDisplayData{
+				Power:    2,
+				MinValue: big.NewFloat(1000),
+			},
+		},
+		{
+			name: "Test 3: When collection is not present in json file string",
+			args: args{
+				collection:   "btcCollection",
+				power:        2,
+				jsonFileData: jsonDataString,
+			},
+			want: types.CollectionDisplayData{
+				Power: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetCollectionDisplayDataFromJSONFile(tt.args.collection, tt.args.power, tt.args.jsonFileData)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetCollectionDisplayDataFromJSONFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckValueBounds(t *testing.T) {
+	type args struct {
+		value       *big.Int
+		displayData types.CollectionDisplayData
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Test 1: When value is within bounds",
+			args: args{
+				value: big.NewInt(250000),
+				displayData: types.CollectionDisplayData{
+					Power:    2,
+					MinValue: big.NewFloat(1000),
+					MaxValue: big.NewFloat(5000),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "Test 2: When value is below minimum value",
+			args: args{
+				value: big.NewInt(25000),
+				displayData: types.CollectionDisplayData{
+					Power:    2,
+					MinValue: big.NewFloat(1000),
+					MaxValue: big.NewFloat(5000),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Test 3: When value is above maximum value",
+			args: args{
+				value: big.NewInt(2500000),
+				displayData: types.CollectionDisplayData{
+					Power:    2,
+					MinValue: big.NewFloat(1000),
+					MaxValue: big.NewFloat(5000),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "Test 4: When bounds are not set",
+			args: args{
+				value: big.NewInt(2500000),
+				displayData: types.CollectionDisplayData{
+					Power: 2,
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckValueBounds("ethCollection", tt.args.value, tt.args.displayData); got != tt.want {
+				t.Errorf("CheckValueBounds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConvertCustomJobToStructJob(t *testing.T) {
 	type args struct {
 		customJob types.CustomJob
@@ -1244,6 +1534,11 @@ func TestGetAggregatedDataOfCollection(t *testing.T) {
 		activeCollectionErr error
 		collectionData      *big.Int
 		aggregationErr      error
+		overrideFilePathErr error
+		overrideFile        types.DataOverrideFile
+		overrideFileErr     error
+		overrideData        *big.Int
+		overrideDataErr     error
 	}
 	tests := []struct {
 		name    string
@@ -1277,16 +1572,74 @@ func TestGetAggregatedDataOfCollection(t *testing.T) {
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Test 4: When the collection is overridden",
+			args: args{
+				activeCollection: bindings.StructsCollection{},
+				collectionData:   big.NewInt(100),
+				overrideFile:     types.DataOverrideFile{Collections: map[string]types.DataOverride{"0": {Value: "2.5"}}},
+				overrideData:     big.NewInt(250),
+			},
+			want:    big.NewInt(250),
+			wantErr: false,
+		},
+		{
+			name: "Test 5: When another collection is overridden",
+			args: args{
+				activeCollection: bindings.StructsCollection{},
+				collectionData:   big.NewInt(100),
+				overrideFile:     types.DataOverrideFile{Collections: map[string]types.DataOverride{"1": {Value: "2.5"}}},
+				overrideData:     big.NewInt(250),
+			},
+			want:    big.NewInt(100),
+			wantErr: false,
+		},
+		{
+			name: "Test 6: When there is an error in getting the value of the override",
+			args: args{
+				activeCollection: bindings.StructsCollection{},
+				collectionData:   big.NewInt(100),
+				overrideFile:     types.DataOverrideFile{Collections: map[string]types.DataOverride{"0": {URL: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last"}}},
+				overrideDataErr:  errors.New("api error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When there is an error in reading the data override file",
+			args: args{
+				activeCollection: bindings.StructsCollection{},
+				collectionData:   big.NewInt(100),
+				overrideFileErr:  errors.New("invalid data override file"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting the path of the data override file",
+			args: args{
+				activeCollection:    bindings.StructsCollection{},
+				collectionData:      big.NewInt(100),
+				overrideFilePathErr: errors.New("path error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			utilsMock := new(mocks.Utils)
+			pathUtilsMock := new(pathMocks.PathInterface)
 			optionsPackageStruct := OptionsPackageStruct{
 				UtilsInterface: utilsMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
+			path.PathUtilsInterface = pathUtilsMock
 
 			utilsMock.On("GetActiveCollection", mock.Anything, mock.Anything).Return(tt.args.activeCollection, tt.args.activeCollectionErr)
+			pathUtilsMock.On("GetDataOverrideFilePath").Return("/home/.razor/dataOverride.json", tt.args.overrideFilePathErr)
+			utilsMock.On("ReadDataOverrideFile", mock.AnythingOfType("string")).Return(tt.args.overrideFile, tt.args.overrideFileErr)
+			utilsMock.On("GetDataOfOverride", mock.Anything, mock.Anything).Return(tt.args.overrideData, tt.args.overrideDataErr)
 			utilsMock.On("Aggregate", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.collectionData, tt.args.aggregationErr)
 
 			got, err := utils.GetAggregatedDataOfCollection(client, collectionId, epoch)
@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	attestationEnabled     bool
+	attestationKey         *ecdsa.PrivateKey
+	attestedCollections    []types.AttestedCollection
+	attestedCollectionOpen bool
+	attestationMutex       sync.Mutex
+)
+
+//This function enables the attestations of the committed values, they are signed with the key of the key file if it is passed and with the key of the staker otherwise
+//The key file has the private key in hex as written by geth's crypto.SaveECDSA
+func EnableAttestations(keyFilePath string) error {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	attestationKey = nil
+	if keyFilePath != "" {
+		key, err := crypto.LoadECDSA(keyFilePath)
+		if err != nil {
+			return errors.New("error in loading attestation key: " + err.Error())
+		}
+		attestationKey = key
+		log.Infof("Attestations are signed with the attestation key of %s", crypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+	attestationEnabled = true
+	return nil
+}
+
+//This function returns if the committed values are attested
+func IsAttestationEnabled() bool {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	return attestationEnabled
+}
+
+//This function returns if the attestations are signed with a separate attestation key instead of the key of the staker
+func HasAttestationKey() bool {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	return attestationKey != nil
+}
+
+//This function signs the data as a personal message with the attestation key and returns the address of the key and the signature
+func SignWithAttestationKey(data []byte) (string, []byte, error) {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	if attestationKey == nil {
+		return "", nil, errors.New("attestation key is not set")
+	}
+	signature, err := crypto.Sign(SignHash(data), attestationKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return crypto.PubkeyToAddress(attestationKey.PublicKey).Hex(), signature, nil
+}
+
+//This function returns if the responses of the sources are recorded while the values of the commit are fetched, which they are for the attestations and the response archive
+//The attestation mutex should be held by the caller
+func isRecordingSources() bool {
+	return attestationEnabled || IsResponseArchiveEnabled()
+}
+
+//This function drops the attested collections of the previous commit, so that the attestation of the commit only has the sources fetched for it
+func StartAttestation() {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	attestedCollections = nil
+	attestedCollectionOpen = false
+}
+
+//This function starts the attestation of the collection, the sources which are fetched until the next collection is started are attested for it
+//The collections are fetched one after the other, the sources of a collection can be fetched concurrently
+func StartAttestedCollection(collectionId uint16) {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	if !isRecordingSources() {
+		return
+	}
+	attestedCollections = append(attestedCollections, types.AttestedCollection{CollectionId: collectionId, Sources: []types.AttestedSource{}})
+	attestedCollectionOpen = true
+}
+
+//This function sets the value committed for the collection, a collection whose value isn't fetched from its sources is attested without sources
+func SetAttestedCollectionValue(collectionId uint16, value *big.Int) {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	if !isRecordingSources() {
+		return
+	}
+	attestedCollectionOpen = false
+	for i := range attestedCollections {
+		if attestedCollections[i].CollectionId == collectionId {
+			attestedCollections[i].Value = value
+			return
+		}
+	}
+	attestedCollections = append(attestedCollections, types.AttestedCollection{CollectionId: collectionId, Value: value, Sources: []types.AttestedSource{}})
+}
+
+//This function adds the response of the source of the job and the value parsed from it to the collection being attested
+//The invalid UTF-8 of the response is replaced, so that the payload has the same JSON encoding when it is read back and verified
+func recordAttestedSource(job bindings.StructsJob, response []byte, value *big.Int) {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	if !isRecordingSources() || !attestedCollectionOpen {
+		return
+	}
+	collection := &attestedCollections[len(attestedCollections)-1]
+	collection.Sources = append(collection.Sources, types.AttestedSource{
+		JobId:     job.Id,
+		JobName:   job.Name,
+		Url:       job.Url,
+		Selector:  job.Selector,
+		Response:  strings.ToValidUTF8(string(response), "\uFFFD"),
+		Value:     value,
+		FetchedAt: time.Now().Unix(),
+		Body:      response,
+	})
+}
+
+//This function returns the attested collections of the commit sorted by collection id and drops them
+func TakeAttestedCollections() []types.AttestedCollection {
+	attestationMutex.Lock()
+	defer attestationMutex.Unlock()
+	collections := attestedCollections
+	attestedCollections = nil
+	attestedCollectionOpen = false
+	sort.SliceStable(collections, func(i, j int) bool { return collections[i].CollectionId < collections[j].CollectionId })
+	return collections
+}
+
+//This function returns the keccak256 hash of the JSON encoding of the attestation payload, which is the data that is signed
+func HashAttestationPayload(payload types.AttestationPayload) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Keccak256(data), nil
+}
+
+//This function checks that the hash of the attestation is the hash of its payload and that it is signed by its signer
+func VerifyAttestation(attestation types.Attestation) error {
+	hash, err := HashAttestationPayload(attestation.Payload)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(hexutil.Encode(hash), attestation.PayloadHash) {
+		return errors.New("payload hash of the attestation doesn't match its payload")
+	}
+	signature, err := hexutil.Decode(attestation.Signature)
+	if err != nil {
+		return errors.New("invalid signature of the attestation: " + err.Error())
+	}
+	if len(signature) == 65 && signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	signer, err := EcRecover(hash, signature)
+	if err != nil {
+		return errors.New("error in verifying signature of the attestation: " + err.Error())
+	}
+	if signer != common.HexToAddress(attestation.Signer) {
+		return fmt.Errorf("attestation is signed by %s instead of %s", signer.Hex(), attestation.Signer)
+	}
+	return nil
+}
+
+//This function adds the attestation to the attestations file, an attestation of the same epoch is replaced
+//The attestations of the epochs older than the journal are dropped, as the responses of the sources make them large
+func (*UtilsStruct) SaveAttestation(filePath string, attestation types.Attestation) error {
+	attestations, err := UtilsInterface.ReadAttestations(filePath)
+	if err != nil {
+		return err
+	}
+
+	epoch := attestation.Payload.Epoch
+	index := sort.Search(len(attestations), func(i int) bool { return attestations[i].Payload.Epoch >= epoch })
+	if index < len(attestations) && attestations[index].Payload.Epoch == epoch {
+		attestations[index] = attestation
+	} else {
+		attestations = append(attestations, types.Attestation{})
+		copy(attestations[index+1:], attestations[index:])
+		attestations[index] = attestation
+	}
+
+	if len(attestations) > core.JournalLength {
+		attestations = attestations[len(attestations)-core.JournalLength:]
+	}
+
+	var attestationData []byte
+	for _, attestation := range attestations {
+		jsonData, err := JsonInterface.Marshal(attestation)
+		if err != nil {
+			return err
+		}
+		attestationData = append(attestationData, jsonData...)
+		attestationData = append(attestationData, '\n')
+	}
+	err = OS.WriteFile(filePath, attestationData, 0600)
+	if err != nil {
+		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	return nil
+}
+
+//This function reads the attestations sorted by epoch, it returns no attestations if the attestations file doesn't exist yet
+func (*UtilsStruct) ReadAttestations(filePath string) ([]types.Attestation, error) {
+	attestationData, err := OS.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("Error in reading attestations file: ", err)
+		return nil, err
+	}
+
+	var attestations []types.Attestation
+	for _, line := range bytes.Split(attestationData, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var attestation types.Attestation
+		err = JsonInterface.Unmarshal(line, &attestation)
+		if err != nil {
+			log.Error(" Unmarshal error: ", err)
+			return nil, err
+		}
+		attestations = append(attestations, attestation)
+	}
+	sort.SliceStable(attestations, func(i, j int) bool { return attestations[i].Payload.Epoch < attestations[j].Payload.Epoch })
+	return attestations, nil
+}
@@ -0,0 +1,213 @@
+package utils
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestAttestedCollections(t *testing.T) {
+	defer func() {
+		attestationEnabled, attestationKey = false, nil
+		StartAttestation()
+	}()
+	job := bindings.StructsJob{Id: 1, Name: "ethusd_gemini", Url: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last"}
+
+	// Nothing is recorded while the attestations aren't enabled
+	StartAttestation()
+	StartAttestedCollection(1)
+	recordAttestedSource(job, []byte(`{"last":"1000"}`), big.NewInt(1000))
+	SetAttestedCollectionValue(1, big.NewInt(1000))
+	if collections := TakeAttestedCollections(); len(collections) != 0 {
+		t.Fatalf("TakeAttestedCollections() got = %+v while the attestations are disabled", collections)
+	}
+
+	if err := EnableAttestations(""); err != nil {
+		t.Fatalf("EnableAttestations() error = %v", err)
+	}
+	StartAttestation()
+	StartAttestedCollection(2)
+	recordAttestedSource(job, []byte("{\"last\":\"2000\xff\"}"), big.NewInt(2000))
+	SetAttestedCollectionValue(2, big.NewInt(2000))
+	// The sources fetched outside of a collection, e.g. for a fallback, aren't attested
+	recordAttestedSource(job, []byte(`{"last":"3000"}`), big.NewInt(3000))
+	// The collections whose previous value is committed have no sources
+	SetAttestedCollectionValue(1, big.NewInt(900))
+
+	collections := TakeAttestedCollections()
+	if len(collections) != 2 || len(collections[1].Sources) != 1 {
+		t.Fatalf("TakeAttestedCollections() got = %+v, want collections 1 and 2 with one source", collections)
+	}
+	source := collections[1].Sources[0]
+	source.FetchedAt = 0
+	wantSource := types.AttestedSource{JobId: 1, JobName: "ethusd_gemini", Url: job.Url, Selector: "last", Response: "{\"last\":\"2000\uFFFD\"}", Value: big.NewInt(2000), Body: []byte("{\"last\":\"2000\xff\"}")}
+	if !reflect.DeepEqual(source, wantSource) {
+		t.Errorf("TakeAttestedCollections() source got = %+v, want %+v", source, wantSource)
+	}
+	wantCollection := types.AttestedCollection{CollectionId: 1, Value: big.NewInt(900), Sources: []types.AttestedSource{}}
+	if !reflect.DeepEqual(collections[0], wantCollection) {
+		t.Errorf("TakeAttestedCollections() collection got = %+v, want %+v", collections[0], wantCollection)
+	}
+	if collections := TakeAttestedCollections(); len(collections) != 0 {
+		t.Errorf("TakeAttestedCollections() got = %+v after the collections are taken", collections)
+	}
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	defer func() { attestationEnabled, attestationKey = false, nil }()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFilePath := filepath.Join(t.TempDir(), "attestation.key")
+	if err := crypto.SaveECDSA(keyFilePath, key); err != nil {
+		t.Fatal(err)
+	}
+	if err := EnableAttestations(keyFilePath); err != nil {
+		t.Fatalf("EnableAttestations() error = %v", err)
+	}
+
+	payload := types.AttestationPayload{Epoch: 10, ChainId: "1", Leaves: []*big.Int{big.NewInt(100)}}
+	hash, err := HashAttestationPayload(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, signature, err := SignWithAttestationKey(hash)
+	if err != nil {
+		t.Fatalf("SignWithAttestationKey() error = %v", err)
+	}
+	if signer != crypto.PubkeyToAddress(key.PublicKey).Hex() {
+		t.Errorf("SignWithAttestationKey() signer = %s, want the address of the attestation key", signer)
+	}
+	attestation := types.Attestation{Payload: payload, PayloadHash: hexutil.Encode(hash), Signer: signer, Signature: hexutil.Encode(signature)}
+
+	tamperedPayload := attestation
+	tamperedPayload.Payload.Leaves = []*big.Int{big.NewInt(101)}
+	rehashed, err := HashAttestationPayload(tamperedPayload.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamperedHash := tamperedPayload
+	tamperedHash.PayloadHash = hexutil.Encode(rehashed)
+	otherSigner := attestation
+	otherSigner.Signer = "0x000000000000000000000000000000000000dEaD"
+	invalidSignature := attestation
+	invalidSignature.Signature = "0x01"
+
+	tests := []struct {
+		name        string
+		attestation types.Attestation
+		wantErr     bool
+	}{
+		{
+			name:        "Test 1: When the attestation is signed by its signer",
+			attestation: attestation,
+			wantErr:     false,
+		},
+		{
+			name:        "Test 2: When the payload is changed after it is hashed",
+			attestation: tamperedPayload,
+			wantErr:     true,
+		},
+		{
+			name:        "Test 3: When the payload is changed and hashed again",
+			attestation: tamperedHash,
+			wantErr:     true,
+		},
+		{
+			name:        "Test 4: When the attestation isn't signed by its signer",
+			attestation: otherSigner,
+			wantErr:     true,
+		},
+		{
+			name:        "Test 5: When the signature is invalid",
+			attestation: invalidSignature,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyAttestation(tt.attestation)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyAttestation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSaveAttestation(t *testing.T) {
+	StartRazor(OptionsPackageStruct{
+		UtilsInterface: &UtilsStruct{},
+		OS:             OSStruct{},
+		JsonInterface:  JsonStruct{},
+	})
+
+	journalLength := core.JournalLength
+	defer func() { core.JournalLength = journalLength }()
+	core.JournalLength = 2
+
+	attestation := func(epoch uint32, value int64) types.Attestation {
+		return types.Attestation{
+			Payload: types.AttestationPayload{
+				Epoch:       epoch,
+				Leaves:      []*big.Int{big.NewInt(value)},
+				Collections: []types.AttestedCollection{{CollectionId: 1, Value: big.NewInt(value), Sources: []types.AttestedSource{{JobId: 1, Response: `{"last":"<1000>"}`, Value: big.NewInt(value)}}}},
+			},
+			Signature: "0x01",
+		}
+	}
+	filePath := filepath.Join(t.TempDir(), "attestations.jsonl")
+
+	ut := &UtilsStruct{}
+	attestations, err := ut.ReadAttestations(filePath)
+	if err != nil || len(attestations) != 0 {
+		t.Fatalf("ReadAttestations() got = %+v, error = %v, want no attestations before the file exists", attestations, err)
+	}
+	for _, attestation := range []types.Attestation{attestation(11, 1100), attestation(10, 1000), attestation(11, 1101)} {
+		if err := ut.SaveAttestation(filePath, attestation); err != nil {
+			t.Fatalf("SaveAttestation() error = %v", err)
+		}
+	}
+	attestations, err = ut.ReadAttestations(filePath)
+	if err != nil {
+		t.Fatalf("ReadAttestations() error = %v", err)
+	}
+	want := []types.Attestation{attestation(10, 1000), attestation(11, 1101)}
+	if !reflect.DeepEqual(attestations, want) {
+		t.Errorf("ReadAttestations() got = %+v, want %+v", attestations, want)
+	}
+	// The payload which is read back has the same hash as the one which was saved
+	for i := range attestations {
+		savedHash, _ := HashAttestationPayload(want[i].Payload)
+		readHash, _ := HashAttestationPayload(attestations[i].Payload)
+		if !reflect.DeepEqual(savedHash, readHash) {
+			t.Errorf("HashAttestationPayload() of epoch %d changed after the attestation is read back", attestations[i].Payload.Epoch)
+		}
+	}
+
+	if err := ut.SaveAttestation(filePath, attestation(12, 1200)); err != nil {
+		t.Fatalf("SaveAttestation() error = %v", err)
+	}
+	attestations, err = ut.ReadAttestations(filePath)
+	if err != nil {
+		t.Fatalf("ReadAttestations() error = %v", err)
+	}
+	if len(attestations) != 2 || attestations[0].Payload.Epoch != 11 || attestations[1].Payload.Epoch != 12 {
+		t.Errorf("SaveAttestation() kept the attestations %+v, want the ones of epochs 11 and 12", attestations)
+	}
+
+	if err := os.WriteFile(filePath, []byte("not json\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ut.ReadAttestations(filePath); err == nil {
+		t.Error("ReadAttestations() error = nil, want an error for an invalid attestation")
+	}
+}
@@ -83,6 +83,10 @@ func (*UtilsStruct) GetBlock(client *ethclient.Client, epoch uint32) (bindings.S
 }
 
 func (*UtilsStruct) GetMinStakeAmount(client *ethclient.Client) (*big.Int, error) {
+	cacheKey := contractCallKey("minStake")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return new(big.Int).Set(cached.(*big.Int)), nil
+	}
 	var (
 		minStake *big.Int
 		err      error
@@ -99,10 +103,15 @@ func (*UtilsStruct) GetMinStakeAmount(client *ethclient.Client) (*big.Int, error
 	if err != nil {
 		return nil, err
 	}
+	storeContractCallResult(cacheKey, new(big.Int).Set(minStake))
 	return minStake, nil
 }
 
 func (*UtilsStruct) GetStateBuffer(client *ethclient.Client) (uint64, error) {
+	cacheKey := contractCallKey("stateBuffer")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint64), nil
+	}
 	var (
 		stateBuffer uint64
 		err         error
@@ -120,10 +129,15 @@ func (*UtilsStruct) GetStateBuffer(client *ethclient.Client) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, stateBuffer)
 	return stateBuffer, nil
 }
 
 func (*UtilsStruct) GetMaxAltBlocks(client *ethclient.Client) (uint8, error) {
+	cacheKey := contractCallKey("maxAltBlocks")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint8), nil
+	}
 	var (
 		maxAltBlocks uint8
 		err          error
@@ -140,6 +154,7 @@ func (*UtilsStruct) GetMaxAltBlocks(client *ethclient.Client) (uint8, error) {
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, maxAltBlocks)
 	return maxAltBlocks, nil
 }
 
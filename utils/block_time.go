@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"razor/core"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	averageBlockTime    time.Duration
+	blockTimeMeasuredAt time.Time
+	blockTimeMutex      sync.Mutex
+)
+
+//This function measures the average block time of the chain over the last core.BlockTimeSampleSize blocks
+func (*UtilsStruct) MeasureAverageBlockTime(client *ethclient.Client) (time.Duration, error) {
+	latestHeader, err := UtilsInterface.GetLatestBlockWithRetry(client)
+	if err != nil {
+		return 0, err
+	}
+	sampleSize := core.BlockTimeSampleSize
+	if latestHeader.Number.Int64() < sampleSize {
+		sampleSize = latestHeader.Number.Int64()
+	}
+	if sampleSize <= 0 {
+		return 0, errors.New("not enough blocks to measure the block time")
+	}
+	olderHeader, err := ClientInterface.HeaderByNumber(client, context.Background(), new(big.Int).Sub(latestHeader.Number, big.NewInt(sampleSize)))
+	if err != nil {
+		return 0, err
+	}
+	if latestHeader.Time <= olderHeader.Time {
+		return 0, errors.New("timestamps of the blocks are not increasing")
+	}
+	return time.Duration(latestHeader.Time-olderHeader.Time) * time.Second / time.Duration(sampleSize), nil
+}
+
+//This function returns the average block time of the chain, which is measured again once it is older than an epoch
+//core.DefaultBlockTime is returned till the block time is measured successfully
+func (*UtilsStruct) GetAverageBlockTime(client *ethclient.Client) time.Duration {
+	blockTimeMutex.Lock()
+	defer blockTimeMutex.Unlock()
+	if !blockTimeMeasuredAt.IsZero() && time.Since(blockTimeMeasuredAt) < time.Duration(core.EpochLength)*time.Second {
+		return getBlockTimeOrDefault()
+	}
+	// The measurement time is updated even if it fails, so that it is not retried on every call
+	blockTimeMeasuredAt = time.Now()
+	blockTime, err := UtilsInterface.MeasureAverageBlockTime(client)
+	if err != nil {
+		log.Error("Error in measuring the average block time: ", err)
+		return getBlockTimeOrDefault()
+	}
+	if blockTime != averageBlockTime {
+		log.Debugf("Average block time of the chain: %s", blockTime)
+	}
+	averageBlockTime = blockTime
+	return averageBlockTime
+}
+
+//This function returns the measured average block time or core.DefaultBlockTime if it isn't measured yet
+func getBlockTimeOrDefault() time.Duration {
+	if averageBlockTime == 0 {
+		return core.DefaultBlockTime
+	}
+	return averageBlockTime
+}
+
+//This function returns the number of blocks in a state at the given block time
+func getStateLengthInBlocks(blockTime time.Duration) uint64 {
+	blocks := uint64(time.Duration(core.StateLength) * time.Second / blockTime)
+	if blocks == 0 {
+		return 1
+	}
+	return blocks
+}
+
+//This function returns the interval at which the transaction receipts are polled, which is 3 blocks but not less than a second
+func getReceiptPollInterval(blockTime time.Duration) time.Duration {
+	interval := 3 * blockTime
+	if interval < time.Second {
+		return time.Second
+	}
+	return interval
+}
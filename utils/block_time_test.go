@@ -0,0 +1,238 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMeasureAverageBlockTime(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		latestHeader    *types.Header
+		latestHeaderErr error
+		olderHeader     *types.Header
+		olderHeaderErr  error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When block time is measured over the sample size",
+			args: args{
+				latestHeader: &types.Header{Number: big.NewInt(1000), Time: 1250},
+				olderHeader:  &types.Header{Number: big.NewInt(900), Time: 1000},
+			},
+			want:    2500 * time.Millisecond,
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the chain has less blocks than the sample size",
+			args: args{
+				latestHeader: &types.Header{Number: big.NewInt(10), Time: 120},
+				olderHeader:  &types.Header{Number: big.NewInt(0), Time: 100},
+			},
+			want:    2 * time.Second,
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When there is an error in getting latest block",
+			args: args{
+				latestHeaderErr: errors.New("block error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is only the genesis block",
+			args: args{
+				latestHeader: &types.Header{Number: big.NewInt(0), Time: 100},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in getting the older block",
+			args: args{
+				latestHeader:   &types.Header{Number: big.NewInt(1000), Time: 1250},
+				olderHeaderErr: errors.New("header error"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When the timestamps are not increasing",
+			args: args{
+				latestHeader: &types.Header{Number: big.NewInt(1000), Time: 1000},
+				olderHeader:  &types.Header{Number: big.NewInt(900), Time: 1000},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			clientMock := new(mocks.ClientUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface:  utilsMock,
+				ClientInterface: clientMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetLatestBlockWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.latestHeader, tt.args.latestHeaderErr)
+			clientMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.olderHeader, tt.args.olderHeaderErr)
+
+			got, err := utils.MeasureAverageBlockTime(client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MeasureAverageBlockTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MeasureAverageBlockTime() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAverageBlockTime(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		averageBlockTime     time.Duration
+		blockTimeMeasuredAt  time.Time
+		measuredBlockTime    time.Duration
+		measuredBlockTimeErr error
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Duration
+	}{
+		{
+			name: "Test 1: When block time is not measured yet",
+			args: args{
+				measuredBlockTime: 2 * time.Second,
+			},
+			want: 2 * time.Second,
+		},
+		{
+			name: "Test 2: When block time is measured in the last epoch",
+			args: args{
+				averageBlockTime:    3 * time.Second,
+				blockTimeMeasuredAt: time.Now(),
+				measuredBlockTime:   2 * time.Second,
+			},
+			want: 3 * time.Second,
+		},
+		{
+			name: "Test 3: When block time was measured before the last epoch",
+			args: args{
+				averageBlockTime:    3 * time.Second,
+				blockTimeMeasuredAt: time.Now().Add(-2 * time.Hour),
+				measuredBlockTime:   2 * time.Second,
+			},
+			want: 2 * time.Second,
+		},
+		{
+			name: "Test 4: When block time can't be measured and wasn't measured before",
+			args: args{
+				measuredBlockTimeErr: errors.New("block time error"),
+			},
+			want: time.Second,
+		},
+		{
+			name: "Test 5: When block time can't be measured but was measured before",
+			args: args{
+				averageBlockTime:     3 * time.Second,
+				blockTimeMeasuredAt:  time.Now().Add(-2 * time.Hour),
+				measuredBlockTimeErr: errors.New("block time error"),
+			},
+			want: 3 * time.Second,
+		},
+	}
+	defer func() {
+		averageBlockTime, blockTimeMeasuredAt = 0, time.Time{}
+	}()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			averageBlockTime, blockTimeMeasuredAt = tt.args.averageBlockTime, tt.args.blockTimeMeasuredAt
+			utilsMock.On("MeasureAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.measuredBlockTime, tt.args.measuredBlockTimeErr)
+
+			if got := utils.GetAverageBlockTime(client); got != tt.want {
+				t.Errorf("GetAverageBlockTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetStateLengthInBlocks(t *testing.T) {
+	tests := []struct {
+		name      string
+		blockTime time.Duration
+		want      uint64
+	}{
+		{
+			name:      "Test 1: When block time is 1 second",
+			blockTime: time.Second,
+			want:      240,
+		},
+		{
+			name:      "Test 2: When block time is 2.5 seconds",
+			blockTime: 2500 * time.Millisecond,
+			want:      96,
+		},
+		{
+			name:      "Test 3: When block time is longer than a state",
+			blockTime: time.Hour,
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getStateLengthInBlocks(tt.blockTime); got != tt.want {
+				t.Errorf("getStateLengthInBlocks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetReceiptPollInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		blockTime time.Duration
+		want      time.Duration
+	}{
+		{
+			name:      "Test 1: When block time is 1 second",
+			blockTime: time.Second,
+			want:      3 * time.Second,
+		},
+		{
+			name:      "Test 2: When block time is less than a third of a second",
+			blockTime: 100 * time.Millisecond,
+			want:      time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getReceiptPollInterval(tt.blockTime); got != tt.want {
+				t.Errorf("getReceiptPollInterval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
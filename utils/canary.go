@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"encoding/json"
+	"os"
+	"razor/core/types"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	canaryFilePath string
+	canaryMutex    sync.Mutex
+)
+
+//This function enables the canary mode in which the transactions are built and signed but not sent, they are exported to the canary file instead
+func EnableCanaryMode(filePath string) {
+	canaryMutex.Lock()
+	defer canaryMutex.Unlock()
+	canaryFilePath = filePath
+	log.Warn("Canary mode is enabled, no transactions will be sent. Transactions are exported to ", filePath)
+}
+
+//This function returns if the canary mode is enabled
+func IsCanaryMode() bool {
+	canaryMutex.Lock()
+	defer canaryMutex.Unlock()
+	return canaryFilePath != ""
+}
+
+//This function returns a signer which exports the signed transaction to the canary file
+//The gas estimation error is exported with the transaction as it shows that the transaction would have reverted if it was sent
+func getCanarySigner(signer bind.SignerFn, methodName string, estimationErr error) bind.SignerFn {
+	return func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+		signedTxn, err := signer(address, txn)
+		if err != nil {
+			return nil, err
+		}
+		canaryTransaction := types.CanaryTransaction{
+			Timestamp: time.Now().Unix(),
+			Method:    methodName,
+			From:      address.Hex(),
+			Nonce:     signedTxn.Nonce(),
+			GasLimit:  signedTxn.Gas(),
+			GasPrice:  signedTxn.GasPrice().String(),
+			Value:     signedTxn.Value().String(),
+			Data:      hexutil.Encode(signedTxn.Data()),
+			TxnHash:   signedTxn.Hash().Hex(),
+		}
+		if signedTxn.To() != nil {
+			canaryTransaction.To = signedTxn.To().Hex()
+		}
+		if estimationErr != nil {
+			canaryTransaction.EstimationError = estimationErr.Error()
+		}
+		log.Infof("Canary mode: not sending %s transaction %s", methodName, canaryTransaction.TxnHash)
+		if err := saveCanaryTransaction(canaryTransaction); err != nil {
+			log.Error("Error in exporting canary transaction: ", err)
+		}
+		return signedTxn, nil
+	}
+}
+
+//This function appends the transaction to the canary file
+func saveCanaryTransaction(canaryTransaction types.CanaryTransaction) error {
+	canaryMutex.Lock()
+	defer canaryMutex.Unlock()
+	data, err := json.Marshal(canaryTransaction)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(canaryFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/core/types"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestGetCanarySigner(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
+	to := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+
+	tests := []struct {
+		name          string
+		estimationErr error
+		want          string
+	}{
+		{
+			name: "Test 1: When gas is estimated",
+			want: "",
+		},
+		{
+			name:          "Test 2: When gas estimation fails",
+			estimationErr: errors.New("execution reverted"),
+			want:          "execution reverted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canaryFilePath = filepath.Join(t.TempDir(), "canary.jsonl")
+			defer func() { canaryFilePath = "" }()
+
+			txn := Types.NewTransaction(5, to, big.NewInt(0), 100000, big.NewInt(1e9), []byte{0x01, 0x02})
+			signer := getCanarySigner(txnOpts.Signer, "commit", tt.estimationErr)
+			signedTxn, err := signer(txnOpts.From, txn)
+			if err != nil {
+				t.Fatalf("getCanarySigner() error = %v", err)
+			}
+
+			data, err := os.ReadFile(canaryFilePath)
+			if err != nil {
+				t.Fatalf("Error in reading canary file: %v", err)
+			}
+			var canaryTransaction types.CanaryTransaction
+			if err := json.Unmarshal([]byte(strings.TrimSpace(string(data))), &canaryTransaction); err != nil {
+				t.Fatalf("Error in unmarshalling canary transaction: %v", err)
+			}
+			if canaryTransaction.TxnHash != signedTxn.Hash().Hex() || canaryTransaction.Method != "commit" || canaryTransaction.From != txnOpts.From.Hex() || canaryTransaction.To != to.Hex() || canaryTransaction.Nonce != 5 || canaryTransaction.Data != "0x0102" {
+				t.Errorf("getCanarySigner() exported = %+v", canaryTransaction)
+			}
+			if canaryTransaction.EstimationError != tt.want {
+				t.Errorf("getCanarySigner() estimation error = %v, want %v", canaryTransaction.EstimationError, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForBlockCompletionInCanaryMode(t *testing.T) {
+	canaryFilePath = filepath.Join(t.TempDir(), "canary.jsonl")
+	defer func() { canaryFilePath = "" }()
+
+	if err := waitForBlockCompletion(nil, "0x1", time.Second); err != nil {
+		t.Errorf("waitForBlockCompletion() error = %v, want nil", err)
+	}
+}
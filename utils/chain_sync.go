@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"razor/core"
+	"sync"
+	"time"
+
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	chainSyncCheckedAt time.Time
+	chainSyncErr       error
+	chainSyncMutex     sync.Mutex
+)
+
+//This function returns an error if the provider has a stale view of the chain, which is when the latest block is older than MaxHeadAge or the provider is syncing
+//The sync status of the provider is only fetched every ChainSyncCheckInterval, if it can't be fetched the last status is kept
+func CheckChainSync(client *ethclient.Client, header *Types.Header) error {
+	headAge := time.Since(time.Unix(int64(header.Time), 0))
+	if headAge > core.MaxHeadAge {
+		return fmt.Errorf("latest block %s of the provider is %s old", header.Number, headAge.Round(time.Second))
+	}
+
+	chainSyncMutex.Lock()
+	defer chainSyncMutex.Unlock()
+	if time.Since(chainSyncCheckedAt) < core.ChainSyncCheckInterval {
+		return chainSyncErr
+	}
+	chainSyncCheckedAt = time.Now()
+	progress, err := ClientInterface.SyncProgress(client, context.Background())
+	if err != nil {
+		log.Error("Error in checking if the provider is syncing: ", err)
+		return chainSyncErr
+	}
+	if progress != nil {
+		chainSyncErr = fmt.Errorf("provider is syncing, it is at block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+	} else {
+		chainSyncErr = nil
+	}
+	return chainSyncErr
+}
@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckChainSync(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		headAge         time.Duration
+		checkedRecently bool
+		lastErr         error
+		syncProgress    *ethereum.SyncProgress
+		syncProgressErr error
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantErr          bool
+		wantSyncProgress bool
+	}{
+		{
+			name: "Test 1: When the provider is synced and its latest block is recent",
+			args: args{
+				headAge: 5 * time.Second,
+			},
+			wantErr:          false,
+			wantSyncProgress: true,
+		},
+		{
+			name: "Test 2: When the latest block of the provider is too old",
+			args: args{
+				headAge: 10 * time.Minute,
+			},
+			wantErr:          true,
+			wantSyncProgress: false,
+		},
+		{
+			name: "Test 3: When the provider is syncing",
+			args: args{
+				headAge:      5 * time.Second,
+				syncProgress: &ethereum.SyncProgress{CurrentBlock: 100, HighestBlock: 200},
+			},
+			wantErr:          true,
+			wantSyncProgress: true,
+		},
+		{
+			name: "Test 4: When there is an error in checking if the provider is syncing, the last status is kept",
+			args: args{
+				headAge:         5 * time.Second,
+				lastErr:         errors.New("provider is syncing"),
+				syncProgressErr: errors.New("syncing error"),
+			},
+			wantErr:          true,
+			wantSyncProgress: true,
+		},
+		{
+			name: "Test 5: When the provider was checked within the interval",
+			args: args{
+				headAge:         5 * time.Second,
+				checkedRecently: true,
+				lastErr:         errors.New("provider is syncing"),
+			},
+			wantErr:          true,
+			wantSyncProgress: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			StartRazor(OptionsPackageStruct{ClientInterface: clientMock})
+
+			chainSyncCheckedAt = time.Time{}
+			if tt.args.checkedRecently {
+				chainSyncCheckedAt = time.Now()
+			}
+			chainSyncErr = tt.args.lastErr
+
+			clientMock.On("SyncProgress", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.args.syncProgress, tt.args.syncProgressErr)
+
+			header := &Types.Header{
+				Number: big.NewInt(100),
+				Time:   uint64(time.Now().Add(-tt.args.headAge).Unix()),
+			}
+			err := CheckChainSync(client, header)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckChainSync() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantSyncProgress {
+				clientMock.AssertCalled(t, "SyncProgress", mock.AnythingOfType("*ethclient.Client"), mock.Anything)
+			} else {
+				clientMock.AssertNotCalled(t, "SyncProgress", mock.Anything, mock.Anything)
+			}
+		})
+	}
+	chainSyncCheckedAt = time.Time{}
+	chainSyncErr = nil
+}
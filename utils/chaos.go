@@ -0,0 +1,225 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//chaosClient wraps the client calls with the faults of the chaos mode, which are dropped calls, delayed responses, stale nonces and transactions whose receipt is never returned
+//It is meant for testnets to verify the retry and resume logic of the node and must never be used while staking on a live network
+type chaosClient struct {
+	client       ClientUtils
+	config       types.ChaosConfig
+	mutex        sync.Mutex
+	timedOutTxns map[common.Hash]bool
+}
+
+//chaosRandom returns the random number in [0, 1) which the probabilities of the faults are compared to
+var chaosRandom = rand.Float64
+
+//This function wraps the client calls with the faults of the chaos mode, their probabilities are read from the chaos config file or the defaults are used if it isn't given
+func EnableChaosMode(filePath string) error {
+	config := types.ChaosConfig{
+		DroppedCallProbability:        core.DefaultChaosDroppedCallProbability,
+		DelayedResponseProbability:    core.DefaultChaosDelayedResponseProbability,
+		ResponseDelay:                 core.DefaultChaosResponseDelay,
+		NonceErrorProbability:         core.DefaultChaosNonceErrorProbability,
+		TransactionTimeoutProbability: core.DefaultChaosTransactionTimeoutProbability,
+	}
+	if filePath != "" {
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		err = JsonInterface.Unmarshal(data, &config)
+		if err != nil {
+			return err
+		}
+	}
+	err := validateChaosConfig(config)
+	if err != nil {
+		return err
+	}
+	rand.Seed(time.Now().UnixNano())
+	ClientInterface = &chaosClient{
+		client:       ClientInterface,
+		config:       config,
+		timedOutTxns: make(map[common.Hash]bool),
+	}
+	log.Warnf("Chaos mode is enabled with dropped call probability %.2f, delayed response probability %.2f, nonce error probability %.2f and transaction timeout probability %.2f, do not use this on a live network!", config.DroppedCallProbability, config.DelayedResponseProbability, config.NonceErrorProbability, config.TransactionTimeoutProbability)
+	return nil
+}
+
+//This function checks that the probabilities of the faults are between 0 and 1 and the response delay is not negative
+func validateChaosConfig(config types.ChaosConfig) error {
+	probabilities := map[string]float64{
+		"droppedCallProbability":        config.DroppedCallProbability,
+		"delayedResponseProbability":    config.DelayedResponseProbability,
+		"nonceErrorProbability":         config.NonceErrorProbability,
+		"transactionTimeoutProbability": config.TransactionTimeoutProbability,
+	}
+	for name, probability := range probabilities {
+		if probability < 0 || probability > 1 {
+			return fmt.Errorf("%s %.2f should be between 0 and 1", name, probability)
+		}
+	}
+	if config.ResponseDelay < 0 {
+		return errors.New("responseDelay cannot be negative")
+	}
+	return nil
+}
+
+//This function delays the response of the call and drops the call by their probabilities
+func (c *chaosClient) injectCallFault(method string) error {
+	if chaosRandom() < c.config.DelayedResponseProbability {
+		log.Warnf("Chaos: delaying the response of %s by %d seconds", method, c.config.ResponseDelay)
+		Time.Sleep(time.Duration(c.config.ResponseDelay) * time.Second)
+	}
+	if chaosRandom() < c.config.DroppedCallProbability {
+		log.Warnf("Chaos: dropping the %s call", method)
+		return fmt.Errorf("chaos: %s call dropped: %w", method, context.DeadlineExceeded)
+	}
+	return nil
+}
+
+//This function returns if the receipt of the transaction is never returned, it is decided by the probability the first time the receipt is fetched so that the wait for the transaction times out
+func (c *chaosClient) isTimedOutTransaction(txHash common.Hash) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	timedOut, ok := c.timedOutTxns[txHash]
+	if !ok {
+		timedOut = chaosRandom() < c.config.TransactionTimeoutProbability
+		c.timedOutTxns[txHash] = timedOut
+		if timedOut {
+			log.Warnf("Chaos: the receipt of transaction %s won't be returned", txHash.Hex())
+		}
+	}
+	return timedOut
+}
+
+func (c *chaosClient) TransactionReceipt(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*Types.Receipt, error) {
+	if err := c.injectCallFault("TransactionReceipt"); err != nil {
+		return nil, err
+	}
+	if c.isTimedOutTransaction(txHash) {
+		return nil, ethereum.NotFound
+	}
+	return c.client.TransactionReceipt(client, ctx, txHash)
+}
+
+func (c *chaosClient) BalanceAt(client *ethclient.Client, ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	if err := c.injectCallFault("BalanceAt"); err != nil {
+		return nil, err
+	}
+	return c.client.BalanceAt(client, ctx, account, blockNumber)
+}
+
+func (c *chaosClient) HeaderByNumber(client *ethclient.Client, ctx context.Context, number *big.Int) (*Types.Header, error) {
+	if err := c.injectCallFault("HeaderByNumber"); err != nil {
+		return nil, err
+	}
+	return c.client.HeaderByNumber(client, ctx, number)
+}
+
+//The nonce of the account is returned stale by the probability, so that the transaction which uses it is rejected with a nonce error
+func (c *chaosClient) PendingNonceAt(client *ethclient.Client, ctx context.Context, account common.Address) (uint64, error) {
+	if err := c.injectCallFault("PendingNonceAt"); err != nil {
+		return 0, err
+	}
+	nonce, err := c.client.PendingNonceAt(client, ctx, account)
+	if err == nil && nonce > 0 && chaosRandom() < c.config.NonceErrorProbability {
+		log.Warnf("Chaos: returning the stale nonce %d instead of %d", nonce-1, nonce)
+		return nonce - 1, nil
+	}
+	return nonce, err
+}
+
+func (c *chaosClient) SuggestGasPrice(client *ethclient.Client, ctx context.Context) (*big.Int, error) {
+	if err := c.injectCallFault("SuggestGasPrice"); err != nil {
+		return nil, err
+	}
+	return c.client.SuggestGasPrice(client, ctx)
+}
+
+func (c *chaosClient) FeeHistory(client *ethclient.Client, ctx context.Context, blockCount uint64, percentiles []float64) (*types.FeeHistory, error) {
+	if err := c.injectCallFault("FeeHistory"); err != nil {
+		return nil, err
+	}
+	return c.client.FeeHistory(client, ctx, blockCount, percentiles)
+}
+
+func (c *chaosClient) EstimateGas(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	if err := c.injectCallFault("EstimateGas"); err != nil {
+		return 0, err
+	}
+	return c.client.EstimateGas(client, ctx, msg)
+}
+
+func (c *chaosClient) FilterLogs(client *ethclient.Client, ctx context.Context, q ethereum.FilterQuery) ([]Types.Log, error) {
+	if err := c.injectCallFault("FilterLogs"); err != nil {
+		return nil, err
+	}
+	return c.client.FilterLogs(client, ctx, q)
+}
+
+func (c *chaosClient) TransactionByHash(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*Types.Transaction, bool, error) {
+	if err := c.injectCallFault("TransactionByHash"); err != nil {
+		return nil, false, err
+	}
+	return c.client.TransactionByHash(client, ctx, txHash)
+}
+
+func (c *chaosClient) SendTransaction(client *ethclient.Client, ctx context.Context, txn *Types.Transaction) error {
+	if err := c.injectCallFault("SendTransaction"); err != nil {
+		return err
+	}
+	return c.client.SendTransaction(client, ctx, txn)
+}
+
+func (c *chaosClient) SubscribeNewHead(client *ethclient.Client, ctx context.Context, ch chan<- *Types.Header) (ethereum.Subscription, error) {
+	if err := c.injectCallFault("SubscribeNewHead"); err != nil {
+		return nil, err
+	}
+	return c.client.SubscribeNewHead(client, ctx, ch)
+}
+
+func (c *chaosClient) SyncProgress(client *ethclient.Client, ctx context.Context) (*ethereum.SyncProgress, error) {
+	if err := c.injectCallFault("SyncProgress"); err != nil {
+		return nil, err
+	}
+	return c.client.SyncProgress(client, ctx)
+}
+
+func (c *chaosClient) CallContract(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if err := c.injectCallFault("CallContract"); err != nil {
+		return nil, err
+	}
+	return c.client.CallContract(client, ctx, msg, blockNumber)
+}
+
+func (c *chaosClient) ChainID(client *ethclient.Client, ctx context.Context) (*big.Int, error) {
+	if err := c.injectCallFault("ChainID"); err != nil {
+		return nil, err
+	}
+	return c.client.ChainID(client, ctx)
+}
+
+func (c *chaosClient) CodeAt(client *ethclient.Client, ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if err := c.injectCallFault("CodeAt"); err != nil {
+		return nil, err
+	}
+	return c.client.CodeAt(client, ctx, account, blockNumber)
+}
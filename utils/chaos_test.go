@@ -0,0 +1,208 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEnableChaosMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		fileData   string
+		noFile     bool
+		noFilePath bool
+		wantConfig types.ChaosConfig
+		wantErr    bool
+	}{
+		{
+			name:     "Test 1: When the chaos config is valid",
+			fileData: `{"droppedCallProbability": 0.2, "responseDelay": 10}`,
+			wantConfig: types.ChaosConfig{
+				DroppedCallProbability:        0.2,
+				DelayedResponseProbability:    core.DefaultChaosDelayedResponseProbability,
+				ResponseDelay:                 10,
+				NonceErrorProbability:         core.DefaultChaosNonceErrorProbability,
+				TransactionTimeoutProbability: core.DefaultChaosTransactionTimeoutProbability,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "Test 2: When no chaos config file is given",
+			noFilePath: true,
+			wantConfig: types.ChaosConfig{
+				DroppedCallProbability:        core.DefaultChaosDroppedCallProbability,
+				DelayedResponseProbability:    core.DefaultChaosDelayedResponseProbability,
+				ResponseDelay:                 core.DefaultChaosResponseDelay,
+				NonceErrorProbability:         core.DefaultChaosNonceErrorProbability,
+				TransactionTimeoutProbability: core.DefaultChaosTransactionTimeoutProbability,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Test 3: When a probability is greater than 1",
+			fileData: `{"nonceErrorProbability": 1.5}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 4: When the response delay is negative",
+			fileData: `{"responseDelay": -1}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 5: When the config file is not valid json",
+			fileData: `{"droppedCallProbability": `,
+			wantErr:  true,
+		},
+		{
+			name:    "Test 6: When the config file doesn't exist",
+			noFile:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}, ClientInterface: clientMock})
+			filePath := filepath.Join(t.TempDir(), "chaos.json")
+			if !tt.noFile && !tt.noFilePath {
+				if err := os.WriteFile(filePath, []byte(tt.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.noFilePath {
+				filePath = ""
+			}
+			err := EnableChaosMode(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnableChaosMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if ClientInterface != clientMock {
+					t.Errorf("EnableChaosMode() wrapped the client although the chaos config is invalid")
+				}
+				return
+			}
+			chaos, ok := ClientInterface.(*chaosClient)
+			if !ok || chaos.client != clientMock {
+				t.Fatalf("EnableChaosMode() didn't wrap the client")
+			}
+			if chaos.config != tt.wantConfig {
+				t.Errorf("EnableChaosMode() config = %+v, want %+v", chaos.config, tt.wantConfig)
+			}
+		})
+	}
+}
+
+func TestChaosClient(t *testing.T) {
+	var client *ethclient.Client
+	account := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+	txHash := common.HexToHash("0x01")
+	receipt := &Types.Receipt{Status: 1}
+	defaultChaosRandom := chaosRandom
+	defer func() { chaosRandom = defaultChaosRandom }()
+
+	tests := []struct {
+		name         string
+		config       types.ChaosConfig
+		random       float64
+		wantSleep    bool
+		wantNonce    uint64
+		wantNonceErr bool
+		wantNotFound bool
+	}{
+		{
+			name:      "Test 1: When no fault is injected",
+			config:    types.ChaosConfig{DroppedCallProbability: 0.1, DelayedResponseProbability: 0.1, ResponseDelay: 5, NonceErrorProbability: 0.1, TransactionTimeoutProbability: 0.1},
+			random:    0.5,
+			wantNonce: 10,
+		},
+		{
+			name:         "Test 2: When the calls are dropped",
+			config:       types.ChaosConfig{DroppedCallProbability: 1},
+			random:       0.5,
+			wantNonceErr: true,
+		},
+		{
+			name:      "Test 3: When the responses are delayed",
+			config:    types.ChaosConfig{DelayedResponseProbability: 1, ResponseDelay: 5},
+			random:    0.5,
+			wantSleep: true,
+			wantNonce: 10,
+		},
+		{
+			name:      "Test 4: When a stale nonce is returned",
+			config:    types.ChaosConfig{NonceErrorProbability: 1},
+			random:    0.5,
+			wantNonce: 9,
+		},
+		{
+			name:         "Test 5: When the receipt of the transaction is never returned",
+			config:       types.ChaosConfig{TransactionTimeoutProbability: 0.6},
+			random:       0.5,
+			wantNonce:    10,
+			wantNotFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			timeMock := new(mocks.TimeUtils)
+			StartRazor(OptionsPackageStruct{ClientInterface: clientMock, Time: timeMock})
+			chaosRandom = func() float64 { return tt.random }
+
+			clientMock.On("PendingNonceAt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, account).Return(uint64(10), nil)
+			clientMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, txHash).Return(receipt, nil)
+			timeMock.On("Sleep", mock.AnythingOfType("time.Duration")).Return()
+
+			chaos := &chaosClient{client: clientMock, config: tt.config, timedOutTxns: make(map[common.Hash]bool)}
+			nonce, err := chaos.PendingNonceAt(client, context.Background(), account)
+			if (err != nil) != tt.wantNonceErr {
+				t.Fatalf("PendingNonceAt() error = %v, wantErr %v", err, tt.wantNonceErr)
+			}
+			if tt.wantNonceErr {
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("PendingNonceAt() error = %v, want a deadline exceeded error", err)
+				}
+				clientMock.AssertNotCalled(t, "PendingNonceAt", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			if nonce != tt.wantNonce {
+				t.Errorf("PendingNonceAt() = %d, want %d", nonce, tt.wantNonce)
+			}
+			if tt.wantSleep {
+				timeMock.AssertCalled(t, "Sleep", 5*time.Second)
+			} else {
+				timeMock.AssertNotCalled(t, "Sleep", mock.Anything)
+			}
+
+			for i := 0; i < 2; i++ {
+				got, err := chaos.TransactionReceipt(client, context.Background(), txHash)
+				if tt.wantNotFound {
+					if !errors.Is(err, ethereum.NotFound) {
+						t.Errorf("TransactionReceipt() error = %v, want %v", err, ethereum.NotFound)
+					}
+					continue
+				}
+				if err != nil || got != receipt {
+					t.Errorf("TransactionReceipt() = %v, %v, want %v", got, err, receipt)
+				}
+			}
+			if tt.wantNotFound {
+				clientMock.AssertNotCalled(t, "TransactionReceipt", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"razor/core"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/pkg/bindings"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type jobCircuitBreaker struct {
+	name             string
+	failures         int
+	trips            int
+	quarantined      bool
+	quarantinedUntil time.Time
+}
+
+var (
+	jobCircuitBreakers     = make(map[uint16]*jobCircuitBreaker)
+	jobCircuitBreakerMutex sync.Mutex
+)
+
+//This function checks if the job is quarantined after repeated failures in fetching its data
+//The job is no longer reported as quarantined in the metric and the status once its quarantine ends
+func (*UtilsStruct) IsJobQuarantined(jobId uint16) bool {
+	jobCircuitBreakerMutex.Lock()
+	defer jobCircuitBreakerMutex.Unlock()
+	breaker, ok := jobCircuitBreakers[jobId]
+	if !ok {
+		return false
+	}
+	if time.Now().Before(breaker.quarantinedUntil) {
+		return true
+	}
+	if breaker.quarantined {
+		log.Infof("Quarantine of job %d (%s) ended, it is re-enabled", jobId, breaker.name)
+		breaker.quarantined = false
+		metrics.JobQuarantinedMetric.WithLabelValues(strconv.Itoa(int(jobId)), breaker.name).Set(0)
+		updateQuarantinedJobsStatus()
+	}
+	return false
+}
+
+//This function records the result of fetching the data of a job
+//A job is quarantined after JobFailureThreshold consecutive failures and the quarantine doubles every time it fails again after being re-enabled
+func (*UtilsStruct) UpdateJobCircuitBreaker(job bindings.StructsJob, success bool) {
+	jobCircuitBreakerMutex.Lock()
+	defer jobCircuitBreakerMutex.Unlock()
+	jobId := strconv.Itoa(int(job.Id))
+
+	breaker, ok := jobCircuitBreakers[job.Id]
+	if success {
+		if ok && breaker.trips > 0 {
+			log.Infof("Job %d (%s) recovered, it is no longer quarantined", job.Id, job.Name)
+		}
+		delete(jobCircuitBreakers, job.Id)
+		metrics.JobQuarantinedMetric.WithLabelValues(jobId, job.Name).Set(0)
+		if ok && breaker.quarantined {
+			updateQuarantinedJobsStatus()
+		}
+		return
+	}
+	if !ok {
+		breaker = &jobCircuitBreaker{name: job.Name}
+		jobCircuitBreakers[job.Id] = breaker
+	}
+	breaker.failures++
+
+	// A job which is re-enabled after quarantine is quarantined again on its first failure
+	if breaker.trips == 0 && breaker.failures < core.JobFailureThreshold {
+		return
+	}
+	quarantineDuration := core.JobQuarantineDuration
+	for i := 0; i < breaker.trips && quarantineDuration < core.MaxJobQuarantineDuration; i++ {
+		quarantineDuration *= 2
+	}
+	if quarantineDuration > core.MaxJobQuarantineDuration {
+		quarantineDuration = core.MaxJobQuarantineDuration
+	}
+	breaker.trips++
+	breaker.failures = 0
+	breaker.quarantined = true
+	breaker.quarantinedUntil = time.Now().Add(quarantineDuration)
+	log.Warnf("Job %d (%s) failed repeatedly, quarantining it for %s", job.Id, job.Name, quarantineDuration)
+	metrics.JobQuarantinedMetric.WithLabelValues(jobId, job.Name).Set(1)
+	updateQuarantinedJobsStatus()
+}
+
+//This function sets the quarantined jobs in the status of the node, it is called with the circuit breakers locked
+func updateQuarantinedJobsStatus() {
+	var quarantinedJobs []types.QuarantinedJob
+	for jobId, breaker := range jobCircuitBreakers {
+		if breaker.quarantined {
+			quarantinedJobs = append(quarantinedJobs, types.QuarantinedJob{Id: jobId, Name: breaker.name, QuarantinedUntil: breaker.quarantinedUntil.Unix()})
+		}
+	}
+	sort.Slice(quarantinedJobs, func(i, j int) bool { return quarantinedJobs[i].Id < quarantinedJobs[j].Id })
+	metrics.UpdateNodeStatus(func(status *types.NodeStatus) {
+		status.QuarantinedJobs = quarantinedJobs
+	})
+}
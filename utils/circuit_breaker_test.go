@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"razor/core"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/pkg/bindings"
+	"reflect"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestJobCircuitBreaker(t *testing.T) {
+	job := bindings.StructsJob{Id: 1, Name: "ethusd_gemini"}
+	utils := &UtilsStruct{}
+
+	type args struct {
+		results []bool
+	}
+	tests := []struct {
+		name                   string
+		args                   args
+		wantQuarantined        bool
+		wantTrips              int
+		wantQuarantineDuration time.Duration
+	}{
+		{
+			name: "Test 1: When job fails less than threshold times",
+			args: args{
+				results: []bool{false, false},
+			},
+			wantQuarantined: false,
+			wantTrips:       0,
+		},
+		{
+			name: "Test 2: When job fails threshold times",
+			args: args{
+				results: []bool{false, false, false},
+			},
+			wantQuarantined:        true,
+			wantTrips:              1,
+			wantQuarantineDuration: core.JobQuarantineDuration,
+		},
+		{
+			name: "Test 3: When job succeeds in between failures",
+			args: args{
+				results: []bool{false, false, true, false, false},
+			},
+			wantQuarantined: false,
+			wantTrips:       0,
+		},
+		{
+			name: "Test 4: When job fails again after being re-enabled",
+			args: args{
+				results: []bool{false, false, false, false},
+			},
+			wantQuarantined:        true,
+			wantTrips:              2,
+			wantQuarantineDuration: 2 * core.JobQuarantineDuration,
+		},
+		{
+			name: "Test 5: When quarantine duration reaches the maximum",
+			args: args{
+				results: []bool{false, false, false, false, false, false, false, false, false, false, false, false, false},
+			},
+			wantQuarantined:        true,
+			wantTrips:              11,
+			wantQuarantineDuration: core.MaxJobQuarantineDuration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobCircuitBreakers = make(map[uint16]*jobCircuitBreaker)
+
+			for _, result := range tt.args.results {
+				utils.UpdateJobCircuitBreaker(job, result)
+				// Checking that the job is re-enabled once its quarantine ends
+				if breaker, ok := jobCircuitBreakers[job.Id]; ok && utils.IsJobQuarantined(job.Id) {
+					breaker.quarantinedUntil = breaker.quarantinedUntil.Add(-core.MaxJobQuarantineDuration)
+					if utils.IsJobQuarantined(job.Id) {
+						t.Errorf("Job is still quarantined after its quarantine ended")
+					}
+					breaker.quarantinedUntil = breaker.quarantinedUntil.Add(core.MaxJobQuarantineDuration)
+				}
+			}
+
+			if got := utils.IsJobQuarantined(job.Id); got != tt.wantQuarantined {
+				t.Errorf("IsJobQuarantined() got = %v, want %v", got, tt.wantQuarantined)
+			}
+			breaker, ok := jobCircuitBreakers[job.Id]
+			if !ok {
+				if tt.wantTrips != 0 {
+					t.Errorf("Circuit breaker of job is not present, want trips %d", tt.wantTrips)
+				}
+				return
+			}
+			if breaker.trips != tt.wantTrips {
+				t.Errorf("Trips got = %d, want %d", breaker.trips, tt.wantTrips)
+			}
+			if tt.wantQuarantined {
+				quarantineDuration := time.Until(breaker.quarantinedUntil)
+				if quarantineDuration > tt.wantQuarantineDuration || quarantineDuration < tt.wantQuarantineDuration-time.Minute {
+					t.Errorf("Quarantine duration got = %s, want %s", quarantineDuration, tt.wantQuarantineDuration)
+				}
+			}
+		})
+	}
+}
+
+func TestJobQuarantineEnd(t *testing.T) {
+	job := bindings.StructsJob{Id: 2, Name: "ethusd_kraken"}
+	utils := &UtilsStruct{}
+	jobCircuitBreakers = make(map[uint16]*jobCircuitBreaker)
+	defer func() {
+		jobCircuitBreakers = make(map[uint16]*jobCircuitBreaker)
+		metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.QuarantinedJobs = nil })
+	}()
+
+	getQuarantinedMetric := func() float64 {
+		var metric dto.Metric
+		if err := metrics.JobQuarantinedMetric.WithLabelValues("2", job.Name).Write(&metric); err != nil {
+			t.Fatal(err)
+		}
+		return metric.GetGauge().GetValue()
+	}
+	getQuarantinedJobs := func() []types.QuarantinedJob {
+		var quarantinedJobs []types.QuarantinedJob
+		metrics.UpdateNodeStatus(func(status *types.NodeStatus) { quarantinedJobs = status.QuarantinedJobs })
+		return quarantinedJobs
+	}
+
+	for i := 0; i < core.JobFailureThreshold; i++ {
+		utils.UpdateJobCircuitBreaker(job, false)
+	}
+	breaker := jobCircuitBreakers[job.Id]
+	wantJobs := []types.QuarantinedJob{{Id: job.Id, Name: job.Name, QuarantinedUntil: breaker.quarantinedUntil.Unix()}}
+	if !utils.IsJobQuarantined(job.Id) || getQuarantinedMetric() != 1 || !reflect.DeepEqual(getQuarantinedJobs(), wantJobs) {
+		t.Errorf("Quarantined job isn't reported, metric = %v, status = %v", getQuarantinedMetric(), getQuarantinedJobs())
+	}
+
+	breaker.quarantinedUntil = time.Now().Add(-time.Second)
+	if utils.IsJobQuarantined(job.Id) {
+		t.Error("Job is still quarantined after its quarantine ended")
+	}
+	if getQuarantinedMetric() != 0 || len(getQuarantinedJobs()) != 0 {
+		t.Errorf("Job is reported as quarantined after its quarantine ended, metric = %v, status = %v", getQuarantinedMetric(), getQuarantinedJobs())
+	}
+}
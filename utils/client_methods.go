@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"math/big"
 	"razor/core"
+	"sync"
 )
 
 func (*UtilsStruct) GetPendingNonceAtWithRetry(client *ethclient.Client, accountAddress common.Address) (uint64, error) {
@@ -91,7 +92,18 @@ func (*UtilsStruct) EstimateGasWithRetry(client *ethclient.Client, message ether
 	return gasLimit, nil
 }
 
+//This function fetches the logs of the query from the read provider if it is set and has synced up to the query, and from the provider otherwise or if the read provider fails
 func (*UtilsStruct) FilterLogsWithRetry(client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
+	logsClient := getLogsClient(client, query)
+	logs, err := filterLogsWithRetry(logsClient, query)
+	if err != nil && logsClient != client {
+		log.Warn("Error in fetching logs from the read provider, fetching them from the provider: ", err)
+		return filterLogsWithRetry(client, query)
+	}
+	return logs, err
+}
+
+func filterLogsWithRetry(client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
 	var (
 		logs []types.Log
 		err  error
@@ -100,6 +112,10 @@ func (*UtilsStruct) FilterLogsWithRetry(client *ethclient.Client, query ethereum
 		func() error {
 			logs, err = ClientInterface.FilterLogs(client, context.Background(), query)
 			if err != nil {
+				// A query over the limits of the provider is rejected again on every retry, it is split by the caller instead
+				if isLogRangeLimitError(err) {
+					return retry.Unrecoverable(err)
+				}
 				log.Error("Error in fetching logs.... Retrying")
 				return err
 			}
@@ -111,6 +127,63 @@ func (*UtilsStruct) FilterLogsWithRetry(client *ethclient.Client, query ethereum
 	return logs, nil
 }
 
+//This function splits the block range of the query into chunks of logsChunkSize blocks and fetches the logs of the chunks concurrently
+//At most core.MaxConcurrentLogQueries queries are in flight at a time and the logs are returned in the order of the blocks, a chunk whose range is rejected by the provider is fetched in smaller chunks
+func (*UtilsStruct) FilterLogsInChunks(client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
+	if query.FromBlock == nil || query.ToBlock == nil || query.FromBlock.Cmp(query.ToBlock) > 0 {
+		return UtilsInterface.FilterLogsWithRetry(client, query)
+	}
+	chunkSize := getLogsChunkSize()
+	var chunkQueries []ethereum.FilterQuery
+	for fromBlock := query.FromBlock; fromBlock.Cmp(query.ToBlock) <= 0; {
+		chunkQuery := getChunkQuery(query, fromBlock, chunkSize)
+		chunkQueries = append(chunkQueries, chunkQuery)
+		fromBlock = new(big.Int).Add(chunkQuery.ToBlock, big.NewInt(1))
+	}
+	return filterLogsOfChunks(client, chunkQueries)
+}
+
+//This function returns the query of the chunk of at most chunkSize blocks which starts at fromBlock
+func getChunkQuery(query ethereum.FilterQuery, fromBlock *big.Int, chunkSize int64) ethereum.FilterQuery {
+	toBlock := new(big.Int).Add(fromBlock, big.NewInt(chunkSize-1))
+	if toBlock.Cmp(query.ToBlock) > 0 {
+		toBlock.Set(query.ToBlock)
+	}
+	chunkQuery := query
+	chunkQuery.FromBlock = new(big.Int).Set(fromBlock)
+	chunkQuery.ToBlock = toBlock
+	return chunkQuery
+}
+
+//This function fetches the logs of the chunks with at most core.MaxConcurrentLogQueries queries in flight and returns them in the order of the chunks
+func filterLogsOfChunks(client *ethclient.Client, chunkQueries []ethereum.FilterQuery) ([]types.Log, error) {
+	var (
+		wg        sync.WaitGroup
+		chunkLogs = make([][]types.Log, len(chunkQueries))
+		chunkErrs = make([]error, len(chunkQueries))
+		semaphore = make(chan struct{}, core.MaxConcurrentLogQueries)
+	)
+	for i := range chunkQueries {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			chunkLogs[i], chunkErrs[i] = filterLogsOfChunk(client, chunkQueries[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var logs []types.Log
+	for i := range chunkQueries {
+		if chunkErrs[i] != nil {
+			return nil, chunkErrs[i]
+		}
+		logs = append(logs, chunkLogs[i]...)
+	}
+	return logs, nil
+}
+
 func (*UtilsStruct) BalanceAtWithRetry(client *ethclient.Client, account common.Address) (*big.Int, error) {
 	var (
 		balance *big.Int
@@ -242,6 +242,93 @@ func TestUtilsStruct_FilterLogsWithRetry(t *testing.T) {
 	}
 }
 
+func TestUtilsStruct_FilterLogsInChunks(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		query   ethereum.FilterQuery
+		logsErr error
+	}
+	tests := []struct {
+		name            string
+		args            args
+		wantBlocks      []uint64
+		wantQueriesSent int
+		wantErr         bool
+	}{
+		{
+			name: "Test 1: When the block range fits in a single chunk",
+			args: args{
+				query: ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1050)},
+			},
+			wantBlocks:      []uint64{1000},
+			wantQueriesSent: 1,
+			wantErr:         false,
+		},
+		{
+			name: "Test 2: When the block range is split into multiple chunks",
+			args: args{
+				query: ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1650)},
+			},
+			wantBlocks:      []uint64{1000, 1100, 1200, 1300, 1400, 1500, 1600},
+			wantQueriesSent: 7,
+			wantErr:         false,
+		},
+		{
+			name: "Test 3: When the block range is not set",
+			args: args{
+				query: ethereum.FilterQuery{},
+			},
+			wantBlocks:      []uint64{0},
+			wantQueriesSent: 1,
+			wantErr:         false,
+		},
+		{
+			name: "Test 4: When there is an error in fetching logs of a chunk",
+			args: args{
+				query:   ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1650)},
+				logsErr: errors.New("logs error"),
+			},
+			wantBlocks:      nil,
+			wantQueriesSent: 7,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(func(client *ethclient.Client, query ethereum.FilterQuery) []types.Log {
+				if query.FromBlock == nil {
+					return []types.Log{{}}
+				}
+				if query.ToBlock.Int64()-query.FromBlock.Int64() >= 100 {
+					t.Errorf("FilterLogsInChunks() queried %d blocks in a single chunk", query.ToBlock.Int64()-query.FromBlock.Int64()+1)
+				}
+				return []types.Log{{BlockNumber: query.FromBlock.Uint64()}}
+			}, tt.args.logsErr)
+
+			got, err := utils.FilterLogsInChunks(client, tt.args.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FilterLogsInChunks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var gotBlocks []uint64
+			for _, vLog := range got {
+				gotBlocks = append(gotBlocks, vLog.BlockNumber)
+			}
+			if !reflect.DeepEqual(gotBlocks, tt.wantBlocks) {
+				t.Errorf("FilterLogsInChunks() got blocks = %v, want %v", gotBlocks, tt.wantBlocks)
+			}
+			utilsMock.AssertNumberOfCalls(t, "FilterLogsWithRetry", tt.wantQueriesSent)
+		})
+	}
+}
+
 func TestUtilsStruct_GetLatestBlockWithRetry(t *testing.T) {
 	var client *ethclient.Client
 
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"sort"
+	"sync"
+)
+
+type collectionFetchStat struct {
+	successes uint64
+	failures  uint64
+}
+
+var (
+	subscribedCollections map[uint16]bool
+	collectionFetchStats  = make(map[uint16]*collectionFetchStat)
+	subscriptionMutex     sync.Mutex
+)
+
+//This function subscribes the node to the collections, the values of the other assigned collections aren't fetched and the values reported by the network in the previous epoch are committed for them
+func SetSubscribedCollections(collectionIds []uint16) {
+	subscriptionMutex.Lock()
+	defer subscriptionMutex.Unlock()
+	if len(collectionIds) == 0 {
+		subscribedCollections = nil
+		return
+	}
+	subscribedCollections = make(map[uint16]bool)
+	for _, collectionId := range collectionIds {
+		subscribedCollections[collectionId] = true
+	}
+	log.Warnf("Subscribed to collections %v, the previous values are committed for the other assigned collections", collectionIds)
+}
+
+//This function returns if the node is subscribed to the collection, the node is subscribed to all the collections if no subscription is set
+func IsSubscribedToCollection(collectionId uint16) bool {
+	subscriptionMutex.Lock()
+	defer subscriptionMutex.Unlock()
+	return subscribedCollections == nil || subscribedCollections[collectionId]
+}
+
+//This function records the outcome of fetching the value of a collection which is used to order the fetches by reliability
+func RecordCollectionFetch(collectionId uint16, fetchErr error) {
+	subscriptionMutex.Lock()
+	defer subscriptionMutex.Unlock()
+	stat, ok := collectionFetchStats[collectionId]
+	if !ok {
+		stat = &collectionFetchStat{}
+		collectionFetchStats[collectionId] = stat
+	}
+	if fetchErr != nil {
+		stat.failures++
+	} else {
+		stat.successes++
+	}
+}
+
+//This function returns the failure rate of fetching the value of a collection, collections which are not fetched yet have a failure rate of 0
+func getCollectionFailureRate(collectionId uint16) float64 {
+	stat, ok := collectionFetchStats[collectionId]
+	if !ok || stat.successes+stat.failures == 0 {
+		return 0
+	}
+	return float64(stat.failures) / float64(stat.successes+stat.failures)
+}
+
+//This function returns the collections sorted by the reliability of their sources, the most reliable collections come first
+//Collections with the same reliability keep their order
+func SortCollectionsByReliability(collectionIds []uint16) []uint16 {
+	subscriptionMutex.Lock()
+	defer subscriptionMutex.Unlock()
+	sortedCollectionIds := make([]uint16, len(collectionIds))
+	copy(sortedCollectionIds, collectionIds)
+	sort.SliceStable(sortedCollectionIds, func(i, j int) bool {
+		return getCollectionFailureRate(sortedCollectionIds[i]) < getCollectionFailureRate(sortedCollectionIds[j])
+	})
+	return sortedCollectionIds
+}
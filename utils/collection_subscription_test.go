@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIsSubscribedToCollection(t *testing.T) {
+	tests := []struct {
+		name                  string
+		subscribedCollections []uint16
+		collectionId          uint16
+		want                  bool
+	}{
+		{
+			name:                  "Test 1: When no subscription is set",
+			subscribedCollections: nil,
+			collectionId:          3,
+			want:                  true,
+		},
+		{
+			name:                  "Test 2: When the node is subscribed to the collection",
+			subscribedCollections: []uint16{1, 3},
+			collectionId:          3,
+			want:                  true,
+		},
+		{
+			name:                  "Test 3: When the node is not subscribed to the collection",
+			subscribedCollections: []uint16{1, 2},
+			collectionId:          3,
+			want:                  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetSubscribedCollections(tt.subscribedCollections)
+			defer SetSubscribedCollections(nil)
+			if got := IsSubscribedToCollection(tt.collectionId); got != tt.want {
+				t.Errorf("IsSubscribedToCollection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortCollectionsByReliability(t *testing.T) {
+	fetches := map[uint16][]error{
+		1: {errors.New("fetch error"), nil},
+		2: {nil, nil},
+		3: {errors.New("fetch error"), errors.New("fetch error")},
+	}
+	tests := []struct {
+		name          string
+		fetches       map[uint16][]error
+		collectionIds []uint16
+		want          []uint16
+	}{
+		{
+			name:          "Test 1: When no collection is fetched yet",
+			fetches:       nil,
+			collectionIds: []uint16{3, 1, 2},
+			want:          []uint16{3, 1, 2},
+		},
+		{
+			name:          "Test 2: When the collections have different failure rates",
+			fetches:       fetches,
+			collectionIds: []uint16{3, 1, 4, 2},
+			want:          []uint16{4, 2, 1, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collectionFetchStats = make(map[uint16]*collectionFetchStat)
+			for collectionId, fetchErrs := range tt.fetches {
+				for _, fetchErr := range fetchErrs {
+					RecordCollectionFetch(collectionId, fetchErr)
+				}
+			}
+			if got := SortCollectionsByReliability(tt.collectionIds); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortCollectionsByReliability() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
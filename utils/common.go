@@ -3,11 +3,14 @@ package utils
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"os"
 	"razor/core"
 	"razor/core/types"
 	"razor/logger"
+	"razor/metrics"
+	"razor/path"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,11 +20,19 @@ import (
 )
 
 func (*UtilsStruct) ConnectToClient(provider string) *ethclient.Client {
+	if client, ok := getSessionClient(provider); ok {
+		return client
+	}
 	client, err := EthClient.Dial(provider)
 	if err != nil {
 		log.Fatal("Error in connecting...", err)
 	}
 	log.Info("Connected to: ", provider)
+	err = ValidateNetwork(client)
+	if err != nil {
+		log.Fatal("Error in validating the network: ", err)
+	}
+	storeSessionClient(provider, client)
 	return client
 }
 
@@ -57,26 +68,55 @@ func (*UtilsStruct) CheckTransactionReceipt(client *ethclient.Client, _txHash st
 	if err != nil {
 		return -1
 	}
+	metrics.TransactionGasUsedMetric.Observe(float64(tx.GasUsed))
+	settleGasBudgetTransaction(txHash, tx.GasUsed)
 	return int(tx.Status)
 }
 
+var ErrTransactionMiningTimeout = errors.New("timeout passed for transaction mining")
+
 func (*UtilsStruct) WaitForBlockCompletion(client *ethclient.Client, hashToRead string) error {
-	timeout := core.BlockCompletionTimeout
-	for start := time.Now(); time.Since(start) < time.Duration(timeout)*time.Second; {
+	return waitForBlockCompletion(client, hashToRead, time.Duration(core.BlockCompletionTimeout)*time.Second)
+}
+
+//This function waits for the transaction to be mined for at most the given timeout
+//ErrTransactionMiningTimeout is returned if the transaction is still pending after the timeout
+func (*UtilsStruct) WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error {
+	return waitForBlockCompletion(client, hashToRead, timeout)
+}
+
+func waitForBlockCompletion(client *ethclient.Client, hashToRead string, timeout time.Duration) error {
+	if IsNoSendMode() {
+		log.Infof("Transaction %s was not sent, proceeding as if it was mined", hashToRead)
+		printTransactionOutput(client, hashToRead, "notSent")
+		return nil
+	}
+	metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.PendingTransactions++ })
+	defer metrics.UpdateNodeStatus(func(status *types.NodeStatus) { status.PendingTransactions-- })
+	for start := time.Now(); time.Since(start) < timeout; {
 		log.Debug("Checking if transaction is mined....")
 		transactionStatus := UtilsInterface.CheckTransactionReceipt(client, hashToRead)
 		if transactionStatus == 0 {
+			saveTransactionReceipt(client, hashToRead)
 			err := errors.New("transaction mining unsuccessful")
+			if reason := UtilsInterface.GetTransactionRevertReason(client, hashToRead); reason != "" {
+				err = fmt.Errorf("transaction mining unsuccessful, %s%s", executionReverted, reason)
+			}
 			log.Error(err)
+			printTransactionOutput(client, hashToRead, "failed")
 			return err
 		} else if transactionStatus == 1 {
 			log.Info("Transaction mined successfully")
+			saveTransactionReceipt(client, hashToRead)
+			printTransactionOutput(client, hashToRead, "mined")
 			return nil
 		}
-		Time.Sleep(3 * time.Second)
+		// The receipt is checked again in the next block when the new heads are subscribed
+		sleepUntilNextHead(getReceiptPollInterval(UtilsInterface.GetAverageBlockTime(client)))
 	}
 	log.Info("Timeout Passed")
-	return errors.New("timeout passed for transaction mining")
+	printTransactionOutput(client, hashToRead, "unresolved")
+	return ErrTransactionMiningTimeout
 }
 
 func (*UtilsStruct) WaitTillNextNSecs(waitTime int32) {
@@ -196,7 +236,7 @@ func (*UtilsStruct) CalculateBlockNumberAtEpochBeginning(client *ethclient.Clien
 		return nil, err
 	}
 	current_epoch := block.Time / uint64(core.EpochLength)
-	previousBlockNumber := block.Number.Uint64() - core.StateLength
+	previousBlockNumber := block.Number.Uint64() - getStateLengthInBlocks(UtilsInterface.GetAverageBlockTime(client))
 
 	previousBlock, err := ClientInterface.HeaderByNumber(client, context.Background(), big.NewInt(int64(previousBlockNumber)))
 	if err != nil {
@@ -221,30 +261,37 @@ func (*UtilsStruct) SaveDataToCommitJsonFile(filePath string, epoch uint32, comm
 	data.AssignedCollections = commitData.AssignedCollections
 	data.SeqAllottedCollections = commitData.SeqAllottedCollections
 	data.Leaves = commitData.Leaves
+	data.MerkleTree = commitData.MerkleTree
 
-	jsonData, err := JsonInterface.Marshal(data)
+	stateData, err := encodeCommitFileData(data)
 	if err != nil {
 		return err
 	}
-	err = OS.WriteFile(filePath, jsonData, 0600)
+	stateData, err = EncryptStateData(stateData)
 	if err != nil {
-		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	err = saveStateRecord(filePath, stateData)
+	if err != nil {
+		log.Error("Error in saving to state store: ", err)
 		return err
 	}
 	return nil
 }
 
 func (*UtilsStruct) ReadFromCommitJsonFile(filePath string) (types.CommitFileData, error) {
-	jsonFile, err := OS.Open(filePath)
+	byteValue, err := readStateRecord(filePath)
 	if err != nil {
-		log.Error("Error in opening json file: ", err)
 		return types.CommitFileData{}, err
 	}
-	byteValue, err := IOInterface.ReadAll(jsonFile)
+	byteValue, err = DecryptStateData(byteValue)
 	if err != nil {
-		log.Error("Error in reading data from json file: ", err)
+		log.Error("Error in decrypting data from json file: ", err)
 		return types.CommitFileData{}, err
 	}
+	if isCompactStateData(byteValue) {
+		return decodeCommitFileData(byteValue)
+	}
 	var commitedData types.CommitFileData
 
 	err = JsonInterface.Unmarshal(byteValue, &commitedData)
@@ -273,29 +320,35 @@ func (*UtilsStruct) SaveDataToProposeJsonFile(filePath string, epoch uint32, pro
 	data.RevealedCollectionIds = proposeData.RevealedCollectionIds
 	data.RevealedDataMaps = proposeData.RevealedDataMaps
 
-	jsonData, err := JsonInterface.Marshal(data)
+	stateData, err := encodeProposeFileData(data)
 	if err != nil {
 		return err
 	}
-	err = OS.WriteFile(filePath, jsonData, 0600)
+	stateData, err = EncryptStateData(stateData)
 	if err != nil {
-		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	err = saveStateRecord(filePath, stateData)
+	if err != nil {
+		log.Error("Error in saving to state store: ", err)
 		return err
 	}
 	return nil
 }
 
 func (*UtilsStruct) ReadFromProposeJsonFile(filePath string) (types.ProposeFileData, error) {
-	jsonFile, err := OS.Open(filePath)
+	byteValue, err := readStateRecord(filePath)
 	if err != nil {
-		log.Error("Error in opening json file: ", err)
 		return types.ProposeFileData{}, err
 	}
-	byteValue, err := IOInterface.ReadAll(jsonFile)
+	byteValue, err = DecryptStateData(byteValue)
 	if err != nil {
-		log.Error("Error in reading data from json file: ", err)
+		log.Error("Error in decrypting data from json file: ", err)
 		return types.ProposeFileData{}, err
 	}
+	if isCompactStateData(byteValue) {
+		return decodeProposeFileData(byteValue)
+	}
 	var proposedData types.ProposeFileData
 
 	err = JsonInterface.Unmarshal(byteValue, &proposedData)
@@ -314,23 +367,26 @@ func (*UtilsStruct) SaveDataToDisputeJsonFile(filePath string, bountyIdQueue []u
 	if err != nil {
 		return err
 	}
-	err = OS.WriteFile(filePath, jsonData, 0600)
+	jsonData, err = EncryptStateData(jsonData)
 	if err != nil {
-		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	err = saveStateRecord(filePath, jsonData)
+	if err != nil {
+		log.Error("Error in saving to state store: ", err)
 		return err
 	}
 	return nil
 }
 
 func (*UtilsStruct) ReadFromDisputeJsonFile(filePath string) (types.DisputeFileData, error) {
-	jsonFile, err := OS.Open(filePath)
+	byteValue, err := readStateRecord(filePath)
 	if err != nil {
-		log.Error("Error in opening json file: ", err)
 		return types.DisputeFileData{}, err
 	}
-	byteValue, err := IOInterface.ReadAll(jsonFile)
+	byteValue, err = DecryptStateData(byteValue)
 	if err != nil {
-		log.Error("Error in reading data from json file: ", err)
+		log.Error("Error in decrypting data from json file: ", err)
 		return types.DisputeFileData{}, err
 	}
 	var disputeData types.DisputeFileData
@@ -342,3 +398,228 @@ func (*UtilsStruct) ReadFromDisputeJsonFile(filePath string) (types.DisputeFileD
 	}
 	return disputeData, nil
 }
+
+//This function saves the scheduled commission update of the staker in the state store
+func (*UtilsStruct) SaveDataToCommissionScheduleFile(filePath string, data types.CommissionSchedule) error {
+	jsonData, err := JsonInterface.Marshal(data)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	err = saveStateRecord(filePath, jsonData)
+	if err != nil {
+		log.Error("Error in saving to state store: ", err)
+		return err
+	}
+	return nil
+}
+
+//This function reads the scheduled commission update of the staker from the state store
+func (*UtilsStruct) ReadFromCommissionScheduleFile(filePath string) (types.CommissionSchedule, error) {
+	byteValue, err := readStateRecord(filePath)
+	if err != nil {
+		return types.CommissionSchedule{}, err
+	}
+	byteValue, err = DecryptStateData(byteValue)
+	if err != nil {
+		log.Error("Error in decrypting data from json file: ", err)
+		return types.CommissionSchedule{}, err
+	}
+	var schedule types.CommissionSchedule
+	err = JsonInterface.Unmarshal(byteValue, &schedule)
+	if err != nil {
+		log.Error(" Unmarshal error: ", err)
+		return types.CommissionSchedule{}, err
+	}
+	return schedule, nil
+}
+
+//This function saves the unstaked amounts tracked in the withdraw queue in the state store
+func (*UtilsStruct) SaveDataToWithdrawQueueFile(filePath string, queue []types.WithdrawQueueEntry) error {
+	jsonData, err := JsonInterface.Marshal(queue)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	err = saveStateRecord(filePath, jsonData)
+	if err != nil {
+		log.Error("Error in saving to state store: ", err)
+		return err
+	}
+	return nil
+}
+
+//This function reads the unstaked amounts tracked in the withdraw queue from the state store
+func (*UtilsStruct) ReadFromWithdrawQueueFile(filePath string) ([]types.WithdrawQueueEntry, error) {
+	byteValue, err := readStateRecord(filePath)
+	if err != nil {
+		return nil, err
+	}
+	byteValue, err = DecryptStateData(byteValue)
+	if err != nil {
+		log.Error("Error in decrypting data from json file: ", err)
+		return nil, err
+	}
+	var queue []types.WithdrawQueueEntry
+	err = JsonInterface.Unmarshal(byteValue, &queue)
+	if err != nil {
+		log.Error(" Unmarshal error: ", err)
+		return nil, err
+	}
+	return queue, nil
+}
+
+func (*UtilsStruct) SaveDataToDelegationMigrationFile(filePath string, data types.DelegationMigrationData) error {
+	jsonData, err := JsonInterface.Marshal(data)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	err = OS.WriteFile(filePath, jsonData, 0600)
+	if err != nil {
+		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	return nil
+}
+
+func (*UtilsStruct) ReadFromDelegationMigrationFile(filePath string) (types.DelegationMigrationData, error) {
+	jsonFile, err := OS.Open(filePath)
+	if err != nil {
+		log.Error("Error in opening json file: ", err)
+		return types.DelegationMigrationData{}, err
+	}
+	byteValue, err := IOInterface.ReadAll(jsonFile)
+	if err != nil {
+		log.Error("Error in reading data from json file: ", err)
+		return types.DelegationMigrationData{}, err
+	}
+	byteValue, err = DecryptStateData(byteValue)
+	if err != nil {
+		log.Error("Error in decrypting data from json file: ", err)
+		return types.DelegationMigrationData{}, err
+	}
+	var migrationData types.DelegationMigrationData
+
+	err = JsonInterface.Unmarshal(byteValue, &migrationData)
+	if err != nil {
+		log.Error(" Unmarshal error: ", err)
+		return types.DelegationMigrationData{}, err
+	}
+	return migrationData, nil
+}
+
+func (*UtilsStruct) SaveDataToGiveSortedProgressFile(filePath string, progress types.GiveSortedProgress) error {
+	jsonData, err := JsonInterface.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	err = OS.WriteFile(filePath, jsonData, 0600)
+	if err != nil {
+		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	return nil
+}
+
+//This function reads the progress of giveSorted, no progress is returned if the file doesn't exist yet
+func (*UtilsStruct) ReadFromGiveSortedProgressFile(filePath string) (types.GiveSortedProgress, error) {
+	byteValue, err := OS.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return types.GiveSortedProgress{}, nil
+	}
+	if err != nil {
+		log.Error("Error in reading data from json file: ", err)
+		return types.GiveSortedProgress{}, err
+	}
+	byteValue, err = DecryptStateData(byteValue)
+	if err != nil {
+		log.Error("Error in decrypting data from json file: ", err)
+		return types.GiveSortedProgress{}, err
+	}
+	var progress types.GiveSortedProgress
+
+	err = JsonInterface.Unmarshal(byteValue, &progress)
+	if err != nil {
+		log.Error(" Unmarshal error: ", err)
+		return types.GiveSortedProgress{}, err
+	}
+	return progress, nil
+}
+
+func (*UtilsStruct) SaveDataToCollectionHistoryFile(filePath string, collectionId uint16, historyData types.CollectionHistoryData) error {
+	var data types.CollectionHistoryFileData
+	if _, err := path.OSUtilsInterface.Stat(filePath); !errors.Is(err, os.ErrNotExist) {
+		data, err = UtilsInterface.ReadFromCollectionHistoryFile(filePath)
+		if err != nil {
+			return err
+		}
+	}
+	data.CollectionId = collectionId
+
+	var history []types.CollectionHistoryData
+	for _, entry := range data.History {
+		if entry.Epoch != historyData.Epoch {
+			history = append(history, entry)
+		}
+	}
+	history = append(history, historyData)
+	if len(history) > core.CollectionHistoryLength {
+		history = history[len(history)-core.CollectionHistoryLength:]
+	}
+	data.History = history
+
+	jsonData, err := JsonInterface.Marshal(data)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	err = OS.WriteFile(filePath, jsonData, 0600)
+	if err != nil {
+		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	return nil
+}
+
+func (*UtilsStruct) ReadFromCollectionHistoryFile(filePath string) (types.CollectionHistoryFileData, error) {
+	jsonFile, err := OS.Open(filePath)
+	if err != nil {
+		log.Error("Error in opening json file: ", err)
+		return types.CollectionHistoryFileData{}, err
+	}
+	byteValue, err := IOInterface.ReadAll(jsonFile)
+	if err != nil {
+		log.Error("Error in reading data from json file: ", err)
+		return types.CollectionHistoryFileData{}, err
+	}
+	byteValue, err = DecryptStateData(byteValue)
+	if err != nil {
+		log.Error("Error in decrypting data from json file: ", err)
+		return types.CollectionHistoryFileData{}, err
+	}
+	var historyData types.CollectionHistoryFileData
+
+	err = JsonInterface.Unmarshal(byteValue, &historyData)
+	if err != nil {
+		log.Error(" Unmarshal error: ", err)
+		return types.CollectionHistoryFileData{}, err
+	}
+	return historyData, nil
+}
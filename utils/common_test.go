@@ -7,13 +7,18 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/mock"
+	"io/fs"
 	"math/big"
 	"os"
+	"razor/core"
 	Types "razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
 	"razor/pkg/bindings"
 	"razor/utils/mocks"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestCheckError(t *testing.T) {
@@ -246,8 +251,10 @@ func TestCheckTransactionReceipt(t *testing.T) {
 func TestConnectToClient(t *testing.T) {
 	var provider string
 	type args struct {
-		client    *ethclient.Client
-		clientErr error
+		client     *ethclient.Client
+		clientErr  error
+		chainId    *big.Int
+		chainIdErr error
 	}
 	tests := []struct {
 		name          string
@@ -257,7 +264,8 @@ func TestConnectToClient(t *testing.T) {
 		{
 			name: "Test 1: When ConnectToClient() executes successfully",
 			args: args{
-				client: &ethclient.Client{},
+				client:  &ethclient.Client{},
+				chainId: core.ChainId,
 			},
 			expectedFatal: false,
 		},
@@ -265,6 +273,23 @@ func TestConnectToClient(t *testing.T) {
 			name: "Test 2: When there is an error in ConnectToClient() function",
 			args: args{
 				clientErr: errors.New("error in connecting to client"),
+				chainId:   core.ChainId,
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 3: When the provider is on another chain than the network",
+			args: args{
+				client:  &ethclient.Client{},
+				chainId: big.NewInt(1),
+			},
+			expectedFatal: true,
+		},
+		{
+			name: "Test 4: When there is an error in getting the chain id",
+			args: args{
+				client:     &ethclient.Client{},
+				chainIdErr: errors.New("chain id error"),
 			},
 			expectedFatal: true,
 		},
@@ -277,13 +302,17 @@ func TestConnectToClient(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ethClientMock := new(mocks.EthClientUtils)
+			clientMock := new(mocks.ClientUtils)
 
 			optionsPackageStruct := OptionsPackageStruct{
-				EthClient: ethClientMock,
+				EthClient:       ethClientMock,
+				ClientInterface: clientMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
 
 			ethClientMock.On("Dial", mock.AnythingOfType("string")).Return(tt.args.client, tt.args.clientErr)
+			clientMock.On("ChainID", mock.Anything, mock.Anything).Return(tt.args.chainId, tt.args.chainIdErr)
+			clientMock.On("CodeAt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{1}, nil)
 
 			fatal = false
 
@@ -597,6 +626,7 @@ func TestWaitForBlockCompletion(t *testing.T) {
 
 	type args struct {
 		transactionStatus int
+		revertReason      string
 	}
 	tests := []struct {
 		name string
@@ -624,6 +654,14 @@ func TestWaitForBlockCompletion(t *testing.T) {
 			},
 			want: errors.New("timeout passed for transaction mining"),
 		},
+		{
+			name: "Test 4: When the transaction reverts with a reason",
+			args: args{
+				transactionStatus: 0,
+				revertReason:      "Block already confirmed",
+			},
+			want: errors.New("transaction mining unsuccessful, execution reverted: Block already confirmed"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -637,7 +675,10 @@ func TestWaitForBlockCompletion(t *testing.T) {
 			utils := StartRazor(optionsPackageStruct)
 
 			utilsMock.On("CheckTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.transactionStatus)
+			utilsMock.On("GetTransactionRevertReason", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.args.revertReason)
+			utilsMock.On("SaveTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
 			timeMock.On("Sleep", mock.Anything).Return()
+			utilsMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
 
 			gotErr := utils.WaitForBlockCompletion(client, hashToRead)
 			if gotErr == nil || tt.want == nil {
@@ -649,6 +690,75 @@ func TestWaitForBlockCompletion(t *testing.T) {
 					t.Errorf("Error for stake function, got = %v, want %v", gotErr, tt.want)
 				}
 			}
+			if tt.args.transactionStatus == 0 || tt.args.transactionStatus == 1 {
+				utilsMock.AssertCalled(t, "SaveTransactionReceipt", client, hashToRead)
+			} else {
+				utilsMock.AssertNotCalled(t, "SaveTransactionReceipt", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestWaitForBlockCompletionWithTimeout(t *testing.T) {
+	var client *ethclient.Client
+	var hashToRead string
+
+	tests := []struct {
+		name              string
+		transactionStatus int
+		timeout           time.Duration
+		wantErr           error
+	}{
+		{
+			name:              "Test 1: When the transaction is mined successfully",
+			transactionStatus: 1,
+			timeout:           time.Second,
+			wantErr:           nil,
+		},
+		{
+			name:              "Test 2: When the transaction mining is unsuccessful",
+			transactionStatus: 0,
+			timeout:           time.Second,
+			wantErr:           errors.New("transaction mining unsuccessful"),
+		},
+		{
+			name:              "Test 3: When the transaction is still pending after the timeout",
+			transactionStatus: 2,
+			timeout:           100 * time.Millisecond,
+			wantErr:           ErrTransactionMiningTimeout,
+		},
+		{
+			name:              "Test 4: When the timeout is 0",
+			transactionStatus: 1,
+			timeout:           0,
+			wantErr:           ErrTransactionMiningTimeout,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			timeMock := new(mocks.TimeUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+				Time:           timeMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("CheckTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(tt.transactionStatus)
+			utilsMock.On("GetTransactionRevertReason", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return("")
+			utilsMock.On("SaveTransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("string")).Return(nil)
+			timeMock.On("Sleep", mock.Anything).Return()
+			utilsMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
+
+			gotErr := utils.WaitForBlockCompletionWithTimeout(client, hashToRead, tt.timeout)
+			if gotErr == nil || tt.wantErr == nil {
+				if gotErr != tt.wantErr {
+					t.Errorf("WaitForBlockCompletionWithTimeout() error = %v, want %v", gotErr, tt.wantErr)
+				}
+			} else if gotErr.Error() != tt.wantErr.Error() {
+				t.Errorf("WaitForBlockCompletionWithTimeout() error = %v, want %v", gotErr, tt.wantErr)
+			}
 		})
 	}
 }
@@ -1032,6 +1142,7 @@ func TestCalculateBlockNumberAtEpochBeginning(t *testing.T) {
 
 			clientMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.block, tt.args.blockErr)
 			clientMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(tt.args.previousBlock, tt.args.previousBlockErr)
+			utilsMock.On("GetAverageBlockTime", mock.AnythingOfType("*ethclient.Client")).Return(time.Second)
 			got, err := utils.CalculateBlockNumberAtEpochBeginning(client, epochLength, currentBlockNumber)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CalculateBlockNumberAtEpochBeginning() error = %v, wantErr %v", err, tt.wantErr)
@@ -1051,9 +1162,7 @@ func TestSaveDataToCommitJsonFile(t *testing.T) {
 		commitData Types.CommitData
 	)
 	type args struct {
-		jsonData     []byte
-		jsonDataErr  error
-		writeFileErr error
+		dbPathErr error
 	}
 	tests := []struct {
 		name    string
@@ -1061,41 +1170,22 @@ func TestSaveDataToCommitJsonFile(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Test 1: When SaveDataToCommitJsonFile() executes successfully",
-			args: args{
-				jsonData: []byte{},
-			},
+			name:    "Test 1: When SaveDataToCommitJsonFile() executes successfully",
+			args:    args{},
 			wantErr: false,
 		},
 		{
-			name: "Test 2: When there is an error in getting jsonData",
-			args: args{
-				jsonDataErr: errors.New("error in getting jsonData"),
-			},
-			wantErr: true,
-		},
-		{
-			name: "Test 3: When there is an error in writing file",
+			name: "Test 2: When there is an error in getting the path of the state store",
 			args: args{
-				jsonData:     []byte{},
-				writeFileErr: errors.New("error in writing file"),
+				dbPathErr: errors.New("error in getting state store path"),
 			},
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			jsonMock := new(mocks.JsonUtils)
-			osMock := new(mocks.OSUtils)
-
-			optionsPackageStruct := OptionsPackageStruct{
-				JsonInterface: jsonMock,
-				OS:            osMock,
-			}
-			utils := StartRazor(optionsPackageStruct)
-
-			jsonMock.On("Marshal", mock.Anything).Return(tt.args.jsonData, tt.args.jsonDataErr)
-			osMock.On("WriteFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.writeFileErr)
+			utils := StartRazor(OptionsPackageStruct{})
+			setStateDBPath(t, tt.args.dbPathErr)
 
 			if err := utils.SaveDataToCommitJsonFile(filePath, epoch, commitData); (err != nil) != tt.wantErr {
 				t.Errorf("SaveDataToCommitJsonFile() error = %v, wantErr %v", err, tt.wantErr)
@@ -1112,9 +1202,7 @@ func TestSaveDataToProposeJsonFile(t *testing.T) {
 	)
 
 	type args struct {
-		jsonData     []byte
-		jsonDataErr  error
-		writeFileErr error
+		dbPathErr error
 	}
 	tests := []struct {
 		name    string
@@ -1122,41 +1210,22 @@ func TestSaveDataToProposeJsonFile(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "Test 1: When SaveDataToProposeJsonFile() executes successfully",
-			args: args{
-				jsonData: []byte{},
-			},
+			name:    "Test 1: When SaveDataToProposeJsonFile() executes successfully",
+			args:    args{},
 			wantErr: false,
 		},
 		{
-			name: "Test 2: When there is an error in getting jsonData",
-			args: args{
-				jsonDataErr: errors.New("error in getting jsonData"),
-			},
-			wantErr: true,
-		},
-		{
-			name: "Test 3: When there is an error in writing file",
+			name: "Test 2: When there is an error in getting the path of the state store",
 			args: args{
-				jsonData:     []byte{},
-				writeFileErr: errors.New("error in writing file"),
+				dbPathErr: errors.New("error in getting state store path"),
 			},
 			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			jsonMock := new(mocks.JsonUtils)
-			osMock := new(mocks.OSUtils)
-
-			optionsPackageStruct := OptionsPackageStruct{
-				JsonInterface: jsonMock,
-				OS:            osMock,
-			}
-			utils := StartRazor(optionsPackageStruct)
-
-			jsonMock.On("Marshal", mock.Anything).Return(tt.args.jsonData, tt.args.jsonDataErr)
-			osMock.On("WriteFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.writeFileErr)
+			utils := StartRazor(OptionsPackageStruct{})
+			setStateDBPath(t, tt.args.dbPathErr)
 			if err := utils.SaveDataToProposeJsonFile(filePath, epoch, proposeData); (err != nil) != tt.wantErr {
 				t.Errorf("SaveDataToProposeJsonFile() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -1170,9 +1239,9 @@ func TestSaveDataToDisputeJsonFile(t *testing.T) {
 		bountyIdQueue []uint32
 	)
 	type args struct {
-		jsonData     []byte
-		jsonDataErr  error
-		writeFileErr error
+		jsonData    []byte
+		jsonDataErr error
+		dbPathErr   error
 	}
 	tests := []struct {
 		name    string
@@ -1194,10 +1263,10 @@ func TestSaveDataToDisputeJsonFile(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "Test 3: When there is an error in writing file",
+			name: "Test 3: When there is an error in getting the path of the state store",
 			args: args{
-				jsonData:     []byte{},
-				writeFileErr: errors.New("error in writing file"),
+				jsonData:  []byte{},
+				dbPathErr: errors.New("error in getting state store path"),
 			},
 			wantErr: true,
 		},
@@ -1205,16 +1274,14 @@ func TestSaveDataToDisputeJsonFile(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			jsonMock := new(mocks.JsonUtils)
-			osMock := new(mocks.OSUtils)
 
 			optionsPackageStruct := OptionsPackageStruct{
 				JsonInterface: jsonMock,
-				OS:            osMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
+			setStateDBPath(t, tt.args.dbPathErr)
 
 			jsonMock.On("Marshal", mock.Anything).Return(tt.args.jsonData, tt.args.jsonDataErr)
-			osMock.On("WriteFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.writeFileErr)
 			if err := utils.SaveDataToDisputeJsonFile(filePath, bountyIdQueue); (err != nil) != tt.wantErr {
 				t.Errorf("SaveDataToDisputeJsonFile() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -1224,6 +1291,13 @@ func TestSaveDataToDisputeJsonFile(t *testing.T) {
 
 func TestReadFromCommitJsonFile(t *testing.T) {
 	var filePath string
+	compactData := Types.CommitFileData{
+		Epoch:                  5,
+		AssignedCollections:    map[int]bool{1: true},
+		SeqAllottedCollections: []*big.Int{big.NewInt(1)},
+		Leaves:                 []*big.Int{big.NewInt(0), big.NewInt(100)},
+	}
+	compactByteValue, _ := encodeCommitFileData(compactData)
 	type args struct {
 		jsonFile     *os.File
 		jsonFileErr  error
@@ -1273,6 +1347,15 @@ func TestReadFromCommitJsonFile(t *testing.T) {
 			want:    Types.CommitFileData{},
 			wantErr: true,
 		},
+		{
+			name: "Test 5: When the file is in the compact encoding",
+			args: args{
+				jsonFile:  &os.File{},
+				byteValue: compactByteValue,
+			},
+			want:    compactData,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1286,6 +1369,7 @@ func TestReadFromCommitJsonFile(t *testing.T) {
 				IOInterface:   ioMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
+			setStateDBPath(t, nil)
 			osMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
 			ioMock.On("ReadAll", mock.Anything).Return(tt.args.byteValue, tt.args.byteValueErr)
 			jsonMock.On("Unmarshal", mock.Anything, mock.Anything).Return(tt.args.unmarshalErr)
@@ -1303,6 +1387,12 @@ func TestReadFromCommitJsonFile(t *testing.T) {
 
 func TestReadFromProposeJsonFile(t *testing.T) {
 	var filePath string
+	compactData := Types.ProposeFileData{
+		Epoch:                 5,
+		MediansData:           []*big.Int{big.NewInt(100)},
+		RevealedCollectionIds: []uint16{1},
+	}
+	compactByteValue, _ := encodeProposeFileData(compactData)
 	type args struct {
 		jsonFile     *os.File
 		jsonFileErr  error
@@ -1352,6 +1442,15 @@ func TestReadFromProposeJsonFile(t *testing.T) {
 			want:    Types.ProposeFileData{},
 			wantErr: true,
 		},
+		{
+			name: "Test 5: When the file is in the compact encoding",
+			args: args{
+				jsonFile:  &os.File{},
+				byteValue: compactByteValue,
+			},
+			want:    compactData,
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1365,6 +1464,7 @@ func TestReadFromProposeJsonFile(t *testing.T) {
 				IOInterface:   ioMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
+			setStateDBPath(t, nil)
 			osMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
 			ioMock.On("ReadAll", mock.Anything).Return(tt.args.byteValue, tt.args.byteValueErr)
 			jsonMock.On("Unmarshal", mock.Anything, mock.Anything).Return(tt.args.unmarshalErr)
@@ -1445,6 +1545,7 @@ func TestReadFromDisputeJsonFile(t *testing.T) {
 				IOInterface:   ioMock,
 			}
 			utils := StartRazor(optionsPackageStruct)
+			setStateDBPath(t, nil)
 			osMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
 			ioMock.On("ReadAll", mock.Anything).Return(tt.args.byteValue, tt.args.byteValueErr)
 			jsonMock.On("Unmarshal", mock.Anything, mock.Anything).Return(tt.args.unmarshalErr)
@@ -1460,3 +1561,440 @@ func TestReadFromDisputeJsonFile(t *testing.T) {
 		})
 	}
 }
+
+func TestSaveDataToDelegationMigrationFile(t *testing.T) {
+	var (
+		filePath string
+		data     Types.DelegationMigrationData
+	)
+	type args struct {
+		jsonData     []byte
+		jsonDataErr  error
+		writeFileErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When SaveDataToDelegationMigrationFile() executes successfully",
+			args: args{
+				jsonData: []byte{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting jsonData",
+			args: args{
+				jsonDataErr: errors.New("error in getting jsonData"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When there is an error in writing file",
+			args: args{
+				jsonData:     []byte{},
+				writeFileErr: errors.New("error in writing file"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonMock := new(mocks.JsonUtils)
+			osMock := new(mocks.OSUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				JsonInterface: jsonMock,
+				OS:            osMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			jsonMock.On("Marshal", mock.Anything).Return(tt.args.jsonData, tt.args.jsonDataErr)
+			osMock.On("WriteFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.writeFileErr)
+			if err := utils.SaveDataToDelegationMigrationFile(filePath, data); (err != nil) != tt.wantErr {
+				t.Errorf("SaveDataToDelegationMigrationFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGiveSortedProgressFile(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, JsonInterface: JsonStruct{}})
+
+	dir := t.TempDir()
+	invalidFilePath := dir + "/invalid_giveSortedProgress.json"
+	if err := os.WriteFile(invalidFilePath, []byte("not a progress"), 0600); err != nil {
+		t.Fatalf("Error in writing progress file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		progress *Types.GiveSortedProgress
+		want     Types.GiveSortedProgress
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When the progress is saved and read back",
+			filePath: dir + "/giveSortedProgress.json",
+			progress: &Types.GiveSortedProgress{Epoch: 5, LeafId: 2, SubmittedValues: 30, ChunkSize: 15},
+			want:     Types.GiveSortedProgress{Epoch: 5, LeafId: 2, SubmittedValues: 30, ChunkSize: 15},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 2: When the progress file doesn't exist",
+			filePath: dir + "/missing_giveSortedProgress.json",
+			want:     Types.GiveSortedProgress{},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 3: When the progress file is invalid",
+			filePath: invalidFilePath,
+			want:     Types.GiveSortedProgress{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := &UtilsStruct{}
+			if tt.progress != nil {
+				if err := ut.SaveDataToGiveSortedProgressFile(tt.filePath, *tt.progress); err != nil {
+					t.Fatalf("SaveDataToGiveSortedProgressFile() error = %v", err)
+				}
+			}
+			got, err := ut.ReadFromGiveSortedProgressFile(tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadFromGiveSortedProgressFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadFromGiveSortedProgressFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommissionScheduleFile(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, IOInterface: IOStruct{}, JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	filePath := t.TempDir() + "/0x01_commissionSchedule.json"
+	ut := &UtilsStruct{}
+
+	if _, err := ut.ReadFromCommissionScheduleFile(filePath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadFromCommissionScheduleFile() error = %v before anything is scheduled, want %v", err, os.ErrNotExist)
+	}
+	schedule := Types.CommissionSchedule{StakerId: 2, Commission: 10, ApplyFromEpoch: 120, Status: "scheduled"}
+	if err := ut.SaveDataToCommissionScheduleFile(filePath, schedule); err != nil {
+		t.Fatalf("SaveDataToCommissionScheduleFile() error = %v", err)
+	}
+	got, err := ut.ReadFromCommissionScheduleFile(filePath)
+	if err != nil {
+		t.Fatalf("ReadFromCommissionScheduleFile() error = %v", err)
+	}
+	if got != schedule {
+		t.Errorf("ReadFromCommissionScheduleFile() got = %v, want %v", got, schedule)
+	}
+}
+
+func TestWithdrawQueueFile(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, IOInterface: IOStruct{}, JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	filePath := t.TempDir() + "/0x01_withdrawQueue.json"
+	ut := &UtilsStruct{}
+
+	if _, err := ut.ReadFromWithdrawQueueFile(filePath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadFromWithdrawQueueFile() error = %v before anything is queued, want %v", err, os.ErrNotExist)
+	}
+	queue := []Types.WithdrawQueueEntry{{StakerId: 2, UnlockAfter: 120, TxnHash: "0x01"}, {StakerId: 5, UnlockAfter: 130}}
+	if err := ut.SaveDataToWithdrawQueueFile(filePath, queue); err != nil {
+		t.Fatalf("SaveDataToWithdrawQueueFile() error = %v", err)
+	}
+	got, err := ut.ReadFromWithdrawQueueFile(filePath)
+	if err != nil {
+		t.Fatalf("ReadFromWithdrawQueueFile() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, queue) {
+		t.Errorf("ReadFromWithdrawQueueFile() got = %v, want %v", got, queue)
+	}
+}
+
+func TestReadFromDelegationMigrationFile(t *testing.T) {
+	var filePath string
+	type args struct {
+		jsonFile     *os.File
+		jsonFileErr  error
+		byteValue    []byte
+		byteValueErr error
+		unmarshalErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Types.DelegationMigrationData
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When ReadFromDelegationMigrationFile() executes successfully",
+			args: args{
+				jsonFile:  &os.File{},
+				byteValue: []byte{},
+			},
+			want:    Types.DelegationMigrationData{},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting jsonFile",
+			args: args{
+				jsonFileErr: errors.New("error in getting jsonFile"),
+			},
+			want:    Types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting byteValue",
+			args: args{
+				jsonFile:     &os.File{},
+				byteValueErr: errors.New("error in getting byteValue"),
+			},
+			want:    Types.DelegationMigrationData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in unmarshal",
+			args: args{
+				jsonFile:     &os.File{},
+				byteValue:    []byte{},
+				unmarshalErr: errors.New("error in unmarshal"),
+			},
+			want:    Types.DelegationMigrationData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonMock := new(mocks.JsonUtils)
+			osMock := new(mocks.OSUtils)
+			ioMock := new(mocks.IOUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				JsonInterface: jsonMock,
+				OS:            osMock,
+				IOInterface:   ioMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+			osMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
+			ioMock.On("ReadAll", mock.Anything).Return(tt.args.byteValue, tt.args.byteValueErr)
+			jsonMock.On("Unmarshal", mock.Anything, mock.Anything).Return(tt.args.unmarshalErr)
+
+			got, err := utils.ReadFromDelegationMigrationFile(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadFromDelegationMigrationFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadFromDelegationMigrationFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveDataToCollectionHistoryFile(t *testing.T) {
+	var (
+		filePath string
+		fileInfo fs.FileInfo
+	)
+	type args struct {
+		statErr      error
+		fileData     Types.CollectionHistoryFileData
+		fileDataErr  error
+		historyData  Types.CollectionHistoryData
+		jsonData     []byte
+		jsonDataErr  error
+		writeFileErr error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantEpochs  []uint32
+		wantErr     bool
+		wantMarshal bool
+	}{
+		{
+			name: "Test 1: When history file does not exist",
+			args: args{
+				statErr:     os.ErrNotExist,
+				historyData: Types.CollectionHistoryData{Epoch: 5},
+				jsonData:    []byte{},
+			},
+			wantEpochs:  []uint32{5},
+			wantErr:     false,
+			wantMarshal: true,
+		},
+		{
+			name: "Test 2: When history file exists and entry of same epoch is replaced",
+			args: args{
+				fileData: Types.CollectionHistoryFileData{
+					CollectionId: 1,
+					History:      []Types.CollectionHistoryData{{Epoch: 3}, {Epoch: 4}, {Epoch: 5}},
+				},
+				historyData: Types.CollectionHistoryData{Epoch: 5},
+				jsonData:    []byte{},
+			},
+			wantEpochs:  []uint32{3, 4, 5},
+			wantErr:     false,
+			wantMarshal: true,
+		},
+		{
+			name: "Test 3: When there is an error in reading history file",
+			args: args{
+				fileDataErr: errors.New("error in reading file"),
+				historyData: Types.CollectionHistoryData{Epoch: 5},
+			},
+			wantErr:     true,
+			wantMarshal: false,
+		},
+		{
+			name: "Test 4: When there is an error in getting jsonData",
+			args: args{
+				statErr:     os.ErrNotExist,
+				historyData: Types.CollectionHistoryData{Epoch: 5},
+				jsonDataErr: errors.New("error in getting jsonData"),
+			},
+			wantEpochs:  []uint32{5},
+			wantErr:     true,
+			wantMarshal: true,
+		},
+		{
+			name: "Test 5: When there is an error in writing file",
+			args: args{
+				statErr:      os.ErrNotExist,
+				historyData:  Types.CollectionHistoryData{Epoch: 5},
+				jsonData:     []byte{},
+				writeFileErr: errors.New("error in writing file"),
+			},
+			wantEpochs:  []uint32{5},
+			wantErr:     true,
+			wantMarshal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			jsonMock := new(mocks.JsonUtils)
+			osMock := new(mocks.OSUtils)
+			osPathMock := new(pathMocks.OSInterface)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+				JsonInterface:  jsonMock,
+				OS:             osMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+			path.OSUtilsInterface = osPathMock
+
+			var marshalledData Types.CollectionHistoryFileData
+			osPathMock.On("Stat", mock.Anything).Return(fileInfo, tt.args.statErr)
+			utilsMock.On("ReadFromCollectionHistoryFile", mock.Anything).Return(tt.args.fileData, tt.args.fileDataErr)
+			jsonMock.On("Marshal", mock.Anything).Run(func(args mock.Arguments) {
+				marshalledData = args.Get(0).(Types.CollectionHistoryFileData)
+			}).Return(tt.args.jsonData, tt.args.jsonDataErr)
+			osMock.On("WriteFile", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.writeFileErr)
+
+			err := utils.SaveDataToCollectionHistoryFile(filePath, 1, tt.args.historyData)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SaveDataToCollectionHistoryFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantMarshal {
+				var gotEpochs []uint32
+				for _, entry := range marshalledData.History {
+					gotEpochs = append(gotEpochs, entry.Epoch)
+				}
+				if !reflect.DeepEqual(gotEpochs, tt.wantEpochs) {
+					t.Errorf("SaveDataToCollectionHistoryFile() epochs = %v, want %v", gotEpochs, tt.wantEpochs)
+				}
+			}
+		})
+	}
+}
+
+func TestReadFromCollectionHistoryFile(t *testing.T) {
+	var filePath string
+	type args struct {
+		jsonFile     *os.File
+		jsonFileErr  error
+		byteValue    []byte
+		byteValueErr error
+		unmarshalErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Types.CollectionHistoryFileData
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When ReadFromCollectionHistoryFile() executes successfully",
+			args: args{
+				jsonFile:  &os.File{},
+				byteValue: []byte{},
+			},
+			want:    Types.CollectionHistoryFileData{},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting jsonFile",
+			args: args{
+				jsonFileErr: errors.New("error in getting jsonFile"),
+			},
+			want:    Types.CollectionHistoryFileData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 3: When there is an error in getting byteValue",
+			args: args{
+				jsonFile:     &os.File{},
+				byteValueErr: errors.New("error in getting byteValue"),
+			},
+			want:    Types.CollectionHistoryFileData{},
+			wantErr: true,
+		},
+		{
+			name: "Test 4: When there is an error in unmarshal",
+			args: args{
+				jsonFile:     &os.File{},
+				byteValue:    []byte{},
+				unmarshalErr: errors.New("error in unmarshal"),
+			},
+			want:    Types.CollectionHistoryFileData{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonMock := new(mocks.JsonUtils)
+			osMock := new(mocks.OSUtils)
+			ioMock := new(mocks.IOUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				JsonInterface: jsonMock,
+				OS:            osMock,
+				IOInterface:   ioMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+			osMock.On("Open", mock.Anything).Return(tt.args.jsonFile, tt.args.jsonFileErr)
+			ioMock.On("ReadAll", mock.Anything).Return(tt.args.byteValue, tt.args.byteValueErr)
+			jsonMock.On("Unmarshal", mock.Anything, mock.Anything).Return(tt.args.unmarshalErr)
+
+			got, err := utils.ReadFromCollectionHistoryFile(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadFromCollectionHistoryFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadFromCollectionHistoryFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"razor/metrics"
+	"sync"
+)
+
+//The results of the contract calls which don't change within an epoch, like the collections, the jobs, the staker ids and the limits of the contracts
+//The results are kept for the epoch they are fetched in and are dropped when the epoch changes
+var (
+	contractCacheEpoch  uint32
+	contractCallResults = make(map[string]interface{})
+	contractCacheMutex  sync.Mutex
+)
+
+//This function sets the current epoch of the chain, the cached results are dropped if the epoch changes
+//Nothing is cached until the epoch is set, so the commands which aren't voting always read the contracts
+func SetContractCacheEpoch(epoch uint32) {
+	contractCacheMutex.Lock()
+	defer contractCacheMutex.Unlock()
+	if epoch == contractCacheEpoch {
+		return
+	}
+	if contractCacheEpoch != 0 {
+		log.Debugf("Epoch changed to %d, dropping %d cached contract call results", epoch, len(contractCallResults))
+	}
+	contractCacheEpoch = epoch
+	contractCallResults = make(map[string]interface{})
+}
+
+//This function drops the cached results and stops caching until the epoch is set again
+func ClearContractCache() {
+	contractCacheMutex.Lock()
+	defer contractCacheMutex.Unlock()
+	contractCacheEpoch = 0
+	contractCallResults = make(map[string]interface{})
+}
+
+//This function returns the key of the result of a contract call from the name of the call and its arguments
+func contractCallKey(call string, args ...interface{}) string {
+	return fmt.Sprintf("%s%v", call, args)
+}
+
+//This function returns the cached result of the contract call if it was fetched in the current epoch
+func getContractCallResult(key string) (interface{}, bool) {
+	contractCacheMutex.Lock()
+	defer contractCacheMutex.Unlock()
+	if contractCacheEpoch == 0 {
+		return nil, false
+	}
+	result, ok := contractCallResults[key]
+	if ok {
+		metrics.ContractCacheRequestsMetric.WithLabelValues("hit").Inc()
+	} else {
+		metrics.ContractCacheRequestsMetric.WithLabelValues("miss").Inc()
+	}
+	return result, ok
+}
+
+//This function keeps the result of the contract call until the epoch changes
+func storeContractCallResult(key string, result interface{}) {
+	contractCacheMutex.Lock()
+	defer contractCacheMutex.Unlock()
+	if contractCacheEpoch == 0 {
+		return
+	}
+	contractCallResults[key] = result
+}
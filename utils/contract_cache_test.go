@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"razor/pkg/bindings"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/avast/retry-go"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestContractCallCache(t *testing.T) {
+	var client *ethclient.Client
+	var callOpts bind.CallOpts
+	defer ClearContractCache()
+
+	job := bindings.StructsJob{Id: 1, Name: "ethusd_gemini", Url: "https://api.gemini.com/v1/pubticker/ethusd"}
+	tests := []struct {
+		name      string
+		epoch     uint32
+		stakerId  uint32
+		wantCalls int
+	}{
+		{
+			name:      "Test 1: When the epoch is not set",
+			epoch:     0,
+			wantCalls: 2,
+		},
+		{
+			name:      "Test 2: When the results are fetched in the same epoch",
+			epoch:     10,
+			stakerId:  5,
+			wantCalls: 1,
+		},
+		{
+			name:      "Test 3: When the address is not a staker",
+			epoch:     10,
+			stakerId:  0,
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ClearContractCache()
+			retryMock := new(mocks.RetryUtils)
+			utilsMock := new(mocks.Utils)
+			assetManagerMock := new(mocks.AssetManagerUtils)
+			stakeManagerMock := new(mocks.StakeManagerUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				RetryInterface:        retryMock,
+				UtilsInterface:        utilsMock,
+				AssetManagerInterface: assetManagerMock,
+				StakeManagerInterface: stakeManagerMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetOptions").Return(callOpts)
+			assetManagerMock.On("Jobs", mock.AnythingOfType("*ethclient.Client"), uint16(1)).Return(job, nil)
+			stakeManagerMock.On("GetStakerId", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.stakerId, nil)
+			retryMock.On("RetryAttempts", mock.AnythingOfType("uint")).Return(retry.Attempts(1))
+
+			SetContractCacheEpoch(tt.epoch)
+			for i := 0; i < 2; i++ {
+				got, err := utils.GetActiveJob(client, 1)
+				if err != nil || !reflect.DeepEqual(got, job) {
+					t.Errorf("GetActiveJob() got = %v, %v, want %v", got, err, job)
+				}
+				stakerId, err := utils.GetStakerId(client, "0x000000000000000000000000000000000000dEaD")
+				if err != nil || stakerId != tt.stakerId {
+					t.Errorf("GetStakerId() got = %v, %v, want %v", stakerId, err, tt.stakerId)
+				}
+			}
+			if tt.epoch == 0 {
+				assetManagerMock.AssertNumberOfCalls(t, "Jobs", 2)
+			} else {
+				assetManagerMock.AssertNumberOfCalls(t, "Jobs", 1)
+			}
+			stakeManagerMock.AssertNumberOfCalls(t, "GetStakerId", tt.wantCalls)
+		})
+	}
+}
+
+func TestSetContractCacheEpoch(t *testing.T) {
+	defer ClearContractCache()
+	ClearContractCache()
+
+	SetContractCacheEpoch(10)
+	storeContractCallResult(contractCallKey("stateBuffer"), uint64(5))
+	SetContractCacheEpoch(10)
+	if result, ok := getContractCallResult(contractCallKey("stateBuffer")); !ok || result != uint64(5) {
+		t.Errorf("getContractCallResult() got = %v, %v in the same epoch, want 5", result, ok)
+	}
+	SetContractCacheEpoch(11)
+	if result, ok := getContractCallResult(contractCallKey("stateBuffer")); ok {
+		t.Errorf("getContractCallResult() got = %v in the next epoch, want no result", result)
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"strconv"
+)
+
+//This function reads the file in which the values of the collections are overridden, an empty override file is returned if the file doesn't exist
+//Every override is keyed by the id of its collection and either pins the value of the collection or fetches it from a custom URL and selector
+func (*UtilsStruct) ReadDataOverrideFile(filePath string) (types.DataOverrideFile, error) {
+	byteValue, err := OS.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return types.DataOverrideFile{}, nil
+	}
+	if err != nil {
+		return types.DataOverrideFile{}, err
+	}
+	var overrideFile types.DataOverrideFile
+	err = JsonInterface.Unmarshal(byteValue, &overrideFile)
+	if err != nil {
+		return types.DataOverrideFile{}, err
+	}
+	for collectionId, override := range overrideFile.Collections {
+		if _, err := strconv.ParseUint(collectionId, 10, 16); err != nil {
+			return types.DataOverrideFile{}, fmt.Errorf("invalid collection id %s in data override file", collectionId)
+		}
+		err = validateDataOverride(override)
+		if err != nil {
+			return types.DataOverrideFile{}, fmt.Errorf("invalid data override of collection %s: %s", collectionId, err)
+		}
+	}
+	return overrideFile, nil
+}
+
+func validateDataOverride(override types.DataOverride) error {
+	if override.Value != "" && override.URL != "" {
+		return errors.New("value and URL can't be overridden together")
+	}
+	if override.Value != "" {
+		if _, ok := new(big.Float).SetString(string(override.Value)); !ok {
+			return errors.New("value " + string(override.Value) + " is not a number")
+		}
+		return nil
+	}
+	if override.URL == "" {
+		return errors.New("either value or URL should be overridden")
+	}
+	if override.Selector == "" {
+		return errors.New("selector of the URL is not set")
+	}
+	return nil
+}
+
+//This function returns the value of the collection from its override, the static value or the value of the custom URL is multiplied with the power of the override or of the collection
+func (*UtilsStruct) GetDataOfOverride(collection bindings.StructsCollection, override types.DataOverride) (*big.Int, error) {
+	power := collection.Power
+	if override.Power != nil {
+		power = *override.Power
+	}
+	if override.Value != "" {
+		value, ok := new(big.Float).SetString(string(override.Value))
+		if !ok {
+			return nil, errors.New("value " + string(override.Value) + " is not a number")
+		}
+		return MultiplyWithPower(value, power), nil
+	}
+	return UtilsInterface.GetDataToCommitFromJob(bindings.StructsJob{
+		SelectorType: override.SelectorType,
+		Weight:       1,
+		Power:        power,
+		Name:         collection.Name + "_override",
+		Selector:     override.Selector,
+		Url:          override.URL,
+	})
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReadDataOverrideFile(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, JsonInterface: JsonStruct{}})
+
+	power := int8(3)
+	dir := t.TempDir()
+	writeOverrideFile := func(name string, data string) string {
+		filePath := dir + "/" + name
+		if err := os.WriteFile(filePath, []byte(data), 0600); err != nil {
+			t.Fatalf("Error in writing data override file: %v", err)
+		}
+		return filePath
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		want     types.DataOverrideFile
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When the values of the collections are pinned and fetched from custom URLs",
+			filePath: writeOverrideFile("valid.json", `{"collections": {"1": {"value": 1850.25}, "2": {"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "power": 3}}}`),
+			want: types.DataOverrideFile{Collections: map[string]types.DataOverride{
+				"1": {Value: "1850.25"},
+				"2": {URL: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last", Power: &power},
+			}},
+			wantErr: false,
+		},
+		{
+			name:     "Test 2: When the data override file doesn't exist",
+			filePath: dir + "/missing.json",
+			want:     types.DataOverrideFile{},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 3: When the data override file is invalid",
+			filePath: writeOverrideFile("invalid.json", `not an override`),
+			want:     types.DataOverrideFile{},
+			wantErr:  true,
+		},
+		{
+			name:     "Test 4: When the collection id is invalid",
+			filePath: writeOverrideFile("invalidId.json", `{"collections": {"ETHUSD": {"value": 1850}}}`),
+			want:     types.DataOverrideFile{},
+			wantErr:  true,
+		},
+		{
+			name:     "Test 5: When both the value and the URL are overridden",
+			filePath: writeOverrideFile("valueAndURL.json", `{"collections": {"1": {"value": 1850, "URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}}}`),
+			want:     types.DataOverrideFile{},
+			wantErr:  true,
+		},
+		{
+			name:     "Test 6: When the URL is overridden without a selector",
+			filePath: writeOverrideFile("noSelector.json", `{"collections": {"1": {"URL": "https://api.gemini.com/v1/pubticker/ethusd"}}}`),
+			want:     types.DataOverrideFile{},
+			wantErr:  true,
+		},
+		{
+			name:     "Test 7: When neither the value nor the URL is overridden",
+			filePath: writeOverrideFile("empty.json", `{"collections": {"1": {"power": 2}}}`),
+			want:     types.DataOverrideFile{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := &UtilsStruct{}
+			got, err := ut.ReadDataOverrideFile(tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReadDataOverrideFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadDataOverrideFile() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDataOfOverride(t *testing.T) {
+	collection := bindings.StructsCollection{Id: 1, Power: 2, Name: "ethCollection"}
+	power := int8(4)
+
+	type args struct {
+		override   types.DataOverride
+		jobData    *big.Int
+		jobDataErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantJob *bindings.StructsJob
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the value is pinned with the power of the collection",
+			args: args{
+				override: types.DataOverride{Value: "1850.25"},
+			},
+			want:    big.NewInt(185025),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the value is pinned with the power of the override",
+			args: args{
+				override: types.DataOverride{Value: "1850.25", Power: &power},
+			},
+			want:    big.NewInt(18502500),
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the value is fetched from a custom URL",
+			args: args{
+				override: types.DataOverride{URL: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last"},
+				jobData:  big.NewInt(185025),
+			},
+			wantJob: &bindings.StructsJob{Weight: 1, Power: 2, Name: "ethCollection_override", Selector: "last", Url: "https://api.gemini.com/v1/pubticker/ethusd"},
+			want:    big.NewInt(185025),
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When there is an error in fetching the custom URL",
+			args: args{
+				override:   types.DataOverride{URL: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last"},
+				jobDataErr: errors.New("api error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the pinned value is not a number",
+			args: args{
+				override: types.DataOverride{Value: "ETH"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetDataToCommitFromJob", mock.Anything).Return(tt.args.jobData, tt.args.jobDataErr)
+
+			got, err := utils.GetDataOfOverride(collection, tt.args.override)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDataOfOverride() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDataOfOverride() got = %v, want %v", got, tt.want)
+			}
+			if tt.wantJob != nil {
+				utilsMock.AssertCalled(t, "GetDataToCommitFromJob", *tt.wantJob)
+			}
+		})
+	}
+}
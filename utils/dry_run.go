@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	dryRunMode  bool
+	dryRunMutex sync.Mutex
+)
+
+//This function enables the dry run mode in which the transactions are built, estimated and signed but not sent, they are only logged
+func EnableDryRunMode() {
+	dryRunMutex.Lock()
+	defer dryRunMutex.Unlock()
+	dryRunMode = true
+	log.Warn("Dry run mode is enabled, no transactions will be sent. The transactions which would be sent are logged with their gas cost")
+}
+
+//This function returns if the dry run mode is enabled
+func IsDryRunMode() bool {
+	dryRunMutex.Lock()
+	defer dryRunMutex.Unlock()
+	return dryRunMode
+}
+
+//This function returns if the transactions are built and signed but not sent, which is the case in the canary and the dry run mode
+func IsNoSendMode() bool {
+	return IsCanaryMode() || IsDryRunMode()
+}
+
+//This function sets the transaction options so that the transaction is signed but not sent, it is exported to the canary file in canary mode and logged in dry run mode
+func setNoSendTxnOpts(txnOpts *bind.TransactOpts, methodName string, parameters []interface{}, estimationErr error) {
+	if IsCanaryMode() {
+		setCanaryTxnOpts(txnOpts, methodName, estimationErr)
+		return
+	}
+	txnOpts.NoSend = true
+	txnOpts.Signer = getDryRunSigner(txnOpts.Signer, methodName, parameters, estimationErr)
+}
+
+//This function returns a signer which logs the signed transaction along with its gas cost instead of it being sent
+//The gas estimation runs the transaction against the contract, so its error shows that the transaction would revert if it was sent
+func getDryRunSigner(signer bind.SignerFn, methodName string, parameters []interface{}, estimationErr error) bind.SignerFn {
+	return func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+		signedTxn, err := signer(address, txn)
+		if err != nil {
+			return nil, err
+		}
+		to := ""
+		if signedTxn.To() != nil {
+			to = signedTxn.To().Hex()
+		}
+		gasCost := new(big.Int).Mul(new(big.Int).SetUint64(signedTxn.Gas()), signedTxn.GasPrice())
+		log.Infof("Dry run: not sending %s%v from %s to %s", methodName, parameters, address.Hex(), to)
+		log.Infof("Dry run: gas limit %d, gas price %s wei, gas cost %s", signedTxn.Gas(), signedTxn.GasPrice(), GetAmountInDecimal(gasCost).Text('f', 18))
+		log.Debugf("Dry run: nonce %d, value %s, calldata %s, hash %s", signedTxn.Nonce(), signedTxn.Value(), hexutil.Encode(signedTxn.Data()), signedTxn.Hash().Hex())
+		if estimationErr != nil {
+			log.Errorf("Dry run: %s transaction would revert: %s", methodName, estimationErr)
+		}
+		return signedTxn, nil
+	}
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestGetDryRunSigner(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
+	to := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+
+	tests := []struct {
+		name          string
+		estimationErr error
+	}{
+		{
+			name: "Test 1: When gas is estimated",
+		},
+		{
+			name:          "Test 2: When gas estimation fails",
+			estimationErr: errors.New("execution reverted"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txn := Types.NewTransaction(5, to, big.NewInt(0), 100000, big.NewInt(1e9), []byte{0x01, 0x02})
+			signer := getDryRunSigner(txnOpts.Signer, "stake", []interface{}{uint32(5), big.NewInt(1000)}, tt.estimationErr)
+			signedTxn, err := signer(txnOpts.From, txn)
+			if err != nil {
+				t.Fatalf("getDryRunSigner() error = %v", err)
+			}
+			if signedTxn.Nonce() != 5 || signedTxn.Gas() != 100000 || *signedTxn.To() != to {
+				t.Errorf("getDryRunSigner() signed = %+v", signedTxn)
+			}
+			sender, err := Types.Sender(Types.LatestSignerForChainID(big.NewInt(1)), signedTxn)
+			if err != nil || sender != txnOpts.From {
+				t.Errorf("getDryRunSigner() sender = %s, want %s", sender.Hex(), txnOpts.From.Hex())
+			}
+		})
+	}
+}
+
+func TestSetNoSendTxnOpts(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, big.NewInt(1))
+	dryRunMode = true
+	defer func() { dryRunMode = false }()
+
+	if !IsNoSendMode() {
+		t.Fatal("IsNoSendMode() = false, want true in dry run mode")
+	}
+	setNoSendTxnOpts(txnOpts, "unstake", nil, nil)
+	if !txnOpts.NoSend {
+		t.Error("setNoSendTxnOpts() didn't set NoSend")
+	}
+}
+
+func TestWaitForBlockCompletionInDryRunMode(t *testing.T) {
+	dryRunMode = true
+	defer func() { dryRunMode = false }()
+
+	if err := waitForBlockCompletion(nil, "0x1", time.Second); err != nil {
+		t.Errorf("waitForBlockCompletion() error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core"
+	"razor/metrics"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//eventIndex keeps the logs of the contracts from the beginning of the epoch, so that the queries of these logs in the epoch are answered without filtering the block range again
+//It is updated on every new head by the vote loop, the last core.EventIndexReorgBlocks blocks are fetched again so that the logs of reorged blocks are replaced
+type eventIndex struct {
+	mutex     sync.Mutex
+	epoch     uint32
+	addresses []common.Address
+	fromBlock uint64
+	nextBlock uint64
+	logs      []types.Log
+}
+
+var events = &eventIndex{}
+
+//This function indexes the logs of the StakeManager and the BlockManager up to the head, which are queried for the bounties, the slashes and the proposed blocks
+func IndexEvents(client *ethclient.Client, header *types.Header) error {
+	addresses := []common.Address{
+		common.HexToAddress(core.StakeManagerAddress),
+		common.HexToAddress(core.BlockManagerAddress),
+	}
+	return events.update(client, header, addresses)
+}
+
+//This function returns the logs of the query from the index if the index has the logs of its block range and contracts, the logs are filtered from the chain in chunks otherwise
+func FilterIndexedLogs(client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
+	if logs, ok := events.filter(query); ok {
+		metrics.EventIndexRequestsMetric.WithLabelValues("hit").Inc()
+		return logs, nil
+	}
+	metrics.EventIndexRequestsMetric.WithLabelValues("miss").Inc()
+	return UtilsInterface.FilterLogsInChunks(client, query)
+}
+
+//This function fetches the logs of the blocks which are not indexed yet up to the head
+//The logs before the beginning of the epoch are dropped when the epoch changes, the index starts again from the beginning of the epoch if it doesn't have the logs up to it
+func (index *eventIndex) update(client *ethclient.Client, header *types.Header, addresses []common.Address) error {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	epoch := uint32(header.Time / uint64(core.EpochLength))
+	headNumber := header.Number.Uint64()
+	if index.nextBlock == 0 || epoch != index.epoch || !equalAddresses(index.addresses, addresses) {
+		epochBeginning, err := UtilsInterface.CalculateBlockNumberAtEpochBeginning(client, core.EpochLength, header.Number)
+		if err != nil {
+			return err
+		}
+		fromBlock := epochBeginning.Uint64()
+		if index.nextBlock != 0 && equalAddresses(index.addresses, addresses) && index.fromBlock <= fromBlock && index.nextBlock >= fromBlock {
+			index.logs = logsFromBlock(index.logs, fromBlock)
+		} else {
+			index.logs = nil
+			index.nextBlock = fromBlock
+		}
+		index.epoch = epoch
+		index.addresses = addresses
+		index.fromBlock = fromBlock
+	}
+
+	refetchFrom := index.nextBlock
+	if refetchFrom > headNumber+1 {
+		refetchFrom = headNumber + 1
+	}
+	if refetchFrom >= index.fromBlock+core.EventIndexReorgBlocks {
+		refetchFrom -= core.EventIndexReorgBlocks
+	} else {
+		refetchFrom = index.fromBlock
+	}
+	if refetchFrom > headNumber {
+		return nil
+	}
+	logs, err := UtilsInterface.FilterLogsInChunks(client, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(refetchFrom),
+		ToBlock:   new(big.Int).SetUint64(headNumber),
+		Addresses: addresses,
+	})
+	if err != nil {
+		return err
+	}
+	index.logs = logsBeforeBlock(index.logs, refetchFrom)
+	for _, vLog := range logs {
+		if !vLog.Removed {
+			index.logs = append(index.logs, vLog)
+		}
+	}
+	index.nextBlock = headNumber + 1
+	return nil
+}
+
+//This function returns the indexed logs which match the query, it returns false if the index doesn't have the logs of the block range or of a contract of the query
+func (index *eventIndex) filter(query ethereum.FilterQuery) ([]types.Log, bool) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	if index.nextBlock == 0 || query.BlockHash != nil || query.FromBlock == nil || query.ToBlock == nil || len(query.Addresses) == 0 {
+		return nil, false
+	}
+	if query.FromBlock.Sign() < 0 || query.FromBlock.Uint64() < index.fromBlock || query.ToBlock.Cmp(new(big.Int).SetUint64(index.nextBlock)) >= 0 {
+		return nil, false
+	}
+	for _, address := range query.Addresses {
+		if !containsAddress(index.addresses, address) {
+			return nil, false
+		}
+	}
+	var logs []types.Log
+	for _, vLog := range index.logs {
+		if vLog.BlockNumber < query.FromBlock.Uint64() || vLog.BlockNumber > query.ToBlock.Uint64() {
+			continue
+		}
+		if containsAddress(query.Addresses, vLog.Address) && matchesTopics(vLog, query.Topics) {
+			logs = append(logs, vLog)
+		}
+	}
+	return logs, true
+}
+
+//This function drops the index, the next update indexes the logs from the beginning of the epoch again
+func (index *eventIndex) reset() {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.epoch = 0
+	index.addresses = nil
+	index.fromBlock = 0
+	index.nextBlock = 0
+	index.logs = nil
+}
+
+//This function returns the logs from the block
+func logsFromBlock(logs []types.Log, blockNumber uint64) []types.Log {
+	for i, vLog := range logs {
+		if vLog.BlockNumber >= blockNumber {
+			return append([]types.Log(nil), logs[i:]...)
+		}
+	}
+	return nil
+}
+
+//This function returns the logs before the block
+func logsBeforeBlock(logs []types.Log, blockNumber uint64) []types.Log {
+	for i, vLog := range logs {
+		if vLog.BlockNumber >= blockNumber {
+			return logs[:i]
+		}
+	}
+	return logs
+}
+
+//This function returns if the log matches the topics of a query, every position of the topics matches any of its topics and an empty position matches every topic
+func matchesTopics(vLog types.Log, topics [][]common.Hash) bool {
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if i >= len(vLog.Topics) {
+			return false
+		}
+		matched := false
+		for _, topic := range alternatives {
+			if topic == vLog.Topics[i] {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+func equalAddresses(a []common.Address, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEventIndexUpdate(t *testing.T) {
+	var client *ethclient.Client
+	defer events.reset()
+
+	address := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	otherAddress := common.HexToAddress("0x000000000000000000000000000000000000bEEF")
+	topic := common.HexToHash("0x01")
+	otherTopic := common.HexToHash("0x02")
+	epochTime := uint64(10 * core.EpochLength)
+
+	type update struct {
+		time      uint64
+		number    int64
+		fromBlock uint64
+		logs      []types.Log
+	}
+	tests := []struct {
+		name      string
+		updates   []update
+		query     ethereum.FilterQuery
+		wantLogs  []types.Log
+		wantFound bool
+	}{
+		{
+			name: "Test 1: When the logs of the epoch are queried",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100, logs: []types.Log{{Address: address, BlockNumber: 101, Topics: []common.Hash{topic}}, {Address: address, BlockNumber: 105, Topics: []common.Hash{otherTopic}}}},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(100), ToBlock: big.NewInt(110), Addresses: []common.Address{address}, Topics: [][]common.Hash{{topic}}},
+			wantLogs:  []types.Log{{Address: address, BlockNumber: 101, Topics: []common.Hash{topic}}},
+			wantFound: true,
+		},
+		{
+			name: "Test 2: When the query is after the indexed blocks",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(100), ToBlock: big.NewInt(111), Addresses: []common.Address{address}},
+			wantFound: false,
+		},
+		{
+			name: "Test 3: When the query is before the beginning of the epoch",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(99), ToBlock: big.NewInt(110), Addresses: []common.Address{address}},
+			wantFound: false,
+		},
+		{
+			name: "Test 4: When the query is of a contract which is not indexed",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(100), ToBlock: big.NewInt(110), Addresses: []common.Address{otherAddress}},
+			wantFound: false,
+		},
+		{
+			name: "Test 5: When the logs of the last blocks are reorged",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100, logs: []types.Log{{Address: address, BlockNumber: 101}, {Address: address, BlockNumber: 109}}},
+				{time: epochTime, number: 112, logs: []types.Log{{Address: address, BlockNumber: 108}, {Address: address, BlockNumber: 109, Removed: true}}},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(100), ToBlock: big.NewInt(112), Addresses: []common.Address{address}},
+			wantLogs:  []types.Log{{Address: address, BlockNumber: 101}, {Address: address, BlockNumber: 108}},
+			wantFound: true,
+		},
+		{
+			name: "Test 6: When the epoch changes",
+			updates: []update{
+				{time: epochTime, number: 110, fromBlock: 100, logs: []types.Log{{Address: address, BlockNumber: 101}, {Address: address, BlockNumber: 110}}},
+				{time: epochTime + uint64(core.EpochLength), number: 111, fromBlock: 110, logs: []types.Log{{Address: address, BlockNumber: 110}, {Address: address, BlockNumber: 111}}},
+			},
+			query:     ethereum.FilterQuery{FromBlock: big.NewInt(110), ToBlock: big.NewInt(111), Addresses: []common.Address{address}},
+			wantLogs:  []types.Log{{Address: address, BlockNumber: 110}, {Address: address, BlockNumber: 111}},
+			wantFound: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events.reset()
+			for _, u := range tt.updates {
+				utilsMock := new(mocks.Utils)
+
+				optionsPackageStruct := OptionsPackageStruct{
+					UtilsInterface: utilsMock,
+				}
+				StartRazor(optionsPackageStruct)
+
+				utilsMock.On("CalculateBlockNumberAtEpochBeginning", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("int64"), mock.AnythingOfType("*big.Int")).Return(new(big.Int).SetUint64(u.fromBlock), nil)
+				utilsMock.On("FilterLogsInChunks", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(u.logs, nil)
+
+				err := events.update(client, &types.Header{Time: u.time, Number: big.NewInt(u.number)}, []common.Address{address})
+				if err != nil {
+					t.Fatalf("update() error = %v", err)
+				}
+			}
+			gotLogs, gotFound := events.filter(tt.query)
+			if gotFound != tt.wantFound {
+				t.Errorf("filter() found = %v, want %v", gotFound, tt.wantFound)
+			}
+			if !reflect.DeepEqual(gotLogs, tt.wantLogs) {
+				t.Errorf("filter() logs = %v, want %v", gotLogs, tt.wantLogs)
+			}
+		})
+	}
+}
+
+func TestMatchesTopics(t *testing.T) {
+	topic := common.HexToHash("0x01")
+	otherTopic := common.HexToHash("0x02")
+	vLog := types.Log{Topics: []common.Hash{topic, otherTopic}}
+	tests := []struct {
+		name   string
+		topics [][]common.Hash
+		want   bool
+	}{
+		{
+			name:   "Test 1: When the query has no topics",
+			topics: nil,
+			want:   true,
+		},
+		{
+			name:   "Test 2: When a topic of the position matches",
+			topics: [][]common.Hash{{otherTopic, topic}},
+			want:   true,
+		},
+		{
+			name:   "Test 3: When an empty position is followed by a matching topic",
+			topics: [][]common.Hash{{}, {otherTopic}},
+			want:   true,
+		},
+		{
+			name:   "Test 4: When no topic of the position matches",
+			topics: [][]common.Hash{{otherTopic}},
+			want:   false,
+		},
+		{
+			name:   "Test 5: When the log has fewer topics than the query",
+			topics: [][]common.Hash{{}, {}, {topic}},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesTopics(vLog, tt.topics); got != tt.want {
+				t.Errorf("matchesTopics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
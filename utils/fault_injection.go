@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"sync"
+)
+
+var (
+	injectedFaults     []types.Fault
+	injectedFaultMutex sync.Mutex
+)
+
+//This function loads the faults to be injected in the epoch loop from the fault injection config file
+//It is meant for resilience tests of the recovery logic and must never be used while staking on a live network
+func LoadFaultInjectionConfig(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	var config types.FaultInjectionConfig
+	err = JsonInterface.Unmarshal(data, &config)
+	if err != nil {
+		return err
+	}
+	for _, fault := range config.Faults {
+		if !Contains([]string{core.CommitFaultPoint, core.RevealFaultPoint, core.ProposeFaultPoint, core.DisputeFaultPoint}, fault.Point) {
+			return fmt.Errorf("invalid fault injection point: %s", fault.Point)
+		}
+		if !Contains([]string{core.RPCTimeoutFault, core.RevertedTransactionFault, core.CorruptStateFileFault}, fault.Type) {
+			return fmt.Errorf("invalid fault type: %s", fault.Type)
+		}
+		if fault.Count < 0 {
+			return errors.New("fault count cannot be negative")
+		}
+	}
+	injectedFaultMutex.Lock()
+	defer injectedFaultMutex.Unlock()
+	injectedFaults = config.Faults
+	if len(injectedFaults) > 0 {
+		log.Warnf("Fault injection is enabled with %d faults, do not use this on a live network!", len(injectedFaults))
+	}
+	return nil
+}
+
+//This function checks if a fault of the given type is configured at the given point and consumes it
+//A fault with count 0 is injected every time the point is reached
+func shouldInjectFault(point string, faultType string) bool {
+	injectedFaultMutex.Lock()
+	defer injectedFaultMutex.Unlock()
+	for i := range injectedFaults {
+		fault := &injectedFaults[i]
+		if fault.Point != point || fault.Type != faultType {
+			continue
+		}
+		if fault.Count == 0 {
+			return true
+		}
+		fault.Count--
+		if fault.Count == 0 {
+			injectedFaults = append(injectedFaults[:i], injectedFaults[i+1:]...)
+		}
+		return true
+	}
+	return false
+}
+
+//This function returns a simulated error if a fault of the given type is to be injected at the given point
+func InjectFault(point string, faultType string) error {
+	if !shouldInjectFault(point, faultType) {
+		return nil
+	}
+	log.Warnf("Injecting %s fault at %s", faultType, point)
+	switch faultType {
+	case core.RPCTimeoutFault:
+		return fmt.Errorf("injected fault: %w", context.DeadlineExceeded)
+	case core.RevertedTransactionFault:
+		return errors.New("injected fault: transaction mining unsuccessful")
+	}
+	return nil
+}
+
+//This function overwrites the state of the given state file in the state store with invalid data if a state file corruption is to be injected at the given point
+func InjectStateFileCorruption(point string, filePath string) error {
+	if !shouldInjectFault(point, core.CorruptStateFileFault) {
+		return nil
+	}
+	log.Warnf("Injecting %s fault at %s in file %s", core.CorruptStateFileFault, point, filePath)
+	return saveStateRecord(filePath, []byte("{corrupted"))
+}
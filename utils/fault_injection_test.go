@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"testing"
+)
+
+func TestLoadFaultInjectionConfig(t *testing.T) {
+	tests := []struct {
+		name       string
+		fileData   string
+		noFile     bool
+		wantFaults int
+		wantErr    bool
+	}{
+		{
+			name:       "Test 1: When the fault injection config is valid",
+			fileData:   `{"faults": [{"point": "commit", "type": "rpcTimeout", "count": 1}, {"point": "propose", "type": "corruptStateFile"}]}`,
+			wantFaults: 2,
+			wantErr:    false,
+		},
+		{
+			name:     "Test 2: When the fault injection point is invalid",
+			fileData: `{"faults": [{"point": "confirm", "type": "rpcTimeout"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 3: When the fault type is invalid",
+			fileData: `{"faults": [{"point": "commit", "type": "panic"}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 4: When the fault count is negative",
+			fileData: `{"faults": [{"point": "commit", "type": "rpcTimeout", "count": -1}]}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 5: When the config file is not valid json",
+			fileData: `{"faults": [`,
+			wantErr:  true,
+		},
+		{
+			name:    "Test 6: When the config file doesn't exist",
+			noFile:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+			injectedFaults = nil
+			filePath := filepath.Join(t.TempDir(), "faults.json")
+			if !tt.noFile {
+				if err := os.WriteFile(filePath, []byte(tt.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := LoadFaultInjectionConfig(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadFaultInjectionConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(injectedFaults) != tt.wantFaults {
+				t.Errorf("LoadFaultInjectionConfig() loaded %d faults, want %d", len(injectedFaults), tt.wantFaults)
+			}
+		})
+	}
+	injectedFaults = nil
+}
+
+func TestInjectFault(t *testing.T) {
+	defer func() { injectedFaults = nil }()
+	injectedFaults = []types.Fault{
+		{Point: core.CommitFaultPoint, Type: core.RPCTimeoutFault, Count: 2},
+		{Point: core.RevealFaultPoint, Type: core.RevertedTransactionFault},
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := InjectFault(core.CommitFaultPoint, core.RPCTimeoutFault); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("InjectFault() error = %v, want timeout error", err)
+		}
+	}
+	if err := InjectFault(core.CommitFaultPoint, core.RPCTimeoutFault); err != nil {
+		t.Errorf("InjectFault() error = %v after the fault count is consumed, want nil", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := InjectFault(core.RevealFaultPoint, core.RevertedTransactionFault); err == nil {
+			t.Error("InjectFault() error = nil for a fault without count, want error")
+		}
+	}
+	if err := InjectFault(core.ProposeFaultPoint, core.RPCTimeoutFault); err != nil {
+		t.Errorf("InjectFault() error = %v at a point without faults, want nil", err)
+	}
+}
+
+func TestInjectStateFileCorruption(t *testing.T) {
+	defer func() { injectedFaults = nil }()
+	injectedFaults = []types.Fault{
+		{Point: core.CommitFaultPoint, Type: core.CorruptStateFileFault, Count: 1},
+	}
+	StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	filePath := filepath.Join(t.TempDir(), "commitData.json")
+	if err := saveStateRecord(filePath, []byte(`{"epoch": 1}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := InjectStateFileCorruption(core.ProposeFaultPoint, filePath); err != nil {
+		t.Errorf("InjectStateFileCorruption() error = %v, want nil", err)
+	}
+	if err := InjectStateFileCorruption(core.CommitFaultPoint, filePath); err != nil {
+		t.Errorf("InjectStateFileCorruption() error = %v, want nil", err)
+	}
+	data, err := readStateRecord(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var commitFileData types.CommitFileData
+	if err := JsonInterface.Unmarshal(data, &commitFileData); err == nil {
+		t.Error("InjectStateFileCorruption() didn't corrupt the state file")
+	}
+}
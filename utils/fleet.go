@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"razor/metrics"
+	"razor/path"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Fields of the configuration which are specific to each node of a fleet and aren't compared across the fleet
+var fleetNodeConfigFields = []string{"Provider", "ReadProvider", "SignerUrl"}
+
+//Hash of an override file which doesn't exist
+const fleetFileAbsent = "absent"
+
+var (
+	fleetMode       bool
+	lastFleetReport types.FleetReport
+	fleetMutex      sync.Mutex
+)
+
+var fleetClient = &http.Client{Timeout: 10 * time.Second}
+
+//This function sets the fleet mode in which the hashes of the configuration and of the override files are reported
+func SetFleetMode(enabled bool) {
+	fleetMutex.Lock()
+	defer fleetMutex.Unlock()
+	fleetMode = enabled
+}
+
+//This function returns if the fleet mode is enabled
+func IsFleetMode() bool {
+	fleetMutex.Lock()
+	defer fleetMutex.Unlock()
+	return fleetMode
+}
+
+//This function returns the hashes of every field of the effective configuration and of every override file of the jobs
+//The hash of the configuration excludes the fields which are specific to each node, the hash of the overrides is of assets.json and the data override file
+func GetFleetReport(config types.Configurations) (types.FleetReport, error) {
+	components := make(map[string]string)
+	configValue := reflect.ValueOf(config)
+	for i := 0; i < configValue.NumField(); i++ {
+		field := configValue.Type().Field(i).Name
+		if Contains(fleetNodeConfigFields, field) {
+			continue
+		}
+		fieldData, err := json.Marshal(configValue.Field(i).Interface())
+		if err != nil {
+			return types.FleetReport{}, err
+		}
+		components["config."+field] = hashFleetData(fieldData)
+	}
+
+	assetsFilePath, err := path.PathUtilsInterface.GetJobFilePath()
+	if err != nil {
+		return types.FleetReport{}, err
+	}
+	dataOverrideFilePath, err := path.PathUtilsInterface.GetDataOverrideFilePath()
+	if err != nil {
+		return types.FleetReport{}, err
+	}
+	for _, filePath := range []string{assetsFilePath, dataOverrideFilePath} {
+		fileHash, err := hashFleetFile(filePath)
+		if err != nil {
+			return types.FleetReport{}, err
+		}
+		components["overrides."+filepath.Base(filePath)] = fileHash
+	}
+
+	return types.FleetReport{
+		Version:       core.VersionWithMeta,
+		ConfigHash:    hashFleetComponents(components, "config."),
+		OverridesHash: hashFleetComponents(components, "overrides."),
+		Components:    components,
+	}, nil
+}
+
+//This function reports the hashes of the configuration and of the override files to the metrics server, the change of the hashes is logged
+func ReportFleetConfig(config types.Configurations) {
+	report, err := GetFleetReport(config)
+	if err != nil {
+		log.Error("Error in getting fleet report: ", err)
+		return
+	}
+	fleetMutex.Lock()
+	changed := report.ConfigHash != lastFleetReport.ConfigHash || report.OverridesHash != lastFleetReport.OverridesHash
+	lastFleetReport = report
+	fleetMutex.Unlock()
+	if !changed {
+		return
+	}
+	log.Infof("Reporting config hash %s and overrides hash %s to the fleet", report.ConfigHash, report.OverridesHash)
+	metrics.FleetConfigMetric.Reset()
+	metrics.FleetConfigMetric.WithLabelValues(report.ConfigHash, report.OverridesHash).Set(1)
+	metrics.SetFleetReport(report)
+}
+
+//This function fetches the fleet report from the metrics server of a node
+func FetchFleetReport(nodeUrl string) (types.FleetReport, error) {
+	reportUrl := strings.TrimSuffix(nodeUrl, "/") + metrics.FleetEndpoint
+	response, err := fleetClient.Get(reportUrl)
+	if err != nil {
+		return types.FleetReport{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotFound {
+		return types.FleetReport{}, fmt.Errorf("%s doesn't serve a fleet report, the node should vote with --fleet and --metricsPort", nodeUrl)
+	}
+	if response.StatusCode != http.StatusOK {
+		return types.FleetReport{}, fmt.Errorf("request to %s failed with status %s", reportUrl, response.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return types.FleetReport{}, err
+	}
+	var report types.FleetReport
+	err = json.Unmarshal(data, &report)
+	if err != nil {
+		return types.FleetReport{}, errors.New("invalid fleet report: " + err.Error())
+	}
+	return report, nil
+}
+
+func hashFleetFile(filePath string) (string, error) {
+	fileData, err := OS.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return fleetFileAbsent, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return hashFleetData(fileData), nil
+}
+
+//This function returns the hash of the components with the prefix, the components are hashed in the order of their names
+func hashFleetComponents(components map[string]string, prefix string) string {
+	var names []string
+	for name := range components {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name + "=" + components[name] + "\n"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+func hashFleetData(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])[:16]
+}
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/core/types"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"testing"
+)
+
+func TestGetFleetReport(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}})
+
+	dir := t.TempDir()
+	assetsFilePath := filepath.Join(dir, "assets.json")
+	staleAssetsFilePath := filepath.Join(dir, "stale", "assets.json")
+	dataOverrideFilePath := filepath.Join(dir, "dataOverride.json")
+	if err := os.WriteFile(assetsFilePath, []byte(`{"assets": {"collection": {}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(staleAssetsFilePath), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(staleAssetsFilePath, []byte(`{"assets": {"collection": {"ethCollectionMean": {}}}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := types.Configurations{
+		Provider:      "https://node1.example.com",
+		GasMultiplier: 1,
+		BufferPercent: 20,
+		WaitTime:      1,
+		LogLevel:      "debug",
+	}
+	otherNodeConfig := config
+	otherNodeConfig.Provider = "https://node2.example.com"
+	otherNodeConfig.SignerUrl = "https://signer2.example.com"
+	driftedConfig := config
+	driftedConfig.BufferPercent = 30
+
+	getReport := func(config types.Configurations, assetsFilePath string, pathErr error) (types.FleetReport, error) {
+		pathUtilsMock := new(pathMocks.PathInterface)
+		path.PathUtilsInterface = pathUtilsMock
+		pathUtilsMock.On("GetJobFilePath").Return(assetsFilePath, pathErr)
+		pathUtilsMock.On("GetDataOverrideFilePath").Return(dataOverrideFilePath, nil)
+		return GetFleetReport(config)
+	}
+
+	report, err := getReport(config, assetsFilePath, nil)
+	if err != nil {
+		t.Fatalf("GetFleetReport() error = %v", err)
+	}
+	if _, ok := report.Components["config.Provider"]; ok {
+		t.Error("GetFleetReport() reported the provider which is specific to each node")
+	}
+	if report.Components["overrides.dataOverride.json"] != fleetFileAbsent {
+		t.Errorf("GetFleetReport() reported %s for the data override file which doesn't exist", report.Components["overrides.dataOverride.json"])
+	}
+
+	t.Run("Test 1: When the nodes differ only in the fields specific to each node", func(t *testing.T) {
+		got, err := getReport(otherNodeConfig, assetsFilePath, nil)
+		if err != nil {
+			t.Fatalf("GetFleetReport() error = %v", err)
+		}
+		if got.ConfigHash != report.ConfigHash || got.OverridesHash != report.OverridesHash {
+			t.Errorf("GetFleetReport() got hashes %s %s, want %s %s", got.ConfigHash, got.OverridesHash, report.ConfigHash, report.OverridesHash)
+		}
+	})
+
+	t.Run("Test 2: When the configuration of the node differs", func(t *testing.T) {
+		got, err := getReport(driftedConfig, assetsFilePath, nil)
+		if err != nil {
+			t.Fatalf("GetFleetReport() error = %v", err)
+		}
+		if got.ConfigHash == report.ConfigHash || got.OverridesHash != report.OverridesHash {
+			t.Errorf("GetFleetReport() got hashes %s %s, want a different config hash and overrides hash %s", got.ConfigHash, got.OverridesHash, report.OverridesHash)
+		}
+		if got.Components["config.BufferPercent"] == report.Components["config.BufferPercent"] {
+			t.Error("GetFleetReport() reported the same hash for a different buffer percent")
+		}
+	})
+
+	t.Run("Test 3: When the node runs stale overrides", func(t *testing.T) {
+		got, err := getReport(config, staleAssetsFilePath, nil)
+		if err != nil {
+			t.Fatalf("GetFleetReport() error = %v", err)
+		}
+		if got.ConfigHash != report.ConfigHash || got.OverridesHash == report.OverridesHash {
+			t.Errorf("GetFleetReport() got hashes %s %s, want config hash %s and a different overrides hash", got.ConfigHash, got.OverridesHash, report.ConfigHash)
+		}
+	})
+
+	t.Run("Test 4: When there is an error in getting the path of assets.json", func(t *testing.T) {
+		_, err := getReport(config, "", errors.New("path error"))
+		if err == nil {
+			t.Error("GetFleetReport() expected an error")
+		}
+	})
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//gasBudgetTransaction is a signed transaction whose cost is counted in the gas budget of its period until its receipt is read
+type gasBudgetTransaction struct {
+	period     string
+	methodName string
+	gasPrice   *big.Int
+	cost       *big.Int
+}
+
+var ErrGasBudgetExceeded = errors.New("gas budget exceeded")
+
+var (
+	gasBudgetPeriod       string
+	gasBudgetSpent        = big.NewInt(0)
+	gasBudgetTransactions = make(map[common.Hash]gasBudgetTransaction)
+	lastRevealCost        = big.NewInt(0)
+	gasBudgetMutex        sync.Mutex
+)
+
+//This function checks that the gas budget period is valid
+func ValidateGasBudgetPeriod(period string) error {
+	if !Contains(core.GasBudgetPeriods, period) {
+		return fmt.Errorf("invalid gasBudgetPeriod %s, valid periods are %s and %s", period, core.EpochGasBudgetPeriod, core.DayGasBudgetPeriod)
+	}
+	return nil
+}
+
+//This function returns the gas budget in wei of the gas budget in the native token
+func getGasBudgetInWei(gasBudget float32) *big.Int {
+	budget, ok := new(big.Rat).SetString(strconv.FormatFloat(float64(gasBudget), 'f', -1, 32))
+	if !ok {
+		return big.NewInt(0)
+	}
+	budget.Mul(budget, new(big.Rat).SetInt(big.NewInt(1e18)))
+	return new(big.Int).Quo(budget.Num(), budget.Denom())
+}
+
+//This function returns the name of the current period of the gas budget, which is the epoch or the UTC day
+func getCurrentGasBudgetPeriod(client *ethclient.Client, period string) (string, error) {
+	if period == core.DayGasBudgetPeriod {
+		return "day " + time.Now().UTC().Format("2006-01-02"), nil
+	}
+	epoch, err := UtilsInterface.GetEpoch(client)
+	if err != nil {
+		return "", err
+	}
+	return "epoch " + strconv.FormatUint(uint64(epoch), 10), nil
+}
+
+//This function returns a signer which counts the cost of the signed transactions in the gas budget of the current period
+//The non critical transactions aren't signed if their cost and the cost of the last reveal exceed the remaining budget, so that the reveal can still be sent
+//The gas budget isn't checked if it is 0 or if the transactions aren't sent
+func getGasBudgetSigner(signer bind.SignerFn, client *ethclient.Client, config types.Configurations, methodName string) bind.SignerFn {
+	return func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+		if config.GasBudget <= 0 || IsNoSendMode() {
+			return signer(address, txn)
+		}
+		period, err := getCurrentGasBudgetPeriod(client, config.GasBudgetPeriod)
+		if err != nil {
+			log.Error("Error in getting period of gas budget, the transaction isn't counted in the budget: ", err)
+			return signer(address, txn)
+		}
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(txn.Gas()), txn.GasPrice())
+		err = checkGasBudget(period, getGasBudgetInWei(config.GasBudget), methodName, cost)
+		if err != nil {
+			return nil, err
+		}
+		signedTxn, err := signer(address, txn)
+		if err != nil {
+			return nil, err
+		}
+		addGasBudgetTransaction(signedTxn.Hash(), gasBudgetTransaction{period: period, methodName: methodName, gasPrice: signedTxn.GasPrice(), cost: cost})
+		return signedTxn, nil
+	}
+}
+
+//This function checks that the cost of the transaction of the method doesn't exceed the remaining gas budget of the period
+//The critical transactions are always sent and only a warning is logged if they exceed the budget
+func checkGasBudget(period string, budget *big.Int, methodName string, cost *big.Int) error {
+	gasBudgetMutex.Lock()
+	defer gasBudgetMutex.Unlock()
+	spent := big.NewInt(0)
+	if period == gasBudgetPeriod {
+		spent.Set(gasBudgetSpent)
+	}
+	projectedSpend := new(big.Int).Add(spent, cost)
+	if Contains(core.NonCriticalTransactions, methodName) {
+		if projectedSpend.Add(projectedSpend, lastRevealCost).Cmp(budget) > 0 {
+			return fmt.Errorf("%w: %s transaction of cost %s is skipped, %s of the gas budget of %s is spent in %s", ErrGasBudgetExceeded, methodName, GetAmountInDecimal(cost).Text('f', 18), GetAmountInDecimal(spent).Text('f', 18), GetAmountInDecimal(budget).Text('f', 18), period)
+		}
+	} else if projectedSpend.Cmp(budget) > 0 {
+		log.Warnf("%s transaction of cost %s exceeds the gas budget of %s in %s, it is still sent as it is critical", methodName, GetAmountInDecimal(cost).Text('f', 18), GetAmountInDecimal(budget).Text('f', 18), period)
+	}
+	return nil
+}
+
+//This function counts the cost of the signed transaction in the gas budget of its period, the spend of the previous period is dropped when a new period starts
+func addGasBudgetTransaction(hash common.Hash, transaction gasBudgetTransaction) {
+	gasBudgetMutex.Lock()
+	defer gasBudgetMutex.Unlock()
+	if transaction.period != gasBudgetPeriod {
+		gasBudgetPeriod = transaction.period
+		gasBudgetSpent = big.NewInt(0)
+		for pendingHash, pendingTransaction := range gasBudgetTransactions {
+			if pendingTransaction.period != gasBudgetPeriod {
+				delete(gasBudgetTransactions, pendingHash)
+			}
+		}
+	}
+	gasBudgetSpent.Add(gasBudgetSpent, transaction.cost)
+	gasBudgetTransactions[hash] = transaction
+	if transaction.methodName == "reveal" {
+		lastRevealCost = transaction.cost
+	}
+}
+
+//This function replaces the cost of the transaction in the gas budget, which is counted at its gas limit when it is signed, by the cost of the gas it used
+func settleGasBudgetTransaction(hash common.Hash, gasUsed uint64) {
+	gasBudgetMutex.Lock()
+	defer gasBudgetMutex.Unlock()
+	transaction, ok := gasBudgetTransactions[hash]
+	if !ok {
+		return
+	}
+	delete(gasBudgetTransactions, hash)
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), transaction.gasPrice)
+	if transaction.period == gasBudgetPeriod {
+		gasBudgetSpent.Sub(gasBudgetSpent, transaction.cost)
+		gasBudgetSpent.Add(gasBudgetSpent, cost)
+	}
+	if transaction.methodName == "reveal" {
+		lastRevealCost = cost
+	}
+}
+
+//This function resets the spend of the gas budget
+func resetGasBudget() {
+	gasBudgetMutex.Lock()
+	defer gasBudgetMutex.Unlock()
+	gasBudgetPeriod = ""
+	gasBudgetSpent = big.NewInt(0)
+	gasBudgetTransactions = make(map[common.Hash]gasBudgetTransaction)
+	lastRevealCost = big.NewInt(0)
+}
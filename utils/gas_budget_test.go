@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+func TestGetGasBudgetSigner(t *testing.T) {
+	var client *ethclient.Client
+	day, _ := getCurrentGasBudgetPeriod(client, "day")
+	to := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+	// The transaction costs 0.0001 of the gas budget of 0.001
+	txn := Types.NewTransaction(1, to, big.NewInt(0), 100000, big.NewInt(1e9), nil)
+
+	type args struct {
+		config      types.Configurations
+		methodName  string
+		spentPeriod string
+		spent       int64
+		revealCost  int64
+		epoch       uint32
+		epochErr    error
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantSpent int64
+		wantErr   bool
+	}{
+		{
+			name: "Test 1: When there is no gas budget",
+			args: args{
+				methodName:  "claimStakerReward",
+				spentPeriod: day,
+				spent:       2e15,
+			},
+			wantSpent: 2e15,
+			wantErr:   false,
+		},
+		{
+			name: "Test 2: When a non critical transaction is in the gas budget",
+			args: args{
+				config:     types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "day"},
+				methodName: "claimStakerReward",
+			},
+			wantSpent: 1e14,
+			wantErr:   false,
+		},
+		{
+			name: "Test 3: When a non critical transaction exceeds the gas budget",
+			args: args{
+				config:      types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "day"},
+				methodName:  "redeemBounty",
+				spentPeriod: day,
+				spent:       95e13,
+			},
+			wantSpent: 95e13,
+			wantErr:   true,
+		},
+		{
+			name: "Test 4: When a critical transaction exceeds the gas budget",
+			args: args{
+				config:      types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "day"},
+				methodName:  "reveal",
+				spentPeriod: day,
+				spent:       95e13,
+			},
+			wantSpent: 105e13,
+			wantErr:   false,
+		},
+		{
+			name: "Test 5: When a non critical transaction leaves no gas budget for the reveal",
+			args: args{
+				config:      types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "day"},
+				methodName:  "resetDispute",
+				spentPeriod: day,
+				spent:       85e13,
+				revealCost:  1e14,
+			},
+			wantSpent: 85e13,
+			wantErr:   true,
+		},
+		{
+			name: "Test 6: When the gas budget of the previous epoch is spent",
+			args: args{
+				config:      types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "epoch"},
+				methodName:  "claimStakerReward",
+				spentPeriod: "epoch 9",
+				spent:       95e13,
+				epoch:       10,
+			},
+			wantSpent: 1e14,
+			wantErr:   false,
+		},
+		{
+			name: "Test 7: When there is an error in getting epoch",
+			args: args{
+				config:      types.Configurations{GasBudget: 0.001, GasBudgetPeriod: "epoch"},
+				methodName:  "claimStakerReward",
+				spentPeriod: "epoch 9",
+				spent:       95e13,
+				epochErr:    errors.New("epoch error"),
+			},
+			wantSpent: 95e13,
+			wantErr:   false,
+		},
+	}
+	defer resetGasBudget()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetEpoch", client).Return(tt.args.epoch, tt.args.epochErr)
+
+			resetGasBudget()
+			if tt.args.spent != 0 {
+				addGasBudgetTransaction(common.Hash{1}, gasBudgetTransaction{period: tt.args.spentPeriod, gasPrice: big.NewInt(1e9), cost: big.NewInt(tt.args.spent)})
+			}
+			lastRevealCost = big.NewInt(tt.args.revealCost)
+
+			signed := false
+			signer := getGasBudgetSigner(func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+				signed = true
+				return txn, nil
+			}, client, tt.args.config, tt.args.methodName)
+			_, err := signer(to, txn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("getGasBudgetSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrGasBudgetExceeded) {
+				t.Errorf("getGasBudgetSigner() error = %v, want ErrGasBudgetExceeded", err)
+			}
+			if signed == tt.wantErr {
+				t.Errorf("getGasBudgetSigner() signed = %v, want %v", signed, !tt.wantErr)
+			}
+			if gasBudgetSpent.Cmp(big.NewInt(tt.wantSpent)) != 0 {
+				t.Errorf("gas budget spent = %s, want %d", gasBudgetSpent, tt.wantSpent)
+			}
+		})
+	}
+}
+
+func TestSettleGasBudgetTransaction(t *testing.T) {
+	defer resetGasBudget()
+	resetGasBudget()
+	revealHash := common.Hash{1}
+	addGasBudgetTransaction(revealHash, gasBudgetTransaction{period: "epoch 10", methodName: "reveal", gasPrice: big.NewInt(1e9), cost: big.NewInt(1e14)})
+	addGasBudgetTransaction(common.Hash{2}, gasBudgetTransaction{period: "epoch 10", methodName: "claimStakerReward", gasPrice: big.NewInt(1e9), cost: big.NewInt(1e14)})
+
+	// The cost counted at the gas limit of the reveal is replaced by the cost of the gas it used, only once
+	settleGasBudgetTransaction(revealHash, 60000)
+	settleGasBudgetTransaction(revealHash, 60000)
+	if gasBudgetSpent.Cmp(big.NewInt(16e13)) != 0 || lastRevealCost.Cmp(big.NewInt(6e13)) != 0 {
+		t.Errorf("gas budget spent = %s and last reveal cost = %s, want 160000000000000 and 60000000000000", gasBudgetSpent, lastRevealCost)
+	}
+
+	// The transactions of the previous period aren't settled in the spend of the new period
+	addGasBudgetTransaction(common.Hash{3}, gasBudgetTransaction{period: "epoch 11", methodName: "commit", gasPrice: big.NewInt(1e9), cost: big.NewInt(1e14)})
+	settleGasBudgetTransaction(common.Hash{2}, 50000)
+	if gasBudgetSpent.Cmp(big.NewInt(1e14)) != 0 {
+		t.Errorf("gas budget spent = %s, want 100000000000000", gasBudgetSpent)
+	}
+}
+
+func TestGetGasBudgetInWei(t *testing.T) {
+	if got := getGasBudgetInWei(0.1); got.Cmp(big.NewInt(1e17)) != 0 {
+		t.Errorf("getGasBudgetInWei() = %s, want 100000000000000000", got)
+	}
+}
@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"razor/core"
+	"razor/core/types"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/tidwall/gjson"
+)
+
+//Maximum size of the response of the gas oracle which is read
+const gasOracleMaxSize = 1 << 20
+
+var (
+	rpcClients      = make(map[*ethclient.Client]*rpc.Client)
+	rpcClientsMutex sync.Mutex
+	gasOracleClient = &http.Client{Timeout: core.GasOracleTimeout}
+)
+
+//Classes of the transactions of the methods for which a gas strategy can be selected, the other methods are of the default class
+var gasStrategyClassOfMethod = map[string]string{
+	"commit":                             "commit",
+	"reveal":                             "reveal",
+	"propose":                            "propose",
+	"giveSorted":                         "dispute",
+	"finalizeDispute":                    "dispute",
+	"resetDispute":                       "dispute",
+	"disputeBiggestStakeProposed":        "dispute",
+	"disputeCollectionIdShouldBeAbsent":  "dispute",
+	"disputeCollectionIdShouldBePresent": "dispute",
+	"disputeOnOrderOfIds":                "dispute",
+	"claimBlockReward":                   "confirm",
+	"claimStakerReward":                  "claim",
+	"redeemBounty":                       "claim",
+	"unlockWithdraw":                     "claim",
+}
+
+//feeHistoryResult is the result of eth_feeHistory
+type feeHistoryResult struct {
+	BaseFees []*hexutil.Big   `json:"baseFeePerGas"`
+	Rewards  [][]*hexutil.Big `json:"reward"`
+}
+
+//This function returns a client of the RPC client and keeps the RPC client, so that the methods which ethclient doesn't have can be called
+func newEthClient(rpcClient *rpc.Client) *ethclient.Client {
+	client := ethclient.NewClient(rpcClient)
+	rpcClientsMutex.Lock()
+	defer rpcClientsMutex.Unlock()
+	rpcClients[client] = rpcClient
+	return client
+}
+
+func getRPCClient(client *ethclient.Client) (*rpc.Client, bool) {
+	rpcClientsMutex.Lock()
+	defer rpcClientsMutex.Unlock()
+	rpcClient, ok := rpcClients[client]
+	return rpcClient, ok
+}
+
+//This function returns the base fees and the percentiles of the priority fees of the last blocks
+//The base fees have one more entry than the blocks, which is the base fee of the next block
+func getFeeHistory(client *ethclient.Client, ctx context.Context, blockCount uint64, percentiles []float64) (*types.FeeHistory, error) {
+	rpcClient, ok := getRPCClient(client)
+	if !ok {
+		return nil, errors.New("fee history can't be fetched with the client")
+	}
+	var result feeHistoryResult
+	err := rpcClient.CallContext(ctx, &result, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", percentiles)
+	if err != nil {
+		return nil, err
+	}
+	feeHistory := &types.FeeHistory{}
+	for _, baseFee := range result.BaseFees {
+		feeHistory.BaseFees = append(feeHistory.BaseFees, baseFee.ToInt())
+	}
+	for _, blockRewards := range result.Rewards {
+		var rewards []*big.Int
+		for _, reward := range blockRewards {
+			rewards = append(rewards, reward.ToInt())
+		}
+		feeHistory.Rewards = append(feeHistory.Rewards, rewards)
+	}
+	return feeHistory, nil
+}
+
+//This function splits the gas strategy into its name and its argument, the argument of the oracle strategy is the url of the oracle
+func splitGasStrategy(strategy string) (string, string) {
+	nameAndArgument := strings.SplitN(strategy, ":", 2)
+	if len(nameAndArgument) == 1 {
+		return strings.TrimSpace(nameAndArgument[0]), ""
+	}
+	return strings.TrimSpace(nameAndArgument[0]), strings.TrimSpace(nameAndArgument[1])
+}
+
+//This function checks that the gas strategies are selected for valid classes and are valid strategies
+//The oracle strategy should be of the form oracle:<url>#<selector>, where the selector is the path of the gas price in gwei in the JSON response
+func ValidateGasStrategies(gasStrategies map[string]string) error {
+	for class, strategy := range gasStrategies {
+		if !Contains(core.GasStrategyClasses, class) {
+			return fmt.Errorf("invalid class %s in gasStrategies, valid classes are %s", class, strings.Join(core.GasStrategyClasses, ", "))
+		}
+		name, argument := splitGasStrategy(strategy)
+		if !Contains(core.GasStrategies, name) {
+			return fmt.Errorf("invalid gas strategy %s of %s class, valid strategies are %s", strategy, class, strings.Join(core.GasStrategies, ", "))
+		}
+		if name != core.OracleGasStrategy {
+			if argument != "" {
+				return fmt.Errorf("invalid gas strategy %s of %s class, only the oracle strategy takes a url", strategy, class)
+			}
+			continue
+		}
+		oracleUrl, err := url.Parse(argument)
+		if err != nil || (oracleUrl.Scheme != "http" && oracleUrl.Scheme != "https") || oracleUrl.Host == "" || oracleUrl.Fragment == "" {
+			return fmt.Errorf("invalid gas strategy %s of %s class, it should be of the form oracle:<url>#<selector>", strategy, class)
+		}
+	}
+	return nil
+}
+
+//This function returns the class of the transaction of the method
+func getGasStrategyClass(methodName string) string {
+	if class, ok := gasStrategyClassOfMethod[methodName]; ok {
+		return class
+	}
+	return core.DefaultGasStrategyClass
+}
+
+//This function returns the gas price of the transaction of the method by the gas strategy selected for its class, the suggested strategy is used if none is selected
+//If the gas price of a strategy can't be fetched the suggested strategy is used, and the gas price is capped at the maximum gas price of the config if it is set
+func getGasPriceOfStrategy(client *ethclient.Client, config types.Configurations, methodName string) *big.Int {
+	class := getGasStrategyClass(methodName)
+	strategy, ok := config.GasStrategies[class]
+	if !ok {
+		strategy = config.GasStrategies[core.DefaultGasStrategyClass]
+	}
+	name, argument := splitGasStrategy(strategy)
+
+	var (
+		gasPrice *big.Int
+		err      error
+	)
+	switch name {
+	case core.SlowGasStrategy, core.StandardGasStrategy, core.FastGasStrategy:
+		gasPrice, err = getFeeHistoryGasPrice(client, core.GasStrategyPercentiles[name])
+	case core.OracleGasStrategy:
+		gasPrice, err = getOracleGasPrice(argument)
+	case core.FixedGasStrategy:
+		gas := big.NewInt(1).Mul(big.NewInt(int64(config.GasPrice)), big.NewInt(1e9))
+		gasPrice = UtilsInterface.MultiplyFloatAndBigInt(gas, float64(config.GasMultiplier))
+	default:
+		name = core.SuggestedGasStrategy
+	}
+	if err != nil {
+		log.Errorf("Error in getting gas price of %s gas strategy, using the suggested gas price: %v", name, err)
+		name = core.SuggestedGasStrategy
+	}
+	if name == core.SuggestedGasStrategy {
+		gasPrice = UtilsInterface.GetGasPrice(client, config)
+	}
+	log.Debugf("Gas price of %s gas strategy for %s: %s", name, class, gasPrice)
+
+	if config.MaxGasPrice > 0 {
+		maxGasPrice := big.NewInt(1).Mul(big.NewInt(int64(config.MaxGasPrice)), big.NewInt(1e9))
+		if gasPrice.Cmp(maxGasPrice) > 0 {
+			log.Warnf("Gas price %s is more than the maximum gas price, capping it at %s", gasPrice, maxGasPrice)
+			gasPrice = maxGasPrice
+		}
+	}
+	return gasPrice
+}
+
+//This function returns the base fee of the next block and the median of the percentile of the priority fees of the last core.FeeHistoryBlocks blocks
+func getFeeHistoryGasPrice(client *ethclient.Client, percentile float64) (*big.Int, error) {
+	feeHistory, err := ClientInterface.FeeHistory(client, context.Background(), uint64(core.FeeHistoryBlocks), []float64{percentile})
+	if err != nil {
+		return nil, err
+	}
+	var rewards []*big.Int
+	for _, blockRewards := range feeHistory.Rewards {
+		if len(blockRewards) > 0 && blockRewards[0] != nil {
+			rewards = append(rewards, blockRewards[0])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil, errors.New("fee history has no priority fees")
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return rewards[i].Cmp(rewards[j]) < 0
+	})
+	gasPrice := new(big.Int).Set(rewards[len(rewards)/2])
+	if len(feeHistory.BaseFees) > 0 && feeHistory.BaseFees[len(feeHistory.BaseFees)-1] != nil {
+		gasPrice.Add(gasPrice, feeHistory.BaseFees[len(feeHistory.BaseFees)-1])
+	}
+	return gasPrice, nil
+}
+
+//This function returns the gas price in wei from the gas oracle, the url is of the form <url>#<selector> where the selector is the path of the gas price in gwei in the JSON response
+func getOracleGasPrice(oracleUrl string) (*big.Int, error) {
+	parsedUrl, err := url.Parse(oracleUrl)
+	if err != nil {
+		return nil, err
+	}
+	selector := parsedUrl.Fragment
+	parsedUrl.Fragment = ""
+	response, err := gasOracleClient.Get(parsedUrl.String())
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request to gas oracle failed with status %s", response.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(response.Body, gasOracleMaxSize))
+	if err != nil {
+		return nil, err
+	}
+	value := gjson.GetBytes(body, selector)
+	if value.Type != gjson.Number && value.Type != gjson.String {
+		return nil, fmt.Errorf("gas oracle response has no gas price at %s", selector)
+	}
+	gwei, ok := new(big.Float).SetString(strings.TrimSpace(value.String()))
+	if !ok || gwei.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid gas price %s of gas oracle", value.String())
+	}
+	gasPrice, _ := gwei.Mul(gwei, big.NewFloat(1e9)).Int(nil)
+	return gasPrice, nil
+}
@@ -0,0 +1,242 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+)
+
+//feeHistoryService serves eth_feeHistory in the tests
+type feeHistoryService struct {
+	result feeHistoryResult
+}
+
+func (s *feeHistoryService) FeeHistory(blockCount hexutil.Uint64, lastBlock string, percentiles []float64) (*feeHistoryResult, error) {
+	if len(percentiles) != 1 || lastBlock != "latest" {
+		return nil, errors.New("invalid params")
+	}
+	return &s.result, nil
+}
+
+func gwei(value int64) *big.Int {
+	return big.NewInt(1).Mul(big.NewInt(value), big.NewInt(1e9))
+}
+
+func TestValidateGasStrategies(t *testing.T) {
+	tests := []struct {
+		name          string
+		gasStrategies map[string]string
+		wantErr       bool
+	}{
+		{
+			name:          "Test 1: When the gas strategies are valid",
+			gasStrategies: map[string]string{"reveal": "fast", "dispute": "oracle:https://gas.example.com/api?chain=1#result.fast", "claim": "slow", "default": "suggested"},
+			wantErr:       false,
+		},
+		{
+			name:          "Test 2: When a class is invalid",
+			gasStrategies: map[string]string{"vote": "fast"},
+			wantErr:       true,
+		},
+		{
+			name:          "Test 3: When a strategy is invalid",
+			gasStrategies: map[string]string{"claim": "cheapest"},
+			wantErr:       true,
+		},
+		{
+			name:          "Test 4: When the oracle strategy has no selector",
+			gasStrategies: map[string]string{"dispute": "oracle:https://gas.example.com/api"},
+			wantErr:       true,
+		},
+		{
+			name:          "Test 5: When the oracle strategy has no url",
+			gasStrategies: map[string]string{"dispute": "oracle"},
+			wantErr:       true,
+		},
+		{
+			name:          "Test 6: When a strategy other than oracle has a url",
+			gasStrategies: map[string]string{"dispute": "fast:https://gas.example.com/api#fast"},
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateGasStrategies(tt.gasStrategies); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateGasStrategies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetGasPriceOfStrategy(t *testing.T) {
+	var client *ethclient.Client
+
+	oracle := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"result":{"safe":"20","fast":45.5}}`)
+	}))
+	defer oracle.Close()
+
+	feeHistory := &types.FeeHistory{
+		BaseFees: []*big.Int{gwei(10), gwei(12), gwei(14)},
+		Rewards:  [][]*big.Int{{gwei(3)}, {gwei(1)}, {gwei(2)}},
+	}
+
+	type args struct {
+		config        types.Configurations
+		methodName    string
+		feeHistory    *types.FeeHistory
+		feeHistoryErr error
+	}
+	tests := []struct {
+		name string
+		args args
+		want *big.Int
+	}{
+		{
+			name: "Test 1: When no gas strategy is selected",
+			args: args{
+				methodName: "reveal",
+			},
+			want: gwei(5),
+		},
+		{
+			name: "Test 2: When the fast strategy is selected for the class of the method",
+			args: args{
+				config:     types.Configurations{GasStrategies: map[string]string{"dispute": "fast"}},
+				methodName: "finalizeDispute",
+				feeHistory: feeHistory,
+			},
+			want: gwei(16),
+		},
+		{
+			name: "Test 3: When the default strategy is used for a class without a strategy",
+			args: args{
+				config:     types.Configurations{GasStrategies: map[string]string{"dispute": "fast", "default": "slow"}},
+				methodName: "stake",
+				feeHistory: feeHistory,
+			},
+			want: gwei(16),
+		},
+		{
+			name: "Test 4: When there is an error in fetching the fee history",
+			args: args{
+				config:        types.Configurations{GasStrategies: map[string]string{"reveal": "standard"}},
+				methodName:    "reveal",
+				feeHistoryErr: errors.New("method not found"),
+			},
+			want: gwei(5),
+		},
+		{
+			name: "Test 5: When the fee history has no priority fees",
+			args: args{
+				config:     types.Configurations{GasStrategies: map[string]string{"reveal": "standard"}},
+				methodName: "reveal",
+				feeHistory: &types.FeeHistory{BaseFees: []*big.Int{gwei(10)}},
+			},
+			want: gwei(5),
+		},
+		{
+			name: "Test 6: When the oracle strategy is selected",
+			args: args{
+				config:     types.Configurations{GasStrategies: map[string]string{"claim": "oracle:" + oracle.URL + "#result.fast"}},
+				methodName: "claimStakerReward",
+			},
+			want: big.NewInt(45500000000),
+		},
+		{
+			name: "Test 7: When the oracle has no gas price at the selector",
+			args: args{
+				config:     types.Configurations{GasStrategies: map[string]string{"claim": "oracle:" + oracle.URL + "#result.slow"}},
+				methodName: "claimStakerReward",
+			},
+			want: gwei(5),
+		},
+		{
+			name: "Test 8: When the fixed strategy is selected",
+			args: args{
+				config:     types.Configurations{GasPrice: 2, GasMultiplier: 1.5, GasStrategies: map[string]string{"commit": "fixed"}},
+				methodName: "commit",
+			},
+			want: gwei(3),
+		},
+		{
+			name: "Test 9: When the gas price of the strategy is more than the maximum gas price",
+			args: args{
+				config:     types.Configurations{MaxGasPrice: 12, GasStrategies: map[string]string{"propose": "fast"}},
+				methodName: "propose",
+				feeHistory: feeHistory,
+			},
+			want: gwei(12),
+		},
+		{
+			name: "Test 10: When the suggested gas price is more than the maximum gas price",
+			args: args{
+				config:     types.Configurations{MaxGasPrice: 4},
+				methodName: "commit",
+			},
+			want: gwei(4),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			clientMock := new(mocks.ClientUtils)
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface:  utilsMock,
+				ClientInterface: clientMock,
+			}
+			StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetGasPrice", client, tt.args.config).Return(gwei(5))
+			utilsMock.On("MultiplyFloatAndBigInt", mock.AnythingOfType("*big.Int"), mock.AnythingOfType("float64")).Return(
+				func(value *big.Int, multiplier float64) *big.Int {
+					return (&UtilsStruct{}).MultiplyFloatAndBigInt(value, multiplier)
+				})
+			clientMock.On("FeeHistory", client, context.Background(), uint64(20), mock.AnythingOfType("[]float64")).Return(tt.args.feeHistory, tt.args.feeHistoryErr)
+
+			got := getGasPriceOfStrategy(client, tt.args.config, tt.args.methodName)
+			if got.Cmp(tt.want) != 0 {
+				t.Errorf("getGasPriceOfStrategy() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFeeHistory(t *testing.T) {
+	server := rpc.NewServer()
+	defer server.Stop()
+	service := &feeHistoryService{result: feeHistoryResult{
+		BaseFees: []*hexutil.Big{(*hexutil.Big)(gwei(10)), (*hexutil.Big)(gwei(11))},
+		Rewards:  [][]*hexutil.Big{{(*hexutil.Big)(gwei(2))}},
+	}}
+	if err := server.RegisterName("eth", service); err != nil {
+		t.Fatal(err)
+	}
+	client := newEthClient(rpc.DialInProc(server))
+	defer client.Close()
+
+	got, err := getFeeHistory(client, context.Background(), 1, []float64{50})
+	if err != nil {
+		t.Fatalf("getFeeHistory() error = %v", err)
+	}
+	want := &types.FeeHistory{BaseFees: []*big.Int{gwei(10), gwei(11)}, Rewards: [][]*big.Int{{gwei(2)}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getFeeHistory() = %v, want %v", got, want)
+	}
+
+	if _, err := getFeeHistory(&ethclient.Client{}, context.Background(), 1, []float64{50}); err == nil {
+		t.Error("getFeeHistory() error = nil for a client which isn't dialled, want an error")
+	}
+}
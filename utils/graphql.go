@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"encoding/json"
+	"razor/core/types"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+//This function returns the GraphQL query of a job of assets.json, which is either the query or an object with the query and its variables, nil is returned if the job has none
+func getGraphQLQueryFromJSONFile(jobData string) *types.GraphQLQuery {
+	queryData := gjson.Get(jobData, "graphql")
+	if !queryData.Exists() {
+		return nil
+	}
+	var query types.GraphQLQuery
+	if queryData.Type == gjson.String {
+		query.Query = queryData.String()
+	} else {
+		err := json.Unmarshal([]byte(queryData.Raw), &query)
+		if err != nil {
+			log.Error("Error in parsing graphql query of the job: ", err)
+			return nil
+		}
+	}
+	if strings.TrimSpace(query.Query) == "" {
+		log.Error("GraphQL query of the job is empty")
+		return nil
+	}
+	return &query
+}
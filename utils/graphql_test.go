@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestGetGraphQLQueryFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    *types.GraphQLQuery
+	}{
+		{
+			name:    "Test 1: When the query is given as a string",
+			jobData: `{"URL": "https://api.thegraph.com/subgraphs/name/uniswap/uniswap-v3", "selector": "data.bundle.ethPriceUSD", "graphql": "{ bundle(id: \"1\") { ethPriceUSD } }"}`,
+			want:    &types.GraphQLQuery{Query: `{ bundle(id: "1") { ethPriceUSD } }`},
+		},
+		{
+			name:    "Test 2: When the query is given with its variables",
+			jobData: `{"URL": "https://api.thegraph.com/subgraphs/name/uniswap/uniswap-v3", "selector": "data.pool.token0Price", "graphql": {"query": "query($id: ID!) { pool(id: $id) { token0Price } }", "variables": {"id": "0x8ad599c3a0ff1de082011efddc58f1908eb6e6d8"}}}`,
+			want: &types.GraphQLQuery{
+				Query:     "query($id: ID!) { pool(id: $id) { token0Price } }",
+				Variables: map[string]interface{}{"id": "0x8ad599c3a0ff1de082011efddc58f1908eb6e6d8"},
+			},
+		},
+		{
+			name:    "Test 3: When the job has no query",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 4: When the query is empty",
+			jobData: `{"URL": "https://api.thegraph.com/subgraphs/name/uniswap/uniswap-v3", "selector": "data.bundle.ethPriceUSD", "graphql": {"variables": {"id": "1"}}}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 5: When the query is invalid",
+			jobData: `{"URL": "https://api.thegraph.com/subgraphs/name/uniswap/uniswap-v3", "selector": "data.bundle.ethPriceUSD", "graphql": ["{ bundle(id: \"1\") { ethPriceUSD } }"]}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getGraphQLQueryFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getGraphQLQueryFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDataFromGraphQL(t *testing.T) {
+	query := types.GraphQLQuery{
+		Query:     "query($id: ID!) { pool(id: $id) { token0Price } }",
+		Variables: map[string]interface{}{"id": "0x8ad5"},
+	}
+	response := []byte(`{"data": {"pool": {"token0Price": "1834.52"}}}`)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var receivedQuery types.GraphQLQuery
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || json.NewDecoder(r.Body).Decode(&receivedQuery) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/errors":
+			_, _ = w.Write([]byte(`{"errors": [{"message": "pool not found"}], "data": null}`))
+		default:
+			if !reflect.DeepEqual(receivedQuery, query) || r.Header.Get("Authorization") != "Bearer key" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write(response)
+		}
+	}))
+	defer server.Close()
+
+	StartRazor(OptionsPackageStruct{IOInterface: IOStruct{}})
+
+	tests := []struct {
+		name         string
+		path         string
+		ttl          int32
+		want         []byte
+		wantErr      bool
+		wantRequests int
+	}{
+		{
+			name:         "Test 1: When the query is posted successfully",
+			want:         response,
+			wantRequests: 2,
+		},
+		{
+			name:         "Test 2: When the response of the query is reused within the TTL",
+			ttl:          60,
+			want:         response,
+			wantRequests: 1,
+		},
+		{
+			name:         "Test 3: When the response has GraphQL errors",
+			path:         "/errors",
+			wantErr:      true,
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetAPICacheTTL(tt.ttl)
+			defer SetAPICacheTTL(0)
+			requests = 0
+
+			utils := &UtilsStruct{}
+			for i := 0; i < 2; i++ {
+				got, err := utils.GetDataFromGraphQL(server.URL+tt.path, query, map[string]string{"Authorization": "Bearer key"})
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("GetDataFromGraphQL() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("GetDataFromGraphQL() got = %s, want %s", got, tt.want)
+				}
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("GetDataFromGraphQL() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"context"
+	"math/big"
+	"razor/core"
+	"strings"
+	"sync"
+	"time"
+
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	subscribedHead        *Types.Header
+	headSubscribed        bool
+	newHeadNotification   = make(chan struct{})
+	headSubscriptionMutex sync.Mutex
+)
+
+//This function returns true if the provider is a WebSocket endpoint on which the new heads can be subscribed
+func IsWebSocketProvider(provider string) bool {
+	return strings.HasPrefix(provider, "ws://") || strings.HasPrefix(provider, "wss://")
+}
+
+//This function subscribes to the new heads of the chain and keeps the latest head until the context is done
+//If the subscription fails, the heads are polled until it is made again after core.HeadResubscribeInterval seconds
+func SubscribeNewHeads(ctx context.Context, client *ethclient.Client) {
+	for {
+		err := followNewHeads(ctx, client)
+		setHeadSubscribed(false)
+		if err != nil {
+			log.Error("Error in subscription to new heads, polling the heads: ", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(core.HeadResubscribeInterval) * time.Second):
+		}
+	}
+}
+
+func followNewHeads(ctx context.Context, client *ethclient.Client) error {
+	heads := make(chan *Types.Header)
+	subscription, err := ClientInterface.SubscribeNewHead(client, ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+	log.Info("Subscribed to new heads")
+	setHeadSubscribed(true)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-subscription.Err():
+			return err
+		case header := <-heads:
+			storeNewHead(header)
+		}
+	}
+}
+
+//This function stores the head and wakes up the functions waiting for a new head
+func storeNewHead(header *Types.Header) {
+	headSubscriptionMutex.Lock()
+	defer headSubscriptionMutex.Unlock()
+	subscribedHead = header
+	close(newHeadNotification)
+	newHeadNotification = make(chan struct{})
+}
+
+func setHeadSubscribed(subscribed bool) {
+	headSubscriptionMutex.Lock()
+	defer headSubscriptionMutex.Unlock()
+	headSubscribed = subscribed
+	if !subscribed {
+		subscribedHead = nil
+	}
+	close(newHeadNotification)
+	newHeadNotification = make(chan struct{})
+}
+
+//This function returns true if the new heads are received from a subscription
+func IsHeadSubscriptionActive() bool {
+	headSubscriptionMutex.Lock()
+	defer headSubscriptionMutex.Unlock()
+	return headSubscribed
+}
+
+//This function waits for at most the timeout for a head from the subscription newer than the block number, any head is returned if the block number is nil
+//False is returned if there is no active subscription or if no newer head arrived before the timeout, the heads must then be polled
+func WaitForNewHead(blockNumber *big.Int, timeout time.Duration) (*Types.Header, bool) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		headSubscriptionMutex.Lock()
+		head, subscribed, notification := subscribedHead, headSubscribed, newHeadNotification
+		headSubscriptionMutex.Unlock()
+		if !subscribed {
+			return nil, false
+		}
+		if head != nil && (blockNumber == nil || head.Number.Cmp(blockNumber) > 0) {
+			return head, true
+		}
+		select {
+		case <-notification:
+		case <-timer.C:
+			return nil, false
+		}
+	}
+}
+
+//This function waits for the next head from the subscription for at most the duration, it sleeps for the duration if there is no active subscription
+func sleepUntilNextHead(duration time.Duration) {
+	headSubscriptionMutex.Lock()
+	head, subscribed := subscribedHead, headSubscribed
+	headSubscriptionMutex.Unlock()
+	if !subscribed {
+		Time.Sleep(duration)
+		return
+	}
+	var blockNumber *big.Int
+	if head != nil {
+		blockNumber = head.Number
+	}
+	WaitForNewHead(blockNumber, duration)
+}
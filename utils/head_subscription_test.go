@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"testing"
+	"time"
+
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+type testSubscription struct {
+	errChan chan error
+}
+
+func (s *testSubscription) Unsubscribe() {}
+
+func (s *testSubscription) Err() <-chan error {
+	return s.errChan
+}
+
+func TestIsWebSocketProvider(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		want     bool
+	}{
+		{
+			name:     "Test 1: When the provider is a ws endpoint",
+			provider: "ws://localhost:8546",
+			want:     true,
+		},
+		{
+			name:     "Test 2: When the provider is a wss endpoint",
+			provider: "wss://mainnet.skalenodes.com/v1/turbulent-unique-scheat",
+			want:     true,
+		},
+		{
+			name:     "Test 3: When the provider is an http endpoint",
+			provider: "https://mainnet.skalenodes.com/v1/turbulent-unique-scheat",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWebSocketProvider(tt.provider); got != tt.want {
+				t.Errorf("IsWebSocketProvider() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForNewHead(t *testing.T) {
+	type args struct {
+		subscribed  bool
+		head        *Types.Header
+		nextHead    *Types.Header
+		blockNumber *big.Int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantNumber *big.Int
+		wantOk     bool
+	}{
+		{
+			name: "Test 1: When the stored head is newer than the block number",
+			args: args{
+				subscribed:  true,
+				head:        &Types.Header{Number: big.NewInt(11)},
+				blockNumber: big.NewInt(10),
+			},
+			wantNumber: big.NewInt(11),
+			wantOk:     true,
+		},
+		{
+			name: "Test 2: When a newer head arrives while waiting",
+			args: args{
+				subscribed:  true,
+				head:        &Types.Header{Number: big.NewInt(10)},
+				nextHead:    &Types.Header{Number: big.NewInt(11)},
+				blockNumber: big.NewInt(10),
+			},
+			wantNumber: big.NewInt(11),
+			wantOk:     true,
+		},
+		{
+			name: "Test 3: When no newer head arrives before the timeout",
+			args: args{
+				subscribed:  true,
+				head:        &Types.Header{Number: big.NewInt(10)},
+				blockNumber: big.NewInt(10),
+			},
+			wantOk: false,
+		},
+		{
+			name: "Test 4: When there is no active subscription",
+			args: args{
+				subscribed:  false,
+				blockNumber: big.NewInt(10),
+			},
+			wantOk: false,
+		},
+		{
+			name: "Test 5: When any head is awaited",
+			args: args{
+				subscribed: true,
+				nextHead:   &Types.Header{Number: big.NewInt(5)},
+			},
+			wantNumber: big.NewInt(5),
+			wantOk:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setHeadSubscribed(tt.args.subscribed)
+			defer setHeadSubscribed(false)
+			if tt.args.head != nil {
+				storeNewHead(tt.args.head)
+			}
+			if tt.args.nextHead != nil {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					storeNewHead(tt.args.nextHead)
+				}()
+			}
+
+			got, ok := WaitForNewHead(tt.args.blockNumber, 200*time.Millisecond)
+			if ok != tt.wantOk {
+				t.Fatalf("WaitForNewHead() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && got.Number.Cmp(tt.wantNumber) != 0 {
+				t.Errorf("WaitForNewHead() got head %v, want %v", got.Number, tt.wantNumber)
+			}
+		})
+	}
+}
+
+func TestSubscribeNewHeads(t *testing.T) {
+	var client *ethclient.Client
+
+	tests := []struct {
+		name            string
+		subscriptionErr error
+		failAfterHead   bool
+		wantHead        bool
+	}{
+		{
+			name:     "Test 1: When the heads are received from the subscription",
+			wantHead: true,
+		},
+		{
+			name:            "Test 2: When there is an error in subscribing to new heads",
+			subscriptionErr: errors.New("notifications not supported"),
+			wantHead:        false,
+		},
+		{
+			name:          "Test 3: When the subscription fails after receiving a head",
+			failAfterHead: true,
+			wantHead:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			optionsPackageStruct := OptionsPackageStruct{
+				ClientInterface: clientMock,
+			}
+			StartRazor(optionsPackageStruct)
+
+			subscription := &testSubscription{errChan: make(chan error, 1)}
+			heads := make(chan chan<- *Types.Header, 1)
+			if tt.subscriptionErr != nil {
+				clientMock.On("SubscribeNewHead", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Return(nil, tt.subscriptionErr)
+			} else {
+				clientMock.On("SubscribeNewHead", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					heads <- args.Get(2).(chan<- *Types.Header)
+				}).Return(subscription, nil)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				SubscribeNewHeads(ctx, client)
+				close(done)
+			}()
+			defer func() {
+				cancel()
+				<-done
+			}()
+
+			if tt.subscriptionErr == nil {
+				(<-heads) <- &Types.Header{Number: big.NewInt(100)}
+				if _, ok := WaitForNewHead(big.NewInt(99), time.Second); !ok {
+					t.Fatalf("Head from the subscription was not received")
+				}
+			}
+			if tt.failAfterHead {
+				subscription.errChan <- errors.New("connection closed")
+				deadline := time.Now().Add(time.Second)
+				for IsHeadSubscriptionActive() && time.Now().Before(deadline) {
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+
+			_, ok := WaitForNewHead(big.NewInt(99), 100*time.Millisecond)
+			if ok != tt.wantHead {
+				t.Errorf("WaitForNewHead() ok = %v, want %v", ok, tt.wantHead)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"razor/core"
+	"razor/metrics"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+)
+
+//hostLimiter spaces the requests sent to a host and blacklists it after repeated 429/5xx responses
+type hostLimiter struct {
+	nextRequestAt    time.Time
+	failures         int
+	blacklistedUntil time.Time
+}
+
+var (
+	hostLimiters     = make(map[string]*hostLimiter)
+	hostLimiterMutex sync.Mutex
+)
+
+//This function returns the host of the url by which the requests are limited
+func getRequestHost(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return rawUrl
+	}
+	return strings.ToLower(parsedUrl.Host)
+}
+
+//This function waits until a request can be sent to the host of the url, the requests to a host are sent at least HostRequestInterval apart
+//An error is returned without waiting if the host is blacklisted
+func waitForHost(rawUrl string) error {
+	host := getRequestHost(rawUrl)
+	hostLimiterMutex.Lock()
+	limiter, ok := hostLimiters[host]
+	if !ok {
+		limiter = &hostLimiter{}
+		hostLimiters[host] = limiter
+	}
+	now := time.Now()
+	if now.Before(limiter.blacklistedUntil) {
+		blacklistedUntil := limiter.blacklistedUntil
+		hostLimiterMutex.Unlock()
+		return fmt.Errorf("host %s is blacklisted until %s after repeated failures", host, blacklistedUntil.Format(time.RFC3339))
+	}
+	wait := limiter.nextRequestAt.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	limiter.nextRequestAt = now.Add(wait + core.HostRequestInterval)
+	hostLimiterMutex.Unlock()
+
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+	return nil
+}
+
+//This function records the status code of a response of the host of the url and returns true if the host is blacklisted by it
+//The host is blacklisted after HostFailureThreshold consecutive 429/5xx responses, for the time asked by the Retry-After header of a 429 response if it is longer
+func updateHostLimiter(rawUrl string, response *http.Response) bool {
+	host := getRequestHost(rawUrl)
+	hostLimiterMutex.Lock()
+	defer hostLimiterMutex.Unlock()
+	limiter, ok := hostLimiters[host]
+	if !ok {
+		limiter = &hostLimiter{}
+		hostLimiters[host] = limiter
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode < 500 {
+		if !limiter.blacklistedUntil.IsZero() {
+			log.Infof("Host %s recovered, it is no longer blacklisted", host)
+			limiter.blacklistedUntil = time.Time{}
+			metrics.HostBlacklistedMetric.WithLabelValues(host).Set(0)
+		}
+		limiter.failures = 0
+		return false
+	}
+
+	limiter.failures++
+	if limiter.failures < core.HostFailureThreshold {
+		return false
+	}
+	blacklistDuration := core.HostBlacklistDuration
+	if response.StatusCode == http.StatusTooManyRequests {
+		if retryAfter, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && time.Duration(retryAfter)*time.Second > blacklistDuration {
+			blacklistDuration = time.Duration(retryAfter) * time.Second
+		}
+	}
+	if blacklistDuration > core.MaxHostBlacklistDuration {
+		blacklistDuration = core.MaxHostBlacklistDuration
+	}
+	limiter.failures = 0
+	limiter.blacklistedUntil = time.Now().Add(blacklistDuration)
+	log.Warnf("Host %s responded with status code %d repeatedly, blacklisting it for %s", host, response.StatusCode, blacklistDuration)
+	metrics.HostBlacklistedMetric.WithLabelValues(host).Set(1)
+	return true
+}
+
+//This function returns the error of a failed response, which isn't retried if the host is rate limiting the requests or is blacklisted by it
+func hostResponseError(blacklisted bool, response *http.Response) error {
+	err := errors.New("unable to reach API")
+	if blacklisted || response.StatusCode == http.StatusTooManyRequests {
+		return retry.Unrecoverable(err)
+	}
+	return err
+}
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUpdateHostLimiter(t *testing.T) {
+	tests := []struct {
+		name            string
+		statusCodes     []int
+		retryAfter      string
+		wantBlacklisted bool
+		wantDuration    time.Duration
+	}{
+		{
+			name:            "Test 1: When the host responds successfully",
+			statusCodes:     []int{200, 200, 200},
+			wantBlacklisted: false,
+		},
+		{
+			name:            "Test 2: When the host fails less than the threshold",
+			statusCodes:     []int{503, 500},
+			wantBlacklisted: false,
+		},
+		{
+			name:            "Test 3: When the host fails repeatedly",
+			statusCodes:     []int{503, 500, 502},
+			wantBlacklisted: true,
+			wantDuration:    core.HostBlacklistDuration,
+		},
+		{
+			name:            "Test 4: When a success resets the failures of the host",
+			statusCodes:     []int{503, 500, 404, 502},
+			wantBlacklisted: false,
+		},
+		{
+			name:            "Test 5: When the host asks to retry after longer than the blacklist",
+			statusCodes:     []int{429, 429, 429},
+			retryAfter:      "1200",
+			wantBlacklisted: true,
+			wantDuration:    20 * time.Minute,
+		},
+		{
+			name:            "Test 6: When the host asks to retry after longer than the maximum blacklist",
+			statusCodes:     []int{429, 429, 429},
+			retryAfter:      "86400",
+			wantBlacklisted: true,
+			wantDuration:    core.MaxHostBlacklistDuration,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hostLimiters = make(map[string]*hostLimiter)
+			url := "https://api.example.com/v1/ticker"
+
+			var blacklisted bool
+			for _, statusCode := range tt.statusCodes {
+				response := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+				response.Header.Set("Retry-After", tt.retryAfter)
+				blacklisted = updateHostLimiter(url, response)
+			}
+			if blacklisted != tt.wantBlacklisted {
+				t.Errorf("updateHostLimiter() = %v, want %v", blacklisted, tt.wantBlacklisted)
+			}
+			err := waitForHost(url)
+			if (err != nil) != tt.wantBlacklisted {
+				t.Errorf("waitForHost() error = %v, want blacklisted %v", err, tt.wantBlacklisted)
+			}
+			if tt.wantBlacklisted {
+				duration := time.Until(hostLimiters["api.example.com"].blacklistedUntil)
+				if duration > tt.wantDuration || duration < tt.wantDuration-time.Minute {
+					t.Errorf("host is blacklisted for %s, want %s", duration, tt.wantDuration)
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForHost(t *testing.T) {
+	hostLimiters = make(map[string]*hostLimiter)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := waitForHost("https://api.example.com/v1/ticker"); err != nil {
+			t.Fatalf("waitForHost() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*core.HostRequestInterval {
+		t.Errorf("requests to the same host were sent %s apart, want at least %s", elapsed, 2*core.HostRequestInterval)
+	}
+
+	start = time.Now()
+	if err := waitForHost("https://api.other.com/v1/ticker"); err != nil {
+		t.Fatalf("waitForHost() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= core.HostRequestInterval {
+		t.Errorf("request to another host waited %s", elapsed)
+	}
+}
+
+func TestGetDataFromAPIWithRateLimitedHost(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	utilsMock := new(mocks.Utils)
+	utils := StartRazor(OptionsPackageStruct{UtilsInterface: utilsMock})
+	utilsMock.On("GetAPICacheData", mock.AnythingOfType("string")).Return(types.APICacheData{}, nil)
+	hostLimiters = make(map[string]*hostLimiter)
+	defer func() { hostLimiters = make(map[string]*hostLimiter) }()
+
+	for i := 0; i < core.HostFailureThreshold+2; i++ {
+		_, err := utils.GetDataFromAPI(server.URL, nil)
+		if err == nil {
+			t.Fatal("GetDataFromAPI() expected an error")
+		}
+	}
+	if requests != core.HostFailureThreshold {
+		t.Errorf("GetDataFromAPI() sent %d requests, want %d", requests, core.HostFailureThreshold)
+	}
+}
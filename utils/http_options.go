@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+	"razor/core"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+)
+
+var (
+	apiClient        = newAPIClient(core.DefaultHTTPTimeout, "")
+	apiRetryAttempts = uint(core.DefaultHTTPRetryAttempts)
+	apiRetryDelay    = time.Duration(core.DefaultHTTPRetryDelay) * time.Second
+	httpOptionsMutex sync.RWMutex
+)
+
+//This function sets the timeout in seconds, the attempts and the delay in seconds between the attempts of the requests to the APIs and the proxy they are sent through
+//The requests are sent through the proxy of the HTTPS_PROXY and HTTP_PROXY environment variables if the proxy is empty
+func SetHTTPOptions(timeout int32, retryAttempts int32, retryDelay int32, proxy string) {
+	httpOptionsMutex.Lock()
+	defer httpOptionsMutex.Unlock()
+	apiClient = newAPIClient(timeout, proxy)
+	apiRetryAttempts = uint(retryAttempts)
+	apiRetryDelay = time.Duration(retryDelay) * time.Second
+}
+
+//This function returns the http client of the requests to the APIs, it is shared so that the connections to the APIs are reused
+func getAPIClient() *http.Client {
+	httpOptionsMutex.RLock()
+	defer httpOptionsMutex.RUnlock()
+	return apiClient
+}
+
+//This function returns the attempts and the delay of the retries of the requests to the APIs
+func getAPIRetryOptions() []retry.Option {
+	httpOptionsMutex.RLock()
+	defer httpOptionsMutex.RUnlock()
+	return []retry.Option{retry.Attempts(apiRetryAttempts), retry.Delay(apiRetryDelay)}
+}
+
+func newAPIClient(timeout int32, proxy string) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if proxy != "" {
+		proxyUrl, err := url.Parse(proxy)
+		if err != nil {
+			log.Error("Error in parsing http proxy, sending the requests without it: ", err)
+		} else {
+			transport.Proxy = http.ProxyURL(proxyUrl)
+		}
+	}
+	return &http.Client{
+		Timeout:   time.Duration(timeout) * time.Second,
+		Transport: transport,
+	}
+}
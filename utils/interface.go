@@ -79,6 +79,7 @@ type Utils interface {
 	IncreaseGasLimitValue(client *ethclient.Client, gasLimit uint64, gasLimitMultiplier float32) (uint64, error)
 	GetLatestBlockWithRetry(client *ethclient.Client) (*Types.Header, error)
 	FilterLogsWithRetry(client *ethclient.Client, query ethereum.FilterQuery) ([]Types.Log, error)
+	FilterLogsInChunks(client *ethclient.Client, query ethereum.FilterQuery) ([]Types.Log, error)
 	BalanceAtWithRetry(client *ethclient.Client, account common.Address) (*big.Int, error)
 	GetBlockManager(client *ethclient.Client) *bindings.BlockManager
 	GetOptions() bind.CallOpts
@@ -89,6 +90,7 @@ type Utils interface {
 	GetMaxAltBlocks(client *ethclient.Client) (uint8, error)
 	GetMinSafeRazor(client *ethclient.Client) (*big.Int, error)
 	GetMinStakeAmount(client *ethclient.Client) (*big.Int, error)
+	GetSlashNums(client *ethclient.Client) (types.SlashNums, error)
 	GetStateBuffer(client *ethclient.Client) (uint64, error)
 	GetProposedBlock(client *ethclient.Client, epoch uint32, proposedBlockId uint32) (bindings.StructsBlock, error)
 	GetSortedProposedBlockIds(client *ethclient.Client, epoch uint32) ([]uint32, error)
@@ -122,23 +124,31 @@ type Utils interface {
 	Aggregate(client *ethclient.Client, previousEpoch uint32, collection bindings.StructsCollection) (*big.Int, error)
 	GetDataToCommitFromJobs(jobs []bindings.StructsJob) ([]*big.Int, []uint8, error)
 	GetDataToCommitFromJob(job bindings.StructsJob) (*big.Int, error)
+	IsJobQuarantined(jobId uint16) bool
+	UpdateJobCircuitBreaker(job bindings.StructsJob, success bool)
 	GetAssignedCollections(client *ethclient.Client, numActiveCollections uint16, seed []byte) (map[int]bool, []*big.Int, error)
 	GetLeafIdOfACollection(client *ethclient.Client, collectionId uint16) (uint16, error)
 	GetCollectionIdFromIndex(client *ethclient.Client, medianIndex uint16) (uint16, error)
 	GetCollectionIdFromLeafId(client *ethclient.Client, leafId uint16) (uint16, error)
 	GetNumActiveCollections(client *ethclient.Client) (uint16, error)
 	GetAggregatedDataOfCollection(client *ethclient.Client, collectionId uint16, epoch uint32) (*big.Int, error)
+	ReadDataOverrideFile(filePath string) (types.DataOverrideFile, error)
+	GetDataOfOverride(collection bindings.StructsCollection, override types.DataOverride) (*big.Int, error)
 	GetJobs(client *ethclient.Client) ([]bindings.StructsJob, error)
 	GetAllCollections(client *ethclient.Client) ([]bindings.StructsCollection, error)
 	GetActiveCollectionIds(client *ethclient.Client) ([]uint16, error)
-	GetDataFromAPI(url string) ([]byte, error)
-	GetDataFromJSON(jsonObject map[string]interface{}, selector string) (interface{}, error)
+	GetDataFromAPI(url string, headers map[string]string) ([]byte, error)
+	GetDataFromGraphQL(url string, query types.GraphQLQuery, headers map[string]string) ([]byte, error)
+	GetAPICacheData(url string) (types.APICacheData, error)
+	SaveAPICacheData(url string, cachedData types.APICacheData) error
+	GetDataFromJSON(jsonObject interface{}, selector string) (interface{}, error)
 	HandleOfficialJobsFromJSONFile(client *ethclient.Client, collection bindings.StructsCollection, dataString string) ([]bindings.StructsJob, []uint16)
-	GetDataFromXHTML(url string, selector string) (string, error)
+	GetDataFromXHTML(url string, selector string, headers map[string]string) (string, error)
 	ConnectToClient(provider string) *ethclient.Client
 	FetchBalance(client *ethclient.Client, accountAddress string) (*big.Int, error)
 	GetDelayedState(client *ethclient.Client, buffer int32) (int64, error)
 	WaitForBlockCompletion(client *ethclient.Client, hashToRead string) error
+	WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error
 	CheckEthBalanceIsZero(client *ethclient.Client, address string)
 	AssignStakerId(flagSet *pflag.FlagSet, client *ethclient.Client, address string) (uint32, error)
 	GetEpoch(client *ethclient.Client) (uint32, error)
@@ -148,7 +158,32 @@ type Utils interface {
 	ReadFromProposeJsonFile(filePath string) (types.ProposeFileData, error)
 	SaveDataToDisputeJsonFile(filePath string, bountyIdQueue []uint32) error
 	ReadFromDisputeJsonFile(filePath string) (types.DisputeFileData, error)
+	SaveDataToDelegationMigrationFile(filePath string, data types.DelegationMigrationData) error
+	ReadFromDelegationMigrationFile(filePath string) (types.DelegationMigrationData, error)
+	SaveDataToCommissionScheduleFile(filePath string, data types.CommissionSchedule) error
+	ReadFromCommissionScheduleFile(filePath string) (types.CommissionSchedule, error)
+	SaveDataToWithdrawQueueFile(filePath string, queue []types.WithdrawQueueEntry) error
+	ReadFromWithdrawQueueFile(filePath string) ([]types.WithdrawQueueEntry, error)
+	SaveDataToGiveSortedProgressFile(filePath string, progress types.GiveSortedProgress) error
+	ReadFromGiveSortedProgressFile(filePath string) (types.GiveSortedProgress, error)
+	SaveDataToCollectionHistoryFile(filePath string, collectionId uint16, historyData types.CollectionHistoryData) error
+	ReadFromCollectionHistoryFile(filePath string) (types.CollectionHistoryFileData, error)
+	SaveJournalAction(filePath string, epoch uint32, action types.JournalAction) error
+	ReadJournal(filePath string) ([]types.JournalEntry, error)
+	SaveAttestation(filePath string, attestation types.Attestation) error
+	ReadAttestations(filePath string) ([]types.Attestation, error)
+	SaveLedgerRecord(address string, record types.LedgerRecord) error
+	ReadLedger(address string, fromEpoch uint32) ([]types.LedgerRecord, error)
+	SaveResponseArchive(address string, epoch uint32, collections []types.AttestedCollection) error
+	ReadResponseArchive(address string, epoch uint32) (types.ResponseArchiveEntry, error)
+	ReadArchivedResponseBody(address string, bodyHash string) ([]byte, error)
+	SaveTransactionReceipt(client *ethclient.Client, _txHash string) error
+	ReadTransactionReceipts(address string, fromEpoch uint32) ([]types.TransactionReceiptRecord, error)
+	SaveStakeChangeSyncRange(address string, syncRange types.StakeChangeSyncRange) error
+	GetStakeChangeSyncRange(address string) (types.StakeChangeSyncRange, error)
 	CalculateBlockTime(client *ethclient.Client) int64
+	MeasureAverageBlockTime(client *ethclient.Client) (time.Duration, error)
+	GetAverageBlockTime(client *ethclient.Client) time.Duration
 	IsFlagPassed(name string) bool
 	GetTokenManager(client *ethclient.Client) *bindings.RAZOR
 	GetStakedToken(client *ethclient.Client, tokenAddress common.Address) *bindings.StakedToken
@@ -156,14 +191,20 @@ type Utils interface {
 	WaitTillNextNSecs(waitTime int32)
 	ReadJSONData(fileName string) (map[string]*types.StructsJob, error)
 	WriteDataToJSON(fileName string, data map[string]*types.StructsJob) error
+	ReadAccountAliases(fileName string) (map[string]string, error)
+	SaveAccountAliases(fileName string, aliases map[string]string) error
 	DeleteJobFromJSON(fileName string, jobId string) error
 	AddJobToJSON(fileName string, job *types.StructsJob) error
 	CheckTransactionReceipt(client *ethclient.Client, _txHash string) int
+	GetTransactionRevertReason(client *ethclient.Client, _txHash string) string
+	SpeedUpTransaction(client *ethclient.Client, hash string) (string, error)
 	CalculateSalt(epoch uint32, medians []*big.Int) [32]byte
 	ToAssign(client *ethclient.Client) (uint16, error)
 	Prng(max uint32, prngHashes []byte) *big.Int
 	GetSaltFromBlockchain(client *ethclient.Client) ([32]byte, error)
 	GetStakerSRZRBalance(client *ethclient.Client, staker bindings.StructsStaker) (*big.Int, error)
+	GetDelegatorSRZRBalance(client *ethclient.Client, address string, staker bindings.StructsStaker) (*big.Int, error)
+	GetSRZRTotalSupply(client *ethclient.Client, staker bindings.StructsStaker) (*big.Int, error)
 	GetRemainingTimeOfCurrentState(client *ethclient.Client, bufferPercent int32) (int64, error)
 	ConvertToNumber(num interface{}) (*big.Float, error)
 	SecondsToReadableTime(input int) string
@@ -183,8 +224,16 @@ type ClientUtils interface {
 	HeaderByNumber(client *ethclient.Client, ctx context.Context, number *big.Int) (*Types.Header, error)
 	PendingNonceAt(client *ethclient.Client, ctx context.Context, account common.Address) (uint64, error)
 	SuggestGasPrice(client *ethclient.Client, ctx context.Context) (*big.Int, error)
+	FeeHistory(client *ethclient.Client, ctx context.Context, blockCount uint64, percentiles []float64) (*types.FeeHistory, error)
 	EstimateGas(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg) (uint64, error)
 	FilterLogs(client *ethclient.Client, ctx context.Context, q ethereum.FilterQuery) ([]Types.Log, error)
+	TransactionByHash(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*Types.Transaction, bool, error)
+	SendTransaction(client *ethclient.Client, ctx context.Context, txn *Types.Transaction) error
+	SubscribeNewHead(client *ethclient.Client, ctx context.Context, ch chan<- *Types.Header) (ethereum.Subscription, error)
+	SyncProgress(client *ethclient.Client, ctx context.Context) (*ethereum.SyncProgress, error)
+	CallContract(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	ChainID(client *ethclient.Client, ctx context.Context) (*big.Int, error)
+	CodeAt(client *ethclient.Client, ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
 }
 
 type TimeUtils interface {
@@ -209,7 +258,9 @@ type CoinUtils interface {
 type MerkleTreeInterface interface {
 	CreateMerkle(values []*big.Int) [][][]byte
 	GetProofPath(tree [][][]byte, assetId uint16) [][32]byte
+	GetProofPaths(tree [][][]byte, leafIds []uint16) [][][32]byte
 	GetMerkleRoot(tree [][][]byte) [32]byte
+	VerifyProof(proof [][32]byte, root [32]byte, value *big.Int, leafId uint16, numLeaves uint16) bool
 }
 type IOUtils interface {
 	ReadAll(body io.ReadCloser) ([]byte, error)
@@ -249,6 +300,7 @@ type StakeManagerUtils interface {
 	GetStaker(client *ethclient.Client, stakerId uint32) (bindings.StructsStaker, error)
 	GetNumStakers(client *ethclient.Client) (uint32, error)
 	MinSafeRazor(client *ethclient.Client) (*big.Int, error)
+	SlashNums(client *ethclient.Client) (types.SlashNums, error)
 	Locks(client *ethclient.Client, address common.Address, address1 common.Address, lockType uint8) (types.Locks, error)
 	MaxCommission(client *ethclient.Client) (uint8, error)
 	EpochLimitForUpdateCommission(client *ethclient.Client) (uint16, error)
@@ -296,6 +348,7 @@ type JsonUtils interface {
 
 type StakedTokenUtils interface {
 	BalanceOf(stakedToken *bindings.StakedToken, callOpts *bind.CallOpts, address common.Address) (*big.Int, error)
+	TotalSupply(stakedToken *bindings.StakedToken, callOpts *bind.CallOpts) (*big.Int, error)
 }
 
 type RetryUtils interface {
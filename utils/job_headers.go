@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"razor/path"
+	"regexp"
+
+	"github.com/tidwall/gjson"
+)
+
+var (
+	secretReferenceRegex = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+)
+
+//This function returns the headers of a job of assets.json, nil is returned if the job has none
+func getHeadersFromJSONFile(jobData string) map[string]string {
+	headersData := gjson.Get(jobData, "headers")
+	if !headersData.Exists() {
+		return nil
+	}
+	var headers map[string]string
+	err := json.Unmarshal([]byte(headersData.Raw), &headers)
+	if err != nil {
+		log.Error("Error in parsing headers of the job: ", err)
+		return nil
+	}
+	return headers
+}
+
+//This function replaces the ${NAME} references in the values of the headers with the secret NAME of the secrets file or else the environment variable NAME
+//The values of the headers are never logged as they usually contain API keys
+func resolveJobHeaders(headers map[string]string) (map[string]string, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	var (
+		secrets map[string]string
+		err     error
+	)
+	resolvedHeaders := make(map[string]string)
+	for name, value := range headers {
+		var unresolvedSecret string
+		resolvedHeaders[name] = secretReferenceRegex.ReplaceAllStringFunc(value, func(reference string) string {
+			secretName := secretReferenceRegex.FindStringSubmatch(reference)[1]
+			if secrets == nil && err == nil {
+				secrets, err = readSecretsFile()
+			}
+			if secret, ok := secrets[secretName]; ok {
+				return secret
+			}
+			if secret, ok := os.LookupEnv(secretName); ok {
+				return secret
+			}
+			unresolvedSecret = secretName
+			return ""
+		})
+		if err != nil {
+			return nil, err
+		}
+		if unresolvedSecret != "" {
+			return nil, errors.New("secret " + unresolvedSecret + " of header " + name + " is neither in the secrets file nor in the environment")
+		}
+	}
+	return resolvedHeaders, nil
+}
+
+//This function reads the secrets file, an empty map is returned if the file doesn't exist
+func readSecretsFile() (map[string]string, error) {
+	secretsFilePath, err := path.PathUtilsInterface.GetSecretsFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(secretsFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	secrets := make(map[string]string)
+	err = json.Unmarshal(data, &secrets)
+	if err != nil {
+		return nil, errors.New("error in parsing secrets file: " + err.Error())
+	}
+	return secrets, nil
+}
@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"reflect"
+	"testing"
+)
+
+func TestGetHeadersFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    map[string]string
+	}{
+		{
+			name:    "Test 1: When the job has headers",
+			jobData: `{"URL": "https://pro-api.coinmarketcap.com/v1/cryptocurrency/quotes/latest?symbol=ETH", "selector": "data.ETH.quote.USD.price", "headers": {"X-CMC_PRO_API_KEY": "${CMC_API_KEY}"}}`,
+			want:    map[string]string{"X-CMC_PRO_API_KEY": "${CMC_API_KEY}"},
+		},
+		{
+			name:    "Test 2: When the job has no headers",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 3: When the headers are invalid",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "headers": ["Authorization"]}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getHeadersFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getHeadersFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveJobHeaders(t *testing.T) {
+	dir := t.TempDir()
+	secretsFilePath := dir + "/secrets.json"
+	if err := os.WriteFile(secretsFilePath, []byte(`{"CMC_API_KEY": "fileKey", "BEARER_TOKEN": "fileToken"}`), 0600); err != nil {
+		t.Fatalf("Error in writing secrets file: %v", err)
+	}
+	invalidSecretsFilePath := dir + "/invalid_secrets.json"
+	if err := os.WriteFile(invalidSecretsFilePath, []byte(`not secrets`), 0600); err != nil {
+		t.Fatalf("Error in writing secrets file: %v", err)
+	}
+	t.Setenv("CMC_API_KEY", "envKey")
+	t.Setenv("COINGECKO_API_KEY", "envCoingeckoKey")
+
+	type args struct {
+		headers            map[string]string
+		secretsFilePath    string
+		secretsFilePathErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the secrets are resolved from the secrets file before the environment",
+			args: args{
+				headers:         map[string]string{"X-CMC_PRO_API_KEY": "${CMC_API_KEY}", "Authorization": "Bearer ${BEARER_TOKEN}"},
+				secretsFilePath: secretsFilePath,
+			},
+			want:    map[string]string{"X-CMC_PRO_API_KEY": "fileKey", "Authorization": "Bearer fileToken"},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the secret is resolved from the environment",
+			args: args{
+				headers:         map[string]string{"x-cg-pro-api-key": "${COINGECKO_API_KEY}"},
+				secretsFilePath: secretsFilePath,
+			},
+			want:    map[string]string{"x-cg-pro-api-key": "envCoingeckoKey"},
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the secrets file doesn't exist",
+			args: args{
+				headers:         map[string]string{"X-CMC_PRO_API_KEY": "${CMC_API_KEY}"},
+				secretsFilePath: dir + "/missing.json",
+			},
+			want:    map[string]string{"X-CMC_PRO_API_KEY": "envKey"},
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When the headers have no secrets",
+			args: args{
+				headers:         map[string]string{"Accept": "application/json"},
+				secretsFilePath: secretsFilePath,
+			},
+			want:    map[string]string{"Accept": "application/json"},
+			wantErr: false,
+		},
+		{
+			name: "Test 5: When the job has no headers",
+			args: args{
+				headers: nil,
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Test 6: When the secret is neither in the secrets file nor in the environment",
+			args: args{
+				headers:         map[string]string{"Authorization": "Bearer ${MISSING_TOKEN}"},
+				secretsFilePath: secretsFilePath,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When the secrets file is invalid",
+			args: args{
+				headers:         map[string]string{"X-CMC_PRO_API_KEY": "${CMC_API_KEY}"},
+				secretsFilePath: invalidSecretsFilePath,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When there is an error in getting the path of the secrets file",
+			args: args{
+				headers:            map[string]string{"X-CMC_PRO_API_KEY": "${CMC_API_KEY}"},
+				secretsFilePathErr: errors.New("path error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pathUtilsMock := new(pathMocks.PathInterface)
+			path.PathUtilsInterface = pathUtilsMock
+
+			pathUtilsMock.On("GetSecretsFilePath").Return(tt.args.secretsFilePath, tt.args.secretsFilePathErr)
+
+			got, err := resolveJobHeaders(tt.args.headers)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveJobHeaders() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveJobHeaders() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"strconv"
+	"sync"
+)
+
+//jobOptionsEntry is the options of a job along with the URL and the selector of the job they were set for
+type jobOptionsEntry struct {
+	url      string
+	selector string
+	options  types.JobOptions
+}
+
+var (
+	jobOptions      = make(map[string]jobOptionsEntry)
+	jobOptionsMutex sync.RWMutex
+)
+
+//This function returns the key of the options of the job, the official jobs are identified by their id and the custom jobs, which have no id, by their name
+func jobOptionsKey(job bindings.StructsJob) string {
+	if job.Id != 0 {
+		return "id:" + strconv.Itoa(int(job.Id))
+	}
+	return "name:" + job.Name
+}
+
+//This function sets the options of the job, the options of the job are dropped if they are empty
+func SetJobOptions(job bindings.StructsJob, options types.JobOptions) {
+	jobOptionsMutex.Lock()
+	defer jobOptionsMutex.Unlock()
+	if options.ParseOptions == nil && options.Sources == nil && len(options.Headers) == 0 && options.Conversion == nil &&
+		options.PostProcess == "" && options.GraphQLQuery == nil && options.WebSocketFeed == nil {
+		delete(jobOptions, jobOptionsKey(job))
+		return
+	}
+	jobOptions[jobOptionsKey(job)] = jobOptionsEntry{
+		url:      job.Url,
+		selector: job.Selector,
+		options:  options,
+	}
+}
+
+//This function returns the options of the job
+//The options are only used for the job with the URL and the selector they were set for, so that an official job fetched from the contract isn't processed with the options of its override
+func getJobOptions(job bindings.StructsJob) types.JobOptions {
+	jobOptionsMutex.RLock()
+	defer jobOptionsMutex.RUnlock()
+	entry, ok := jobOptions[jobOptionsKey(job)]
+	if !ok || entry.url != job.Url || entry.selector != job.Selector {
+		return types.JobOptions{}
+	}
+	return entry.options
+}
+
+//This function returns the options of a job of assets.json
+func getJobOptionsFromJSONFile(jobData string) types.JobOptions {
+	return types.JobOptions{
+		ParseOptions:  getParseOptionsFromJSONFile(jobData),
+		Sources:       getJobSourcesFromJSONFile(jobData),
+		Headers:       getHeadersFromJSONFile(jobData),
+		Conversion:    getConversionFromJSONFile(jobData),
+		PostProcess:   getPostProcessFromJSONFile(jobData),
+		GraphQLQuery:  getGraphQLQueryFromJSONFile(jobData),
+		WebSocketFeed: getWebSocketFeedFromJSONFile(jobData),
+	}
+}
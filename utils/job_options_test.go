@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"reflect"
+	"testing"
+)
+
+func TestJobOptions(t *testing.T) {
+	officialJob := bindings.StructsJob{Id: 1, Name: "ethusd_gemini", Url: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last"}
+	customJob := bindings.StructsJob{Name: "ethCollection custom job 0", Url: officialJob.Url, Selector: officialJob.Selector}
+	officialOptions := types.JobOptions{Headers: map[string]string{"x-api-key": "key"}}
+	customOptions := types.JobOptions{PostProcess: "value * 2"}
+
+	SetJobOptions(officialJob, officialOptions)
+	defer SetJobOptions(officialJob, types.JobOptions{})
+	SetJobOptions(customJob, customOptions)
+	defer SetJobOptions(customJob, types.JobOptions{})
+
+	tests := []struct {
+		name string
+		job  bindings.StructsJob
+		want types.JobOptions
+	}{
+		{
+			name: "Test 1: When the options of an official job are set",
+			job:  officialJob,
+			want: officialOptions,
+		},
+		{
+			name: "Test 2: When a custom job has the URL and the selector of an official job",
+			job:  customJob,
+			want: customOptions,
+		},
+		{
+			name: "Test 3: When another job has the URL and the selector of a job with options",
+			job:  bindings.StructsJob{Id: 2, Url: officialJob.Url, Selector: officialJob.Selector},
+			want: types.JobOptions{},
+		},
+		{
+			name: "Test 4: When the job of the contract has another URL than its override",
+			job:  bindings.StructsJob{Id: 1, Name: "ethusd_gemini", Url: "https://api.gemini.com/v2/ticker/ethusd", Selector: "close"},
+			want: types.JobOptions{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getJobOptions(tt.job); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getJobOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	SetJobOptions(officialJob, types.JobOptions{})
+	if _, ok := jobOptions[jobOptionsKey(officialJob)]; ok {
+		t.Error("SetJobOptions() kept the empty options of the job")
+	}
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"razor/core/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+//The tokens which are not valid JSON but are returned by some APIs for the values which can't be computed
+var nonFiniteTokens = []string{"-Infinity", "Infinity", "NaN"}
+
+//The separators which are removed from the numeric strings along with the currency symbols
+var numericStringNoise = regexp.MustCompile(`[\p{Sc}\s'_\x{00A0}\x{202F}]`)
+
+//This function returns the parse options of a job of assets.json, nil is returned if the job has none
+func getParseOptionsFromJSONFile(jobData string) *types.JobParseOptions {
+	parseOptions := gjson.Get(jobData, "parseOptions")
+	if !parseOptions.Exists() {
+		return nil
+	}
+	var options types.JobParseOptions
+	err := json.Unmarshal([]byte(parseOptions.Raw), &options)
+	if err != nil {
+		log.Error("Error in parsing parseOptions of the job: ", err)
+		return nil
+	}
+	return &options
+}
+
+//This function decodes the response of the API, tolerating the byte order mark, the prefix and the JSONP padding set in the options
+//The NaN and Infinity values are decoded as null, so that the other values of the response can still be used
+func decodeTolerantJSON(response []byte, options types.JobParseOptions) (interface{}, error) {
+	data := bytes.TrimSpace(bytes.TrimPrefix(response, []byte("\xef\xbb\xbf")))
+	if options.StripPrefix != "" {
+		data = bytes.TrimSpace(bytes.TrimPrefix(data, []byte(options.StripPrefix)))
+	}
+	if options.Jsonp {
+		start := bytes.IndexByte(data, '(')
+		end := bytes.LastIndexByte(data, ')')
+		if start < 0 || end < start {
+			return nil, errors.New("response is not padded with a JSONP callback")
+		}
+		data = data[start+1 : end]
+	}
+	var parsedJSON interface{}
+	err := json.Unmarshal(replaceNonFiniteTokens(data), &parsedJSON)
+	if err != nil {
+		return nil, err
+	}
+	return parsedJSON, nil
+}
+
+//This function replaces the NaN and Infinity tokens which are outside of the strings with null
+func replaceNonFiniteTokens(data []byte) []byte {
+	var (
+		result   bytes.Buffer
+		inString bool
+		escaped  bool
+	)
+	for i := 0; i < len(data); i++ {
+		char := data[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case char == '\\':
+				escaped = true
+			case char == '"':
+				inString = false
+			}
+			result.WriteByte(char)
+			continue
+		}
+		if char == '"' {
+			inString = true
+			result.WriteByte(char)
+			continue
+		}
+		replaced := false
+		for _, token := range nonFiniteTokens {
+			if bytes.HasPrefix(data[i:], []byte(token)) {
+				result.WriteString("null")
+				i += len(token) - 1
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result.WriteByte(char)
+		}
+	}
+	return result.Bytes()
+}
+
+//This function converts the value selected from the response to a finite number, the strings are normalized before being parsed if numericStrings is set in the options
+func parseTolerantNumber(value interface{}, options types.JobParseOptions) (interface{}, error) {
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case string:
+		numericString := v
+		if options.NumericStrings {
+			numericString = normalizeNumericString(v, options.DecimalSeparator)
+		}
+		parsedNumber, err := strconv.ParseFloat(numericString, 64)
+		if err != nil {
+			return nil, err
+		}
+		number = parsedNumber
+	case nil:
+		return nil, errors.New("no data provided")
+	default:
+		return nil, errors.New("value is not a number")
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, errors.New("value is not a finite number")
+	}
+	return number, nil
+}
+
+//This function removes the currency symbols, spaces and thousands separators of the numeric string and replaces the decimal separator by a dot
+func normalizeNumericString(value string, decimalSeparator string) string {
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+	numericString := numericStringNoise.ReplaceAllString(value, "")
+	numericString = strings.ReplaceAll(numericString, thousandsSeparator, "")
+	return strings.ReplaceAll(numericString, decimalSeparator, ".")
+}
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeTolerantJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		options  types.JobParseOptions
+		want     map[string]interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When the response is plain JSON",
+			response: `{"last": 1234.5}`,
+			want:     map[string]interface{}{"last": 1234.5},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 2: When the response has a byte order mark",
+			response: "\xef\xbb\xbf {\"last\": 1234.5}",
+			want:     map[string]interface{}{"last": 1234.5},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 3: When the prefix is stripped",
+			response: ")]}',\n{\"last\": 1234.5}",
+			options:  types.JobParseOptions{StripPrefix: ")]}',"},
+			want:     map[string]interface{}{"last": 1234.5},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 4: When the JSONP padding is removed",
+			response: `/**/ticker_callback({"last": 1234.5});`,
+			options:  types.JobParseOptions{Jsonp: true},
+			want:     map[string]interface{}{"last": 1234.5},
+			wantErr:  false,
+		},
+		{
+			name:     "Test 5: When the response is not padded",
+			response: `{"last": 1234.5}`,
+			options:  types.JobParseOptions{Jsonp: true},
+			wantErr:  true,
+		},
+		{
+			name:     "Test 6: When the response has NaN and Infinity values",
+			response: `{"last": 1234.5, "change": NaN, "high": Infinity, "low": -Infinity, "note": "NaN is kept in strings \"Infinity\""}`,
+			want: map[string]interface{}{
+				"last":   1234.5,
+				"change": nil,
+				"high":   nil,
+				"low":    nil,
+				"note":   `NaN is kept in strings "Infinity"`,
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Test 7: When the response is not JSON",
+			response: `<html></html>`,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeTolerantJSON([]byte(tt.response), tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeTolerantJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeTolerantJSON() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTolerantNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		options types.JobParseOptions
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When the value is a number",
+			value:   1234.5,
+			want:    1234.5,
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When the value is a plain numeric string",
+			value:   "1234.5",
+			want:    1234.5,
+			wantErr: false,
+		},
+		{
+			name:    "Test 3: When the numeric string has thousands separators and a currency symbol",
+			value:   "$ 1,234.5",
+			options: types.JobParseOptions{NumericStrings: true},
+			want:    1234.5,
+			wantErr: false,
+		},
+		{
+			name:    "Test 4: When the numeric string has a comma as the decimal separator",
+			value:   "1.234,5 €",
+			options: types.JobParseOptions{NumericStrings: true, DecimalSeparator: ","},
+			want:    1234.5,
+			wantErr: false,
+		},
+		{
+			name:    "Test 5: When the numeric string has spaces as thousands separators",
+			value:   "1 234,5",
+			options: types.JobParseOptions{NumericStrings: true, DecimalSeparator: ","},
+			want:    1234.5,
+			wantErr: false,
+		},
+		{
+			name:    "Test 6: When the numeric string is not normalized without numericStrings",
+			value:   "1,234.5",
+			wantErr: true,
+		},
+		{
+			name:    "Test 7: When the value is a NaN string",
+			value:   "NaN",
+			options: types.JobParseOptions{NumericStrings: true},
+			wantErr: true,
+		},
+		{
+			name:    "Test 8: When the value is an Infinity string",
+			value:   "-Infinity",
+			wantErr: true,
+		},
+		{
+			name:    "Test 9: When the value is null",
+			value:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "Test 10: When the value is not a number",
+			value:   map[string]interface{}{"last": 1234.5},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTolerantNumber(tt.value, tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseTolerantNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTolerantNumber() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetParseOptionsFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    *types.JobParseOptions
+	}{
+		{
+			name:    "Test 1: When the job has parse options",
+			jobData: `{"URL": "https://api.quirky.com/ticker", "selector": "last", "parseOptions": {"jsonp": true, "numericStrings": true, "decimalSeparator": ","}}`,
+			want:    &types.JobParseOptions{Jsonp: true, NumericStrings: true, DecimalSeparator: ","},
+		},
+		{
+			name:    "Test 2: When the job has empty parse options",
+			jobData: `{"URL": "https://api.quirky.com/ticker", "selector": "last", "parseOptions": {}}`,
+			want:    &types.JobParseOptions{},
+		},
+		{
+			name:    "Test 3: When the job has no parse options",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 4: When the parse options are invalid",
+			jobData: `{"URL": "https://api.quirky.com/ticker", "selector": "last", "parseOptions": {"jsonp": "yes"}}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getParseOptionsFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getParseOptionsFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
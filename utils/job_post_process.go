@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/tidwall/gjson"
+)
+
+//This function returns the post-processing expression of a job of assets.json, an empty string is returned if the job has none
+//An invalid expression is kept so that the job fails instead of committing the value which isn't post-processed
+func getPostProcessFromJSONFile(jobData string) string {
+	expression := gjson.Get(jobData, "postProcess").String()
+	if expression == "" {
+		return ""
+	}
+	normalized, _, err := normalizeJSONSelector(expression)
+	if err == nil {
+		_, err = jsonSelectorLanguage.NewEvaluable(normalized)
+	}
+	if err != nil {
+		log.Errorf("Error in parsing postProcess %s of the job, the job fails until it is fixed: %s", expression, err)
+	}
+	return expression
+}
+
+//This function post-processes the value of the job with its expression, in which value is the value of the job before it is multiplied with the power and response is its JSON response
+//The expressions are evaluated with the language of the JSON selectors on float64 numbers, they have no access to the files, the network or the state of the node
+func postProcessJobValue(expression string, value *big.Float, response interface{}) (*big.Float, error) {
+	if value == nil {
+		return nil, errors.New("no value to post-process")
+	}
+	// The single quoted strings of the filters are normalized as in the selectors
+	expression, _, err := normalizeJSONSelector(expression)
+	if err != nil {
+		return nil, err
+	}
+	// The expressions are evaluated on float64, a value which isn't exactly a float64 is rejected instead of being rounded silently
+	number, accuracy := value.Float64()
+	if accuracy != big.Exact {
+		return nil, fmt.Errorf("value %s can't be post-processed without losing precision", value.Text('g', -1))
+	}
+	result, err := jsonSelectorLanguage.Evaluate(expression, map[string]interface{}{
+		"value":    number,
+		"response": response,
+	})
+	if err != nil {
+		return nil, err
+	}
+	processed, err := toSelectorNumber(result)
+	if err != nil {
+		return nil, fmt.Errorf("result of postProcess is not a number: %w", err)
+	}
+	if math.IsNaN(processed) || math.IsInf(processed, 0) {
+		return nil, fmt.Errorf("result of postProcess %v is not a finite number", processed)
+	}
+	return big.NewFloat(processed), nil
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestGetPostProcessFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    string
+	}{
+		{
+			name:    "Test 1: When the job has a post-processing expression",
+			jobData: `{"URL": "https://api.example.com/ticker/usdeth", "selector": "last", "postProcess": "1 / value"}`,
+			want:    "1 / value",
+		},
+		{
+			name:    "Test 2: When the job has no post-processing expression",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    "",
+		},
+		{
+			name:    "Test 3: When the post-processing expression is invalid, it is kept so that the job fails",
+			jobData: `{"URL": "https://api.example.com/ticker/usdeth", "selector": "last", "postProcess": "1 / (value"}`,
+			want:    "1 / (value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getPostProcessFromJSONFile(tt.jobData); got != tt.want {
+				t.Errorf("getPostProcessFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostProcessJobValue(t *testing.T) {
+	response := map[string]interface{}{
+		"bid":      "2695.07",
+		"ask":      "2696.23",
+		"decimals": 6.0,
+		"rates":    []interface{}{map[string]interface{}{"currency": "EUR", "rate": "0.5"}, map[string]interface{}{"currency": "GBP", "rate": "0.25"}},
+	}
+	preciseValue, _ := new(big.Float).SetPrec(128).SetString("1.00000000000000000000001")
+	tests := []struct {
+		name       string
+		expression string
+		value      *big.Float
+		response   interface{}
+		want       *big.Float
+		wantErr    bool
+	}{
+		{
+			name:       "Test 1: When the pair is inverted",
+			expression: "1 / value",
+			value:      big.NewFloat(0.5),
+			want:       big.NewFloat(2),
+		},
+		{
+			name:       "Test 2: When the decimals of the response are shifted",
+			expression: "value / 10 ** response.decimals",
+			value:      big.NewFloat(2500000),
+			response:   response,
+			want:       big.NewFloat(2.5),
+		},
+		{
+			name:       "Test 3: When two fields of the response are combined and rounded",
+			expression: "round((response.bid + response.ask) / 2, 2)",
+			value:      big.NewFloat(2697.15),
+			response:   response,
+			want:       big.NewFloat(2695.65),
+		},
+		{
+			name:       "Test 4: When the value is converted with a rate filtered from the response",
+			expression: "value * one($.response.rates[?(@.currency=='EUR')].rate)",
+			value:      big.NewFloat(10),
+			response:   response,
+			want:       big.NewFloat(5),
+		},
+		{
+			name:       "Test 5: When the value can't be represented exactly as a float64",
+			expression: "value * 2",
+			value:      preciseValue,
+			wantErr:    true,
+		},
+		{
+			name:       "Test 6: When the field of the response doesn't exist",
+			expression: "value * response.rate",
+			value:      big.NewFloat(10),
+			response:   response,
+			wantErr:    true,
+		},
+		{
+			name:       "Test 7: When the result is not a number",
+			expression: "value > 1",
+			value:      big.NewFloat(10),
+			wantErr:    true,
+		},
+		{
+			name:       "Test 8: When the result is not finite",
+			expression: "1 / value",
+			value:      big.NewFloat(0),
+			wantErr:    true,
+		},
+		{
+			name:       "Test 9: When there is no value",
+			expression: "1 / value",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := postProcessJobValue(tt.expression, tt.value, tt.response)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("postProcessJobValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Cmp(tt.want) != 0 {
+				t.Errorf("postProcessJobValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
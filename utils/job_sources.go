@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"sort"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+//This function returns the additional sources of a job of assets.json and their aggregation, nil is returned if the job has none
+func getJobSourcesFromJSONFile(jobData string) *types.JobSources {
+	sourcesData := gjson.Get(jobData, "sources")
+	if !sourcesData.Exists() {
+		return nil
+	}
+	var sources []types.JobSource
+	err := json.Unmarshal([]byte(sourcesData.Raw), &sources)
+	if err != nil {
+		log.Error("Error in parsing sources of the job: ", err)
+		return nil
+	}
+	jobSourcesData := types.JobSources{}
+	for _, source := range sources {
+		if source.URL == "" {
+			log.Error("Source of the job has no URL, skipping it")
+			continue
+		}
+		jobSourcesData.Sources = append(jobSourcesData.Sources, source)
+	}
+	if len(jobSourcesData.Sources) == 0 {
+		return nil
+	}
+	if aggregationData := gjson.Get(jobData, "sourceAggregation"); aggregationData.Exists() {
+		err = json.Unmarshal([]byte(aggregationData.Raw), &jobSourcesData.Aggregation)
+		if err != nil {
+			log.Error("Error in parsing sourceAggregation of the job: ", err)
+			return nil
+		}
+	}
+	return &jobSourcesData
+}
+
+//This function fetches the job from its own URL and all its sources in parallel and aggregates the values of the sources which didn't fail
+//The own URL of the job has a weight of 1 and the parse options, the conversion and the post-processing of the job apply to all its sources, the headers of the job are only sent to its own URL
+func getDataToCommitFromJobSources(job bindings.StructsJob, options types.JobOptions) (*big.Int, error) {
+	sourceJobs := []bindings.StructsJob{job}
+	sourceWeights := []uint8{1}
+	sourceOptions := []types.JobOptions{options}
+	for _, source := range options.Sources.Sources {
+		sourceJob := job
+		sourceJob.Url = source.URL
+		if source.Selector != "" {
+			sourceJob.Selector = source.Selector
+		}
+		sourceWeight := source.Weight
+		if sourceWeight == 0 {
+			sourceWeight = 1
+		}
+		sourceJobs = append(sourceJobs, sourceJob)
+		sourceWeights = append(sourceWeights, sourceWeight)
+		sourceOptions = append(sourceOptions, types.JobOptions{
+			ParseOptions: options.ParseOptions,
+			Headers:      source.Headers,
+			Conversion:   options.Conversion,
+			PostProcess:  options.PostProcess,
+		})
+	}
+
+	sourceValues := make([]*big.Int, len(sourceJobs))
+	var wg sync.WaitGroup
+	for i := range sourceJobs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value, err := getDataToCommitFromSource(sourceJobs[i], sourceOptions[i])
+			if err != nil {
+				log.Errorf("Error in fetching source %s of job %d: %s", sourceJobs[i].Url, job.Id, err)
+				return
+			}
+			sourceValues[i] = value
+		}(i)
+	}
+	wg.Wait()
+
+	var (
+		values  []*big.Int
+		weights []uint8
+	)
+	for i, value := range sourceValues {
+		if value != nil {
+			values = append(values, value)
+			weights = append(weights, sourceWeights[i])
+		}
+	}
+	return aggregateJobSourceValues(values, weights, options.Sources.Aggregation)
+}
+
+//This function rejects the outliers among the values of the sources of a job and aggregates the others with the strategy of the job
+func aggregateJobSourceValues(values []*big.Int, weights []uint8, aggregation types.JobSourceAggregation) (*big.Int, error) {
+	minSources := aggregation.MinSources
+	if minSources < 1 {
+		minSources = 1
+	}
+	if len(values) < minSources {
+		return nil, fmt.Errorf("%d sources returned a value, %d are required", len(values), minSources)
+	}
+	if aggregation.MaxDeviationPercent > 0 {
+		values, weights = rejectSourceOutliers(values, weights, aggregation.MaxDeviationPercent)
+		if len(values) < minSources {
+			return nil, fmt.Errorf("%d sources are within %.2f%% of the median, %d are required", len(values), aggregation.MaxDeviationPercent, minSources)
+		}
+	}
+
+	switch aggregation.Strategy {
+	case "", core.MedianSourceAggregation:
+		return PerformAggregation(values, weights, 1)
+	case core.WeightedMeanSourceAggregation:
+		return PerformAggregation(values, weights, 2)
+	case core.TrimmedMeanSourceAggregation:
+		trimPercent := aggregation.TrimPercent
+		if trimPercent == 0 {
+			trimPercent = core.DefaultSourceTrimPercent
+		}
+		return calculateTrimmedMean(values, trimPercent), nil
+	}
+	return nil, errors.New("invalid source aggregation strategy " + aggregation.Strategy)
+}
+
+//This function removes the values which deviate from the median of the values by more than maxDeviationPercent
+func rejectSourceOutliers(values []*big.Int, weights []uint8, maxDeviationPercent float64) ([]*big.Int, []uint8) {
+	median := calculateMedian(values)
+	maxDeviation := new(big.Float).Mul(new(big.Float).SetInt(new(big.Int).Abs(median)), big.NewFloat(maxDeviationPercent))
+	var (
+		acceptedValues  []*big.Int
+		acceptedWeights []uint8
+	)
+	for i, value := range values {
+		deviation := new(big.Int).Abs(new(big.Int).Sub(value, median))
+		deviation.Mul(deviation, big.NewInt(100))
+		if new(big.Float).SetInt(deviation).Cmp(maxDeviation) > 0 {
+			log.Warnf("Rejecting source value %s which deviates from the median %s by more than %.2f%%", value, median, maxDeviationPercent)
+			continue
+		}
+		acceptedValues = append(acceptedValues, value)
+		acceptedWeights = append(acceptedWeights, weights[i])
+	}
+	return acceptedValues, acceptedWeights
+}
+
+//This function returns the median of the values, the mean of the two middle values is returned for an even number of values
+func calculateMedian(values []*big.Int) *big.Int {
+	sortedValues := sortBigInts(values)
+	mid := len(sortedValues) / 2
+	if len(sortedValues)%2 == 1 {
+		return sortedValues[mid]
+	}
+	sum := new(big.Int).Add(sortedValues[mid-1], sortedValues[mid])
+	return sum.Div(sum, big.NewInt(2))
+}
+
+//This function returns the mean of the values after dropping trimPercent of the values from each end, at least one value is kept
+func calculateTrimmedMean(values []*big.Int, trimPercent float64) *big.Int {
+	sortedValues := sortBigInts(values)
+	trim := int(float64(len(sortedValues)) * trimPercent / 100)
+	if 2*trim >= len(sortedValues) {
+		trim = (len(sortedValues) - 1) / 2
+	}
+	keptValues := sortedValues[trim : len(sortedValues)-trim]
+	sum := big.NewInt(0)
+	for _, value := range keptValues {
+		sum.Add(sum, value)
+	}
+	return sum.Div(sum, big.NewInt(int64(len(keptValues))))
+}
+
+func sortBigInts(values []*big.Int) []*big.Int {
+	sortedValues := append([]*big.Int{}, values...)
+	sort.Slice(sortedValues, func(i, j int) bool { return sortedValues[i].Cmp(sortedValues[j]) < 0 })
+	return sortedValues
+}
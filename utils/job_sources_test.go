@@ -0,0 +1,318 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"razor/utils/mocks"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAggregateJobSourceValues(t *testing.T) {
+	values := []*big.Int{big.NewInt(100), big.NewInt(102), big.NewInt(98), big.NewInt(101), big.NewInt(500)}
+	weights := []uint8{1, 1, 1, 1, 1}
+
+	type args struct {
+		values      []*big.Int
+		weights     []uint8
+		aggregation types.JobSourceAggregation
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the values are aggregated with the median by default",
+			args: args{
+				values:  values,
+				weights: weights,
+			},
+			want:    big.NewInt(101),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When the values are aggregated with the weighted mean",
+			args: args{
+				values:      []*big.Int{big.NewInt(100), big.NewInt(200)},
+				weights:     []uint8{3, 1},
+				aggregation: types.JobSourceAggregation{Strategy: "weightedMean"},
+			},
+			want:    big.NewInt(125),
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the values are aggregated with the trimmed mean",
+			args: args{
+				values:      values,
+				weights:     weights,
+				aggregation: types.JobSourceAggregation{Strategy: "trimmedMean"},
+			},
+			want:    big.NewInt(101),
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When the outlier is rejected before the weighted mean",
+			args: args{
+				values:      values,
+				weights:     weights,
+				aggregation: types.JobSourceAggregation{Strategy: "weightedMean", MaxDeviationPercent: 5},
+			},
+			want:    big.NewInt(100),
+			wantErr: false,
+		},
+		{
+			name: "Test 5: When too few sources are left after rejecting the outliers",
+			args: args{
+				values:      []*big.Int{big.NewInt(100), big.NewInt(150), big.NewInt(300)},
+				weights:     []uint8{1, 1, 1},
+				aggregation: types.JobSourceAggregation{MaxDeviationPercent: 10, MinSources: 2},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When too few sources returned a value",
+			args: args{
+				values:      []*big.Int{big.NewInt(100)},
+				weights:     []uint8{1},
+				aggregation: types.JobSourceAggregation{MinSources: 2},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 7: When no source returned a value",
+			args: args{
+				values:  nil,
+				weights: nil,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 8: When the strategy is invalid",
+			args: args{
+				values:      values,
+				weights:     weights,
+				aggregation: types.JobSourceAggregation{Strategy: "mode"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := aggregateJobSourceValues(tt.args.values, tt.args.weights, tt.args.aggregation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("aggregateJobSourceValues() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("aggregateJobSourceValues() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateTrimmedMean(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []*big.Int
+		trimPercent float64
+		want        *big.Int
+	}{
+		{
+			name:        "Test 1: When a value is trimmed from each end",
+			values:      []*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(12), big.NewInt(14), big.NewInt(1000)},
+			trimPercent: 20,
+			want:        big.NewInt(12),
+		},
+		{
+			name:        "Test 2: When nothing is trimmed",
+			values:      []*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(13)},
+			trimPercent: 10,
+			want:        big.NewInt(8),
+		},
+		{
+			name:        "Test 3: When the trim would drop all the values",
+			values:      []*big.Int{big.NewInt(1), big.NewInt(10), big.NewInt(13), big.NewInt(20)},
+			trimPercent: 50,
+			want:        big.NewInt(11),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateTrimmedMean(tt.values, tt.trimPercent); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("calculateTrimmedMean() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetJobSourcesFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    *types.JobSources
+	}{
+		{
+			name:    "Test 1: When the job has sources and a source aggregation",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "sources": [{"URL": "https://api.kraken.com/ticker", "selector": "price", "weight": 2}], "sourceAggregation": {"strategy": "trimmedMean", "trimPercent": 10, "maxDeviationPercent": 5, "minSources": 2}}`,
+			want: &types.JobSources{
+				Sources:     []types.JobSource{{URL: "https://api.kraken.com/ticker", Selector: "price", Weight: 2}},
+				Aggregation: types.JobSourceAggregation{Strategy: "trimmedMean", TrimPercent: 10, MaxDeviationPercent: 5, MinSources: 2},
+			},
+		},
+		{
+			name:    "Test 2: When the job has sources without a source aggregation",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "sources": [{"URL": "https://api.kraken.com/ticker"}, {"selector": "price"}]}`,
+			want: &types.JobSources{
+				Sources: []types.JobSource{{URL: "https://api.kraken.com/ticker"}},
+			},
+		},
+		{
+			name:    "Test 3: When the job has no sources",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 4: When the sources are invalid",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "sources": {"URL": "https://api.kraken.com/ticker"}}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 5: When the source aggregation is invalid",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last", "sources": [{"URL": "https://api.kraken.com/ticker"}], "sourceAggregation": {"minSources": "two"}}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getJobSourcesFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getJobSourcesFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDataToCommitFromJobWithSources(t *testing.T) {
+	job := bindings.StructsJob{Id: 1, SelectorType: 0, Weight: 100,
+		Power: 2, Name: "ethusd_gemini", Selector: "last",
+		Url: "https://api.gemini.com/v1/pubticker/ethusd",
+	}
+
+	type args struct {
+		sources    types.JobSources
+		responses  map[string]string
+		failingURL string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When the job is aggregated from all its sources",
+			args: args{
+				sources: types.JobSources{
+					Sources: []types.JobSource{{URL: "https://api.kraken.com/ticker"}, {URL: "https://api.bitstamp.net/ticker"}},
+				},
+				responses: map[string]string{
+					"https://api.gemini.com/v1/pubticker/ethusd": `{"last": 1000}`,
+					"https://api.kraken.com/ticker":              `{"last": 1010}`,
+					"https://api.bitstamp.net/ticker":            `{"last": 990}`,
+				},
+			},
+			want:    big.NewInt(100000),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When a single bad source is rejected as an outlier",
+			args: args{
+				sources: types.JobSources{
+					Sources:     []types.JobSource{{URL: "https://api.kraken.com/ticker"}, {URL: "https://api.bitstamp.net/ticker"}},
+					Aggregation: types.JobSourceAggregation{Strategy: "weightedMean", MaxDeviationPercent: 5},
+				},
+				responses: map[string]string{
+					"https://api.gemini.com/v1/pubticker/ethusd": `{"last": 1000}`,
+					"https://api.kraken.com/ticker":              `{"last": 1010}`,
+					"https://api.bitstamp.net/ticker":            `{"last": 1}`,
+				},
+			},
+			want:    big.NewInt(100500),
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When a source fails and the others are aggregated",
+			args: args{
+				sources: types.JobSources{
+					Sources: []types.JobSource{{URL: "https://api.kraken.com/ticker"}},
+				},
+				responses: map[string]string{
+					"https://api.gemini.com/v1/pubticker/ethusd": `{"last": 1000}`,
+				},
+				failingURL: "https://api.kraken.com/ticker",
+			},
+			want:    big.NewInt(100000),
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When fewer sources than required returned a value",
+			args: args{
+				sources: types.JobSources{
+					Sources:     []types.JobSource{{URL: "https://api.kraken.com/ticker"}},
+					Aggregation: types.JobSourceAggregation{MinSources: 2},
+				},
+				responses: map[string]string{
+					"https://api.gemini.com/v1/pubticker/ethusd": `{"last": 1000}`,
+				},
+				failingURL: "https://api.kraken.com/ticker",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("GetDataFromAPI", mock.AnythingOfType("string"), mock.Anything).Return(func(url string, headers map[string]string) []byte {
+				return []byte(tt.args.responses[url])
+			}, func(url string, headers map[string]string) error {
+				if url == tt.args.failingURL {
+					return errors.New("api error")
+				}
+				return nil
+			})
+			utilsMock.On("GetDataFromJSON", mock.Anything, mock.AnythingOfType("string")).Return(func(jsonObject interface{}, selector string) interface{} {
+				return jsonObject.(map[string]interface{})[strings.Trim(selector, "[]`")]
+			}, nil)
+			utilsMock.On("ConvertToNumber", mock.Anything).Return(func(num interface{}) *big.Float {
+				return big.NewFloat(num.(float64))
+			}, nil)
+
+			SetJobOptions(job, types.JobOptions{Sources: &tt.args.sources})
+			defer SetJobOptions(job, types.JobOptions{})
+
+			got, err := utils.GetDataToCommitFromJob(job)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetDataToCommitFromJob() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDataToCommitFromJob() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+//This function returns the keccak256 hash of the JSON encoding of the data which is written in the journal
+//The JSON encoding is deterministic, so the same inputs give the same hash on every node
+func HashJournalData(data interface{}) string {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		log.Error("Error in marshalling journal data: ", err)
+		return ""
+	}
+	return hexutil.Encode(crypto.Keccak256(jsonData))
+}
+
+//This function adds the action to the journal entry of the epoch, an action with the same name in the epoch is replaced
+//The journal is stored in plain text with one JSON entry per line, so that journals of two nodes can be compared with diff
+func (*UtilsStruct) SaveJournalAction(filePath string, epoch uint32, action types.JournalAction) error {
+	entries, err := UtilsInterface.ReadJournal(filePath)
+	if err != nil {
+		return err
+	}
+
+	index := sort.Search(len(entries), func(i int) bool { return entries[i].Epoch >= epoch })
+	if index == len(entries) || entries[index].Epoch != epoch {
+		entries = append(entries, types.JournalEntry{})
+		copy(entries[index+1:], entries[index:])
+		entries[index] = types.JournalEntry{Epoch: epoch}
+	}
+
+	replaced := false
+	for i := range entries[index].Actions {
+		if entries[index].Actions[i].Action == action.Action {
+			entries[index].Actions[i] = action
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		entries[index].Actions = append(entries[index].Actions, action)
+	}
+
+	if len(entries) > core.JournalLength {
+		entries = entries[len(entries)-core.JournalLength:]
+	}
+
+	var journalData []byte
+	for _, entry := range entries {
+		jsonData, err := JsonInterface.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		journalData = append(journalData, jsonData...)
+		journalData = append(journalData, '\n')
+	}
+	err = OS.WriteFile(filePath, journalData, 0600)
+	if err != nil {
+		log.Error("Error in writing to file: ", err)
+		return err
+	}
+	return nil
+}
+
+//This function reads the journal entries sorted by epoch, it returns no entries if the journal doesn't exist yet
+func (*UtilsStruct) ReadJournal(filePath string) ([]types.JournalEntry, error) {
+	journalData, err := OS.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("Error in reading journal file: ", err)
+		return nil, err
+	}
+
+	var entries []types.JournalEntry
+	for _, line := range bytes.Split(journalData, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var entry types.JournalEntry
+		err = JsonInterface.Unmarshal(line, &entry)
+		if err != nil {
+			log.Error(" Unmarshal error: ", err)
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Epoch < entries[j].Epoch })
+	return entries, nil
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestHashJournalData(t *testing.T) {
+	values := []*big.Int{big.NewInt(100), big.NewInt(200)}
+	hash := HashJournalData(values)
+	if len(hash) != 66 {
+		t.Errorf("HashJournalData() got = %s, want 32 bytes hex encoded hash", hash)
+	}
+	if HashJournalData([]*big.Int{big.NewInt(100), big.NewInt(200)}) != hash {
+		t.Error("HashJournalData() returned different hashes for the same data")
+	}
+	if HashJournalData([]*big.Int{big.NewInt(100), big.NewInt(201)}) == hash {
+		t.Error("HashJournalData() returned the same hash for different data")
+	}
+}
+
+func TestSaveJournalAction(t *testing.T) {
+	StartRazor(OptionsPackageStruct{
+		UtilsInterface: &UtilsStruct{},
+		OS:             OSStruct{},
+		JsonInterface:  JsonStruct{},
+	})
+
+	journalLength := core.JournalLength
+	defer func() { core.JournalLength = journalLength }()
+	core.JournalLength = 2
+
+	filePath := filepath.Join(t.TempDir(), "journal.jsonl")
+	commit := types.JournalAction{Action: "commit", Hashes: map[string]string{"values": "0x01"}, TxnHash: "0x02", Status: "mined"}
+	reveal := types.JournalAction{Action: "reveal", Hashes: map[string]string{"values": "0x01"}, TxnHash: "0x03", Status: "failed"}
+	retriedReveal := types.JournalAction{Action: "reveal", Hashes: map[string]string{"values": "0x01"}, TxnHash: "0x04", Status: "mined"}
+
+	ut := &UtilsStruct{}
+	for _, action := range []struct {
+		epoch  uint32
+		action types.JournalAction
+	}{
+		{epoch: 11, action: commit},
+		{epoch: 10, action: commit},
+		{epoch: 11, action: reveal},
+		{epoch: 11, action: retriedReveal},
+	} {
+		if err := ut.SaveJournalAction(filePath, action.epoch, action.action); err != nil {
+			t.Fatalf("SaveJournalAction() error = %v", err)
+		}
+	}
+
+	entries, err := ut.ReadJournal(filePath)
+	if err != nil {
+		t.Fatalf("ReadJournal() error = %v", err)
+	}
+	want := []types.JournalEntry{
+		{Epoch: 10, Actions: []types.JournalAction{commit}},
+		{Epoch: 11, Actions: []types.JournalAction{commit, retriedReveal}},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("ReadJournal() got = %+v, want %+v", entries, want)
+	}
+
+	if err := ut.SaveJournalAction(filePath, 12, commit); err != nil {
+		t.Fatalf("SaveJournalAction() error = %v", err)
+	}
+	journalData, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Error in reading journal file: %v", err)
+	}
+	wantJournal := `{"epoch":11,"actions":[{"action":"commit","hashes":{"values":"0x01"},"txnHash":"0x02","status":"mined"},{"action":"reveal","hashes":{"values":"0x01"},"txnHash":"0x04","status":"mined"}]}` + "\n" +
+		`{"epoch":12,"actions":[{"action":"commit","hashes":{"values":"0x01"},"txnHash":"0x02","status":"mined"}]}` + "\n"
+	if string(journalData) != wantJournal {
+		t.Errorf("SaveJournalAction() journal got = %s, want %s", journalData, wantJournal)
+	}
+}
+
+func TestReadJournal(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, JsonInterface: JsonStruct{}})
+
+	dir := t.TempDir()
+	invalidFilePath := filepath.Join(dir, "invalid.jsonl")
+	if err := os.WriteFile(invalidFilePath, []byte("not a journal entry\n"), 0600); err != nil {
+		t.Fatalf("Error in writing journal file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		want     []types.JournalEntry
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When journal file doesn't exist",
+			filePath: filepath.Join(dir, "missing.jsonl"),
+			want:     nil,
+			wantErr:  false,
+		},
+		{
+			name:     "Test 2: When journal file has an invalid entry",
+			filePath: invalidFilePath,
+			want:     nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := &UtilsStruct{}
+			got, err := ut.ReadJournal(tt.filePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadJournal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadJournal() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+)
+
+//The language of the JSON selectors, the JSONPath of the value with filters and slices which can be used in arithmetic expressions and with the rounding functions
+var jsonSelectorLanguage = gval.NewLanguage(
+	// The operators which are defined first take precedence over the ones of the full language
+	gval.InfixTextOperator("+", addSelectorStrings),
+	gval.Full(),
+	jsonpath.Language(),
+	gval.Function("one", selectSingleValue),
+	gval.Function("round", roundSelectorValue),
+	gval.Function("floor", func(x interface{}) (interface{}, error) { return applySelectorFunction(x, math.Floor) }),
+	gval.Function("ceil", func(x interface{}) (interface{}, error) { return applySelectorFunction(x, math.Ceil) }),
+	gval.Function("abs", func(x interface{}) (interface{}, error) { return applySelectorFunction(x, math.Abs) }),
+)
+
+//This function evaluates the selector on the JSON response and returns the value it selects
+//The selector is either the path of the value from the root like data.price or [`data`][0][`price`], or a JSONPath expression from $ like $.data[?(@.symbol=='ETH')].price or round(($.bid + $.ask) / 2, 2)
+//A path which can match several values should match exactly one, the value matched by such a path is taken with one() in the arithmetic expressions like one($[?(@.symbol=='ETH')].price) * 100
+func evaluateJSONSelector(jsonObject interface{}, selector string) (interface{}, error) {
+	expression, isExpression, err := normalizeJSONSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	if !isExpression {
+		if expression[0] == '[' {
+			expression = "$" + expression
+		} else {
+			expression = "$." + expression
+		}
+	}
+	value, err := jsonSelectorLanguage.Evaluate(expression, jsonObject)
+	if err != nil {
+		return nil, err
+	}
+	return selectSingleValue(value)
+}
+
+//This function replaces the single quoted strings of the selector with double quoted ones, which the expressions are parsed with, and returns if the selector is a JSONPath expression
+//The selector is an expression if it has a $ outside of its strings
+func normalizeJSONSelector(selector string) (string, bool, error) {
+	if strings.TrimSpace(selector) == "" {
+		return "", false, errors.New("selector is empty")
+	}
+	var (
+		normalized   strings.Builder
+		isExpression bool
+	)
+	for i := 0; i < len(selector); i++ {
+		switch selector[i] {
+		case '$':
+			isExpression = true
+			normalized.WriteByte('$')
+		case '"', '`':
+			end := findStringEnd(selector, i)
+			if end < 0 {
+				return "", false, fmt.Errorf("unterminated string in selector %s", selector)
+			}
+			normalized.WriteString(selector[i : end+1])
+			i = end
+		case '\'':
+			end := findStringEnd(selector, i)
+			if end < 0 {
+				return "", false, fmt.Errorf("unterminated string in selector %s", selector)
+			}
+			normalized.WriteString(strconv.Quote(strings.ReplaceAll(selector[i+1:end], `\'`, `'`)))
+			i = end
+		default:
+			normalized.WriteByte(selector[i])
+		}
+	}
+	return normalized.String(), isExpression, nil
+}
+
+//This function returns the index of the quote which ends the string starting at the given index, the quotes escaped with a backslash don't end it except in a raw string
+func findStringEnd(selector string, start int) int {
+	quote := selector[start]
+	for i := start + 1; i < len(selector); i++ {
+		if selector[i] == '\\' && quote != '`' {
+			i++
+			continue
+		}
+		if selector[i] == quote {
+			return i
+		}
+	}
+	return -1
+}
+
+//This function returns the value matched by a path which can match several values, i.e. with a filter, a slice or a wildcard, which JSONPath returns as an array
+//The path should match exactly one value, so that a change in the response can't silently change the selected value
+func selectSingleValue(value interface{}) (interface{}, error) {
+	values, ok := value.([]interface{})
+	if !ok {
+		return value, nil
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("selector matches %d values instead of one", len(values))
+	}
+	return values[0], nil
+}
+
+//This function adds the strings, the numbers of the APIs which are strings are added as numbers instead of being concatenated
+func addSelectorStrings(a, b string) (interface{}, error) {
+	x, xErr := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	y, yErr := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if xErr != nil || yErr != nil {
+		return a + b, nil
+	}
+	return x + y, nil
+}
+
+//This function rounds the value to the given number of decimals, to an integer if they aren't passed
+func roundSelectorValue(arguments ...interface{}) (interface{}, error) {
+	if len(arguments) == 0 || len(arguments) > 2 {
+		return nil, errors.New("round takes a value and optionally the number of decimals")
+	}
+	decimals := 0.0
+	if len(arguments) == 2 {
+		var err error
+		decimals, err = toSelectorNumber(arguments[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	multiplier := math.Pow(10, decimals)
+	return applySelectorFunction(arguments[0], func(x float64) float64 { return math.Round(x*multiplier) / multiplier })
+}
+
+//This function applies the function to the numeric value selected from the response
+func applySelectorFunction(value interface{}, function func(float64) float64) (interface{}, error) {
+	number, err := toSelectorNumber(value)
+	if err != nil {
+		return nil, err
+	}
+	return function(number), nil
+}
+
+//This function returns the number of a value selected from the response, the numbers of the APIs are often strings
+func toSelectorNumber(value interface{}) (float64, error) {
+	value, err := selectSingleValue(value)
+	if err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	}
+	return 0, fmt.Errorf("%v is not a number", value)
+}
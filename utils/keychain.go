@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+//Service under which the passwords of the keystores are stored in the keychain of the OS
+const keychainService = "razor-go"
+
+var (
+	ErrKeychainPasswordNotFound = errors.New("password is not stored in the keychain")
+	errKeychainUnsupported      = errors.New("keychain is not supported on this OS")
+)
+
+//This function stores the password of the keystore of the address in the keychain of the OS
+//It is stored in the Keychain on macOS, the Secret Service on Linux and the Credential Manager on Windows
+func StoreKeychainPassword(address string, password string) error {
+	return setKeychainPassword(keychainService, getKeychainAccount(address), password)
+}
+
+//This function returns the password of the keystore of the address stored in the keychain of the OS
+func GetKeychainPassword(address string) (string, error) {
+	return getKeychainPassword(keychainService, getKeychainAccount(address))
+}
+
+//This function removes the password of the keystore of the address from the keychain of the OS
+func DeleteKeychainPassword(address string) error {
+	return deleteKeychainPassword(keychainService, getKeychainAccount(address))
+}
+
+//The addresses are stored in lower case so that the password is found irrespective of the checksum case of the address
+func getKeychainAccount(address string) string {
+	return strings.ToLower(address)
+}
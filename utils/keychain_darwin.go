@@ -0,0 +1,52 @@
+//go:build darwin
+// +build darwin
+
+package utils
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+//The password is passed to the security tool in its interactive mode on the standard input, so that it isn't visible in the arguments of the process
+//As the interactive mode doesn't fail on the errors of its commands, the password is read back to check that it is stored
+func setKeychainPassword(service string, account string, password string) error {
+	command := exec.Command("security", "-i")
+	command.Stdin = strings.NewReader("add-generic-password -U -a " + quoteSecurityArgument(account) + " -s " + quoteSecurityArgument(service) + " -w " + quoteSecurityArgument(password) + "\n")
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return errors.New("error in storing password in the keychain: " + strings.TrimSpace(string(output)))
+	}
+	storedPassword, err := getKeychainPassword(service, account)
+	if err != nil || storedPassword != password {
+		return errors.New("error in storing password in the keychain: " + strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func getKeychainPassword(service string, account string) (string, error) {
+	output, err := exec.Command("security", "find-generic-password", "-a", account, "-s", service, "-w").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 44 {
+			return "", ErrKeychainPasswordNotFound
+		}
+		return "", err
+	}
+	return strings.TrimSuffix(string(output), "\n"), nil
+}
+
+func deleteKeychainPassword(service string, account string) error {
+	err := exec.Command("security", "delete-generic-password", "-a", account, "-s", service).Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 44 {
+		return ErrKeychainPasswordNotFound
+	}
+	return err
+}
+
+//This function quotes the argument for the interactive mode of the security tool
+func quoteSecurityArgument(argument string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(argument) + `"`
+}
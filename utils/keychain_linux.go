@@ -0,0 +1,40 @@
+//go:build linux
+// +build linux
+
+package utils
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+//The password is passed to secret-tool on the standard input, so that it isn't visible in the arguments of the process
+func setKeychainPassword(service string, account string, password string) error {
+	command := exec.Command("secret-tool", "store", "--label=razor-go keystore "+account, "service", service, "account", account)
+	command.Stdin = strings.NewReader(password)
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return errors.New("error in storing password in the secret service: " + strings.TrimSpace(string(output)) + " " + err.Error())
+	}
+	return nil
+}
+
+func getKeychainPassword(service string, account string) (string, error) {
+	output, err := exec.Command("secret-tool", "lookup", "service", service, "account", account).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) == 0 {
+			return "", ErrKeychainPasswordNotFound
+		}
+		return "", err
+	}
+	return string(output), nil
+}
+
+func deleteKeychainPassword(service string, account string) error {
+	if _, err := getKeychainPassword(service, account); err != nil {
+		return err
+	}
+	return exec.Command("secret-tool", "clear", "service", service, "account", account).Run()
+}
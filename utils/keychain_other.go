@@ -0,0 +1,16 @@
+//go:build !darwin && !linux && !windows
+// +build !darwin,!linux,!windows
+
+package utils
+
+func setKeychainPassword(service string, account string, password string) error {
+	return errKeychainUnsupported
+}
+
+func getKeychainPassword(service string, account string) (string, error) {
+	return "", errKeychainUnsupported
+}
+
+func deleteKeychainPassword(service string, account string) error {
+	return errKeychainUnsupported
+}
@@ -0,0 +1,29 @@
+package utils
+
+import "testing"
+
+func TestGetKeychainAccount(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    string
+	}{
+		{
+			name:    "Test 1: When address is checksummed",
+			address: "0x5a0b54D5dc17e0AadC383d2db43B0a0D3E029c4c",
+			want:    "0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c",
+		},
+		{
+			name:    "Test 2: When address is in lower case",
+			address: "0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c",
+			want:    "0x5a0b54d5dc17e0aadc383d2db43b0a0d3e029c4c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getKeychainAccount(tt.address); got != tt.want {
+				t.Errorf("getKeychainAccount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+//go:build windows
+// +build windows
+
+package utils
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	credTypeGeneric         = 1
+	credPersistLocalMachine = 2
+	errorNotFound           = syscall.Errno(1168)
+)
+
+var (
+	advapi32        = syscall.NewLazyDLL("advapi32.dll")
+	procCredWriteW  = advapi32.NewProc("CredWriteW")
+	procCredReadW   = advapi32.NewProc("CredReadW")
+	procCredDeleteW = advapi32.NewProc("CredDeleteW")
+	procCredFree    = advapi32.NewProc("CredFree")
+)
+
+//credential is the CREDENTIALW structure of the Credential Manager
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+//The target of the credential is the service and the account, as the Credential Manager keys the credentials only by their target
+func getCredentialTarget(service string, account string) (*uint16, error) {
+	return syscall.UTF16PtrFromString(service + ":" + account)
+}
+
+func setKeychainPassword(service string, account string, password string) error {
+	if password == "" {
+		return errors.New("password can't be empty")
+	}
+	target, err := getCredentialTarget(service, account)
+	if err != nil {
+		return err
+	}
+	userName, err := syscall.UTF16PtrFromString(account)
+	if err != nil {
+		return err
+	}
+	blob := []byte(password)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		CredentialBlob:     &blob[0],
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	ret, _, err := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+func getKeychainPassword(service string, account string) (string, error) {
+	target, err := getCredentialTarget(service, account)
+	if err != nil {
+		return "", err
+	}
+	var cred *credential
+	ret, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if ret == 0 {
+		if errors.Is(err, errorNotFound) {
+			return "", ErrKeychainPasswordNotFound
+		}
+		return "", err
+	}
+	defer procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+	if cred.CredentialBlobSize == 0 {
+		return "", nil
+	}
+	blob := unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)
+	return string(blob), nil
+}
+
+func deleteKeychainPassword(service string, account string) error {
+	target, err := getCredentialTarget(service, account)
+	if err != nil {
+		return err
+	}
+	ret, _, err := procCredDeleteW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0)
+	if ret == 0 {
+		if errors.Is(err, errorNotFound) {
+			return ErrKeychainPasswordNotFound
+		}
+		return err
+	}
+	return nil
+}
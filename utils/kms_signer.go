@@ -0,0 +1,492 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"razor/core"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+//Schemes of the uri of the KMS key which signs the transactions and the secrets without the key leaving the KMS
+const (
+	awsKMSScheme       = "awskms"
+	gcpKMSScheme       = "gcpkms"
+	kmsSignaturePrefix = "kmsSignature/"
+)
+
+//Object identifier of the secp256k1 curve in the public keys returned by the KMS
+var secp256k1OID = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+//kmsBackend signs the digests with a secp256k1 key which is kept by the KMS
+type kmsBackend interface {
+	//This function returns the public key in DER encoded SubjectPublicKeyInfo
+	getPublicKey() ([]byte, error)
+	//This function returns the DER encoded ECDSA signature of the digest
+	sign(digest []byte) ([]byte, error)
+}
+
+var (
+	kmsSigner    kmsBackend
+	kmsPublicKey *ecdsa.PublicKey
+	kmsMutex     sync.Mutex
+)
+
+//The signature of the data which is signed last by the KMS key of an address
+type kmsSignature struct {
+	Hash      hexutil.Bytes `json:"hash"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+//This function sets the KMS key which signs the transactions and the secrets, the local keystore is used if it is empty
+//The key is awskms://<region>/<key id or arn> for AWS KMS or gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>/cryptoKeyVersions/<version> for GCP Cloud KMS
+func SetKMSSigner(keyUri string) error {
+	kmsMutex.Lock()
+	defer kmsMutex.Unlock()
+	kmsSigner, kmsPublicKey = nil, nil
+	if keyUri == "" {
+		return nil
+	}
+	backend, err := newKMSBackend(keyUri)
+	if err != nil {
+		return err
+	}
+	kmsSigner = backend
+	return nil
+}
+
+//This function checks that the uri of the KMS key is of a supported KMS
+func ValidateKMSKey(keyUri string) error {
+	if keyUri == "" {
+		return nil
+	}
+	_, err := newKMSBackend(keyUri)
+	return err
+}
+
+//This function returns true if the transactions and the secrets are signed by the KMS
+func IsKMSSignerEnabled() bool {
+	kmsMutex.Lock()
+	defer kmsMutex.Unlock()
+	return kmsSigner != nil
+}
+
+func newKMSBackend(keyUri string) (kmsBackend, error) {
+	invalidKeyErr := fmt.Errorf("invalid kmsKey %s, it should be awskms://<region>/<key id> or gcpkms://projects/<project>/locations/<location>/keyRings/<key ring>/cryptoKeys/<key>/cryptoKeyVersions/<version>", keyUri)
+	parts := strings.SplitN(keyUri, "://", 2)
+	if len(parts) != 2 {
+		return nil, invalidKeyErr
+	}
+	switch parts[0] {
+	case awsKMSScheme:
+		keyParts := strings.SplitN(parts[1], "/", 2)
+		if len(keyParts) != 2 || keyParts[0] == "" || keyParts[1] == "" {
+			return nil, invalidKeyErr
+		}
+		return &awsKMS{
+			region:   keyParts[0],
+			keyId:    keyParts[1],
+			endpoint: "https://kms." + keyParts[0] + ".amazonaws.com",
+		}, nil
+	case gcpKMSScheme:
+		if !strings.HasPrefix(parts[1], "projects/") || !strings.Contains(parts[1], "/cryptoKeyVersions/") {
+			return nil, invalidKeyErr
+		}
+		return &gcpKMS{
+			name:     parts[1],
+			endpoint: "https://cloudkms.googleapis.com",
+		}, nil
+	default:
+		return nil, invalidKeyErr
+	}
+}
+
+//This function returns the public key of the KMS key, which is fetched once
+func getKMSPublicKey() (kmsBackend, *ecdsa.PublicKey, error) {
+	kmsMutex.Lock()
+	defer kmsMutex.Unlock()
+	if kmsSigner == nil {
+		return nil, nil, errors.New("KMS key is not set")
+	}
+	if kmsPublicKey == nil {
+		der, err := kmsSigner.getPublicKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		publicKey, err := parseKMSPublicKey(der)
+		if err != nil {
+			return nil, nil, err
+		}
+		kmsPublicKey = publicKey
+	}
+	return kmsSigner, kmsPublicKey, nil
+}
+
+//This function parses the DER encoded SubjectPublicKeyInfo of a secp256k1 key, which the x509 package doesn't support
+func parseKMSPublicKey(der []byte) (*ecdsa.PublicKey, error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("error in parsing public key of the KMS key: %w", err)
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(secp256k1OID) {
+		return nil, errors.New("KMS key should be a secp256k1 key (ECC_SECG_P256K1 in AWS KMS, EC_SIGN_SECP256K1_SHA256 in GCP Cloud KMS)")
+	}
+	return crypto.UnmarshalPubkey(info.PublicKey.Bytes)
+}
+
+//This function returns the address of the KMS key after checking that it is the given address
+func checkKMSAddress(address common.Address) (kmsBackend, *ecdsa.PublicKey, error) {
+	backend, publicKey, err := getKMSPublicKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	if kmsAddress := crypto.PubkeyToAddress(*publicKey); kmsAddress != address {
+		return nil, nil, fmt.Errorf("KMS key belongs to %s instead of %s", kmsAddress.Hex(), address.Hex())
+	}
+	return backend, publicKey, nil
+}
+
+//This function signs the digest with the KMS key and returns the signature in the [R || S || V] format with a recovery id of 0 or 1
+func signDigestWithKMS(backend kmsBackend, publicKey *ecdsa.PublicKey, digest []byte) ([]byte, error) {
+	der, err := backend.sign(digest)
+	if err != nil {
+		return nil, err
+	}
+	return toEthereumSignature(digest, der, publicKey)
+}
+
+//This function converts the DER encoded signature of the KMS into the signature which ethereum accepts
+//The S value is moved to the lower half of the curve order as required by EIP-2 and the recovery id is found by recovering the public key
+func toEthereumSignature(digest []byte, der []byte, publicKey *ecdsa.PublicKey) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("error in parsing signature of the KMS: %w", err)
+	}
+	curveOrder := crypto.S256().Params().N
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.Cmp(curveOrder) >= 0 || sig.S.Cmp(curveOrder) >= 0 {
+		return nil, errors.New("invalid signature of the KMS")
+	}
+	s := sig.S
+	if s.Cmp(new(big.Int).Rsh(curveOrder, 1)) > 0 {
+		s = new(big.Int).Sub(curveOrder, s)
+	}
+	signature := make([]byte, 65)
+	sig.R.FillBytes(signature[:32])
+	s.FillBytes(signature[32:64])
+	expectedPublicKey := crypto.FromECDSAPub(publicKey)
+	for recoveryId := byte(0); recoveryId < 2; recoveryId++ {
+		signature[64] = recoveryId
+		recoveredPublicKey, err := crypto.Ecrecover(digest, signature)
+		if err == nil && bytes.Equal(recoveredPublicKey, expectedPublicKey) {
+			return signature, nil
+		}
+	}
+	return nil, errors.New("signature of the KMS doesn't match the public key of the KMS key")
+}
+
+//This function returns the transactor of the address whose transactions are signed by the KMS
+func getKMSTransactor(address common.Address, chainId *big.Int) (*bind.TransactOpts, error) {
+	backend, publicKey, err := checkKMSAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	signer := Types.LatestSignerForChainID(chainId)
+	return &bind.TransactOpts{
+		From: address,
+		Signer: func(from common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+			if from != address {
+				return nil, bind.ErrNotAuthorized
+			}
+			signature, err := signDigestWithKMS(backend, publicKey, signer.Hash(txn).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return txn.WithSignature(signer, signature)
+		},
+		Context: context.Background(),
+	}, nil
+}
+
+//This function signs the data as a personal message with the KMS key, as done with the local keystore the recovery id of the signature is 0 or 1
+//The KMS doesn't sign deterministically, so the signature is saved in the state store and the same signature is returned when the data is signed again, e.g. for the secret at reveal
+func SignDataWithKMS(address string, data []byte) ([]byte, error) {
+	backend, publicKey, err := checkKMSAddress(common.HexToAddress(address))
+	if err != nil {
+		return nil, err
+	}
+	hash := SignHash(data)
+	saved, err := readKMSSignature(address)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(saved.Hash, hash) {
+		return append([]byte{}, saved.Signature...), nil
+	}
+	signature, err := signDigestWithKMS(backend, publicKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	err = saveKMSSignature(address, kmsSignature{Hash: hash, Signature: signature})
+	if err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+//This function signs the data as a personal message with the KMS key of the address without saving the signature, as the saved signature is the one of the secret
+func SignAttestationWithKMS(address string, data []byte) ([]byte, error) {
+	backend, publicKey, err := checkKMSAddress(common.HexToAddress(address))
+	if err != nil {
+		return nil, err
+	}
+	return signDigestWithKMS(backend, publicKey, SignHash(data))
+}
+
+func readKMSSignature(address string) (kmsSignature, error) {
+	var signature kmsSignature
+	err := withStateDB(func(db *leveldb.DB) error {
+		data, err := db.Get([]byte(kmsSignaturePrefix+strings.ToLower(address)), nil)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		data, err = DecryptStateData(data)
+		if err != nil {
+			return err
+		}
+		return JsonInterface.Unmarshal(data, &signature)
+	})
+	return signature, err
+}
+
+func saveKMSSignature(address string, signature kmsSignature) error {
+	jsonData, err := JsonInterface.Marshal(signature)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	return withStateDB(func(db *leveldb.DB) error {
+		return db.Put([]byte(kmsSignaturePrefix+strings.ToLower(address)), jsonData, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function sends the request to the KMS and decodes its response
+func doKMSRequest(request *http.Request, response interface{}) error {
+	client := &http.Client{Timeout: time.Duration(core.RemoteSignerTimeout) * time.Second}
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("KMS returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.Unmarshal(body, response)
+}
+
+//awsKMS signs with a key of AWS KMS, the credentials are read from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN
+type awsKMS struct {
+	region   string
+	keyId    string
+	endpoint string
+}
+
+func (kms *awsKMS) getPublicKey() ([]byte, error) {
+	var response struct {
+		PublicKey []byte
+	}
+	err := kms.call("GetPublicKey", map[string]interface{}{"KeyId": kms.keyId}, &response)
+	return response.PublicKey, err
+}
+
+func (kms *awsKMS) sign(digest []byte) ([]byte, error) {
+	var response struct {
+		Signature []byte
+	}
+	err := kms.call("Sign", map[string]interface{}{
+		"KeyId":            kms.keyId,
+		"Message":          digest,
+		"MessageType":      "DIGEST",
+		"SigningAlgorithm": "ECDSA_SHA_256",
+	}, &response)
+	return response.Signature, err
+}
+
+func (kms *awsKMS) call(action string, request interface{}, response interface{}) error {
+	accessKeyId, secretAccessKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKeyId == "" || secretAccessKey == "" {
+		return errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY should be set to use AWS KMS")
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	httpRequest, err := http.NewRequest(http.MethodPost, kms.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	httpRequest.Header.Set("X-Amz-Target", "TrentService."+action)
+	if sessionToken := os.Getenv("AWS_SESSION_TOKEN"); sessionToken != "" {
+		httpRequest.Header.Set("X-Amz-Security-Token", sessionToken)
+	}
+	signAWSRequest(httpRequest, body, accessKeyId, secretAccessKey, kms.region, "kms", time.Now().UTC())
+	return doKMSRequest(httpRequest, response)
+}
+
+//This function signs the request with AWS Signature Version 4, all the headers set on the request are signed
+func signAWSRequest(request *http.Request, body []byte, accessKeyId string, secretAccessKey string, region string, service string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	request.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{"host": request.URL.Host}
+	for name, values := range request.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	var names []string
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	canonicalUri := request.URL.EscapedPath()
+	if canonicalUri == "" {
+		canonicalUri = "/"
+	}
+	payloadHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{request.Method, canonicalUri, request.URL.RawQuery, canonicalHeaders.String(), signedHeaders, hex.EncodeToString(payloadHash[:])}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+	signature := hex.EncodeToString(hmacSHA256(getAWSSigningKey(secretAccessKey, date, region, service), stringToSign))
+	request.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKeyId, scope, signedHeaders, signature))
+}
+
+//This function derives the key which signs the requests of the day to the service in the region
+func getAWSSigningKey(secretAccessKey string, date string, region string, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+//gcpKMS signs with a key version of GCP Cloud KMS, the access token is read from GOOGLE_OAUTH_ACCESS_TOKEN or from the metadata server of the instance
+type gcpKMS struct {
+	name     string
+	endpoint string
+}
+
+func (kms *gcpKMS) getPublicKey() ([]byte, error) {
+	var response struct {
+		Pem string `json:"pem"`
+	}
+	if err := kms.call(http.MethodGet, "/v1/"+kms.name+"/publicKey", nil, &response); err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(response.Pem))
+	if block == nil {
+		return nil, errors.New("public key of the KMS key is not in PEM format")
+	}
+	return block.Bytes, nil
+}
+
+func (kms *gcpKMS) sign(digest []byte) ([]byte, error) {
+	var response struct {
+		Signature []byte `json:"signature"`
+	}
+	request := map[string]interface{}{"digest": map[string][]byte{"sha256": digest}}
+	err := kms.call(http.MethodPost, "/v1/"+kms.name+":asymmetricSign", request, &response)
+	return response.Signature, err
+}
+
+func (kms *gcpKMS) call(method string, path string, request interface{}, response interface{}) error {
+	accessToken, err := getGCPAccessToken()
+	if err != nil {
+		return err
+	}
+	var body io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	httpRequest, err := http.NewRequest(method, kms.endpoint+path, body)
+	if err != nil {
+		return err
+	}
+	httpRequest.Header.Set("Authorization", "Bearer "+accessToken)
+	httpRequest.Header.Set("Content-Type", "application/json")
+	return doKMSRequest(httpRequest, response)
+}
+
+//This function returns the access token of GCP from GOOGLE_OAUTH_ACCESS_TOKEN or from the service account of the instance
+func getGCPAccessToken() (string, error) {
+	if accessToken := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); accessToken != "" {
+		return accessToken, nil
+	}
+	request, err := http.NewRequest(http.MethodGet, "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Metadata-Flavor", "Google")
+	var response struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := doKMSRequest(request, &response); err != nil {
+		return "", fmt.Errorf("error in getting access token of GCP, set GOOGLE_OAUTH_ACCESS_TOKEN outside GCP: %w", err)
+	}
+	return response.AccessToken, nil
+}
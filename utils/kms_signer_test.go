@@ -0,0 +1,252 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+//This function returns the DER encoded SubjectPublicKeyInfo of the secp256k1 key as returned by the KMS
+func marshalKMSPublicKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	curve, err := asn1.Marshal(secp256k1OID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := crypto.FromECDSAPub(&key.PublicKey)
+	der, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}, Parameters: asn1.RawValue{FullBytes: curve}},
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: len(publicKey) * 8},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+//This function signs the digest like the KMS, with a random nonce and a DER encoded signature whose S value can be in the upper half of the curve order
+func signLikeKMS(t *testing.T, key *ecdsa.PrivateKey, digest []byte) []byte {
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
+
+func TestNewKMSBackend(t *testing.T) {
+	tests := []struct {
+		name    string
+		keyUri  string
+		want    kmsBackend
+		wantErr bool
+	}{
+		{
+			name:   "Test 1: When the key is of AWS KMS",
+			keyUri: "awskms://us-east-1/arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			want:   &awsKMS{region: "us-east-1", keyId: "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab", endpoint: "https://kms.us-east-1.amazonaws.com"},
+		},
+		{
+			name:   "Test 2: When the key is of GCP Cloud KMS",
+			keyUri: "gcpkms://projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1/cryptoKeyVersions/1",
+			want:   &gcpKMS{name: "projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1/cryptoKeyVersions/1", endpoint: "https://cloudkms.googleapis.com"},
+		},
+		{
+			name:    "Test 3: When the key of AWS KMS has no region",
+			keyUri:  "awskms:///1234abcd-12ab-34cd-56ef-1234567890ab",
+			wantErr: true,
+		},
+		{
+			name:    "Test 4: When the key of GCP Cloud KMS has no version",
+			keyUri:  "gcpkms://projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1",
+			wantErr: true,
+		},
+		{
+			name:    "Test 5: When the KMS is not supported",
+			keyUri:  "vault://127.0.0.1:8200/transit/keys/staker1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newKMSBackend(tt.keyUri)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("newKMSBackend() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !isSameKMSBackend(got, tt.want) {
+				t.Errorf("newKMSBackend() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func isSameKMSBackend(got kmsBackend, want kmsBackend) bool {
+	switch backend := got.(type) {
+	case *awsKMS:
+		wantBackend, ok := want.(*awsKMS)
+		return ok && *backend == *wantBackend
+	case *gcpKMS:
+		wantBackend, ok := want.(*gcpKMS)
+		return ok && *backend == *wantBackend
+	}
+	return false
+}
+
+func TestGetAWSSigningKey(t *testing.T) {
+	// Example of deriving the signing key in the documentation of AWS Signature Version 4
+	got := hex.EncodeToString(getAWSSigningKey("wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", "20120215", "us-east-1", "iam"))
+	want := "f4780e2d9f65fa895f9c67b32ce1baf0b0d8a43505a000a1a9e090d414db404d"
+	if got != want {
+		t.Errorf("getAWSSigningKey() = %s, want %s", got, want)
+	}
+}
+
+func TestKMSTransactor(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	publicKey := marshalKMSPublicKey(t, key)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var request struct {
+			KeyId   string
+			Message []byte
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.KeyId != "1234abcd-12ab-34cd-56ef-1234567890ab" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.GetPublicKey":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"PublicKey": publicKey, "KeySpec": "ECC_SECG_P256K1"})
+		case "TrentService.Sign":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"Signature": signLikeKMS(t, key, request.Message)})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	defer SetKMSSigner("")
+	if err := SetKMSSigner("awskms://us-east-1/1234abcd-12ab-34cd-56ef-1234567890ab"); err != nil {
+		t.Fatal(err)
+	}
+	kmsSigner.(*awsKMS).endpoint = server.URL
+
+	chainId := big.NewInt(80001)
+	txnOpts, err := getKMSTransactor(address, chainId)
+	if err != nil {
+		t.Fatalf("getKMSTransactor() error = %v", err)
+	}
+	signer := Types.LatestSignerForChainID(chainId)
+	// The KMS signs with a random nonce, so the S value is in the upper half of the curve order for about half of the transactions
+	for nonce := uint64(0); nonce < 8; nonce++ {
+		txn := Types.NewTransaction(nonce, common.HexToAddress("0x000000000000000000000000000000000000dea1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+		signedTxn, err := txnOpts.Signer(address, txn)
+		if err != nil {
+			t.Fatalf("Signer() error = %v", err)
+		}
+		sender, err := Types.Sender(signer, signedTxn)
+		if err != nil || sender != address {
+			t.Errorf("Sender() = %s, %v, want %s", sender.Hex(), err, address.Hex())
+		}
+	}
+
+	if _, err := getKMSTransactor(common.HexToAddress("0x000000000000000000000000000000000000dea1"), chainId); err == nil {
+		t.Error("getKMSTransactor() error = nil for an address which isn't of the KMS key")
+	}
+}
+
+func TestGCPKMS(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := "projects/razor/locations/global/keyRings/stakers/cryptoKeys/staker1/cryptoKeyVersions/1"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/"+name+"/publicKey":
+			publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: marshalKMSPublicKey(t, key)})
+			_ = json.NewEncoder(w).Encode(map[string]string{"pem": string(publicKey), "algorithm": "EC_SIGN_SECP256K1_SHA256"})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/"+name+":asymmetricSign":
+			var request struct {
+				Digest struct {
+					Sha256 []byte `json:"sha256"`
+				} `json:"digest"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"signature": signLikeKMS(t, key, request.Digest.Sha256)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "token")
+	backend := &gcpKMS{name: name, endpoint: server.URL}
+	der, err := backend.getPublicKey()
+	if err != nil {
+		t.Fatalf("getPublicKey() error = %v", err)
+	}
+	publicKey, err := parseKMSPublicKey(der)
+	if err != nil {
+		t.Fatalf("parseKMSPublicKey() error = %v", err)
+	}
+	if crypto.PubkeyToAddress(*publicKey) != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("parseKMSPublicKey() returned another key")
+	}
+
+	data := []byte("razororacle")
+	digest := SignHash(data)
+	signature, err := signDigestWithKMS(backend, publicKey, digest)
+	if err != nil {
+		t.Fatalf("signDigestWithKMS() error = %v", err)
+	}
+	recoveredAddress, err := EcRecover(data, signature)
+	if err != nil || recoveredAddress != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Errorf("EcRecover() = %s, %v, want %s", recoveredAddress.Hex(), err, crypto.PubkeyToAddress(key.PublicKey).Hex())
+	}
+
+	otherKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signDigestWithKMS(backend, &otherKey.PublicKey, digest); err == nil {
+		t.Error("signDigestWithKMS() error = nil for a signature which doesn't match the public key")
+	}
+}
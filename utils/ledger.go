@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"razor/core/types"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	ledgerPrefix               = "ledger/"
+	stakeChangeSyncRangePrefix = "stakeChangeSyncRange/"
+)
+
+//This function returns the key of the ledger record, the records of an address are ordered by epoch
+func getLedgerKey(address string, epoch uint32, action string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%010d/%s", ledgerPrefix, strings.ToLower(address), epoch, action))
+}
+
+//This function saves the record of the action in the ledger of the address in the state store, a record of the same action in the epoch is replaced
+//Unlike the journal, the ledger keeps the values of the actions and their gas cost for accounting
+func (*UtilsStruct) SaveLedgerRecord(address string, record types.LedgerRecord) error {
+	jsonData, err := JsonInterface.Marshal(record)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	return withStateDB(func(db *leveldb.DB) error {
+		return db.Put(getLedgerKey(address, record.Epoch, record.Action), jsonData, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function reads the ledger records of the address from the given epoch on, sorted by epoch
+func (*UtilsStruct) ReadLedger(address string, fromEpoch uint32) ([]types.LedgerRecord, error) {
+	var records []types.LedgerRecord
+	err := withStateDB(func(db *leveldb.DB) error {
+		prefix := ledgerPrefix + strings.ToLower(address) + "/"
+		iterator := db.NewIterator(&util.Range{
+			Start: []byte(fmt.Sprintf("%s%010d/", prefix, fromEpoch)),
+			Limit: util.BytesPrefix([]byte(prefix)).Limit,
+		}, nil)
+		defer iterator.Release()
+		for iterator.Next() {
+			data, err := DecryptStateData(iterator.Value())
+			if err != nil {
+				return err
+			}
+			var record types.LedgerRecord
+			if err := JsonInterface.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return iterator.Error()
+	})
+	if err != nil {
+		log.Error("Error in reading ledger: ", err)
+		return nil, err
+	}
+	return records, nil
+}
+
+//This function saves the range of blocks whose stake changes of the address are recorded in the ledger
+func (*UtilsStruct) SaveStakeChangeSyncRange(address string, syncRange types.StakeChangeSyncRange) error {
+	jsonData, err := JsonInterface.Marshal(syncRange)
+	if err != nil {
+		return err
+	}
+	return withStateDB(func(db *leveldb.DB) error {
+		return db.Put([]byte(stakeChangeSyncRangePrefix+strings.ToLower(address)), jsonData, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function returns the range of blocks whose stake changes of the address are recorded in the ledger
+//The range is empty if no stake change is recorded yet
+func (*UtilsStruct) GetStakeChangeSyncRange(address string) (types.StakeChangeSyncRange, error) {
+	var syncRange types.StakeChangeSyncRange
+	err := withStateDB(func(db *leveldb.DB) error {
+		data, err := db.Get([]byte(stakeChangeSyncRangePrefix+strings.ToLower(address)), nil)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return JsonInterface.Unmarshal(data, &syncRange)
+	})
+	return syncRange, err
+}
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestLedger(t *testing.T) {
+	StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	utils := &UtilsStruct{}
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	records := []types.LedgerRecord{
+		{Epoch: 9, Action: "commit", TxnHash: "0x01", Status: "mined"},
+		{Epoch: 10, Action: "commit", Values: []string{"100"}, TxnHash: "0x02", Status: "mined"},
+		{Epoch: 10, Action: "reveal", Values: []string{"100"}, TxnHash: "0x03", Status: "failed"},
+		{Epoch: 10, Action: "commit", Values: []string{"200"}, TxnHash: "0x04", Status: "mined"},
+	}
+	for _, record := range records {
+		if err := utils.SaveLedgerRecord(address, record); err != nil {
+			t.Fatalf("SaveLedgerRecord() error = %v", err)
+		}
+	}
+	if err := utils.SaveLedgerRecord("0x000000000000000000000000000000000000bEEF", types.LedgerRecord{Epoch: 10, Action: "commit"}); err != nil {
+		t.Fatalf("SaveLedgerRecord() error = %v", err)
+	}
+
+	got, err := utils.ReadLedger("0x000000000000000000000000000000000000dead", 10)
+	if err != nil {
+		t.Fatalf("ReadLedger() error = %v", err)
+	}
+	want := []types.LedgerRecord{records[3], records[2]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReadLedger() got = %v, want %v", got, want)
+	}
+}
+
+func TestStakeChangeSyncRange(t *testing.T) {
+	StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	utils := &UtilsStruct{}
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	syncRange, err := utils.GetStakeChangeSyncRange(address)
+	if err != nil || syncRange != (types.StakeChangeSyncRange{}) {
+		t.Errorf("GetStakeChangeSyncRange() = %v, %v before anything is synced, want an empty range", syncRange, err)
+	}
+	want := types.StakeChangeSyncRange{FromBlock: 100, ToBlock: 200}
+	if err := utils.SaveStakeChangeSyncRange(address, want); err != nil {
+		t.Fatalf("SaveStakeChangeSyncRange() error = %v", err)
+	}
+	syncRange, err = utils.GetStakeChangeSyncRange("0x000000000000000000000000000000000000dead")
+	if err != nil || syncRange != want {
+		t.Errorf("GetStakeChangeSyncRange() = %v, %v, want %v", syncRange, err, want)
+	}
+}
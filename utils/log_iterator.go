@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//LogIterator streams the logs of a query in the order of the blocks
+//The logs are fetched lazily, core.MaxConcurrentLogQueries chunks of logsChunkSize blocks at a time, so that only the logs of these chunks are held in memory while scanning a long block range
+type LogIterator struct {
+	client    *ethclient.Client
+	query     ethereum.FilterQuery
+	fromBlock *big.Int
+	logs      []types.Log
+	current   types.Log
+	done      bool
+	err       error
+}
+
+//This function returns an iterator over the logs of the query, no log is fetched before Next is called
+func NewLogIterator(client *ethclient.Client, query ethereum.FilterQuery) *LogIterator {
+	return &LogIterator{
+		client:    client,
+		query:     query,
+		fromBlock: query.FromBlock,
+	}
+}
+
+//This function advances the iterator to the next log, it returns false when all the logs are iterated or the logs of a chunk couldn't be fetched
+func (it *LogIterator) Next() bool {
+	for len(it.logs) == 0 {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.logs, it.err = it.fetchNextChunks()
+	}
+	it.current = it.logs[0]
+	it.logs = it.logs[1:]
+	return true
+}
+
+//This function returns the log the iterator is at
+func (it *LogIterator) Log() types.Log {
+	return it.current
+}
+
+//This function returns the error which stopped the iteration
+func (it *LogIterator) Error() error {
+	return it.err
+}
+
+func (it *LogIterator) fetchNextChunks() ([]types.Log, error) {
+	// A query without a block range can't be split and is fetched at once
+	if it.query.FromBlock == nil || it.query.ToBlock == nil || it.query.FromBlock.Cmp(it.query.ToBlock) > 0 {
+		it.done = true
+		return UtilsInterface.FilterLogsWithRetry(it.client, it.query)
+	}
+	chunkSize := getLogsChunkSize()
+	var chunkQueries []ethereum.FilterQuery
+	for len(chunkQueries) < core.MaxConcurrentLogQueries && it.fromBlock.Cmp(it.query.ToBlock) <= 0 {
+		chunkQuery := getChunkQuery(it.query, it.fromBlock, chunkSize)
+		chunkQueries = append(chunkQueries, chunkQuery)
+		it.fromBlock = new(big.Int).Add(chunkQuery.ToBlock, big.NewInt(1))
+	}
+	if it.fromBlock.Cmp(it.query.ToBlock) > 0 {
+		it.done = true
+	}
+	return filterLogsOfChunks(it.client, chunkQueries)
+}
@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogIterator(t *testing.T) {
+	var client *ethclient.Client
+
+	type args struct {
+		query        ethereum.FilterQuery
+		failingChunk int64
+	}
+	tests := []struct {
+		name            string
+		args            args
+		wantBlocks      []uint64
+		wantQueriesSent int
+		wantErr         bool
+	}{
+		{
+			name: "Test 1: When the block range fits in a single chunk",
+			args: args{
+				query: ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1050)},
+			},
+			wantBlocks:      []uint64{1000},
+			wantQueriesSent: 1,
+			wantErr:         false,
+		},
+		{
+			name: "Test 2: When the logs of multiple windows of chunks are iterated in the order of the blocks",
+			args: args{
+				query: ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1650)},
+			},
+			wantBlocks:      []uint64{1000, 1100, 1200, 1300, 1400, 1500, 1600},
+			wantQueriesSent: 7,
+			wantErr:         false,
+		},
+		{
+			name: "Test 3: When the block range is not set",
+			args: args{
+				query: ethereum.FilterQuery{},
+			},
+			wantBlocks:      []uint64{0},
+			wantQueriesSent: 1,
+			wantErr:         false,
+		},
+		{
+			name: "Test 4: When there is an error in fetching logs of a chunk of the second window",
+			args: args{
+				query:        ethereum.FilterQuery{FromBlock: big.NewInt(1000), ToBlock: big.NewInt(1650)},
+				failingChunk: 1500,
+			},
+			wantBlocks:      []uint64{1000, 1100, 1200, 1300},
+			wantQueriesSent: 7,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			StartRazor(optionsPackageStruct)
+
+			utilsMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(func(client *ethclient.Client, query ethereum.FilterQuery) []types.Log {
+				if query.FromBlock == nil {
+					return []types.Log{{}}
+				}
+				return []types.Log{{BlockNumber: query.FromBlock.Uint64()}}
+			}, func(client *ethclient.Client, query ethereum.FilterQuery) error {
+				if query.FromBlock != nil && query.FromBlock.Int64() == tt.args.failingChunk {
+					return errors.New("logs error")
+				}
+				return nil
+			})
+
+			logIterator := NewLogIterator(client, tt.args.query)
+			utilsMock.AssertNumberOfCalls(t, "FilterLogsWithRetry", 0)
+
+			var gotBlocks []uint64
+			for logIterator.Next() {
+				gotBlocks = append(gotBlocks, logIterator.Log().BlockNumber)
+			}
+			if (logIterator.Error() != nil) != tt.wantErr {
+				t.Errorf("LogIterator error = %v, wantErr %v", logIterator.Error(), tt.wantErr)
+			}
+			if !reflect.DeepEqual(gotBlocks, tt.wantBlocks) {
+				t.Errorf("LogIterator got blocks = %v, want %v", gotBlocks, tt.wantBlocks)
+			}
+			utilsMock.AssertNumberOfCalls(t, "FilterLogsWithRetry", tt.wantQueriesSent)
+		})
+	}
+}
+
+func TestLogIteratorFetchesLazily(t *testing.T) {
+	var client *ethclient.Client
+
+	utilsMock := new(mocks.Utils)
+	optionsPackageStruct := OptionsPackageStruct{
+		UtilsInterface: utilsMock,
+	}
+	StartRazor(optionsPackageStruct)
+
+	utilsMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(func(client *ethclient.Client, query ethereum.FilterQuery) []types.Log {
+		return []types.Log{{BlockNumber: query.FromBlock.Uint64()}, {BlockNumber: query.ToBlock.Uint64()}}
+	}, nil)
+
+	// 10000 blocks are split in 100 chunks but only the first window of chunks is fetched for the first log
+	logIterator := NewLogIterator(client, ethereum.FilterQuery{FromBlock: big.NewInt(0), ToBlock: big.NewInt(9999)})
+	if !logIterator.Next() || logIterator.Log().BlockNumber != 0 {
+		t.Fatalf("LogIterator didn't return the first log")
+	}
+	utilsMock.AssertNumberOfCalls(t, "FilterLogsWithRetry", 4)
+
+	logs := 1
+	for logIterator.Next() {
+		logs++
+	}
+	if logs != 200 || logIterator.Error() != nil {
+		t.Errorf("LogIterator iterated %d logs with error %v, want 200 logs", logs, logIterator.Error())
+	}
+	utilsMock.AssertNumberOfCalls(t, "FilterLogsWithRetry", 100)
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//The maximum number of blocks whose logs are fetched in a single query, it is lowered to the block range the provider accepts when a query is rejected for its range
+var (
+	logsChunkSize      = int64(core.DefaultLogsChunkSize)
+	logsChunkSizeMutex sync.RWMutex
+)
+
+//The errors which the providers return when the block range or the number of results of a logs query is over their limits
+var logRangeLimitErrors = []string{
+	"block range",
+	"range is too large",
+	"range too large",
+	"too many blocks",
+	"query returned more than",
+	"more than 10000 results",
+	"response size exceeded",
+	"log response size",
+}
+
+//This function sets the maximum number of blocks whose logs are fetched in a single query
+func SetLogsChunkSize(size int32) {
+	logsChunkSizeMutex.Lock()
+	defer logsChunkSizeMutex.Unlock()
+	logsChunkSize = int64(size)
+}
+
+//This function returns the maximum number of blocks whose logs are fetched in a single query
+func getLogsChunkSize() int64 {
+	logsChunkSizeMutex.RLock()
+	defer logsChunkSizeMutex.RUnlock()
+	return logsChunkSize
+}
+
+//This function lowers the chunk size to the block range which the provider accepted after a larger range was rejected, so that the next chunks aren't rejected again
+func lowerLogsChunkSize(size int64) {
+	logsChunkSizeMutex.Lock()
+	defer logsChunkSizeMutex.Unlock()
+	if size < 1 || size >= logsChunkSize {
+		return
+	}
+	log.Warnf("Provider rejected the logs queries of %d blocks, the logs are fetched %d blocks at a time, set logsChunkSize to the block range limit of the provider", logsChunkSize, size)
+	logsChunkSize = size
+}
+
+//This function returns if the provider rejected the logs query as its block range or its results are over the limits of the provider
+func isLogRangeLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, limitError := range logRangeLimitErrors {
+		if strings.Contains(message, limitError) {
+			return true
+		}
+	}
+	return false
+}
+
+//This function fetches the logs of the chunk, if the provider rejects its block range the chunk is split in halves which are fetched one after the other
+//The halves are split again until the provider accepts their range or they are of a single block, the logs are returned in the order of the blocks
+func filterLogsOfChunk(client *ethclient.Client, query ethereum.FilterQuery) ([]types.Log, error) {
+	logs, err := UtilsInterface.FilterLogsWithRetry(client, query)
+	if !isLogRangeLimitError(err) || query.FromBlock == nil || query.ToBlock == nil || query.FromBlock.Cmp(query.ToBlock) >= 0 {
+		return logs, err
+	}
+	middle := new(big.Int).Add(query.FromBlock, query.ToBlock)
+	middle.Rsh(middle, 1)
+	log.Debugf("Logs of blocks %s to %s are over the limits of the provider, fetching them in two halves", query.FromBlock, query.ToBlock)
+
+	firstHalf := query
+	firstHalf.ToBlock = middle
+	secondHalf := query
+	secondHalf.FromBlock = new(big.Int).Add(middle, big.NewInt(1))
+
+	firstLogs, err := filterLogsOfChunk(client, firstHalf)
+	if err != nil {
+		return nil, err
+	}
+	secondLogs, err := filterLogsOfChunk(client, secondHalf)
+	if err != nil {
+		return nil, err
+	}
+	lowerLogsChunkSize(new(big.Int).Sub(middle, query.FromBlock).Int64() + 1)
+	return append(firstLogs, secondLogs...), nil
+}
@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/core"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFilterLogsInChunksWithRangeLimit(t *testing.T) {
+	var client *ethclient.Client
+	defer SetLogsChunkSize(core.DefaultLogsChunkSize)
+
+	tests := []struct {
+		name              string
+		logsChunkSize     int32
+		providerRange     int64
+		query             ethereum.FilterQuery
+		wantBlocks        []uint64
+		wantLogsChunkSize int64
+		wantErr           bool
+	}{
+		{
+			name:              "Test 1: When the provider accepts the block range of the chunks",
+			logsChunkSize:     1000,
+			providerRange:     1000,
+			query:             ethereum.FilterQuery{FromBlock: big.NewInt(0), ToBlock: big.NewInt(1999)},
+			wantBlocks:        []uint64{0, 1000},
+			wantLogsChunkSize: 1000,
+			wantErr:           false,
+		},
+		{
+			name:              "Test 2: When the provider caps the block range below the chunk size",
+			logsChunkSize:     1000,
+			providerRange:     300,
+			query:             ethereum.FilterQuery{FromBlock: big.NewInt(0), ToBlock: big.NewInt(999)},
+			wantBlocks:        []uint64{0, 250, 500, 750},
+			wantLogsChunkSize: 250,
+			wantErr:           false,
+		},
+		{
+			name:              "Test 3: When the provider rejects a single block",
+			logsChunkSize:     4,
+			providerRange:     0,
+			query:             ethereum.FilterQuery{FromBlock: big.NewInt(0), ToBlock: big.NewInt(3)},
+			wantBlocks:        nil,
+			wantLogsChunkSize: 4,
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetLogsChunkSize(tt.logsChunkSize)
+			utilsMock := new(mocks.Utils)
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface: utilsMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			utilsMock.On("FilterLogsWithRetry", mock.AnythingOfType("*ethclient.Client"), mock.AnythingOfType("ethereum.FilterQuery")).Return(func(client *ethclient.Client, query ethereum.FilterQuery) []types.Log {
+				if query.ToBlock.Int64()-query.FromBlock.Int64() >= tt.providerRange {
+					return nil
+				}
+				return []types.Log{{BlockNumber: query.FromBlock.Uint64()}}
+			}, func(client *ethclient.Client, query ethereum.FilterQuery) error {
+				if query.ToBlock.Int64()-query.FromBlock.Int64() >= tt.providerRange {
+					return errors.New("query exceeds max block range 300")
+				}
+				return nil
+			})
+
+			got, err := utils.FilterLogsInChunks(client, tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FilterLogsInChunks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var gotBlocks []uint64
+			for _, vLog := range got {
+				gotBlocks = append(gotBlocks, vLog.BlockNumber)
+			}
+			if !reflect.DeepEqual(gotBlocks, tt.wantBlocks) {
+				t.Errorf("FilterLogsInChunks() got blocks = %v, want %v", gotBlocks, tt.wantBlocks)
+			}
+			if got := getLogsChunkSize(); got != tt.wantLogsChunkSize {
+				t.Errorf("getLogsChunkSize() = %v, want %v", got, tt.wantLogsChunkSize)
+			}
+		})
+	}
+}
+
+func TestIsLogRangeLimitError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "Test 1: When there is no error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "Test 2: When the block range is over the limit",
+			err:  errors.New("eth_getLogs is limited to a 10,000 block range"),
+			want: true,
+		},
+		{
+			name: "Test 3: When the results are over the limit",
+			err:  errors.New("query returned more than 10000 results"),
+			want: true,
+		},
+		{
+			name: "Test 4: When the provider is down",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isLogRangeLimitError(tt.err); got != tt.want {
+				t.Errorf("isLogRangeLimitError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
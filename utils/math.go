@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	mathRand "math/rand"
+	"razor/aggregator"
 	"sort"
 	"strconv"
 	"time"
@@ -42,6 +43,14 @@ func MultiplyWithPower(num *big.Float, power int8) *big.Int {
 	return result
 }
 
+func DivideWithPower(num *big.Int, power int8) *big.Float {
+	if num == nil {
+		return big.NewFloat(0)
+	}
+	decimalDivisor := big.NewFloat(math.Pow(10, float64(power)))
+	return new(big.Float).Quo(new(big.Float).SetInt(num), decimalDivisor)
+}
+
 func (*UtilsStruct) MultiplyFloatAndBigInt(bigIntVal *big.Int, floatingVal float64) *big.Int {
 	if bigIntVal == nil || floatingVal == 0 {
 		return big.NewInt(0)
@@ -81,7 +90,7 @@ func GetAmountInDecimal(amountInWei *big.Int) *big.Float {
 	return new(big.Float).Quo(new(big.Float).SetInt(amountInWei), new(big.Float).SetInt(big.NewInt(1e18)))
 }
 
-func performAggregation(data []*big.Int, weight []uint8, aggregationMethod uint32) (*big.Int, error) {
+func PerformAggregation(data []*big.Int, weight []uint8, aggregationMethod uint32) (*big.Int, error) {
 	if len(data) == 0 {
 		return nil, errors.New("aggregation cannot be performed for nil data")
 	}
@@ -97,6 +106,34 @@ func performAggregation(data []*big.Int, weight []uint8, aggregationMethod uint3
 	}
 	return nil, errors.New("invalid aggregation method")
 }
+
+//This function aggregates the data with the registered aggregator of the name, the aggregation method of the collection is used if no aggregator is selected or it isn't registered
+func AggregateWithAggregator(aggregatorName string, data []*big.Int, weight []uint8, metadata aggregator.Metadata) (*big.Int, error) {
+	if aggregatorName == "" {
+		return PerformAggregation(data, weight, metadata.AggregationMethod)
+	}
+	customAggregator, ok := aggregator.Get(aggregatorName)
+	if !ok {
+		log.Errorf("Aggregator %s of collection %s is not registered, using the aggregation method of the collection", aggregatorName, metadata.CollectionName)
+		return PerformAggregation(data, weight, metadata.AggregationMethod)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("aggregation cannot be performed for nil data")
+	}
+	output, err := customAggregator.Aggregate(aggregator.Input{
+		Values:   data,
+		Weights:  weight,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if output.Value == nil {
+		return nil, errors.New("aggregator " + aggregatorName + " returned no value")
+	}
+	log.Debugf("Aggregator %s aggregated collection %s to %s with confidence %.2f", aggregatorName, metadata.CollectionName, output.Value, output.Confidence)
+	return output.Value, nil
+}
 func calculateWeightedMedian(data []*big.Int, weight []uint8, totalWeight uint) *big.Int {
 	if len(data) == 0 || len(weight) == 0 || totalWeight == 0 {
 		return nil
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"errors"
 	"math/big"
+	"razor/aggregator"
 	"razor/utils/mocks"
 	"reflect"
 	"testing"
@@ -400,6 +402,51 @@ func TestMultiplyWithPower(t *testing.T) {
 	}
 }
 
+func TestDivideWithPower(t *testing.T) {
+	type args struct {
+		num   *big.Int
+		power int8
+	}
+	tests := []struct {
+		name string
+		args args
+		want *big.Float
+	}{
+		{
+			name: "Test value when power is 8",
+			args: args{
+				num:   big.NewInt(122342000),
+				power: 8,
+			},
+			want: big.NewFloat(1.22342),
+		},
+		{
+			name: "Test value when power is negative",
+			args: args{
+				num:   big.NewInt(12),
+				power: -2,
+			},
+			want: big.NewFloat(1200),
+		},
+		{
+			name: "Test value when number is nil",
+			args: args{
+				num:   nil,
+				power: 10,
+			},
+			want: big.NewFloat(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DivideWithPower(tt.args.num, tt.args.power)
+			if got.Text('f', 8) != tt.want.Text('f', 8) {
+				t.Errorf("DivideWithPower() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConvertWeiToEth(t *testing.T) {
 	type args struct {
 		data *big.Int
@@ -449,7 +496,7 @@ func TestConvertWeiToEth(t *testing.T) {
 	}
 }
 
-func Test_performAggregation(t *testing.T) {
+func TestPerformAggregation(t *testing.T) {
 	type args struct {
 		data              []*big.Int
 		weight            []uint8
@@ -564,13 +611,128 @@ func Test_performAggregation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := performAggregation(tt.args.data, tt.args.weight, tt.args.aggregationMethod)
+			got, err := PerformAggregation(tt.args.data, tt.args.weight, tt.args.aggregationMethod)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PerformAggregation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PerformAggregation() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+//This function registers the aggregators which are selected in the tests, they are only registered once as the registry can't be cleared
+func registerTestAggregators() {
+	if _, ok := aggregator.Get("testMax"); !ok {
+		_ = aggregator.Register("testMax", aggregator.AggregatorFunc(func(input aggregator.Input) (aggregator.Output, error) {
+			max := input.Values[0]
+			for _, value := range input.Values {
+				if value.Cmp(max) > 0 {
+					max = value
+				}
+			}
+			return aggregator.Output{Value: max, Confidence: 1}, nil
+		}))
+	}
+	if _, ok := aggregator.Get("testFailing"); !ok {
+		_ = aggregator.Register("testFailing", aggregator.AggregatorFunc(func(input aggregator.Input) (aggregator.Output, error) {
+			return aggregator.Output{}, errors.New("sources disagree")
+		}))
+	}
+	if _, ok := aggregator.Get("testNoValue"); !ok {
+		_ = aggregator.Register("testNoValue", aggregator.AggregatorFunc(func(input aggregator.Input) (aggregator.Output, error) {
+			return aggregator.Output{Confidence: 0}, nil
+		}))
+	}
+}
+
+func TestAggregateWithAggregator(t *testing.T) {
+	registerTestAggregators()
+
+	type args struct {
+		aggregatorName    string
+		data              []*big.Int
+		weight            []uint8
+		aggregationMethod uint32
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When no aggregator is selected",
+			args: args{
+				data:              []*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(9)},
+				weight:            []uint8{1, 1, 1},
+				aggregationMethod: 2,
+			},
+			want:    big.NewInt(5),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When a registered aggregator is selected",
+			args: args{
+				aggregatorName:    "testMax",
+				data:              []*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(9)},
+				weight:            []uint8{1, 1, 1},
+				aggregationMethod: 2,
+			},
+			want:    big.NewInt(9),
+			wantErr: false,
+		},
+		{
+			name: "Test 3: When the selected aggregator is not registered",
+			args: args{
+				aggregatorName:    "missing",
+				data:              []*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(9)},
+				weight:            []uint8{1, 1, 1},
+				aggregationMethod: 1,
+			},
+			want:    big.NewInt(4),
+			wantErr: false,
+		},
+		{
+			name: "Test 4: When the aggregator returns an error",
+			args: args{
+				aggregatorName: "testFailing",
+				data:           []*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(9)},
+				weight:         []uint8{1, 1, 1},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When the aggregator returns no value",
+			args: args{
+				aggregatorName: "testNoValue",
+				data:           []*big.Int{big.NewInt(2), big.NewInt(4), big.NewInt(9)},
+				weight:         []uint8{1, 1, 1},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Test 6: When there is no data to aggregate",
+			args: args{
+				aggregatorName: "testMax",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AggregateWithAggregator(tt.args.aggregatorName, tt.args.data, tt.args.weight, aggregator.Metadata{AggregationMethod: tt.args.aggregationMethod})
 			if (err != nil) != tt.wantErr {
-				t.Errorf("performAggregation() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("AggregateWithAggregator() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("performAggregation() got = %v, want %v", got, tt.want)
+				t.Errorf("AggregateWithAggregator() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
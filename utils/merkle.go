@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"bytes"
 	solsha3 "github.com/miguelmota/go-solidity-sha3"
 	"math/big"
 )
@@ -61,8 +62,61 @@ func (*MerkleTreeStruct) GetProofPath(tree [][][]byte, assetId uint16) [][32]byt
 	return compactProofPath
 }
 
+//This function returns the proof paths of the leaves in one pass over the levels of the tree
+//The proof path of every leaf is the one returned by GetProofPath
+func (*MerkleTreeStruct) GetProofPaths(tree [][][]byte, leafIds []uint16) [][][32]byte {
+	proofPaths := make([][][32]byte, len(leafIds))
+	nodeIds := make([]uint16, len(leafIds))
+	copy(nodeIds, leafIds)
+	for currentLevel := len(tree) - 1; currentLevel > 0; currentLevel-- {
+		currentLevelNodes := tree[currentLevel]
+		currentLevelCount := len(currentLevelNodes)
+		for i, nodeId := range nodeIds {
+			nodeIds[i] = nodeId / 2
+			if int(nodeId) == currentLevelCount-1 && currentLevelCount%2 == 1 {
+				continue
+			}
+			var node [32]byte
+			if nodeId%2 == 1 {
+				copy(node[:], currentLevelNodes[nodeId-1])
+			} else {
+				copy(node[:], currentLevelNodes[nodeId+1])
+			}
+			proofPaths[i] = append(proofPaths[i], node)
+		}
+	}
+	return proofPaths
+}
+
 func (*MerkleTreeStruct) GetMerkleRoot(tree [][][]byte) [32]byte {
 	var root [32]byte
 	copy(root[:], tree[0][0])
 	return root
 }
+
+//This function verifies the proof path of a value against the merkle root of a tree with numLeaves leaves
+//The proof path is expected in the compact form returned by GetProofPath
+func (*MerkleTreeStruct) VerifyProof(proof [][32]byte, root [32]byte, value *big.Int, leafId uint16, numLeaves uint16) bool {
+	if value == nil || leafId >= numLeaves {
+		return false
+	}
+	node := solsha3.SoliditySHA3([]string{"uint256"}, []interface{}{value})
+	proofIndex := 0
+	for levelCount := int(numLeaves); levelCount > 1; levelCount = (levelCount + 1) / 2 {
+		// The last node of a level with odd number of nodes is moved to the next level as it is
+		if int(leafId) != levelCount-1 || levelCount%2 == 0 {
+			if proofIndex >= len(proof) {
+				return false
+			}
+			sibling := proof[proofIndex]
+			if leafId%2 == 1 {
+				node = solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{sibling[:], node})
+			} else {
+				node = solsha3.SoliditySHA3([]string{"bytes32", "bytes32"}, []interface{}{node, sibling[:]})
+			}
+			proofIndex++
+		}
+		leafId = leafId / 2
+	}
+	return proofIndex == len(proof) && bytes.Equal(node, root[:])
+}
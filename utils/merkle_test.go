@@ -153,3 +153,156 @@ func TestMerkleTreeStructGetProofPath(t *testing.T) {
 		})
 	}
 }
+
+func TestMerkleTreeStructGetProofPaths(t *testing.T) {
+	me := &MerkleTreeStruct{}
+	values := []*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(23), big.NewInt(0), big.NewInt(4567), big.NewInt(89), big.NewInt(0)}
+	tests := []struct {
+		name      string
+		numLeaves int
+		leafIds   []uint16
+	}{
+		{
+			name:      "Test 1: When tree contains a single leaf",
+			numLeaves: 1,
+			leafIds:   []uint16{0},
+		},
+		{
+			name:      "Test 2: When tree contains odd number of leaves",
+			numLeaves: 7,
+			leafIds:   []uint16{6, 0, 3, 4},
+		},
+		{
+			name:      "Test 3: When tree contains even number of leaves",
+			numLeaves: 6,
+			leafIds:   []uint16{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:      "Test 4: When no leaf ids are passed",
+			numLeaves: 7,
+			leafIds:   []uint16{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := me.CreateMerkle(values[:tt.numLeaves])
+			got := me.GetProofPaths(tree, tt.leafIds)
+			if len(got) != len(tt.leafIds) {
+				t.Fatalf("GetProofPaths() returned %d proof paths, want %d", len(got), len(tt.leafIds))
+			}
+			for i, leafId := range tt.leafIds {
+				if want := me.GetProofPath(tree, leafId); !reflect.DeepEqual(got[i], want) {
+					t.Errorf("GetProofPaths() proof path of leaf %d = %v, want %v", leafId, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestMerkleTreeStructVerifyProof(t *testing.T) {
+	me := &MerkleTreeStruct{}
+	values := []*big.Int{big.NewInt(1), big.NewInt(0), big.NewInt(23), big.NewInt(0), big.NewInt(4567), big.NewInt(89), big.NewInt(0)}
+
+	type args struct {
+		numLeaves int
+		leafId    uint16
+		value     *big.Int
+		tamper    bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Test 1: When tree contains a single leaf",
+			args: args{
+				numLeaves: 1,
+				leafId:    0,
+				value:     values[0],
+			},
+			want: true,
+		},
+		{
+			name: "Test 2: When leaf is the last node of a level with odd number of nodes",
+			args: args{
+				numLeaves: 7,
+				leafId:    6,
+				value:     values[6],
+			},
+			want: true,
+		},
+		{
+			name: "Test 3: When leaf has a sibling at every level",
+			args: args{
+				numLeaves: 7,
+				leafId:    2,
+				value:     values[2],
+			},
+			want: true,
+		},
+		{
+			name: "Test 4: When tree contains even number of leaves",
+			args: args{
+				numLeaves: 6,
+				leafId:    5,
+				value:     values[5],
+			},
+			want: true,
+		},
+		{
+			name: "Test 5: When value is different from the value in the tree",
+			args: args{
+				numLeaves: 7,
+				leafId:    4,
+				value:     big.NewInt(4568),
+			},
+			want: false,
+		},
+		{
+			name: "Test 6: When proof is tampered",
+			args: args{
+				numLeaves: 7,
+				leafId:    3,
+				value:     values[3],
+				tamper:    true,
+			},
+			want: false,
+		},
+		{
+			name: "Test 7: When leafId is greater than number of leaves",
+			args: args{
+				numLeaves: 5,
+				leafId:    5,
+				value:     values[5],
+			},
+			want: false,
+		},
+		{
+			name: "Test 8: When value is nil",
+			args: args{
+				numLeaves: 5,
+				leafId:    1,
+				value:     nil,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := me.CreateMerkle(values[:tt.args.numLeaves])
+			root := me.GetMerkleRoot(tree)
+			proofLeafId := tt.args.leafId
+			if int(proofLeafId) >= tt.args.numLeaves {
+				proofLeafId = 0
+			}
+			proof := me.GetProofPath(tree, proofLeafId)
+			if tt.args.tamper {
+				proof[0][0]++
+			}
+			if got := me.VerifyProof(proof, root, tt.args.value, tt.args.leafId, uint16(tt.args.numLeaves)); got != tt.want {
+				t.Errorf("VerifyProof() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
+
+	coretypes "razor/core/types"
 )
 
 // ClientUtils is an autogenerated mock type for the ClientUtils type
@@ -45,6 +47,75 @@ func (_m *ClientUtils) BalanceAt(client *ethclient.Client, ctx context.Context,
 	return r0, r1
 }
 
+// CallContract provides a mock function with given fields: client, ctx, msg, blockNumber
+func (_m *ClientUtils) CallContract(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ret := _m.Called(client, ctx, msg, blockNumber)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, ethereum.CallMsg, *big.Int) []byte); ok {
+		r0 = rf(client, ctx, msg, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context, ethereum.CallMsg, *big.Int) error); ok {
+		r1 = rf(client, ctx, msg, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainID provides a mock function with given fields: client, ctx
+func (_m *ClientUtils) ChainID(client *ethclient.Client, ctx context.Context) (*big.Int, error) {
+	ret := _m.Called(client, ctx)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context) *big.Int); ok {
+		r0 = rf(client, ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context) error); ok {
+		r1 = rf(client, ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CodeAt provides a mock function with given fields: client, ctx, account, blockNumber
+func (_m *ClientUtils) CodeAt(client *ethclient.Client, ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	ret := _m.Called(client, ctx, account, blockNumber)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, common.Address, *big.Int) []byte); ok {
+		r0 = rf(client, ctx, account, blockNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context, common.Address, *big.Int) error); ok {
+		r1 = rf(client, ctx, account, blockNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // EstimateGas provides a mock function with given fields: client, ctx, msg
 func (_m *ClientUtils) EstimateGas(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	ret := _m.Called(client, ctx, msg)
@@ -66,6 +137,29 @@ func (_m *ClientUtils) EstimateGas(client *ethclient.Client, ctx context.Context
 	return r0, r1
 }
 
+// FeeHistory provides a mock function with given fields: client, ctx, blockCount, percentiles
+func (_m *ClientUtils) FeeHistory(client *ethclient.Client, ctx context.Context, blockCount uint64, percentiles []float64) (*coretypes.FeeHistory, error) {
+	ret := _m.Called(client, ctx, blockCount, percentiles)
+
+	var r0 *coretypes.FeeHistory
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, uint64, []float64) *coretypes.FeeHistory); ok {
+		r0 = rf(client, ctx, blockCount, percentiles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.FeeHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context, uint64, []float64) error); ok {
+		r1 = rf(client, ctx, blockCount, percentiles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FilterLogs provides a mock function with given fields: client, ctx, q
 func (_m *ClientUtils) FilterLogs(client *ethclient.Client, ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
 	ret := _m.Called(client, ctx, q)
@@ -133,6 +227,43 @@ func (_m *ClientUtils) PendingNonceAt(client *ethclient.Client, ctx context.Cont
 	return r0, r1
 }
 
+// SendTransaction provides a mock function with given fields: client, ctx, txn
+func (_m *ClientUtils) SendTransaction(client *ethclient.Client, ctx context.Context, txn *types.Transaction) error {
+	ret := _m.Called(client, ctx, txn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, *types.Transaction) error); ok {
+		r0 = rf(client, ctx, txn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscribeNewHead provides a mock function with given fields: client, ctx, ch
+func (_m *ClientUtils) SubscribeNewHead(client *ethclient.Client, ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ret := _m.Called(client, ctx, ch)
+
+	var r0 ethereum.Subscription
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, chan<- *types.Header) ethereum.Subscription); ok {
+		r0 = rf(client, ctx, ch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ethereum.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context, chan<- *types.Header) error); ok {
+		r1 = rf(client, ctx, ch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SuggestGasPrice provides a mock function with given fields: client, ctx
 func (_m *ClientUtils) SuggestGasPrice(client *ethclient.Client, ctx context.Context) (*big.Int, error) {
 	ret := _m.Called(client, ctx)
@@ -156,6 +287,59 @@ func (_m *ClientUtils) SuggestGasPrice(client *ethclient.Client, ctx context.Con
 	return r0, r1
 }
 
+// SyncProgress provides a mock function with given fields: client, ctx
+func (_m *ClientUtils) SyncProgress(client *ethclient.Client, ctx context.Context) (*ethereum.SyncProgress, error) {
+	ret := _m.Called(client, ctx)
+
+	var r0 *ethereum.SyncProgress
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context) *ethereum.SyncProgress); ok {
+		r0 = rf(client, ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ethereum.SyncProgress)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context) error); ok {
+		r1 = rf(client, ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionByHash provides a mock function with given fields: client, ctx, txHash
+func (_m *ClientUtils) TransactionByHash(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	ret := _m.Called(client, ctx, txHash)
+
+	var r0 *types.Transaction
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, context.Context, common.Hash) *types.Transaction); ok {
+		r0 = rf(client, ctx, txHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, context.Context, common.Hash) bool); ok {
+		r1 = rf(client, ctx, txHash)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*ethclient.Client, context.Context, common.Hash) error); ok {
+		r2 = rf(client, ctx, txHash)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // TransactionReceipt provides a mock function with given fields: client, ctx, txHash
 func (_m *ClientUtils) TransactionReceipt(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
 	ret := _m.Called(client, ctx, txHash)
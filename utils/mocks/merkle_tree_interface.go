@@ -61,6 +61,36 @@ func (_m *MerkleTreeInterface) GetProofPath(tree [][][]byte, assetId uint16) [][
 	return r0
 }
 
+// GetProofPaths provides a mock function with given fields: tree, leafIds
+func (_m *MerkleTreeInterface) GetProofPaths(tree [][][]byte, leafIds []uint16) [][][32]byte {
+	ret := _m.Called(tree, leafIds)
+
+	var r0 [][][32]byte
+	if rf, ok := ret.Get(0).(func([][][]byte, []uint16) [][][32]byte); ok {
+		r0 = rf(tree, leafIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][][32]byte)
+		}
+	}
+
+	return r0
+}
+
+// VerifyProof provides a mock function with given fields: proof, root, value, leafId, numLeaves
+func (_m *MerkleTreeInterface) VerifyProof(proof [][32]byte, root [32]byte, value *big.Int, leafId uint16, numLeaves uint16) bool {
+	ret := _m.Called(proof, root, value, leafId, numLeaves)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func([][32]byte, [32]byte, *big.Int, uint16, uint16) bool); ok {
+		r0 = rf(proof, root, value, leafId, numLeaves)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewMerkleTreeInterface interface {
 	mock.TestingT
 	Cleanup(func())
@@ -169,6 +169,27 @@ func (_m *StakeManagerUtils) MinSafeRazor(client *ethclient.Client) (*big.Int, e
 	return r0, r1
 }
 
+// SlashNums provides a mock function with given fields: client
+func (_m *StakeManagerUtils) SlashNums(client *ethclient.Client) (types.SlashNums, error) {
+	ret := _m.Called(client)
+
+	var r0 types.SlashNums
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) types.SlashNums); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(types.SlashNums)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client) error); ok {
+		r1 = rf(client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WithdrawInitiationPeriod provides a mock function with given fields: client
 func (_m *StakeManagerUtils) WithdrawInitiationPeriod(client *ethclient.Client) (uint16, error) {
 	ret := _m.Called(client)
@@ -41,6 +41,29 @@ func (_m *StakedTokenUtils) BalanceOf(stakedToken *bindings.StakedToken, callOpt
 	return r0, r1
 }
 
+// TotalSupply provides a mock function with given fields: stakedToken, callOpts
+func (_m *StakedTokenUtils) TotalSupply(stakedToken *bindings.StakedToken, callOpts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(stakedToken, callOpts)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(*bindings.StakedToken, *bind.CallOpts) *big.Int); ok {
+		r0 = rf(stakedToken, callOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*bindings.StakedToken, *bind.CallOpts) error); ok {
+		r1 = rf(stakedToken, callOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewStakedTokenUtils interface {
 	mock.TestingT
 	Cleanup(func())
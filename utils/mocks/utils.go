@@ -21,6 +21,8 @@ import (
 	pflag "github.com/spf13/pflag"
 
 	types "razor/core/types"
+
+	time "time"
 )
 
 // Utils is an autogenerated mock type for the Utils type
@@ -306,6 +308,29 @@ func (_m *Utils) FetchPreviousValue(client *ethclient.Client, epoch uint32, asse
 	return r0, r1
 }
 
+// FilterLogsInChunks provides a mock function with given fields: client, query
+func (_m *Utils) FilterLogsInChunks(client *ethclient.Client, query ethereum.FilterQuery) ([]coretypes.Log, error) {
+	ret := _m.Called(client, query)
+
+	var r0 []coretypes.Log
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, ethereum.FilterQuery) []coretypes.Log); ok {
+		r0 = rf(client, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]coretypes.Log)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, ethereum.FilterQuery) error); ok {
+		r1 = rf(client, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FilterLogsWithRetry provides a mock function with given fields: client, query
 func (_m *Utils) FilterLogsWithRetry(client *ethclient.Client, query ethereum.FilterQuery) ([]coretypes.Log, error) {
 	ret := _m.Called(client, query)
@@ -329,6 +354,27 @@ func (_m *Utils) FilterLogsWithRetry(client *ethclient.Client, query ethereum.Fi
 	return r0, r1
 }
 
+// GetAPICacheData provides a mock function with given fields: url
+func (_m *Utils) GetAPICacheData(url string) (types.APICacheData, error) {
+	ret := _m.Called(url)
+
+	var r0 types.APICacheData
+	if rf, ok := ret.Get(0).(func(string) types.APICacheData); ok {
+		r0 = rf(url)
+	} else {
+		r0 = ret.Get(0).(types.APICacheData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(url)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetActiveCollection provides a mock function with given fields: client, collectionId
 func (_m *Utils) GetActiveCollection(client *ethclient.Client, collectionId uint16) (bindings.StructsCollection, error) {
 	ret := _m.Called(client, collectionId)
@@ -472,6 +518,20 @@ func (_m *Utils) GetAssignedCollections(client *ethclient.Client, numActiveColle
 	return r0, r1, r2
 }
 
+// GetAverageBlockTime provides a mock function with given fields: client
+func (_m *Utils) GetAverageBlockTime(client *ethclient.Client) time.Duration {
+	ret := _m.Called(client)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) time.Duration); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // GetBlock provides a mock function with given fields: client, epoch
 func (_m *Utils) GetBlock(client *ethclient.Client, epoch uint32) (bindings.StructsBlock, error) {
 	ret := _m.Called(client, epoch)
@@ -678,13 +738,13 @@ func (_m *Utils) GetCommitments(client *ethclient.Client, address string) ([32]b
 	return r0, r1
 }
 
-// GetDataFromAPI provides a mock function with given fields: url
-func (_m *Utils) GetDataFromAPI(url string) ([]byte, error) {
-	ret := _m.Called(url)
+// GetDataFromAPI provides a mock function with given fields: url, headers
+func (_m *Utils) GetDataFromAPI(url string, headers map[string]string) ([]byte, error) {
+	ret := _m.Called(url, headers)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(string) []byte); ok {
-		r0 = rf(url)
+	if rf, ok := ret.Get(0).(func(string, map[string]string) []byte); ok {
+		r0 = rf(url, headers)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -692,8 +752,31 @@ func (_m *Utils) GetDataFromAPI(url string) ([]byte, error) {
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(url)
+	if rf, ok := ret.Get(1).(func(string, map[string]string) error); ok {
+		r1 = rf(url, headers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataFromGraphQL provides a mock function with given fields: url, query, headers
+func (_m *Utils) GetDataFromGraphQL(url string, query types.GraphQLQuery, headers map[string]string) ([]byte, error) {
+	ret := _m.Called(url, query, headers)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string, types.GraphQLQuery, map[string]string) []byte); ok {
+		r0 = rf(url, query, headers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, types.GraphQLQuery, map[string]string) error); ok {
+		r1 = rf(url, query, headers)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -702,11 +785,11 @@ func (_m *Utils) GetDataFromAPI(url string) ([]byte, error) {
 }
 
 // GetDataFromJSON provides a mock function with given fields: jsonObject, selector
-func (_m *Utils) GetDataFromJSON(jsonObject map[string]interface{}, selector string) (interface{}, error) {
+func (_m *Utils) GetDataFromJSON(jsonObject interface{}, selector string) (interface{}, error) {
 	ret := _m.Called(jsonObject, selector)
 
 	var r0 interface{}
-	if rf, ok := ret.Get(0).(func(map[string]interface{}, string) interface{}); ok {
+	if rf, ok := ret.Get(0).(func(interface{}, string) interface{}); ok {
 		r0 = rf(jsonObject, selector)
 	} else {
 		if ret.Get(0) != nil {
@@ -715,7 +798,7 @@ func (_m *Utils) GetDataFromJSON(jsonObject map[string]interface{}, selector str
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(map[string]interface{}, string) error); ok {
+	if rf, ok := ret.Get(1).(func(interface{}, string) error); ok {
 		r1 = rf(jsonObject, selector)
 	} else {
 		r1 = ret.Error(1)
@@ -724,20 +807,43 @@ func (_m *Utils) GetDataFromJSON(jsonObject map[string]interface{}, selector str
 	return r0, r1
 }
 
-// GetDataFromXHTML provides a mock function with given fields: url, selector
-func (_m *Utils) GetDataFromXHTML(url string, selector string) (string, error) {
-	ret := _m.Called(url, selector)
+// GetDataFromXHTML provides a mock function with given fields: url, selector, headers
+func (_m *Utils) GetDataFromXHTML(url string, selector string, headers map[string]string) (string, error) {
+	ret := _m.Called(url, selector, headers)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = rf(url, selector)
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string) string); ok {
+		r0 = rf(url, selector, headers)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(url, selector)
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string) error); ok {
+		r1 = rf(url, selector, headers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDataOfOverride provides a mock function with given fields: collection, override
+func (_m *Utils) GetDataOfOverride(collection bindings.StructsCollection, override types.DataOverride) (*big.Int, error) {
+	ret := _m.Called(collection, override)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(bindings.StructsCollection, types.DataOverride) *big.Int); ok {
+		r0 = rf(collection, override)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(bindings.StructsCollection, types.DataOverride) error); ok {
+		r1 = rf(collection, override)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -821,6 +927,29 @@ func (_m *Utils) GetDelayedState(client *ethclient.Client, buffer int32) (int64,
 	return r0, r1
 }
 
+// GetDelegatorSRZRBalance provides a mock function with given fields: client, address, staker
+func (_m *Utils) GetDelegatorSRZRBalance(client *ethclient.Client, address string, staker bindings.StructsStaker) (*big.Int, error) {
+	ret := _m.Called(client, address, staker)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, bindings.StructsStaker) *big.Int); ok {
+		r0 = rf(client, address, staker)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string, bindings.StructsStaker) error); ok {
+		r1 = rf(client, address, staker)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEpoch provides a mock function with given fields: client
 func (_m *Utils) GetEpoch(client *ethclient.Client) (uint32, error) {
 	ret := _m.Called(client)
@@ -1302,6 +1431,29 @@ func (_m *Utils) GetRemainingTimeOfCurrentState(client *ethclient.Client, buffer
 	return r0, r1
 }
 
+// GetSRZRTotalSupply provides a mock function with given fields: client, staker
+func (_m *Utils) GetSRZRTotalSupply(client *ethclient.Client, staker bindings.StructsStaker) (*big.Int, error) {
+	ret := _m.Called(client, staker)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, bindings.StructsStaker) *big.Int); ok {
+		r0 = rf(client, staker)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, bindings.StructsStaker) error); ok {
+		r1 = rf(client, staker)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSaltFromBlockchain provides a mock function with given fields: client
 func (_m *Utils) GetSaltFromBlockchain(client *ethclient.Client) ([32]byte, error) {
 	ret := _m.Called(client)
@@ -1325,6 +1477,27 @@ func (_m *Utils) GetSaltFromBlockchain(client *ethclient.Client) ([32]byte, erro
 	return r0, r1
 }
 
+// GetSlashNums provides a mock function with given fields: client
+func (_m *Utils) GetSlashNums(client *ethclient.Client) (types.SlashNums, error) {
+	ret := _m.Called(client)
+
+	var r0 types.SlashNums
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) types.SlashNums); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(types.SlashNums)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client) error); ok {
+		r1 = rf(client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSortedProposedBlockId provides a mock function with given fields: client, epoch, index
 func (_m *Utils) GetSortedProposedBlockId(client *ethclient.Client, epoch uint32, index *big.Int) (uint32, error) {
 	ret := _m.Called(client, epoch, index)
@@ -1392,6 +1565,27 @@ func (_m *Utils) GetStake(client *ethclient.Client, stakerId uint32) (*big.Int,
 	return r0, r1
 }
 
+// GetStakeChangeSyncRange provides a mock function with given fields: address
+func (_m *Utils) GetStakeChangeSyncRange(address string) (types.StakeChangeSyncRange, error) {
+	ret := _m.Called(address)
+
+	var r0 types.StakeChangeSyncRange
+	if rf, ok := ret.Get(0).(func(string) types.StakeChangeSyncRange); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(types.StakeChangeSyncRange)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStakeManager provides a mock function with given fields: client
 func (_m *Utils) GetStakeManager(client *ethclient.Client) *bindings.StakeManager {
 	ret := _m.Called(client)
@@ -1609,6 +1803,20 @@ func (_m *Utils) GetTotalInfluenceRevealed(client *ethclient.Client, epoch uint3
 	return r0, r1
 }
 
+// GetTransactionRevertReason provides a mock function with given fields: client, _txHash
+func (_m *Utils) GetTransactionRevertReason(client *ethclient.Client, _txHash string) string {
+	ret := _m.Called(client, _txHash)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) string); ok {
+		r0 = rf(client, _txHash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GetTxnOpts provides a mock function with given fields: transactionData
 func (_m *Utils) GetTxnOpts(transactionData types.TransactionOptions) *bind.TransactOpts {
 	ret := _m.Called(transactionData)
@@ -1789,6 +1997,41 @@ func (_m *Utils) IsFlagPassed(name string) bool {
 	return r0
 }
 
+// IsJobQuarantined provides a mock function with given fields: jobId
+func (_m *Utils) IsJobQuarantined(jobId uint16) bool {
+	ret := _m.Called(jobId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uint16) bool); ok {
+		r0 = rf(jobId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MeasureAverageBlockTime provides a mock function with given fields: client
+func (_m *Utils) MeasureAverageBlockTime(client *ethclient.Client) (time.Duration, error) {
+	ret := _m.Called(client)
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(*ethclient.Client) time.Duration); ok {
+		r0 = rf(client)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client) error); ok {
+		r1 = rf(client)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MultiplyFloatAndBigInt provides a mock function with given fields: bigIntVal, floatingVal
 func (_m *Utils) MultiplyFloatAndBigInt(bigIntVal *big.Int, floatingVal float64) *big.Int {
 	ret := _m.Called(bigIntVal, floatingVal)
@@ -1821,20 +2064,22 @@ func (_m *Utils) Prng(max uint32, prngHashes []byte) *big.Int {
 	return r0
 }
 
-// ReadFromCommitJsonFile provides a mock function with given fields: filePath
-func (_m *Utils) ReadFromCommitJsonFile(filePath string) (types.CommitFileData, error) {
-	ret := _m.Called(filePath)
+// ReadAccountAliases provides a mock function with given fields: fileName
+func (_m *Utils) ReadAccountAliases(fileName string) (map[string]string, error) {
+	ret := _m.Called(fileName)
 
-	var r0 types.CommitFileData
-	if rf, ok := ret.Get(0).(func(string) types.CommitFileData); ok {
-		r0 = rf(filePath)
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(fileName)
 	} else {
-		r0 = ret.Get(0).(types.CommitFileData)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(filePath)
+		r1 = rf(fileName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1842,15 +2087,40 @@ func (_m *Utils) ReadFromCommitJsonFile(filePath string) (types.CommitFileData,
 	return r0, r1
 }
 
-// ReadFromDisputeJsonFile provides a mock function with given fields: filePath
-func (_m *Utils) ReadFromDisputeJsonFile(filePath string) (types.DisputeFileData, error) {
+// ReadArchivedResponseBody provides a mock function with given fields: address, bodyHash
+func (_m *Utils) ReadArchivedResponseBody(address string, bodyHash string) ([]byte, error) {
+	ret := _m.Called(address, bodyHash)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(string, string) []byte); ok {
+		r0 = rf(address, bodyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(address, bodyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadAttestations provides a mock function with given fields: filePath
+func (_m *Utils) ReadAttestations(filePath string) ([]types.Attestation, error) {
 	ret := _m.Called(filePath)
 
-	var r0 types.DisputeFileData
-	if rf, ok := ret.Get(0).(func(string) types.DisputeFileData); ok {
+	var r0 []types.Attestation
+	if rf, ok := ret.Get(0).(func(string) []types.Attestation); ok {
 		r0 = rf(filePath)
 	} else {
-		r0 = ret.Get(0).(types.DisputeFileData)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Attestation)
+		}
 	}
 
 	var r1 error
@@ -1863,15 +2133,15 @@ func (_m *Utils) ReadFromDisputeJsonFile(filePath string) (types.DisputeFileData
 	return r0, r1
 }
 
-// ReadFromProposeJsonFile provides a mock function with given fields: filePath
-func (_m *Utils) ReadFromProposeJsonFile(filePath string) (types.ProposeFileData, error) {
+// ReadDataOverrideFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadDataOverrideFile(filePath string) (types.DataOverrideFile, error) {
 	ret := _m.Called(filePath)
 
-	var r0 types.ProposeFileData
-	if rf, ok := ret.Get(0).(func(string) types.ProposeFileData); ok {
+	var r0 types.DataOverrideFile
+	if rf, ok := ret.Get(0).(func(string) types.DataOverrideFile); ok {
 		r0 = rf(filePath)
 	} else {
-		r0 = ret.Get(0).(types.ProposeFileData)
+		r0 = ret.Get(0).(types.DataOverrideFile)
 	}
 
 	var r1 error
@@ -1884,22 +2154,20 @@ func (_m *Utils) ReadFromProposeJsonFile(filePath string) (types.ProposeFileData
 	return r0, r1
 }
 
-// ReadJSONData provides a mock function with given fields: fileName
-func (_m *Utils) ReadJSONData(fileName string) (map[string]*types.StructsJob, error) {
-	ret := _m.Called(fileName)
+// ReadFromCollectionHistoryFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromCollectionHistoryFile(filePath string) (types.CollectionHistoryFileData, error) {
+	ret := _m.Called(filePath)
 
-	var r0 map[string]*types.StructsJob
-	if rf, ok := ret.Get(0).(func(string) map[string]*types.StructsJob); ok {
-		r0 = rf(fileName)
+	var r0 types.CollectionHistoryFileData
+	if rf, ok := ret.Get(0).(func(string) types.CollectionHistoryFileData); ok {
+		r0 = rf(filePath)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]*types.StructsJob)
-		}
+		r0 = ret.Get(0).(types.CollectionHistoryFileData)
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(fileName)
+		r1 = rf(filePath)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1907,23 +2175,369 @@ func (_m *Utils) ReadJSONData(fileName string) (map[string]*types.StructsJob, er
 	return r0, r1
 }
 
-// SaveDataToCommitJsonFile provides a mock function with given fields: filePath, epoch, commitData
-func (_m *Utils) SaveDataToCommitJsonFile(filePath string, epoch uint32, commitData types.CommitData) error {
-	ret := _m.Called(filePath, epoch, commitData)
+// ReadFromCommissionScheduleFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromCommissionScheduleFile(filePath string) (types.CommissionSchedule, error) {
+	ret := _m.Called(filePath)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, uint32, types.CommitData) error); ok {
-		r0 = rf(filePath, epoch, commitData)
+	var r0 types.CommissionSchedule
+	if rf, ok := ret.Get(0).(func(string) types.CommissionSchedule); ok {
+		r0 = rf(filePath)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(types.CommissionSchedule)
 	}
 
-	return r0
-}
-
-// SaveDataToDisputeJsonFile provides a mock function with given fields: filePath, bountyIdQueue
-func (_m *Utils) SaveDataToDisputeJsonFile(filePath string, bountyIdQueue []uint32) error {
-	ret := _m.Called(filePath, bountyIdQueue)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromCommitJsonFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromCommitJsonFile(filePath string) (types.CommitFileData, error) {
+	ret := _m.Called(filePath)
+
+	var r0 types.CommitFileData
+	if rf, ok := ret.Get(0).(func(string) types.CommitFileData); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(types.CommitFileData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromDelegationMigrationFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromDelegationMigrationFile(filePath string) (types.DelegationMigrationData, error) {
+	ret := _m.Called(filePath)
+
+	var r0 types.DelegationMigrationData
+	if rf, ok := ret.Get(0).(func(string) types.DelegationMigrationData); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(types.DelegationMigrationData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromDisputeJsonFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromDisputeJsonFile(filePath string) (types.DisputeFileData, error) {
+	ret := _m.Called(filePath)
+
+	var r0 types.DisputeFileData
+	if rf, ok := ret.Get(0).(func(string) types.DisputeFileData); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(types.DisputeFileData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromGiveSortedProgressFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromGiveSortedProgressFile(filePath string) (types.GiveSortedProgress, error) {
+	ret := _m.Called(filePath)
+
+	var r0 types.GiveSortedProgress
+	if rf, ok := ret.Get(0).(func(string) types.GiveSortedProgress); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(types.GiveSortedProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromProposeJsonFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromProposeJsonFile(filePath string) (types.ProposeFileData, error) {
+	ret := _m.Called(filePath)
+
+	var r0 types.ProposeFileData
+	if rf, ok := ret.Get(0).(func(string) types.ProposeFileData); ok {
+		r0 = rf(filePath)
+	} else {
+		r0 = ret.Get(0).(types.ProposeFileData)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadFromWithdrawQueueFile provides a mock function with given fields: filePath
+func (_m *Utils) ReadFromWithdrawQueueFile(filePath string) ([]types.WithdrawQueueEntry, error) {
+	ret := _m.Called(filePath)
+
+	var r0 []types.WithdrawQueueEntry
+	if rf, ok := ret.Get(0).(func(string) []types.WithdrawQueueEntry); ok {
+		r0 = rf(filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.WithdrawQueueEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadJSONData provides a mock function with given fields: fileName
+func (_m *Utils) ReadJSONData(fileName string) (map[string]*types.StructsJob, error) {
+	ret := _m.Called(fileName)
+
+	var r0 map[string]*types.StructsJob
+	if rf, ok := ret.Get(0).(func(string) map[string]*types.StructsJob); ok {
+		r0 = rf(fileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*types.StructsJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(fileName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadJournal provides a mock function with given fields: filePath
+func (_m *Utils) ReadJournal(filePath string) ([]types.JournalEntry, error) {
+	ret := _m.Called(filePath)
+
+	var r0 []types.JournalEntry
+	if rf, ok := ret.Get(0).(func(string) []types.JournalEntry); ok {
+		r0 = rf(filePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.JournalEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(filePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadLedger provides a mock function with given fields: address, fromEpoch
+func (_m *Utils) ReadLedger(address string, fromEpoch uint32) ([]types.LedgerRecord, error) {
+	ret := _m.Called(address, fromEpoch)
+
+	var r0 []types.LedgerRecord
+	if rf, ok := ret.Get(0).(func(string, uint32) []types.LedgerRecord); ok {
+		r0 = rf(address, fromEpoch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.LedgerRecord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(address, fromEpoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadResponseArchive provides a mock function with given fields: address, epoch
+func (_m *Utils) ReadResponseArchive(address string, epoch uint32) (types.ResponseArchiveEntry, error) {
+	ret := _m.Called(address, epoch)
+
+	var r0 types.ResponseArchiveEntry
+	if rf, ok := ret.Get(0).(func(string, uint32) types.ResponseArchiveEntry); ok {
+		r0 = rf(address, epoch)
+	} else {
+		r0 = ret.Get(0).(types.ResponseArchiveEntry)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(address, epoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadTransactionReceipts provides a mock function with given fields: address, fromEpoch
+func (_m *Utils) ReadTransactionReceipts(address string, fromEpoch uint32) ([]types.TransactionReceiptRecord, error) {
+	ret := _m.Called(address, fromEpoch)
+
+	var r0 []types.TransactionReceiptRecord
+	if rf, ok := ret.Get(0).(func(string, uint32) []types.TransactionReceiptRecord); ok {
+		r0 = rf(address, fromEpoch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TransactionReceiptRecord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, uint32) error); ok {
+		r1 = rf(address, fromEpoch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveAPICacheData provides a mock function with given fields: url, cachedData
+func (_m *Utils) SaveAPICacheData(url string, cachedData types.APICacheData) error {
+	ret := _m.Called(url, cachedData)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.APICacheData) error); ok {
+		r0 = rf(url, cachedData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveAccountAliases provides a mock function with given fields: fileName, aliases
+func (_m *Utils) SaveAccountAliases(fileName string, aliases map[string]string) error {
+	ret := _m.Called(fileName, aliases)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]string) error); ok {
+		r0 = rf(fileName, aliases)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveAttestation provides a mock function with given fields: filePath, attestation
+func (_m *Utils) SaveAttestation(filePath string, attestation types.Attestation) error {
+	ret := _m.Called(filePath, attestation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.Attestation) error); ok {
+		r0 = rf(filePath, attestation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveDataToCollectionHistoryFile provides a mock function with given fields: filePath, collectionId, historyData
+func (_m *Utils) SaveDataToCollectionHistoryFile(filePath string, collectionId uint16, historyData types.CollectionHistoryData) error {
+	ret := _m.Called(filePath, collectionId, historyData)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint16, types.CollectionHistoryData) error); ok {
+		r0 = rf(filePath, collectionId, historyData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveDataToCommissionScheduleFile provides a mock function with given fields: filePath, data
+func (_m *Utils) SaveDataToCommissionScheduleFile(filePath string, data types.CommissionSchedule) error {
+	ret := _m.Called(filePath, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.CommissionSchedule) error); ok {
+		r0 = rf(filePath, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveDataToCommitJsonFile provides a mock function with given fields: filePath, epoch, commitData
+func (_m *Utils) SaveDataToCommitJsonFile(filePath string, epoch uint32, commitData types.CommitData) error {
+	ret := _m.Called(filePath, epoch, commitData)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint32, types.CommitData) error); ok {
+		r0 = rf(filePath, epoch, commitData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveDataToDelegationMigrationFile provides a mock function with given fields: filePath, data
+func (_m *Utils) SaveDataToDelegationMigrationFile(filePath string, data types.DelegationMigrationData) error {
+	ret := _m.Called(filePath, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.DelegationMigrationData) error); ok {
+		r0 = rf(filePath, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveDataToDisputeJsonFile provides a mock function with given fields: filePath, bountyIdQueue
+func (_m *Utils) SaveDataToDisputeJsonFile(filePath string, bountyIdQueue []uint32) error {
+	ret := _m.Called(filePath, bountyIdQueue)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, []uint32) error); ok {
@@ -1935,6 +2549,20 @@ func (_m *Utils) SaveDataToDisputeJsonFile(filePath string, bountyIdQueue []uint
 	return r0
 }
 
+// SaveDataToGiveSortedProgressFile provides a mock function with given fields: filePath, progress
+func (_m *Utils) SaveDataToGiveSortedProgressFile(filePath string, progress types.GiveSortedProgress) error {
+	ret := _m.Called(filePath, progress)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.GiveSortedProgress) error); ok {
+		r0 = rf(filePath, progress)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveDataToProposeJsonFile provides a mock function with given fields: filePath, epoch, proposeData
 func (_m *Utils) SaveDataToProposeJsonFile(filePath string, epoch uint32, proposeData types.ProposeData) error {
 	ret := _m.Called(filePath, epoch, proposeData)
@@ -1949,6 +2577,90 @@ func (_m *Utils) SaveDataToProposeJsonFile(filePath string, epoch uint32, propos
 	return r0
 }
 
+// SaveDataToWithdrawQueueFile provides a mock function with given fields: filePath, queue
+func (_m *Utils) SaveDataToWithdrawQueueFile(filePath string, queue []types.WithdrawQueueEntry) error {
+	ret := _m.Called(filePath, queue)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []types.WithdrawQueueEntry) error); ok {
+		r0 = rf(filePath, queue)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveJournalAction provides a mock function with given fields: filePath, epoch, action
+func (_m *Utils) SaveJournalAction(filePath string, epoch uint32, action types.JournalAction) error {
+	ret := _m.Called(filePath, epoch, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint32, types.JournalAction) error); ok {
+		r0 = rf(filePath, epoch, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveLedgerRecord provides a mock function with given fields: address, record
+func (_m *Utils) SaveLedgerRecord(address string, record types.LedgerRecord) error {
+	ret := _m.Called(address, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.LedgerRecord) error); ok {
+		r0 = rf(address, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveResponseArchive provides a mock function with given fields: address, epoch, collections
+func (_m *Utils) SaveResponseArchive(address string, epoch uint32, collections []types.AttestedCollection) error {
+	ret := _m.Called(address, epoch, collections)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint32, []types.AttestedCollection) error); ok {
+		r0 = rf(address, epoch, collections)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveStakeChangeSyncRange provides a mock function with given fields: address, syncRange
+func (_m *Utils) SaveStakeChangeSyncRange(address string, syncRange types.StakeChangeSyncRange) error {
+	ret := _m.Called(address, syncRange)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, types.StakeChangeSyncRange) error); ok {
+		r0 = rf(address, syncRange)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveTransactionReceipt provides a mock function with given fields: client, _txHash
+func (_m *Utils) SaveTransactionReceipt(client *ethclient.Client, _txHash string) error {
+	ret := _m.Called(client, _txHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) error); ok {
+		r0 = rf(client, _txHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SecondsToReadableTime provides a mock function with given fields: input
 func (_m *Utils) SecondsToReadableTime(input int) string {
 	ret := _m.Called(input)
@@ -1979,6 +2691,27 @@ func (_m *Utils) Shuffle(slice []uint32) []uint32 {
 	return r0
 }
 
+// SpeedUpTransaction provides a mock function with given fields: client, hash
+func (_m *Utils) SpeedUpTransaction(client *ethclient.Client, hash string) (string, error) {
+	ret := _m.Called(client, hash)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string) string); ok {
+		r0 = rf(client, hash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ethclient.Client, string) error); ok {
+		r1 = rf(client, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SuggestGasPriceWithRetry provides a mock function with given fields: client
 func (_m *Utils) SuggestGasPriceWithRetry(client *ethclient.Client) (*big.Int, error) {
 	ret := _m.Called(client)
@@ -2023,6 +2756,11 @@ func (_m *Utils) ToAssign(client *ethclient.Client) (uint16, error) {
 	return r0, r1
 }
 
+// UpdateJobCircuitBreaker provides a mock function with given fields: job, success
+func (_m *Utils) UpdateJobCircuitBreaker(job bindings.StructsJob, success bool) {
+	_m.Called(job, success)
+}
+
 // WaitForBlockCompletion provides a mock function with given fields: client, hashToRead
 func (_m *Utils) WaitForBlockCompletion(client *ethclient.Client, hashToRead string) error {
 	ret := _m.Called(client, hashToRead)
@@ -2037,6 +2775,20 @@ func (_m *Utils) WaitForBlockCompletion(client *ethclient.Client, hashToRead str
 	return r0
 }
 
+// WaitForBlockCompletionWithTimeout provides a mock function with given fields: client, hashToRead, timeout
+func (_m *Utils) WaitForBlockCompletionWithTimeout(client *ethclient.Client, hashToRead string, timeout time.Duration) error {
+	ret := _m.Called(client, hashToRead, timeout)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*ethclient.Client, string, time.Duration) error); ok {
+		r0 = rf(client, hashToRead, timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitTillNextNSecs provides a mock function with given fields: waitTime
 func (_m *Utils) WaitTillNextNSecs(waitTime int32) {
 	_m.Called(waitTime)
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"razor/core"
+	"razor/core/types"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var contractNetwork = core.MainnetNetwork
+
+//This function selects the network whose contracts the node calls, the contract addresses and the chain id of the testnet and of a custom network are read from the addresses file
+//The addresses file has the format of the addresses.json of the contracts with the ChainId of the deployment added to it
+func SetNetwork(network string, addressesFile string) error {
+	if !Contains(core.Networks, network) {
+		return fmt.Errorf("invalid network %s, valid networks are %s", network, strings.Join(core.Networks, ", "))
+	}
+	if network == core.MainnetNetwork {
+		if addressesFile != "" {
+			return errors.New("the addresses file can only be used with the testnet and custom networks, the addresses of the mainnet are compiled in")
+		}
+		contractNetwork = network
+		return nil
+	}
+	if addressesFile == "" {
+		return fmt.Errorf("the addresses file is needed for the %s network as its contract addresses are not compiled in", network)
+	}
+	addresses, err := readContractAddresses(addressesFile)
+	if err != nil {
+		return err
+	}
+	core.StakeManagerAddress = addresses.StakeManager
+	core.RAZORAddress = addresses.RAZOR
+	core.CollectionManagerAddress = addresses.CollectionManager
+	core.VoteManagerAddress = addresses.VoteManager
+	core.BlockManagerAddress = addresses.BlockManager
+	core.ChainId = big.NewInt(addresses.ChainId)
+	contractNetwork = network
+	log.Infof("Using the contracts of the %s network on the chain %d from %s", network, addresses.ChainId, addressesFile)
+	return nil
+}
+
+//This function returns the network whose contracts the node calls
+func GetNetwork() string {
+	return contractNetwork
+}
+
+//This function reads the contract addresses and the chain id from the addresses file and checks that they are all set
+func readContractAddresses(filePath string) (types.ContractAddresses, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return types.ContractAddresses{}, err
+	}
+	var addresses types.ContractAddresses
+	err = JsonInterface.Unmarshal(data, &addresses)
+	if err != nil {
+		return types.ContractAddresses{}, err
+	}
+	if addresses.ChainId <= 0 {
+		return types.ContractAddresses{}, fmt.Errorf("the ChainId of the addresses file %s is not set", filePath)
+	}
+	for _, contract := range getContractAddresses(addresses) {
+		if !common.IsHexAddress(contract.address) {
+			return types.ContractAddresses{}, fmt.Errorf("invalid %s address %q in the addresses file %s", contract.name, contract.address, filePath)
+		}
+	}
+	return addresses, nil
+}
+
+//This function checks that the provider is on the chain of the network and that the contracts of the network are deployed on it
+func ValidateNetwork(client *ethclient.Client) error {
+	chainId, err := ClientInterface.ChainID(client, context.Background())
+	if err != nil {
+		return err
+	}
+	if chainId.Cmp(core.ChainId) != 0 {
+		return fmt.Errorf("the provider is on the chain %s but the contracts of the %s network are on the chain %s", chainId, contractNetwork, core.ChainId)
+	}
+	addresses := types.ContractAddresses{
+		StakeManager:      core.StakeManagerAddress,
+		RAZOR:             core.RAZORAddress,
+		CollectionManager: core.CollectionManagerAddress,
+		VoteManager:       core.VoteManagerAddress,
+		BlockManager:      core.BlockManagerAddress,
+	}
+	for _, contract := range getContractAddresses(addresses) {
+		code, err := ClientInterface.CodeAt(client, context.Background(), common.HexToAddress(contract.address), nil)
+		if err != nil {
+			return err
+		}
+		if len(code) == 0 {
+			return fmt.Errorf("no contract is deployed at the %s address %s of the %s network", contract.name, contract.address, contractNetwork)
+		}
+	}
+	return nil
+}
+
+type contractAddress struct {
+	name    string
+	address string
+}
+
+//This function returns the addresses of the contracts with their names in a fixed order
+func getContractAddresses(addresses types.ContractAddresses) []contractAddress {
+	return []contractAddress{
+		{name: "StakeManager", address: addresses.StakeManager},
+		{name: "RAZOR", address: addresses.RAZOR},
+		{name: "CollectionManager", address: addresses.CollectionManager},
+		{name: "VoteManager", address: addresses.VoteManager},
+		{name: "BlockManager", address: addresses.BlockManager},
+	}
+}
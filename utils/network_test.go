@@ -0,0 +1,186 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetNetwork(t *testing.T) {
+	stakeManagerAddress, blockManagerAddress, chainId := core.StakeManagerAddress, core.BlockManagerAddress, core.ChainId
+	defer func() {
+		core.StakeManagerAddress, core.BlockManagerAddress, core.ChainId = stakeManagerAddress, blockManagerAddress, chainId
+		contractNetwork = core.MainnetNetwork
+	}()
+
+	addressesFileData := `{
+  "ChainId": 1234,
+  "Governance": "0x00000000000000000000000000000000000000A1",
+  "BlockManager": "0x00000000000000000000000000000000000000b1",
+  "CollectionManager": "0x00000000000000000000000000000000000000c1",
+  "StakeManager": "0x00000000000000000000000000000000000000d1",
+  "VoteManager": "0x00000000000000000000000000000000000000e1",
+  "RAZOR": "0x00000000000000000000000000000000000000f1"
+}`
+	tests := []struct {
+		name                    string
+		network                 string
+		fileData                string
+		noFile                  bool
+		noFilePath              bool
+		wantStakeManagerAddress string
+		wantChainId             *big.Int
+		wantErr                 bool
+	}{
+		{
+			name:                    "Test 1: When the mainnet is selected",
+			network:                 core.MainnetNetwork,
+			noFilePath:              true,
+			wantStakeManagerAddress: stakeManagerAddress,
+			wantChainId:             chainId,
+			wantErr:                 false,
+		},
+		{
+			name:                    "Test 2: When a custom network is selected with its addresses file",
+			network:                 core.CustomNetwork,
+			fileData:                addressesFileData,
+			wantStakeManagerAddress: "0x00000000000000000000000000000000000000d1",
+			wantChainId:             big.NewInt(1234),
+			wantErr:                 false,
+		},
+		{
+			name:     "Test 3: When the network is invalid",
+			network:  "devnet",
+			fileData: addressesFileData,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 4: When an addresses file is given for the mainnet",
+			network:  core.MainnetNetwork,
+			fileData: addressesFileData,
+			wantErr:  true,
+		},
+		{
+			name:       "Test 5: When no addresses file is given for the testnet",
+			network:    core.TestnetNetwork,
+			noFilePath: true,
+			wantErr:    true,
+		},
+		{
+			name:     "Test 6: When the chain id is missing from the addresses file",
+			network:  core.TestnetNetwork,
+			fileData: `{"BlockManager": "0x00000000000000000000000000000000000000b1"}`,
+			wantErr:  true,
+		},
+		{
+			name:     "Test 7: When an address of the addresses file is invalid",
+			network:  core.TestnetNetwork,
+			fileData: `{"ChainId": 1234, "StakeManager": "0xd1", "RAZOR": "", "CollectionManager": "", "VoteManager": "", "BlockManager": ""}`,
+			wantErr:  true,
+		},
+		{
+			name:    "Test 8: When the addresses file doesn't exist",
+			network: core.CustomNetwork,
+			noFile:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+			core.StakeManagerAddress, core.BlockManagerAddress, core.ChainId = stakeManagerAddress, blockManagerAddress, chainId
+			filePath := filepath.Join(t.TempDir(), "addresses.json")
+			if !tt.noFile && !tt.noFilePath {
+				if err := os.WriteFile(filePath, []byte(tt.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.noFilePath {
+				filePath = ""
+			}
+			err := SetNetwork(tt.network, filePath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetNetwork() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if core.StakeManagerAddress != stakeManagerAddress || core.ChainId != chainId {
+					t.Errorf("SetNetwork() changed the contract addresses although the network is invalid")
+				}
+				return
+			}
+			if GetNetwork() != tt.network {
+				t.Errorf("GetNetwork() = %s, want %s", GetNetwork(), tt.network)
+			}
+			if core.StakeManagerAddress != tt.wantStakeManagerAddress || core.ChainId.Cmp(tt.wantChainId) != 0 {
+				t.Errorf("SetNetwork() StakeManagerAddress = %s, ChainId = %s, want %s, %s", core.StakeManagerAddress, core.ChainId, tt.wantStakeManagerAddress, tt.wantChainId)
+			}
+		})
+	}
+}
+
+func TestValidateNetwork(t *testing.T) {
+	var client *ethclient.Client
+	tests := []struct {
+		name       string
+		chainId    *big.Int
+		chainIdErr error
+		code       []byte
+		codeErr    error
+		wantErr    bool
+	}{
+		{
+			name:    "Test 1: When the contracts of the network are deployed on the chain of the provider",
+			chainId: core.ChainId,
+			code:    []byte{1},
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When the provider is on another chain",
+			chainId: big.NewInt(1),
+			code:    []byte{1},
+			wantErr: true,
+		},
+		{
+			name:       "Test 3: When there is an error in getting the chain id",
+			chainIdErr: errors.New("chain id error"),
+			wantErr:    true,
+		},
+		{
+			name:    "Test 4: When no contract is deployed at an address",
+			chainId: core.ChainId,
+			code:    []byte{},
+			wantErr: true,
+		},
+		{
+			name:    "Test 5: When there is an error in getting the code",
+			chainId: core.ChainId,
+			codeErr: errors.New("code error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			StartRazor(OptionsPackageStruct{ClientInterface: clientMock})
+
+			clientMock.On("ChainID", mock.AnythingOfType("*ethclient.Client"), mock.Anything).Return(tt.chainId, tt.chainIdErr)
+			clientMock.On("CodeAt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("common.Address"), mock.Anything).Return(tt.code, tt.codeErr)
+
+			err := ValidateNetwork(client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNetwork() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				clientMock.AssertCalled(t, "CodeAt", client, mock.Anything, common.HexToAddress(core.BlockManagerAddress), mock.Anything)
+			}
+		})
+	}
+}
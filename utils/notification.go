@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"os"
+	"razor/core/types"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+//The default template is used for the events which have no template of their own
+const defaultNotificationTemplate = "default"
+
+const defaultNotificationTemplates = `{{define "default"}}{{.Event}} of {{.Address}} in epoch {{.Epoch}}: {{.Status}}{{if .TxnHash}} ({{.TxnHash}}){{end}}{{end}}
+{{define "commit"}}Committed in epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "reveal"}}Revealed in epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "propose"}}Proposed a block in epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "claimBlockReward"}}Claimed the block reward of epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "claimBounty"}}Claimed a bounty of {{.Amount}} RZR in epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "inclusionLatency"}}Late inclusion in epoch {{.Epoch}}: {{.Status}} ({{.TxnHash}}){{end}}
+{{define "chainSync"}}Voting {{.Status}} in epoch {{.Epoch}}{{end}}
+{{define "missedReveal"}}Missed the reveal of epoch {{.Epoch}}: {{.Status}}{{end}}
+{{define "disputedBlock"}}Block proposed in epoch {{.Epoch}} is disputed: {{.Status}}{{end}}
+{{define "slashed"}}Staker of {{.Address}} is slashed in epoch {{.Epoch}}: {{.Status}}{{end}}
+{{define "lowBalance"}}Low balance of {{.Address}} in epoch {{.Epoch}}: {{.Status}}{{end}}
+{{define "insufficientBalance"}}Commit of epoch {{.Epoch}} is skipped: {{.Status}}{{end}}
+{{define "rpcDown"}}Provider {{.Status}}{{end}}
+{{define "invalidBlock"}}Invalid block proposed in epoch {{.Epoch}}: {{.Status}}{{end}}
+{{define "missingConfirmation"}}No block confirmed in epoch {{.Epoch}}: {{.Status}}{{end}}`
+
+var (
+	notificationTemplates     = template.Must(template.New("notifications").Parse(defaultNotificationTemplates))
+	notificationTemplateMutex sync.RWMutex
+)
+
+//This function loads the notification templates of the file on top of the default templates
+//A template defined in the file with the name of an event replaces the default template of the event, the other events keep their default templates
+func LoadNotificationTemplates(filePath string) error {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	templates, err := template.Must(template.New("notifications").Parse(defaultNotificationTemplates)).Parse(string(data))
+	if err != nil {
+		return err
+	}
+	notificationTemplateMutex.Lock()
+	defer notificationTemplateMutex.Unlock()
+	notificationTemplates = templates
+	return nil
+}
+
+//This function renders the message of the notification with the template of its event
+func RenderNotification(notification types.Notification) (string, error) {
+	notificationTemplateMutex.RLock()
+	defer notificationTemplateMutex.RUnlock()
+	name := notification.Event
+	if notificationTemplates.Lookup(name) == nil {
+		name = defaultNotificationTemplate
+	}
+	var message strings.Builder
+	err := notificationTemplates.ExecuteTemplate(&message, name, notification)
+	if err != nil {
+		return "", err
+	}
+	return message.String(), nil
+}
+
+//This function renders the notification, logs the message and sends it to the alert webhooks if the event is critical
+//The errors are only logged as notifications shouldn't stop the voting
+func Notify(notification types.Notification) {
+	message, err := RenderNotification(notification)
+	if err != nil {
+		log.Errorf("Error in rendering notification of %s: %s", notification.Event, err)
+		return
+	}
+	log.Info("Notification: ", message)
+	sendAlerts(notification, message)
+}
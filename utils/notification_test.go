@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"razor/core/types"
+	"testing"
+)
+
+func TestRenderNotification(t *testing.T) {
+	defaultTemplates := notificationTemplates
+	defer func() { notificationTemplates = defaultTemplates }()
+
+	tests := []struct {
+		name         string
+		templates    string
+		notification types.Notification
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:         "Test 1: When the notification is rendered with the default template of the event",
+			notification: types.Notification{Event: "commit", Epoch: 10, Status: "mined", TxnHash: "0x01"},
+			want:         "Committed in epoch 10: mined (0x01)",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 2: When the event has no template",
+			notification: types.Notification{Event: "localMedians", Address: "0x01", Epoch: 10, Status: "mined"},
+			want:         "localMedians of 0x01 in epoch 10: mined",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 3: When the amount of the bounty is rendered",
+			notification: types.Notification{Event: "claimBounty", Epoch: 10, Status: "mined", TxnHash: "0x01", Amount: "12.5"},
+			want:         "Claimed a bounty of 12.5 RZR in epoch 10: mined (0x01)",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 4: When the template of the event is replaced by the file",
+			templates:    `{{define "commit"}}Commit de l'époque {{.Epoch}} : {{.Status}}{{end}}`,
+			notification: types.Notification{Event: "commit", Epoch: 10, Status: "mined", TxnHash: "0x01"},
+			want:         "Commit de l'époque 10 : mined",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 5: When the events which aren't in the file keep their default templates",
+			templates:    `{{define "commit"}}Commit de l'époque {{.Epoch}} : {{.Status}}{{end}}`,
+			notification: types.Notification{Event: "reveal", Epoch: 10, Status: "mined", TxnHash: "0x01"},
+			want:         "Revealed in epoch 10: mined (0x01)",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 6: When the hashes are rendered by the default template replaced by the file",
+			templates:    `{{define "default"}}[{{.Event}}] {{index .Hashes "values"}}{{end}}`,
+			notification: types.Notification{Event: "localMedians", Hashes: map[string]string{"values": "0xabc"}},
+			want:         "[localMedians] 0xabc",
+			wantErr:      false,
+		},
+		{
+			name:         "Test 7: When the template uses a variable which doesn't exist",
+			templates:    `{{define "commit"}}{{.Reward}}{{end}}`,
+			notification: types.Notification{Event: "commit"},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notificationTemplates = defaultTemplates
+			if tt.templates != "" {
+				filePath := filepath.Join(t.TempDir(), "notifications.tmpl")
+				if err := os.WriteFile(filePath, []byte(tt.templates), 0600); err != nil {
+					t.Fatal(err)
+				}
+				if err := LoadNotificationTemplates(filePath); err != nil {
+					t.Fatalf("LoadNotificationTemplates() error = %v", err)
+				}
+			}
+			got, err := RenderNotification(tt.notification)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderNotification() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RenderNotification() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadNotificationTemplates(t *testing.T) {
+	defaultTemplates := notificationTemplates
+	defer func() { notificationTemplates = defaultTemplates }()
+
+	tests := []struct {
+		name      string
+		templates string
+		noFile    bool
+		wantErr   bool
+	}{
+		{
+			name:      "Test 1: When the templates are loaded",
+			templates: `{{define "commit"}}{{.Epoch}}{{end}}`,
+			wantErr:   false,
+		},
+		{
+			name:    "Test 2: When the file doesn't exist",
+			noFile:  true,
+			wantErr: true,
+		},
+		{
+			name:      "Test 3: When the templates can't be parsed",
+			templates: `{{define "commit"}}{{.Epoch}`,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notificationTemplates = defaultTemplates
+			filePath := filepath.Join(t.TempDir(), "notifications.tmpl")
+			if !tt.noFile {
+				if err := os.WriteFile(filePath, []byte(tt.templates), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			err := LoadNotificationTemplates(filePath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadNotificationTemplates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && notificationTemplates != defaultTemplates {
+				t.Errorf("Templates are changed although they couldn't be loaded")
+			}
+		})
+	}
+}
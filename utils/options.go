@@ -27,19 +27,35 @@ func (*UtilsStruct) GetOptions() bind.CallOpts {
 }
 
 func (*UtilsStruct) GetTxnOpts(transactionData types.TransactionOptions) *bind.TransactOpts {
-	defaultPath, err := PathInterface.GetDefaultPath()
-	CheckError("Error in fetching default path: ", err)
-	keystorePath := path.Join(defaultPath, "keystore_files")
-	privateKey, err := AccountsInterface.GetPrivateKey(transactionData.AccountAddress, transactionData.Password, keystorePath)
-	if privateKey == nil || err != nil {
-		CheckError("Error in fetching private key: ", errors.New(transactionData.AccountAddress+" not present in razor-go"))
+	var txnOpts *bind.TransactOpts
+	if signerUrl := getRemoteSignerUrl(); signerUrl != "" {
+		// The key is kept by the external signer and the node only sends it the unsigned transactions
+		txnOpts = getRemoteTransactor(signerUrl, common.HexToAddress(transactionData.AccountAddress), transactionData.ChainId)
+	} else if IsKMSSignerEnabled() {
+		// The key never leaves the KMS, which only signs the hashes of the transactions
+		var err error
+		txnOpts, err = getKMSTransactor(common.HexToAddress(transactionData.AccountAddress), transactionData.ChainId)
+		CheckError("Error in getting KMS transactor: ", err)
+	} else {
+		defaultPath, err := PathInterface.GetDefaultPath()
+		CheckError("Error in fetching default path: ", err)
+		keystorePath := path.Join(defaultPath, "keystore_files")
+		privateKey, err := getPrivateKey(transactionData.AccountAddress, transactionData.Password, keystorePath)
+		if privateKey == nil || err != nil {
+			CheckError("Error in fetching private key: ", errors.New(transactionData.AccountAddress+" not present in razor-go"))
+		}
+		txnOpts, err = BindInterface.NewKeyedTransactorWithChainID(privateKey, transactionData.ChainId)
+		CheckError("Error in getting transactor: ", err)
 	}
 	nonce, err := UtilsInterface.GetPendingNonceAtWithRetry(transactionData.Client, common.HexToAddress(transactionData.AccountAddress))
 	CheckError("Error in fetching pending nonce: ", err)
 
-	gasPrice := UtilsInterface.GetGasPrice(transactionData.Client, transactionData.Config)
-	txnOpts, err := BindInterface.NewKeyedTransactorWithChainID(privateKey, transactionData.ChainId)
-	CheckError("Error in getting transactor: ", err)
+	gasPrice := getGasPriceOfStrategy(transactionData.Client, transactionData.Config, transactionData.MethodName)
+	// The signed transactions are kept so that they can be sped up if they get stuck, and their cost is counted in the gas budget
+	// No transaction is signed once the deadline of the state handler which sends it has passed
+	ctx := getTransactionContext(transactionData.AccountAddress)
+	txnOpts.Context = ctx
+	txnOpts.Signer = getDeadlineSigner(getGasBudgetSigner(getMonitoredSigner(txnOpts.Signer), transactionData.Client, transactionData.Config, transactionData.MethodName), ctx, transactionData.MethodName)
 	txnOpts.Nonce = big.NewInt(int64(nonce))
 	txnOpts.GasPrice = gasPrice
 	txnOpts.Value = transactionData.EtherValue
@@ -54,15 +70,35 @@ func (*UtilsStruct) GetTxnOpts(transactionData types.TransactionOptions) *bind.T
 			txnOpts.GasLimit = latestBlock.GasLimit
 			log.Debug("Error occurred due to RPC issue, sending block gas limit...")
 			log.Debug("Gas Limit: ", txnOpts.GasLimit)
+			if IsNoSendMode() {
+				setNoSendTxnOpts(txnOpts, transactionData.MethodName, transactionData.Parameters, nil)
+			}
 			return txnOpts
 		}
 		log.Error("Error in getting gas limit: ", err)
+		if IsNoSendMode() {
+			// The transaction isn't sent, so it is still signed and exported with the block gas limit
+			latestBlock, blockErr := UtilsInterface.GetLatestBlockWithRetry(transactionData.Client)
+			CheckError("Error in fetching block: ", blockErr)
+			txnOpts.GasLimit = latestBlock.GasLimit
+			setNoSendTxnOpts(txnOpts, transactionData.MethodName, transactionData.Parameters, err)
+			return txnOpts
+		}
 	}
 	log.Debug("Gas after increment: ", gasLimit)
 	txnOpts.GasLimit = gasLimit
+	if IsNoSendMode() {
+		setNoSendTxnOpts(txnOpts, transactionData.MethodName, transactionData.Parameters, nil)
+	}
 	return txnOpts
 }
 
+//This function sets the transaction options so that the transaction is signed and exported to the canary file but not sent
+func setCanaryTxnOpts(txnOpts *bind.TransactOpts, methodName string, estimationErr error) {
+	txnOpts.NoSend = true
+	txnOpts.Signer = getCanarySigner(txnOpts.Signer, methodName, estimationErr)
+}
+
 func (*UtilsStruct) GetGasPrice(client *ethclient.Client, config types.Configurations) *big.Int {
 	var gas *big.Int
 	if config.GasPrice != 0 {
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"razor/core/types"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	TableOutput = "table"
+	JsonOutput  = "json"
+)
+
+var (
+	outputFormat = TableOutput
+	outputMutex  sync.Mutex
+)
+
+//This function sets the format in which the commands print their results, the results are printed in tables by default
+func SetOutputFormat(format string) error {
+	if format == "" {
+		format = TableOutput
+	}
+	if format != TableOutput && format != JsonOutput {
+		return fmt.Errorf("invalid output format %s, it should be either %s or %s", format, TableOutput, JsonOutput)
+	}
+	outputMutex.Lock()
+	defer outputMutex.Unlock()
+	outputFormat = format
+	return nil
+}
+
+//This function returns if the results of the commands are printed as JSON
+func IsJsonOutput() bool {
+	outputMutex.Lock()
+	defer outputMutex.Unlock()
+	return outputFormat == JsonOutput
+}
+
+//This function prints the result as a single line of JSON to stdout, the logs are written to stderr so that they don't mix with it
+func PrintJson(result interface{}) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+//This function prints the hash, status and gas used of the transaction as JSON if the results are printed as JSON
+func printTransactionOutput(client *ethclient.Client, hashToRead string, status string) {
+	if !IsJsonOutput() {
+		return
+	}
+	transactionOutput := types.TransactionOutput{
+		TxnHash: hashToRead,
+		Status:  status,
+	}
+	if status == "mined" || status == "failed" {
+		receipt, err := ClientInterface.TransactionReceipt(client, context.Background(), common.HexToHash(hashToRead))
+		if err != nil {
+			log.Error("Error in fetching transaction receipt: ", err)
+		} else {
+			transactionOutput.GasUsed = receipt.GasUsed
+			if receipt.BlockNumber != nil {
+				transactionOutput.BlockNumber = receipt.BlockNumber.Uint64()
+			}
+		}
+	}
+	if err := PrintJson(transactionOutput); err != nil {
+		log.Error("Error in printing transaction output: ", err)
+	}
+}
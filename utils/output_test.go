@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"razor/core/types"
+	"testing"
+)
+
+func TestSetOutputFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		wantJson bool
+		wantErr  bool
+	}{
+		{
+			name:     "Test 1: When the output format is not passed",
+			format:   "",
+			wantJson: false,
+		},
+		{
+			name:     "Test 2: When the output format is table",
+			format:   "table",
+			wantJson: false,
+		},
+		{
+			name:     "Test 3: When the output format is json",
+			format:   "json",
+			wantJson: true,
+		},
+		{
+			name:     "Test 4: When the output format is invalid",
+			format:   "yaml",
+			wantJson: false,
+			wantErr:  true,
+		},
+	}
+	defer func() { outputFormat = TableOutput }()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputFormat = TableOutput
+			err := SetOutputFormat(tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetOutputFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if IsJsonOutput() != tt.wantJson {
+				t.Errorf("IsJsonOutput() = %v, want %v", IsJsonOutput(), tt.wantJson)
+			}
+		})
+	}
+}
+
+func TestPrintTransactionOutput(t *testing.T) {
+	outputFormat = JsonOutput
+	defer func() { outputFormat = TableOutput }()
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	printTransactionOutput(nil, "0x1", "notSent")
+	os.Stdout = stdout
+	writer.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var transactionOutput types.TransactionOutput
+	if err := json.Unmarshal(data, &transactionOutput); err != nil {
+		t.Fatalf("Error in unmarshalling transaction output %s: %v", data, err)
+	}
+	if transactionOutput.TxnHash != "0x1" || transactionOutput.Status != "notSent" {
+		t.Errorf("printTransactionOutput() printed %+v", transactionOutput)
+	}
+}
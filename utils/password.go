@@ -2,10 +2,31 @@ package utils
 
 import (
 	"errors"
+	"fmt"
 	"github.com/manifoldco/promptui"
+	"os"
+	"runtime"
+	"strings"
 	"unicode"
 )
 
+//Sources from which the password is read, the password is prompted for by default
+const (
+	PasswordSourcePrompt  = "prompt"
+	PasswordSourceEnv     = "env"
+	PasswordSourceFile    = "file"
+	PasswordSourceKeyring = "keyring"
+)
+
+//The source from which the password is read, the value is the environment variable or the file and the address is the account whose password is read
+type passwordSourceConfig struct {
+	kind    string
+	value   string
+	address string
+}
+
+var passwordSource = passwordSourceConfig{kind: PasswordSourcePrompt}
+
 func PasswordPrompt() string {
 	prompt := promptui.Prompt{
 		Label:    "Password",
@@ -19,6 +40,20 @@ func PasswordPrompt() string {
 	return password
 }
 
+//This function prompts for the password of a new keystore, which has to be strong
+func NewPasswordPrompt() string {
+	prompt := promptui.Prompt{
+		Label:    "Password",
+		Validate: validateNewPassword,
+		Mask:     ' ',
+	}
+	password, err := prompt.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	return password
+}
+
 func PrivateKeyPrompt() string {
 	prompt := promptui.Prompt{
 		Label:    "🔑 Private Key",
@@ -33,6 +68,13 @@ func PrivateKeyPrompt() string {
 }
 
 func validate(input string) error {
+	if input == "" {
+		return errors.New("enter a valid password")
+	}
+	return nil
+}
+
+func validateNewPassword(input string) error {
 	if input == "" || !strongPassword(input) {
 		return errors.New("enter a valid password")
 	}
@@ -47,7 +89,103 @@ func validatePrivateKey(input string) error {
 }
 
 func AssignPassword() string {
-	return PasswordPrompt()
+	if password, ok := getSessionPassword(); ok {
+		return password
+	}
+	password, err := ReadPassword(passwordSource.address)
+	if err != nil {
+		log.Fatal("Error in reading password: ", err)
+	}
+	SetSessionPassword(password)
+	return password
+}
+
+//This function returns the password of a new keystore, the strength of the password is checked whatever its source is
+func AssignNewPassword() string {
+	var password string
+	if passwordSource.kind == PasswordSourcePrompt {
+		password = NewPasswordPrompt()
+	} else {
+		var err error
+		password, err = ReadPassword(passwordSource.address)
+		if err != nil {
+			log.Fatal("Error in reading password: ", err)
+		}
+		if !strongPassword(password) {
+			log.Fatal("The password should be of minimum 8 characters containing least 1 uppercase, lowercase, digit and special character")
+		}
+	}
+	SetSessionPassword(password)
+	return password
+}
+
+//This function sets the source from which the password is read instead of prompting for it
+//The source is prompt, env:<variable>, file:<path> or keyring, the password of the address is read from the keyring
+func SetPasswordSource(source string, address string) error {
+	kind, value := source, ""
+	if index := strings.Index(source, ":"); index != -1 {
+		kind, value = source[:index], source[index+1:]
+	}
+	switch kind {
+	case "", PasswordSourcePrompt, PasswordSourceKeyring:
+		if value != "" {
+			return fmt.Errorf("%s password source doesn't take a value", kind)
+		}
+		if kind == "" {
+			kind = PasswordSourcePrompt
+		}
+	case PasswordSourceEnv, PasswordSourceFile:
+		if value == "" {
+			return fmt.Errorf("%s password source needs a value, e.g. env:RAZOR_PASSWORD or file:/etc/razor/password", kind)
+		}
+	default:
+		return fmt.Errorf("invalid password source %s, it should be prompt, env:<variable>, file:<path> or keyring", source)
+	}
+	passwordSource = passwordSourceConfig{kind: kind, value: value, address: address}
+	return nil
+}
+
+//This function reads the password of the address from the source which is set, the password is prompted for if no source is set
+func ReadPassword(address string) (string, error) {
+	switch passwordSource.kind {
+	case PasswordSourceEnv:
+		password := os.Getenv(passwordSource.value)
+		if password == "" {
+			return "", fmt.Errorf("environment variable %s is not set", passwordSource.value)
+		}
+		return password, nil
+	case PasswordSourceFile:
+		return readPasswordFile(passwordSource.value)
+	case PasswordSourceKeyring:
+		if address == "" {
+			return "", errors.New("address is needed to read the password from the keyring")
+		}
+		return GetKeychainPassword(address)
+	default:
+		return PasswordPrompt(), nil
+	}
+}
+
+//This function reads the password from the file, which should only be readable by its owner
+//The trailing newline of the file is not a part of the password
+func readPasswordFile(fileName string) (string, error) {
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return "", err
+	}
+	// The permissions of the file can't be checked on Windows
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return "", fmt.Errorf("password file %s should only be readable by its owner, set its permissions to 0600", fileName)
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	password := strings.TrimRight(string(data), "\r\n")
+	if password == "" {
+		return "", fmt.Errorf("password file %s is empty", fileName)
+	}
+	return password, nil
 }
 
 //This function checks if the password is strong enough or not
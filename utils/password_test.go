@@ -1,6 +1,11 @@
 package utils
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
 
 func Test_strongPassword(t *testing.T) {
 	type args struct {
@@ -69,3 +74,140 @@ func Test_strongPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPasswordSource(t *testing.T) {
+	defer func() { passwordSource = passwordSourceConfig{kind: PasswordSourcePrompt} }()
+
+	tests := []struct {
+		name    string
+		source  string
+		want    passwordSourceConfig
+		wantErr bool
+	}{
+		{
+			name:   "Test 1: When no source is passed",
+			source: "",
+			want:   passwordSourceConfig{kind: PasswordSourcePrompt, address: "0x000000000000000000000000000000000000dea1"},
+		},
+		{
+			name:   "Test 2: When the password is read from an environment variable",
+			source: "env:RAZOR_PASSWORD",
+			want:   passwordSourceConfig{kind: PasswordSourceEnv, value: "RAZOR_PASSWORD", address: "0x000000000000000000000000000000000000dea1"},
+		},
+		{
+			name:   "Test 3: When the password is read from a file",
+			source: "file:/etc/razor/password",
+			want:   passwordSourceConfig{kind: PasswordSourceFile, value: "/etc/razor/password", address: "0x000000000000000000000000000000000000dea1"},
+		},
+		{
+			name:   "Test 4: When the password is read from the keyring",
+			source: "keyring",
+			want:   passwordSourceConfig{kind: PasswordSourceKeyring, address: "0x000000000000000000000000000000000000dea1"},
+		},
+		{
+			name:    "Test 5: When the environment variable isn't passed",
+			source:  "env:",
+			wantErr: true,
+		},
+		{
+			name:    "Test 6: When the keyring is passed with a value",
+			source:  "keyring:razor",
+			wantErr: true,
+		},
+		{
+			name:    "Test 7: When the source is invalid",
+			source:  "vault:razor",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passwordSource = passwordSourceConfig{kind: PasswordSourcePrompt}
+			err := SetPasswordSource(tt.source, "0x000000000000000000000000000000000000dea1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetPasswordSource() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && passwordSource != tt.want {
+				t.Errorf("SetPasswordSource() source = %v, want %v", passwordSource, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadPassword(t *testing.T) {
+	defer func() { passwordSource = passwordSourceConfig{kind: PasswordSourcePrompt} }()
+
+	directory := t.TempDir()
+	passwordFile := filepath.Join(directory, "password")
+	if err := os.WriteFile(passwordFile, []byte("Qwerty12@\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	readableFile := filepath.Join(directory, "readablePassword")
+	if err := os.WriteFile(readableFile, []byte("Qwerty12@"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(directory, "emptyPassword")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("RAZOR_TEST_PASSWORD", "Qwerty12@")
+
+	tests := []struct {
+		name    string
+		source  passwordSourceConfig
+		address string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "Test 1: When the password is read from an environment variable",
+			source: passwordSourceConfig{kind: PasswordSourceEnv, value: "RAZOR_TEST_PASSWORD"},
+			want:   "Qwerty12@",
+		},
+		{
+			name:    "Test 2: When the environment variable isn't set",
+			source:  passwordSourceConfig{kind: PasswordSourceEnv, value: "RAZOR_TEST_UNSET_PASSWORD"},
+			wantErr: true,
+		},
+		{
+			name:   "Test 3: When the password is read from a file",
+			source: passwordSourceConfig{kind: PasswordSourceFile, value: passwordFile},
+			want:   "Qwerty12@",
+		},
+		{
+			name:    "Test 4: When the password file is readable by others",
+			source:  passwordSourceConfig{kind: PasswordSourceFile, value: readableFile},
+			wantErr: runtime.GOOS != "windows",
+			want:    "Qwerty12@",
+		},
+		{
+			name:    "Test 5: When the password file is empty",
+			source:  passwordSourceConfig{kind: PasswordSourceFile, value: emptyFile},
+			wantErr: true,
+		},
+		{
+			name:    "Test 6: When the password file doesn't exist",
+			source:  passwordSourceConfig{kind: PasswordSourceFile, value: filepath.Join(directory, "missing")},
+			wantErr: true,
+		},
+		{
+			name:    "Test 7: When the password is read from the keyring without an address",
+			source:  passwordSourceConfig{kind: PasswordSourceKeyring},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passwordSource = tt.source
+			got, err := ReadPassword(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadPassword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ReadPassword() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
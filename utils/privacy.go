@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"razor/core"
+	"strings"
+	"sync"
+)
+
+var (
+	requestHeadersMode  string
+	allowedHosts        []string
+	requestPrivacyMutex sync.RWMutex
+
+	//Common browser User-Agents which are sent when the request headers are randomized
+	browserUserAgents = []string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/101.0.4951.67 Safari/537.36",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/15.4 Safari/605.1.15",
+		"Mozilla/5.0 (X11; Linux x86_64; rv:100.0) Gecko/20100101 Firefox/100.0",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64; rv:100.0) Gecko/20100101 Firefox/100.0",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/101.0.4951.64 Safari/537.36",
+	}
+)
+
+//This function sets how the identifying headers are sent and the hosts which the APIs of the jobs can be fetched from
+func SetRequestPrivacy(headersMode string, hosts []string) {
+	requestPrivacyMutex.Lock()
+	defer requestPrivacyMutex.Unlock()
+	requestHeadersMode = headersMode
+	allowedHosts = hosts
+}
+
+//This function returns the User-Agent to be sent with the requests
+//The second return value is false if the default User-Agent of the client should be sent
+func getUserAgent() (string, bool) {
+	requestPrivacyMutex.RLock()
+	defer requestPrivacyMutex.RUnlock()
+	switch requestHeadersMode {
+	case core.OmitRequestHeaders:
+		return "", true
+	case core.RandomizeRequestHeaders:
+		return browserUserAgents[rand.Intn(len(browserUserAgents))], true
+	default:
+		return "", false
+	}
+}
+
+//This function sets the identifying headers of the request according to the request headers mode
+//An empty User-Agent header is not sent by the http client
+func setRequestHeaders(header http.Header) {
+	if userAgent, ok := getUserAgent(); ok {
+		header.Set("User-Agent", userAgent)
+	}
+}
+
+//This function returns an error if the host of the url is not one of the allowed hosts
+func CheckAllowedHost(rawUrl string) error {
+	requestPrivacyMutex.RLock()
+	defer requestPrivacyMutex.RUnlock()
+	if len(allowedHosts) == 0 {
+		return nil
+	}
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return err
+	}
+	for _, host := range allowedHosts {
+		if strings.EqualFold(parsedUrl.Hostname(), host) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %s is not in the allowed hosts", parsedUrl.Hostname())
+}
+
+//requestHeadersTransport sets the identifying headers of the requests sent to the RPC provider
+type requestHeadersTransport struct {
+	base http.RoundTripper
+}
+
+func (t requestHeadersTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	setRequestHeaders(request.Header)
+	return t.base.RoundTrip(request)
+}
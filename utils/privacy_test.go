@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckAllowedHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		hosts   []string
+		url     string
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When allowed hosts are not set",
+			hosts:   nil,
+			url:     "https://api.gemini.com/v1/pubticker/btcusd",
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When host of the url is allowed",
+			hosts:   []string{"api.kraken.com", "API.gemini.com"},
+			url:     "https://api.gemini.com:443/v1/pubticker/btcusd",
+			wantErr: false,
+		},
+		{
+			name:    "Test 3: When host of the url is not allowed",
+			hosts:   []string{"api.kraken.com"},
+			url:     "https://api.gemini.com/v1/pubticker/btcusd",
+			wantErr: true,
+		},
+		{
+			name:    "Test 4: When url is invalid",
+			hosts:   []string{"api.kraken.com"},
+			url:     "://api.kraken.com",
+			wantErr: true,
+		},
+	}
+	defer SetRequestPrivacy("", nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetRequestPrivacy("", tt.hosts)
+			if err := CheckAllowedHost(tt.url); (err != nil) != tt.wantErr {
+				t.Errorf("CheckAllowedHost() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRequestHeadersTransport(t *testing.T) {
+	tests := []struct {
+		name           string
+		headersMode    string
+		wantUserAgents []string
+	}{
+		{
+			name:           "Test 1: When request headers mode is not set",
+			headersMode:    "",
+			wantUserAgents: []string{"Go-http-client/1.1"},
+		},
+		{
+			name:           "Test 2: When request headers are omitted",
+			headersMode:    "omit",
+			wantUserAgents: []string{""},
+		},
+		{
+			name:           "Test 3: When request headers are randomized",
+			headersMode:    "randomize",
+			wantUserAgents: browserUserAgents,
+		},
+	}
+	defer SetRequestPrivacy("", nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var userAgent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				userAgent = r.Header.Get("User-Agent")
+			}))
+			defer server.Close()
+
+			SetRequestPrivacy(tt.headersMode, nil)
+			client := &http.Client{Transport: requestHeadersTransport{base: http.DefaultTransport}}
+			response, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("Error in sending request: %v", err)
+			}
+			response.Body.Close()
+
+			if !Contains(tt.wantUserAgents, userAgent) {
+				t.Errorf("User-Agent got = %q, want one of %q", userAgent, tt.wantUserAgents)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+var (
+	readProvider       string
+	readProviderClient *ethclient.Client
+	readProviderMutex  sync.Mutex
+)
+
+//This function sets the RPC provider which serves the heavy reads such as the log scans, the provider serves them too if it is empty
+func SetReadProvider(provider string) {
+	readProviderMutex.Lock()
+	defer readProviderMutex.Unlock()
+	if provider == readProvider {
+		return
+	}
+	readProvider = provider
+	readProviderClient = nil
+}
+
+//This function returns the client of the read provider, the client of the provider is returned if no read provider is set or it can't be connected to
+func getReadProviderClient(client *ethclient.Client) *ethclient.Client {
+	readProviderMutex.Lock()
+	defer readProviderMutex.Unlock()
+	if readProvider == "" {
+		return client
+	}
+	if readProviderClient == nil {
+		readClient, err := EthClient.Dial(readProvider)
+		if err != nil {
+			log.Error("Error in connecting to the read provider, using the provider instead: ", err)
+			return client
+		}
+		log.Info("Connected to read provider: ", readProvider)
+		readProviderClient = readClient
+	}
+	return readProviderClient
+}
+
+//This function returns the client which the logs of the query are fetched from
+//The read provider is only used if it has synced up to the last block of the query, as it would otherwise silently miss the latest logs
+func getLogsClient(client *ethclient.Client, query ethereum.FilterQuery) *ethclient.Client {
+	readClient := getReadProviderClient(client)
+	if readClient == client || query.ToBlock == nil {
+		return readClient
+	}
+	header, err := ClientInterface.HeaderByNumber(readClient, context.Background(), nil)
+	if err != nil {
+		log.Warn("Error in fetching latest block of the read provider, fetching the logs from the provider: ", err)
+		return client
+	}
+	if header.Number.Cmp(query.ToBlock) < 0 {
+		log.Debugf("Read provider is at block %s behind block %s of the query, fetching the logs from the provider", header.Number, query.ToBlock)
+		return client
+	}
+	return readClient
+}
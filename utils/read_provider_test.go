@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/avast/retry-go"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFilterLogsWithReadProvider(t *testing.T) {
+	client := &ethclient.Client{}
+	readClient := &ethclient.Client{}
+	query := ethereum.FilterQuery{
+		FromBlock: big.NewInt(100),
+		ToBlock:   big.NewInt(200),
+	}
+	providerLogs := []types.Log{{BlockHash: common.HexToHash("0x01")}}
+	readProviderLogs := []types.Log{{BlockHash: common.HexToHash("0x02")}}
+
+	type args struct {
+		readProvider  string
+		dialErr       error
+		readHead      *big.Int
+		readHeadErr   error
+		readLogsErr   error
+		providerQuery bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []types.Log
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When no read provider is set",
+			args: args{},
+			want: providerLogs,
+		},
+		{
+			name: "Test 2: When the read provider has synced up to the query",
+			args: args{
+				readProvider: "https://read.example.com",
+				readHead:     big.NewInt(200),
+			},
+			want: readProviderLogs,
+		},
+		{
+			name: "Test 3: When the read provider is behind the query",
+			args: args{
+				readProvider: "https://read.example.com",
+				readHead:     big.NewInt(199),
+			},
+			want: providerLogs,
+		},
+		{
+			name: "Test 4: When there is an error in fetching the latest block of the read provider",
+			args: args{
+				readProvider: "https://read.example.com",
+				readHeadErr:  errors.New("header error"),
+			},
+			want: providerLogs,
+		},
+		{
+			name: "Test 5: When there is an error in fetching the logs from the read provider",
+			args: args{
+				readProvider: "https://read.example.com",
+				readHead:     big.NewInt(300),
+				readLogsErr:  errors.New("logs error"),
+			},
+			want: providerLogs,
+		},
+		{
+			name: "Test 6: When there is an error in connecting to the read provider",
+			args: args{
+				readProvider: "https://read.example.com",
+				dialErr:      errors.New("dial error"),
+			},
+			want: providerLogs,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryMock := new(mocks.RetryUtils)
+			clientMock := new(mocks.ClientUtils)
+			ethClientMock := new(mocks.EthClientUtils)
+			optionsPackageStruct := OptionsPackageStruct{
+				RetryInterface:  retryMock,
+				ClientInterface: clientMock,
+				EthClient:       ethClientMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			SetReadProvider(tt.args.readProvider)
+			defer SetReadProvider("")
+
+			ethClientMock.On("Dial", tt.args.readProvider).Return(readClient, tt.args.dialErr)
+			clientMock.On("HeaderByNumber", readClient, context.Background(), (*big.Int)(nil)).Return(&types.Header{Number: tt.args.readHead}, tt.args.readHeadErr)
+			clientMock.On("FilterLogs", mock.AnythingOfType("*ethclient.Client"), context.Background(), query).Return(
+				func(c *ethclient.Client, ctx context.Context, q ethereum.FilterQuery) []types.Log {
+					if c == readClient {
+						if tt.args.readLogsErr != nil {
+							return nil
+						}
+						return readProviderLogs
+					}
+					return providerLogs
+				},
+				func(c *ethclient.Client, ctx context.Context, q ethereum.FilterQuery) error {
+					if c == readClient {
+						return tt.args.readLogsErr
+					}
+					return nil
+				})
+			retryMock.On("RetryAttempts", mock.AnythingOfType("uint")).Return(retry.Attempts(1))
+
+			got, err := utils.FilterLogsWithRetry(client, query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FilterLogsWithRetry() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterLogsWithRetry() got = %v, want %v", got, tt.want)
+			}
+			if tt.args.readProvider == "" {
+				ethClientMock.AssertNotCalled(t, "Dial", mock.Anything)
+			}
+		})
+	}
+}
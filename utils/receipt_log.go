@@ -0,0 +1,174 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const receiptPrefix = "receipt/"
+
+var (
+	contractMethods     map[string]string
+	contractMethodsOnce sync.Once
+)
+
+//This function returns the key of the receipt record, the records of an address are ordered by epoch
+func getReceiptKey(address string, epoch uint32, txnHash string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%010d/%s", receiptPrefix, strings.ToLower(address), epoch, strings.ToLower(txnHash)))
+}
+
+//This function fetches the receipt of the mined transaction and saves it in the state store of its sender
+//The receipt is kept with the method of the transaction, the epoch it was mined in and its gas cost, so that the gas spending can be reported without a block explorer
+func (*UtilsStruct) SaveTransactionReceipt(client *ethclient.Client, _txHash string) error {
+	txHash := common.HexToHash(_txHash)
+	txn, _, err := ClientInterface.TransactionByHash(client, context.Background(), txHash)
+	if err != nil {
+		return err
+	}
+	receipt, err := ClientInterface.TransactionReceipt(client, context.Background(), txHash)
+	if err != nil {
+		return err
+	}
+	header, err := ClientInterface.HeaderByNumber(client, context.Background(), receipt.BlockNumber)
+	if err != nil {
+		return err
+	}
+	from, err := Types.Sender(Types.LatestSignerForChainID(txn.ChainId()), txn)
+	if err != nil {
+		return err
+	}
+	record := getTransactionReceiptRecord(txn, receipt, header)
+	record.From = from.Hex()
+
+	jsonData, err := JsonInterface.Marshal(record)
+	if err != nil {
+		return err
+	}
+	jsonData, err = EncryptStateData(jsonData)
+	if err != nil {
+		return err
+	}
+	return withStateDB(func(db *leveldb.DB) error {
+		return db.Put(getReceiptKey(record.From, record.Epoch, record.TxnHash), jsonData, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function saves the receipt of the mined transaction, the errors are only logged as the audit log of the receipts shouldn't fail the transaction
+func saveTransactionReceipt(client *ethclient.Client, hashToRead string) {
+	if err := UtilsInterface.SaveTransactionReceipt(client, hashToRead); err != nil {
+		log.Error("Error in saving transaction receipt: ", err)
+	}
+}
+
+//This function reads the receipt records of the transactions sent by the address from the given epoch on, sorted by epoch
+func (*UtilsStruct) ReadTransactionReceipts(address string, fromEpoch uint32) ([]types.TransactionReceiptRecord, error) {
+	var records []types.TransactionReceiptRecord
+	err := withStateDB(func(db *leveldb.DB) error {
+		prefix := receiptPrefix + strings.ToLower(address) + "/"
+		iterator := db.NewIterator(&util.Range{
+			Start: []byte(fmt.Sprintf("%s%010d/", prefix, fromEpoch)),
+			Limit: util.BytesPrefix([]byte(prefix)).Limit,
+		}, nil)
+		defer iterator.Release()
+		for iterator.Next() {
+			data, err := DecryptStateData(iterator.Value())
+			if err != nil {
+				return err
+			}
+			var record types.TransactionReceiptRecord
+			if err := JsonInterface.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return iterator.Error()
+	})
+	if err != nil {
+		log.Error("Error in reading transaction receipts: ", err)
+		return nil, err
+	}
+	return records, nil
+}
+
+//This function returns the receipt record of the transaction mined in the block of the header
+func getTransactionReceiptRecord(txn *Types.Transaction, receipt *Types.Receipt, header *Types.Header) types.TransactionReceiptRecord {
+	effectiveGasPrice := getEffectiveGasPrice(txn, header.BaseFee)
+	record := types.TransactionReceiptRecord{
+		TxnHash:           txn.Hash().Hex(),
+		Method:            getTransactionMethod(txn.Data()),
+		Epoch:             uint32(header.Time / uint64(core.EpochLength)),
+		BlockNumber:       header.Number.Uint64(),
+		Status:            receipt.Status,
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: effectiveGasPrice.String(),
+		GasCost:           new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), effectiveGasPrice).String(),
+		Timestamp:         int64(header.Time),
+	}
+	if txn.To() != nil {
+		record.To = txn.To().Hex()
+	}
+	for _, vLog := range receipt.Logs {
+		receiptLog := types.ReceiptLog{
+			Address: vLog.Address.Hex(),
+			Data:    hexutil.Encode(vLog.Data),
+		}
+		for _, topic := range vLog.Topics {
+			receiptLog.Topics = append(receiptLog.Topics, topic.Hex())
+		}
+		record.Logs = append(record.Logs, receiptLog)
+	}
+	return record
+}
+
+//This function returns the price paid per gas by the transaction, which is the fee cap capped sum of the tip and the base fee for the dynamic fee transactions
+func getEffectiveGasPrice(txn *Types.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil || txn.Type() == Types.LegacyTxType || txn.Type() == Types.AccessListTxType {
+		return new(big.Int).Set(txn.GasPrice())
+	}
+	effectiveGasPrice := new(big.Int).Add(txn.GasTipCap(), baseFee)
+	if effectiveGasPrice.Cmp(txn.GasFeeCap()) > 0 {
+		effectiveGasPrice.Set(txn.GasFeeCap())
+	}
+	return effectiveGasPrice
+}
+
+//This function returns the name of the contract method which the transaction calls, it is "nativeTransfer" for a transfer of the gas token
+func getTransactionMethod(data []byte) string {
+	if len(data) == 0 {
+		return "nativeTransfer"
+	}
+	if len(data) < 4 {
+		return "unknown"
+	}
+	contractMethodsOnce.Do(func() {
+		contractMethods = make(map[string]string)
+		for _, contractAbi := range contractABIs {
+			parsedAbi, err := abi.JSON(strings.NewReader(contractAbi))
+			if err != nil {
+				log.Debug("Error in parsing methods of contract ABI: ", err)
+				continue
+			}
+			for _, method := range parsedAbi.Methods {
+				contractMethods[string(method.ID)] = method.Name
+			}
+		}
+	})
+	if method, ok := contractMethods[string(data[:4])]; ok {
+		return method
+	}
+	return "unknown"
+}
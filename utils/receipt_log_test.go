@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"razor/utils/mocks"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTransactionReceipts(t *testing.T) {
+	var client *ethclient.Client
+	clientMock := new(mocks.ClientUtils)
+	StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}, ClientInterface: clientMock})
+	setStateDBPath(t, nil)
+	utils := &UtilsStruct{}
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(privateKey.PublicKey)
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	signer := Types.LatestSignerForChainID(big.NewInt(137))
+
+	var wantRecords []types.TransactionReceiptRecord
+	for i, epoch := range []uint32{9, 10, 11} {
+		txn, err := Types.SignTx(Types.NewTx(&Types.LegacyTx{Nonce: uint64(i), To: &to, Gas: 100000, GasPrice: big.NewInt(10)}), signer, privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blockNumber := big.NewInt(int64(100 + i))
+		header := &Types.Header{Number: blockNumber, Time: uint64(epoch) * uint64(core.EpochLength)}
+		receipt := &Types.Receipt{
+			Status:      uint64(i % 2),
+			GasUsed:     21000,
+			BlockNumber: blockNumber,
+			Logs:        []*Types.Log{{Address: to, Topics: []common.Hash{common.HexToHash("0x01")}, Data: []byte{0x02}}},
+		}
+		clientMock.On("TransactionByHash", mock.AnythingOfType("*ethclient.Client"), mock.Anything, txn.Hash()).Return(txn, false, nil)
+		clientMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, txn.Hash()).Return(receipt, nil)
+		clientMock.On("HeaderByNumber", mock.AnythingOfType("*ethclient.Client"), mock.Anything, blockNumber).Return(header, nil)
+
+		if err := utils.SaveTransactionReceipt(client, txn.Hash().Hex()); err != nil {
+			t.Fatalf("SaveTransactionReceipt() error = %v", err)
+		}
+		wantRecords = append(wantRecords, types.TransactionReceiptRecord{
+			TxnHash:           txn.Hash().Hex(),
+			From:              from.Hex(),
+			To:                to.Hex(),
+			Method:            "nativeTransfer",
+			Epoch:             epoch,
+			BlockNumber:       blockNumber.Uint64(),
+			Status:            uint64(i % 2),
+			GasUsed:           21000,
+			EffectiveGasPrice: "10",
+			GasCost:           "210000",
+			Logs: []types.ReceiptLog{{
+				Address: to.Hex(),
+				Topics:  []string{common.HexToHash("0x01").Hex()},
+				Data:    "0x02",
+			}},
+			Timestamp: int64(epoch) * core.EpochLength,
+		})
+	}
+
+	got, err := utils.ReadTransactionReceipts(from.Hex(), 10)
+	if err != nil {
+		t.Fatalf("ReadTransactionReceipts() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, wantRecords[1:]) {
+		t.Errorf("ReadTransactionReceipts() got = %v, want %v", got, wantRecords[1:])
+	}
+	got, err = utils.ReadTransactionReceipts(to.Hex(), 0)
+	if err != nil || len(got) != 0 {
+		t.Errorf("ReadTransactionReceipts() got = %v, %v for an address without transactions, want no records", got, err)
+	}
+}
+
+func TestGetEffectiveGasPrice(t *testing.T) {
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	tests := []struct {
+		name    string
+		txn     *Types.Transaction
+		baseFee *big.Int
+		want    *big.Int
+	}{
+		{
+			name:    "Test 1: When the transaction is a legacy transaction",
+			txn:     Types.NewTx(&Types.LegacyTx{To: &to, GasPrice: big.NewInt(30)}),
+			baseFee: big.NewInt(10),
+			want:    big.NewInt(30),
+		},
+		{
+			name:    "Test 2: When the tip and the base fee are below the fee cap",
+			txn:     Types.NewTx(&Types.DynamicFeeTx{To: &to, GasTipCap: big.NewInt(2), GasFeeCap: big.NewInt(30)}),
+			baseFee: big.NewInt(10),
+			want:    big.NewInt(12),
+		},
+		{
+			name:    "Test 3: When the tip and the base fee are above the fee cap",
+			txn:     Types.NewTx(&Types.DynamicFeeTx{To: &to, GasTipCap: big.NewInt(5), GasFeeCap: big.NewInt(12)}),
+			baseFee: big.NewInt(10),
+			want:    big.NewInt(12),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getEffectiveGasPrice(tt.txn, tt.baseFee); got.Cmp(tt.want) != 0 {
+				t.Errorf("getEffectiveGasPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
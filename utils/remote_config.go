@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"razor/core/types"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+//Maximum size of the remote configuration and its signature which is read
+const remoteConfigMaxSize = 1 << 20
+
+var remoteConfigClient = &http.Client{Timeout: 30 * time.Second}
+
+//This function returns the HTTPS url of the remote configuration, s3://bucket/key urls are converted to the url of the S3 object
+func GetRemoteConfigUrl(rawUrl string) (string, error) {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", err
+	}
+	switch parsedUrl.Scheme {
+	case "https":
+		return parsedUrl.String(), nil
+	case "s3":
+		if parsedUrl.Host == "" || strings.Trim(parsedUrl.Path, "/") == "" {
+			return "", errors.New("s3 url of remote config should be of the form s3://bucket/key")
+		}
+		return "https://" + parsedUrl.Host + ".s3.amazonaws.com/" + strings.TrimPrefix(parsedUrl.Path, "/"), nil
+	default:
+		return "", fmt.Errorf("scheme %s of remote config url is not supported, only https and s3 are supported", parsedUrl.Scheme)
+	}
+}
+
+//This function fetches the remote configuration and its signature from <url>.sig and verifies that it is signed by the signer
+//The signature is an Ethereum signed message signature of the configuration file as created by personal_sign
+func FetchRemoteConfig(remoteConfig types.RemoteConfig) (map[string]interface{}, error) {
+	configUrl, err := GetRemoteConfigUrl(remoteConfig.Url)
+	if err != nil {
+		return nil, err
+	}
+	configData, err := fetchRemoteConfigFile(configUrl)
+	if err != nil {
+		return nil, err
+	}
+	signatureData, err := fetchRemoteConfigFile(configUrl + ".sig")
+	if err != nil {
+		return nil, errors.New("error in fetching signature of remote config: " + err.Error())
+	}
+	signature, err := hexutil.Decode(string(bytes.TrimSpace(signatureData)))
+	if err != nil {
+		return nil, errors.New("invalid signature of remote config: " + err.Error())
+	}
+	if len(signature) == 65 && signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	signer, err := EcRecover(configData, signature)
+	if err != nil {
+		return nil, errors.New("error in verifying signature of remote config: " + err.Error())
+	}
+	if signer != common.HexToAddress(remoteConfig.Signer) {
+		return nil, fmt.Errorf("remote config is signed by %s and not by the signer %s", signer.Hex(), remoteConfig.Signer)
+	}
+
+	var values map[string]interface{}
+	err = json.Unmarshal(configData, &values)
+	if err != nil {
+		return nil, errors.New("invalid remote config: " + err.Error())
+	}
+	return values, nil
+}
+
+//This function fetches a file of the remote configuration
+func fetchRemoteConfigFile(fileUrl string) ([]byte, error) {
+	response, err := remoteConfigClient.Get(fileUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request to %s failed with status %s", fileUrl, response.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(response.Body, remoteConfigMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > remoteConfigMaxSize {
+		return nil, fmt.Errorf("file at %s is larger than %d bytes", fileUrl, remoteConfigMaxSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"net/http"
+	"net/http/httptest"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestGetRemoteConfigUrl(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawUrl  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When url is https",
+			rawUrl:  "https://example.com/razor/config.json",
+			want:    "https://example.com/razor/config.json",
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When url is s3",
+			rawUrl:  "s3://razor-fleet/configs/config.json",
+			want:    "https://razor-fleet.s3.amazonaws.com/configs/config.json",
+			wantErr: false,
+		},
+		{
+			name:    "Test 3: When s3 url doesn't have a key",
+			rawUrl:  "s3://razor-fleet",
+			wantErr: true,
+		},
+		{
+			name:    "Test 4: When url is http",
+			rawUrl:  "http://example.com/razor/config.json",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetRemoteConfigUrl(tt.rawUrl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetRemoteConfigUrl() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GetRemoteConfigUrl() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchRemoteConfig(t *testing.T) {
+	signerKey, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(signerKey.PublicKey).Hex()
+
+	configData := []byte(`{"gasmultiplier":1.5,"buffer":20}`)
+	sign := func(data []byte, key *ecdsa.PrivateKey) string {
+		signature, _ := crypto.Sign(SignHash(data), key)
+		signature[64] += 27
+		return hexutil.Encode(signature)
+	}
+
+	files := map[string]string{
+		"/config.json":         string(configData),
+		"/config.json.sig":     sign(configData, signerKey),
+		"/other.json":          string(configData),
+		"/other.json.sig":      sign(configData, otherKey),
+		"/unsigned.json":       string(configData),
+		"/invalid.json":        "not json",
+		"/invalid.json.sig":    sign([]byte("not json"), signerKey),
+		"/invalidSig.json":     string(configData),
+		"/invalidSig.json.sig": "not a signature",
+	}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(data))
+	}))
+	defer server.Close()
+
+	client := remoteConfigClient
+	remoteConfigClient = server.Client()
+	defer func() { remoteConfigClient = client }()
+
+	tests := []struct {
+		name    string
+		path    string
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "Test 1: When remote config is signed by the signer",
+			path:    "/config.json",
+			want:    map[string]interface{}{"gasmultiplier": 1.5, "buffer": float64(20)},
+			wantErr: false,
+		},
+		{
+			name:    "Test 2: When remote config is signed by another key",
+			path:    "/other.json",
+			wantErr: true,
+		},
+		{
+			name:    "Test 3: When remote config doesn't have a signature",
+			path:    "/unsigned.json",
+			wantErr: true,
+		},
+		{
+			name:    "Test 4: When remote config is not JSON",
+			path:    "/invalid.json",
+			wantErr: true,
+		},
+		{
+			name:    "Test 5: When signature is invalid",
+			path:    "/invalidSig.json",
+			wantErr: true,
+		},
+		{
+			name:    "Test 6: When remote config doesn't exist",
+			path:    "/missing.json",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FetchRemoteConfig(types.RemoteConfig{Url: server.URL + tt.path, Signer: signer})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FetchRemoteConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FetchRemoteConfig() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+//JSON-RPC error code returned by a signer which doesn't have the method
+const methodNotFoundErrorCode = -32601
+
+var (
+	remoteSignerUrl   string
+	remoteSignerMutex sync.RWMutex
+)
+
+//remoteSignerTransaction is the unsigned transaction sent to the external signer
+type remoteSignerTransaction struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to,omitempty"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+	Nonce    hexutil.Uint64  `json:"nonce"`
+	ChainId  *hexutil.Big    `json:"chainId"`
+}
+
+//This function sets the url of the external signer which signs the transactions and the secrets, the local keystore is used if it is empty
+func SetRemoteSigner(signerUrl string) {
+	remoteSignerMutex.Lock()
+	defer remoteSignerMutex.Unlock()
+	remoteSignerUrl = signerUrl
+}
+
+func getRemoteSignerUrl() string {
+	remoteSignerMutex.RLock()
+	defer remoteSignerMutex.RUnlock()
+	return remoteSignerUrl
+}
+
+//This function returns true if the transactions and the secrets are signed by an external signer
+func IsRemoteSignerEnabled() bool {
+	return getRemoteSignerUrl() != ""
+}
+
+//This function returns the transactor of the address whose transactions are signed by the external signer
+func getRemoteTransactor(signerUrl string, address common.Address, chainId *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: address,
+		Signer: func(from common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+			return signTransactionRemotely(signerUrl, chainId, from, txn)
+		},
+		Context: context.Background(),
+	}
+}
+
+//This function signs the transaction with the external signer
+//The signed transaction is only accepted if it is the transaction sent to the signer and is signed by the address
+func signTransactionRemotely(signerUrl string, chainId *big.Int, from common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+	args := remoteSignerTransaction{
+		From:     from,
+		To:       txn.To(),
+		Gas:      hexutil.Uint64(txn.Gas()),
+		GasPrice: (*hexutil.Big)(txn.GasPrice()),
+		Value:    (*hexutil.Big)(txn.Value()),
+		Data:     txn.Data(),
+		Nonce:    hexutil.Uint64(txn.Nonce()),
+		ChainId:  (*hexutil.Big)(chainId),
+	}
+	// web3signer signs the transactions with eth_signTransaction and clef with account_signTransaction
+	var result json.RawMessage
+	err := callRemoteSigner(signerUrl, &result, "eth_signTransaction", args)
+	if isMethodNotFound(err) {
+		err = callRemoteSigner(signerUrl, &result, "account_signTransaction", args)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(result, &raw); err != nil {
+		// clef returns the raw transaction along with its decoded fields
+		var signed struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(result, &signed); err != nil {
+			return nil, err
+		}
+		raw = signed.Raw
+	}
+	signedTxn := new(Types.Transaction)
+	if err := signedTxn.UnmarshalBinary(raw); err != nil {
+		return nil, err
+	}
+
+	signer := Types.LatestSignerForChainID(chainId)
+	if signer.Hash(signedTxn) != signer.Hash(txn) {
+		return nil, errors.New("transaction signed by the external signer is not the transaction sent to it")
+	}
+	sender, err := Types.Sender(signer, signedTxn)
+	if err != nil {
+		return nil, err
+	}
+	if sender != from {
+		return nil, fmt.Errorf("transaction is signed by %s instead of %s", sender.Hex(), from.Hex())
+	}
+	return signedTxn, nil
+}
+
+//This function signs the data as a personal message with the external signer, as done with the local keystore the recovery id of the signature is 0 or 1
+func SignDataRemotely(address string, data []byte) ([]byte, error) {
+	signerUrl := getRemoteSignerUrl()
+	if signerUrl == "" {
+		return nil, errors.New("external signer is not set")
+	}
+	// web3signer signs the personal messages with eth_sign and clef with account_signData
+	var signature hexutil.Bytes
+	err := callRemoteSigner(signerUrl, &signature, "eth_sign", common.HexToAddress(address), hexutil.Bytes(data))
+	if isMethodNotFound(err) {
+		err = callRemoteSigner(signerUrl, &signature, "account_signData", "text/plain", common.HexToAddress(address), hexutil.Bytes(data))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("signature of the external signer must be 65 bytes long, got %d bytes", len(signature))
+	}
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+	return signature, nil
+}
+
+func callRemoteSigner(signerUrl string, result interface{}, method string, args ...interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(core.RemoteSignerTimeout)*time.Second)
+	defer cancel()
+	client, err := rpc.DialContext(ctx, signerUrl)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.CallContext(ctx, result, method, args...)
+}
+
+func isMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundErrorCode
+}
@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+//testSigner signs the requests of the node as an external signer would
+type testSigner struct {
+	key         *ecdsa.PrivateKey
+	chainId     *big.Int
+	nonceOffset uint64
+	err         error
+}
+
+func (s *testSigner) signTransaction(args remoteSignerTransaction) (*Types.Transaction, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	txn := Types.NewTransaction(uint64(args.Nonce)+s.nonceOffset, *args.To, args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), args.Data)
+	return Types.SignTx(txn, Types.LatestSignerForChainID(s.chainId), s.key)
+}
+
+func (s *testSigner) signData(data hexutil.Bytes) (hexutil.Bytes, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	signature, err := crypto.Sign(SignHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+//testWeb3Signer serves the eth namespace of web3signer
+type testWeb3Signer struct {
+	*testSigner
+}
+
+func (s testWeb3Signer) SignTransaction(args remoteSignerTransaction) (hexutil.Bytes, error) {
+	txn, err := s.signTransaction(args)
+	if err != nil {
+		return nil, err
+	}
+	return txn.MarshalBinary()
+}
+
+func (s testWeb3Signer) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.signData(data)
+}
+
+//testClefSigner serves the account namespace of clef
+type testClefSigner struct {
+	*testSigner
+}
+
+type testClefSignTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *Types.Transaction `json:"tx"`
+}
+
+func (s testClefSigner) SignTransaction(args remoteSignerTransaction) (*testClefSignTransactionResult, error) {
+	txn, err := s.signTransaction(args)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := txn.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &testClefSignTransactionResult{Raw: raw, Tx: txn}, nil
+}
+
+func (s testClefSigner) SignData(contentType string, address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return s.signData(data)
+}
+
+func startTestSigner(t *testing.T, namespace string, signer *testSigner) string {
+	server := rpc.NewServer()
+	var err error
+	if namespace == "account" {
+		err = server.RegisterName(namespace, testClefSigner{signer})
+	} else {
+		err = server.RegisterName(namespace, testWeb3Signer{signer})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func TestSignTransactionRemotely(t *testing.T) {
+	chainId := big.NewInt(0x109B4597)
+	key, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	txn := Types.NewTransaction(7, common.HexToAddress("0x000000000000000000000000000000000000dea1"), big.NewInt(0), 100000, big.NewInt(1e9), []byte{0x01, 0x02})
+
+	tests := []struct {
+		name      string
+		namespace string
+		signer    *testSigner
+		wantErr   bool
+	}{
+		{
+			name:      "Test 1: When the transaction is signed by web3signer",
+			namespace: "eth",
+			signer:    &testSigner{key: key, chainId: chainId},
+			wantErr:   false,
+		},
+		{
+			name:      "Test 2: When the transaction is signed by clef",
+			namespace: "account",
+			signer:    &testSigner{key: key, chainId: chainId},
+			wantErr:   false,
+		},
+		{
+			name:      "Test 3: When the transaction is signed by another address",
+			namespace: "eth",
+			signer:    &testSigner{key: otherKey, chainId: chainId},
+			wantErr:   true,
+		},
+		{
+			name:      "Test 4: When the signer changes the transaction",
+			namespace: "eth",
+			signer:    &testSigner{key: key, chainId: chainId, nonceOffset: 1},
+			wantErr:   true,
+		},
+		{
+			name:      "Test 5: When the signer signs for another chain",
+			namespace: "account",
+			signer:    &testSigner{key: key, chainId: big.NewInt(1)},
+			wantErr:   true,
+		},
+		{
+			name:      "Test 6: When the signer rejects the transaction",
+			namespace: "eth",
+			signer:    &testSigner{key: key, chainId: chainId, err: errors.New("request denied")},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signerUrl := startTestSigner(t, tt.namespace, tt.signer)
+			transactor := getRemoteTransactor(signerUrl, from, chainId)
+			if transactor.From != from {
+				t.Fatalf("Transactor is of %s, want %s", transactor.From.Hex(), from.Hex())
+			}
+
+			got, err := transactor.Signer(from, txn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Signer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			sender, err := Types.Sender(Types.LatestSignerForChainID(chainId), got)
+			if err != nil || sender != from {
+				t.Errorf("Signed transaction is from %s, want %s", sender.Hex(), from.Hex())
+			}
+			if got.Nonce() != txn.Nonce() || string(got.Data()) != string(txn.Data()) {
+				t.Errorf("Signed transaction doesn't match the transaction")
+			}
+		})
+	}
+}
+
+func TestSignDataRemotely(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	hash := crypto.Keccak256([]byte("razororacle"))
+	localSignature, _ := crypto.Sign(SignHash(hash), key)
+
+	tests := []struct {
+		name      string
+		namespace string
+		signer    *testSigner
+		noSigner  bool
+		wantErr   bool
+	}{
+		{
+			name:      "Test 1: When the data is signed by web3signer",
+			namespace: "eth",
+			signer:    &testSigner{key: key},
+			wantErr:   false,
+		},
+		{
+			name:      "Test 2: When the data is signed by clef",
+			namespace: "account",
+			signer:    &testSigner{key: key},
+			wantErr:   false,
+		},
+		{
+			name:      "Test 3: When the signer rejects the request",
+			namespace: "eth",
+			signer:    &testSigner{key: key, err: errors.New("request denied")},
+			wantErr:   true,
+		},
+		{
+			name:     "Test 4: When the external signer is not set",
+			noSigner: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer SetRemoteSigner("")
+			if !tt.noSigner {
+				SetRemoteSigner(startTestSigner(t, tt.namespace, tt.signer))
+			}
+
+			got, err := SignDataRemotely(address.Hex(), hash)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SignDataRemotely() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if hexutil.Encode(got) != hexutil.Encode(localSignature) {
+				t.Errorf("SignDataRemotely() got = %x, want the signature of the local key %x", got, localSignature)
+			}
+			recoveredAddress, err := EcRecover(hash, got)
+			if err != nil || recoveredAddress != address {
+				t.Errorf("Signature is recovered to %s, want %s", recoveredAddress.Hex(), address.Hex())
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"razor/core"
+	"razor/core/types"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	responseArchivePrefix = "responseArchive/"
+	responseBodyPrefix    = "responseBody/"
+)
+
+var (
+	responseArchiveEnabled bool
+	//Number of epochs whose responses are kept in the archive
+	responseArchiveRetention uint32
+	responseArchiveMutex     sync.Mutex
+)
+
+//This function enables the archive of the responses used for the commits, the responses older than the retention days are pruned
+func EnableResponseArchive(retentionDays uint32) error {
+	if retentionDays == 0 {
+		return errors.New("retention of the response archive should be at least a day")
+	}
+	responseArchiveMutex.Lock()
+	defer responseArchiveMutex.Unlock()
+	responseArchiveEnabled = true
+	responseArchiveRetention = uint32(int64(retentionDays) * 24 * 60 * 60 / core.EpochLength)
+	return nil
+}
+
+//This function returns if the responses used for the commits are archived
+func IsResponseArchiveEnabled() bool {
+	responseArchiveMutex.Lock()
+	defer responseArchiveMutex.Unlock()
+	return responseArchiveEnabled
+}
+
+//This function returns the key of the archived responses of the commit of the address in the epoch, the entries of an address are ordered by epoch
+func getResponseArchiveKey(address string, epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s/%010d", responseArchivePrefix, strings.ToLower(address), epoch))
+}
+
+//This function returns the key of the archived response body with the given hash
+func getResponseBodyKey(address string, bodyHash string) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s", responseBodyPrefix, strings.ToLower(address), bodyHash))
+}
+
+//This function archives the responses of the sources used for the commit of the epoch in the state store
+//The bodies are stored compressed by the hash of their content, so that a response which doesn't change between epochs is stored once
+//The entries older than the retention are pruned along with the bodies which only they refer to
+func (*UtilsStruct) SaveResponseArchive(address string, epoch uint32, collections []types.AttestedCollection) error {
+	responseArchiveMutex.Lock()
+	retention := responseArchiveRetention
+	responseArchiveMutex.Unlock()
+
+	entry := types.ResponseArchiveEntry{Epoch: epoch, Collections: []types.ArchivedCollection{}}
+	bodies := make(map[string][]byte)
+	for _, collection := range collections {
+		archivedCollection := types.ArchivedCollection{CollectionId: collection.CollectionId, Value: collection.Value, Responses: []types.ArchivedResponse{}}
+		for _, source := range collection.Sources {
+			bodyHash := hexutil.Encode(crypto.Keccak256(source.Body))
+			bodies[bodyHash] = source.Body
+			archivedCollection.Responses = append(archivedCollection.Responses, types.ArchivedResponse{
+				JobId:     source.JobId,
+				JobName:   source.JobName,
+				Url:       source.Url,
+				Selector:  source.Selector,
+				BodyHash:  bodyHash,
+				BodySize:  len(source.Body),
+				Value:     source.Value,
+				FetchedAt: source.FetchedAt,
+			})
+		}
+		entry.Collections = append(entry.Collections, archivedCollection)
+	}
+	entryData, err := JsonInterface.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	entryData, err = EncryptStateData(entryData)
+	if err != nil {
+		return err
+	}
+
+	return withStateDB(func(db *leveldb.DB) error {
+		batch := new(leveldb.Batch)
+		batch.Put(getResponseArchiveKey(address, epoch), entryData)
+		for bodyHash, body := range bodies {
+			has, err := db.Has(getResponseBodyKey(address, bodyHash), nil)
+			if err != nil {
+				return err
+			}
+			if has {
+				continue
+			}
+			bodyData, err := compressStateData(body)
+			if err != nil {
+				return err
+			}
+			bodyData, err = EncryptStateData(bodyData)
+			if err != nil {
+				return err
+			}
+			batch.Put(getResponseBodyKey(address, bodyHash), bodyData)
+		}
+
+		// The entries out of the retention are dropped, the bodies are kept while a retained entry refers to them
+		referencedBodies := make(map[string]bool)
+		for bodyHash := range bodies {
+			referencedBodies[bodyHash] = true
+		}
+		prefix := responseArchivePrefix + strings.ToLower(address) + "/"
+		iterator := db.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+		defer iterator.Release()
+		for iterator.Next() {
+			var entryEpoch uint32
+			if _, err := fmt.Sscanf(string(iterator.Key()[len(prefix):]), "%d", &entryEpoch); err != nil {
+				return err
+			}
+			if entryEpoch == epoch {
+				continue
+			}
+			if entryEpoch+retention <= epoch {
+				batch.Delete(append([]byte(nil), iterator.Key()...))
+				continue
+			}
+			archivedEntry, err := decodeResponseArchiveEntry(iterator.Value())
+			if err != nil {
+				return err
+			}
+			for _, collection := range archivedEntry.Collections {
+				for _, response := range collection.Responses {
+					referencedBodies[response.BodyHash] = true
+				}
+			}
+		}
+		if err := iterator.Error(); err != nil {
+			return err
+		}
+		bodyPrefix := responseBodyPrefix + strings.ToLower(address) + "/"
+		bodyIterator := db.NewIterator(util.BytesPrefix([]byte(bodyPrefix)), nil)
+		defer bodyIterator.Release()
+		for bodyIterator.Next() {
+			if !referencedBodies[string(bodyIterator.Key()[len(bodyPrefix):])] {
+				batch.Delete(append([]byte(nil), bodyIterator.Key()...))
+			}
+		}
+		if err := bodyIterator.Error(); err != nil {
+			return err
+		}
+		return db.Write(batch, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function decrypts and decodes the archived responses of a commit
+func decodeResponseArchiveEntry(data []byte) (types.ResponseArchiveEntry, error) {
+	var entry types.ResponseArchiveEntry
+	data, err := DecryptStateData(data)
+	if err != nil {
+		return entry, err
+	}
+	err = JsonInterface.Unmarshal(data, &entry)
+	return entry, err
+}
+
+//This function reads the archived responses of the commit of the address in the epoch, the bodies are read separately by their hash
+func (*UtilsStruct) ReadResponseArchive(address string, epoch uint32) (types.ResponseArchiveEntry, error) {
+	var entry types.ResponseArchiveEntry
+	err := withStateDB(func(db *leveldb.DB) error {
+		data, err := db.Get(getResponseArchiveKey(address, epoch), nil)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return fmt.Errorf("no archived responses of epoch %d for %s, the responses are archived while voting with --archiveResponses", epoch, address)
+		}
+		if err != nil {
+			return err
+		}
+		entry, err = decodeResponseArchiveEntry(data)
+		return err
+	})
+	return entry, err
+}
+
+//This function reads the archived response body with the given hash and checks that its content still has the hash
+func (*UtilsStruct) ReadArchivedResponseBody(address string, bodyHash string) ([]byte, error) {
+	var body []byte
+	err := withStateDB(func(db *leveldb.DB) error {
+		data, err := db.Get(getResponseBodyKey(address, bodyHash), nil)
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return fmt.Errorf("archived response body %s is not found", bodyHash)
+		}
+		if err != nil {
+			return err
+		}
+		data, err = DecryptStateData(data)
+		if err != nil {
+			return err
+		}
+		body, err = decompressStateData(data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hexutil.Encode(crypto.Keccak256(body)) != bodyHash {
+		return nil, fmt.Errorf("archived response body doesn't match its hash %s", bodyHash)
+	}
+	return body, nil
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core/types"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestResponseArchive(t *testing.T) {
+	StartRazor(OptionsPackageStruct{JsonInterface: JsonStruct{}})
+	setStateDBPath(t, nil)
+	defer func() { responseArchiveEnabled, responseArchiveRetention = false, 0 }()
+	if err := EnableResponseArchive(0); err == nil {
+		t.Error("EnableResponseArchive() error = nil, want an error for a retention of zero days")
+	}
+	// A day is 72 epochs
+	if err := EnableResponseArchive(1); err != nil {
+		t.Fatalf("EnableResponseArchive() error = %v", err)
+	}
+	utils := &UtilsStruct{}
+	address := "0x000000000000000000000000000000000000dEaD"
+
+	collections := func(body string, value int64) []types.AttestedCollection {
+		return []types.AttestedCollection{
+			{CollectionId: 1, Value: big.NewInt(value), Sources: []types.AttestedSource{{JobId: 1, JobName: "ethusd_gemini", Url: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last", Value: big.NewInt(value), Body: []byte(body)}}},
+			{CollectionId: 2, Value: big.NewInt(900), Sources: []types.AttestedSource{}},
+		}
+	}
+	stableBody, changedBody := `{"last":"1000"}`, "{\"last\":\"2000\xff\"}"
+	hash := func(body string) string { return hexutil.Encode(crypto.Keccak256([]byte(body))) }
+
+	if err := utils.SaveResponseArchive(address, 10, collections(stableBody, 1000)); err != nil {
+		t.Fatalf("SaveResponseArchive() error = %v", err)
+	}
+	if err := utils.SaveResponseArchive(address, 11, collections(changedBody, 2000)); err != nil {
+		t.Fatalf("SaveResponseArchive() error = %v", err)
+	}
+	entry, err := utils.ReadResponseArchive("0x000000000000000000000000000000000000dead", 11)
+	if err != nil {
+		t.Fatalf("ReadResponseArchive() error = %v", err)
+	}
+	want := types.ResponseArchiveEntry{Epoch: 11, Collections: []types.ArchivedCollection{
+		{CollectionId: 1, Value: big.NewInt(2000), Responses: []types.ArchivedResponse{{JobId: 1, JobName: "ethusd_gemini", Url: "https://api.gemini.com/v1/pubticker/ethusd", Selector: "last", BodyHash: hash(changedBody), BodySize: len(changedBody), Value: big.NewInt(2000)}}},
+		{CollectionId: 2, Value: big.NewInt(900), Responses: []types.ArchivedResponse{}},
+	}}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("ReadResponseArchive() got = %+v, want %+v", entry, want)
+	}
+	body, err := utils.ReadArchivedResponseBody(address, hash(changedBody))
+	if err != nil || string(body) != changedBody {
+		t.Errorf("ReadArchivedResponseBody() got = %q, error = %v, want %q", body, err, changedBody)
+	}
+	if _, err := utils.ReadResponseArchive(address, 12); err == nil {
+		t.Error("ReadResponseArchive() error = nil, want an error for an epoch which isn't archived")
+	}
+
+	// The epoch 10 is out of the retention at epoch 82, its body is kept as the epoch 82 has the same response
+	if err := utils.SaveResponseArchive(address, 82, collections(stableBody, 1000)); err != nil {
+		t.Fatalf("SaveResponseArchive() error = %v", err)
+	}
+	if _, err := utils.ReadResponseArchive(address, 10); err == nil {
+		t.Error("ReadResponseArchive() error = nil, want the epoch out of the retention to be pruned")
+	}
+	if body, err := utils.ReadArchivedResponseBody(address, hash(stableBody)); err != nil || string(body) != stableBody {
+		t.Errorf("ReadArchivedResponseBody() got = %q, error = %v, want the body which is still referred to", body, err)
+	}
+
+	// The body of the epoch 11 is pruned along with it
+	if err := utils.SaveResponseArchive(address, 83, collections(stableBody, 1000)); err != nil {
+		t.Fatalf("SaveResponseArchive() error = %v", err)
+	}
+	if _, err := utils.ReadResponseArchive(address, 11); err == nil {
+		t.Error("ReadResponseArchive() error = nil, want the epoch out of the retention to be pruned")
+	}
+	if _, err := utils.ReadArchivedResponseBody(address, hash(changedBody)); err == nil {
+		t.Error("ReadArchivedResponseBody() error = nil, want the body which isn't referred to anymore to be pruned")
+	}
+	if _, err := utils.ReadResponseArchive(address, 82); err != nil {
+		t.Errorf("ReadResponseArchive() error = %v, want the epoch in the retention to be kept", err)
+	}
+}
@@ -0,0 +1,189 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/pkg/bindings"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const executionReverted = "execution reverted: "
+
+//The descriptions of the codes of the Panic(uint256) errors which solidity reverts with on failed assertions and runtime errors
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "conversion to an invalid enum value",
+	0x22: "access to an incorrectly encoded storage byte array",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "too much memory allocated",
+	0x51: "call to a zero initialized internal function",
+}
+
+var panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+//The ABIs of the contracts which the node sends transactions to
+var contractABIs = []string{
+	bindings.BlockManagerABI,
+	bindings.CollectionManagerABI,
+	bindings.RAZORABI,
+	bindings.StakeManagerABI,
+	bindings.VoteManagerABI,
+}
+
+//customError is an error declared in the ABI of a contract, which a contract reverts with as the selector of its signature followed by its encoded inputs
+type customError struct {
+	Type   string        `json:"type"`
+	Name   string        `json:"name"`
+	Inputs abi.Arguments `json:"inputs"`
+}
+
+var (
+	customErrors     map[string]customError
+	customErrorsOnce sync.Once
+)
+
+//This function returns the custom errors of the contracts by the selectors of their signatures, the ABIs are parsed once when a revert is decoded for the first time
+func getCustomErrors() map[string]customError {
+	customErrorsOnce.Do(func() {
+		customErrors = make(map[string]customError)
+		for _, contractAbi := range contractABIs {
+			var entries []customError
+			if err := json.Unmarshal([]byte(contractAbi), &entries); err != nil {
+				log.Debug("Error in parsing custom errors of contract ABI: ", err)
+				continue
+			}
+			for _, entry := range entries {
+				if entry.Type != "error" {
+					continue
+				}
+				customErrors[string(customErrorSelector(entry))] = entry
+			}
+		}
+	})
+	return customErrors
+}
+
+//This function returns the selector of the signature of the custom error
+func customErrorSelector(entry customError) []byte {
+	inputTypes := make([]string, len(entry.Inputs))
+	for i, input := range entry.Inputs {
+		inputTypes[i] = input.Type.String()
+	}
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s(%s)", entry.Name, strings.Join(inputTypes, ","))))[:4]
+}
+
+//This function decodes the data which a call reverts with into a human readable cause
+//The data is decoded as the revert string of require and revert, as the panic code of a failed assertion or runtime error or as a custom error of the contracts
+func DecodeRevertData(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	if reason, ok := decodeRevertData(data); ok {
+		return reason
+	}
+	return "unknown error " + hexutil.Encode(data)
+}
+
+//This function returns the decoded cause of the revert data, it returns false if the data isn't a revert string, a panic or a custom error of the contracts
+func decodeRevertData(data []byte) (string, bool) {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+	if len(data) < 4 {
+		return "", false
+	}
+	selector := data[:4]
+	if string(selector) == string(panicSelector) && len(data) == 36 {
+		code := new(big.Int).SetBytes(data[4:])
+		if reason, ok := panicReasons[code.Uint64()]; code.IsUint64() && ok {
+			return fmt.Sprintf("panic: %s (0x%x)", reason, code), true
+		}
+		return fmt.Sprintf("panic: unknown code 0x%x", code), true
+	}
+	entry, ok := getCustomErrors()[string(selector)]
+	if !ok {
+		return "", false
+	}
+	values, err := entry.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return entry.Name, true
+	}
+	inputs := make([]string, len(values))
+	for i, value := range values {
+		inputs[i] = fmt.Sprint(value)
+	}
+	return fmt.Sprintf("%s(%s)", entry.Name, strings.Join(inputs, ", ")), true
+}
+
+//This function returns the reason with which a transaction reverts from the error of its estimation or of its sending, it is empty if the error isn't a revert with a reason
+//The reason is decoded from the data of the error if the provider returns it, it is read from the message of the error otherwise
+func GetRevertReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	var dataError rpc.DataError
+	if errors.As(err, &dataError) {
+		if data, ok := dataError.ErrorData().(string); ok {
+			if reason, ok := decodeRevertData(common.FromHex(data)); ok {
+				return reason
+			}
+		}
+	}
+	message := err.Error()
+	if index := strings.Index(message, executionReverted); index >= 0 {
+		return strings.TrimSpace(message[index+len(executionReverted):])
+	}
+	return ""
+}
+
+//This function returns the cause of the revert of a mined transaction, it is empty if the cause can't be found
+//The transaction is executed again with eth_call at the block it was mined in, as the revert data of a mined transaction isn't kept in its receipt
+func (*UtilsStruct) GetTransactionRevertReason(client *ethclient.Client, _txHash string) string {
+	txHash := common.HexToHash(_txHash)
+	tx, _, err := ClientInterface.TransactionByHash(client, context.Background(), txHash)
+	if err != nil {
+		log.Debug("Error in getting transaction to find revert reason: ", err)
+		return ""
+	}
+	receipt, err := ClientInterface.TransactionReceipt(client, context.Background(), txHash)
+	if err != nil {
+		log.Debug("Error in getting transaction receipt to find revert reason: ", err)
+		return ""
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Debug("Error in getting sender of transaction to find revert reason: ", err)
+		return ""
+	}
+	// The gas price isn't set so that the call isn't rejected for the balance of the sender at the block
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	data, err := ClientInterface.CallContract(client, context.Background(), msg, receipt.BlockNumber)
+	if err != nil {
+		return GetRevertReason(err)
+	}
+	// The call can succeed at the block if the transaction reverted for the state changed by an earlier transaction of the block
+	return DecodeRevertData(data)
+}
@@ -0,0 +1,236 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+type revertDataError struct {
+	message string
+	data    interface{}
+}
+
+func (err revertDataError) Error() string {
+	return err.message
+}
+
+func (err revertDataError) ErrorData() interface{} {
+	return err.data
+}
+
+func TestGetRevertReason(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	encodedReason, err := abi.Arguments{{Type: stringType}}.Pack("Block already confirmed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revertData := hexutil.Encode(append(crypto.Keccak256([]byte("Error(string)"))[:4], encodedReason...))
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "Test 1: When the reason is in the data of the error",
+			err:  revertDataError{message: "execution reverted", data: revertData},
+			want: "Block already confirmed",
+		},
+		{
+			name: "Test 2: When the reason is in the message of the error",
+			err:  errors.New("execution reverted: Block Proposer mismatches"),
+			want: "Block Proposer mismatches",
+		},
+		{
+			name: "Test 3: When the revert error is wrapped",
+			err:  fmt.Errorf("ClaimBlockReward error: %w", revertDataError{message: "execution reverted", data: revertData}),
+			want: "Block already confirmed",
+		},
+		{
+			name: "Test 4: When the data of the error can't be decoded",
+			err:  revertDataError{message: "execution reverted: incorrect state", data: "0x1234"},
+			want: "incorrect state",
+		},
+		{
+			name: "Test 5: When the error isn't a revert",
+			err:  errors.New("nonce too low"),
+			want: "",
+		},
+		{
+			name: "Test 6: When there is no error",
+			err:  nil,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetRevertReason(tt.err); got != tt.want {
+				t.Errorf("GetRevertReason() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRevertData(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	uint32Type, _ := abi.NewType("uint32", "", nil)
+
+	encodedReason, err := abi.Arguments{{Type: stringType}}.Pack("incorrect state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodedPanicCode, err := abi.Arguments{{Type: uint256Type}}.Pack(big.NewInt(0x11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodedUnknownPanicCode, err := abi.Arguments{{Type: uint256Type}}.Pack(big.NewInt(0x99))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalidEpoch := customError{Type: "error", Name: "InvalidEpoch", Inputs: abi.Arguments{{Name: "epoch", Type: uint32Type}}}
+	invalidEpochSelector := customErrorSelector(invalidEpoch)
+	getCustomErrors()[string(invalidEpochSelector)] = invalidEpoch
+	defer delete(getCustomErrors(), string(invalidEpochSelector))
+	encodedEpoch, err := invalidEpoch.Inputs.Pack(uint32(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "Test 1: When the data is a revert string",
+			data: append(crypto.Keccak256([]byte("Error(string)"))[:4], encodedReason...),
+			want: "incorrect state",
+		},
+		{
+			name: "Test 2: When the data is a panic",
+			data: append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], encodedPanicCode...),
+			want: "panic: arithmetic overflow or underflow (0x11)",
+		},
+		{
+			name: "Test 3: When the data is a panic with an unknown code",
+			data: append(crypto.Keccak256([]byte("Panic(uint256)"))[:4], encodedUnknownPanicCode...),
+			want: "panic: unknown code 0x99",
+		},
+		{
+			name: "Test 4: When the data is a custom error of the contracts",
+			data: append(invalidEpochSelector, encodedEpoch...),
+			want: "InvalidEpoch(5)",
+		},
+		{
+			name: "Test 5: When the data can't be decoded",
+			data: []byte{0x12, 0x34, 0x56, 0x78},
+			want: "unknown error 0x12345678",
+		},
+		{
+			name: "Test 6: When there is no data",
+			data: nil,
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DecodeRevertData(tt.data); got != tt.want {
+				t.Errorf("DecodeRevertData() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetTransactionRevertReason(t *testing.T) {
+	var client *ethclient.Client
+	txHash := "0x0000000000000000000000000000000000000000000000000000000000000001"
+
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	signer := Types.LatestSignerForChainID(big.NewInt(137))
+	tx, err := Types.SignTx(Types.NewTx(&Types.LegacyTx{Nonce: 1, To: &to, Gas: 100000, GasPrice: big.NewInt(1), Data: []byte{0x01}}), signer, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stringType, _ := abi.NewType("string", "", nil)
+	encodedReason, err := abi.Arguments{{Type: stringType}}.Pack("Block already confirmed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], encodedReason...)
+
+	tests := []struct {
+		name       string
+		txErr      error
+		receiptErr error
+		callData   []byte
+		callErr    error
+		want       string
+	}{
+		{
+			name:    "Test 1: When the call at the block of the transaction reverts with a reason",
+			callErr: revertDataError{message: "execution reverted", data: hexutil.Encode(revertData)},
+			want:    "Block already confirmed",
+		},
+		{
+			name:    "Test 2: When the reason is only in the message of the call error",
+			callErr: errors.New("execution reverted: incorrect state"),
+			want:    "incorrect state",
+		},
+		{
+			name:     "Test 3: When the call at the block of the transaction succeeds",
+			callData: []byte{},
+			want:     "",
+		},
+		{
+			name:  "Test 4: When there is an error in getting the transaction",
+			txErr: errors.New("transaction error"),
+			want:  "",
+		},
+		{
+			name:       "Test 5: When there is an error in getting the transaction receipt",
+			receiptErr: errors.New("receipt error"),
+			want:       "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientMock := new(mocks.ClientUtils)
+			utils := StartRazor(OptionsPackageStruct{ClientInterface: clientMock})
+
+			clientMock.On("TransactionByHash", mock.AnythingOfType("*ethclient.Client"), mock.Anything, common.HexToHash(txHash)).Return(tx, false, tt.txErr)
+			clientMock.On("TransactionReceipt", mock.AnythingOfType("*ethclient.Client"), mock.Anything, common.HexToHash(txHash)).Return(&Types.Receipt{BlockNumber: big.NewInt(100)}, tt.receiptErr)
+			clientMock.On("CallContract", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.Anything, big.NewInt(100)).Return(tt.callData, tt.callErr)
+
+			if got := utils.GetTransactionRevertReason(client, txHash); got != tt.want {
+				t.Errorf("GetTransactionRevertReason() = %q, want %q", got, tt.want)
+			}
+			if tt.txErr == nil && tt.receiptErr == nil {
+				clientMock.AssertCalled(t, "CallContract", client, mock.Anything, ethereum.CallMsg{
+					From:  crypto.PubkeyToAddress(privateKey.PublicKey),
+					To:    &to,
+					Gas:   100000,
+					Value: tx.Value(),
+					Data:  []byte{0x01},
+				}, big.NewInt(100))
+			}
+		})
+	}
+}
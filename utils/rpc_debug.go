@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"razor/core/types"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	rpcDebugFilePath string
+	rpcDebugUntil    time.Time
+	rpcDebugMutex    sync.Mutex
+)
+
+//This function starts capturing the requests sent to the RPC provider and their responses in the capture file for the duration
+func EnableRPCDebugCapture(filePath string, duration time.Duration) {
+	rpcDebugMutex.Lock()
+	defer rpcDebugMutex.Unlock()
+	rpcDebugFilePath = filePath
+	rpcDebugUntil = time.Now().Add(duration)
+	log.Warnf("RPC debug capture is enabled for %s, requests and responses are captured in %s", duration, filePath)
+}
+
+//This function returns if the requests are being captured, the capture is stopped once its window is over
+func isRPCDebugCapturing() bool {
+	rpcDebugMutex.Lock()
+	defer rpcDebugMutex.Unlock()
+	if rpcDebugFilePath == "" {
+		return false
+	}
+	if time.Now().After(rpcDebugUntil) {
+		log.Info("RPC debug capture window is over, requests and responses were captured in ", rpcDebugFilePath)
+		rpcDebugFilePath = ""
+		return false
+	}
+	return true
+}
+
+//rpcDebugTransport captures the JSON-RPC requests sent to the RPC provider and their responses while the RPC debug capture is enabled
+//Only the scheme and host of the provider are captured as the path and query often hold the API key, and the headers are never captured
+type rpcDebugTransport struct {
+	base http.RoundTripper
+}
+
+func (t rpcDebugTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if !isRPCDebugCapturing() {
+		return t.base.RoundTrip(request)
+	}
+	var requestBody []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		body, err := io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		requestBody = body
+		request = request.Clone(request.Context())
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	start := time.Now()
+	provider := redactProviderURL(request.URL)
+	rpcDebugEntry := types.RPCDebugEntry{
+		Timestamp: start.Unix(),
+		Provider:  provider,
+		Request:   getRPCDebugJSON(requestBody),
+	}
+	response, err := t.base.RoundTrip(request)
+	if err != nil {
+		rpcDebugEntry.Latency = time.Since(start).Milliseconds()
+		rpcDebugEntry.Error = strings.ReplaceAll(err.Error(), request.URL.String(), provider)
+		saveRPCDebugEntry(rpcDebugEntry)
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	rpcDebugEntry.Latency = time.Since(start).Milliseconds()
+	rpcDebugEntry.StatusCode = response.StatusCode
+	rpcDebugEntry.Response = getRPCDebugJSON(responseBody)
+	if err != nil {
+		rpcDebugEntry.Error = err.Error()
+		saveRPCDebugEntry(rpcDebugEntry)
+		return nil, err
+	}
+	saveRPCDebugEntry(rpcDebugEntry)
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	return response, nil
+}
+
+//This function returns the scheme and host of the provider url without the credentials, path and query
+func redactProviderURL(providerUrl *url.URL) string {
+	return providerUrl.Scheme + "://" + providerUrl.Host
+}
+
+//This function returns the body as JSON, the bodies which are not valid JSON are captured as a string
+func getRPCDebugJSON(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	if json.Valid(body) {
+		return body
+	}
+	data, err := json.Marshal(string(body))
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+//This function appends the entry to the capture file, the errors are only logged as the capture shouldn't fail the requests
+func saveRPCDebugEntry(rpcDebugEntry types.RPCDebugEntry) {
+	rpcDebugMutex.Lock()
+	defer rpcDebugMutex.Unlock()
+	if rpcDebugFilePath == "" {
+		return
+	}
+	data, err := json.Marshal(rpcDebugEntry)
+	if err != nil {
+		log.Error("Error in marshalling RPC debug entry: ", err)
+		return
+	}
+	file, err := os.OpenFile(rpcDebugFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Error("Error in opening RPC debug capture file: ", err)
+		return
+	}
+	defer file.Close()
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		log.Error("Error in writing RPC debug entry: ", err)
+	}
+}
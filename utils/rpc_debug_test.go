@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"razor/core/types"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRPCDebugTransport(t *testing.T) {
+	requestBody := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	responseBody := `{"jsonrpc":"2.0","id":1,"result":"0x10"}`
+
+	tests := []struct {
+		name        string
+		duration    time.Duration
+		wantEntries int
+	}{
+		{
+			name:        "Test 1: When the requests are captured",
+			duration:    time.Minute,
+			wantEntries: 1,
+		},
+		{
+			name:        "Test 2: When the capture window is over",
+			duration:    -time.Second,
+			wantEntries: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var receivedBody string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				data, _ := io.ReadAll(r.Body)
+				receivedBody = string(data)
+				w.Write([]byte(responseBody))
+			}))
+			defer server.Close()
+
+			fileName := filepath.Join(t.TempDir(), "rpc_debug.jsonl")
+			EnableRPCDebugCapture(fileName, tt.duration)
+			defer EnableRPCDebugCapture("", 0)
+
+			client := &http.Client{Transport: rpcDebugTransport{base: http.DefaultTransport}}
+			response, err := client.Post(server.URL+"/v3/secretApiKey", "application/json", strings.NewReader(requestBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := io.ReadAll(response.Body)
+			response.Body.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if receivedBody != requestBody {
+				t.Errorf("Body received by the RPC provider = %v, want %v", receivedBody, requestBody)
+			}
+			if string(data) != responseBody {
+				t.Errorf("Body of the response = %v, want %v", string(data), responseBody)
+			}
+
+			var entries []types.RPCDebugEntry
+			file, err := os.Open(fileName)
+			if err == nil {
+				scanner := bufio.NewScanner(file)
+				for scanner.Scan() {
+					var entry types.RPCDebugEntry
+					if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+						t.Fatal(err)
+					}
+					entries = append(entries, entry)
+				}
+				file.Close()
+			}
+			if len(entries) != tt.wantEntries {
+				t.Fatalf("Number of captured entries = %d, want %d", len(entries), tt.wantEntries)
+			}
+			for _, entry := range entries {
+				if entry.Provider != server.URL {
+					t.Errorf("Captured provider = %v, want %v", entry.Provider, server.URL)
+				}
+				if string(entry.Request) != requestBody || string(entry.Response) != responseBody {
+					t.Errorf("Captured request = %s and response = %s, want %s and %s", entry.Request, entry.Response, requestBody, responseBody)
+				}
+				if entry.StatusCode != http.StatusOK {
+					t.Errorf("Captured status code = %d, want %d", entry.StatusCode, http.StatusOK)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRPCDebugJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "Test 1: When body is JSON",
+			body: `{"result":"0x1"}`,
+			want: `{"result":"0x1"}`,
+		},
+		{
+			name: "Test 2: When body is not JSON",
+			body: "bad gateway",
+			want: `"bad gateway"`,
+		},
+		{
+			name: "Test 3: When body is empty",
+			body: "",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getRPCDebugJSON([]byte(tt.body)); string(got) != tt.want {
+				t.Errorf("getRPCDebugJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"razor/metrics"
+	"time"
+)
+
+//rpcLatencyTransport records the latency of the JSON-RPC requests sent to the RPC provider by their method
+type rpcLatencyTransport struct {
+	base http.RoundTripper
+}
+
+func (t rpcLatencyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	method := "unknown"
+	if request.Body != nil && request.Body != http.NoBody {
+		body, err := io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		method = getRPCMethod(body)
+		request = request.Clone(request.Context())
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	start := time.Now()
+	response, err := t.base.RoundTrip(request)
+	metrics.RPCLatencyMetric.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	return response, err
+}
+
+//This function returns the method of the JSON-RPC request, a batch of requests is recorded as batch
+func getRPCMethod(body []byte) string {
+	trimmedBody := bytes.TrimSpace(body)
+	if len(trimmedBody) > 0 && trimmedBody[0] == '[' {
+		return "batch"
+	}
+	var message struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(trimmedBody, &message); err != nil || message.Method == "" {
+		return "unknown"
+	}
+	return message.Method
+}
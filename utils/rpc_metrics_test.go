@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetRPCMethod(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "Test 1: When body is a JSON-RPC request",
+			body: `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`,
+			want: "eth_blockNumber",
+		},
+		{
+			name: "Test 2: When body is a batch of JSON-RPC requests",
+			body: ` [{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}]`,
+			want: "batch",
+		},
+		{
+			name: "Test 3: When body doesn't have a method",
+			body: `{"jsonrpc":"2.0","id":1}`,
+			want: "unknown",
+		},
+		{
+			name: "Test 4: When body is not JSON",
+			body: "body",
+			want: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getRPCMethod([]byte(tt.body)); got != tt.want {
+				t.Errorf("getRPCMethod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRPCLatencyTransport(t *testing.T) {
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+	var receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		receivedBody = string(data)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: rpcLatencyTransport{base: http.DefaultTransport}}
+	response, err := client.Post(server.URL, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	if receivedBody != body {
+		t.Errorf("Body received by the RPC provider = %v, want %v", receivedBody, body)
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//A session keeps the clients, the password and the unlocked keys in memory while the commands of a script are executed in the same process
+type session struct {
+	clients  map[string]*ethclient.Client
+	password string
+	keys     map[string]sessionKey
+}
+
+type sessionKey struct {
+	password   string
+	privateKey *ecdsa.PrivateKey
+}
+
+var (
+	activeSession *session
+	sessionMutex  sync.Mutex
+)
+
+//This function starts a session in which the clients are connected, the password is prompted and the keystores are unlocked only once
+func StartSession() {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	activeSession = &session{
+		clients: make(map[string]*ethclient.Client),
+		keys:    make(map[string]sessionKey),
+	}
+}
+
+//This function ends the session, closes its clients and drops its password and keys
+func EndSession() {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession == nil {
+		return
+	}
+	for _, client := range activeSession.clients {
+		if client != nil {
+			client.Close()
+		}
+	}
+	activeSession = nil
+}
+
+//This function returns true if the commands are executed in a session
+func IsSessionActive() bool {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	return activeSession != nil
+}
+
+//This function sets the password which is returned instead of prompting for it during the session
+func SetSessionPassword(password string) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession != nil {
+		activeSession.password = password
+	}
+}
+
+func getSessionPassword() (string, bool) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession == nil || activeSession.password == "" {
+		return "", false
+	}
+	return activeSession.password, true
+}
+
+func getSessionClient(provider string) (*ethclient.Client, bool) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession == nil {
+		return nil, false
+	}
+	client, ok := activeSession.clients[provider]
+	return client, ok
+}
+
+func storeSessionClient(provider string, client *ethclient.Client) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession != nil {
+		activeSession.clients[provider] = client
+	}
+}
+
+//The key is only returned for the password it was unlocked with, so that a wrong password still fails as it would outside of the session
+func getSessionPrivateKey(address string, password string) (*ecdsa.PrivateKey, bool) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession == nil {
+		return nil, false
+	}
+	key, ok := activeSession.keys[strings.ToLower(address)]
+	if !ok || key.password != password {
+		return nil, false
+	}
+	return key.privateKey, true
+}
+
+func storeSessionPrivateKey(address string, password string, privateKey *ecdsa.PrivateKey) {
+	sessionMutex.Lock()
+	defer sessionMutex.Unlock()
+	if activeSession != nil {
+		activeSession.keys[strings.ToLower(address)] = sessionKey{password: password, privateKey: privateKey}
+	}
+}
+
+//This function returns the private key of the address, the keystore is only unlocked once during a session
+func getPrivateKey(address string, password string, keystorePath string) (*ecdsa.PrivateKey, error) {
+	if privateKey, ok := getSessionPrivateKey(address, password); ok {
+		return privateKey, nil
+	}
+	privateKey, err := AccountsInterface.GetPrivateKey(address, password, keystorePath)
+	if err != nil || privateKey == nil {
+		return privateKey, err
+	}
+	storeSessionPrivateKey(address, password, privateKey)
+	return privateKey, nil
+}
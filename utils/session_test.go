@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetPrivateKey(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	type args struct {
+		session       bool
+		passwords     []string
+		privateKeyErr error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantUnlocks int
+		wantErr     bool
+	}{
+		{
+			name: "Test 1: When the keystore is unlocked once in a session",
+			args: args{
+				session:   true,
+				passwords: []string{"test", "test", "test"},
+			},
+			wantUnlocks: 1,
+			wantErr:     false,
+		},
+		{
+			name: "Test 2: When the keystore is unlocked every time outside of a session",
+			args: args{
+				session:   false,
+				passwords: []string{"test", "test"},
+			},
+			wantUnlocks: 2,
+			wantErr:     false,
+		},
+		{
+			name: "Test 3: When another password is passed in the session, the key of the last password is kept",
+			args: args{
+				session:   true,
+				passwords: []string{"test", "other", "test"},
+			},
+			wantUnlocks: 3,
+			wantErr:     false,
+		},
+		{
+			name: "Test 4: When the keystore can't be unlocked in the session",
+			args: args{
+				session:       true,
+				passwords:     []string{"wrong", "wrong"},
+				privateKeyErr: errors.New("could not decrypt key with given password"),
+			},
+			wantUnlocks: 2,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountsMock := new(mocks.AccountsUtils)
+			optionsPackageStruct := OptionsPackageStruct{
+				AccountsInterface: accountsMock,
+			}
+			StartRazor(optionsPackageStruct)
+
+			if tt.args.session {
+				StartSession()
+				defer EndSession()
+			}
+
+			var keystoreKey *ecdsa.PrivateKey
+			if tt.args.privateKeyErr == nil {
+				keystoreKey = privateKey
+			}
+			accountsMock.On("GetPrivateKey", mock.AnythingOfType("string"), mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(keystoreKey, tt.args.privateKeyErr)
+
+			for _, password := range tt.args.passwords {
+				got, err := getPrivateKey(address, password, "/home/.razor/keystore_files")
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("getPrivateKey() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !tt.wantErr && got != privateKey {
+					t.Errorf("getPrivateKey() didn't return the private key of the keystore")
+				}
+			}
+			accountsMock.AssertNumberOfCalls(t, "GetPrivateKey", tt.wantUnlocks)
+		})
+	}
+}
+
+func TestSessionPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  bool
+		password string
+		want     string
+		wantOk   bool
+	}{
+		{
+			name:     "Test 1: When the password is set in a session",
+			session:  true,
+			password: "test",
+			want:     "test",
+			wantOk:   true,
+		},
+		{
+			name:     "Test 2: When the password is set outside of a session",
+			session:  false,
+			password: "test",
+			wantOk:   false,
+		},
+		{
+			name:    "Test 3: When the password is not set in the session",
+			session: true,
+			wantOk:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.session {
+				StartSession()
+				defer EndSession()
+			}
+			SetSessionPassword(tt.password)
+			got, ok := getSessionPassword()
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("getSessionPassword() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
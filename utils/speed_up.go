@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"razor/core"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+//monitoredTransaction is a sent transaction along with the signer which signed it, so that it can be replaced if it gets stuck
+type monitoredTransaction struct {
+	from   common.Address
+	txn    *Types.Transaction
+	signer bind.SignerFn
+}
+
+var (
+	monitoredTransactions      = make(map[common.Hash]monitoredTransaction)
+	monitoredTransactionHashes []common.Hash
+	monitoredTransactionsMutex sync.Mutex
+)
+
+//This function returns a signer which keeps the signed transactions, only the latest core.MaxMonitoredTransactions transactions are kept
+func getMonitoredSigner(signer bind.SignerFn) bind.SignerFn {
+	return func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+		signedTxn, err := signer(address, txn)
+		if err != nil {
+			return nil, err
+		}
+		addMonitoredTransaction(monitoredTransaction{from: address, txn: signedTxn, signer: signer})
+		return signedTxn, nil
+	}
+}
+
+func addMonitoredTransaction(transaction monitoredTransaction) {
+	monitoredTransactionsMutex.Lock()
+	defer monitoredTransactionsMutex.Unlock()
+	hash := transaction.txn.Hash()
+	if _, ok := monitoredTransactions[hash]; !ok {
+		monitoredTransactionHashes = append(monitoredTransactionHashes, hash)
+	}
+	monitoredTransactions[hash] = transaction
+	for len(monitoredTransactionHashes) > core.MaxMonitoredTransactions {
+		delete(monitoredTransactions, monitoredTransactionHashes[0])
+		monitoredTransactionHashes = monitoredTransactionHashes[1:]
+	}
+}
+
+func getMonitoredTransaction(hash common.Hash) (monitoredTransaction, bool) {
+	monitoredTransactionsMutex.Lock()
+	defer monitoredTransactionsMutex.Unlock()
+	transaction, ok := monitoredTransactions[hash]
+	return transaction, ok
+}
+
+//This function replaces a pending transaction with a transaction of the same nonce, recipient, value, gas and data but a higher gas price and returns its hash
+//The gas price is bumped by core.SpeedUpGasPriceBumpPercent, which is more than the minimum bump of 10% the nodes accept for a replacement, or set to the suggested gas price if that is higher
+func (*UtilsStruct) SpeedUpTransaction(client *ethclient.Client, hash string) (string, error) {
+	transaction, ok := getMonitoredTransaction(common.HexToHash(hash))
+	if !ok {
+		return "", errors.New("transaction " + hash + " is not signed by this node")
+	}
+	if transaction.txn.To() == nil {
+		return "", errors.New("contract creation transaction " + hash + " can't be sped up")
+	}
+	gasPrice := new(big.Int).Mul(transaction.txn.GasPrice(), big.NewInt(100+core.SpeedUpGasPriceBumpPercent))
+	gasPrice.Div(gasPrice, big.NewInt(100))
+	suggestedGasPrice, err := UtilsInterface.SuggestGasPriceWithRetry(client)
+	if err != nil {
+		log.Error("Error in getting suggested gas price: ", err)
+	} else if suggestedGasPrice.Cmp(gasPrice) > 0 {
+		gasPrice = suggestedGasPrice
+	}
+
+	replacementTxn := Types.NewTransaction(transaction.txn.Nonce(), *transaction.txn.To(), transaction.txn.Value(), transaction.txn.Gas(), gasPrice, transaction.txn.Data())
+	signedReplacementTxn, err := transaction.signer(transaction.from, replacementTxn)
+	if err != nil {
+		return "", err
+	}
+	err = ClientInterface.SendTransaction(client, context.Background(), signedReplacementTxn)
+	if err != nil {
+		return "", err
+	}
+	addMonitoredTransaction(monitoredTransaction{from: transaction.from, txn: signedReplacementTxn, signer: transaction.signer})
+	log.Infof("Sped up transaction %s with nonce %d by replacing it with %s at gas price %s", hash, transaction.txn.Nonce(), signedReplacementTxn.Hash().Hex(), gasPrice)
+	return signedReplacementTxn.Hash().Hex(), nil
+}
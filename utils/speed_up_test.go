@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"razor/utils/mocks"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSpeedUpTransaction(t *testing.T) {
+	var client *ethclient.Client
+	privateKey, _ := crypto.GenerateKey()
+	chainId := big.NewInt(1)
+	txnOpts, _ := bind.NewKeyedTransactorWithChainID(privateKey, chainId)
+	signer := getMonitoredSigner(txnOpts.Signer)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+	stuckTxn, err := signer(txnOpts.From, Types.NewTransaction(5, to, big.NewInt(0), 100000, big.NewInt(100), []byte{0x01}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		hash                 string
+		suggestedGasPrice    *big.Int
+		suggestedGasPriceErr error
+		sendErr              error
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantGasPrice *big.Int
+		wantErr      bool
+	}{
+		{
+			name: "Test 1: When the gas price of the transaction is bumped",
+			args: args{
+				hash:              stuckTxn.Hash().Hex(),
+				suggestedGasPrice: big.NewInt(90),
+			},
+			wantGasPrice: big.NewInt(120),
+			wantErr:      false,
+		},
+		{
+			name: "Test 2: When the suggested gas price is higher than the bumped gas price",
+			args: args{
+				hash:              stuckTxn.Hash().Hex(),
+				suggestedGasPrice: big.NewInt(200),
+			},
+			wantGasPrice: big.NewInt(200),
+			wantErr:      false,
+		},
+		{
+			name: "Test 3: When there is an error in getting suggested gas price",
+			args: args{
+				hash:                 stuckTxn.Hash().Hex(),
+				suggestedGasPriceErr: errors.New("gas price error"),
+			},
+			wantGasPrice: big.NewInt(120),
+			wantErr:      false,
+		},
+		{
+			name: "Test 4: When the transaction is not signed by the node",
+			args: args{
+				hash:              "0x01",
+				suggestedGasPrice: big.NewInt(90),
+			},
+			wantErr: true,
+		},
+		{
+			name: "Test 5: When there is an error in sending the replacement",
+			args: args{
+				hash:              stuckTxn.Hash().Hex(),
+				suggestedGasPrice: big.NewInt(90),
+				sendErr:           errors.New("replacement transaction underpriced"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			clientMock := new(mocks.ClientUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				UtilsInterface:  utilsMock,
+				ClientInterface: clientMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			var sentTxn *Types.Transaction
+			utilsMock.On("SuggestGasPriceWithRetry", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.suggestedGasPrice, tt.args.suggestedGasPriceErr)
+			clientMock.On("SendTransaction", mock.AnythingOfType("*ethclient.Client"), mock.Anything, mock.AnythingOfType("*types.Transaction")).Run(func(args mock.Arguments) {
+				sentTxn = args.Get(2).(*Types.Transaction)
+			}).Return(tt.args.sendErr)
+
+			got, err := utils.SpeedUpTransaction(client, tt.args.hash)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SpeedUpTransaction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got != sentTxn.Hash().Hex() {
+				t.Errorf("SpeedUpTransaction() got = %v, want hash of the sent replacement %v", got, sentTxn.Hash().Hex())
+			}
+			if sentTxn.Nonce() != stuckTxn.Nonce() || *sentTxn.To() != *stuckTxn.To() || sentTxn.Gas() != stuckTxn.Gas() || string(sentTxn.Data()) != string(stuckTxn.Data()) {
+				t.Errorf("Replacement transaction doesn't match the stuck transaction")
+			}
+			if sentTxn.GasPrice().Cmp(tt.wantGasPrice) != 0 {
+				t.Errorf("Gas price of the replacement got = %v, want %v", sentTxn.GasPrice(), tt.wantGasPrice)
+			}
+			sender, err := Types.Sender(Types.NewEIP155Signer(chainId), sentTxn)
+			if err != nil || sender != txnOpts.From {
+				t.Errorf("Replacement transaction is not signed by the sender of the stuck transaction")
+			}
+			if _, ok := getMonitoredTransaction(sentTxn.Hash()); !ok {
+				t.Errorf("Replacement transaction is not monitored")
+			}
+		})
+	}
+}
@@ -18,6 +18,10 @@ func (*UtilsStruct) GetStakeManagerWithOpts(client *ethclient.Client) (*bindings
 }
 
 func (*UtilsStruct) GetStakerId(client *ethclient.Client, address string) (uint32, error) {
+	cacheKey := contractCallKey("stakerId", common.HexToAddress(address).Hex())
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint32), nil
+	}
 	var (
 		stakerId  uint32
 		stakerErr error
@@ -34,6 +38,10 @@ func (*UtilsStruct) GetStakerId(client *ethclient.Client, address string) (uint3
 	if stakerErr != nil {
 		return 0, stakerErr
 	}
+	// The address can still stake in the epoch if it isn't a staker yet
+	if stakerId != 0 {
+		storeContractCallResult(cacheKey, stakerId)
+	}
 	return stakerId, nil
 }
 
@@ -122,6 +130,10 @@ func (*UtilsStruct) GetLock(client *ethclient.Client, address string, stakerId u
 }
 
 func (*UtilsStruct) GetWithdrawInitiationPeriod(client *ethclient.Client) (uint16, error) {
+	cacheKey := contractCallKey("withdrawInitiationPeriod")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint16), nil
+	}
 	var (
 		withdrawReleasePeriod uint16
 		err                   error
@@ -138,6 +150,7 @@ func (*UtilsStruct) GetWithdrawInitiationPeriod(client *ethclient.Client) (uint1
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, withdrawReleasePeriod)
 	return withdrawReleasePeriod, nil
 }
 
@@ -161,6 +174,10 @@ func (*UtilsStruct) GetMaxCommission(client *ethclient.Client) (uint8, error) {
 }
 
 func (*UtilsStruct) GetEpochLimitForUpdateCommission(client *ethclient.Client) (uint16, error) {
+	cacheKey := contractCallKey("epochLimitForUpdateCommission")
+	if cached, ok := getContractCallResult(cacheKey); ok {
+		return cached.(uint16), nil
+	}
 	var (
 		epochLimitForUpdateCommission uint16
 		err                           error
@@ -176,6 +193,7 @@ func (*UtilsStruct) GetEpochLimitForUpdateCommission(client *ethclient.Client) (
 	if err != nil {
 		return 0, err
 	}
+	storeContractCallResult(cacheKey, epochLimitForUpdateCommission)
 	return epochLimitForUpdateCommission, nil
 }
 
@@ -191,6 +209,32 @@ func (*UtilsStruct) GetStakerSRZRBalance(client *ethclient.Client, staker bindin
 	return sRZRBalance, nil
 }
 
+//This function returns the sRZR balance of the address in the staked token of the staker
+func (*UtilsStruct) GetDelegatorSRZRBalance(client *ethclient.Client, address string, staker bindings.StructsStaker) (*big.Int, error) {
+	stakedToken := UtilsInterface.GetStakedToken(client, staker.TokenAddress)
+	callOpts := UtilsInterface.GetOptions()
+
+	sRZRBalance, err := StakedTokenInterface.BalanceOf(stakedToken, &callOpts, common.HexToAddress(address))
+	if err != nil {
+		log.Error("Error in getting sRZRBalance: ", err)
+		return nil, err
+	}
+	return sRZRBalance, nil
+}
+
+//This function returns the total supply of the staked token of the staker
+func (*UtilsStruct) GetSRZRTotalSupply(client *ethclient.Client, staker bindings.StructsStaker) (*big.Int, error) {
+	stakedToken := UtilsInterface.GetStakedToken(client, staker.TokenAddress)
+	callOpts := UtilsInterface.GetOptions()
+
+	totalSupply, err := StakedTokenInterface.TotalSupply(stakedToken, &callOpts)
+	if err != nil {
+		log.Error("Error in getting sRZR total supply: ", err)
+		return nil, err
+	}
+	return totalSupply, nil
+}
+
 func (*UtilsStruct) GetMinSafeRazor(client *ethclient.Client) (*big.Int, error) {
 	var (
 		minSafeRazor *big.Int
@@ -210,3 +254,24 @@ func (*UtilsStruct) GetMinSafeRazor(client *ethclient.Client) (*big.Int, error)
 	}
 	return minSafeRazor, nil
 }
+
+//This function returns the fractions of the stake which are given as bounty, burnt and kept when a staker is slashed, out of core.BaseDenominator
+func (*UtilsStruct) GetSlashNums(client *ethclient.Client) (types.SlashNums, error) {
+	var (
+		slashNums types.SlashNums
+		err       error
+	)
+	err = retry.Do(
+		func() error {
+			slashNums, err = StakeManagerInterface.SlashNums(client)
+			if err != nil {
+				log.Error("Error in fetching slash nums.... Retrying")
+				return err
+			}
+			return nil
+		}, RetryInterface.RetryAttempts(core.MaxRetries))
+	if err != nil {
+		return types.SlashNums{}, err
+	}
+	return slashNums, nil
+}
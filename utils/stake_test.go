@@ -586,6 +586,130 @@ func TestGetStakerSRZRBalance(t *testing.T) {
 	}
 }
 
+func TestGetDelegatorSRZRBalance(t *testing.T) {
+	var (
+		client      *ethclient.Client
+		staker      bindings.StructsStaker
+		callOpts    bind.CallOpts
+		stakedToken *bindings.StakedToken
+	)
+
+	type args struct {
+		sRZR    *big.Int
+		sRZRErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetDelegatorSRZRBalance executes successfully",
+			args: args{
+				sRZR:    big.NewInt(2000),
+				sRZRErr: nil,
+			},
+			want:    big.NewInt(2000),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error from BalanceOf()",
+			args: args{
+				sRZRErr: errors.New("sRZR error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			stakedTokenMock := new(mocks.StakedTokenUtils)
+
+			utilsMock.On("GetStakedToken", mock.Anything, mock.Anything).Return(stakedToken)
+			utilsMock.On("GetOptions").Return(callOpts)
+			stakedTokenMock.On("BalanceOf", mock.Anything, mock.Anything, mock.Anything).Return(tt.args.sRZR, tt.args.sRZRErr)
+
+			utils := StartRazor(OptionsPackageStruct{
+				UtilsInterface:       utilsMock,
+				StakedTokenInterface: stakedTokenMock,
+			})
+
+			got, err := utils.GetDelegatorSRZRBalance(client, "0x000000000000000000000000000000000000dEaD", staker)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDelegatorSRZRBalance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetDelegatorSRZRBalance() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetSRZRTotalSupply(t *testing.T) {
+	var (
+		client      *ethclient.Client
+		staker      bindings.StructsStaker
+		callOpts    bind.CallOpts
+		stakedToken *bindings.StakedToken
+	)
+
+	type args struct {
+		totalSupply    *big.Int
+		totalSupplyErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *big.Int
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetSRZRTotalSupply executes successfully",
+			args: args{
+				totalSupply:    big.NewInt(2000),
+				totalSupplyErr: nil,
+			},
+			want:    big.NewInt(2000),
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error from TotalSupply()",
+			args: args{
+				totalSupplyErr: errors.New("totalSupply error"),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilsMock := new(mocks.Utils)
+			stakedTokenMock := new(mocks.StakedTokenUtils)
+
+			utilsMock.On("GetStakedToken", mock.Anything, mock.Anything).Return(stakedToken)
+			utilsMock.On("GetOptions").Return(callOpts)
+			stakedTokenMock.On("TotalSupply", mock.Anything, mock.Anything).Return(tt.args.totalSupply, tt.args.totalSupplyErr)
+
+			utils := StartRazor(OptionsPackageStruct{
+				UtilsInterface:       utilsMock,
+				StakedTokenInterface: stakedTokenMock,
+			})
+
+			got, err := utils.GetSRZRTotalSupply(client, staker)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSRZRTotalSupply() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSRZRTotalSupply() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetMinSafeRazor(t *testing.T) {
 	var client *ethclient.Client
 	type args struct {
@@ -640,3 +764,58 @@ func TestGetMinSafeRazor(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSlashNums(t *testing.T) {
+	var client *ethclient.Client
+	type args struct {
+		slashNums    types.SlashNums
+		slashNumsErr error
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    types.SlashNums
+		wantErr bool
+	}{
+		{
+			name: "Test 1: When GetSlashNums() executes successfully",
+			args: args{
+				slashNums: types.SlashNums{Bounty: 500000, Burn: 9500000, Keep: 0},
+			},
+			want:    types.SlashNums{Bounty: 500000, Burn: 9500000, Keep: 0},
+			wantErr: false,
+		},
+		{
+			name: "Test 2: When there is an error in getting slashNums",
+			args: args{
+				slashNumsErr: errors.New("slashNums error"),
+			},
+			want:    types.SlashNums{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			retryMock := new(mocks.RetryUtils)
+			stakeManagerMock := new(mocks.StakeManagerUtils)
+
+			optionsPackageStruct := OptionsPackageStruct{
+				RetryInterface:        retryMock,
+				StakeManagerInterface: stakeManagerMock,
+			}
+			utils := StartRazor(optionsPackageStruct)
+
+			stakeManagerMock.On("SlashNums", mock.AnythingOfType("*ethclient.Client")).Return(tt.args.slashNums, tt.args.slashNumsErr)
+			retryMock.On("RetryAttempts", mock.AnythingOfType("uint")).Return(retry.Attempts(1))
+
+			got, err := utils.GetSlashNums(client)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetSlashNums() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetSlashNums() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
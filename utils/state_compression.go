@@ -0,0 +1,411 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"razor/core/types"
+	"sort"
+)
+
+//Prefix of the compressed state data, it lets the JSON state data written by the earlier versions to be read
+var compactStatePrefix = []byte("razor-compact-v1:")
+
+var errInvalidCompactState = errors.New("invalid compact state data")
+
+//This function returns if the state data is in the compact encoding
+func isCompactStateData(data []byte) bool {
+	return bytes.HasPrefix(data, compactStatePrefix)
+}
+
+//This function compresses the compact encoding of the state data with gzip and prefixes it
+func compressStateData(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.Write(compactStatePrefix)
+	writer, err := gzip.NewWriterLevel(&buffer, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+//This function decompresses the state data written by compressStateData
+func decompressStateData(data []byte) ([]byte, error) {
+	if !isCompactStateData(data) {
+		return nil, errInvalidCompactState
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data[len(compactStatePrefix):]))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+//This function encodes the commit data in the compact binary encoding and compresses it
+func encodeCommitFileData(data types.CommitFileData) ([]byte, error) {
+	encoder := &compactEncoder{}
+	encoder.writeUvarint(uint64(data.Epoch))
+	indices := make([]int, 0, len(data.AssignedCollections))
+	for index := range data.AssignedCollections {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+	encoder.writeLength(len(indices), data.AssignedCollections == nil)
+	for _, index := range indices {
+		encoder.writeVarint(int64(index))
+		encoder.writeBool(data.AssignedCollections[index])
+	}
+	encoder.writeBigInts(data.SeqAllottedCollections)
+	encoder.writeBigInts(data.Leaves)
+	encoder.writeLength(len(data.MerkleTree), data.MerkleTree == nil)
+	for _, level := range data.MerkleTree {
+		encoder.writeLength(len(level), level == nil)
+		for _, node := range level {
+			encoder.writeBytes(node)
+		}
+	}
+	return compressStateData(encoder.buffer.Bytes())
+}
+
+//This function decompresses and decodes the commit data written by encodeCommitFileData
+func decodeCommitFileData(compressedData []byte) (types.CommitFileData, error) {
+	data, err := decompressStateData(compressedData)
+	if err != nil {
+		return types.CommitFileData{}, err
+	}
+	decoder := newCompactDecoder(data)
+	var commitFileData types.CommitFileData
+	commitFileData.Epoch = uint32(decoder.readUvarint())
+	if length, isNil := decoder.readLength(); !isNil {
+		commitFileData.AssignedCollections = make(map[int]bool, length)
+		for i := 0; i < length; i++ {
+			index := int(decoder.readVarint())
+			commitFileData.AssignedCollections[index] = decoder.readBool()
+		}
+	}
+	commitFileData.SeqAllottedCollections = decoder.readBigInts()
+	commitFileData.Leaves = decoder.readBigInts()
+	//The merkle tree is not in the commit data written before it was cached
+	if decoder.hasMore() {
+		if length, isNil := decoder.readLength(); !isNil {
+			commitFileData.MerkleTree = make([][][]byte, length)
+			for i := range commitFileData.MerkleTree {
+				if levelLength, isNil := decoder.readLength(); !isNil {
+					commitFileData.MerkleTree[i] = make([][]byte, levelLength)
+					for j := range commitFileData.MerkleTree[i] {
+						commitFileData.MerkleTree[i][j] = decoder.readBytes()
+					}
+				}
+			}
+		}
+	}
+	if err = decoder.finish(); err != nil {
+		return types.CommitFileData{}, err
+	}
+	return commitFileData, nil
+}
+
+//This function encodes the propose data in the compact binary encoding and compresses it
+func encodeProposeFileData(data types.ProposeFileData) ([]byte, error) {
+	encoder := &compactEncoder{}
+	encoder.writeUvarint(uint64(data.Epoch))
+	encoder.writeBigInts(data.MediansData)
+	encoder.writeLength(len(data.RevealedCollectionIds), data.RevealedCollectionIds == nil)
+	for _, collectionId := range data.RevealedCollectionIds {
+		encoder.writeUvarint(uint64(collectionId))
+	}
+	encoder.writeBool(data.RevealedDataMaps != nil)
+	if data.RevealedDataMaps != nil {
+		sortedRevealedValues := data.RevealedDataMaps.SortedRevealedValues
+		revealedLeafIds := make([]uint16, 0, len(sortedRevealedValues))
+		for leafId := range sortedRevealedValues {
+			revealedLeafIds = append(revealedLeafIds, leafId)
+		}
+		sort.Slice(revealedLeafIds, func(i, j int) bool { return revealedLeafIds[i] < revealedLeafIds[j] })
+		encoder.writeLength(len(revealedLeafIds), sortedRevealedValues == nil)
+		for _, leafId := range revealedLeafIds {
+			encoder.writeUvarint(uint64(leafId))
+			encoder.writeBigInts(sortedRevealedValues[leafId])
+		}
+
+		voteWeights := data.RevealedDataMaps.VoteWeights
+		values := make([]string, 0, len(voteWeights))
+		for value := range voteWeights {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+		encoder.writeLength(len(values), voteWeights == nil)
+		for _, value := range values {
+			encoder.writeString(value)
+			encoder.writeBigInt(voteWeights[value])
+		}
+
+		influenceSum := data.RevealedDataMaps.InfluenceSum
+		leafIds := make([]uint16, 0, len(influenceSum))
+		for leafId := range influenceSum {
+			leafIds = append(leafIds, leafId)
+		}
+		sort.Slice(leafIds, func(i, j int) bool { return leafIds[i] < leafIds[j] })
+		encoder.writeLength(len(leafIds), influenceSum == nil)
+		for _, leafId := range leafIds {
+			encoder.writeUvarint(uint64(leafId))
+			encoder.writeBigInt(influenceSum[leafId])
+		}
+	}
+	return compressStateData(encoder.buffer.Bytes())
+}
+
+//This function decompresses and decodes the propose data written by encodeProposeFileData
+func decodeProposeFileData(compressedData []byte) (types.ProposeFileData, error) {
+	data, err := decompressStateData(compressedData)
+	if err != nil {
+		return types.ProposeFileData{}, err
+	}
+	decoder := newCompactDecoder(data)
+	var proposeFileData types.ProposeFileData
+	proposeFileData.Epoch = uint32(decoder.readUvarint())
+	proposeFileData.MediansData = decoder.readBigInts()
+	if length, isNil := decoder.readLength(); !isNil {
+		proposeFileData.RevealedCollectionIds = make([]uint16, length)
+		for i := 0; i < length; i++ {
+			proposeFileData.RevealedCollectionIds[i] = uint16(decoder.readUvarint())
+		}
+	}
+	if decoder.readBool() {
+		revealedDataMaps := &types.RevealedDataMaps{}
+		if length, isNil := decoder.readLength(); !isNil {
+			revealedDataMaps.SortedRevealedValues = make(map[uint16][]*big.Int, length)
+			for i := 0; i < length; i++ {
+				leafId := uint16(decoder.readUvarint())
+				revealedDataMaps.SortedRevealedValues[leafId] = decoder.readBigInts()
+			}
+		}
+		if length, isNil := decoder.readLength(); !isNil {
+			revealedDataMaps.VoteWeights = make(map[string]*big.Int, length)
+			for i := 0; i < length; i++ {
+				value := decoder.readString()
+				revealedDataMaps.VoteWeights[value] = decoder.readBigInt()
+			}
+		}
+		if length, isNil := decoder.readLength(); !isNil {
+			revealedDataMaps.InfluenceSum = make(map[uint16]*big.Int, length)
+			for i := 0; i < length; i++ {
+				leafId := uint16(decoder.readUvarint())
+				revealedDataMaps.InfluenceSum[leafId] = decoder.readBigInt()
+			}
+		}
+		proposeFileData.RevealedDataMaps = revealedDataMaps
+	}
+	if err = decoder.finish(); err != nil {
+		return types.ProposeFileData{}, err
+	}
+	return proposeFileData, nil
+}
+
+//compactEncoder writes varints and the big integers as their absolute value in big endian bytes prefixed with the sign and length
+type compactEncoder struct {
+	buffer bytes.Buffer
+}
+
+func (e *compactEncoder) writeUvarint(x uint64) {
+	var scratch [binary.MaxVarintLen64]byte
+	e.buffer.Write(scratch[:binary.PutUvarint(scratch[:], x)])
+}
+
+func (e *compactEncoder) writeVarint(x int64) {
+	var scratch [binary.MaxVarintLen64]byte
+	e.buffer.Write(scratch[:binary.PutVarint(scratch[:], x)])
+}
+
+func (e *compactEncoder) writeBool(b bool) {
+	if b {
+		e.buffer.WriteByte(1)
+	} else {
+		e.buffer.WriteByte(0)
+	}
+}
+
+//The length is written incremented by 1, so that a nil slice or map written as 0 is read back as nil
+func (e *compactEncoder) writeLength(length int, isNil bool) {
+	if isNil {
+		e.writeUvarint(0)
+		return
+	}
+	e.writeUvarint(uint64(length) + 1)
+}
+
+func (e *compactEncoder) writeString(s string) {
+	e.writeUvarint(uint64(len(s)))
+	e.buffer.WriteString(s)
+}
+
+func (e *compactEncoder) writeBytes(b []byte) {
+	e.writeUvarint(uint64(len(b)))
+	e.buffer.Write(b)
+}
+
+const (
+	compactNilBigInt byte = iota
+	compactPositiveBigInt
+	compactNegativeBigInt
+)
+
+func (e *compactEncoder) writeBigInt(x *big.Int) {
+	if x == nil {
+		e.buffer.WriteByte(compactNilBigInt)
+		return
+	}
+	if x.Sign() < 0 {
+		e.buffer.WriteByte(compactNegativeBigInt)
+	} else {
+		e.buffer.WriteByte(compactPositiveBigInt)
+	}
+	absBytes := x.Bytes()
+	e.writeUvarint(uint64(len(absBytes)))
+	e.buffer.Write(absBytes)
+}
+
+func (e *compactEncoder) writeBigInts(values []*big.Int) {
+	e.writeLength(len(values), values == nil)
+	for _, value := range values {
+		e.writeBigInt(value)
+	}
+}
+
+//compactDecoder reads the values written by compactEncoder, the first error is kept and the later reads return zero values
+type compactDecoder struct {
+	reader *bytes.Reader
+	err    error
+}
+
+func newCompactDecoder(data []byte) *compactDecoder {
+	return &compactDecoder{reader: bytes.NewReader(data)}
+}
+
+func (d *compactDecoder) readUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	x, err := binary.ReadUvarint(d.reader)
+	if err != nil {
+		d.err = errInvalidCompactState
+	}
+	return x
+}
+
+func (d *compactDecoder) readVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	x, err := binary.ReadVarint(d.reader)
+	if err != nil {
+		d.err = errInvalidCompactState
+	}
+	return x
+}
+
+func (d *compactDecoder) readBool() bool {
+	if d.err != nil {
+		return false
+	}
+	b, err := d.reader.ReadByte()
+	if err != nil || b > 1 {
+		d.err = errInvalidCompactState
+		return false
+	}
+	return b == 1
+}
+
+//The length can't be more than the remaining bytes as every element takes at least a byte, which prevents huge allocations for corrupt data
+func (d *compactDecoder) readLength() (int, bool) {
+	length := d.readUvarint()
+	if d.err != nil || length == 0 {
+		return 0, true
+	}
+	if length-1 > uint64(d.reader.Len()) {
+		d.err = errInvalidCompactState
+		return 0, true
+	}
+	return int(length - 1), false
+}
+
+func (d *compactDecoder) readBytes() []byte {
+	length := d.readUvarint()
+	if d.err != nil {
+		return nil
+	}
+	if length > uint64(d.reader.Len()) {
+		d.err = errInvalidCompactState
+		return nil
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(d.reader, data); err != nil {
+		d.err = errInvalidCompactState
+		return nil
+	}
+	return data
+}
+
+func (d *compactDecoder) readString() string {
+	return string(d.readBytes())
+}
+
+func (d *compactDecoder) readBigInt() *big.Int {
+	if d.err != nil {
+		return nil
+	}
+	sign, err := d.reader.ReadByte()
+	if err != nil {
+		d.err = errInvalidCompactState
+		return nil
+	}
+	switch sign {
+	case compactNilBigInt:
+		return nil
+	case compactPositiveBigInt:
+		return new(big.Int).SetBytes(d.readBytes())
+	case compactNegativeBigInt:
+		return new(big.Int).Neg(new(big.Int).SetBytes(d.readBytes()))
+	default:
+		d.err = errInvalidCompactState
+		return nil
+	}
+}
+
+func (d *compactDecoder) readBigInts() []*big.Int {
+	length, isNil := d.readLength()
+	if isNil {
+		return nil
+	}
+	values := make([]*big.Int, length)
+	for i := range values {
+		values[i] = d.readBigInt()
+	}
+	return values
+}
+
+//This function returns if there are bytes left which are not read
+func (d *compactDecoder) hasMore() bool {
+	return d.err == nil && d.reader.Len() != 0
+}
+
+//This function returns the first error of the reads or an error if there are bytes left which are not read
+func (d *compactDecoder) finish() error {
+	if d.err != nil {
+		return d.err
+	}
+	if d.reader.Len() != 0 {
+		return errInvalidCompactState
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"encoding/json"
+	"math/big"
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestCommitFileDataEncoding(t *testing.T) {
+	largeValue, _ := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+	tests := []struct {
+		name string
+		data types.CommitFileData
+	}{
+		{
+			name: "Test 1: When commit data has all the fields",
+			data: types.CommitFileData{
+				Epoch:                  1024,
+				AssignedCollections:    map[int]bool{0: true, 7: true, 300: false},
+				SeqAllottedCollections: []*big.Int{big.NewInt(0), big.NewInt(7), big.NewInt(300)},
+				Leaves:                 []*big.Int{big.NewInt(0), largeValue, big.NewInt(-42)},
+			},
+		},
+		{
+			name: "Test 2: When commit data has nil and empty fields",
+			data: types.CommitFileData{
+				Epoch:                  1,
+				AssignedCollections:    map[int]bool{},
+				SeqAllottedCollections: nil,
+				Leaves:                 []*big.Int{nil},
+			},
+		},
+		{
+			name: "Test 3: When commit data is empty",
+			data: types.CommitFileData{},
+		},
+		{
+			name: "Test 4: When commit data has the merkle tree",
+			data: types.CommitFileData{
+				Epoch:                  1024,
+				SeqAllottedCollections: []*big.Int{big.NewInt(1)},
+				Leaves:                 []*big.Int{big.NewInt(10), big.NewInt(20)},
+				MerkleTree:             [][][]byte{{{3, 4}}, {{1}, {2}}, nil},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encodedData, err := encodeCommitFileData(tt.data)
+			if err != nil {
+				t.Fatalf("encodeCommitFileData() error = %v", err)
+			}
+			if !isCompactStateData(encodedData) {
+				t.Errorf("encodeCommitFileData() data is not in the compact encoding")
+			}
+			got, err := decodeCommitFileData(encodedData)
+			if err != nil {
+				t.Fatalf("decodeCommitFileData() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.data) {
+				t.Errorf("decodeCommitFileData() got = %v, want %v", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestDecodeCommitFileDataWithoutMerkleTree(t *testing.T) {
+	// The commit data written before the merkle tree was cached ends after the leaves
+	encoder := &compactEncoder{}
+	encoder.writeUvarint(5)
+	encoder.writeLength(0, true)
+	encoder.writeBigInts([]*big.Int{big.NewInt(0)})
+	encoder.writeBigInts([]*big.Int{big.NewInt(100)})
+	encodedData, err := compressStateData(encoder.buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decodeCommitFileData(encodedData)
+	if err != nil {
+		t.Fatalf("decodeCommitFileData() error = %v", err)
+	}
+	want := types.CommitFileData{Epoch: 5, SeqAllottedCollections: []*big.Int{big.NewInt(0)}, Leaves: []*big.Int{big.NewInt(100)}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decodeCommitFileData() got = %v, want %v", got, want)
+	}
+}
+
+func TestProposeFileDataEncoding(t *testing.T) {
+	tests := []struct {
+		name string
+		data types.ProposeFileData
+	}{
+		{
+			name: "Test 1: When propose data has all the fields",
+			data: types.ProposeFileData{
+				Epoch:                 1024,
+				MediansData:           []*big.Int{big.NewInt(100), big.NewInt(0)},
+				RevealedCollectionIds: []uint16{1, 4},
+				RevealedDataMaps: &types.RevealedDataMaps{
+					SortedRevealedValues: map[uint16][]*big.Int{0: {big.NewInt(90), big.NewInt(100)}, 3: {big.NewInt(0)}},
+					VoteWeights:          map[string]*big.Int{"90": big.NewInt(5), "100": big.NewInt(10)},
+					InfluenceSum:         map[uint16]*big.Int{0: big.NewInt(15), 3: big.NewInt(10)},
+				},
+			},
+		},
+		{
+			name: "Test 2: When propose data has no revealed data maps",
+			data: types.ProposeFileData{
+				Epoch:                 5,
+				MediansData:           []*big.Int{},
+				RevealedCollectionIds: nil,
+			},
+		},
+		{
+			name: "Test 3: When revealed data maps are empty",
+			data: types.ProposeFileData{
+				Epoch:            5,
+				RevealedDataMaps: &types.RevealedDataMaps{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encodedData, err := encodeProposeFileData(tt.data)
+			if err != nil {
+				t.Fatalf("encodeProposeFileData() error = %v", err)
+			}
+			got, err := decodeProposeFileData(encodedData)
+			if err != nil {
+				t.Fatalf("decodeProposeFileData() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.data) {
+				t.Errorf("decodeProposeFileData() got = %v, want %v", got, tt.data)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalidCompactState(t *testing.T) {
+	commitData, _ := encodeCommitFileData(types.CommitFileData{Epoch: 5, Leaves: []*big.Int{big.NewInt(100)}})
+	proposeData, _ := encodeProposeFileData(types.ProposeFileData{Epoch: 5, MediansData: []*big.Int{big.NewInt(100)}})
+	jsonData, _ := json.Marshal(types.CommitFileData{Epoch: 5})
+	truncatedData, _ := compressStateData([]byte{5, 0, 3})
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "Test 1: When data is JSON",
+			data: jsonData,
+		},
+		{
+			name: "Test 2: When compressed data is truncated",
+			data: commitData[:len(commitData)-4],
+		},
+		{
+			name: "Test 3: When data is propose data",
+			data: proposeData,
+		},
+		{
+			name: "Test 4: When a length is more than the remaining data",
+			data: truncatedData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCommitFileData(tt.data); err == nil {
+				t.Errorf("decodeCommitFileData() expected an error")
+			}
+		})
+	}
+}
+
+func TestStateDataCompression(t *testing.T) {
+	leaves := make([]*big.Int, 1000)
+	for i := range leaves {
+		leaves[i] = new(big.Int).Mul(big.NewInt(int64(i)), big.NewInt(1e12))
+	}
+	data := types.CommitFileData{Epoch: 5, Leaves: leaves, SeqAllottedCollections: leaves}
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodedData, err := encodeCommitFileData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(encodedData) >= len(jsonData)/4 {
+		t.Errorf("compact encoding of %d bytes isn't smaller than a quarter of %d bytes of JSON", len(encodedData), len(jsonData))
+	}
+}
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+	"os"
+	"razor/path"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+var (
+	stateEncryptionKey   []byte
+	stateEncryptionMutex sync.RWMutex
+
+	//Prefix of the encrypted state data, it lets the plain state data written before enabling the encryption to be read
+	encryptedStatePrefix = []byte("razor-encrypted-v1:")
+)
+
+const (
+	stateEncryptionSaltLength = 32
+	stateEncryptionKeyLength  = 32
+)
+
+//This function derives the key used to encrypt the state files and the local database from the password
+//The salt is generated once and stored in the razor directory so that the same key is derived on every startup
+func InitStateEncryption(password string) error {
+	if password == "" {
+		return errors.New("password is required to encrypt the state")
+	}
+	saltFilePath, err := path.PathUtilsInterface.GetStateEncryptionSaltFilePath()
+	if err != nil {
+		return err
+	}
+	salt, err := OS.ReadFile(saltFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		salt = make([]byte, stateEncryptionSaltLength)
+		if _, err = io.ReadFull(rand.Reader, salt); err != nil {
+			return err
+		}
+		if err = OS.WriteFile(saltFilePath, salt, 0600); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	if len(salt) != stateEncryptionSaltLength {
+		return errors.New("invalid state encryption salt")
+	}
+	key, err := scrypt.Key([]byte(password), salt, 1<<15, 8, 1, stateEncryptionKeyLength)
+	if err != nil {
+		return err
+	}
+	stateEncryptionMutex.Lock()
+	stateEncryptionKey = key
+	stateEncryptionMutex.Unlock()
+	log.Info("State encryption is enabled")
+	return nil
+}
+
+//This function encrypts the state data if the state encryption is enabled, otherwise it returns the data as it is
+func EncryptStateData(data []byte) ([]byte, error) {
+	stateEncryptionMutex.RLock()
+	key := stateEncryptionKey
+	stateEncryptionMutex.RUnlock()
+	if key == nil {
+		return data, nil
+	}
+	gcm, err := getStateCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	encryptedData := append([]byte{}, encryptedStatePrefix...)
+	encryptedData = append(encryptedData, nonce...)
+	return gcm.Seal(encryptedData, nonce, data, nil), nil
+}
+
+//This function decrypts the state data if it was encrypted, the plain state data is returned as it is
+func DecryptStateData(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, encryptedStatePrefix) {
+		return data, nil
+	}
+	stateEncryptionMutex.RLock()
+	key := stateEncryptionKey
+	stateEncryptionMutex.RUnlock()
+	if key == nil {
+		return nil, errors.New("state data is encrypted, pass the encryptState flag to decrypt it")
+	}
+	gcm, err := getStateCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	data = data[len(encryptedStatePrefix):]
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("invalid encrypted state data")
+	}
+	decryptedData, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("error in decrypting state data, the password might be incorrect")
+	}
+	return decryptedData, nil
+}
+
+func getStateCipher(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"testing"
+)
+
+func TestInitStateEncryption(t *testing.T) {
+	saltFilePath := filepath.Join(t.TempDir(), "state_encryption.salt")
+
+	pathMock := new(pathMocks.PathInterface)
+	path.PathUtilsInterface = pathMock
+	pathMock.On("GetStateEncryptionSaltFilePath").Return(saltFilePath, nil)
+
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}})
+
+	stateEncryptionKey = nil
+	defer func() { stateEncryptionKey = nil }()
+
+	if err := InitStateEncryption(""); err == nil {
+		t.Error("InitStateEncryption() expected error for empty password")
+	}
+
+	if err := InitStateEncryption("test"); err != nil {
+		t.Fatalf("InitStateEncryption() error = %v", err)
+	}
+	salt, err := os.ReadFile(saltFilePath)
+	if err != nil {
+		t.Fatalf("Error in reading salt file: %v", err)
+	}
+	if len(salt) != stateEncryptionSaltLength {
+		t.Errorf("InitStateEncryption() salt length = %d, want %d", len(salt), stateEncryptionSaltLength)
+	}
+	key := stateEncryptionKey
+
+	if err := InitStateEncryption("test"); err != nil {
+		t.Fatalf("InitStateEncryption() error = %v", err)
+	}
+	if !bytes.Equal(key, stateEncryptionKey) {
+		t.Error("InitStateEncryption() derived a different key for the same password and salt")
+	}
+
+	if err := os.WriteFile(saltFilePath, []byte("short"), 0600); err != nil {
+		t.Fatalf("Error in writing salt file: %v", err)
+	}
+	if err := InitStateEncryption("test"); err == nil {
+		t.Error("InitStateEncryption() expected error for invalid salt")
+	}
+}
+
+func TestEncryptAndDecryptStateData(t *testing.T) {
+	saltFilePath := filepath.Join(t.TempDir(), "state_encryption.salt")
+
+	pathMock := new(pathMocks.PathInterface)
+	path.PathUtilsInterface = pathMock
+	pathMock.On("GetStateEncryptionSaltFilePath").Return(saltFilePath, nil)
+
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}})
+
+	stateEncryptionKey = nil
+	defer func() { stateEncryptionKey = nil }()
+
+	data := []byte(`{"epoch":1}`)
+
+	plainData, err := EncryptStateData(data)
+	if err != nil || !bytes.Equal(plainData, data) {
+		t.Errorf("EncryptStateData() without key got = %s, err = %v, want plain data", plainData, err)
+	}
+
+	if err := InitStateEncryption("test"); err != nil {
+		t.Fatalf("InitStateEncryption() error = %v", err)
+	}
+
+	encryptedData, err := EncryptStateData(data)
+	if err != nil {
+		t.Fatalf("EncryptStateData() error = %v", err)
+	}
+	if bytes.Contains(encryptedData, data) {
+		t.Error("EncryptStateData() returned data in plain text")
+	}
+
+	decryptedData, err := DecryptStateData(encryptedData)
+	if err != nil || !bytes.Equal(decryptedData, data) {
+		t.Errorf("DecryptStateData() got = %s, err = %v, want %s", decryptedData, err, data)
+	}
+
+	decryptedData, err = DecryptStateData(data)
+	if err != nil || !bytes.Equal(decryptedData, data) {
+		t.Errorf("DecryptStateData() for plain data got = %s, err = %v, want %s", decryptedData, err, data)
+	}
+
+	if err := InitStateEncryption("wrong password"); err != nil {
+		t.Fatalf("InitStateEncryption() error = %v", err)
+	}
+	if _, err := DecryptStateData(encryptedData); err == nil {
+		t.Error("DecryptStateData() expected error for wrong password")
+	}
+
+	stateEncryptionKey = nil
+	if _, err := DecryptStateData(encryptedData); err == nil {
+		t.Error("DecryptStateData() expected error when state encryption is not enabled")
+	}
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	stateSchemaVersionKey = "schemaVersion"
+	stateRecordPrefix     = "state/"
+	stateHistoryPrefix    = "history/"
+)
+
+var (
+	stateDB      *leveldb.DB
+	stateDBErr   error
+	stateDBOnce  = new(sync.Once)
+	stateDBMutex sync.RWMutex
+)
+
+//This function passes the state store shared by the command to the given function
+//The store is opened and migrated at its first access and kept open until CloseStateDB is called at the shutdown
+func withStateDB(handle func(db *leveldb.DB) error) error {
+	stateDBMutex.RLock()
+	once := stateDBOnce
+	once.Do(openStateDB)
+	db, err := stateDB, stateDBErr
+	if err != nil {
+		stateDBMutex.RUnlock()
+		// The store is opened again at the next access, as it may be held by another command only for a while
+		stateDBMutex.Lock()
+		if stateDBOnce == once {
+			stateDBOnce = new(sync.Once)
+		}
+		stateDBMutex.Unlock()
+		return err
+	}
+	defer stateDBMutex.RUnlock()
+	return handle(db)
+}
+
+//This function opens the state store and migrates its schema, retrying while it is held by another command of the staker
+func openStateDB() {
+	stateDB, stateDBErr = nil, nil
+	dbPath, err := path.PathUtilsInterface.GetStateDBPath()
+	if err != nil {
+		stateDBErr = err
+		return
+	}
+	var db *leveldb.DB
+	err = retry.Do(
+		func() error {
+			db, err = leveldb.OpenFile(dbPath, nil)
+			return err
+		}, retry.Attempts(core.StateStoreOpenAttempts), retry.Delay(100*time.Millisecond), retry.LastErrorOnly(true))
+	if err != nil {
+		stateDBErr = fmt.Errorf("error in opening state store: %w", err)
+		return
+	}
+	if err := migrateStateDB(db); err != nil {
+		db.Close()
+		stateDBErr = err
+		return
+	}
+	stateDB = db
+}
+
+//This function closes the state store once the accesses in progress are completed
+func CloseStateDB() {
+	stateDBMutex.Lock()
+	defer stateDBMutex.Unlock()
+	if stateDB != nil {
+		if err := stateDB.Close(); err != nil {
+			log.Error("Error in closing state store: ", err)
+		}
+	}
+	stateDB, stateDBErr = nil, nil
+	stateDBOnce = new(sync.Once)
+}
+
+//This function brings the schema of the state store to the current version
+//The store of a newer version isn't used as its records may not be understood
+func migrateStateDB(db *leveldb.DB) error {
+	data, err := db.Get([]byte(stateSchemaVersionKey), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return db.Put([]byte(stateSchemaVersionKey), []byte(strconv.Itoa(core.StateStoreSchemaVersion)), &opt.WriteOptions{Sync: true})
+	}
+	if err != nil {
+		return err
+	}
+	version, err := strconv.Atoi(string(data))
+	if err != nil {
+		return fmt.Errorf("invalid state store schema version %q", data)
+	}
+	if version > core.StateStoreSchemaVersion {
+		return fmt.Errorf("state store schema version %d is newer than the supported version %d, upgrade razor-go", version, core.StateStoreSchemaVersion)
+	}
+	return nil
+}
+
+//This function saves the data of the state file in the state store along with a version in its history
+//The data and its history are written atomically, so that a crash can't leave the state half written
+func saveStateRecord(filePath string, data []byte) error {
+	name := filepath.Base(filePath)
+	return withStateDB(func(db *leveldb.DB) error {
+		batch := new(leveldb.Batch)
+		batch.Put([]byte(stateRecordPrefix+name), data)
+		batch.Put([]byte(fmt.Sprintf("%s%s/%020d", stateHistoryPrefix, name, time.Now().UnixNano())), data)
+
+		// The versions older than the history length are dropped in the same write
+		historyKeys := getStateHistoryKeys(db, name)
+		for i := 0; i < len(historyKeys)+1-core.StateHistoryLength; i++ {
+			batch.Delete(historyKeys[i])
+		}
+		return db.Write(batch, &opt.WriteOptions{Sync: true})
+	})
+}
+
+//This function reads the data of the state file from the state store
+//If the store has no data for it, the state file written by earlier versions is migrated to the store
+func readStateRecord(filePath string) ([]byte, error) {
+	name := filepath.Base(filePath)
+	var data []byte
+	err := withStateDB(func(db *leveldb.DB) error {
+		var err error
+		data, err = db.Get([]byte(stateRecordPrefix+name), nil)
+		if !errors.Is(err, leveldb.ErrNotFound) {
+			return err
+		}
+		data, err = migrateStateFile(db, filePath)
+		return err
+	})
+	return data, err
+}
+
+//This function imports the state file into the state store and renames the file, so that it isn't imported again
+func migrateStateFile(db *leveldb.DB, filePath string) ([]byte, error) {
+	jsonFile, err := OS.Open(filePath)
+	if err != nil {
+		// Nothing is saved yet if the file doesn't exist either, which the callers check with os.ErrNotExist
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Error("Error in opening json file: ", err)
+		}
+		return nil, err
+	}
+	defer jsonFile.Close()
+	data, err := IOInterface.ReadAll(jsonFile)
+	if err != nil {
+		log.Error("Error in reading data from json file: ", err)
+		return nil, err
+	}
+	name := filepath.Base(filePath)
+	if err := db.Put([]byte(stateRecordPrefix+name), data, &opt.WriteOptions{Sync: true}); err != nil {
+		return nil, err
+	}
+	log.Infof("Migrated %s to the state store", filePath)
+	if err := os.Rename(filePath, filePath+".migrated"); err != nil {
+		log.Warnf("Error in renaming migrated state file %s: %s", filePath, err)
+	}
+	return data, nil
+}
+
+//This function returns the keys of the versions in the history of the state, from the oldest to the latest
+func getStateHistoryKeys(db *leveldb.DB, name string) [][]byte {
+	var keys [][]byte
+	iterator := db.NewIterator(util.BytesPrefix([]byte(stateHistoryPrefix+name+"/")), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+	return keys
+}
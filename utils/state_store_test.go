@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"razor/core"
+	"razor/path"
+	pathMocks "razor/path/mocks"
+	"strconv"
+	"testing"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+//This function points the state store to a new database in a temporary directory
+func setStateDBPath(t *testing.T, dbPathErr error) {
+	CloseStateDB()
+	t.Cleanup(CloseStateDB)
+	pathMock := new(pathMocks.PathInterface)
+	path.PathUtilsInterface = pathMock
+	pathMock.On("GetStateDBPath").Return(filepath.Join(t.TempDir(), "state_db"), dbPathErr)
+}
+
+func TestStateRecord(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, IOInterface: IOStruct{}})
+	setStateDBPath(t, nil)
+	filePath := filepath.Join(t.TempDir(), "0x01_disputeData.json")
+
+	if _, err := readStateRecord(filePath); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("readStateRecord() error = %v before anything is saved, want %v", err, os.ErrNotExist)
+	}
+	for i := 0; i < core.StateHistoryLength+2; i++ {
+		if err := saveStateRecord(filePath, []byte(strconv.Itoa(i))); err != nil {
+			t.Fatalf("saveStateRecord() error = %v", err)
+		}
+	}
+	data, err := readStateRecord(filePath)
+	if err != nil {
+		t.Fatalf("readStateRecord() error = %v", err)
+	}
+	if string(data) != strconv.Itoa(core.StateHistoryLength+1) {
+		t.Errorf("readStateRecord() = %s, want the latest saved data", data)
+	}
+	var historyKeys [][]byte
+	err = withStateDB(func(db *leveldb.DB) error {
+		historyKeys = getStateHistoryKeys(db, filepath.Base(filePath))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(historyKeys) != core.StateHistoryLength {
+		t.Errorf("state history has %d versions, want %d", len(historyKeys), core.StateHistoryLength)
+	}
+}
+
+func TestMigrateStateFile(t *testing.T) {
+	StartRazor(OptionsPackageStruct{OS: OSStruct{}, IOInterface: IOStruct{}})
+	setStateDBPath(t, nil)
+	filePath := filepath.Join(t.TempDir(), "0x01_disputeData.json")
+	if err := os.WriteFile(filePath, []byte(`{"BountyIdQueue":[1]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := readStateRecord(filePath)
+	if err != nil {
+		t.Fatalf("readStateRecord() error = %v", err)
+	}
+	if string(data) != `{"BountyIdQueue":[1]}` {
+		t.Errorf("readStateRecord() = %s, want the data of the state file", data)
+	}
+	if _, err := os.Stat(filePath); !errors.Is(err, os.ErrNotExist) {
+		t.Error("the migrated state file isn't renamed")
+	}
+	data, err = readStateRecord(filePath)
+	if err != nil || string(data) != `{"BountyIdQueue":[1]}` {
+		t.Errorf("readStateRecord() = %s, %v after the migration, want the data of the state file", data, err)
+	}
+}
+
+func TestMigrateStateDB(t *testing.T) {
+	setStateDBPath(t, nil)
+
+	err := withStateDB(func(db *leveldb.DB) error {
+		return db.Put([]byte(stateSchemaVersionKey), []byte(strconv.Itoa(core.StateStoreSchemaVersion+1)), nil)
+	})
+	if err != nil {
+		t.Fatalf("withStateDB() error = %v", err)
+	}
+	// The schema version is checked when the store is opened again
+	CloseStateDB()
+	if err := withStateDB(func(db *leveldb.DB) error { return nil }); err == nil {
+		t.Error("withStateDB() expected an error for the state store of a newer schema version")
+	}
+}
+
+func TestSharedStateDB(t *testing.T) {
+	setStateDBPath(t, nil)
+
+	var first, second *leveldb.DB
+	if err := withStateDB(func(db *leveldb.DB) error { first = db; return nil }); err != nil {
+		t.Fatalf("withStateDB() error = %v", err)
+	}
+	if err := withStateDB(func(db *leveldb.DB) error { second = db; return db.Put([]byte("key"), []byte("value"), nil) }); err != nil {
+		t.Fatalf("withStateDB() error = %v", err)
+	}
+	if first != second {
+		t.Error("withStateDB() opened the state store again, want the shared handle")
+	}
+
+	CloseStateDB()
+	err := withStateDB(func(db *leveldb.DB) error {
+		value, err := db.Get([]byte("key"), nil)
+		if err == nil && string(value) != "value" {
+			t.Errorf("state store has %s after it is opened again, want value", value)
+		}
+		return err
+	})
+	if err != nil {
+		t.Errorf("withStateDB() error = %v after CloseStateDB", err)
+	}
+}
@@ -8,11 +8,13 @@ import (
 	"io"
 	"io/fs"
 	"math/big"
+	"net/http"
 	"os"
 	"razor/accounts"
 	coretypes "razor/core/types"
 	"razor/path"
 	"razor/pkg/bindings"
+	"strings"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -22,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/spf13/pflag"
 )
 
@@ -155,6 +158,11 @@ func (s StakeManagerStruct) MinSafeRazor(client *ethclient.Client) (*big.Int, er
 	return stakeManager.MinSafeRazor(&opts)
 }
 
+func (s StakeManagerStruct) SlashNums(client *ethclient.Client) (coretypes.SlashNums, error) {
+	stakeManager, opts := UtilsInterface.GetStakeManagerWithOpts(client)
+	return stakeManager.SlashNums(&opts)
+}
+
 func (s StakeManagerStruct) Locks(client *ethclient.Client, address common.Address, address1 common.Address, lockType uint8) (coretypes.Locks, error) {
 	stakeManager, opts := UtilsInterface.GetStakeManagerWithOpts(client)
 	return stakeManager.Locks(&opts, address, address1, lockType)
@@ -267,7 +275,25 @@ func (u UtilsStruct) GetUint32(flagSet *pflag.FlagSet, name string) (uint32, err
 }
 
 func (e EthClientStruct) Dial(rawurl string) (*ethclient.Client, error) {
-	return ethclient.Dial(rawurl)
+	if strings.HasPrefix(rawurl, "http://") || strings.HasPrefix(rawurl, "https://") {
+		transport := http.DefaultTransport
+		if _, ok := getUserAgent(); ok {
+			transport = requestHeadersTransport{base: transport}
+		}
+		transport = rpcDebugTransport{base: transport}
+		rpcClient, err := rpc.DialHTTPWithClient(rawurl, &http.Client{
+			Transport: rpcLatencyTransport{base: transport},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newEthClient(rpcClient), nil
+	}
+	rpcClient, err := rpc.Dial(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	return newEthClient(rpcClient), nil
 }
 
 func (t TimeStruct) Sleep(duration time.Duration) {
@@ -314,6 +340,10 @@ func (c ClientStruct) SuggestGasPrice(client *ethclient.Client, ctx context.Cont
 	return client.SuggestGasPrice(ctx)
 }
 
+func (c ClientStruct) FeeHistory(client *ethclient.Client, ctx context.Context, blockCount uint64, percentiles []float64) (*coretypes.FeeHistory, error) {
+	return getFeeHistory(client, ctx, blockCount, percentiles)
+}
+
 func (c ClientStruct) EstimateGas(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
 	return client.EstimateGas(ctx, msg)
 }
@@ -322,6 +352,34 @@ func (c ClientStruct) FilterLogs(client *ethclient.Client, ctx context.Context,
 	return client.FilterLogs(ctx, q)
 }
 
+func (c ClientStruct) TransactionByHash(client *ethclient.Client, ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	return client.TransactionByHash(ctx, txHash)
+}
+
+func (c ClientStruct) SendTransaction(client *ethclient.Client, ctx context.Context, txn *types.Transaction) error {
+	return client.SendTransaction(ctx, txn)
+}
+
+func (c ClientStruct) SubscribeNewHead(client *ethclient.Client, ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return client.SubscribeNewHead(ctx, ch)
+}
+
+func (c ClientStruct) SyncProgress(client *ethclient.Client, ctx context.Context) (*ethereum.SyncProgress, error) {
+	return client.SyncProgress(ctx)
+}
+
+func (c ClientStruct) CallContract(client *ethclient.Client, ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return client.CallContract(ctx, msg, blockNumber)
+}
+
+func (c ClientStruct) ChainID(client *ethclient.Client, ctx context.Context) (*big.Int, error) {
+	return client.ChainID(ctx)
+}
+
+func (c ClientStruct) CodeAt(client *ethclient.Client, ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return client.CodeAt(ctx, account, blockNumber)
+}
+
 func (b BufioStruct) NewScanner(r io.Reader) *bufio.Scanner {
 	return bufio.NewScanner(r)
 }
@@ -354,6 +412,10 @@ func (s StakedTokenStruct) BalanceOf(stakedToken *bindings.StakedToken, callOpts
 	return stakedToken.BalanceOf(callOpts, address)
 }
 
+func (s StakedTokenStruct) TotalSupply(stakedToken *bindings.StakedToken, callOpts *bind.CallOpts) (*big.Int, error) {
+	return stakedToken.TotalSupply(callOpts)
+}
+
 func (r RetryStruct) RetryAttempts(numberOfAttempts uint) retry.Option {
 	return retry.Attempts(numberOfAttempts)
 }
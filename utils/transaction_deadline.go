@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	transactionContexts      = make(map[string]context.Context)
+	transactionContextsMutex sync.RWMutex
+)
+
+//This function sets the context which the transactions of the account are sent with, they aren't sent once it is done
+//It is the context of the state handler so that no transaction is sent after the deadline of the state
+func SetTransactionContext(address string, ctx context.Context) {
+	transactionContextsMutex.Lock()
+	defer transactionContextsMutex.Unlock()
+	transactionContexts[strings.ToLower(address)] = ctx
+}
+
+//This function removes the context of the transactions of the account, its transactions are sent without a deadline afterwards
+func ClearTransactionContext(address string) {
+	transactionContextsMutex.Lock()
+	defer transactionContextsMutex.Unlock()
+	delete(transactionContexts, strings.ToLower(address))
+}
+
+//This function returns the context which the transactions of the account are sent with, it is the background context if none is set
+func getTransactionContext(address string) context.Context {
+	transactionContextsMutex.RLock()
+	defer transactionContextsMutex.RUnlock()
+	if ctx, ok := transactionContexts[strings.ToLower(address)]; ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+//This function returns a signer which doesn't sign the transaction of the method once the context is done
+func getDeadlineSigner(signer bind.SignerFn, ctx context.Context, methodName string) bind.SignerFn {
+	return func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("not sending %s as the deadline of the state handler has passed: %w", methodName, err)
+		}
+		return signer(address, txn)
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	Types "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestGetDeadlineSigner(t *testing.T) {
+	to := common.HexToAddress("0x000000000000000000000000000000000000dea1")
+	txn := Types.NewTransaction(1, to, big.NewInt(0), 100000, big.NewInt(1e9), nil)
+
+	expiredCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-expiredCtx.Done()
+	activeCtx, cancelActive := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelActive()
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		wantSigned bool
+		wantErr    error
+	}{
+		{
+			name:       "Test 1: When there is no deadline",
+			ctx:        context.Background(),
+			wantSigned: true,
+		},
+		{
+			name:       "Test 2: When the deadline hasn't passed",
+			ctx:        activeCtx,
+			wantSigned: true,
+		},
+		{
+			name:    "Test 3: When the deadline has passed",
+			ctx:     expiredCtx,
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed := false
+			signer := getDeadlineSigner(func(address common.Address, txn *Types.Transaction) (*Types.Transaction, error) {
+				signed = true
+				return txn, nil
+			}, tt.ctx, "reveal")
+			_, err := signer(to, txn)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("getDeadlineSigner() error = %v, want %v", err, tt.wantErr)
+			}
+			if signed != tt.wantSigned {
+				t.Errorf("getDeadlineSigner() signed = %v, want %v", signed, tt.wantSigned)
+			}
+		})
+	}
+}
+
+func TestTransactionContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	address := "0x000000000000000000000000000000000000dEa1"
+
+	SetTransactionContext(address, ctx)
+	if got := getTransactionContext("0x000000000000000000000000000000000000dea1"); got != ctx {
+		t.Errorf("getTransactionContext() = %v, want the context of the account", got)
+	}
+	if got := getTransactionContext("0x000000000000000000000000000000000000dea2"); got != context.Background() {
+		t.Errorf("getTransactionContext() = %v, want the background context for another account", got)
+	}
+	ClearTransactionContext(address)
+	if got := getTransactionContext(address); got != context.Background() {
+		t.Errorf("getTransactionContext() = %v, want the background context after it is cleared", got)
+	}
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"razor/core"
+	"razor/core/types"
+	"strings"
+	"time"
+	// The timezones of the timestamp conversions are embedded as the hosts of the nodes may have no timezone database
+	_ "time/tzdata"
+
+	"github.com/tidwall/gjson"
+)
+
+//The conversions of the numeric values of the jobs by their names, the timestamps are converted by convertTimestamp
+var unitConversions = map[string]func(*big.Float) *big.Float{
+	core.PercentToBasisPointsConversion: func(value *big.Float) *big.Float {
+		return new(big.Float).Mul(value, big.NewFloat(100))
+	},
+	core.BasisPointsToPercentConversion: func(value *big.Float) *big.Float {
+		return new(big.Float).Quo(value, big.NewFloat(100))
+	},
+	core.WeiToEthConversion: func(value *big.Float) *big.Float {
+		return new(big.Float).Quo(value, big.NewFloat(1e18))
+	},
+	core.EthToWeiConversion: func(value *big.Float) *big.Float {
+		return new(big.Float).Mul(value, big.NewFloat(1e18))
+	},
+	core.CelsiusToFahrenheitConversion: func(value *big.Float) *big.Float {
+		fahrenheit := new(big.Float).Mul(value, big.NewFloat(9))
+		fahrenheit.Quo(fahrenheit, big.NewFloat(5))
+		return fahrenheit.Add(fahrenheit, big.NewFloat(32))
+	},
+	core.FahrenheitToCelsiusConversion: func(value *big.Float) *big.Float {
+		celsius := new(big.Float).Sub(value, big.NewFloat(32))
+		celsius.Mul(celsius, big.NewFloat(5))
+		return celsius.Quo(celsius, big.NewFloat(9))
+	},
+}
+
+//This function returns the conversion of a job of assets.json, which is either the name of the conversion or an object with its type, nil is returned if the job has none
+func getConversionFromJSONFile(jobData string) *types.JobConversion {
+	conversionData := gjson.Get(jobData, "conversion")
+	if !conversionData.Exists() {
+		return nil
+	}
+	var conversion types.JobConversion
+	if conversionData.Type == gjson.String {
+		conversion.Type = conversionData.String()
+	} else {
+		err := json.Unmarshal([]byte(conversionData.Raw), &conversion)
+		if err != nil {
+			log.Error("Error in parsing conversion of the job: ", err)
+			return nil
+		}
+	}
+	err := validateJobConversion(conversion)
+	if err != nil {
+		log.Error("Error in parsing conversion of the job: ", err)
+		return nil
+	}
+	return &conversion
+}
+
+//This function checks that the conversion is one of the conversions of the catalog and that the timezone of a timestamp conversion exists
+func validateJobConversion(conversion types.JobConversion) error {
+	if conversion.Type == core.TimestampToUnixConversion {
+		if conversion.Timezone != "" {
+			_, err := time.LoadLocation(conversion.Timezone)
+			if err != nil {
+				return fmt.Errorf("invalid timezone %s: %s", conversion.Timezone, err)
+			}
+		}
+		return nil
+	}
+	if _, ok := unitConversions[conversion.Type]; !ok {
+		return fmt.Errorf("invalid conversion %s, valid conversions are %s", conversion.Type, strings.Join(core.JobConversions, ", "))
+	}
+	return nil
+}
+
+//This function converts the value selected from the response of a job to a number in the unit of the conversion
+func convertJobValue(value interface{}, conversion types.JobConversion) (*big.Float, error) {
+	if conversion.Type == core.TimestampToUnixConversion {
+		return convertTimestamp(value, conversion)
+	}
+	convert, ok := unitConversions[conversion.Type]
+	if !ok {
+		return nil, errors.New("unknown conversion " + conversion.Type)
+	}
+	number, err := UtilsInterface.ConvertToNumber(value)
+	if err != nil {
+		return nil, err
+	}
+	return convert(number), nil
+}
+
+//This function converts the timestamp to seconds since the unix epoch
+//The timestamp is parsed with the layout of the conversion (RFC 3339 by default) and, if it has no offset, in the timezone of the conversion (UTC by default)
+func convertTimestamp(value interface{}, conversion types.JobConversion) (*big.Float, error) {
+	timestamp, ok := value.(string)
+	if !ok {
+		return nil, errors.New("timestamp is not a string")
+	}
+	layout := conversion.Layout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	location := time.UTC
+	if conversion.Timezone != "" {
+		var err error
+		location, err = time.LoadLocation(conversion.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	parsedTime, err := time.ParseInLocation(layout, strings.TrimSpace(timestamp), location)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Float).SetInt64(parsedTime.Unix()), nil
+}
@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"math/big"
+	"razor/core/types"
+	"reflect"
+	"testing"
+)
+
+func TestGetConversionFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    *types.JobConversion
+	}{
+		{
+			name:    "Test 1: When the conversion is given by its name",
+			jobData: `{"URL": "https://api.example.com/rates", "selector": "rate", "conversion": "percentToBasisPoints"}`,
+			want:    &types.JobConversion{Type: "percentToBasisPoints"},
+		},
+		{
+			name:    "Test 2: When the conversion is given as an object",
+			jobData: `{"URL": "https://api.example.com/match", "selector": "kickoff", "conversion": {"type": "timestampToUnix", "layout": "2006-01-02 15:04", "timezone": "Europe/London"}}`,
+			want:    &types.JobConversion{Type: "timestampToUnix", Layout: "2006-01-02 15:04", Timezone: "Europe/London"},
+		},
+		{
+			name:    "Test 3: When the job has no conversion",
+			jobData: `{"URL": "https://api.gemini.com/v1/pubticker/ethusd", "selector": "last"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 4: When the conversion is not in the catalog",
+			jobData: `{"URL": "https://api.example.com/weather", "selector": "temp", "conversion": "kelvinToCelsius"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 5: When the timezone of the timestamp conversion doesn't exist",
+			jobData: `{"URL": "https://api.example.com/match", "selector": "kickoff", "conversion": {"type": "timestampToUnix", "timezone": "Mars/Olympus"}}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 6: When the conversion is invalid",
+			jobData: `{"URL": "https://api.example.com/rates", "selector": "rate", "conversion": ["percentToBasisPoints"]}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getConversionFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getConversionFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertJobValue(t *testing.T) {
+	StartRazor(OptionsPackageStruct{UtilsInterface: &UtilsStruct{}})
+
+	tests := []struct {
+		name       string
+		value      interface{}
+		conversion types.JobConversion
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "Test 1: When a percent is converted to basis points",
+			value:      4.25,
+			conversion: types.JobConversion{Type: "percentToBasisPoints"},
+			want:       "425",
+		},
+		{
+			name:       "Test 2: When basis points are converted to a percent",
+			value:      "150",
+			conversion: types.JobConversion{Type: "basisPointsToPercent"},
+			want:       "1.5",
+		},
+		{
+			name:       "Test 3: When wei are converted to eth",
+			value:      "2500000000000000000",
+			conversion: types.JobConversion{Type: "weiToEth"},
+			want:       "2.5",
+		},
+		{
+			name:       "Test 4: When eth is converted to wei",
+			value:      0.5,
+			conversion: types.JobConversion{Type: "ethToWei"},
+			want:       "500000000000000000",
+		},
+		{
+			name:       "Test 5: When celsius is converted to fahrenheit",
+			value:      -40.0,
+			conversion: types.JobConversion{Type: "celsiusToFahrenheit"},
+			want:       "-40",
+		},
+		{
+			name:       "Test 6: When fahrenheit is converted to celsius",
+			value:      212.0,
+			conversion: types.JobConversion{Type: "fahrenheitToCelsius"},
+			want:       "100",
+		},
+		{
+			name:       "Test 7: When an RFC 3339 timestamp is converted",
+			value:      "2022-03-01T12:00:00+02:00",
+			conversion: types.JobConversion{Type: "timestampToUnix"},
+			want:       "1646128800",
+		},
+		{
+			name:       "Test 8: When a timestamp without offset is converted in its timezone",
+			value:      "2022-03-01 12:00",
+			conversion: types.JobConversion{Type: "timestampToUnix", Layout: "2006-01-02 15:04", Timezone: "Asia/Kolkata"},
+			want:       "1646116200",
+		},
+		{
+			name:       "Test 9: When a timestamp without offset is converted in UTC",
+			value:      "2022-03-01 12:00",
+			conversion: types.JobConversion{Type: "timestampToUnix", Layout: "2006-01-02 15:04"},
+			want:       "1646136000",
+		},
+		{
+			name:       "Test 10: When the timestamp doesn't match the layout",
+			value:      "01/03/2022",
+			conversion: types.JobConversion{Type: "timestampToUnix"},
+			wantErr:    true,
+		},
+		{
+			name:       "Test 11: When the timestamp is not a string",
+			value:      1646136000.0,
+			conversion: types.JobConversion{Type: "timestampToUnix"},
+			wantErr:    true,
+		},
+		{
+			name:       "Test 12: When the value is not a number",
+			value:      "warm",
+			conversion: types.JobConversion{Type: "celsiusToFahrenheit"},
+			wantErr:    true,
+		},
+		{
+			name:       "Test 13: When the conversion is unknown",
+			value:      1.0,
+			conversion: types.JobConversion{Type: "kelvinToCelsius"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertJobValue(tt.value, tt.conversion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("convertJobValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			want, _ := new(big.Float).SetString(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("convertJobValue() got = %s, want %s", got.Text('f', -1), tt.want)
+			}
+		})
+	}
+}
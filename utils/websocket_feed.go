@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/tidwall/gjson"
+)
+
+//webSocketFeed is the last value selected from the messages of a WebSocket feed, received is closed once the first value is received
+type webSocketFeed struct {
+	value      interface{}
+	receivedAt time.Time
+	readAt     time.Time
+	received   chan struct{}
+}
+
+var (
+	webSocketFeeds      = make(map[string]*webSocketFeed)
+	webSocketFeedsMutex sync.Mutex
+)
+
+//This function returns the subscription of the WebSocket feed of a job of assets.json, nil is returned if the job has none
+func getWebSocketFeedFromJSONFile(jobData string) *types.WebSocketFeed {
+	feedData := gjson.Get(jobData, "websocket")
+	if !feedData.Exists() {
+		return nil
+	}
+	var feed types.WebSocketFeed
+	err := json.Unmarshal([]byte(feedData.Raw), &feed)
+	if err != nil {
+		log.Error("Error in parsing websocket of the job: ", err)
+		return nil
+	}
+	return &feed
+}
+
+//This function returns the last value selected from the messages of the WebSocket feed of the job
+//The feed is subscribed on the first call, which waits for its first value, and it is kept open as long as its values are read
+func getDataFromWebSocketFeed(job bindings.StructsJob, feedSubscription *types.WebSocketFeed, headers map[string]string) (interface{}, error) {
+	if err := CheckAllowedHost(job.Url); err != nil {
+		return nil, err
+	}
+	var subscription types.WebSocketFeed
+	if feedSubscription != nil {
+		subscription = *feedSubscription
+	}
+	maxAge := core.WebSocketFeedMaxAge
+	if subscription.MaxAge != 0 {
+		maxAge = time.Duration(subscription.MaxAge) * time.Second
+	}
+
+	key := job.Url + "\x00" + string(subscription.Subscribe) + "\x00" + job.Selector
+	webSocketFeedsMutex.Lock()
+	feed, ok := webSocketFeeds[key]
+	if !ok {
+		feed = &webSocketFeed{received: make(chan struct{})}
+		webSocketFeeds[key] = feed
+		go runWebSocketFeed(key, feed, job.Url, job.Selector, subscription.Subscribe, headers)
+	}
+	feed.readAt = time.Now()
+	webSocketFeedsMutex.Unlock()
+
+	select {
+	case <-feed.received:
+	case <-time.After(core.WebSocketFeedFirstValueTimeout):
+		return nil, fmt.Errorf("no value received from WebSocket feed %s", job.Url)
+	}
+
+	webSocketFeedsMutex.Lock()
+	defer webSocketFeedsMutex.Unlock()
+	if age := time.Since(feed.receivedAt); age > maxAge {
+		return nil, fmt.Errorf("last value of WebSocket feed %s is %s old", job.Url, age.Round(time.Second))
+	}
+	return feed.value, nil
+}
+
+//This function keeps the WebSocket feed connected, it is reconnected after WebSocketFeedReconnectInterval if the connection fails
+//The feed is closed once its values haven't been read for WebSocketFeedIdleTimeout
+func runWebSocketFeed(key string, feed *webSocketFeed, url string, selector string, subscribe json.RawMessage, headers map[string]string) {
+	for {
+		err := followWebSocketFeed(key, feed, url, selector, subscribe, headers)
+		if isWebSocketFeedIdle(key, feed) {
+			log.Debugf("Closing WebSocket feed %s as its values aren't read", url)
+			return
+		}
+		log.Errorf("Error in WebSocket feed %s, reconnecting: %s", url, err)
+		time.Sleep(core.WebSocketFeedReconnectInterval)
+	}
+}
+
+//This function connects to the WebSocket feed, sends the subscribe message and stores the value selected from every message which has it
+func followWebSocketFeed(key string, feed *webSocketFeed, url string, selector string, subscribe json.RawMessage, headers map[string]string) error {
+	requestHeader := http.Header{}
+	setRequestHeaders(requestHeader)
+	for name, value := range headers {
+		requestHeader.Set(name, value)
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(url, requestHeader)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if len(subscribe) > 0 {
+		err = conn.WriteMessage(websocket.TextMessage, subscribe)
+		if err != nil {
+			return err
+		}
+	}
+	for !isWebSocketFeedIdle(key, feed) {
+		err = conn.SetReadDeadline(time.Now().Add(core.WebSocketFeedReadTimeout))
+		if err != nil {
+			return err
+		}
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		// The messages which don't have the value, e.g. the confirmation of the subscription, are skipped
+		var parsedMessage interface{}
+		if json.Unmarshal(message, &parsedMessage) != nil {
+			continue
+		}
+		value, err := UtilsInterface.GetDataFromJSON(parsedMessage, selector)
+		if err != nil || value == nil {
+			continue
+		}
+		webSocketFeedsMutex.Lock()
+		firstValue := feed.receivedAt.IsZero()
+		feed.value = value
+		feed.receivedAt = time.Now()
+		webSocketFeedsMutex.Unlock()
+		if firstValue {
+			close(feed.received)
+		}
+	}
+	return nil
+}
+
+//This function returns true and removes the feed if its values haven't been read for WebSocketFeedIdleTimeout
+func isWebSocketFeedIdle(key string, feed *webSocketFeed) bool {
+	webSocketFeedsMutex.Lock()
+	defer webSocketFeedsMutex.Unlock()
+	if time.Since(feed.readAt) < core.WebSocketFeedIdleTimeout {
+		return false
+	}
+	if webSocketFeeds[key] == feed {
+		delete(webSocketFeeds, key)
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"razor/core"
+	"razor/core/types"
+	"razor/pkg/bindings"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestGetWebSocketFeedFromJSONFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobData string
+		want    *types.WebSocketFeed
+	}{
+		{
+			name:    "Test 1: When the job has a subscribe message and a max age",
+			jobData: `{"URL": "wss://ws-feed.exchange.coinbase.com", "selector": "price", "websocket": {"subscribe": {"type": "subscribe", "product_ids": ["ETH-USD"], "channels": ["ticker"]}, "maxAge": 30}}`,
+			want: &types.WebSocketFeed{
+				Subscribe: json.RawMessage(`{"type": "subscribe", "product_ids": ["ETH-USD"], "channels": ["ticker"]}`),
+				MaxAge:    30,
+			},
+		},
+		{
+			name:    "Test 2: When the job has no subscription",
+			jobData: `{"URL": "wss://stream.binance.com:9443/ws/ethusdt@trade", "selector": "p"}`,
+			want:    nil,
+		},
+		{
+			name:    "Test 3: When the subscription is invalid",
+			jobData: `{"URL": "wss://stream.binance.com:9443/ws/ethusdt@trade", "selector": "p", "websocket": {"maxAge": "30"}}`,
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getWebSocketFeedFromJSONFile(tt.jobData); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getWebSocketFeedFromJSONFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDataFromWebSocketFeed(t *testing.T) {
+	StartRazor(OptionsPackageStruct{UtilsInterface: &UtilsStruct{}})
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if r.URL.Path == "/silent" {
+			_, _, _ = conn.ReadMessage()
+			return
+		}
+		_, subscribe, err := conn.ReadMessage()
+		if err != nil || !strings.Contains(string(subscribe), "ETH-USD") {
+			return
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type": "subscriptions", "channels": [{"name": "ticker"}]}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"type": "ticker", "product_id": "ETH-USD", "price": "1834.52"}`))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+	feedUrl := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	firstValueTimeout := core.WebSocketFeedFirstValueTimeout
+	core.WebSocketFeedFirstValueTimeout = time.Second
+	defer func() { core.WebSocketFeedFirstValueTimeout = firstValueTimeout }()
+
+	subscription := &types.WebSocketFeed{Subscribe: json.RawMessage(`{"type": "subscribe", "product_ids": ["ETH-USD"], "channels": ["ticker"]}`)}
+
+	tests := []struct {
+		name         string
+		job          bindings.StructsJob
+		subscription *types.WebSocketFeed
+		staleFeed    bool
+		want         interface{}
+		wantErr      bool
+	}{
+		{
+			name:         "Test 1: When the value is selected from the messages of the feed",
+			job:          bindings.StructsJob{Id: 1, Url: feedUrl, Selector: "price"},
+			subscription: subscription,
+			want:         "1834.52",
+		},
+		{
+			name:    "Test 2: When the feed sends no value",
+			job:     bindings.StructsJob{Id: 2, Url: feedUrl + "/silent", Selector: "price"},
+			wantErr: true,
+		},
+		{
+			name:         "Test 3: When the last value of the feed is older than its max age",
+			job:          bindings.StructsJob{Id: 3, Url: feedUrl + "/stale", Selector: "price"},
+			subscription: &types.WebSocketFeed{MaxAge: 30},
+			staleFeed:    true,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.staleFeed {
+				received := make(chan struct{})
+				close(received)
+				webSocketFeedsMutex.Lock()
+				webSocketFeeds[tt.job.Url+"\x00\x00"+tt.job.Selector] = &webSocketFeed{
+					value:      "1834.52",
+					receivedAt: time.Now().Add(-time.Minute),
+					received:   received,
+				}
+				webSocketFeedsMutex.Unlock()
+			}
+
+			got, err := getDataFromWebSocketFeed(tt.job, tt.subscription, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getDataFromWebSocketFeed() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getDataFromWebSocketFeed() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}